@@ -12,15 +12,26 @@ import (
 	"strings"
 	"sync"
 	"syscall"
+	_ "time/tzdata" // plan time zones are resolved on hosts without a time zone database too, e.g. containers.
 
+	"connectrpc.com/connect"
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
 	"github.com/garethgeorge/backrest/gen/go/v1/v1connect"
 	"github.com/garethgeorge/backrest/internal/api"
+	"github.com/garethgeorge/backrest/internal/auditlog"
 	"github.com/garethgeorge/backrest/internal/auth"
 	"github.com/garethgeorge/backrest/internal/config"
+	"github.com/garethgeorge/backrest/internal/grpcreflect"
+	"github.com/garethgeorge/backrest/internal/metrics"
+	"github.com/garethgeorge/backrest/internal/mqtt"
 	"github.com/garethgeorge/backrest/internal/oplog"
 	"github.com/garethgeorge/backrest/internal/orchestrator"
 	"github.com/garethgeorge/backrest/internal/resticinstaller"
 	"github.com/garethgeorge/backrest/internal/rotatinglog"
+	"github.com/garethgeorge/backrest/internal/selfbackup"
+	"github.com/garethgeorge/backrest/internal/servertls"
+	"github.com/garethgeorge/backrest/internal/unixsocket"
+	"github.com/garethgeorge/backrest/pkg/restic"
 	"github.com/garethgeorge/backrest/webui"
 	"github.com/mattn/go-colorable"
 	"go.etcd.io/bbolt"
@@ -28,16 +39,24 @@ import (
 	"go.uber.org/zap/zapcore"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
+	"google.golang.org/protobuf/proto"
 )
 
+var flagOplogBackend = flag.String("oplog-backend", "bbolt", "storage backend for the operation log, either bbolt or sqlite. Switching backends starts with an empty operation log.")
+var flagRestoreState = flag.String("restore-state", "", "restore backrest's config and operation log from the latest self backup in the restic repo at this URI before starting. The repo password is read from RESTIC_PASSWORD.")
+
 func main() {
 	flag.Parse()
 	ctx, cancel := context.WithCancel(context.Background())
 	go onterm(cancel)
 
-	resticPath, err := resticinstaller.FindOrInstallResticBinary()
-	if err != nil {
-		zap.S().Fatalf("Error finding or installing restic: %v", err)
+	oplogFile := oplogPath()
+	if *flagRestoreState != "" {
+		resticPath, err := resticinstaller.FindOrInstallResticBinary()
+		if err != nil {
+			zap.S().Fatalf("Error finding or installing restic: %v", err)
+		}
+		restoreState(ctx, resticPath, oplogFile)
 	}
 
 	// Load the configuration
@@ -47,14 +66,29 @@ func main() {
 		zap.S().Fatalf("Error loading config: %v", err)
 	}
 
+	// Find or install the restic version pinned in the config, the pin is recorded if it changed.
+	resticPath, pin, err := resticinstaller.FindOrInstallPinned(cfg.GetResticBinary())
+	if err != nil {
+		zap.S().Fatalf("Error finding or installing restic: %v", err)
+	}
+	if !proto.Equal(pin, cfg.GetResticBinary()) {
+		pinned := proto.Clone(cfg).(*v1.Config)
+		pinned.ResticBinary = pin
+		pinned.Modno += 1
+		if err := configStore.Update(pinned); err != nil {
+			zap.S().Warnf("Error recording the installed restic binary in the config: %v", err)
+		} else {
+			cfg = pinned
+		}
+	}
+
 	// Create the authenticator
 	authenticator := auth.NewAuthenticator(getSecret(), configStore)
 
 	var wg sync.WaitGroup
 
 	// Create / load the operation log
-	oplogFile := path.Join(config.DataDir(), "oplog.boltdb")
-	oplog, err := oplog.NewOpLog(oplogFile)
+	oplog, err := openOpLog(oplogFile)
 	if err != nil {
 		if !errors.Is(err, bbolt.ErrTimeout) {
 			zap.S().Fatalf("Timeout while waiting to open database, is the database open elsewhere?")
@@ -82,20 +116,67 @@ func main() {
 		wg.Done()
 	}()
 
+	// Publish plan and repo status to MQTT if a broker is configured.
+	mqttPublisher := mqtt.NewPublisher(configStore, oplog)
+	wg.Add(1)
+	go func() {
+		mqttPublisher.Run(ctx)
+		wg.Done()
+	}()
+
+	// Keep the history of plan metrics for charts, it is backfilled from the oplog the first time.
+	metricsStore, loaded, err := metrics.NewStore(path.Join(config.DataDir(), "metrics.binpb"))
+	if err != nil {
+		zap.S().Warnf("error loading metrics history, starting a new one: %v", err)
+	}
+	if !loaded {
+		var planIds []string
+		for _, plan := range cfg.Plans {
+			planIds = append(planIds, plan.Id)
+		}
+		if err := metricsStore.Backfill(oplog, planIds); err != nil {
+			zap.S().Warnf("error backfilling metrics history: %v", err)
+		}
+	}
+	wg.Add(1)
+	go func() {
+		metricsStore.Run(ctx, oplog)
+		wg.Done()
+	}()
+
 	// Create and serve the HTTP gateway
+	auditLog := auditlog.NewAuditLog(path.Join(config.DataDir(), "audit.log"))
 	apiBackrestHandler := api.NewBackrestHandler(
 		configStore,
 		orchestrator,
 		oplog,
 		logStore,
+		auditLog,
+		metricsStore,
 	)
 
-	apiAuthenticationHandler := api.NewAuthenticationHandler(authenticator)
+	apiAuthenticationHandler := api.NewAuthenticationHandler(authenticator, auditLog)
+
+	var handlerOpts []connect.HandlerOption
+	readOnly := config.ReadOnly()
+	if readOnly {
+		zap.S().Info("read-only mode, API calls that change the config, history or repos are rejected")
+		handlerOpts = append(handlerOpts, connect.WithInterceptors(api.NewReadOnlyInterceptor()))
+	}
 
 	mux := http.NewServeMux()
-	mux.Handle(v1connect.NewAuthenticationHandler(apiAuthenticationHandler))
-	backrestHandlerPath, backrestHandler := v1connect.NewBackrestHandler(apiBackrestHandler)
+	mux.Handle(v1connect.NewAuthenticationHandler(apiAuthenticationHandler, handlerOpts...))
+	mux.Handle(auth.OIDCPathPrefix, auth.NewOIDCHandler(authenticator))
+	backrestHandlerPath, backrestHandler := v1connect.NewBackrestHandler(apiBackrestHandler, handlerOpts...)
 	mux.Handle(backrestHandlerPath, auth.RequireAuthentication(backrestHandler, authenticator))
+	// snapshot archives restore data and webhook triggers run backups, neither is served in read-only mode.
+	if !readOnly {
+		mux.Handle(api.SnapshotArchivePath, auth.RequireAuthentication(api.NewSnapshotArchiveHandler(orchestrator, auditLog), authenticator))
+		mux.Handle(api.WebhookTriggerPath, api.NewWebhookTriggerHandler(orchestrator, auditLog))
+	}
+	for path, handler := range grpcreflect.NewHandlers(v1connect.BackrestName, v1connect.AuthenticationName) {
+		mux.Handle(path, handler)
+	}
 	mux.Handle("/", webui.Handler())
 
 	// Serve the HTTP gateway
@@ -104,12 +185,42 @@ func main() {
 		Handler: h2c.NewHandler(mux, &http2.Server{}), // h2c is HTTP/2 without TLS for grpc-connect support.
 	}
 
+	tlsServer, err := servertls.New(cfg.GetTls(), path.Join(config.DataDir(), "acme"))
+	if err != nil {
+		zap.S().Fatalf("Error configuring TLS: %v", err)
+	}
+	if tlsServer != nil {
+		server.TLSConfig = tlsServer.TLSConfig
+	}
+	if tlsServer != nil && tlsServer.ChallengeHandler != nil {
+		challengeServer := &http.Server{Addr: tlsServer.ChallengeAddress, Handler: tlsServer.ChallengeHandler}
+		zap.S().Infof("Starting ACME challenge server %v", challengeServer.Addr)
+		go func() {
+			<-ctx.Done()
+			challengeServer.Shutdown(context.Background())
+		}()
+		go func() {
+			if err := challengeServer.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+				zap.L().Error("Error starting ACME challenge server", zap.Error(err))
+			}
+		}()
+	}
+
+	if socketPath := config.UnixSocket(); socketPath != "" {
+		go serveUnixSocket(ctx, socketPath, server.Handler)
+	}
+
 	zap.S().Infof("Starting web server %v", server.Addr)
 	go func() {
 		<-ctx.Done()
 		server.Shutdown(context.Background())
 	}()
-	if err := server.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+	if server.TLSConfig != nil {
+		err = server.ListenAndServeTLS("", "")
+	} else {
+		err = server.ListenAndServe()
+	}
+	if !errors.Is(err, http.ErrServerClosed) {
 		zap.L().Error("Error starting server", zap.Error(err))
 	}
 	zap.L().Info("HTTP gateway shutdown")
@@ -117,6 +228,28 @@ func main() {
 	wg.Wait()
 }
 
+// serveUnixSocket serves the handler on a unix socket for local tools, the socket's file mode controls who may
+// connect and API calls are still authenticated.
+func serveUnixSocket(ctx context.Context, path string, handler http.Handler) {
+	mode, err := config.UnixSocketMode()
+	if err != nil {
+		zap.S().Fatalf("Error configuring unix socket: %v", err)
+	}
+	l, err := unixsocket.Listen(path, mode)
+	if err != nil {
+		zap.S().Fatalf("Error listening on unix socket: %v", err)
+	}
+	server := &http.Server{Handler: handler}
+	go func() {
+		<-ctx.Done()
+		server.Shutdown(context.Background())
+	}()
+	zap.S().Infof("Starting unix socket server %v", path)
+	if err := server.Serve(l); !errors.Is(err, http.ErrServerClosed) {
+		zap.L().Error("Error serving unix socket", zap.Error(err))
+	}
+}
+
 func init() {
 	zap.ReplaceGlobals(zap.Must(zap.NewProduction()))
 	if !strings.HasPrefix(os.Getenv("ENV"), "prod") {
@@ -133,9 +266,49 @@ func init() {
 }
 
 func createConfigProvider() config.ConfigStore {
-	return &config.CachingValidatingStore{
-		ConfigStore: &config.JsonFileStore{Path: config.ConfigFilePath()},
+	return &config.HistoryStore{
+		ConfigStore: &config.CachingValidatingStore{
+			ConfigStore: &config.JsonFileStore{Path: config.ConfigFilePath()},
+		},
+		Dir: path.Join(config.DataDir(), "config-history"),
+	}
+}
+
+// oplogPath returns the location of the operation log database for the configured backend.
+func oplogPath() string {
+	switch *flagOplogBackend {
+	case "bbolt":
+		return path.Join(config.DataDir(), "oplog.boltdb")
+	case "sqlite":
+		return path.Join(config.DataDir(), "oplog.sqlite")
+	default:
+		zap.S().Fatalf("Unknown oplog backend %q, expected bbolt or sqlite", *flagOplogBackend)
+		return ""
+	}
+}
+
+func openOpLog(oplogFile string) (*oplog.OpLog, error) {
+	if *flagOplogBackend == "sqlite" {
+		store, err := oplog.NewSqliteStore(oplogFile)
+		if err != nil {
+			return nil, err
+		}
+		return oplog.NewOpLogWithStore(store), nil
+	}
+	return oplog.NewOpLog(oplogFile)
+}
+
+// restoreState bootstraps a new backrest instance from the state saved by a self backup.
+func restoreState(ctx context.Context, resticPath string, oplogFile string) {
+	repo := restic.NewRepo(resticPath, &v1.Repo{
+		Id:       "restore-state",
+		Uri:      *flagRestoreState,
+		Password: os.Getenv("RESTIC_PASSWORD"),
+	}, restic.WithEnviron())
+	if err := selfbackup.RestoreState(ctx, repo, config.ConfigFilePath(), oplogFile); err != nil {
+		zap.S().Fatalf("Error restoring backrest state: %v", err)
 	}
+	zap.S().Infof("Restored backrest state from %v", *flagRestoreState)
 }
 
 func onterm(callback func()) {
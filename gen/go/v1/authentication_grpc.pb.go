@@ -12,6 +12,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -20,8 +21,17 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Authentication_Login_FullMethodName        = "/v1.Authentication/Login"
-	Authentication_HashPassword_FullMethodName = "/v1.Authentication/HashPassword"
+	Authentication_Login_FullMethodName                      = "/v1.Authentication/Login"
+	Authentication_HashPassword_FullMethodName               = "/v1.Authentication/HashPassword"
+	Authentication_BeginTotpEnrollment_FullMethodName        = "/v1.Authentication/BeginTotpEnrollment"
+	Authentication_ConfirmTotpEnrollment_FullMethodName      = "/v1.Authentication/ConfirmTotpEnrollment"
+	Authentication_BeginWebAuthnRegistration_FullMethodName  = "/v1.Authentication/BeginWebAuthnRegistration"
+	Authentication_FinishWebAuthnRegistration_FullMethodName = "/v1.Authentication/FinishWebAuthnRegistration"
+	Authentication_RegenerateRecoveryCodes_FullMethodName    = "/v1.Authentication/RegenerateRecoveryCodes"
+	Authentication_RemoveSecondFactors_FullMethodName        = "/v1.Authentication/RemoveSecondFactors"
+	Authentication_CreateApiToken_FullMethodName             = "/v1.Authentication/CreateApiToken"
+	Authentication_ListLoginLockouts_FullMethodName          = "/v1.Authentication/ListLoginLockouts"
+	Authentication_ClearLoginLockouts_FullMethodName         = "/v1.Authentication/ClearLoginLockouts"
 )
 
 // AuthenticationClient is the client API for Authentication service.
@@ -30,6 +40,26 @@ const (
 type AuthenticationClient interface {
 	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
 	HashPassword(ctx context.Context, in *types.StringValue, opts ...grpc.CallOption) (*types.StringValue, error)
+	// BeginTotpEnrollment generates a TOTP secret, it is enrolled once confirmed with a code.
+	BeginTotpEnrollment(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*TotpEnrollment, error)
+	// ConfirmTotpEnrollment enrolls the TOTP secret, recovery codes are returned if the user had none.
+	ConfirmTotpEnrollment(ctx context.Context, in *ConfirmTotpEnrollmentRequest, opts ...grpc.CallOption) (*RecoveryCodes, error)
+	// BeginWebAuthnRegistration returns the options for navigator.credentials.create().
+	BeginWebAuthnRegistration(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*WebAuthnChallenge, error)
+	// FinishWebAuthnRegistration enrolls the created credential, recovery codes are returned if the user had none.
+	FinishWebAuthnRegistration(ctx context.Context, in *FinishWebAuthnRegistrationRequest, opts ...grpc.CallOption) (*RecoveryCodes, error)
+	// RegenerateRecoveryCodes replaces the user's recovery codes.
+	RegenerateRecoveryCodes(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*RecoveryCodes, error)
+	// RemoveSecondFactors removes all second factors of the authenticated user once they prove they still hold one, a
+	// user who lost theirs proves it with a recovery code.
+	RemoveSecondFactors(ctx context.Context, in *RemoveSecondFactorsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// CreateApiToken returns a token for the user valid for the requested number of days (default 365, at most 3650)
+	// e.g. for another instance to read this instance's status.
+	CreateApiToken(ctx context.Context, in *types.Int64Value, opts ...grpc.CallOption) (*types.StringValue, error)
+	// ListLoginLockouts returns the clients and users with recent failed logins and whether they are locked out.
+	ListLoginLockouts(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*LoginLockoutList, error)
+	// ClearLoginLockouts clears the failed logins of the key e.g. "user:alice", or of all keys if empty.
+	ClearLoginLockouts(ctx context.Context, in *types.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type authenticationClient struct {
@@ -58,12 +88,113 @@ func (c *authenticationClient) HashPassword(ctx context.Context, in *types.Strin
 	return out, nil
 }
 
+func (c *authenticationClient) BeginTotpEnrollment(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*TotpEnrollment, error) {
+	out := new(TotpEnrollment)
+	err := c.cc.Invoke(ctx, Authentication_BeginTotpEnrollment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticationClient) ConfirmTotpEnrollment(ctx context.Context, in *ConfirmTotpEnrollmentRequest, opts ...grpc.CallOption) (*RecoveryCodes, error) {
+	out := new(RecoveryCodes)
+	err := c.cc.Invoke(ctx, Authentication_ConfirmTotpEnrollment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticationClient) BeginWebAuthnRegistration(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*WebAuthnChallenge, error) {
+	out := new(WebAuthnChallenge)
+	err := c.cc.Invoke(ctx, Authentication_BeginWebAuthnRegistration_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticationClient) FinishWebAuthnRegistration(ctx context.Context, in *FinishWebAuthnRegistrationRequest, opts ...grpc.CallOption) (*RecoveryCodes, error) {
+	out := new(RecoveryCodes)
+	err := c.cc.Invoke(ctx, Authentication_FinishWebAuthnRegistration_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticationClient) RegenerateRecoveryCodes(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*RecoveryCodes, error) {
+	out := new(RecoveryCodes)
+	err := c.cc.Invoke(ctx, Authentication_RegenerateRecoveryCodes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticationClient) RemoveSecondFactors(ctx context.Context, in *RemoveSecondFactorsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Authentication_RemoveSecondFactors_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticationClient) CreateApiToken(ctx context.Context, in *types.Int64Value, opts ...grpc.CallOption) (*types.StringValue, error) {
+	out := new(types.StringValue)
+	err := c.cc.Invoke(ctx, Authentication_CreateApiToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticationClient) ListLoginLockouts(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*LoginLockoutList, error) {
+	out := new(LoginLockoutList)
+	err := c.cc.Invoke(ctx, Authentication_ListLoginLockouts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *authenticationClient) ClearLoginLockouts(ctx context.Context, in *types.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Authentication_ClearLoginLockouts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AuthenticationServer is the server API for Authentication service.
 // All implementations must embed UnimplementedAuthenticationServer
 // for forward compatibility
 type AuthenticationServer interface {
 	Login(context.Context, *LoginRequest) (*LoginResponse, error)
 	HashPassword(context.Context, *types.StringValue) (*types.StringValue, error)
+	// BeginTotpEnrollment generates a TOTP secret, it is enrolled once confirmed with a code.
+	BeginTotpEnrollment(context.Context, *emptypb.Empty) (*TotpEnrollment, error)
+	// ConfirmTotpEnrollment enrolls the TOTP secret, recovery codes are returned if the user had none.
+	ConfirmTotpEnrollment(context.Context, *ConfirmTotpEnrollmentRequest) (*RecoveryCodes, error)
+	// BeginWebAuthnRegistration returns the options for navigator.credentials.create().
+	BeginWebAuthnRegistration(context.Context, *emptypb.Empty) (*WebAuthnChallenge, error)
+	// FinishWebAuthnRegistration enrolls the created credential, recovery codes are returned if the user had none.
+	FinishWebAuthnRegistration(context.Context, *FinishWebAuthnRegistrationRequest) (*RecoveryCodes, error)
+	// RegenerateRecoveryCodes replaces the user's recovery codes.
+	RegenerateRecoveryCodes(context.Context, *emptypb.Empty) (*RecoveryCodes, error)
+	// RemoveSecondFactors removes all second factors of the authenticated user once they prove they still hold one, a
+	// user who lost theirs proves it with a recovery code.
+	RemoveSecondFactors(context.Context, *RemoveSecondFactorsRequest) (*emptypb.Empty, error)
+	// CreateApiToken returns a token for the user valid for the requested number of days (default 365, at most 3650)
+	// e.g. for another instance to read this instance's status.
+	CreateApiToken(context.Context, *types.Int64Value) (*types.StringValue, error)
+	// ListLoginLockouts returns the clients and users with recent failed logins and whether they are locked out.
+	ListLoginLockouts(context.Context, *emptypb.Empty) (*LoginLockoutList, error)
+	// ClearLoginLockouts clears the failed logins of the key e.g. "user:alice", or of all keys if empty.
+	ClearLoginLockouts(context.Context, *types.StringValue) (*emptypb.Empty, error)
 	mustEmbedUnimplementedAuthenticationServer()
 }
 
@@ -77,6 +208,33 @@ func (UnimplementedAuthenticationServer) Login(context.Context, *LoginRequest) (
 func (UnimplementedAuthenticationServer) HashPassword(context.Context, *types.StringValue) (*types.StringValue, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HashPassword not implemented")
 }
+func (UnimplementedAuthenticationServer) BeginTotpEnrollment(context.Context, *emptypb.Empty) (*TotpEnrollment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BeginTotpEnrollment not implemented")
+}
+func (UnimplementedAuthenticationServer) ConfirmTotpEnrollment(context.Context, *ConfirmTotpEnrollmentRequest) (*RecoveryCodes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmTotpEnrollment not implemented")
+}
+func (UnimplementedAuthenticationServer) BeginWebAuthnRegistration(context.Context, *emptypb.Empty) (*WebAuthnChallenge, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BeginWebAuthnRegistration not implemented")
+}
+func (UnimplementedAuthenticationServer) FinishWebAuthnRegistration(context.Context, *FinishWebAuthnRegistrationRequest) (*RecoveryCodes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinishWebAuthnRegistration not implemented")
+}
+func (UnimplementedAuthenticationServer) RegenerateRecoveryCodes(context.Context, *emptypb.Empty) (*RecoveryCodes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegenerateRecoveryCodes not implemented")
+}
+func (UnimplementedAuthenticationServer) RemoveSecondFactors(context.Context, *RemoveSecondFactorsRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveSecondFactors not implemented")
+}
+func (UnimplementedAuthenticationServer) CreateApiToken(context.Context, *types.Int64Value) (*types.StringValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateApiToken not implemented")
+}
+func (UnimplementedAuthenticationServer) ListLoginLockouts(context.Context, *emptypb.Empty) (*LoginLockoutList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLoginLockouts not implemented")
+}
+func (UnimplementedAuthenticationServer) ClearLoginLockouts(context.Context, *types.StringValue) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearLoginLockouts not implemented")
+}
 func (UnimplementedAuthenticationServer) mustEmbedUnimplementedAuthenticationServer() {}
 
 // UnsafeAuthenticationServer may be embedded to opt out of forward compatibility for this service.
@@ -126,6 +284,168 @@ func _Authentication_HashPassword_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Authentication_BeginTotpEnrollment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticationServer).BeginTotpEnrollment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Authentication_BeginTotpEnrollment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticationServer).BeginTotpEnrollment(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Authentication_ConfirmTotpEnrollment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmTotpEnrollmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticationServer).ConfirmTotpEnrollment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Authentication_ConfirmTotpEnrollment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticationServer).ConfirmTotpEnrollment(ctx, req.(*ConfirmTotpEnrollmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Authentication_BeginWebAuthnRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticationServer).BeginWebAuthnRegistration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Authentication_BeginWebAuthnRegistration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticationServer).BeginWebAuthnRegistration(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Authentication_FinishWebAuthnRegistration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinishWebAuthnRegistrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticationServer).FinishWebAuthnRegistration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Authentication_FinishWebAuthnRegistration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticationServer).FinishWebAuthnRegistration(ctx, req.(*FinishWebAuthnRegistrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Authentication_RegenerateRecoveryCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticationServer).RegenerateRecoveryCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Authentication_RegenerateRecoveryCodes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticationServer).RegenerateRecoveryCodes(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Authentication_RemoveSecondFactors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveSecondFactorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticationServer).RemoveSecondFactors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Authentication_RemoveSecondFactors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticationServer).RemoveSecondFactors(ctx, req.(*RemoveSecondFactorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Authentication_CreateApiToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Int64Value)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticationServer).CreateApiToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Authentication_CreateApiToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticationServer).CreateApiToken(ctx, req.(*types.Int64Value))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Authentication_ListLoginLockouts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticationServer).ListLoginLockouts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Authentication_ListLoginLockouts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticationServer).ListLoginLockouts(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Authentication_ClearLoginLockouts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.StringValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthenticationServer).ClearLoginLockouts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Authentication_ClearLoginLockouts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthenticationServer).ClearLoginLockouts(ctx, req.(*types.StringValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Authentication_ServiceDesc is the grpc.ServiceDesc for Authentication service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -141,6 +461,42 @@ var Authentication_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "HashPassword",
 			Handler:    _Authentication_HashPassword_Handler,
 		},
+		{
+			MethodName: "BeginTotpEnrollment",
+			Handler:    _Authentication_BeginTotpEnrollment_Handler,
+		},
+		{
+			MethodName: "ConfirmTotpEnrollment",
+			Handler:    _Authentication_ConfirmTotpEnrollment_Handler,
+		},
+		{
+			MethodName: "BeginWebAuthnRegistration",
+			Handler:    _Authentication_BeginWebAuthnRegistration_Handler,
+		},
+		{
+			MethodName: "FinishWebAuthnRegistration",
+			Handler:    _Authentication_FinishWebAuthnRegistration_Handler,
+		},
+		{
+			MethodName: "RegenerateRecoveryCodes",
+			Handler:    _Authentication_RegenerateRecoveryCodes_Handler,
+		},
+		{
+			MethodName: "RemoveSecondFactors",
+			Handler:    _Authentication_RemoveSecondFactors_Handler,
+		},
+		{
+			MethodName: "CreateApiToken",
+			Handler:    _Authentication_CreateApiToken_Handler,
+		},
+		{
+			MethodName: "ListLoginLockouts",
+			Handler:    _Authentication_ListLoginLockouts_Handler,
+		},
+		{
+			MethodName: "ClearLoginLockouts",
+			Handler:    _Authentication_ClearLoginLockouts_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/authentication.proto",
@@ -20,14 +20,70 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ResourceLimits_IoClass int32
+
+const (
+	ResourceLimits_IO_CLASS_UNCHANGED   ResourceLimits_IoClass = 0
+	ResourceLimits_IO_CLASS_REALTIME    ResourceLimits_IoClass = 1
+	ResourceLimits_IO_CLASS_BEST_EFFORT ResourceLimits_IoClass = 2
+	ResourceLimits_IO_CLASS_IDLE        ResourceLimits_IoClass = 3
+)
+
+// Enum value maps for ResourceLimits_IoClass.
+var (
+	ResourceLimits_IoClass_name = map[int32]string{
+		0: "IO_CLASS_UNCHANGED",
+		1: "IO_CLASS_REALTIME",
+		2: "IO_CLASS_BEST_EFFORT",
+		3: "IO_CLASS_IDLE",
+	}
+	ResourceLimits_IoClass_value = map[string]int32{
+		"IO_CLASS_UNCHANGED":   0,
+		"IO_CLASS_REALTIME":    1,
+		"IO_CLASS_BEST_EFFORT": 2,
+		"IO_CLASS_IDLE":        3,
+	}
+)
+
+func (x ResourceLimits_IoClass) Enum() *ResourceLimits_IoClass {
+	p := new(ResourceLimits_IoClass)
+	*p = x
+	return p
+}
+
+func (x ResourceLimits_IoClass) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ResourceLimits_IoClass) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_config_proto_enumTypes[0].Descriptor()
+}
+
+func (ResourceLimits_IoClass) Type() protoreflect.EnumType {
+	return &file_v1_config_proto_enumTypes[0]
+}
+
+func (x ResourceLimits_IoClass) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ResourceLimits_IoClass.Descriptor instead.
+func (ResourceLimits_IoClass) EnumDescriptor() ([]byte, []int) {
+	return file_v1_config_proto_rawDescGZIP(), []int{22, 0}
+}
+
 type Hook_Condition int32
 
 const (
-	Hook_CONDITION_UNKNOWN        Hook_Condition = 0
-	Hook_CONDITION_ANY_ERROR      Hook_Condition = 1 // error running any operation.
-	Hook_CONDITION_SNAPSHOT_START Hook_Condition = 2 // backup started.
-	Hook_CONDITION_SNAPSHOT_END   Hook_Condition = 3 // backup completed (success or fail).
-	Hook_CONDITION_SNAPSHOT_ERROR Hook_Condition = 4 // snapshot failed.
+	Hook_CONDITION_UNKNOWN             Hook_Condition = 0
+	Hook_CONDITION_ANY_ERROR           Hook_Condition = 1 // error running any operation.
+	Hook_CONDITION_SNAPSHOT_START      Hook_Condition = 2 // backup started.
+	Hook_CONDITION_SNAPSHOT_END        Hook_Condition = 3 // backup completed (success or fail).
+	Hook_CONDITION_SNAPSHOT_ERROR      Hook_Condition = 4 // snapshot failed.
+	Hook_CONDITION_TIMEOUT             Hook_Condition = 5 // a task exceeded the plan's timeout.
+	Hook_CONDITION_RESTORE_DRILL_ERROR Hook_Condition = 6 // a restore drill failed or found mismatched files.
+	Hook_CONDITION_LOW_DISK_SPACE      Hook_Condition = 7 // a path a task writes to is below the repo's disk space warning threshold.
+	Hook_CONDITION_BACKUP_ANOMALY      Hook_Condition = 8 // a backup deviated wildly from the plan's baseline, see AnomalyDetection.
 )
 
 // Enum value maps for Hook_Condition.
@@ -38,13 +94,21 @@ var (
 		2: "CONDITION_SNAPSHOT_START",
 		3: "CONDITION_SNAPSHOT_END",
 		4: "CONDITION_SNAPSHOT_ERROR",
+		5: "CONDITION_TIMEOUT",
+		6: "CONDITION_RESTORE_DRILL_ERROR",
+		7: "CONDITION_LOW_DISK_SPACE",
+		8: "CONDITION_BACKUP_ANOMALY",
 	}
 	Hook_Condition_value = map[string]int32{
-		"CONDITION_UNKNOWN":        0,
-		"CONDITION_ANY_ERROR":      1,
-		"CONDITION_SNAPSHOT_START": 2,
-		"CONDITION_SNAPSHOT_END":   3,
-		"CONDITION_SNAPSHOT_ERROR": 4,
+		"CONDITION_UNKNOWN":             0,
+		"CONDITION_ANY_ERROR":           1,
+		"CONDITION_SNAPSHOT_START":      2,
+		"CONDITION_SNAPSHOT_END":        3,
+		"CONDITION_SNAPSHOT_ERROR":      4,
+		"CONDITION_TIMEOUT":             5,
+		"CONDITION_RESTORE_DRILL_ERROR": 6,
+		"CONDITION_LOW_DISK_SPACE":      7,
+		"CONDITION_BACKUP_ANOMALY":      8,
 	}
 )
 
@@ -59,11 +123,11 @@ func (x Hook_Condition) String() string {
 }
 
 func (Hook_Condition) Descriptor() protoreflect.EnumDescriptor {
-	return file_v1_config_proto_enumTypes[0].Descriptor()
+	return file_v1_config_proto_enumTypes[1].Descriptor()
 }
 
 func (Hook_Condition) Type() protoreflect.EnumType {
-	return &file_v1_config_proto_enumTypes[0]
+	return &file_v1_config_proto_enumTypes[1]
 }
 
 func (x Hook_Condition) Number() protoreflect.EnumNumber {
@@ -72,7 +136,7 @@ func (x Hook_Condition) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Hook_Condition.Descriptor instead.
 func (Hook_Condition) EnumDescriptor() ([]byte, []int) {
-	return file_v1_config_proto_rawDescGZIP(), []int{5, 0}
+	return file_v1_config_proto_rawDescGZIP(), []int{27, 0}
 }
 
 // Config is the top level config object for restic UI.
@@ -85,10 +149,18 @@ type Config struct {
 	Modno   int32 `protobuf:"varint,1,opt,name=modno,proto3" json:"modno,omitempty"`
 	Version int32 `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"` // version of the config file format. Used to determine when to run migrations.
 	// override the hostname tagged on backups. If provided it will be used in addition to tags to group backups.
-	Host  string  `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
-	Repos []*Repo `protobuf:"bytes,3,rep,name=repos,proto3" json:"repos,omitempty"`
-	Plans []*Plan `protobuf:"bytes,4,rep,name=plans,proto3" json:"plans,omitempty"`
-	Auth  *Auth   `protobuf:"bytes,5,opt,name=auth,proto3" json:"auth,omitempty"`
+	Host            string            `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
+	Repos           []*Repo           `protobuf:"bytes,3,rep,name=repos,proto3" json:"repos,omitempty"`
+	Plans           []*Plan           `protobuf:"bytes,4,rep,name=plans,proto3" json:"plans,omitempty"`
+	Auth            *Auth             `protobuf:"bytes,5,opt,name=auth,proto3" json:"auth,omitempty"`
+	Mqtt            *Mqtt             `protobuf:"bytes,7,opt,name=mqtt,proto3" json:"mqtt,omitempty"`                                               // optional MQTT broker to publish plan and repo status to.
+	SelfBackup      *SelfBackup       `protobuf:"bytes,8,opt,name=self_backup,json=selfBackup,proto3" json:"self_backup,omitempty"`                 // optional backup of backrest's own config and operation log.
+	Tls             *Tls              `protobuf:"bytes,9,opt,name=tls,proto3" json:"tls,omitempty"`                                                 // optional TLS for the API and UI listener, changes take effect on restart.
+	RemoteInstances []*RemoteInstance `protobuf:"bytes,10,rep,name=remote_instances,json=remoteInstances,proto3" json:"remote_instances,omitempty"` // other backrest instances whose status is shown read-only.
+	Notifications   *Notifications    `protobuf:"bytes,11,opt,name=notifications,proto3" json:"notifications,omitempty"`                            // optional, language and wording of hook notifications.
+	EmergencyStop   *EmergencyStop    `protobuf:"bytes,12,opt,name=emergency_stop,json=emergencyStop,proto3" json:"emergency_stop,omitempty"`       // set while an emergency stop is in effect, see the EmergencyStop RPC.
+	PlanTemplates   []*PlanTemplate   `protobuf:"bytes,13,rep,name=plan_templates,json=planTemplates,proto3" json:"plan_templates,omitempty"`       // templates plans are created from with InstantiatePlanTemplate.
+	ResticBinary    *ResticBinary     `protobuf:"bytes,14,opt,name=restic_binary,json=resticBinary,proto3" json:"restic_binary,omitempty"`          // set by backrest, the restic binary it installed and verified.
 }
 
 func (x *Config) Reset() {
@@ -165,23 +237,77 @@ func (x *Config) GetAuth() *Auth {
 	return nil
 }
 
-type Repo struct {
+func (x *Config) GetMqtt() *Mqtt {
+	if x != nil {
+		return x.Mqtt
+	}
+	return nil
+}
+
+func (x *Config) GetSelfBackup() *SelfBackup {
+	if x != nil {
+		return x.SelfBackup
+	}
+	return nil
+}
+
+func (x *Config) GetTls() *Tls {
+	if x != nil {
+		return x.Tls
+	}
+	return nil
+}
+
+func (x *Config) GetRemoteInstances() []*RemoteInstance {
+	if x != nil {
+		return x.RemoteInstances
+	}
+	return nil
+}
+
+func (x *Config) GetNotifications() *Notifications {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
+}
+
+func (x *Config) GetEmergencyStop() *EmergencyStop {
+	if x != nil {
+		return x.EmergencyStop
+	}
+	return nil
+}
+
+func (x *Config) GetPlanTemplates() []*PlanTemplate {
+	if x != nil {
+		return x.PlanTemplates
+	}
+	return nil
+}
+
+func (x *Config) GetResticBinary() *ResticBinary {
+	if x != nil {
+		return x.ResticBinary
+	}
+	return nil
+}
+
+// PlanTemplate is a plan whose string fields contain ${name} variables, e.g. a plan with the id "home-${hostname}"
+// backing up "${path}". Instantiating the template substitutes the variables, unknown variables are an error. Hooks
+// are copied as is since their commands often use shell variables.
+type PlanTemplate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          string       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                      // unique but human readable ID for this repo.
-	Uri         string       `protobuf:"bytes,2,opt,name=uri,proto3" json:"uri,omitempty"`                                    // restic repo URI
-	Password    string       `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`                          // plaintext password
-	Env         []string     `protobuf:"bytes,4,rep,name=env,proto3" json:"env,omitempty"`                                    // extra environment variables to set for restic.
-	Flags       []string     `protobuf:"bytes,5,rep,name=flags,proto3" json:"flags,omitempty"`                                // extra flags set on the restic command.
-	PrunePolicy *PrunePolicy `protobuf:"bytes,6,opt,name=prune_policy,json=prunePolicy,proto3" json:"prune_policy,omitempty"` // policy for when to run prune.
-	Hooks       []*Hook      `protobuf:"bytes,7,rep,name=hooks,proto3" json:"hooks,omitempty"`                                // hooks to run on events for this repo.
-	AutoUnlock  bool         `protobuf:"varint,8,opt,name=auto_unlock,json=autoUnlock,proto3" json:"auto_unlock,omitempty"`   // automatically unlock the repo when needed.
+	Id          string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                   // unique but human readable ID for this template.
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"` // optional, what plans created from the template back up.
+	Plan        *Plan  `protobuf:"bytes,3,opt,name=plan,proto3" json:"plan,omitempty"`
 }
 
-func (x *Repo) Reset() {
-	*x = Repo{}
+func (x *PlanTemplate) Reset() {
+	*x = PlanTemplate{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_v1_config_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -189,13 +315,13 @@ func (x *Repo) Reset() {
 	}
 }
 
-func (x *Repo) String() string {
+func (x *PlanTemplate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Repo) ProtoMessage() {}
+func (*PlanTemplate) ProtoMessage() {}
 
-func (x *Repo) ProtoReflect() protoreflect.Message {
+func (x *PlanTemplate) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_config_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -207,99 +333,118 @@ func (x *Repo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Repo.ProtoReflect.Descriptor instead.
-func (*Repo) Descriptor() ([]byte, []int) {
+// Deprecated: Use PlanTemplate.ProtoReflect.Descriptor instead.
+func (*PlanTemplate) Descriptor() ([]byte, []int) {
 	return file_v1_config_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *Repo) GetId() string {
+func (x *PlanTemplate) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *Repo) GetUri() string {
+func (x *PlanTemplate) GetDescription() string {
 	if x != nil {
-		return x.Uri
+		return x.Description
 	}
 	return ""
 }
 
-func (x *Repo) GetPassword() string {
+func (x *PlanTemplate) GetPlan() *Plan {
 	if x != nil {
-		return x.Password
+		return x.Plan
 	}
-	return ""
+	return nil
 }
 
-func (x *Repo) GetEnv() []string {
-	if x != nil {
-		return x.Env
-	}
-	return nil
+// ResticBinary pins the restic binary backrest downloaded, the binary is checked against the hash at startup and
+// reinstalled if it was modified.
+type ResticBinary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	Sha256  string `protobuf:"bytes,2,opt,name=sha256,proto3" json:"sha256,omitempty"` // hex SHA-256 of the installed binary.
 }
 
-func (x *Repo) GetFlags() []string {
-	if x != nil {
-		return x.Flags
+func (x *ResticBinary) Reset() {
+	*x = ResticBinary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_config_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *Repo) GetPrunePolicy() *PrunePolicy {
-	if x != nil {
-		return x.PrunePolicy
+func (x *ResticBinary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResticBinary) ProtoMessage() {}
+
+func (x *ResticBinary) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_config_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Repo) GetHooks() []*Hook {
+// Deprecated: Use ResticBinary.ProtoReflect.Descriptor instead.
+func (*ResticBinary) Descriptor() ([]byte, []int) {
+	return file_v1_config_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ResticBinary) GetVersion() string {
 	if x != nil {
-		return x.Hooks
+		return x.Version
 	}
-	return nil
+	return ""
 }
 
-func (x *Repo) GetAutoUnlock() bool {
+func (x *ResticBinary) GetSha256() string {
 	if x != nil {
-		return x.AutoUnlock
+		return x.Sha256
 	}
-	return false
+	return ""
 }
 
-type Plan struct {
+// EmergencyStop records an emergency stop, while set no tasks are run or scheduled until schedules are explicitly
+// resumed.
+type EmergencyStop struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id        string           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`               // unique but human readable ID for this plan.
-	Repo      string           `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`           // ID of the repo to use.
-	Paths     []string         `protobuf:"bytes,4,rep,name=paths,proto3" json:"paths,omitempty"`         // paths to include in the backup.
-	Excludes  []string         `protobuf:"bytes,5,rep,name=excludes,proto3" json:"excludes,omitempty"`   // glob patterns to exclude.
-	Iexcludes []string         `protobuf:"bytes,9,rep,name=iexcludes,proto3" json:"iexcludes,omitempty"` // case insensitive glob patterns to exclude.
-	Cron      string           `protobuf:"bytes,6,opt,name=cron,proto3" json:"cron,omitempty"`           // cron expression describing the backup schedule.
-	Retention *RetentionPolicy `protobuf:"bytes,7,opt,name=retention,proto3" json:"retention,omitempty"` // retention policy for snapshots.
-	Hooks     []*Hook          `protobuf:"bytes,8,rep,name=hooks,proto3" json:"hooks,omitempty"`         // hooks to run on events for this plan.
+	UnixTimeMs int64  `protobuf:"varint,1,opt,name=unix_time_ms,json=unixTimeMs,proto3" json:"unix_time_ms,omitempty"` // time the emergency stop was triggered.
+	User       string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`                                  // user that triggered the emergency stop, empty if auth is disabled.
+	Reason     string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`                              // optional, why the emergency stop was triggered.
 }
 
-func (x *Plan) Reset() {
-	*x = Plan{}
+func (x *EmergencyStop) Reset() {
+	*x = EmergencyStop{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_config_proto_msgTypes[2]
+		mi := &file_v1_config_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Plan) String() string {
+func (x *EmergencyStop) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Plan) ProtoMessage() {}
+func (*EmergencyStop) ProtoMessage() {}
 
-func (x *Plan) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_config_proto_msgTypes[2]
+func (x *EmergencyStop) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_config_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -310,113 +455,116 @@ func (x *Plan) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Plan.ProtoReflect.Descriptor instead.
-func (*Plan) Descriptor() ([]byte, []int) {
-	return file_v1_config_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use EmergencyStop.ProtoReflect.Descriptor instead.
+func (*EmergencyStop) Descriptor() ([]byte, []int) {
+	return file_v1_config_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *Plan) GetId() string {
+func (x *EmergencyStop) GetUnixTimeMs() int64 {
 	if x != nil {
-		return x.Id
+		return x.UnixTimeMs
 	}
-	return ""
+	return 0
 }
 
-func (x *Plan) GetRepo() string {
+func (x *EmergencyStop) GetUser() string {
 	if x != nil {
-		return x.Repo
+		return x.User
 	}
 	return ""
 }
 
-func (x *Plan) GetPaths() []string {
+func (x *EmergencyStop) GetReason() string {
 	if x != nil {
-		return x.Paths
+		return x.Reason
 	}
-	return nil
+	return ""
 }
 
-func (x *Plan) GetExcludes() []string {
-	if x != nil {
-		return x.Excludes
-	}
-	return nil
+// Notifications selects the built-in notification messages and overrides individual ones.
+type Notifications struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Locale    string            `protobuf:"bytes,1,opt,name=locale,proto3" json:"locale,omitempty"`                                                                                               // language of the built-in messages, "en" (default) or "de".
+	Templates map[string]string `protobuf:"bytes,2,rep,name=templates,proto3" json:"templates,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // overrides of messages by name e.g. "summary_snapshot_end", see GetNotificationTemplates for the names and defaults.
 }
 
-func (x *Plan) GetIexcludes() []string {
-	if x != nil {
-		return x.Iexcludes
+func (x *Notifications) Reset() {
+	*x = Notifications{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_config_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *Plan) GetCron() string {
-	if x != nil {
-		return x.Cron
+func (x *Notifications) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notifications) ProtoMessage() {}
+
+func (x *Notifications) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_config_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Plan) GetRetention() *RetentionPolicy {
+// Deprecated: Use Notifications.ProtoReflect.Descriptor instead.
+func (*Notifications) Descriptor() ([]byte, []int) {
+	return file_v1_config_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Notifications) GetLocale() string {
 	if x != nil {
-		return x.Retention
+		return x.Locale
 	}
-	return nil
+	return ""
 }
 
-func (x *Plan) GetHooks() []*Hook {
+func (x *Notifications) GetTemplates() map[string]string {
 	if x != nil {
-		return x.Hooks
+		return x.Templates
 	}
 	return nil
 }
 
-type RetentionPolicy struct {
+// RemoteInstance is another backrest instance whose status and operations are proxied into this instance's UI.
+type RemoteInstance struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Deprecated: Marked as deprecated in v1/config.proto.
-	MaxUnusedLimit string `protobuf:"bytes,1,opt,name=max_unused_limit,json=maxUnusedLimit,proto3" json:"max_unused_limit,omitempty"`
-	// Deprecated: Marked as deprecated in v1/config.proto.
-	KeepLastN int32 `protobuf:"varint,2,opt,name=keep_last_n,json=keepLastN,proto3" json:"keep_last_n,omitempty"`
-	// Deprecated: Marked as deprecated in v1/config.proto.
-	KeepHourly int32 `protobuf:"varint,3,opt,name=keep_hourly,json=keepHourly,proto3" json:"keep_hourly,omitempty"`
-	// Deprecated: Marked as deprecated in v1/config.proto.
-	KeepDaily int32 `protobuf:"varint,4,opt,name=keep_daily,json=keepDaily,proto3" json:"keep_daily,omitempty"`
-	// Deprecated: Marked as deprecated in v1/config.proto.
-	KeepWeekly int32 `protobuf:"varint,5,opt,name=keep_weekly,json=keepWeekly,proto3" json:"keep_weekly,omitempty"`
-	// Deprecated: Marked as deprecated in v1/config.proto.
-	KeepMonthly int32 `protobuf:"varint,6,opt,name=keep_monthly,json=keepMonthly,proto3" json:"keep_monthly,omitempty"`
-	// Deprecated: Marked as deprecated in v1/config.proto.
-	KeepYearly int32 `protobuf:"varint,7,opt,name=keep_yearly,json=keepYearly,proto3" json:"keep_yearly,omitempty"`
-	// Deprecated: Marked as deprecated in v1/config.proto.
-	KeepWithinDuration string `protobuf:"bytes,8,opt,name=keep_within_duration,json=keepWithinDuration,proto3" json:"keep_within_duration,omitempty"` // keep snapshots within a duration e.g. 1y2m3d4h5m6s
-	// Types that are assignable to Policy:
-	//
-	//	*RetentionPolicy_PolicyKeepLastN
-	//	*RetentionPolicy_PolicyTimeBucketed
-	//	*RetentionPolicy_PolicyKeepAll
-	Policy isRetentionPolicy_Policy `protobuf_oneof:"policy"`
+	Id    string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`       // unique but human readable ID for the instance.
+	Url   string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`     // base URL of the instance e.g. https://nas.local:9898.
+	Token string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"` // API token of a user on the remote instance, see CreateApiToken.
 }
 
-func (x *RetentionPolicy) Reset() {
-	*x = RetentionPolicy{}
+func (x *RemoteInstance) Reset() {
+	*x = RemoteInstance{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_config_proto_msgTypes[3]
+		mi := &file_v1_config_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RetentionPolicy) String() string {
+func (x *RemoteInstance) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RetentionPolicy) ProtoMessage() {}
+func (*RemoteInstance) ProtoMessage() {}
 
-func (x *RetentionPolicy) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_config_proto_msgTypes[3]
+func (x *RemoteInstance) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_config_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -427,152 +575,2781 @@ func (x *RetentionPolicy) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RetentionPolicy.ProtoReflect.Descriptor instead.
-func (*RetentionPolicy) Descriptor() ([]byte, []int) {
-	return file_v1_config_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use RemoteInstance.ProtoReflect.Descriptor instead.
+func (*RemoteInstance) Descriptor() ([]byte, []int) {
+	return file_v1_config_proto_rawDescGZIP(), []int{5}
 }
 
-// Deprecated: Marked as deprecated in v1/config.proto.
-func (x *RetentionPolicy) GetMaxUnusedLimit() string {
+func (x *RemoteInstance) GetId() string {
 	if x != nil {
-		return x.MaxUnusedLimit
+		return x.Id
 	}
 	return ""
 }
 
-// Deprecated: Marked as deprecated in v1/config.proto.
-func (x *RetentionPolicy) GetKeepLastN() int32 {
+func (x *RemoteInstance) GetUrl() string {
 	if x != nil {
-		return x.KeepLastN
+		return x.Url
 	}
-	return 0
+	return ""
 }
 
-// Deprecated: Marked as deprecated in v1/config.proto.
-func (x *RetentionPolicy) GetKeepHourly() int32 {
+func (x *RemoteInstance) GetToken() string {
 	if x != nil {
-		return x.KeepHourly
+		return x.Token
 	}
-	return 0
+	return ""
 }
 
-// Deprecated: Marked as deprecated in v1/config.proto.
-func (x *RetentionPolicy) GetKeepDaily() int32 {
-	if x != nil {
-		return x.KeepDaily
-	}
-	return 0
+// ConfigRevision is a snapshot of the config as it was written at a point in time.
+type ConfigRevision struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Revision   int64   `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`                         // sequential revision number, incremented on every write.
+	UnixTimeMs int64   `protobuf:"varint,2,opt,name=unix_time_ms,json=unixTimeMs,proto3" json:"unix_time_ms,omitempty"` // time the revision was written.
+	Config     *Config `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`                              // the config, omitted when listing revisions.
+	Diff       string  `protobuf:"bytes,4,opt,name=diff,proto3" json:"diff,omitempty"`                                  // diff from the previous revision with secrets redacted, populated when listing revisions.
+}
+
+func (x *ConfigRevision) Reset() {
+	*x = ConfigRevision{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_config_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigRevision) ProtoMessage() {}
+
+func (x *ConfigRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_config_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigRevision.ProtoReflect.Descriptor instead.
+func (*ConfigRevision) Descriptor() ([]byte, []int) {
+	return file_v1_config_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ConfigRevision) GetRevision() int64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
+func (x *ConfigRevision) GetUnixTimeMs() int64 {
+	if x != nil {
+		return x.UnixTimeMs
+	}
+	return 0
+}
+
+func (x *ConfigRevision) GetConfig() *Config {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *ConfigRevision) GetDiff() string {
+	if x != nil {
+		return x.Diff
+	}
+	return ""
+}
+
+type ConfigRevisionList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Revisions []*ConfigRevision `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+}
+
+func (x *ConfigRevisionList) Reset() {
+	*x = ConfigRevisionList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_config_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigRevisionList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigRevisionList) ProtoMessage() {}
+
+func (x *ConfigRevisionList) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_config_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigRevisionList.ProtoReflect.Descriptor instead.
+func (*ConfigRevisionList) Descriptor() ([]byte, []int) {
+	return file_v1_config_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ConfigRevisionList) GetRevisions() []*ConfigRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+type Repo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                      string           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                                                             // unique but human readable ID for this repo.
+	Uri                     string           `protobuf:"bytes,2,opt,name=uri,proto3" json:"uri,omitempty"`                                                                           // restic repo URI
+	Password                string           `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`                                                                 // plaintext password
+	Env                     []string         `protobuf:"bytes,4,rep,name=env,proto3" json:"env,omitempty"`                                                                           // extra environment variables to set for restic.
+	Flags                   []string         `protobuf:"bytes,5,rep,name=flags,proto3" json:"flags,omitempty"`                                                                       // extra flags set on the restic command.
+	PrunePolicy             *PrunePolicy     `protobuf:"bytes,6,opt,name=prune_policy,json=prunePolicy,proto3" json:"prune_policy,omitempty"`                                        // policy for when to run prune.
+	Hooks                   []*Hook          `protobuf:"bytes,7,rep,name=hooks,proto3" json:"hooks,omitempty"`                                                                       // hooks to run on events for this repo.
+	AutoUnlock              bool             `protobuf:"varint,8,opt,name=auto_unlock,json=autoUnlock,proto3" json:"auto_unlock,omitempty"`                                          // automatically unlock the repo when needed.
+	GroupUntrackedSnapshots bool             `protobuf:"varint,9,opt,name=group_untracked_snapshots,json=groupUntrackedSnapshots,proto3" json:"group_untracked_snapshots,omitempty"` // group snapshots not created by backrest by hostname and tags instead of as a single unassociated group.
+	IncrementalStats        bool             `protobuf:"varint,10,opt,name=incremental_stats,json=incrementalStats,proto3" json:"incremental_stats,omitempty"`                       // compute stats for just the new snapshot after each backup, full repo stats only run every 100 operations or 10 GB added.
+	PackSizeMb              int32            `protobuf:"varint,11,opt,name=pack_size_mb,json=packSizeMb,proto3" json:"pack_size_mb,omitempty"`                                       // optional, target pack size in MiB from 4 to 128 (restic --pack-size), requires restic 0.14.0 or newer.
+	Compression             string           `protobuf:"bytes,12,opt,name=compression,proto3" json:"compression,omitempty"`                                                          // optional, one of "auto", "max" or "off" (restic --compression), requires restic 0.14.0 or newer.
+	ExtraFlags              []*ResticFlag    `protobuf:"bytes,13,rep,name=extra_flags,json=extraFlags,proto3" json:"extra_flags,omitempty"`                                          // optional, allowlisted global restic flags set on every command for the repo.
+	DiskSpace               *DiskSpace       `protobuf:"bytes,14,opt,name=disk_space,json=diskSpace,proto3" json:"disk_space,omitempty"`                                             // optional, free space required on the restic cache, a local repo and restore targets.
+	CacheDir                string           `protobuf:"bytes,15,opt,name=cache_dir,json=cacheDir,proto3" json:"cache_dir,omitempty"`                                                // optional, absolute path of the restic cache directory (restic --cache-dir), defaults to the user's cache directory.
+	CachePolicy             *CachePolicy     `protobuf:"bytes,16,opt,name=cache_policy,json=cachePolicy,proto3" json:"cache_policy,omitempty"`                                       // optional, scheduled cleanup of the restic cache.
+	BackendOptions          *BackendOptions  `protobuf:"bytes,17,opt,name=backend_options,json=backendOptions,proto3" json:"backend_options,omitempty"`                              // optional, settings of the storage backend named by the uri.
+	ColdStorage             *ColdStorage     `protobuf:"bytes,18,opt,name=cold_storage,json=coldStorage,proto3" json:"cold_storage,omitempty"`                                       // optional, the repo's data is on archive storage e.g. S3 Glacier.
+	AppendOnly              *AppendOnly      `protobuf:"bytes,19,opt,name=append_only,json=appendOnly,proto3" json:"append_only,omitempty"`                                          // optional, the repo can't be deleted from.
+	DelayedDeletion         *DelayedDeletion `protobuf:"bytes,20,opt,name=delayed_deletion,json=delayedDeletion,proto3" json:"delayed_deletion,omitempty"`                           // optional, snapshots are forgotten a cool off after retention marks them.
+}
+
+func (x *Repo) Reset() {
+	*x = Repo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_config_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Repo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Repo) ProtoMessage() {}
+
+func (x *Repo) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_config_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Repo.ProtoReflect.Descriptor instead.
+func (*Repo) Descriptor() ([]byte, []int) {
+	return file_v1_config_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Repo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Repo) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *Repo) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *Repo) GetEnv() []string {
+	if x != nil {
+		return x.Env
+	}
+	return nil
+}
+
+func (x *Repo) GetFlags() []string {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+func (x *Repo) GetPrunePolicy() *PrunePolicy {
+	if x != nil {
+		return x.PrunePolicy
+	}
+	return nil
+}
+
+func (x *Repo) GetHooks() []*Hook {
+	if x != nil {
+		return x.Hooks
+	}
+	return nil
+}
+
+func (x *Repo) GetAutoUnlock() bool {
+	if x != nil {
+		return x.AutoUnlock
+	}
+	return false
+}
+
+func (x *Repo) GetGroupUntrackedSnapshots() bool {
+	if x != nil {
+		return x.GroupUntrackedSnapshots
+	}
+	return false
+}
+
+func (x *Repo) GetIncrementalStats() bool {
+	if x != nil {
+		return x.IncrementalStats
+	}
+	return false
+}
+
+func (x *Repo) GetPackSizeMb() int32 {
+	if x != nil {
+		return x.PackSizeMb
+	}
+	return 0
+}
+
+func (x *Repo) GetCompression() string {
+	if x != nil {
+		return x.Compression
+	}
+	return ""
+}
+
+func (x *Repo) GetExtraFlags() []*ResticFlag {
+	if x != nil {
+		return x.ExtraFlags
+	}
+	return nil
+}
+
+func (x *Repo) GetDiskSpace() *DiskSpace {
+	if x != nil {
+		return x.DiskSpace
+	}
+	return nil
+}
+
+func (x *Repo) GetCacheDir() string {
+	if x != nil {
+		return x.CacheDir
+	}
+	return ""
+}
+
+func (x *Repo) GetCachePolicy() *CachePolicy {
+	if x != nil {
+		return x.CachePolicy
+	}
+	return nil
+}
+
+func (x *Repo) GetBackendOptions() *BackendOptions {
+	if x != nil {
+		return x.BackendOptions
+	}
+	return nil
+}
+
+func (x *Repo) GetColdStorage() *ColdStorage {
+	if x != nil {
+		return x.ColdStorage
+	}
+	return nil
+}
+
+func (x *Repo) GetAppendOnly() *AppendOnly {
+	if x != nil {
+		return x.AppendOnly
+	}
+	return nil
+}
+
+func (x *Repo) GetDelayedDeletion() *DelayedDeletion {
+	if x != nil {
+		return x.DelayedDeletion
+	}
+	return nil
+}
+
+// DelayedDeletion protects a repo's snapshots against deletion by a compromised instance, e.g. by ransomware. Forgets
+// tag snapshots as marked for deletion instead of forgetting them, a scheduled task forgets marked snapshots once
+// the cool off passed. Until then marked snapshots can be rescued.
+type DelayedDeletion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CoolOffHours int32  `protobuf:"varint,1,opt,name=cool_off_hours,json=coolOffHours,proto3" json:"cool_off_hours,omitempty"` // how long marked snapshots are kept, delayed deletion is enabled if positive.
+	Cron         string `protobuf:"bytes,2,opt,name=cron,proto3" json:"cron,omitempty"`                                        // optional, schedule of the task forgetting marked snapshots, defaults to hourly.
+}
+
+func (x *DelayedDeletion) Reset() {
+	*x = DelayedDeletion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_config_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DelayedDeletion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DelayedDeletion) ProtoMessage() {}
+
+func (x *DelayedDeletion) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_config_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DelayedDeletion.ProtoReflect.Descriptor instead.
+func (*DelayedDeletion) Descriptor() ([]byte, []int) {
+	return file_v1_config_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DelayedDeletion) GetCoolOffHours() int32 {
+	if x != nil {
+		return x.CoolOffHours
+	}
+	return 0
+}
+
+func (x *DelayedDeletion) GetCron() string {
+	if x != nil {
+		return x.Cron
+	}
+	return ""
+}
+
+// AppendOnly marks a repo whose data can't be deleted, e.g. served by rest-server --append-only or on an object-lock
+// bucket. Forget and prune aren't run against it, the retention of its plans is applied through the retention repo.
+type AppendOnly struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// optional, ID of a trusted repo accessing the same restic repo with permission to delete, e.g. a rest-server
+	// without --append-only, forget and prune of the append-only repo's plans run against it instead.
+	RetentionRepo string `protobuf:"bytes,2,opt,name=retention_repo,json=retentionRepo,proto3" json:"retention_repo,omitempty"`
+}
+
+func (x *AppendOnly) Reset() {
+	*x = AppendOnly{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_config_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppendOnly) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppendOnly) ProtoMessage() {}
+
+func (x *AppendOnly) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_config_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppendOnly.ProtoReflect.Descriptor instead.
+func (*AppendOnly) Descriptor() ([]byte, []int) {
+	return file_v1_config_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AppendOnly) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *AppendOnly) GetRetentionRepo() string {
+	if x != nil {
+		return x.RetentionRepo
+	}
+	return ""
+}
+
+// ColdStorage marks a repo whose pack data is on archive storage that must be restored, e.g. from S3 Glacier, before
+// it can be read. Operations reading pack data (prune, restores, restore drills, check --read-data) are refused
+// unless the repo was warmed up, backups and other operations only reading metadata are unaffected.
+type ColdStorage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// optional, shell command warming up the repo, it should request the restore of the repo's archived objects and
+	// exit once they are readable. It runs with the repo's env, BACKREST_REPO_ID and BACKREST_REPO_URI set.
+	// Repos without a warmup command can't run operations reading pack data.
+	WarmupCommand    string `protobuf:"bytes,2,opt,name=warmup_command,json=warmupCommand,proto3" json:"warmup_command,omitempty"`
+	WarmupValidHours int32  `protobuf:"varint,3,opt,name=warmup_valid_hours,json=warmupValidHours,proto3" json:"warmup_valid_hours,omitempty"` // how long restored objects stay readable after a warmup, defaults to 24.
+}
+
+func (x *ColdStorage) Reset() {
+	*x = ColdStorage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_config_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ColdStorage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ColdStorage) ProtoMessage() {}
+
+func (x *ColdStorage) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_config_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ColdStorage.ProtoReflect.Descriptor instead.
+func (*ColdStorage) Descriptor() ([]byte, []int) {
+	return file_v1_config_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ColdStorage) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *ColdStorage) GetWarmupCommand() string {
+	if x != nil {
+		return x.WarmupCommand
+	}
+	return ""
+}
+
+func (x *ColdStorage) GetWarmupValidHours() int32 {
+	if x != nil {
+		return x.WarmupValidHours
+	}
+	return 0
+}
+
+// BackendOptions configures a repo's storage backend, translated to the environment variables and extended options
+// (restic -o) restic reads. The backend must match the scheme of the repo's uri.
+type BackendOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Backend:
+	//
+	//	*BackendOptions_S3_
+	//	*BackendOptions_B2_
+	//	*BackendOptions_Azure_
+	Backend     isBackendOptions_Backend `protobuf_oneof:"backend"`
+	Connections int32                    `protobuf:"varint,4,opt,name=connections,proto3" json:"connections,omitempty"` // optional, limit of concurrent connections to the backend, restic's default if 0.
+}
+
+func (x *BackendOptions) Reset() {
+	*x = BackendOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_config_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackendOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackendOptions) ProtoMessage() {}
+
+func (x *BackendOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_config_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackendOptions.ProtoReflect.Descriptor instead.
+func (*BackendOptions) Descriptor() ([]byte, []int) {
+	return file_v1_config_proto_rawDescGZIP(), []int{12}
+}
+
+func (m *BackendOptions) GetBackend() isBackendOptions_Backend {
+	if m != nil {
+		return m.Backend
+	}
+	return nil
+}
+
+func (x *BackendOptions) GetS3() *BackendOptions_S3 {
+	if x, ok := x.GetBackend().(*BackendOptions_S3_); ok {
+		return x.S3
+	}
+	return nil
+}
+
+func (x *BackendOptions) GetB2() *BackendOptions_B2 {
+	if x, ok := x.GetBackend().(*BackendOptions_B2_); ok {
+		return x.B2
+	}
+	return nil
+}
+
+func (x *BackendOptions) GetAzure() *BackendOptions_Azure {
+	if x, ok := x.GetBackend().(*BackendOptions_Azure_); ok {
+		return x.Azure
+	}
+	return nil
+}
+
+func (x *BackendOptions) GetConnections() int32 {
+	if x != nil {
+		return x.Connections
+	}
+	return 0
+}
+
+type isBackendOptions_Backend interface {
+	isBackendOptions_Backend()
+}
+
+type BackendOptions_S3_ struct {
+	S3 *BackendOptions_S3 `protobuf:"bytes,1,opt,name=s3,proto3,oneof"`
+}
+
+type BackendOptions_B2_ struct {
+	B2 *BackendOptions_B2 `protobuf:"bytes,2,opt,name=b2,proto3,oneof"`
+}
+
+type BackendOptions_Azure_ struct {
+	Azure *BackendOptions_Azure `protobuf:"bytes,3,opt,name=azure,proto3,oneof"`
+}
+
+func (*BackendOptions_S3_) isBackendOptions_Backend() {}
+
+func (*BackendOptions_B2_) isBackendOptions_Backend() {}
+
+func (*BackendOptions_Azure_) isBackendOptions_Backend() {}
+
+// CachePolicy schedules cleanups of the restic cache used by a repo.
+type CachePolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cron       string `protobuf:"bytes,1,opt,name=cron,proto3" json:"cron,omitempty"`                                  // cron expression describing the cleanup schedule.
+	MaxAgeDays int32  `protobuf:"varint,2,opt,name=max_age_days,json=maxAgeDays,proto3" json:"max_age_days,omitempty"` // cache directories of repos unused for longer are removed (restic cache --cleanup --max-age), defaults to 30.
+	MaxSizeMb  int64  `protobuf:"varint,3,opt,name=max_size_mb,json=maxSizeMb,proto3" json:"max_size_mb,omitempty"`    // optional, the repo's cache is cleared when it grows larger, restic rebuilds it as needed.
+}
+
+func (x *CachePolicy) Reset() {
+	*x = CachePolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_config_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CachePolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CachePolicy) ProtoMessage() {}
+
+func (x *CachePolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_config_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CachePolicy.ProtoReflect.Descriptor instead.
+func (*CachePolicy) Descriptor() ([]byte, []int) {
+	return file_v1_config_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CachePolicy) GetCron() string {
+	if x != nil {
+		return x.Cron
+	}
+	return ""
+}
+
+func (x *CachePolicy) GetMaxAgeDays() int32 {
+	if x != nil {
+		return x.MaxAgeDays
+	}
+	return 0
+}
+
+func (x *CachePolicy) GetMaxSizeMb() int64 {
+	if x != nil {
+		return x.MaxSizeMb
+	}
+	return 0
+}
+
+// DiskSpace sets the free space checked for before backups, prunes and restores.
+type DiskSpace struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MinFreeMb  int64 `protobuf:"varint,1,opt,name=min_free_mb,json=minFreeMb,proto3" json:"min_free_mb,omitempty"`    // tasks are refused when a path they write to has less free space, 0 to not check.
+	WarnFreeMb int64 `protobuf:"varint,2,opt,name=warn_free_mb,json=warnFreeMb,proto3" json:"warn_free_mb,omitempty"` // tasks record a warning and run the CONDITION_LOW_DISK_SPACE hooks below this, 0 to not warn.
+}
+
+func (x *DiskSpace) Reset() {
+	*x = DiskSpace{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_config_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiskSpace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiskSpace) ProtoMessage() {}
+
+func (x *DiskSpace) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_config_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiskSpace.ProtoReflect.Descriptor instead.
+func (*DiskSpace) Descriptor() ([]byte, []int) {
+	return file_v1_config_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DiskSpace) GetMinFreeMb() int64 {
+	if x != nil {
+		return x.MinFreeMb
+	}
+	return 0
+}
+
+func (x *DiskSpace) GetWarnFreeMb() int64 {
+	if x != nil {
+		return x.WarnFreeMb
+	}
+	return 0
+}
+
+// ResticFlag is a restic command line flag, only flags on backrest's allowlist are accepted.
+type ResticFlag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`   // e.g. "--ignore-inode".
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"` // the flag's value, empty for flags that don't take one.
+}
+
+func (x *ResticFlag) Reset() {
+	*x = ResticFlag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_config_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResticFlag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResticFlag) ProtoMessage() {}
+
+func (x *ResticFlag) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_config_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResticFlag.ProtoReflect.Descriptor instead.
+func (*ResticFlag) Descriptor() ([]byte, []int) {
+	return file_v1_config_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ResticFlag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ResticFlag) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type Plan struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id                    string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                                                        // unique but human readable ID for this plan.
+	Repo                  string            `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`                                                                    // ID of the repo to use.
+	Paths                 []string          `protobuf:"bytes,4,rep,name=paths,proto3" json:"paths,omitempty"`                                                                  // paths to include in the backup.
+	Excludes              []string          `protobuf:"bytes,5,rep,name=excludes,proto3" json:"excludes,omitempty"`                                                            // glob patterns to exclude.
+	Iexcludes             []string          `protobuf:"bytes,9,rep,name=iexcludes,proto3" json:"iexcludes,omitempty"`                                                          // case insensitive glob patterns to exclude.
+	Cron                  string            `protobuf:"bytes,6,opt,name=cron,proto3" json:"cron,omitempty"`                                                                    // cron expression describing the backup schedule.
+	Retention             *RetentionPolicy  `protobuf:"bytes,7,opt,name=retention,proto3" json:"retention,omitempty"`                                                          // retention policy for snapshots.
+	Hooks                 []*Hook           `protobuf:"bytes,8,rep,name=hooks,proto3" json:"hooks,omitempty"`                                                                  // hooks to run on events for this plan.
+	Host                  string            `protobuf:"bytes,10,opt,name=host,proto3" json:"host,omitempty"`                                                                   // optional, hostname recorded on snapshots (restic --host). When set snapshot listing and retention only consider snapshots from this host.
+	GroupBy               []string          `protobuf:"bytes,11,rep,name=group_by,json=groupBy,proto3" json:"group_by,omitempty"`                                              // optional, how snapshots are grouped when applying retention, any of "host", "paths", "tags". Defaults to "tags".
+	Timeouts              *TaskTimeouts     `protobuf:"bytes,12,opt,name=timeouts,proto3" json:"timeouts,omitempty"`                                                           // optional, limits on how long the plan's tasks may run.
+	Preconditions         *Preconditions    `protobuf:"bytes,13,opt,name=preconditions,proto3" json:"preconditions,omitempty"`                                                 // optional, conditions that must hold for scheduled backups to run.
+	ResourceLimits        *ResourceLimits   `protobuf:"bytes,14,opt,name=resource_limits,json=resourceLimits,proto3" json:"resource_limits,omitempty"`                         // optional, CPU, IO and memory limits applied to the plan's restic processes.
+	RestoreDrill          *RestoreDrill     `protobuf:"bytes,15,opt,name=restore_drill,json=restoreDrill,proto3" json:"restore_drill,omitempty"`                               // optional, scheduled test restores of the plan's latest snapshot.
+	ExtraFlags            []*ResticFlag     `protobuf:"bytes,16,rep,name=extra_flags,json=extraFlags,proto3" json:"extra_flags,omitempty"`                                     // optional, allowlisted global or backup restic flags set on the plan's backups.
+	Env                   []string          `protobuf:"bytes,17,rep,name=env,proto3" json:"env,omitempty"`                                                                     // optional, extra environment variables KEY=VALUE set for the plan's restic processes.
+	OneFileSystem         bool              `protobuf:"varint,18,opt,name=one_file_system,json=oneFileSystem,proto3" json:"one_file_system,omitempty"`                         // don't cross file system boundaries beneath any of the paths (restic --one-file-system).
+	PathOptions           []*PathOptions    `protobuf:"bytes,19,rep,name=path_options,json=pathOptions,proto3" json:"path_options,omitempty"`                                  // optional, mountpoint handling for individual paths.
+	Dependencies          *PlanDependencies `protobuf:"bytes,20,opt,name=dependencies,proto3" json:"dependencies,omitempty"`                                                   // optional, other plans this plan's backups follow or require.
+	ScheduleJitterMinutes int32             `protobuf:"varint,21,opt,name=schedule_jitter_minutes,json=scheduleJitterMinutes,proto3" json:"schedule_jitter_minutes,omitempty"` // optional, scheduled backups and restore drills start up to this many minutes after their cron time. The delay is fixed per plan so plans sharing a schedule are spread out.
+	VerifySampleFiles     int32             `protobuf:"varint,22,opt,name=verify_sample_files,json=verifySampleFiles,proto3" json:"verify_sample_files,omitempty"`             // optional, after each backup this many random files of the new snapshot are compared with the source files to catch read errors and bit rot, 0 to disable.
+	AnomalyDetection      *AnomalyDetection `protobuf:"bytes,23,opt,name=anomaly_detection,json=anomalyDetection,proto3" json:"anomaly_detection,omitempty"`                   // optional, flag backups deviating from the plan's previous backups.
+	// optional, IANA time zone e.g. "Europe/Berlin" the plan's cron and restore drill schedules are evaluated in,
+	// defaults to the server's local time zone. Times skipped by a daylight saving change run at the end of the gap,
+	// times repeated by one only run at their first occurrence.
+	Timezone       string          `protobuf:"bytes,24,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	WebhookTrigger *WebhookTrigger `protobuf:"bytes,25,opt,name=webhook_trigger,json=webhookTrigger,proto3" json:"webhook_trigger,omitempty"` // optional, lets external systems trigger the plan's backups.
+	// optional, further repos each backup is also made to e.g. an offsite repo for a 3-2-1 setup. Their backups run one
+	// after another following the backup to repo and join its run, retention is applied in each repo. Restore drills
+	// only read from repo.
+	AdditionalRepos []string `protobuf:"bytes,26,rep,name=additional_repos,json=additionalRepos,proto3" json:"additional_repos,omitempty"`
+}
+
+func (x *Plan) Reset() {
+	*x = Plan{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_config_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Plan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Plan) ProtoMessage() {}
+
+func (x *Plan) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_config_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Plan.ProtoReflect.Descriptor instead.
+func (*Plan) Descriptor() ([]byte, []int) {
+	return file_v1_config_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Plan) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Plan) GetRepo() string {
+	if x != nil {
+		return x.Repo
+	}
+	return ""
+}
+
+func (x *Plan) GetPaths() []string {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+func (x *Plan) GetExcludes() []string {
+	if x != nil {
+		return x.Excludes
+	}
+	return nil
+}
+
+func (x *Plan) GetIexcludes() []string {
+	if x != nil {
+		return x.Iexcludes
+	}
+	return nil
+}
+
+func (x *Plan) GetCron() string {
+	if x != nil {
+		return x.Cron
+	}
+	return ""
+}
+
+func (x *Plan) GetRetention() *RetentionPolicy {
+	if x != nil {
+		return x.Retention
+	}
+	return nil
+}
+
+func (x *Plan) GetHooks() []*Hook {
+	if x != nil {
+		return x.Hooks
+	}
+	return nil
+}
+
+func (x *Plan) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *Plan) GetGroupBy() []string {
+	if x != nil {
+		return x.GroupBy
+	}
+	return nil
+}
+
+func (x *Plan) GetTimeouts() *TaskTimeouts {
+	if x != nil {
+		return x.Timeouts
+	}
+	return nil
+}
+
+func (x *Plan) GetPreconditions() *Preconditions {
+	if x != nil {
+		return x.Preconditions
+	}
+	return nil
+}
+
+func (x *Plan) GetResourceLimits() *ResourceLimits {
+	if x != nil {
+		return x.ResourceLimits
+	}
+	return nil
+}
+
+func (x *Plan) GetRestoreDrill() *RestoreDrill {
+	if x != nil {
+		return x.RestoreDrill
+	}
+	return nil
+}
+
+func (x *Plan) GetExtraFlags() []*ResticFlag {
+	if x != nil {
+		return x.ExtraFlags
+	}
+	return nil
+}
+
+func (x *Plan) GetEnv() []string {
+	if x != nil {
+		return x.Env
+	}
+	return nil
+}
+
+func (x *Plan) GetOneFileSystem() bool {
+	if x != nil {
+		return x.OneFileSystem
+	}
+	return false
+}
+
+func (x *Plan) GetPathOptions() []*PathOptions {
+	if x != nil {
+		return x.PathOptions
+	}
+	return nil
+}
+
+func (x *Plan) GetDependencies() *PlanDependencies {
+	if x != nil {
+		return x.Dependencies
+	}
+	return nil
+}
+
+func (x *Plan) GetScheduleJitterMinutes() int32 {
+	if x != nil {
+		return x.ScheduleJitterMinutes
+	}
+	return 0
+}
+
+func (x *Plan) GetVerifySampleFiles() int32 {
+	if x != nil {
+		return x.VerifySampleFiles
+	}
+	return 0
+}
+
+func (x *Plan) GetAnomalyDetection() *AnomalyDetection {
+	if x != nil {
+		return x.AnomalyDetection
+	}
+	return nil
+}
+
+func (x *Plan) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *Plan) GetWebhookTrigger() *WebhookTrigger {
+	if x != nil {
+		return x.WebhookTrigger
+	}
+	return nil
+}
+
+func (x *Plan) GetAdditionalRepos() []string {
+	if x != nil {
+		return x.AdditionalRepos
+	}
+	return nil
+}
+
+// WebhookTrigger lets external systems e.g. CI pipelines or home automation trigger a plan's backup by POSTing to
+// /api/trigger/<plan id> with the token as a bearer token or the token query parameter.
+type WebhookTrigger struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"` // at least 16 characters, requests without it are rejected.
+}
+
+func (x *WebhookTrigger) Reset() {
+	*x = WebhookTrigger{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_config_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebhookTrigger) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookTrigger) ProtoMessage() {}
+
+func (x *WebhookTrigger) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_config_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookTrigger.ProtoReflect.Descriptor instead.
+func (*WebhookTrigger) Descriptor() ([]byte, []int) {
+	return file_v1_config_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *WebhookTrigger) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// AnomalyDetection compares each backup with the baseline of the plan's previous backups, backups adding far more
+// data or changing most existing files, as ransomware encrypting files does, are flagged as anomalous and run the
+// CONDITION_BACKUP_ANOMALY hooks.
+type AnomalyDetection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled             bool    `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	DataAddedFactor     float64 `protobuf:"fixed64,2,opt,name=data_added_factor,json=dataAddedFactor,proto3" json:"data_added_factor,omitempty"`            // backups adding this many times the baseline's data are anomalous, defaults to 100.
+	ChangedFilesPercent int32   `protobuf:"varint,3,opt,name=changed_files_percent,json=changedFilesPercent,proto3" json:"changed_files_percent,omitempty"` // backups changing this percentage of existing files are anomalous unless the baseline does too, defaults to 90.
+	BaselineBackups     int32   `protobuf:"varint,4,opt,name=baseline_backups,json=baselineBackups,proto3" json:"baseline_backups,omitempty"`               // number of previous backups the baseline is the median of, defaults to 10. At least 3 are required.
+	MinDataAddedBytes   int64   `protobuf:"varint,5,opt,name=min_data_added_bytes,json=minDataAddedBytes,proto3" json:"min_data_added_bytes,omitempty"`     // backups adding less data are never anomalous for the data they add, defaults to 100 MB.
+}
+
+func (x *AnomalyDetection) Reset() {
+	*x = AnomalyDetection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_config_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AnomalyDetection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnomalyDetection) ProtoMessage() {}
+
+func (x *AnomalyDetection) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_config_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnomalyDetection.ProtoReflect.Descriptor instead.
+func (*AnomalyDetection) Descriptor() ([]byte, []int) {
+	return file_v1_config_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *AnomalyDetection) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *AnomalyDetection) GetDataAddedFactor() float64 {
+	if x != nil {
+		return x.DataAddedFactor
+	}
+	return 0
+}
+
+func (x *AnomalyDetection) GetChangedFilesPercent() int32 {
+	if x != nil {
+		return x.ChangedFilesPercent
+	}
+	return 0
+}
+
+func (x *AnomalyDetection) GetBaselineBackups() int32 {
+	if x != nil {
+		return x.BaselineBackups
+	}
+	return 0
+}
+
+func (x *AnomalyDetection) GetMinDataAddedBytes() int64 {
+	if x != nil {
+		return x.MinDataAddedBytes
+	}
+	return 0
+}
+
+// PlanDependencies chain a plan's backups to other plans, e.g. copying to an offsite repo after a local backup.
+type PlanDependencies struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	After    []string `protobuf:"bytes,1,rep,name=after,proto3" json:"after,omitempty"`       // plans whose successful backups are each followed by a backup of this plan, the plan's cron may be left empty to only back up after them.
+	Requires []string `protobuf:"bytes,2,rep,name=requires,proto3" json:"requires,omitempty"` // plans whose latest backup must have succeeded for this plan's scheduled backups and restore drills to run.
+}
+
+func (x *PlanDependencies) Reset() {
+	*x = PlanDependencies{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_config_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlanDependencies) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanDependencies) ProtoMessage() {}
+
+func (x *PlanDependencies) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_config_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanDependencies.ProtoReflect.Descriptor instead.
+func (*PlanDependencies) Descriptor() ([]byte, []int) {
+	return file_v1_config_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PlanDependencies) GetAfter() []string {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+func (x *PlanDependencies) GetRequires() []string {
+	if x != nil {
+		return x.Requires
+	}
+	return nil
+}
+
+// PathOptions controls how file systems mounted beneath one of a plan's paths are backed up (linux only).
+type PathOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path          string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`                                           // one of the plan's paths.
+	OneFileSystem bool     `protobuf:"varint,2,opt,name=one_file_system,json=oneFileSystem,proto3" json:"one_file_system,omitempty"` // exclude the file systems mounted beneath the path.
+	IncludeMounts []string `protobuf:"bytes,3,rep,name=include_mounts,json=includeMounts,proto3" json:"include_mounts,omitempty"`    // mountpoints beneath the path that are backed up even when not crossing file systems.
+}
+
+func (x *PathOptions) Reset() {
+	*x = PathOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_config_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PathOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PathOptions) ProtoMessage() {}
+
+func (x *PathOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_config_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PathOptions.ProtoReflect.Descriptor instead.
+func (*PathOptions) Descriptor() ([]byte, []int) {
+	return file_v1_config_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *PathOptions) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *PathOptions) GetOneFileSystem() bool {
+	if x != nil {
+		return x.OneFileSystem
+	}
+	return false
+}
+
+func (x *PathOptions) GetIncludeMounts() []string {
+	if x != nil {
+		return x.IncludeMounts
+	}
+	return nil
+}
+
+// RestoreDrill periodically restores part of a plan's latest snapshot to a temporary directory and verifies it.
+type RestoreDrill struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cron        string `protobuf:"bytes,1,opt,name=cron,proto3" json:"cron,omitempty"`                                   // cron expression describing the drill schedule.
+	SampleFiles int32  `protobuf:"varint,2,opt,name=sample_files,json=sampleFiles,proto3" json:"sample_files,omitempty"` // number of randomly chosen files to restore, defaults to 10.
+	Path        string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`                                   // optional, restore this path of the snapshot instead of a random sample.
+}
+
+func (x *RestoreDrill) Reset() {
+	*x = RestoreDrill{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_config_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreDrill) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreDrill) ProtoMessage() {}
+
+func (x *RestoreDrill) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_config_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreDrill.ProtoReflect.Descriptor instead.
+func (*RestoreDrill) Descriptor() ([]byte, []int) {
+	return file_v1_config_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RestoreDrill) GetCron() string {
+	if x != nil {
+		return x.Cron
+	}
+	return ""
+}
+
+func (x *RestoreDrill) GetSampleFiles() int32 {
+	if x != nil {
+		return x.SampleFiles
+	}
+	return 0
+}
+
+func (x *RestoreDrill) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type ResourceLimits struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Nice       int32                  `protobuf:"varint,1,opt,name=nice,proto3" json:"nice,omitempty"`                                                     // CPU scheduling priority from -20 (highest) to 19 (lowest), 0 leaves it unchanged. Negative values require root.
+	IoClass    ResourceLimits_IoClass `protobuf:"varint,2,opt,name=io_class,json=ioClass,proto3,enum=v1.ResourceLimits_IoClass" json:"io_class,omitempty"` // IO scheduling class (linux ionice).
+	IoPriority int32                  `protobuf:"varint,3,opt,name=io_priority,json=ioPriority,proto3" json:"io_priority,omitempty"`                       // IO priority within the best effort or realtime class from 0 (highest) to 7 (lowest).
+	Gomaxprocs int32                  `protobuf:"varint,4,opt,name=gomaxprocs,proto3" json:"gomaxprocs,omitempty"`                                         // max number of CPUs restic uses at once, 0 for no limit.
+	MemoryMax  string                 `protobuf:"bytes,5,opt,name=memory_max,json=memoryMax,proto3" json:"memory_max,omitempty"`                           // cgroup memory limit e.g. "2G" (linux with systemd).
+	IoWeight   int32                  `protobuf:"varint,6,opt,name=io_weight,json=ioWeight,proto3" json:"io_weight,omitempty"`                             // cgroup IO weight from 1 to 10000, the default weight is 100 (linux with systemd).
+}
+
+func (x *ResourceLimits) Reset() {
+	*x = ResourceLimits{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_config_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResourceLimits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResourceLimits) ProtoMessage() {}
+
+func (x *ResourceLimits) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_config_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResourceLimits.ProtoReflect.Descriptor instead.
+func (*ResourceLimits) Descriptor() ([]byte, []int) {
+	return file_v1_config_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ResourceLimits) GetNice() int32 {
+	if x != nil {
+		return x.Nice
+	}
+	return 0
+}
+
+func (x *ResourceLimits) GetIoClass() ResourceLimits_IoClass {
+	if x != nil {
+		return x.IoClass
+	}
+	return ResourceLimits_IO_CLASS_UNCHANGED
+}
+
+func (x *ResourceLimits) GetIoPriority() int32 {
+	if x != nil {
+		return x.IoPriority
+	}
+	return 0
+}
+
+func (x *ResourceLimits) GetGomaxprocs() int32 {
+	if x != nil {
+		return x.Gomaxprocs
+	}
+	return 0
+}
+
+func (x *ResourceLimits) GetMemoryMax() string {
+	if x != nil {
+		return x.MemoryMax
+	}
+	return ""
+}
+
+func (x *ResourceLimits) GetIoWeight() int32 {
+	if x != nil {
+		return x.IoWeight
+	}
+	return 0
+}
+
+type Preconditions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OnAcPower        bool     `protobuf:"varint,1,opt,name=on_ac_power,json=onAcPower,proto3" json:"on_ac_power,omitempty"`                    // only run while the host is not running on battery.
+	UnmeteredNetwork bool     `protobuf:"varint,2,opt,name=unmetered_network,json=unmeteredNetwork,proto3" json:"unmetered_network,omitempty"` // only run while no active connection is metered (linux with NetworkManager).
+	WifiSsids        []string `protobuf:"bytes,3,rep,name=wifi_ssids,json=wifiSsids,proto3" json:"wifi_ssids,omitempty"`                       // only run while connected to one of these Wi-Fi networks.
+	ReachableHosts   []string `protobuf:"bytes,4,rep,name=reachable_hosts,json=reachableHosts,proto3" json:"reachable_hosts,omitempty"`        // only run while each of these host:port addresses accepts TCP connections, the port defaults to 443.
+	DeferMinutes     int32    `protobuf:"varint,5,opt,name=defer_minutes,json=deferMinutes,proto3" json:"defer_minutes,omitempty"`             // how long to wait before checking again when a precondition is unmet, defaults to 15.
+}
+
+func (x *Preconditions) Reset() {
+	*x = Preconditions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_config_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Preconditions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Preconditions) ProtoMessage() {}
+
+func (x *Preconditions) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_config_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Preconditions.ProtoReflect.Descriptor instead.
+func (*Preconditions) Descriptor() ([]byte, []int) {
+	return file_v1_config_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Preconditions) GetOnAcPower() bool {
+	if x != nil {
+		return x.OnAcPower
+	}
+	return false
+}
+
+func (x *Preconditions) GetUnmeteredNetwork() bool {
+	if x != nil {
+		return x.UnmeteredNetwork
+	}
+	return false
+}
+
+func (x *Preconditions) GetWifiSsids() []string {
+	if x != nil {
+		return x.WifiSsids
+	}
+	return nil
+}
+
+func (x *Preconditions) GetReachableHosts() []string {
+	if x != nil {
+		return x.ReachableHosts
+	}
+	return nil
+}
+
+func (x *Preconditions) GetDeferMinutes() int32 {
+	if x != nil {
+		return x.DeferMinutes
+	}
+	return 0
+}
+
+type TaskTimeouts struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BackupMinutes     int32        `protobuf:"varint,1,opt,name=backup_minutes,json=backupMinutes,proto3" json:"backup_minutes,omitempty"`               // backups running longer are interrupted and marked as timed out, 0 for no limit.
+	PruneMinutes      int32        `protobuf:"varint,2,opt,name=prune_minutes,json=pruneMinutes,proto3" json:"prune_minutes,omitempty"`                  // prunes running longer are interrupted and marked as timed out, 0 for no limit.
+	Retries           int32        `protobuf:"varint,3,opt,name=retries,proto3" json:"retries,omitempty"`                                                // number of times a timed out task is retried.
+	RetryDelayMinutes int32        `protobuf:"varint,4,opt,name=retry_delay_minutes,json=retryDelayMinutes,proto3" json:"retry_delay_minutes,omitempty"` // delay before retrying a timed out task.
+	RetryOn           []ErrorClass `protobuf:"varint,5,rep,packed,name=retry_on,json=retryOn,proto3,enum=v1.ErrorClass" json:"retry_on,omitempty"`       // optional, failures of these classes are retried like timeouts e.g. ERROR_CLASS_NETWORK.
+}
+
+func (x *TaskTimeouts) Reset() {
+	*x = TaskTimeouts{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_config_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TaskTimeouts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaskTimeouts) ProtoMessage() {}
+
+func (x *TaskTimeouts) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_config_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaskTimeouts.ProtoReflect.Descriptor instead.
+func (*TaskTimeouts) Descriptor() ([]byte, []int) {
+	return file_v1_config_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *TaskTimeouts) GetBackupMinutes() int32 {
+	if x != nil {
+		return x.BackupMinutes
+	}
+	return 0
+}
+
+func (x *TaskTimeouts) GetPruneMinutes() int32 {
+	if x != nil {
+		return x.PruneMinutes
+	}
+	return 0
+}
+
+func (x *TaskTimeouts) GetRetries() int32 {
+	if x != nil {
+		return x.Retries
+	}
+	return 0
+}
+
+func (x *TaskTimeouts) GetRetryDelayMinutes() int32 {
+	if x != nil {
+		return x.RetryDelayMinutes
+	}
+	return 0
+}
+
+func (x *TaskTimeouts) GetRetryOn() []ErrorClass {
+	if x != nil {
+		return x.RetryOn
+	}
+	return nil
+}
+
+type RetentionPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Deprecated: Marked as deprecated in v1/config.proto.
+	MaxUnusedLimit string `protobuf:"bytes,1,opt,name=max_unused_limit,json=maxUnusedLimit,proto3" json:"max_unused_limit,omitempty"`
+	// Deprecated: Marked as deprecated in v1/config.proto.
+	KeepLastN int32 `protobuf:"varint,2,opt,name=keep_last_n,json=keepLastN,proto3" json:"keep_last_n,omitempty"`
+	// Deprecated: Marked as deprecated in v1/config.proto.
+	KeepHourly int32 `protobuf:"varint,3,opt,name=keep_hourly,json=keepHourly,proto3" json:"keep_hourly,omitempty"`
+	// Deprecated: Marked as deprecated in v1/config.proto.
+	KeepDaily int32 `protobuf:"varint,4,opt,name=keep_daily,json=keepDaily,proto3" json:"keep_daily,omitempty"`
+	// Deprecated: Marked as deprecated in v1/config.proto.
+	KeepWeekly int32 `protobuf:"varint,5,opt,name=keep_weekly,json=keepWeekly,proto3" json:"keep_weekly,omitempty"`
+	// Deprecated: Marked as deprecated in v1/config.proto.
+	KeepMonthly int32 `protobuf:"varint,6,opt,name=keep_monthly,json=keepMonthly,proto3" json:"keep_monthly,omitempty"`
+	// Deprecated: Marked as deprecated in v1/config.proto.
+	KeepYearly int32 `protobuf:"varint,7,opt,name=keep_yearly,json=keepYearly,proto3" json:"keep_yearly,omitempty"`
+	// Deprecated: Marked as deprecated in v1/config.proto.
+	KeepWithinDuration string `protobuf:"bytes,8,opt,name=keep_within_duration,json=keepWithinDuration,proto3" json:"keep_within_duration,omitempty"` // keep snapshots within a duration e.g. 1y2m3d4h5m6s
+	// Types that are assignable to Policy:
+	//
+	//	*RetentionPolicy_PolicyKeepLastN
+	//	*RetentionPolicy_PolicyTimeBucketed
+	//	*RetentionPolicy_PolicyKeepAll
+	Policy isRetentionPolicy_Policy `protobuf_oneof:"policy"`
+}
+
+func (x *RetentionPolicy) Reset() {
+	*x = RetentionPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_config_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetentionPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetentionPolicy) ProtoMessage() {}
+
+func (x *RetentionPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_config_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetentionPolicy.ProtoReflect.Descriptor instead.
+func (*RetentionPolicy) Descriptor() ([]byte, []int) {
+	return file_v1_config_proto_rawDescGZIP(), []int{25}
+}
+
+// Deprecated: Marked as deprecated in v1/config.proto.
+func (x *RetentionPolicy) GetMaxUnusedLimit() string {
+	if x != nil {
+		return x.MaxUnusedLimit
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in v1/config.proto.
+func (x *RetentionPolicy) GetKeepLastN() int32 {
+	if x != nil {
+		return x.KeepLastN
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in v1/config.proto.
+func (x *RetentionPolicy) GetKeepHourly() int32 {
+	if x != nil {
+		return x.KeepHourly
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in v1/config.proto.
+func (x *RetentionPolicy) GetKeepDaily() int32 {
+	if x != nil {
+		return x.KeepDaily
+	}
+	return 0
 }
 
 // Deprecated: Marked as deprecated in v1/config.proto.
 func (x *RetentionPolicy) GetKeepWeekly() int32 {
 	if x != nil {
-		return x.KeepWeekly
+		return x.KeepWeekly
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in v1/config.proto.
+func (x *RetentionPolicy) GetKeepMonthly() int32 {
+	if x != nil {
+		return x.KeepMonthly
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in v1/config.proto.
+func (x *RetentionPolicy) GetKeepYearly() int32 {
+	if x != nil {
+		return x.KeepYearly
+	}
+	return 0
+}
+
+// Deprecated: Marked as deprecated in v1/config.proto.
+func (x *RetentionPolicy) GetKeepWithinDuration() string {
+	if x != nil {
+		return x.KeepWithinDuration
+	}
+	return ""
+}
+
+func (m *RetentionPolicy) GetPolicy() isRetentionPolicy_Policy {
+	if m != nil {
+		return m.Policy
+	}
+	return nil
+}
+
+func (x *RetentionPolicy) GetPolicyKeepLastN() int32 {
+	if x, ok := x.GetPolicy().(*RetentionPolicy_PolicyKeepLastN); ok {
+		return x.PolicyKeepLastN
+	}
+	return 0
+}
+
+func (x *RetentionPolicy) GetPolicyTimeBucketed() *RetentionPolicy_TimeBucketedCounts {
+	if x, ok := x.GetPolicy().(*RetentionPolicy_PolicyTimeBucketed); ok {
+		return x.PolicyTimeBucketed
+	}
+	return nil
+}
+
+func (x *RetentionPolicy) GetPolicyKeepAll() bool {
+	if x, ok := x.GetPolicy().(*RetentionPolicy_PolicyKeepAll); ok {
+		return x.PolicyKeepAll
+	}
+	return false
+}
+
+type isRetentionPolicy_Policy interface {
+	isRetentionPolicy_Policy()
+}
+
+type RetentionPolicy_PolicyKeepLastN struct {
+	PolicyKeepLastN int32 `protobuf:"varint,10,opt,name=policy_keep_last_n,json=policyKeepLastN,proto3,oneof"`
+}
+
+type RetentionPolicy_PolicyTimeBucketed struct {
+	PolicyTimeBucketed *RetentionPolicy_TimeBucketedCounts `protobuf:"bytes,11,opt,name=policy_time_bucketed,json=policyTimeBucketed,proto3,oneof"`
+}
+
+type RetentionPolicy_PolicyKeepAll struct {
+	PolicyKeepAll bool `protobuf:"varint,12,opt,name=policy_keep_all,json=policyKeepAll,proto3,oneof"`
+}
+
+func (*RetentionPolicy_PolicyKeepLastN) isRetentionPolicy_Policy() {}
+
+func (*RetentionPolicy_PolicyTimeBucketed) isRetentionPolicy_Policy() {}
+
+func (*RetentionPolicy_PolicyKeepAll) isRetentionPolicy_Policy() {}
+
+type PrunePolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MaxFrequencyDays    int32 `protobuf:"varint,1,opt,name=max_frequency_days,json=maxFrequencyDays,proto3" json:"max_frequency_days,omitempty"`            // max frequency of prune runs in days. If 0, prune will be run on every backup.
+	MaxUnusedPercent    int32 `protobuf:"varint,100,opt,name=max_unused_percent,json=maxUnusedPercent,proto3" json:"max_unused_percent,omitempty"`          // max percentage of repo size that can be unused before prune is run.
+	MaxUnusedBytes      int32 `protobuf:"varint,101,opt,name=max_unused_bytes,json=maxUnusedBytes,proto3" json:"max_unused_bytes,omitempty"`                // max number of bytes that can be unused before prune is run.
+	MinReclaimableBytes int64 `protobuf:"varint,102,opt,name=min_reclaimable_bytes,json=minReclaimableBytes,proto3" json:"min_reclaimable_bytes,omitempty"` // if set, the prune following a forget only runs if a dry run estimates it will reclaim at least this many bytes.
+}
+
+func (x *PrunePolicy) Reset() {
+	*x = PrunePolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_config_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrunePolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrunePolicy) ProtoMessage() {}
+
+func (x *PrunePolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_config_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrunePolicy.ProtoReflect.Descriptor instead.
+func (*PrunePolicy) Descriptor() ([]byte, []int) {
+	return file_v1_config_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *PrunePolicy) GetMaxFrequencyDays() int32 {
+	if x != nil {
+		return x.MaxFrequencyDays
+	}
+	return 0
+}
+
+func (x *PrunePolicy) GetMaxUnusedPercent() int32 {
+	if x != nil {
+		return x.MaxUnusedPercent
+	}
+	return 0
+}
+
+func (x *PrunePolicy) GetMaxUnusedBytes() int32 {
+	if x != nil {
+		return x.MaxUnusedBytes
+	}
+	return 0
+}
+
+func (x *PrunePolicy) GetMinReclaimableBytes() int64 {
+	if x != nil {
+		return x.MinReclaimableBytes
+	}
+	return 0
+}
+
+type Hook struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Conditions     []Hook_Condition `protobuf:"varint,1,rep,packed,name=conditions,proto3,enum=v1.Hook_Condition" json:"conditions,omitempty"`
+	TimeoutSeconds int32            `protobuf:"varint,2,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"` // the hook is stopped and fails after this long, defaults to 10 minutes.
+	// optional expression over the hook's variables, the hook only runs if it is true e.g. `error contains "timeout" && plan == "media"`.
+	ConditionExpr string `protobuf:"bytes,3,opt,name=condition_expr,json=conditionExpr,proto3" json:"condition_expr,omitempty"`
+	// Types that are assignable to Action:
+	//
+	//	*Hook_ActionCommand
+	//	*Hook_ActionWebhook
+	//	*Hook_ActionDiscord
+	//	*Hook_ActionGotify
+	//	*Hook_ActionSlack
+	//	*Hook_ActionPlugin
+	Action isHook_Action `protobuf_oneof:"action"`
+}
+
+func (x *Hook) Reset() {
+	*x = Hook{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_config_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Hook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Hook) ProtoMessage() {}
+
+func (x *Hook) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_config_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Hook.ProtoReflect.Descriptor instead.
+func (*Hook) Descriptor() ([]byte, []int) {
+	return file_v1_config_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *Hook) GetConditions() []Hook_Condition {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+func (x *Hook) GetTimeoutSeconds() int32 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+func (x *Hook) GetConditionExpr() string {
+	if x != nil {
+		return x.ConditionExpr
+	}
+	return ""
+}
+
+func (m *Hook) GetAction() isHook_Action {
+	if m != nil {
+		return m.Action
+	}
+	return nil
+}
+
+func (x *Hook) GetActionCommand() *Hook_Command {
+	if x, ok := x.GetAction().(*Hook_ActionCommand); ok {
+		return x.ActionCommand
+	}
+	return nil
+}
+
+func (x *Hook) GetActionWebhook() *Hook_Webhook {
+	if x, ok := x.GetAction().(*Hook_ActionWebhook); ok {
+		return x.ActionWebhook
+	}
+	return nil
+}
+
+func (x *Hook) GetActionDiscord() *Hook_Discord {
+	if x, ok := x.GetAction().(*Hook_ActionDiscord); ok {
+		return x.ActionDiscord
+	}
+	return nil
+}
+
+func (x *Hook) GetActionGotify() *Hook_Gotify {
+	if x, ok := x.GetAction().(*Hook_ActionGotify); ok {
+		return x.ActionGotify
+	}
+	return nil
+}
+
+func (x *Hook) GetActionSlack() *Hook_Slack {
+	if x, ok := x.GetAction().(*Hook_ActionSlack); ok {
+		return x.ActionSlack
+	}
+	return nil
+}
+
+func (x *Hook) GetActionPlugin() *Hook_Plugin {
+	if x, ok := x.GetAction().(*Hook_ActionPlugin); ok {
+		return x.ActionPlugin
+	}
+	return nil
+}
+
+type isHook_Action interface {
+	isHook_Action()
+}
+
+type Hook_ActionCommand struct {
+	ActionCommand *Hook_Command `protobuf:"bytes,100,opt,name=action_command,json=actionCommand,proto3,oneof"`
+}
+
+type Hook_ActionWebhook struct {
+	ActionWebhook *Hook_Webhook `protobuf:"bytes,101,opt,name=action_webhook,json=actionWebhook,proto3,oneof"`
+}
+
+type Hook_ActionDiscord struct {
+	ActionDiscord *Hook_Discord `protobuf:"bytes,102,opt,name=action_discord,json=actionDiscord,proto3,oneof"`
+}
+
+type Hook_ActionGotify struct {
+	ActionGotify *Hook_Gotify `protobuf:"bytes,103,opt,name=action_gotify,json=actionGotify,proto3,oneof"`
+}
+
+type Hook_ActionSlack struct {
+	ActionSlack *Hook_Slack `protobuf:"bytes,104,opt,name=action_slack,json=actionSlack,proto3,oneof"`
+}
+
+type Hook_ActionPlugin struct {
+	ActionPlugin *Hook_Plugin `protobuf:"bytes,105,opt,name=action_plugin,json=actionPlugin,proto3,oneof"`
+}
+
+func (*Hook_ActionCommand) isHook_Action() {}
+
+func (*Hook_ActionWebhook) isHook_Action() {}
+
+func (*Hook_ActionDiscord) isHook_Action() {}
+
+func (*Hook_ActionGotify) isHook_Action() {}
+
+func (*Hook_ActionSlack) isHook_Action() {}
+
+func (*Hook_ActionPlugin) isHook_Action() {}
+
+// SelfBackup configures periodic backups of backrest's config and operation log to one of the configured repos.
+type SelfBackup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Repo      string           `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`           // ID of the repo to store backrest's state in. Self backup is disabled if empty.
+	Cron      string           `protobuf:"bytes,2,opt,name=cron,proto3" json:"cron,omitempty"`           // cron expression describing the backup schedule, defaults to daily.
+	Retention *RetentionPolicy `protobuf:"bytes,3,opt,name=retention,proto3" json:"retention,omitempty"` // retention policy for state snapshots, defaults to keeping the last 30.
+}
+
+func (x *SelfBackup) Reset() {
+	*x = SelfBackup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_config_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SelfBackup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelfBackup) ProtoMessage() {}
+
+func (x *SelfBackup) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_config_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelfBackup.ProtoReflect.Descriptor instead.
+func (*SelfBackup) Descriptor() ([]byte, []int) {
+	return file_v1_config_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SelfBackup) GetRepo() string {
+	if x != nil {
+		return x.Repo
+	}
+	return ""
+}
+
+func (x *SelfBackup) GetCron() string {
+	if x != nil {
+		return x.Cron
+	}
+	return ""
+}
+
+func (x *SelfBackup) GetRetention() *RetentionPolicy {
+	if x != nil {
+		return x.Retention
+	}
+	return nil
+}
+
+// Tls configures TLS for the API and UI listener using either certificate files or ACME issued certificates.
+type Tls struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CertFile          string `protobuf:"bytes,1,opt,name=cert_file,json=certFile,proto3" json:"cert_file,omitempty"`                               // PEM encoded certificate chain, reloaded when the file changes.
+	KeyFile           string `protobuf:"bytes,2,opt,name=key_file,json=keyFile,proto3" json:"key_file,omitempty"`                                  // PEM encoded private key for cert_file.
+	Acme              *Acme  `protobuf:"bytes,3,opt,name=acme,proto3" json:"acme,omitempty"`                                                       // obtain certificates automatically, mutually exclusive with cert_file.
+	ClientCaFile      string `protobuf:"bytes,4,opt,name=client_ca_file,json=clientCaFile,proto3" json:"client_ca_file,omitempty"`                 // optional PEM encoded CAs to verify client certificates against, verified certificates authenticate as the user named by their common name.
+	RequireClientCert bool   `protobuf:"varint,5,opt,name=require_client_cert,json=requireClientCert,proto3" json:"require_client_cert,omitempty"` // reject connections without a certificate signed by client_ca_file.
+}
+
+func (x *Tls) Reset() {
+	*x = Tls{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_config_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Tls) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tls) ProtoMessage() {}
+
+func (x *Tls) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_config_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tls.ProtoReflect.Descriptor instead.
+func (*Tls) Descriptor() ([]byte, []int) {
+	return file_v1_config_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *Tls) GetCertFile() string {
+	if x != nil {
+		return x.CertFile
+	}
+	return ""
+}
+
+func (x *Tls) GetKeyFile() string {
+	if x != nil {
+		return x.KeyFile
+	}
+	return ""
+}
+
+func (x *Tls) GetAcme() *Acme {
+	if x != nil {
+		return x.Acme
+	}
+	return nil
+}
+
+func (x *Tls) GetClientCaFile() string {
+	if x != nil {
+		return x.ClientCaFile
+	}
+	return ""
+}
+
+func (x *Tls) GetRequireClientCert() bool {
+	if x != nil {
+		return x.RequireClientCert
+	}
+	return false
+}
+
+type Acme struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Domains              []string `protobuf:"bytes,1,rep,name=domains,proto3" json:"domains,omitempty"`                                                         // domains to request certificates for, required.
+	Email                string   `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`                                                             // optional contact email for the ACME account.
+	DirectoryUrl         string   `protobuf:"bytes,3,opt,name=directory_url,json=directoryUrl,proto3" json:"directory_url,omitempty"`                           // ACME directory, defaults to Let's Encrypt.
+	HttpChallengeAddress string   `protobuf:"bytes,4,opt,name=http_challenge_address,json=httpChallengeAddress,proto3" json:"http_challenge_address,omitempty"` // optional address e.g. ":80" to answer HTTP-01 challenges on, TLS-ALPN-01 challenges are always answered on the TLS listener.
+}
+
+func (x *Acme) Reset() {
+	*x = Acme{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_config_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Acme) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Acme) ProtoMessage() {}
+
+func (x *Acme) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_config_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Acme.ProtoReflect.Descriptor instead.
+func (*Acme) Descriptor() ([]byte, []int) {
+	return file_v1_config_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *Acme) GetDomains() []string {
+	if x != nil {
+		return x.Domains
+	}
+	return nil
+}
+
+func (x *Acme) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Acme) GetDirectoryUrl() string {
+	if x != nil {
+		return x.DirectoryUrl
+	}
+	return ""
+}
+
+func (x *Acme) GetHttpChallengeAddress() string {
+	if x != nil {
+		return x.HttpChallengeAddress
+	}
+	return ""
+}
+
+type Mqtt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Broker           string `protobuf:"bytes,1,opt,name=broker,proto3" json:"broker,omitempty"` // address of the broker e.g. tcp://localhost:1883. Publishing is disabled if empty.
+	Username         string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Password         string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	ClientId         string `protobuf:"bytes,4,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`                          // client id to connect with, defaults to backrest-<host>.
+	TopicPrefix      string `protobuf:"bytes,5,opt,name=topic_prefix,json=topicPrefix,proto3" json:"topic_prefix,omitempty"`                 // prefix for status topics, defaults to "backrest".
+	DiscoveryPrefix  string `protobuf:"bytes,6,opt,name=discovery_prefix,json=discoveryPrefix,proto3" json:"discovery_prefix,omitempty"`     // Home Assistant discovery prefix, defaults to "homeassistant".
+	DisableDiscovery bool   `protobuf:"varint,7,opt,name=disable_discovery,json=disableDiscovery,proto3" json:"disable_discovery,omitempty"` // do not publish Home Assistant discovery topics.
+}
+
+func (x *Mqtt) Reset() {
+	*x = Mqtt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_config_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Mqtt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Mqtt) ProtoMessage() {}
+
+func (x *Mqtt) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_config_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Mqtt.ProtoReflect.Descriptor instead.
+func (*Mqtt) Descriptor() ([]byte, []int) {
+	return file_v1_config_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *Mqtt) GetBroker() string {
+	if x != nil {
+		return x.Broker
+	}
+	return ""
+}
+
+func (x *Mqtt) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *Mqtt) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *Mqtt) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *Mqtt) GetTopicPrefix() string {
+	if x != nil {
+		return x.TopicPrefix
+	}
+	return ""
+}
+
+func (x *Mqtt) GetDiscoveryPrefix() string {
+	if x != nil {
+		return x.DiscoveryPrefix
+	}
+	return ""
+}
+
+func (x *Mqtt) GetDisableDiscovery() bool {
+	if x != nil {
+		return x.DisableDiscovery
+	}
+	return false
+}
+
+type Auth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Users            []*User            `protobuf:"bytes,2,rep,name=users,proto3" json:"users,omitempty"`                                               // users to allow access to the UI.
+	ProxyHeader      *ProxyHeaderAuth   `protobuf:"bytes,3,opt,name=proxy_header,json=proxyHeader,proto3" json:"proxy_header,omitempty"`                // optional, trust identities asserted by a reverse proxy.
+	Oidc             *OidcAuth          `protobuf:"bytes,4,opt,name=oidc,proto3" json:"oidc,omitempty"`                                                 // optional, allow login with an OpenID Connect provider.
+	IdentityMappings []*IdentityMapping `protobuf:"bytes,5,rep,name=identity_mappings,json=identityMappings,proto3" json:"identity_mappings,omitempty"` // maps external identities to users, identities that match no mapping log in as the user of the same name.
+}
+
+func (x *Auth) Reset() {
+	*x = Auth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_config_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Auth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Auth) ProtoMessage() {}
+
+func (x *Auth) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_config_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Auth.ProtoReflect.Descriptor instead.
+func (*Auth) Descriptor() ([]byte, []int) {
+	return file_v1_config_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *Auth) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *Auth) GetProxyHeader() *ProxyHeaderAuth {
+	if x != nil {
+		return x.ProxyHeader
+	}
+	return nil
+}
+
+func (x *Auth) GetOidc() *OidcAuth {
+	if x != nil {
+		return x.Oidc
+	}
+	return nil
+}
+
+func (x *Auth) GetIdentityMappings() []*IdentityMapping {
+	if x != nil {
+		return x.IdentityMappings
+	}
+	return nil
+}
+
+// ProxyHeaderAuth authenticates requests by headers set by an authenticating reverse proxy e.g. Authelia or authentik.
+type ProxyHeaderAuth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled        bool     `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	UserHeader     string   `protobuf:"bytes,2,opt,name=user_header,json=userHeader,proto3" json:"user_header,omitempty"`             // header holding the external username, defaults to Remote-User.
+	GroupsHeader   string   `protobuf:"bytes,3,opt,name=groups_header,json=groupsHeader,proto3" json:"groups_header,omitempty"`       // header holding comma separated groups, defaults to Remote-Groups.
+	TrustedProxies []string `protobuf:"bytes,4,rep,name=trusted_proxies,json=trustedProxies,proto3" json:"trusted_proxies,omitempty"` // IPs or CIDRs of proxies allowed to set the headers, required.
+}
+
+func (x *ProxyHeaderAuth) Reset() {
+	*x = ProxyHeaderAuth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_config_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProxyHeaderAuth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProxyHeaderAuth) ProtoMessage() {}
+
+func (x *ProxyHeaderAuth) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_config_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProxyHeaderAuth.ProtoReflect.Descriptor instead.
+func (*ProxyHeaderAuth) Descriptor() ([]byte, []int) {
+	return file_v1_config_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ProxyHeaderAuth) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *ProxyHeaderAuth) GetUserHeader() string {
+	if x != nil {
+		return x.UserHeader
+	}
+	return ""
+}
+
+func (x *ProxyHeaderAuth) GetGroupsHeader() string {
+	if x != nil {
+		return x.GroupsHeader
+	}
+	return ""
+}
+
+func (x *ProxyHeaderAuth) GetTrustedProxies() []string {
+	if x != nil {
+		return x.TrustedProxies
+	}
+	return nil
+}
+
+// OidcAuth configures login with an OpenID Connect provider using the authorization code flow.
+type OidcAuth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Issuer        string   `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"` // issuer URL, discovery is used to find the provider's endpoints. OIDC is disabled if empty.
+	ClientId      string   `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ClientSecret  string   `protobuf:"bytes,3,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	RedirectUrl   string   `protobuf:"bytes,4,opt,name=redirect_url,json=redirectUrl,proto3" json:"redirect_url,omitempty"`       // must point at /auth/oidc/callback on this instance e.g. https://backrest.example.com/auth/oidc/callback.
+	Scopes        []string `protobuf:"bytes,5,rep,name=scopes,proto3" json:"scopes,omitempty"`                                    // defaults to openid, profile, email and groups.
+	UsernameClaim string   `protobuf:"bytes,6,opt,name=username_claim,json=usernameClaim,proto3" json:"username_claim,omitempty"` // ID token claim holding the external username, defaults to preferred_username.
+	GroupsClaim   string   `protobuf:"bytes,7,opt,name=groups_claim,json=groupsClaim,proto3" json:"groups_claim,omitempty"`       // ID token claim holding the groups, defaults to groups.
+}
+
+func (x *OidcAuth) Reset() {
+	*x = OidcAuth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_config_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OidcAuth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OidcAuth) ProtoMessage() {}
+
+func (x *OidcAuth) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_config_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OidcAuth.ProtoReflect.Descriptor instead.
+func (*OidcAuth) Descriptor() ([]byte, []int) {
+	return file_v1_config_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *OidcAuth) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *OidcAuth) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *OidcAuth) GetClientSecret() string {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return ""
+}
+
+func (x *OidcAuth) GetRedirectUrl() string {
+	if x != nil {
+		return x.RedirectUrl
+	}
+	return ""
+}
+
+func (x *OidcAuth) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *OidcAuth) GetUsernameClaim() string {
+	if x != nil {
+		return x.UsernameClaim
+	}
+	return ""
+}
+
+func (x *OidcAuth) GetGroupsClaim() string {
+	if x != nil {
+		return x.GroupsClaim
+	}
+	return ""
+}
+
+// IdentityMapping maps an external user or group to a backrest user. The first matching mapping wins.
+type IdentityMapping struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Match:
+	//
+	//	*IdentityMapping_ExternalUser
+	//	*IdentityMapping_Group
+	Match isIdentityMapping_Match `protobuf_oneof:"match"`
+	User  string                  `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"` // name of the user to log in as.
+}
+
+func (x *IdentityMapping) Reset() {
+	*x = IdentityMapping{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_config_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IdentityMapping) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdentityMapping) ProtoMessage() {}
+
+func (x *IdentityMapping) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_config_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdentityMapping.ProtoReflect.Descriptor instead.
+func (*IdentityMapping) Descriptor() ([]byte, []int) {
+	return file_v1_config_proto_rawDescGZIP(), []int{35}
+}
+
+func (m *IdentityMapping) GetMatch() isIdentityMapping_Match {
+	if m != nil {
+		return m.Match
 	}
-	return 0
+	return nil
 }
 
-// Deprecated: Marked as deprecated in v1/config.proto.
-func (x *RetentionPolicy) GetKeepMonthly() int32 {
-	if x != nil {
-		return x.KeepMonthly
+func (x *IdentityMapping) GetExternalUser() string {
+	if x, ok := x.GetMatch().(*IdentityMapping_ExternalUser); ok {
+		return x.ExternalUser
 	}
-	return 0
+	return ""
 }
 
-// Deprecated: Marked as deprecated in v1/config.proto.
-func (x *RetentionPolicy) GetKeepYearly() int32 {
-	if x != nil {
-		return x.KeepYearly
+func (x *IdentityMapping) GetGroup() string {
+	if x, ok := x.GetMatch().(*IdentityMapping_Group); ok {
+		return x.Group
 	}
-	return 0
+	return ""
 }
 
-// Deprecated: Marked as deprecated in v1/config.proto.
-func (x *RetentionPolicy) GetKeepWithinDuration() string {
+func (x *IdentityMapping) GetUser() string {
 	if x != nil {
-		return x.KeepWithinDuration
+		return x.User
 	}
 	return ""
 }
 
-func (m *RetentionPolicy) GetPolicy() isRetentionPolicy_Policy {
-	if m != nil {
-		return m.Policy
-	}
-	return nil
+type isIdentityMapping_Match interface {
+	isIdentityMapping_Match()
 }
 
-func (x *RetentionPolicy) GetPolicyKeepLastN() int32 {
-	if x, ok := x.GetPolicy().(*RetentionPolicy_PolicyKeepLastN); ok {
-		return x.PolicyKeepLastN
-	}
-	return 0
+type IdentityMapping_ExternalUser struct {
+	ExternalUser string `protobuf:"bytes,1,opt,name=external_user,json=externalUser,proto3,oneof"`
 }
 
-func (x *RetentionPolicy) GetPolicyTimeBucketed() *RetentionPolicy_TimeBucketedCounts {
-	if x, ok := x.GetPolicy().(*RetentionPolicy_PolicyTimeBucketed); ok {
-		return x.PolicyTimeBucketed
+type IdentityMapping_Group struct {
+	Group string `protobuf:"bytes,2,opt,name=group,proto3,oneof"`
+}
+
+func (*IdentityMapping_ExternalUser) isIdentityMapping_Match() {}
+
+func (*IdentityMapping_Group) isIdentityMapping_Match() {}
+
+type User struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Types that are assignable to Password:
+	//
+	//	*User_PasswordBcrypt
+	Password     isUser_Password `protobuf_oneof:"password"`
+	SecondFactor *SecondFactor   `protobuf:"bytes,3,opt,name=second_factor,json=secondFactor,proto3" json:"second_factor,omitempty"` // optional, second factors enrolled for interactive login.
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_config_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *RetentionPolicy) GetPolicyKeepAll() bool {
-	if x, ok := x.GetPolicy().(*RetentionPolicy_PolicyKeepAll); ok {
-		return x.PolicyKeepAll
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_config_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-type isRetentionPolicy_Policy interface {
-	isRetentionPolicy_Policy()
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_v1_config_proto_rawDescGZIP(), []int{36}
 }
 
-type RetentionPolicy_PolicyKeepLastN struct {
-	PolicyKeepLastN int32 `protobuf:"varint,10,opt,name=policy_keep_last_n,json=policyKeepLastN,proto3,oneof"`
+func (x *User) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-type RetentionPolicy_PolicyTimeBucketed struct {
-	PolicyTimeBucketed *RetentionPolicy_TimeBucketedCounts `protobuf:"bytes,11,opt,name=policy_time_bucketed,json=policyTimeBucketed,proto3,oneof"`
+func (m *User) GetPassword() isUser_Password {
+	if m != nil {
+		return m.Password
+	}
+	return nil
 }
 
-type RetentionPolicy_PolicyKeepAll struct {
-	PolicyKeepAll bool `protobuf:"varint,12,opt,name=policy_keep_all,json=policyKeepAll,proto3,oneof"`
+func (x *User) GetPasswordBcrypt() string {
+	if x, ok := x.GetPassword().(*User_PasswordBcrypt); ok {
+		return x.PasswordBcrypt
+	}
+	return ""
 }
 
-func (*RetentionPolicy_PolicyKeepLastN) isRetentionPolicy_Policy() {}
+func (x *User) GetSecondFactor() *SecondFactor {
+	if x != nil {
+		return x.SecondFactor
+	}
+	return nil
+}
 
-func (*RetentionPolicy_PolicyTimeBucketed) isRetentionPolicy_Policy() {}
+type isUser_Password interface {
+	isUser_Password()
+}
 
-func (*RetentionPolicy_PolicyKeepAll) isRetentionPolicy_Policy() {}
+type User_PasswordBcrypt struct {
+	PasswordBcrypt string `protobuf:"bytes,2,opt,name=password_bcrypt,json=passwordBcrypt,proto3,oneof"`
+}
 
-type PrunePolicy struct {
+func (*User_PasswordBcrypt) isUser_Password() {}
+
+// SecondFactor holds the second factors a user has enrolled. Once any factor is enrolled it is required at login.
+type SecondFactor struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MaxFrequencyDays int32 `protobuf:"varint,1,opt,name=max_frequency_days,json=maxFrequencyDays,proto3" json:"max_frequency_days,omitempty"`   // max frequency of prune runs in days. If 0, prune will be run on every backup.
-	MaxUnusedPercent int32 `protobuf:"varint,100,opt,name=max_unused_percent,json=maxUnusedPercent,proto3" json:"max_unused_percent,omitempty"` // max percentage of repo size that can be unused before prune is run.
-	MaxUnusedBytes   int32 `protobuf:"varint,101,opt,name=max_unused_bytes,json=maxUnusedBytes,proto3" json:"max_unused_bytes,omitempty"`       // max number of bytes that can be unused before prune is run.
+	Required            bool                  `protobuf:"varint,1,opt,name=required,proto3" json:"required,omitempty"`                      // refuse logins without a second factor, can only be set once a factor is enrolled.
+	TotpSecret          string                `protobuf:"bytes,2,opt,name=totp_secret,json=totpSecret,proto3" json:"totp_secret,omitempty"` // base32 encoded TOTP secret, empty if TOTP is not enrolled.
+	WebauthnCredentials []*WebAuthnCredential `protobuf:"bytes,3,rep,name=webauthn_credentials,json=webauthnCredentials,proto3" json:"webauthn_credentials,omitempty"`
+	RecoveryCodesBcrypt []string              `protobuf:"bytes,4,rep,name=recovery_codes_bcrypt,json=recoveryCodesBcrypt,proto3" json:"recovery_codes_bcrypt,omitempty"` // unused recovery codes, each can be used once in place of a second factor.
 }
 
-func (x *PrunePolicy) Reset() {
-	*x = PrunePolicy{}
+func (x *SecondFactor) Reset() {
+	*x = SecondFactor{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_config_proto_msgTypes[4]
+		mi := &file_v1_config_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PrunePolicy) String() string {
+func (x *SecondFactor) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PrunePolicy) ProtoMessage() {}
+func (*SecondFactor) ProtoMessage() {}
 
-func (x *PrunePolicy) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_config_proto_msgTypes[4]
+func (x *SecondFactor) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_config_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -583,65 +3360,68 @@ func (x *PrunePolicy) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PrunePolicy.ProtoReflect.Descriptor instead.
-func (*PrunePolicy) Descriptor() ([]byte, []int) {
-	return file_v1_config_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use SecondFactor.ProtoReflect.Descriptor instead.
+func (*SecondFactor) Descriptor() ([]byte, []int) {
+	return file_v1_config_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *PrunePolicy) GetMaxFrequencyDays() int32 {
+func (x *SecondFactor) GetRequired() bool {
 	if x != nil {
-		return x.MaxFrequencyDays
+		return x.Required
 	}
-	return 0
+	return false
 }
 
-func (x *PrunePolicy) GetMaxUnusedPercent() int32 {
+func (x *SecondFactor) GetTotpSecret() string {
 	if x != nil {
-		return x.MaxUnusedPercent
+		return x.TotpSecret
 	}
-	return 0
+	return ""
 }
 
-func (x *PrunePolicy) GetMaxUnusedBytes() int32 {
+func (x *SecondFactor) GetWebauthnCredentials() []*WebAuthnCredential {
 	if x != nil {
-		return x.MaxUnusedBytes
+		return x.WebauthnCredentials
 	}
-	return 0
+	return nil
 }
 
-type Hook struct {
+func (x *SecondFactor) GetRecoveryCodesBcrypt() []string {
+	if x != nil {
+		return x.RecoveryCodesBcrypt
+	}
+	return nil
+}
+
+type WebAuthnCredential struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Conditions []Hook_Condition `protobuf:"varint,1,rep,packed,name=conditions,proto3,enum=v1.Hook_Condition" json:"conditions,omitempty"`
-	// Types that are assignable to Action:
-	//
-	//	*Hook_ActionCommand
-	//	*Hook_ActionWebhook
-	//	*Hook_ActionDiscord
-	//	*Hook_ActionGotify
-	//	*Hook_ActionSlack
-	Action isHook_Action `protobuf_oneof:"action"`
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                                 // base64url encoded credential ID.
+	PublicKey []byte `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`  // PKIX encoded public key.
+	RpId      string `protobuf:"bytes,3,opt,name=rp_id,json=rpId,proto3" json:"rp_id,omitempty"`                 // relying party ID (host name) the credential is scoped to.
+	SignCount uint32 `protobuf:"varint,4,opt,name=sign_count,json=signCount,proto3" json:"sign_count,omitempty"` // signature counter reported at registration, later counters are only kept in memory.
+	Name      string `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`                             // user provided name for the authenticator.
 }
 
-func (x *Hook) Reset() {
-	*x = Hook{}
+func (x *WebAuthnCredential) Reset() {
+	*x = WebAuthnCredential{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_config_proto_msgTypes[5]
+		mi := &file_v1_config_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Hook) String() string {
+func (x *WebAuthnCredential) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Hook) ProtoMessage() {}
+func (*WebAuthnCredential) ProtoMessage() {}
 
-func (x *Hook) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_config_proto_msgTypes[5]
+func (x *WebAuthnCredential) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_config_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -652,119 +3432,170 @@ func (x *Hook) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Hook.ProtoReflect.Descriptor instead.
-func (*Hook) Descriptor() ([]byte, []int) {
-	return file_v1_config_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use WebAuthnCredential.ProtoReflect.Descriptor instead.
+func (*WebAuthnCredential) Descriptor() ([]byte, []int) {
+	return file_v1_config_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *Hook) GetConditions() []Hook_Condition {
+func (x *WebAuthnCredential) GetId() string {
 	if x != nil {
-		return x.Conditions
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (m *Hook) GetAction() isHook_Action {
-	if m != nil {
-		return m.Action
+func (x *WebAuthnCredential) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
 	}
 	return nil
 }
 
-func (x *Hook) GetActionCommand() *Hook_Command {
-	if x, ok := x.GetAction().(*Hook_ActionCommand); ok {
-		return x.ActionCommand
+func (x *WebAuthnCredential) GetRpId() string {
+	if x != nil {
+		return x.RpId
 	}
-	return nil
+	return ""
 }
 
-func (x *Hook) GetActionWebhook() *Hook_Webhook {
-	if x, ok := x.GetAction().(*Hook_ActionWebhook); ok {
-		return x.ActionWebhook
+func (x *WebAuthnCredential) GetSignCount() uint32 {
+	if x != nil {
+		return x.SignCount
 	}
-	return nil
+	return 0
 }
 
-func (x *Hook) GetActionDiscord() *Hook_Discord {
-	if x, ok := x.GetAction().(*Hook_ActionDiscord); ok {
-		return x.ActionDiscord
+func (x *WebAuthnCredential) GetName() string {
+	if x != nil {
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *Hook) GetActionGotify() *Hook_Gotify {
-	if x, ok := x.GetAction().(*Hook_ActionGotify); ok {
-		return x.ActionGotify
-	}
-	return nil
+type BackendOptions_S3 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccessKeyId     string `protobuf:"bytes,1,opt,name=access_key_id,json=accessKeyId,proto3" json:"access_key_id,omitempty"`             // AWS_ACCESS_KEY_ID
+	SecretAccessKey string `protobuf:"bytes,2,opt,name=secret_access_key,json=secretAccessKey,proto3" json:"secret_access_key,omitempty"` // AWS_SECRET_ACCESS_KEY
+	SessionToken    string `protobuf:"bytes,3,opt,name=session_token,json=sessionToken,proto3" json:"session_token,omitempty"`            // optional, AWS_SESSION_TOKEN of temporary credentials.
+	Profile         string `protobuf:"bytes,4,opt,name=profile,proto3" json:"profile,omitempty"`                                          // optional, AWS_PROFILE of the shared credentials file, mutually exclusive with access keys.
+	Region          string `protobuf:"bytes,5,opt,name=region,proto3" json:"region,omitempty"`                                            // optional, bucket region (s3.region).
+	StorageClass    string `protobuf:"bytes,6,opt,name=storage_class,json=storageClass,proto3" json:"storage_class,omitempty"`            // optional, storage class of new objects (s3.storage-class) e.g. STANDARD_IA.
+	BucketLookup    string `protobuf:"bytes,7,opt,name=bucket_lookup,json=bucketLookup,proto3" json:"bucket_lookup,omitempty"`            // optional, one of "auto", "dns" or "path" (s3.bucket-lookup), "path" for most self hosted endpoints.
 }
 
-func (x *Hook) GetActionSlack() *Hook_Slack {
-	if x, ok := x.GetAction().(*Hook_ActionSlack); ok {
-		return x.ActionSlack
+func (x *BackendOptions_S3) Reset() {
+	*x = BackendOptions_S3{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_config_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-type isHook_Action interface {
-	isHook_Action()
+func (x *BackendOptions_S3) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type Hook_ActionCommand struct {
-	ActionCommand *Hook_Command `protobuf:"bytes,100,opt,name=action_command,json=actionCommand,proto3,oneof"`
-}
+func (*BackendOptions_S3) ProtoMessage() {}
 
-type Hook_ActionWebhook struct {
-	ActionWebhook *Hook_Webhook `protobuf:"bytes,101,opt,name=action_webhook,json=actionWebhook,proto3,oneof"`
+func (x *BackendOptions_S3) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_config_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type Hook_ActionDiscord struct {
-	ActionDiscord *Hook_Discord `protobuf:"bytes,102,opt,name=action_discord,json=actionDiscord,proto3,oneof"`
+// Deprecated: Use BackendOptions_S3.ProtoReflect.Descriptor instead.
+func (*BackendOptions_S3) Descriptor() ([]byte, []int) {
+	return file_v1_config_proto_rawDescGZIP(), []int{12, 0}
 }
 
-type Hook_ActionGotify struct {
-	ActionGotify *Hook_Gotify `protobuf:"bytes,103,opt,name=action_gotify,json=actionGotify,proto3,oneof"`
+func (x *BackendOptions_S3) GetAccessKeyId() string {
+	if x != nil {
+		return x.AccessKeyId
+	}
+	return ""
 }
 
-type Hook_ActionSlack struct {
-	ActionSlack *Hook_Slack `protobuf:"bytes,104,opt,name=action_slack,json=actionSlack,proto3,oneof"`
+func (x *BackendOptions_S3) GetSecretAccessKey() string {
+	if x != nil {
+		return x.SecretAccessKey
+	}
+	return ""
 }
 
-func (*Hook_ActionCommand) isHook_Action() {}
+func (x *BackendOptions_S3) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
 
-func (*Hook_ActionWebhook) isHook_Action() {}
+func (x *BackendOptions_S3) GetProfile() string {
+	if x != nil {
+		return x.Profile
+	}
+	return ""
+}
 
-func (*Hook_ActionDiscord) isHook_Action() {}
+func (x *BackendOptions_S3) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
 
-func (*Hook_ActionGotify) isHook_Action() {}
+func (x *BackendOptions_S3) GetStorageClass() string {
+	if x != nil {
+		return x.StorageClass
+	}
+	return ""
+}
 
-func (*Hook_ActionSlack) isHook_Action() {}
+func (x *BackendOptions_S3) GetBucketLookup() string {
+	if x != nil {
+		return x.BucketLookup
+	}
+	return ""
+}
 
-type Auth struct {
+type BackendOptions_B2 struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Users []*User `protobuf:"bytes,2,rep,name=users,proto3" json:"users,omitempty"` // users to allow access to the UI.
+	AccountId  string `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`    // B2_ACCOUNT_ID, the application key ID.
+	AccountKey string `protobuf:"bytes,2,opt,name=account_key,json=accountKey,proto3" json:"account_key,omitempty"` // B2_ACCOUNT_KEY, the application key.
+	// hide deleted files, keeping their versions for the bucket's lifecycle rules, instead of deleting every version.
+	// restic's b2 backend always deletes every version so this requires an rclone: uri of a b2 remote.
+	HideDeleted bool `protobuf:"varint,3,opt,name=hide_deleted,json=hideDeleted,proto3" json:"hide_deleted,omitempty"`
 }
 
-func (x *Auth) Reset() {
-	*x = Auth{}
+func (x *BackendOptions_B2) Reset() {
+	*x = BackendOptions_B2{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_config_proto_msgTypes[6]
+		mi := &file_v1_config_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Auth) String() string {
+func (x *BackendOptions_B2) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Auth) ProtoMessage() {}
+func (*BackendOptions_B2) ProtoMessage() {}
 
-func (x *Auth) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_config_proto_msgTypes[6]
+func (x *BackendOptions_B2) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_config_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -775,47 +3606,60 @@ func (x *Auth) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Auth.ProtoReflect.Descriptor instead.
-func (*Auth) Descriptor() ([]byte, []int) {
-	return file_v1_config_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use BackendOptions_B2.ProtoReflect.Descriptor instead.
+func (*BackendOptions_B2) Descriptor() ([]byte, []int) {
+	return file_v1_config_proto_rawDescGZIP(), []int{12, 1}
 }
 
-func (x *Auth) GetUsers() []*User {
+func (x *BackendOptions_B2) GetAccountId() string {
 	if x != nil {
-		return x.Users
+		return x.AccountId
 	}
-	return nil
+	return ""
 }
 
-type User struct {
+func (x *BackendOptions_B2) GetAccountKey() string {
+	if x != nil {
+		return x.AccountKey
+	}
+	return ""
+}
+
+func (x *BackendOptions_B2) GetHideDeleted() bool {
+	if x != nil {
+		return x.HideDeleted
+	}
+	return false
+}
+
+type BackendOptions_Azure struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Types that are assignable to Password:
-	//
-	//	*User_PasswordBcrypt
-	Password isUser_Password `protobuf_oneof:"password"`
+	AccountName    string `protobuf:"bytes,1,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`          // AZURE_ACCOUNT_NAME
+	AccountKey     string `protobuf:"bytes,2,opt,name=account_key,json=accountKey,proto3" json:"account_key,omitempty"`             // AZURE_ACCOUNT_KEY, mutually exclusive with account_sas.
+	AccountSas     string `protobuf:"bytes,3,opt,name=account_sas,json=accountSas,proto3" json:"account_sas,omitempty"`             // AZURE_ACCOUNT_SAS, a shared access signature token.
+	EndpointSuffix string `protobuf:"bytes,4,opt,name=endpoint_suffix,json=endpointSuffix,proto3" json:"endpoint_suffix,omitempty"` // optional, AZURE_ENDPOINT_SUFFIX of non public clouds e.g. core.chinacloudapi.cn, requires restic 0.16.0 or newer.
 }
 
-func (x *User) Reset() {
-	*x = User{}
+func (x *BackendOptions_Azure) Reset() {
+	*x = BackendOptions_Azure{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_config_proto_msgTypes[7]
+		mi := &file_v1_config_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *User) String() string {
+func (x *BackendOptions_Azure) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*User) ProtoMessage() {}
+func (*BackendOptions_Azure) ProtoMessage() {}
 
-func (x *User) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_config_proto_msgTypes[7]
+func (x *BackendOptions_Azure) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_config_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -826,42 +3670,39 @@ func (x *User) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use User.ProtoReflect.Descriptor instead.
-func (*User) Descriptor() ([]byte, []int) {
-	return file_v1_config_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use BackendOptions_Azure.ProtoReflect.Descriptor instead.
+func (*BackendOptions_Azure) Descriptor() ([]byte, []int) {
+	return file_v1_config_proto_rawDescGZIP(), []int{12, 2}
 }
 
-func (x *User) GetName() string {
+func (x *BackendOptions_Azure) GetAccountName() string {
 	if x != nil {
-		return x.Name
+		return x.AccountName
 	}
 	return ""
 }
 
-func (m *User) GetPassword() isUser_Password {
-	if m != nil {
-		return m.Password
+func (x *BackendOptions_Azure) GetAccountKey() string {
+	if x != nil {
+		return x.AccountKey
 	}
-	return nil
+	return ""
 }
 
-func (x *User) GetPasswordBcrypt() string {
-	if x, ok := x.GetPassword().(*User_PasswordBcrypt); ok {
-		return x.PasswordBcrypt
+func (x *BackendOptions_Azure) GetAccountSas() string {
+	if x != nil {
+		return x.AccountSas
 	}
 	return ""
 }
 
-type isUser_Password interface {
-	isUser_Password()
-}
-
-type User_PasswordBcrypt struct {
-	PasswordBcrypt string `protobuf:"bytes,2,opt,name=password_bcrypt,json=passwordBcrypt,proto3,oneof"`
+func (x *BackendOptions_Azure) GetEndpointSuffix() string {
+	if x != nil {
+		return x.EndpointSuffix
+	}
+	return ""
 }
 
-func (*User_PasswordBcrypt) isUser_Password() {}
-
 type RetentionPolicy_TimeBucketedCounts struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -877,7 +3718,7 @@ type RetentionPolicy_TimeBucketedCounts struct {
 func (x *RetentionPolicy_TimeBucketedCounts) Reset() {
 	*x = RetentionPolicy_TimeBucketedCounts{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_config_proto_msgTypes[8]
+		mi := &file_v1_config_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -890,7 +3731,7 @@ func (x *RetentionPolicy_TimeBucketedCounts) String() string {
 func (*RetentionPolicy_TimeBucketedCounts) ProtoMessage() {}
 
 func (x *RetentionPolicy_TimeBucketedCounts) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_config_proto_msgTypes[8]
+	mi := &file_v1_config_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -903,7 +3744,7 @@ func (x *RetentionPolicy_TimeBucketedCounts) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use RetentionPolicy_TimeBucketedCounts.ProtoReflect.Descriptor instead.
 func (*RetentionPolicy_TimeBucketedCounts) Descriptor() ([]byte, []int) {
-	return file_v1_config_proto_rawDescGZIP(), []int{3, 0}
+	return file_v1_config_proto_rawDescGZIP(), []int{25, 0}
 }
 
 func (x *RetentionPolicy_TimeBucketedCounts) GetHourly() int32 {
@@ -946,13 +3787,17 @@ type Hook_Command struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Command string `protobuf:"bytes,1,opt,name=command,proto3" json:"command,omitempty"`
+	Command    string `protobuf:"bytes,1,opt,name=command,proto3" json:"command,omitempty"`
+	WorkingDir string `protobuf:"bytes,2,opt,name=working_dir,json=workingDir,proto3" json:"working_dir,omitempty"` // directory the command runs in, defaults to backrest's working directory.
+	// names of the environment variables passed to the command from backrest's environment, all are passed if empty.
+	EnvAllowlist []string `protobuf:"bytes,3,rep,name=env_allowlist,json=envAllowlist,proto3" json:"env_allowlist,omitempty"`
+	User         string   `protobuf:"bytes,4,opt,name=user,proto3" json:"user,omitempty"` // optional, name or uid of the user to run the command as, unix only and requires running as root.
 }
 
 func (x *Hook_Command) Reset() {
 	*x = Hook_Command{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_config_proto_msgTypes[9]
+		mi := &file_v1_config_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -965,7 +3810,7 @@ func (x *Hook_Command) String() string {
 func (*Hook_Command) ProtoMessage() {}
 
 func (x *Hook_Command) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_config_proto_msgTypes[9]
+	mi := &file_v1_config_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -978,7 +3823,7 @@ func (x *Hook_Command) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Hook_Command.ProtoReflect.Descriptor instead.
 func (*Hook_Command) Descriptor() ([]byte, []int) {
-	return file_v1_config_proto_rawDescGZIP(), []int{5, 0}
+	return file_v1_config_proto_rawDescGZIP(), []int{27, 0}
 }
 
 func (x *Hook_Command) GetCommand() string {
@@ -988,6 +3833,27 @@ func (x *Hook_Command) GetCommand() string {
 	return ""
 }
 
+func (x *Hook_Command) GetWorkingDir() string {
+	if x != nil {
+		return x.WorkingDir
+	}
+	return ""
+}
+
+func (x *Hook_Command) GetEnvAllowlist() []string {
+	if x != nil {
+		return x.EnvAllowlist
+	}
+	return nil
+}
+
+func (x *Hook_Command) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
 type Hook_Webhook struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -999,7 +3865,7 @@ type Hook_Webhook struct {
 func (x *Hook_Webhook) Reset() {
 	*x = Hook_Webhook{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_config_proto_msgTypes[10]
+		mi := &file_v1_config_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1012,7 +3878,7 @@ func (x *Hook_Webhook) String() string {
 func (*Hook_Webhook) ProtoMessage() {}
 
 func (x *Hook_Webhook) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_config_proto_msgTypes[10]
+	mi := &file_v1_config_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1025,7 +3891,7 @@ func (x *Hook_Webhook) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Hook_Webhook.ProtoReflect.Descriptor instead.
 func (*Hook_Webhook) Descriptor() ([]byte, []int) {
-	return file_v1_config_proto_rawDescGZIP(), []int{5, 1}
+	return file_v1_config_proto_rawDescGZIP(), []int{27, 1}
 }
 
 func (x *Hook_Webhook) GetWebhookUrl() string {
@@ -1047,7 +3913,7 @@ type Hook_Discord struct {
 func (x *Hook_Discord) Reset() {
 	*x = Hook_Discord{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_config_proto_msgTypes[11]
+		mi := &file_v1_config_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1060,7 +3926,7 @@ func (x *Hook_Discord) String() string {
 func (*Hook_Discord) ProtoMessage() {}
 
 func (x *Hook_Discord) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_config_proto_msgTypes[11]
+	mi := &file_v1_config_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1073,7 +3939,7 @@ func (x *Hook_Discord) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Hook_Discord.ProtoReflect.Descriptor instead.
 func (*Hook_Discord) Descriptor() ([]byte, []int) {
-	return file_v1_config_proto_rawDescGZIP(), []int{5, 2}
+	return file_v1_config_proto_rawDescGZIP(), []int{27, 2}
 }
 
 func (x *Hook_Discord) GetWebhookUrl() string {
@@ -1104,7 +3970,7 @@ type Hook_Gotify struct {
 func (x *Hook_Gotify) Reset() {
 	*x = Hook_Gotify{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_config_proto_msgTypes[12]
+		mi := &file_v1_config_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1117,7 +3983,7 @@ func (x *Hook_Gotify) String() string {
 func (*Hook_Gotify) ProtoMessage() {}
 
 func (x *Hook_Gotify) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_config_proto_msgTypes[12]
+	mi := &file_v1_config_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1130,7 +3996,7 @@ func (x *Hook_Gotify) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Hook_Gotify.ProtoReflect.Descriptor instead.
 func (*Hook_Gotify) Descriptor() ([]byte, []int) {
-	return file_v1_config_proto_rawDescGZIP(), []int{5, 3}
+	return file_v1_config_proto_rawDescGZIP(), []int{27, 3}
 }
 
 func (x *Hook_Gotify) GetBaseUrl() string {
@@ -1173,7 +4039,7 @@ type Hook_Slack struct {
 func (x *Hook_Slack) Reset() {
 	*x = Hook_Slack{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_config_proto_msgTypes[13]
+		mi := &file_v1_config_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1186,7 +4052,7 @@ func (x *Hook_Slack) String() string {
 func (*Hook_Slack) ProtoMessage() {}
 
 func (x *Hook_Slack) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_config_proto_msgTypes[13]
+	mi := &file_v1_config_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1199,7 +4065,7 @@ func (x *Hook_Slack) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Hook_Slack.ProtoReflect.Descriptor instead.
 func (*Hook_Slack) Descriptor() ([]byte, []int) {
-	return file_v1_config_proto_rawDescGZIP(), []int{5, 4}
+	return file_v1_config_proto_rawDescGZIP(), []int{27, 4}
 }
 
 func (x *Hook_Slack) GetWebhookUrl() string {
@@ -1216,166 +4082,677 @@ func (x *Hook_Slack) GetTemplate() string {
 	return ""
 }
 
+// Plugin runs an external plugin found in backrest's plugins directory, see BACKREST_PLUGINS_DIR.
+type Hook_Plugin struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                                                                                 // name of the plugin's executable, without extension.
+	Settings map[string]string `protobuf:"bytes,2,rep,name=settings,proto3" json:"settings,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"` // passed to the plugin e.g. an API key or routing key.
+}
+
+func (x *Hook_Plugin) Reset() {
+	*x = Hook_Plugin{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_config_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Hook_Plugin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Hook_Plugin) ProtoMessage() {}
+
+func (x *Hook_Plugin) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_config_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Hook_Plugin.ProtoReflect.Descriptor instead.
+func (*Hook_Plugin) Descriptor() ([]byte, []int) {
+	return file_v1_config_proto_rawDescGZIP(), []int{27, 5}
+}
+
+func (x *Hook_Plugin) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Hook_Plugin) GetSettings() map[string]string {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
 var File_v1_config_proto protoreflect.FileDescriptor
 
 var file_v1_config_proto_rawDesc = []byte{
 	0x0a, 0x0f, 0x76, 0x31, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x12, 0x02, 0x76, 0x31, 0x22, 0xaa, 0x01, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x6e, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x05, 0x6d, 0x6f, 0x64, 0x6e, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x68, 0x6f, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x05, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x18, 0x03, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x05, 0x72,
-	0x65, 0x70, 0x6f, 0x73, 0x12, 0x1e, 0x0a, 0x05, 0x70, 0x6c, 0x61, 0x6e, 0x73, 0x18, 0x04, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x05, 0x70,
-	0x6c, 0x61, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x52, 0x04, 0x61, 0x75,
-	0x74, 0x68, 0x22, 0xe1, 0x01, 0x0a, 0x04, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75,
-	0x72, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x1a, 0x0a,
-	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x76,
-	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x65, 0x6e, 0x76, 0x12, 0x14, 0x0a, 0x05, 0x66,
-	0x6c, 0x61, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67,
-	0x73, 0x12, 0x32, 0x0a, 0x0c, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x75,
-	0x6e, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0b, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x50,
-	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1e, 0x0a, 0x05, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x07,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x6f, 0x6b, 0x52, 0x05,
-	0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x75, 0x6e,
-	0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x6f,
-	0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0xe1, 0x01, 0x0a, 0x04, 0x50, 0x6c, 0x61, 0x6e, 0x12,
+	0x6f, 0x12, 0x02, 0x76, 0x31, 0x1a, 0x0f, 0x76, 0x31, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x69, 0x63,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb6, 0x04, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x6e, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x6d, 0x6f, 0x64, 0x6e, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x05, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x52, 0x05,
+	0x72, 0x65, 0x70, 0x6f, 0x73, 0x12, 0x1e, 0x0a, 0x05, 0x70, 0x6c, 0x61, 0x6e, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x05,
+	0x70, 0x6c, 0x61, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x52, 0x04, 0x61,
+	0x75, 0x74, 0x68, 0x12, 0x1c, 0x0a, 0x04, 0x6d, 0x71, 0x74, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x71, 0x74, 0x74, 0x52, 0x04, 0x6d, 0x71, 0x74,
+	0x74, 0x12, 0x2f, 0x0a, 0x0b, 0x73, 0x65, 0x6c, 0x66, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6c, 0x66,
+	0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x0a, 0x73, 0x65, 0x6c, 0x66, 0x42, 0x61, 0x63, 0x6b,
+	0x75, 0x70, 0x12, 0x19, 0x0a, 0x03, 0x74, 0x6c, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x07, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6c, 0x73, 0x52, 0x03, 0x74, 0x6c, 0x73, 0x12, 0x3d, 0x0a,
+	0x10, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6d,
+	0x6f, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x0f, 0x72, 0x65, 0x6d,
+	0x6f, 0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x0d,
+	0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0d, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x38, 0x0a, 0x0e, 0x65, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x6e,
+	0x63, 0x79, 0x5f, 0x73, 0x74, 0x6f, 0x70, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x76, 0x31, 0x2e, 0x45, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x53, 0x74, 0x6f, 0x70,
+	0x52, 0x0d, 0x65, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x53, 0x74, 0x6f, 0x70, 0x12,
+	0x37, 0x0a, 0x0e, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61,
+	0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x0d, 0x70, 0x6c, 0x61, 0x6e, 0x54,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x74,
+	0x69, 0x63, 0x5f, 0x62, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x69, 0x63, 0x42, 0x69, 0x6e, 0x61, 0x72,
+	0x79, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x74, 0x69, 0x63, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x22,
+	0x5e, 0x0a, 0x0c, 0x50, 0x6c, 0x61, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12,
 	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x12, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72,
-	0x65, 0x70, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x04, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x63,
-	0x6c, 0x75, 0x64, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x65, 0x78, 0x63,
-	0x6c, 0x75, 0x64, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64,
-	0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x69, 0x65, 0x78, 0x63, 0x6c, 0x75,
-	0x64, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x72, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x63, 0x72, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x65, 0x6e,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x76, 0x31, 0x2e,
-	0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52,
-	0x09, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x05, 0x68, 0x6f,
-	0x6f, 0x6b, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x48,
-	0x6f, 0x6f, 0x6b, 0x52, 0x05, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x22, 0xa0, 0x05, 0x0a, 0x0f, 0x52,
-	0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2c,
-	0x0a, 0x10, 0x6d, 0x61, 0x78, 0x5f, 0x75, 0x6e, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x6c, 0x69, 0x6d,
-	0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0e, 0x6d, 0x61,
-	0x78, 0x55, 0x6e, 0x75, 0x73, 0x65, 0x64, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x22, 0x0a, 0x0b,
-	0x6b, 0x65, 0x65, 0x70, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x05, 0x42, 0x02, 0x18, 0x01, 0x52, 0x09, 0x6b, 0x65, 0x65, 0x70, 0x4c, 0x61, 0x73, 0x74, 0x4e,
-	0x12, 0x23, 0x0a, 0x0b, 0x6b, 0x65, 0x65, 0x70, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x6c, 0x79, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0a, 0x6b, 0x65, 0x65, 0x70, 0x48,
-	0x6f, 0x75, 0x72, 0x6c, 0x79, 0x12, 0x21, 0x0a, 0x0a, 0x6b, 0x65, 0x65, 0x70, 0x5f, 0x64, 0x61,
-	0x69, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x02, 0x18, 0x01, 0x52, 0x09, 0x6b,
-	0x65, 0x65, 0x70, 0x44, 0x61, 0x69, 0x6c, 0x79, 0x12, 0x23, 0x0a, 0x0b, 0x6b, 0x65, 0x65, 0x70,
-	0x5f, 0x77, 0x65, 0x65, 0x6b, 0x6c, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x42, 0x02, 0x18,
-	0x01, 0x52, 0x0a, 0x6b, 0x65, 0x65, 0x70, 0x57, 0x65, 0x65, 0x6b, 0x6c, 0x79, 0x12, 0x25, 0x0a,
-	0x0c, 0x6b, 0x65, 0x65, 0x70, 0x5f, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x05, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0b, 0x6b, 0x65, 0x65, 0x70, 0x4d, 0x6f, 0x6e,
-	0x74, 0x68, 0x6c, 0x79, 0x12, 0x23, 0x0a, 0x0b, 0x6b, 0x65, 0x65, 0x70, 0x5f, 0x79, 0x65, 0x61,
-	0x72, 0x6c, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0a, 0x6b,
-	0x65, 0x65, 0x70, 0x59, 0x65, 0x61, 0x72, 0x6c, 0x79, 0x12, 0x34, 0x0a, 0x14, 0x6b, 0x65, 0x65,
-	0x70, 0x5f, 0x77, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x12, 0x6b, 0x65, 0x65,
-	0x70, 0x57, 0x69, 0x74, 0x68, 0x69, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x2d, 0x0a, 0x12, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x65, 0x70, 0x5f, 0x6c,
-	0x61, 0x73, 0x74, 0x5f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x0f, 0x70,
-	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x4b, 0x65, 0x65, 0x70, 0x4c, 0x61, 0x73, 0x74, 0x4e, 0x12, 0x5a,
-	0x0a, 0x14, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x62, 0x75,
-	0x63, 0x6b, 0x65, 0x74, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x76,
-	0x31, 0x2e, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x65, 0x64, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x73, 0x48, 0x00, 0x52, 0x12, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x54, 0x69,
-	0x6d, 0x65, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x70, 0x6f,
-	0x6c, 0x69, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x65, 0x70, 0x5f, 0x61, 0x6c, 0x6c, 0x18, 0x0c, 0x20,
-	0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x0d, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x4b, 0x65, 0x65,
-	0x70, 0x41, 0x6c, 0x6c, 0x1a, 0x8c, 0x01, 0x0a, 0x12, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x75, 0x63,
-	0x6b, 0x65, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x68,
-	0x6f, 0x75, 0x72, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x68, 0x6f, 0x75,
-	0x72, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x05, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x65,
-	0x6b, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x77, 0x65, 0x65, 0x6b, 0x6c,
-	0x79, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x07, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x79,
-	0x65, 0x61, 0x72, 0x6c, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x79, 0x65, 0x61,
-	0x72, 0x6c, 0x79, 0x42, 0x08, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x93, 0x01,
-	0x0a, 0x0b, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2c, 0x0a,
-	0x12, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x64,
-	0x61, 0x79, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x46, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x44, 0x61, 0x79, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x6d,
-	0x61, 0x78, 0x5f, 0x75, 0x6e, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
-	0x74, 0x18, 0x64, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x55, 0x6e, 0x75, 0x73,
-	0x65, 0x64, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x61, 0x78,
-	0x5f, 0x75, 0x6e, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x65, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x55, 0x6e, 0x75, 0x73, 0x65, 0x64, 0x42, 0x79,
-	0x74, 0x65, 0x73, 0x22, 0xd5, 0x06, 0x0a, 0x04, 0x48, 0x6f, 0x6f, 0x6b, 0x12, 0x32, 0x0a, 0x0a,
-	0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e,
-	0x32, 0x12, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x6f, 0x6b, 0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x12, 0x39, 0x0a, 0x0e, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
-	0x6e, 0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f,
-	0x6f, 0x6b, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x0d, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x39, 0x0a, 0x0e, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x18, 0x65, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x6f, 0x6b, 0x2e, 0x57, 0x65,
-	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x48, 0x00, 0x52, 0x0d, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x57,
-	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x39, 0x0a, 0x0e, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x18, 0x66, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
-	0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x6f, 0x6b, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x64,
-	0x48, 0x00, 0x52, 0x0d, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x72,
-	0x64, 0x12, 0x36, 0x0a, 0x0d, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x67, 0x6f, 0x74, 0x69,
-	0x66, 0x79, 0x18, 0x67, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f,
-	0x6f, 0x6b, 0x2e, 0x47, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x48, 0x00, 0x52, 0x0c, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x47, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x12, 0x33, 0x0a, 0x0c, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x6c, 0x61, 0x63, 0x6b, 0x18, 0x68, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0e, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x6f, 0x6b, 0x2e, 0x53, 0x6c, 0x61, 0x63, 0x6b, 0x48,
-	0x00, 0x52, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6c, 0x61, 0x63, 0x6b, 0x1a, 0x23,
-	0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d,
-	0x6d, 0x61, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d,
-	0x61, 0x6e, 0x64, 0x1a, 0x2a, 0x0a, 0x07, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x1f,
-	0x0a, 0x0b, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x55, 0x72, 0x6c, 0x1a,
-	0x46, 0x0a, 0x07, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x65,
-	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x55, 0x72, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x74,
-	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74,
-	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x1a, 0x7c, 0x0a, 0x06, 0x47, 0x6f, 0x74, 0x69, 0x66,
-	0x79, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x73, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x14, 0x0a, 0x05,
+	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1c, 0x0a, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x08, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x04, 0x70, 0x6c, 0x61, 0x6e, 0x22,
+	0x40, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x74, 0x69, 0x63, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61,
+	0x32, 0x35, 0x36, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x68, 0x61, 0x32, 0x35,
+	0x36, 0x22, 0x5d, 0x0a, 0x0d, 0x45, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x63, 0x79, 0x53, 0x74,
+	0x6f, 0x70, 0x12, 0x20, 0x0a, 0x0c, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x75, 0x6e, 0x69, 0x78, 0x54, 0x69,
+	0x6d, 0x65, 0x4d, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x22, 0xa5, 0x01, 0x0a, 0x0d, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x3e, 0x0a, 0x09, 0x74, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x09, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x1a, 0x3c, 0x0a, 0x0e, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x48, 0x0a, 0x0e, 0x52, 0x65, 0x6d, 0x6f,
+	0x74, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x14, 0x0a, 0x05,
 	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x64,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x25,
-	0x0a, 0x0e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
-	0x18, 0x65, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x54, 0x65, 0x6d,
-	0x70, 0x6c, 0x61, 0x74, 0x65, 0x1a, 0x44, 0x0a, 0x05, 0x53, 0x6c, 0x61, 0x63, 0x6b, 0x12, 0x1f,
-	0x0a, 0x0b, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x55, 0x72, 0x6c, 0x12,
-	0x1a, 0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x22, 0x93, 0x01, 0x0a, 0x09,
-	0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x15, 0x0a, 0x11, 0x43, 0x4f, 0x4e,
-	0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00,
-	0x12, 0x17, 0x0a, 0x13, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x4e,
-	0x59, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x4f, 0x4e,
-	0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x4e, 0x41, 0x50, 0x53, 0x48, 0x4f, 0x54, 0x5f,
-	0x53, 0x54, 0x41, 0x52, 0x54, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x43, 0x4f, 0x4e, 0x44, 0x49,
-	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x4e, 0x41, 0x50, 0x53, 0x48, 0x4f, 0x54, 0x5f, 0x45, 0x4e,
-	0x44, 0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e,
-	0x5f, 0x53, 0x4e, 0x41, 0x50, 0x53, 0x48, 0x4f, 0x54, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10,
-	0x04, 0x42, 0x08, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x26, 0x0a, 0x04, 0x41,
-	0x75, 0x74, 0x68, 0x12, 0x1e, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75, 0x73,
-	0x65, 0x72, 0x73, 0x22, 0x51, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x29, 0x0a, 0x0f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x62, 0x63, 0x72, 0x79,
-	0x70, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0e, 0x70, 0x61, 0x73, 0x73,
-	0x77, 0x6f, 0x72, 0x64, 0x42, 0x63, 0x72, 0x79, 0x70, 0x74, 0x42, 0x0a, 0x0a, 0x08, 0x70, 0x61,
-	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x42, 0x2c, 0x5a, 0x2a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x61, 0x72, 0x65, 0x74, 0x68, 0x67, 0x65, 0x6f, 0x72, 0x67,
-	0x65, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67,
-	0x6f, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x6e, 0x22, 0x86, 0x01, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x20, 0x0a, 0x0c, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x75, 0x6e, 0x69, 0x78, 0x54, 0x69, 0x6d,
+	0x65, 0x4d, 0x73, 0x12, 0x22, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x22, 0x46, 0x0a, 0x12, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x30, 0x0a, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x22, 0xa0, 0x06, 0x0a, 0x04, 0x52, 0x65, 0x70, 0x6f, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x72, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e,
+	0x76, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x65, 0x6e, 0x76, 0x12, 0x14, 0x0a, 0x05,
+	0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x66, 0x6c, 0x61,
+	0x67, 0x73, 0x12, 0x32, 0x0a, 0x0c, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72,
+	0x75, 0x6e, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0b, 0x70, 0x72, 0x75, 0x6e, 0x65,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1e, 0x0a, 0x05, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x6f, 0x6b, 0x52,
+	0x05, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x75,
+	0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x75, 0x74,
+	0x6f, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x3a, 0x0a, 0x19, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x5f, 0x75, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x17, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x55, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x61, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10,
+	0x69, 0x6e, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x12, 0x20, 0x0a, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x6d, 0x62,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x70, 0x61, 0x63, 0x6b, 0x53, 0x69, 0x7a, 0x65,
+	0x4d, 0x62, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2f, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x66, 0x6c,
+	0x61, 0x67, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x73, 0x74, 0x69, 0x63, 0x46, 0x6c, 0x61, 0x67, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x72, 0x61,
+	0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x2c, 0x0a, 0x0a, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x69, 0x73, 0x6b, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x53, 0x70,
+	0x61, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x61, 0x63, 0x68, 0x65, 0x5f, 0x64, 0x69, 0x72,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x63, 0x68, 0x65, 0x44, 0x69, 0x72,
+	0x12, 0x32, 0x0a, 0x0c, 0x63, 0x61, 0x63, 0x68, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x63, 0x68,
+	0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0b, 0x63, 0x61, 0x63, 0x68, 0x65, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x12, 0x3b, 0x0a, 0x0f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x0e, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x32, 0x0a, 0x0c, 0x63, 0x6f, 0x6c, 0x64, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6c,
+	0x64, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x0b, 0x63, 0x6f, 0x6c, 0x64, 0x53, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x2f, 0x0a, 0x0b, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x5f,
+	0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x65,
+	0x6e, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x3e, 0x0a, 0x10, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x65,
+	0x64, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x4b, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x65,
+	0x64, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0e, 0x63, 0x6f, 0x6f,
+	0x6c, 0x5f, 0x6f, 0x66, 0x66, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0c, 0x63, 0x6f, 0x6f, 0x6c, 0x4f, 0x66, 0x66, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12,
+	0x12, 0x0a, 0x04, 0x63, 0x72, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63,
+	0x72, 0x6f, 0x6e, 0x22, 0x4d, 0x0a, 0x0a, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x4f, 0x6e, 0x6c,
+	0x79, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x72,
+	0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x70, 0x6f, 0x22, 0x7c, 0x0a, 0x0b, 0x43, 0x6f, 0x6c, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x77,
+	0x61, 0x72, 0x6d, 0x75, 0x70, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x77, 0x61, 0x72, 0x6d, 0x75, 0x70, 0x43, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x77, 0x61, 0x72, 0x6d, 0x75, 0x70, 0x5f, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10,
+	0x77, 0x61, 0x72, 0x6d, 0x75, 0x70, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x48, 0x6f, 0x75, 0x72, 0x73,
+	0x22, 0xba, 0x05, 0x0a, 0x0e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x27, 0x0a, 0x02, 0x73, 0x33, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2e, 0x53, 0x33, 0x48, 0x00, 0x52, 0x02, 0x73, 0x33, 0x12, 0x27, 0x0a, 0x02,
+	0x62, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61,
+	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x42, 0x32, 0x48,
+	0x00, 0x52, 0x02, 0x62, 0x32, 0x12, 0x30, 0x0a, 0x05, 0x61, 0x7a, 0x75, 0x72, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e,
+	0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x41, 0x7a, 0x75, 0x72, 0x65, 0x48, 0x00,
+	0x52, 0x05, 0x61, 0x7a, 0x75, 0x72, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0xf5, 0x01, 0x0a, 0x02, 0x53, 0x33,
+	0x12, 0x22, 0x0a, 0x0d, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b,
+	0x65, 0x79, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x5f, 0x61,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x4b, 0x65, 0x79,
+	0x12, 0x23, 0x0a, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x23, 0x0a, 0x0d,
+	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4c, 0x6f, 0x6f, 0x6b, 0x75,
+	0x70, 0x1a, 0x67, 0x0a, 0x02, 0x42, 0x32, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x68, 0x69, 0x64, 0x65, 0x5f,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x68,
+	0x69, 0x64, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x1a, 0x95, 0x01, 0x0a, 0x05, 0x41,
+	0x7a, 0x75, 0x72, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x5f, 0x73, 0x61, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x61, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x6e, 0x64,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x73, 0x75, 0x66, 0x66, 0x69, 0x78, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x53, 0x75, 0x66, 0x66,
+	0x69, 0x78, 0x42, 0x09, 0x0a, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x22, 0x63, 0x0a,
+	0x0b, 0x43, 0x61, 0x63, 0x68, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x12, 0x0a, 0x04,
+	0x63, 0x72, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x72, 0x6f, 0x6e,
+	0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x61, 0x79, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x41, 0x67, 0x65, 0x44, 0x61,
+	0x79, 0x73, 0x12, 0x1e, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x6d,
+	0x62, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x53, 0x69, 0x7a, 0x65,
+	0x4d, 0x62, 0x22, 0x4d, 0x0a, 0x09, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12,
+	0x1e, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x5f, 0x66, 0x72, 0x65, 0x65, 0x5f, 0x6d, 0x62, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x46, 0x72, 0x65, 0x65, 0x4d, 0x62, 0x12,
+	0x20, 0x0a, 0x0c, 0x77, 0x61, 0x72, 0x6e, 0x5f, 0x66, 0x72, 0x65, 0x65, 0x5f, 0x6d, 0x62, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x77, 0x61, 0x72, 0x6e, 0x46, 0x72, 0x65, 0x65, 0x4d,
+	0x62, 0x22, 0x36, 0x0a, 0x0a, 0x52, 0x65, 0x73, 0x74, 0x69, 0x63, 0x46, 0x6c, 0x61, 0x67, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xf3, 0x07, 0x0a, 0x04, 0x50, 0x6c,
+	0x61, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08,
+	0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x65, 0x78, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x69, 0x65, 0x78,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x72, 0x6f, 0x6e, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x72, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x09, 0x72, 0x65,
+	0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x52, 0x09, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a,
+	0x05, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x76,
+	0x31, 0x2e, 0x48, 0x6f, 0x6f, 0x6b, 0x52, 0x05, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x62, 0x79, 0x18, 0x0b, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x42, 0x79, 0x12, 0x2c, 0x0a, 0x08,
+	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x73, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73,
+	0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x12, 0x37, 0x0a, 0x0d, 0x70, 0x72,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x3b, 0x0a, 0x0f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73,
+	0x52, 0x0e, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73,
+	0x12, 0x35, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x64, 0x72, 0x69, 0x6c,
+	0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x44, 0x72, 0x69, 0x6c, 0x6c, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x44, 0x72, 0x69, 0x6c, 0x6c, 0x12, 0x2f, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x72, 0x61,
+	0x5f, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x69, 0x63, 0x46, 0x6c, 0x61, 0x67, 0x52, 0x0a, 0x65, 0x78,
+	0x74, 0x72, 0x61, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x76, 0x18,
+	0x11, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x65, 0x6e, 0x76, 0x12, 0x26, 0x0a, 0x0f, 0x6f, 0x6e,
+	0x65, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x12, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0d, 0x6f, 0x6e, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x12, 0x32, 0x0a, 0x0c, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61,
+	0x74, 0x68, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0b, 0x70, 0x61, 0x74, 0x68, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x38, 0x0a, 0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64,
+	0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69,
+	0x65, 0x73, 0x52, 0x0c, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73,
+	0x12, 0x36, 0x0a, 0x17, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x6a, 0x69, 0x74,
+	0x74, 0x65, 0x72, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x15, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x15, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x4a, 0x69, 0x74, 0x74, 0x65,
+	0x72, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x76, 0x65, 0x72, 0x69,
+	0x66, 0x79, 0x5f, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18,
+	0x16, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x53, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x41, 0x0a, 0x11, 0x61, 0x6e, 0x6f, 0x6d,
+	0x61, 0x6c, 0x79, 0x5f, 0x64, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x17, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79,
+	0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x61, 0x6e, 0x6f, 0x6d, 0x61,
+	0x6c, 0x79, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x74,
+	0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x18, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74,
+	0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x3b, 0x0a, 0x0f, 0x77, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x5f, 0x74, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x54, 0x72, 0x69,
+	0x67, 0x67, 0x65, 0x72, 0x52, 0x0e, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x54, 0x72, 0x69,
+	0x67, 0x67, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x61, 0x6c, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x18, 0x1a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f,
+	0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x22,
+	0x26, 0x0a, 0x0e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65,
+	0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xe8, 0x01, 0x0a, 0x10, 0x41, 0x6e, 0x6f, 0x6d,
+	0x61, 0x6c, 0x79, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07,
+	0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x61,
+	0x64, 0x64, 0x65, 0x64, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0f, 0x64, 0x61, 0x74, 0x61, 0x41, 0x64, 0x64, 0x65, 0x64, 0x46, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x12, 0x32, 0x0a, 0x15, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x66, 0x69,
+	0x6c, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x13, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x50,
+	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x62, 0x61, 0x73, 0x65, 0x6c, 0x69,
+	0x6e, 0x65, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0f, 0x62, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70,
+	0x73, 0x12, 0x2f, 0x0a, 0x14, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x61, 0x64,
+	0x64, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x11, 0x6d, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x41, 0x64, 0x64, 0x65, 0x64, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x22, 0x44, 0x0a, 0x10, 0x50, 0x6c, 0x61, 0x6e, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64,
+	0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08,
+	0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08,
+	0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x73, 0x22, 0x70, 0x0a, 0x0b, 0x50, 0x61, 0x74, 0x68,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x26, 0x0a, 0x0f, 0x6f,
+	0x6e, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x6f, 0x6e, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x4d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22, 0x59, 0x0a, 0x0c, 0x52, 0x65,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x44, 0x72, 0x69, 0x6c, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x72,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x72, 0x6f, 0x6e, 0x12, 0x21,
+	0x0a, 0x0c, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x46, 0x69, 0x6c, 0x65,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0xbf, 0x02, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x69, 0x63, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x6e, 0x69, 0x63, 0x65, 0x12, 0x35, 0x0a, 0x08,
+	0x69, 0x6f, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x73, 0x2e, 0x49, 0x6f, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x52, 0x07, 0x69, 0x6f, 0x43, 0x6c,
+	0x61, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6f, 0x5f, 0x70, 0x72, 0x69, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x69, 0x6f, 0x50, 0x72, 0x69, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x6f, 0x6d, 0x61, 0x78, 0x70, 0x72, 0x6f,
+	0x63, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x67, 0x6f, 0x6d, 0x61, 0x78, 0x70,
+	0x72, 0x6f, 0x63, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x5f, 0x6d,
+	0x61, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x4d, 0x61, 0x78, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6f, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x69, 0x6f, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x22, 0x65, 0x0a, 0x07, 0x49, 0x6f, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x12, 0x49,
+	0x4f, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f, 0x55, 0x4e, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x49, 0x4f, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f,
+	0x52, 0x45, 0x41, 0x4c, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x49, 0x4f,
+	0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f, 0x42, 0x45, 0x53, 0x54, 0x5f, 0x45, 0x46, 0x46, 0x4f,
+	0x52, 0x54, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x49, 0x4f, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53,
+	0x5f, 0x49, 0x44, 0x4c, 0x45, 0x10, 0x03, 0x22, 0xc9, 0x01, 0x0a, 0x0d, 0x50, 0x72, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0b, 0x6f, 0x6e, 0x5f,
+	0x61, 0x63, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x6f, 0x6e, 0x41, 0x63, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x11, 0x75, 0x6e, 0x6d,
+	0x65, 0x74, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x75, 0x6e, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x65, 0x64, 0x4e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x69, 0x66, 0x69, 0x5f, 0x73,
+	0x73, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x77, 0x69, 0x66, 0x69,
+	0x53, 0x73, 0x69, 0x64, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e,
+	0x72, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x23,
+	0x0a, 0x0d, 0x64, 0x65, 0x66, 0x65, 0x72, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x64, 0x65, 0x66, 0x65, 0x72, 0x4d, 0x69, 0x6e, 0x75,
+	0x74, 0x65, 0x73, 0x22, 0xcf, 0x01, 0x0a, 0x0c, 0x54, 0x61, 0x73, 0x6b, 0x54, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x6d,
+	0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x62, 0x61,
+	0x63, 0x6b, 0x75, 0x70, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x70,
+	0x72, 0x75, 0x6e, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0c, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x07, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x72, 0x65,
+	0x74, 0x72, 0x79, 0x5f, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x72, 0x65, 0x74, 0x72, 0x79, 0x44, 0x65,
+	0x6c, 0x61, 0x79, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x08, 0x72, 0x65,
+	0x74, 0x72, 0x79, 0x5f, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x0e, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x52, 0x07, 0x72, 0x65,
+	0x74, 0x72, 0x79, 0x4f, 0x6e, 0x22, 0xa0, 0x05, 0x0a, 0x0f, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2c, 0x0a, 0x10, 0x6d, 0x61, 0x78,
+	0x5f, 0x75, 0x6e, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x55, 0x6e, 0x75, 0x73,
+	0x65, 0x64, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x22, 0x0a, 0x0b, 0x6b, 0x65, 0x65, 0x70, 0x5f,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x02, 0x18, 0x01,
+	0x52, 0x09, 0x6b, 0x65, 0x65, 0x70, 0x4c, 0x61, 0x73, 0x74, 0x4e, 0x12, 0x23, 0x0a, 0x0b, 0x6b,
+	0x65, 0x65, 0x70, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x42, 0x02, 0x18, 0x01, 0x52, 0x0a, 0x6b, 0x65, 0x65, 0x70, 0x48, 0x6f, 0x75, 0x72, 0x6c, 0x79,
+	0x12, 0x21, 0x0a, 0x0a, 0x6b, 0x65, 0x65, 0x70, 0x5f, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x05, 0x42, 0x02, 0x18, 0x01, 0x52, 0x09, 0x6b, 0x65, 0x65, 0x70, 0x44, 0x61,
+	0x69, 0x6c, 0x79, 0x12, 0x23, 0x0a, 0x0b, 0x6b, 0x65, 0x65, 0x70, 0x5f, 0x77, 0x65, 0x65, 0x6b,
+	0x6c, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0a, 0x6b, 0x65,
+	0x65, 0x70, 0x57, 0x65, 0x65, 0x6b, 0x6c, 0x79, 0x12, 0x25, 0x0a, 0x0c, 0x6b, 0x65, 0x65, 0x70,
+	0x5f, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x42, 0x02,
+	0x18, 0x01, 0x52, 0x0b, 0x6b, 0x65, 0x65, 0x70, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x12,
+	0x23, 0x0a, 0x0b, 0x6b, 0x65, 0x65, 0x70, 0x5f, 0x79, 0x65, 0x61, 0x72, 0x6c, 0x79, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x05, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0a, 0x6b, 0x65, 0x65, 0x70, 0x59, 0x65,
+	0x61, 0x72, 0x6c, 0x79, 0x12, 0x34, 0x0a, 0x14, 0x6b, 0x65, 0x65, 0x70, 0x5f, 0x77, 0x69, 0x74,
+	0x68, 0x69, 0x6e, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x12, 0x6b, 0x65, 0x65, 0x70, 0x57, 0x69, 0x74, 0x68,
+	0x69, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x12, 0x70, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x5f, 0x6b, 0x65, 0x65, 0x70, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x0f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x4b, 0x65, 0x65, 0x70, 0x4c, 0x61, 0x73, 0x74, 0x4e, 0x12, 0x5a, 0x0a, 0x14, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x65,
+	0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74,
+	0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x48,
+	0x00, 0x52, 0x12, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x5f,
+	0x6b, 0x65, 0x65, 0x70, 0x5f, 0x61, 0x6c, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00,
+	0x52, 0x0d, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x4b, 0x65, 0x65, 0x70, 0x41, 0x6c, 0x6c, 0x1a,
+	0x8c, 0x01, 0x0a, 0x12, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x65, 0x64,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x6f, 0x75, 0x72, 0x6c, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x68, 0x6f, 0x75, 0x72, 0x6c, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x64,
+	0x61, 0x69, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x65, 0x6b, 0x6c, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x77, 0x65, 0x65, 0x6b, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6d,
+	0x6f, 0x6e, 0x74, 0x68, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x79, 0x65, 0x61, 0x72, 0x6c, 0x79,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x79, 0x65, 0x61, 0x72, 0x6c, 0x79, 0x42, 0x08,
+	0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0xc7, 0x01, 0x0a, 0x0b, 0x50, 0x72, 0x75,
+	0x6e, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x5f,
+	0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x64, 0x61, 0x79, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x79, 0x44, 0x61, 0x79, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x5f, 0x75, 0x6e,
+	0x75, 0x73, 0x65, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x64, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x55, 0x6e, 0x75, 0x73, 0x65, 0x64, 0x50, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x61, 0x78, 0x5f, 0x75, 0x6e, 0x75, 0x73,
+	0x65, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x65, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e,
+	0x6d, 0x61, 0x78, 0x55, 0x6e, 0x75, 0x73, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x32,
+	0x0a, 0x15, 0x6d, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x62, 0x6c,
+	0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x66, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x6d,
+	0x69, 0x6e, 0x52, 0x65, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x22, 0xc4, 0x0a, 0x0a, 0x04, 0x48, 0x6f, 0x6f, 0x6b, 0x12, 0x32, 0x0a, 0x0a, 0x63,
+	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32,
+	0x12, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x6f, 0x6b, 0x2e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x27, 0x0a, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x78, 0x70, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x72, 0x12,
+	0x39, 0x0a, 0x0e, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x6f,
+	0x6b, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x0d, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x39, 0x0a, 0x0e, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x18, 0x65, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x10, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x6f, 0x6b, 0x2e, 0x57, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x48, 0x00, 0x52, 0x0d, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x39, 0x0a, 0x0e, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x64, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x18, 0x66, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x76, 0x31, 0x2e, 0x48, 0x6f, 0x6f, 0x6b, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x48,
+	0x00, 0x52, 0x0d, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x64,
+	0x12, 0x36, 0x0a, 0x0d, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x67, 0x6f, 0x74, 0x69, 0x66,
+	0x79, 0x18, 0x67, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x6f,
+	0x6b, 0x2e, 0x47, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x48, 0x00, 0x52, 0x0c, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x47, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x12, 0x33, 0x0a, 0x0c, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x73, 0x6c, 0x61, 0x63, 0x6b, 0x18, 0x68, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x6f, 0x6b, 0x2e, 0x53, 0x6c, 0x61, 0x63, 0x6b, 0x48, 0x00,
+	0x52, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x6c, 0x61, 0x63, 0x6b, 0x12, 0x36, 0x0a,
+	0x0d, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x18, 0x69,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x6f, 0x6b, 0x2e, 0x50,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x48, 0x00, 0x52, 0x0c, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x1a, 0x7d, 0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x6f,
+	0x72, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x69, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x65,
+	0x6e, 0x76, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0c, 0x65, 0x6e, 0x76, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x75, 0x73, 0x65, 0x72, 0x1a, 0x2a, 0x0a, 0x07, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12,
+	0x1f, 0x0a, 0x0b, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x55, 0x72, 0x6c,
+	0x1a, 0x46, 0x0a, 0x07, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x77,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x55, 0x72, 0x6c, 0x12, 0x1a, 0x0a, 0x08,
+	0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x1a, 0x7c, 0x0a, 0x06, 0x47, 0x6f, 0x74, 0x69,
+	0x66, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x73, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18,
+	0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12,
+	0x25, 0x0a, 0x0e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x18, 0x65, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x1a, 0x44, 0x0a, 0x05, 0x53, 0x6c, 0x61, 0x63, 0x6b, 0x12,
+	0x1f, 0x0a, 0x0b, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x55, 0x72, 0x6c,
+	0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x1a, 0x94, 0x01, 0x0a,
+	0x06, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x39, 0x0a, 0x08, 0x73,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x76, 0x31, 0x2e, 0x48, 0x6f, 0x6f, 0x6b, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x73, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x1a, 0x3b, 0x0a, 0x0d, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0x89, 0x02, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x15, 0x0a, 0x11, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55,
+	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x43, 0x4f, 0x4e, 0x44,
+	0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x4e, 0x59, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10,
+	0x01, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53,
+	0x4e, 0x41, 0x50, 0x53, 0x48, 0x4f, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x10, 0x02, 0x12,
+	0x1a, 0x0a, 0x16, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x4e, 0x41,
+	0x50, 0x53, 0x48, 0x4f, 0x54, 0x5f, 0x45, 0x4e, 0x44, 0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18, 0x43,
+	0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x4e, 0x41, 0x50, 0x53, 0x48, 0x4f,
+	0x54, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x04, 0x12, 0x15, 0x0a, 0x11, 0x43, 0x4f, 0x4e,
+	0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10, 0x05,
+	0x12, 0x21, 0x0a, 0x1d, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45,
+	0x53, 0x54, 0x4f, 0x52, 0x45, 0x5f, 0x44, 0x52, 0x49, 0x4c, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f,
+	0x52, 0x10, 0x06, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x4c, 0x4f, 0x57, 0x5f, 0x44, 0x49, 0x53, 0x4b, 0x5f, 0x53, 0x50, 0x41, 0x43, 0x45, 0x10,
+	0x07, 0x12, 0x1c, 0x0a, 0x18, 0x43, 0x4f, 0x4e, 0x44, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x42,
+	0x41, 0x43, 0x4b, 0x55, 0x50, 0x5f, 0x41, 0x4e, 0x4f, 0x4d, 0x41, 0x4c, 0x59, 0x10, 0x08, 0x42,
+	0x08, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x67, 0x0a, 0x0a, 0x53, 0x65, 0x6c,
+	0x66, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x65, 0x70, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x63,
+	0x72, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x72, 0x6f, 0x6e, 0x12,
+	0x31, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x09, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0xb1, 0x01, 0x0a, 0x03, 0x54, 0x6c, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x65,
+	0x72, 0x74, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63,
+	0x65, 0x72, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x5f, 0x66,
+	0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x46, 0x69,
+	0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x04, 0x61, 0x63, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x6d, 0x65, 0x52, 0x04, 0x61, 0x63, 0x6d, 0x65,
+	0x12, 0x24, 0x0a, 0x0e, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x61, 0x5f, 0x66, 0x69,
+	0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x43, 0x61, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x2e, 0x0a, 0x13, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x5f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x11, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x43, 0x65, 0x72, 0x74, 0x22, 0x91, 0x01, 0x0a, 0x04, 0x41, 0x63, 0x6d, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x07, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61,
+	0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12,
+	0x23, 0x0a, 0x0d, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x75, 0x72, 0x6c,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x79, 0x55, 0x72, 0x6c, 0x12, 0x34, 0x0a, 0x16, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x63, 0x68, 0x61,
+	0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x68, 0x74, 0x74, 0x70, 0x43, 0x68, 0x61, 0x6c, 0x6c, 0x65,
+	0x6e, 0x67, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xee, 0x01, 0x0a, 0x04, 0x4d,
+	0x71, 0x74, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x72, 0x6f, 0x6b, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x72, 0x6f, 0x6b, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x50, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79,
+	0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64,
+	0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x2b,
+	0x0a, 0x11, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x64, 0x69, 0x73, 0x61, 0x62,
+	0x6c, 0x65, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x22, 0xc2, 0x01, 0x0a, 0x04,
+	0x41, 0x75, 0x74, 0x68, 0x12, 0x1e, 0x0a, 0x05, 0x75, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x08, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x75,
+	0x73, 0x65, 0x72, 0x73, 0x12, 0x36, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x5f, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x72, 0x6f, 0x78, 0x79, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68, 0x52,
+	0x0b, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x04,
+	0x6f, 0x69, 0x64, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x76, 0x31, 0x2e,
+	0x4f, 0x69, 0x64, 0x63, 0x41, 0x75, 0x74, 0x68, 0x52, 0x04, 0x6f, 0x69, 0x64, 0x63, 0x12, 0x40,
+	0x0a, 0x11, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x6d, 0x61, 0x70, 0x70, 0x69,
+	0x6e, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x76, 0x31, 0x2e, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x10,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x73,
+	0x22, 0x9a, 0x01, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x41, 0x75, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1f,
+	0x0a, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12,
+	0x23, 0x0a, 0x0d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x5f,
+	0x70, 0x72, 0x6f, 0x78, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x74,
+	0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x78, 0x69, 0x65, 0x73, 0x22, 0xe9, 0x01,
+	0x0a, 0x08, 0x4f, 0x69, 0x64, 0x63, 0x41, 0x75, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x73,
+	0x73, 0x75, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75,
+	0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
+	0x23, 0x0a, 0x0d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x55, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73, 0x12,
+	0x25, 0x0a, 0x0e, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x63, 0x6c, 0x61, 0x69,
+	0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
+	0x65, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x12, 0x21, 0x0a, 0x0c, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x5f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x22, 0x6d, 0x0a, 0x0f, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x25, 0x0a, 0x0d,
+	0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0c, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x55,
+	0x73, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x42,
+	0x07, 0x0a, 0x05, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x22, 0x88, 0x01, 0x0a, 0x04, 0x55, 0x73, 0x65,
+	0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x0f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x5f, 0x62, 0x63, 0x72, 0x79, 0x70, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
+	0x52, 0x0e, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x42, 0x63, 0x72, 0x79, 0x70, 0x74,
+	0x12, 0x35, 0x0a, 0x0d, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x0c, 0x73, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x0a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x22, 0xca, 0x01, 0x0a, 0x0c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x46, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64,
+	0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x70, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x70, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x12, 0x49, 0x0a, 0x14, 0x77, 0x65, 0x62, 0x61, 0x75, 0x74, 0x68, 0x6e, 0x5f, 0x63, 0x72,
+	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x65, 0x62, 0x41, 0x75, 0x74, 0x68, 0x6e, 0x43, 0x72, 0x65,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x13, 0x77, 0x65, 0x62, 0x61, 0x75, 0x74, 0x68,
+	0x6e, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x32, 0x0a, 0x15,
+	0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x5f, 0x62,
+	0x63, 0x72, 0x79, 0x70, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x72, 0x65, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x42, 0x63, 0x72, 0x79, 0x70, 0x74,
+	0x22, 0x8b, 0x01, 0x0a, 0x12, 0x57, 0x65, 0x62, 0x41, 0x75, 0x74, 0x68, 0x6e, 0x43, 0x72, 0x65,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x13, 0x0a, 0x05, 0x72, 0x70, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x70, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x69, 0x67, 0x6e, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x09, 0x73, 0x69, 0x67, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x2c,
+	0x5a, 0x2a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x61, 0x72,
+	0x65, 0x74, 0x68, 0x67, 0x65, 0x6f, 0x72, 0x67, 0x65, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x72, 0x65,
+	0x73, 0x74, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1390,46 +4767,127 @@ func file_v1_config_proto_rawDescGZIP() []byte {
 	return file_v1_config_proto_rawDescData
 }
 
-var file_v1_config_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_v1_config_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_v1_config_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_v1_config_proto_msgTypes = make([]protoimpl.MessageInfo, 51)
 var file_v1_config_proto_goTypes = []interface{}{
-	(Hook_Condition)(0),     // 0: v1.Hook.Condition
-	(*Config)(nil),          // 1: v1.Config
-	(*Repo)(nil),            // 2: v1.Repo
-	(*Plan)(nil),            // 3: v1.Plan
-	(*RetentionPolicy)(nil), // 4: v1.RetentionPolicy
-	(*PrunePolicy)(nil),     // 5: v1.PrunePolicy
-	(*Hook)(nil),            // 6: v1.Hook
-	(*Auth)(nil),            // 7: v1.Auth
-	(*User)(nil),            // 8: v1.User
-	(*RetentionPolicy_TimeBucketedCounts)(nil), // 9: v1.RetentionPolicy.TimeBucketedCounts
-	(*Hook_Command)(nil),                       // 10: v1.Hook.Command
-	(*Hook_Webhook)(nil),                       // 11: v1.Hook.Webhook
-	(*Hook_Discord)(nil),                       // 12: v1.Hook.Discord
-	(*Hook_Gotify)(nil),                        // 13: v1.Hook.Gotify
-	(*Hook_Slack)(nil),                         // 14: v1.Hook.Slack
+	(ResourceLimits_IoClass)(0),                // 0: v1.ResourceLimits.IoClass
+	(Hook_Condition)(0),                        // 1: v1.Hook.Condition
+	(*Config)(nil),                             // 2: v1.Config
+	(*PlanTemplate)(nil),                       // 3: v1.PlanTemplate
+	(*ResticBinary)(nil),                       // 4: v1.ResticBinary
+	(*EmergencyStop)(nil),                      // 5: v1.EmergencyStop
+	(*Notifications)(nil),                      // 6: v1.Notifications
+	(*RemoteInstance)(nil),                     // 7: v1.RemoteInstance
+	(*ConfigRevision)(nil),                     // 8: v1.ConfigRevision
+	(*ConfigRevisionList)(nil),                 // 9: v1.ConfigRevisionList
+	(*Repo)(nil),                               // 10: v1.Repo
+	(*DelayedDeletion)(nil),                    // 11: v1.DelayedDeletion
+	(*AppendOnly)(nil),                         // 12: v1.AppendOnly
+	(*ColdStorage)(nil),                        // 13: v1.ColdStorage
+	(*BackendOptions)(nil),                     // 14: v1.BackendOptions
+	(*CachePolicy)(nil),                        // 15: v1.CachePolicy
+	(*DiskSpace)(nil),                          // 16: v1.DiskSpace
+	(*ResticFlag)(nil),                         // 17: v1.ResticFlag
+	(*Plan)(nil),                               // 18: v1.Plan
+	(*WebhookTrigger)(nil),                     // 19: v1.WebhookTrigger
+	(*AnomalyDetection)(nil),                   // 20: v1.AnomalyDetection
+	(*PlanDependencies)(nil),                   // 21: v1.PlanDependencies
+	(*PathOptions)(nil),                        // 22: v1.PathOptions
+	(*RestoreDrill)(nil),                       // 23: v1.RestoreDrill
+	(*ResourceLimits)(nil),                     // 24: v1.ResourceLimits
+	(*Preconditions)(nil),                      // 25: v1.Preconditions
+	(*TaskTimeouts)(nil),                       // 26: v1.TaskTimeouts
+	(*RetentionPolicy)(nil),                    // 27: v1.RetentionPolicy
+	(*PrunePolicy)(nil),                        // 28: v1.PrunePolicy
+	(*Hook)(nil),                               // 29: v1.Hook
+	(*SelfBackup)(nil),                         // 30: v1.SelfBackup
+	(*Tls)(nil),                                // 31: v1.Tls
+	(*Acme)(nil),                               // 32: v1.Acme
+	(*Mqtt)(nil),                               // 33: v1.Mqtt
+	(*Auth)(nil),                               // 34: v1.Auth
+	(*ProxyHeaderAuth)(nil),                    // 35: v1.ProxyHeaderAuth
+	(*OidcAuth)(nil),                           // 36: v1.OidcAuth
+	(*IdentityMapping)(nil),                    // 37: v1.IdentityMapping
+	(*User)(nil),                               // 38: v1.User
+	(*SecondFactor)(nil),                       // 39: v1.SecondFactor
+	(*WebAuthnCredential)(nil),                 // 40: v1.WebAuthnCredential
+	nil,                                        // 41: v1.Notifications.TemplatesEntry
+	(*BackendOptions_S3)(nil),                  // 42: v1.BackendOptions.S3
+	(*BackendOptions_B2)(nil),                  // 43: v1.BackendOptions.B2
+	(*BackendOptions_Azure)(nil),               // 44: v1.BackendOptions.Azure
+	(*RetentionPolicy_TimeBucketedCounts)(nil), // 45: v1.RetentionPolicy.TimeBucketedCounts
+	(*Hook_Command)(nil),                       // 46: v1.Hook.Command
+	(*Hook_Webhook)(nil),                       // 47: v1.Hook.Webhook
+	(*Hook_Discord)(nil),                       // 48: v1.Hook.Discord
+	(*Hook_Gotify)(nil),                        // 49: v1.Hook.Gotify
+	(*Hook_Slack)(nil),                         // 50: v1.Hook.Slack
+	(*Hook_Plugin)(nil),                        // 51: v1.Hook.Plugin
+	nil,                                        // 52: v1.Hook.Plugin.SettingsEntry
+	(ErrorClass)(0),                            // 53: v1.ErrorClass
 }
 var file_v1_config_proto_depIdxs = []int32{
-	2,  // 0: v1.Config.repos:type_name -> v1.Repo
-	3,  // 1: v1.Config.plans:type_name -> v1.Plan
-	7,  // 2: v1.Config.auth:type_name -> v1.Auth
-	5,  // 3: v1.Repo.prune_policy:type_name -> v1.PrunePolicy
-	6,  // 4: v1.Repo.hooks:type_name -> v1.Hook
-	4,  // 5: v1.Plan.retention:type_name -> v1.RetentionPolicy
-	6,  // 6: v1.Plan.hooks:type_name -> v1.Hook
-	9,  // 7: v1.RetentionPolicy.policy_time_bucketed:type_name -> v1.RetentionPolicy.TimeBucketedCounts
-	0,  // 8: v1.Hook.conditions:type_name -> v1.Hook.Condition
-	10, // 9: v1.Hook.action_command:type_name -> v1.Hook.Command
-	11, // 10: v1.Hook.action_webhook:type_name -> v1.Hook.Webhook
-	12, // 11: v1.Hook.action_discord:type_name -> v1.Hook.Discord
-	13, // 12: v1.Hook.action_gotify:type_name -> v1.Hook.Gotify
-	14, // 13: v1.Hook.action_slack:type_name -> v1.Hook.Slack
-	8,  // 14: v1.Auth.users:type_name -> v1.User
-	15, // [15:15] is the sub-list for method output_type
-	15, // [15:15] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	10, // 0: v1.Config.repos:type_name -> v1.Repo
+	18, // 1: v1.Config.plans:type_name -> v1.Plan
+	34, // 2: v1.Config.auth:type_name -> v1.Auth
+	33, // 3: v1.Config.mqtt:type_name -> v1.Mqtt
+	30, // 4: v1.Config.self_backup:type_name -> v1.SelfBackup
+	31, // 5: v1.Config.tls:type_name -> v1.Tls
+	7,  // 6: v1.Config.remote_instances:type_name -> v1.RemoteInstance
+	6,  // 7: v1.Config.notifications:type_name -> v1.Notifications
+	5,  // 8: v1.Config.emergency_stop:type_name -> v1.EmergencyStop
+	3,  // 9: v1.Config.plan_templates:type_name -> v1.PlanTemplate
+	4,  // 10: v1.Config.restic_binary:type_name -> v1.ResticBinary
+	18, // 11: v1.PlanTemplate.plan:type_name -> v1.Plan
+	41, // 12: v1.Notifications.templates:type_name -> v1.Notifications.TemplatesEntry
+	2,  // 13: v1.ConfigRevision.config:type_name -> v1.Config
+	8,  // 14: v1.ConfigRevisionList.revisions:type_name -> v1.ConfigRevision
+	28, // 15: v1.Repo.prune_policy:type_name -> v1.PrunePolicy
+	29, // 16: v1.Repo.hooks:type_name -> v1.Hook
+	17, // 17: v1.Repo.extra_flags:type_name -> v1.ResticFlag
+	16, // 18: v1.Repo.disk_space:type_name -> v1.DiskSpace
+	15, // 19: v1.Repo.cache_policy:type_name -> v1.CachePolicy
+	14, // 20: v1.Repo.backend_options:type_name -> v1.BackendOptions
+	13, // 21: v1.Repo.cold_storage:type_name -> v1.ColdStorage
+	12, // 22: v1.Repo.append_only:type_name -> v1.AppendOnly
+	11, // 23: v1.Repo.delayed_deletion:type_name -> v1.DelayedDeletion
+	42, // 24: v1.BackendOptions.s3:type_name -> v1.BackendOptions.S3
+	43, // 25: v1.BackendOptions.b2:type_name -> v1.BackendOptions.B2
+	44, // 26: v1.BackendOptions.azure:type_name -> v1.BackendOptions.Azure
+	27, // 27: v1.Plan.retention:type_name -> v1.RetentionPolicy
+	29, // 28: v1.Plan.hooks:type_name -> v1.Hook
+	26, // 29: v1.Plan.timeouts:type_name -> v1.TaskTimeouts
+	25, // 30: v1.Plan.preconditions:type_name -> v1.Preconditions
+	24, // 31: v1.Plan.resource_limits:type_name -> v1.ResourceLimits
+	23, // 32: v1.Plan.restore_drill:type_name -> v1.RestoreDrill
+	17, // 33: v1.Plan.extra_flags:type_name -> v1.ResticFlag
+	22, // 34: v1.Plan.path_options:type_name -> v1.PathOptions
+	21, // 35: v1.Plan.dependencies:type_name -> v1.PlanDependencies
+	20, // 36: v1.Plan.anomaly_detection:type_name -> v1.AnomalyDetection
+	19, // 37: v1.Plan.webhook_trigger:type_name -> v1.WebhookTrigger
+	0,  // 38: v1.ResourceLimits.io_class:type_name -> v1.ResourceLimits.IoClass
+	53, // 39: v1.TaskTimeouts.retry_on:type_name -> v1.ErrorClass
+	45, // 40: v1.RetentionPolicy.policy_time_bucketed:type_name -> v1.RetentionPolicy.TimeBucketedCounts
+	1,  // 41: v1.Hook.conditions:type_name -> v1.Hook.Condition
+	46, // 42: v1.Hook.action_command:type_name -> v1.Hook.Command
+	47, // 43: v1.Hook.action_webhook:type_name -> v1.Hook.Webhook
+	48, // 44: v1.Hook.action_discord:type_name -> v1.Hook.Discord
+	49, // 45: v1.Hook.action_gotify:type_name -> v1.Hook.Gotify
+	50, // 46: v1.Hook.action_slack:type_name -> v1.Hook.Slack
+	51, // 47: v1.Hook.action_plugin:type_name -> v1.Hook.Plugin
+	27, // 48: v1.SelfBackup.retention:type_name -> v1.RetentionPolicy
+	32, // 49: v1.Tls.acme:type_name -> v1.Acme
+	38, // 50: v1.Auth.users:type_name -> v1.User
+	35, // 51: v1.Auth.proxy_header:type_name -> v1.ProxyHeaderAuth
+	36, // 52: v1.Auth.oidc:type_name -> v1.OidcAuth
+	37, // 53: v1.Auth.identity_mappings:type_name -> v1.IdentityMapping
+	39, // 54: v1.User.second_factor:type_name -> v1.SecondFactor
+	40, // 55: v1.SecondFactor.webauthn_credentials:type_name -> v1.WebAuthnCredential
+	52, // 56: v1.Hook.Plugin.settings:type_name -> v1.Hook.Plugin.SettingsEntry
+	57, // [57:57] is the sub-list for method output_type
+	57, // [57:57] is the sub-list for method input_type
+	57, // [57:57] is the sub-list for extension type_name
+	57, // [57:57] is the sub-list for extension extendee
+	0,  // [0:57] is the sub-list for field type_name
 }
 
 func init() { file_v1_config_proto_init() }
@@ -1437,6 +4895,7 @@ func file_v1_config_proto_init() {
 	if File_v1_config_proto != nil {
 		return
 	}
+	file_v1_restic_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_v1_config_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Config); i {
@@ -1450,8 +4909,152 @@ func file_v1_config_proto_init() {
 				return nil
 			}
 		}
-		file_v1_config_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Repo); i {
+		file_v1_config_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlanTemplate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_config_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResticBinary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_config_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EmergencyStop); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_config_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Notifications); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_config_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoteInstance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_config_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigRevision); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_config_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigRevisionList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_config_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Repo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_config_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DelayedDeletion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_config_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppendOnly); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_config_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ColdStorage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_config_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackendOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_config_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CachePolicy); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1462,7 +5065,31 @@ func file_v1_config_proto_init() {
 				return nil
 			}
 		}
-		file_v1_config_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+		file_v1_config_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskSpace); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_config_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResticFlag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_config_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Plan); i {
 			case 0:
 				return &v.state
@@ -1474,7 +5101,103 @@ func file_v1_config_proto_init() {
 				return nil
 			}
 		}
-		file_v1_config_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+		file_v1_config_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebhookTrigger); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_config_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AnomalyDetection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_config_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlanDependencies); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_config_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PathOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_config_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreDrill); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_config_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResourceLimits); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_config_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Preconditions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_config_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TaskTimeouts); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_config_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RetentionPolicy); i {
 			case 0:
 				return &v.state
@@ -1486,7 +5209,7 @@ func file_v1_config_proto_init() {
 				return nil
 			}
 		}
-		file_v1_config_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+		file_v1_config_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PrunePolicy); i {
 			case 0:
 				return &v.state
@@ -1498,7 +5221,7 @@ func file_v1_config_proto_init() {
 				return nil
 			}
 		}
-		file_v1_config_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+		file_v1_config_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Hook); i {
 			case 0:
 				return &v.state
@@ -1510,7 +5233,55 @@ func file_v1_config_proto_init() {
 				return nil
 			}
 		}
-		file_v1_config_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+		file_v1_config_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SelfBackup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_config_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Tls); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_config_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Acme); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_config_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Mqtt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_config_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Auth); i {
 			case 0:
 				return &v.state
@@ -1522,7 +5293,43 @@ func file_v1_config_proto_init() {
 				return nil
 			}
 		}
-		file_v1_config_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+		file_v1_config_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProxyHeaderAuth); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_config_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OidcAuth); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_config_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*IdentityMapping); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_config_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*User); i {
 			case 0:
 				return &v.state
@@ -1534,7 +5341,67 @@ func file_v1_config_proto_init() {
 				return nil
 			}
 		}
-		file_v1_config_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+		file_v1_config_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SecondFactor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_config_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebAuthnCredential); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_config_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackendOptions_S3); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_config_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackendOptions_B2); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_config_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackendOptions_Azure); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_config_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RetentionPolicy_TimeBucketedCounts); i {
 			case 0:
 				return &v.state
@@ -1546,7 +5413,7 @@ func file_v1_config_proto_init() {
 				return nil
 			}
 		}
-		file_v1_config_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_v1_config_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Hook_Command); i {
 			case 0:
 				return &v.state
@@ -1558,7 +5425,7 @@ func file_v1_config_proto_init() {
 				return nil
 			}
 		}
-		file_v1_config_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_v1_config_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Hook_Webhook); i {
 			case 0:
 				return &v.state
@@ -1570,7 +5437,7 @@ func file_v1_config_proto_init() {
 				return nil
 			}
 		}
-		file_v1_config_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_v1_config_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Hook_Discord); i {
 			case 0:
 				return &v.state
@@ -1582,7 +5449,7 @@ func file_v1_config_proto_init() {
 				return nil
 			}
 		}
-		file_v1_config_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_v1_config_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Hook_Gotify); i {
 			case 0:
 				return &v.state
@@ -1594,7 +5461,7 @@ func file_v1_config_proto_init() {
 				return nil
 			}
 		}
-		file_v1_config_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_v1_config_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Hook_Slack); i {
 			case 0:
 				return &v.state
@@ -1606,20 +5473,42 @@ func file_v1_config_proto_init() {
 				return nil
 			}
 		}
+		file_v1_config_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Hook_Plugin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_v1_config_proto_msgTypes[3].OneofWrappers = []interface{}{
+	file_v1_config_proto_msgTypes[12].OneofWrappers = []interface{}{
+		(*BackendOptions_S3_)(nil),
+		(*BackendOptions_B2_)(nil),
+		(*BackendOptions_Azure_)(nil),
+	}
+	file_v1_config_proto_msgTypes[25].OneofWrappers = []interface{}{
 		(*RetentionPolicy_PolicyKeepLastN)(nil),
 		(*RetentionPolicy_PolicyTimeBucketed)(nil),
 		(*RetentionPolicy_PolicyKeepAll)(nil),
 	}
-	file_v1_config_proto_msgTypes[5].OneofWrappers = []interface{}{
+	file_v1_config_proto_msgTypes[27].OneofWrappers = []interface{}{
 		(*Hook_ActionCommand)(nil),
 		(*Hook_ActionWebhook)(nil),
 		(*Hook_ActionDiscord)(nil),
 		(*Hook_ActionGotify)(nil),
 		(*Hook_ActionSlack)(nil),
+		(*Hook_ActionPlugin)(nil),
+	}
+	file_v1_config_proto_msgTypes[35].OneofWrappers = []interface{}{
+		(*IdentityMapping_ExternalUser)(nil),
+		(*IdentityMapping_Group)(nil),
 	}
-	file_v1_config_proto_msgTypes[7].OneofWrappers = []interface{}{
+	file_v1_config_proto_msgTypes[36].OneofWrappers = []interface{}{
 		(*User_PasswordBcrypt)(nil),
 	}
 	type x struct{}
@@ -1627,8 +5516,8 @@ func file_v1_config_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_v1_config_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   14,
+			NumEnums:      2,
+			NumMessages:   51,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
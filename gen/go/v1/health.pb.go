@@ -0,0 +1,554 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        (unknown)
+// source: v1/health.proto
+
+package v1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// RepoHealth aggregates the state of a repo relevant to its long term health.
+type RepoHealth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RepoId              string           `protobuf:"bytes,1,opt,name=repo_id,json=repoId,proto3" json:"repo_id,omitempty"`
+	LastBackup          *Operation       `protobuf:"bytes,2,opt,name=last_backup,json=lastBackup,proto3" json:"last_backup,omitempty"`                               // most recent completed backup operation, if any.
+	LastPrune           *Operation       `protobuf:"bytes,3,opt,name=last_prune,json=lastPrune,proto3" json:"last_prune,omitempty"`                                  // most recent completed prune operation, if any.
+	LastStats           *Operation       `protobuf:"bytes,4,opt,name=last_stats,json=lastStats,proto3" json:"last_stats,omitempty"`                                  // most recent completed stats operation, if any.
+	Check               *RepoCheckResult `protobuf:"bytes,5,opt,name=check,proto3" json:"check,omitempty"`                                                           // set if a check was requested.
+	Locks               []*RepoLock      `protobuf:"bytes,6,rep,name=locks,proto3" json:"locks,omitempty"`                                                           // locks currently held in the repo.
+	StaleLockCount      int64            `protobuf:"varint,7,opt,name=stale_lock_count,json=staleLockCount,proto3" json:"stale_lock_count,omitempty"`                // number of locks older than restic's stale lock timeout.
+	UnusedBytesEstimate int64            `protobuf:"varint,8,opt,name=unused_bytes_estimate,json=unusedBytesEstimate,proto3" json:"unused_bytes_estimate,omitempty"` // bytes a prune would remove, only set if an estimate was requested.
+	RawDataSize         int64            `protobuf:"varint,9,opt,name=raw_data_size,json=rawDataSize,proto3" json:"raw_data_size,omitempty"`                         // size of the deduplicated data referenced by snapshots.
+	RestoreSize         int64            `protobuf:"varint,10,opt,name=restore_size,json=restoreSize,proto3" json:"restore_size,omitempty"`                          // size of all snapshots if they were restored.
+	DedupRatio          float64          `protobuf:"fixed64,11,opt,name=dedup_ratio,json=dedupRatio,proto3" json:"dedup_ratio,omitempty"`                            // restore_size / raw_data_size.
+}
+
+func (x *RepoHealth) Reset() {
+	*x = RepoHealth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_health_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepoHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepoHealth) ProtoMessage() {}
+
+func (x *RepoHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_health_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepoHealth.ProtoReflect.Descriptor instead.
+func (*RepoHealth) Descriptor() ([]byte, []int) {
+	return file_v1_health_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RepoHealth) GetRepoId() string {
+	if x != nil {
+		return x.RepoId
+	}
+	return ""
+}
+
+func (x *RepoHealth) GetLastBackup() *Operation {
+	if x != nil {
+		return x.LastBackup
+	}
+	return nil
+}
+
+func (x *RepoHealth) GetLastPrune() *Operation {
+	if x != nil {
+		return x.LastPrune
+	}
+	return nil
+}
+
+func (x *RepoHealth) GetLastStats() *Operation {
+	if x != nil {
+		return x.LastStats
+	}
+	return nil
+}
+
+func (x *RepoHealth) GetCheck() *RepoCheckResult {
+	if x != nil {
+		return x.Check
+	}
+	return nil
+}
+
+func (x *RepoHealth) GetLocks() []*RepoLock {
+	if x != nil {
+		return x.Locks
+	}
+	return nil
+}
+
+func (x *RepoHealth) GetStaleLockCount() int64 {
+	if x != nil {
+		return x.StaleLockCount
+	}
+	return 0
+}
+
+func (x *RepoHealth) GetUnusedBytesEstimate() int64 {
+	if x != nil {
+		return x.UnusedBytesEstimate
+	}
+	return 0
+}
+
+func (x *RepoHealth) GetRawDataSize() int64 {
+	if x != nil {
+		return x.RawDataSize
+	}
+	return 0
+}
+
+func (x *RepoHealth) GetRestoreSize() int64 {
+	if x != nil {
+		return x.RestoreSize
+	}
+	return 0
+}
+
+func (x *RepoHealth) GetDedupRatio() float64 {
+	if x != nil {
+		return x.DedupRatio
+	}
+	return 0
+}
+
+// RepoCacheStats describes the restic cache of a repo.
+type RepoCacheStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RepoId         string `protobuf:"bytes,1,opt,name=repo_id,json=repoId,proto3" json:"repo_id,omitempty"`
+	Path           string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`                                              // directory of the repo's cache, empty if the repo doesn't use one.
+	SizeBytes      int64  `protobuf:"varint,3,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`                  // size of the repo's cache directory.
+	TotalSizeBytes int64  `protobuf:"varint,4,opt,name=total_size_bytes,json=totalSizeBytes,proto3" json:"total_size_bytes,omitempty"` // size of the cache directory shared by all repos using it.
+}
+
+func (x *RepoCacheStats) Reset() {
+	*x = RepoCacheStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_health_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepoCacheStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepoCacheStats) ProtoMessage() {}
+
+func (x *RepoCacheStats) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_health_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepoCacheStats.ProtoReflect.Descriptor instead.
+func (*RepoCacheStats) Descriptor() ([]byte, []int) {
+	return file_v1_health_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RepoCacheStats) GetRepoId() string {
+	if x != nil {
+		return x.RepoId
+	}
+	return ""
+}
+
+func (x *RepoCacheStats) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *RepoCacheStats) GetSizeBytes() int64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *RepoCacheStats) GetTotalSizeBytes() int64 {
+	if x != nil {
+		return x.TotalSizeBytes
+	}
+	return 0
+}
+
+type RepoCheckResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ok         bool   `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	Output     string `protobuf:"bytes,2,opt,name=output,proto3" json:"output,omitempty"`
+	UnixTimeMs int64  `protobuf:"varint,3,opt,name=unix_time_ms,json=unixTimeMs,proto3" json:"unix_time_ms,omitempty"`
+}
+
+func (x *RepoCheckResult) Reset() {
+	*x = RepoCheckResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_health_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepoCheckResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepoCheckResult) ProtoMessage() {}
+
+func (x *RepoCheckResult) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_health_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepoCheckResult.ProtoReflect.Descriptor instead.
+func (*RepoCheckResult) Descriptor() ([]byte, []int) {
+	return file_v1_health_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RepoCheckResult) GetOk() bool {
+	if x != nil {
+		return x.Ok
+	}
+	return false
+}
+
+func (x *RepoCheckResult) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+func (x *RepoCheckResult) GetUnixTimeMs() int64 {
+	if x != nil {
+		return x.UnixTimeMs
+	}
+	return 0
+}
+
+type RepoLock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UnixTimeMs int64  `protobuf:"varint,2,opt,name=unix_time_ms,json=unixTimeMs,proto3" json:"unix_time_ms,omitempty"`
+	Exclusive  bool   `protobuf:"varint,3,opt,name=exclusive,proto3" json:"exclusive,omitempty"`
+	Hostname   string `protobuf:"bytes,4,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Username   string `protobuf:"bytes,5,opt,name=username,proto3" json:"username,omitempty"`
+	Pid        int64  `protobuf:"varint,6,opt,name=pid,proto3" json:"pid,omitempty"`
+	Stale      bool   `protobuf:"varint,7,opt,name=stale,proto3" json:"stale,omitempty"`
+}
+
+func (x *RepoLock) Reset() {
+	*x = RepoLock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_health_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepoLock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepoLock) ProtoMessage() {}
+
+func (x *RepoLock) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_health_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepoLock.ProtoReflect.Descriptor instead.
+func (*RepoLock) Descriptor() ([]byte, []int) {
+	return file_v1_health_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RepoLock) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RepoLock) GetUnixTimeMs() int64 {
+	if x != nil {
+		return x.UnixTimeMs
+	}
+	return 0
+}
+
+func (x *RepoLock) GetExclusive() bool {
+	if x != nil {
+		return x.Exclusive
+	}
+	return false
+}
+
+func (x *RepoLock) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *RepoLock) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *RepoLock) GetPid() int64 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *RepoLock) GetStale() bool {
+	if x != nil {
+		return x.Stale
+	}
+	return false
+}
+
+var File_v1_health_proto protoreflect.FileDescriptor
+
+var file_v1_health_proto_rawDesc = []byte{
+	0x0a, 0x0f, 0x76, 0x31, 0x2f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x02, 0x76, 0x31, 0x1a, 0x13, 0x76, 0x31, 0x2f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc6, 0x03, 0x0a, 0x0a, 0x52,
+	0x65, 0x70, 0x6f, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x65, 0x70,
+	0x6f, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x70, 0x6f,
+	0x49, 0x64, 0x12, 0x2e, 0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x75,
+	0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x42, 0x61, 0x63, 0x6b,
+	0x75, 0x70, 0x12, 0x2c, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x72, 0x75, 0x6e, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x50, 0x72, 0x75, 0x6e, 0x65,
+	0x12, 0x2c, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x29,
+	0x0a, 0x05, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x52, 0x05, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x22, 0x0a, 0x05, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x70, 0x6f, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x05, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x28, 0x0a,
+	0x10, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x4c, 0x6f,
+	0x63, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x75, 0x6e, 0x75, 0x73, 0x65,
+	0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x75, 0x6e, 0x75, 0x73, 0x65, 0x64, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x72,
+	0x61, 0x77, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x72, 0x61, 0x77, 0x44, 0x61, 0x74, 0x61, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x69,
+	0x7a, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x65, 0x64, 0x75, 0x70, 0x5f, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x64, 0x65, 0x64, 0x75, 0x70, 0x52, 0x61,
+	0x74, 0x69, 0x6f, 0x22, 0x86, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x70, 0x6f, 0x43, 0x61, 0x63, 0x68,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x49, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x5b, 0x0a, 0x0f,
+	0x52, 0x65, 0x70, 0x6f, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x02, 0x6f, 0x6b, 0x12,
+	0x16, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x75, 0x6e, 0x69, 0x78, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x75,
+	0x6e, 0x69, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x22, 0xba, 0x01, 0x0a, 0x08, 0x52, 0x65,
+	0x70, 0x6f, 0x4c, 0x6f, 0x63, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0c, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x75, 0x6e,
+	0x69, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x63, 0x6c,
+	0x75, 0x73, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x65, 0x78, 0x63,
+	0x6c, 0x75, 0x73, 0x69, 0x76, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x70, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x70, 0x69, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x6c, 0x65, 0x42, 0x2c, 0x5a, 0x2a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x61, 0x72, 0x65, 0x74, 0x68, 0x67, 0x65, 0x6f, 0x72, 0x67,
+	0x65, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67,
+	0x6f, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_v1_health_proto_rawDescOnce sync.Once
+	file_v1_health_proto_rawDescData = file_v1_health_proto_rawDesc
+)
+
+func file_v1_health_proto_rawDescGZIP() []byte {
+	file_v1_health_proto_rawDescOnce.Do(func() {
+		file_v1_health_proto_rawDescData = protoimpl.X.CompressGZIP(file_v1_health_proto_rawDescData)
+	})
+	return file_v1_health_proto_rawDescData
+}
+
+var file_v1_health_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_v1_health_proto_goTypes = []interface{}{
+	(*RepoHealth)(nil),      // 0: v1.RepoHealth
+	(*RepoCacheStats)(nil),  // 1: v1.RepoCacheStats
+	(*RepoCheckResult)(nil), // 2: v1.RepoCheckResult
+	(*RepoLock)(nil),        // 3: v1.RepoLock
+	(*Operation)(nil),       // 4: v1.Operation
+}
+var file_v1_health_proto_depIdxs = []int32{
+	4, // 0: v1.RepoHealth.last_backup:type_name -> v1.Operation
+	4, // 1: v1.RepoHealth.last_prune:type_name -> v1.Operation
+	4, // 2: v1.RepoHealth.last_stats:type_name -> v1.Operation
+	2, // 3: v1.RepoHealth.check:type_name -> v1.RepoCheckResult
+	3, // 4: v1.RepoHealth.locks:type_name -> v1.RepoLock
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_v1_health_proto_init() }
+func file_v1_health_proto_init() {
+	if File_v1_health_proto != nil {
+		return
+	}
+	file_v1_operations_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_v1_health_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepoHealth); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_health_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepoCacheStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_health_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepoCheckResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_health_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepoLock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_v1_health_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_v1_health_proto_goTypes,
+		DependencyIndexes: file_v1_health_proto_depIdxs,
+		MessageInfos:      file_v1_health_proto_msgTypes,
+	}.Build()
+	File_v1_health_proto = out.File
+	file_v1_health_proto_rawDesc = nil
+	file_v1_health_proto_goTypes = nil
+	file_v1_health_proto_depIdxs = nil
+}
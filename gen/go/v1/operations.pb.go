@@ -20,7 +20,6 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-// OperationEventType indicates whether the operation was created or updated
 type OperationEventType int32
 
 const (
@@ -184,40 +183,20 @@ func (x *OperationList) GetOperations() []*Operation {
 	return nil
 }
 
-type Operation struct {
+// DurationEstimate summarizes how long a plan's recent operations of one type took.
+type DurationEstimate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// required, primary ID of the operation. ID is sequential based on creation time of the operation.
-	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// required, repo id if associated with a repo
-	RepoId string `protobuf:"bytes,2,opt,name=repo_id,json=repoId,proto3" json:"repo_id,omitempty"`
-	// required, plan id if associated with a plan
-	PlanId string `protobuf:"bytes,3,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
-	// optional snapshot id if associated with a snapshot.
-	SnapshotId string          `protobuf:"bytes,8,opt,name=snapshot_id,json=snapshotId,proto3" json:"snapshot_id,omitempty"`
-	Status     OperationStatus `protobuf:"varint,4,opt,name=status,proto3,enum=v1.OperationStatus" json:"status,omitempty"`
-	// required, unix time in milliseconds of the operation's creation (ID is derived from this)
-	UnixTimeStartMs int64 `protobuf:"varint,5,opt,name=unix_time_start_ms,json=unixTimeStartMs,proto3" json:"unix_time_start_ms,omitempty"`
-	// optional, unix time in milliseconds of the operation's completion
-	UnixTimeEndMs int64 `protobuf:"varint,6,opt,name=unix_time_end_ms,json=unixTimeEndMs,proto3" json:"unix_time_end_ms,omitempty"`
-	// optional, human readable context message, typically an error message.
-	DisplayMessage string `protobuf:"bytes,7,opt,name=display_message,json=displayMessage,proto3" json:"display_message,omitempty"`
-	// Types that are assignable to Op:
-	//
-	//	*Operation_OperationBackup
-	//	*Operation_OperationIndexSnapshot
-	//	*Operation_OperationForget
-	//	*Operation_OperationPrune
-	//	*Operation_OperationRestore
-	//	*Operation_OperationStats
-	//	*Operation_OperationRunHook
-	Op isOperation_Op `protobuf_oneof:"op"`
+	OperationType string `protobuf:"bytes,1,opt,name=operation_type,json=operationType,proto3" json:"operation_type,omitempty"` // e.g. "backup", as in QueryOperationsRequest.types.
+	AverageMs     int64  `protobuf:"varint,2,opt,name=average_ms,json=averageMs,proto3" json:"average_ms,omitempty"`
+	MaxMs         int64  `protobuf:"varint,3,opt,name=max_ms,json=maxMs,proto3" json:"max_ms,omitempty"`
+	Samples       int32  `protobuf:"varint,4,opt,name=samples,proto3" json:"samples,omitempty"` // number of completed operations the estimate is based on.
 }
 
-func (x *Operation) Reset() {
-	*x = Operation{}
+func (x *DurationEstimate) Reset() {
+	*x = DurationEstimate{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_v1_operations_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -225,13 +204,13 @@ func (x *Operation) Reset() {
 	}
 }
 
-func (x *Operation) String() string {
+func (x *DurationEstimate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Operation) ProtoMessage() {}
+func (*DurationEstimate) ProtoMessage() {}
 
-func (x *Operation) ProtoReflect() protoreflect.Message {
+func (x *DurationEstimate) ProtoReflect() protoreflect.Message {
 	mi := &file_v1_operations_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -243,196 +222,167 @@ func (x *Operation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Operation.ProtoReflect.Descriptor instead.
-func (*Operation) Descriptor() ([]byte, []int) {
+// Deprecated: Use DurationEstimate.ProtoReflect.Descriptor instead.
+func (*DurationEstimate) Descriptor() ([]byte, []int) {
 	return file_v1_operations_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *Operation) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *Operation) GetRepoId() string {
-	if x != nil {
-		return x.RepoId
-	}
-	return ""
-}
-
-func (x *Operation) GetPlanId() string {
-	if x != nil {
-		return x.PlanId
-	}
-	return ""
-}
-
-func (x *Operation) GetSnapshotId() string {
+func (x *DurationEstimate) GetOperationType() string {
 	if x != nil {
-		return x.SnapshotId
+		return x.OperationType
 	}
 	return ""
 }
 
-func (x *Operation) GetStatus() OperationStatus {
+func (x *DurationEstimate) GetAverageMs() int64 {
 	if x != nil {
-		return x.Status
+		return x.AverageMs
 	}
-	return OperationStatus_STATUS_UNKNOWN
+	return 0
 }
 
-func (x *Operation) GetUnixTimeStartMs() int64 {
+func (x *DurationEstimate) GetMaxMs() int64 {
 	if x != nil {
-		return x.UnixTimeStartMs
+		return x.MaxMs
 	}
 	return 0
 }
 
-func (x *Operation) GetUnixTimeEndMs() int64 {
+func (x *DurationEstimate) GetSamples() int32 {
 	if x != nil {
-		return x.UnixTimeEndMs
+		return x.Samples
 	}
 	return 0
 }
 
-func (x *Operation) GetDisplayMessage() string {
-	if x != nil {
-		return x.DisplayMessage
-	}
-	return ""
-}
+// ResourceUsage is the resources used by the restic processes of an operation, or the total of several operations.
+type ResourceUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (m *Operation) GetOp() isOperation_Op {
-	if m != nil {
-		return m.Op
-	}
-	return nil
+	Processes   int32 `protobuf:"varint,1,opt,name=processes,proto3" json:"processes,omitempty"` // number of restic processes run.
+	CpuUserMs   int64 `protobuf:"varint,2,opt,name=cpu_user_ms,json=cpuUserMs,proto3" json:"cpu_user_ms,omitempty"`
+	CpuSystemMs int64 `protobuf:"varint,3,opt,name=cpu_system_ms,json=cpuSystemMs,proto3" json:"cpu_system_ms,omitempty"`
+	MaxRssBytes int64 `protobuf:"varint,4,opt,name=max_rss_bytes,json=maxRssBytes,proto3" json:"max_rss_bytes,omitempty"` // peak resident memory of the largest process.
+	ReadBytes   int64 `protobuf:"varint,5,opt,name=read_bytes,json=readBytes,proto3" json:"read_bytes,omitempty"`         // read from block devices, reads served from the page cache are not counted.
+	WriteBytes  int64 `protobuf:"varint,6,opt,name=write_bytes,json=writeBytes,proto3" json:"write_bytes,omitempty"`      // written to block devices.
+	// estimated upload of a backup to a remote repo, the packed size of the data it added if restic reports it
+	// (0.17 and later) or else the uncompressed size. Not measured, metadata and protocol overhead aren't counted.
+	UploadBytesEstimate int64 `protobuf:"varint,7,opt,name=upload_bytes_estimate,json=uploadBytesEstimate,proto3" json:"upload_bytes_estimate,omitempty"`
 }
 
-func (x *Operation) GetOperationBackup() *OperationBackup {
-	if x, ok := x.GetOp().(*Operation_OperationBackup); ok {
-		return x.OperationBackup
+func (x *ResourceUsage) Reset() {
+	*x = ResourceUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_operations_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *Operation) GetOperationIndexSnapshot() *OperationIndexSnapshot {
-	if x, ok := x.GetOp().(*Operation_OperationIndexSnapshot); ok {
-		return x.OperationIndexSnapshot
-	}
-	return nil
+func (x *ResourceUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Operation) GetOperationForget() *OperationForget {
-	if x, ok := x.GetOp().(*Operation_OperationForget); ok {
-		return x.OperationForget
-	}
-	return nil
-}
+func (*ResourceUsage) ProtoMessage() {}
 
-func (x *Operation) GetOperationPrune() *OperationPrune {
-	if x, ok := x.GetOp().(*Operation_OperationPrune); ok {
-		return x.OperationPrune
+func (x *ResourceUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_operations_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Operation) GetOperationRestore() *OperationRestore {
-	if x, ok := x.GetOp().(*Operation_OperationRestore); ok {
-		return x.OperationRestore
-	}
-	return nil
+// Deprecated: Use ResourceUsage.ProtoReflect.Descriptor instead.
+func (*ResourceUsage) Descriptor() ([]byte, []int) {
+	return file_v1_operations_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *Operation) GetOperationStats() *OperationStats {
-	if x, ok := x.GetOp().(*Operation_OperationStats); ok {
-		return x.OperationStats
+func (x *ResourceUsage) GetProcesses() int32 {
+	if x != nil {
+		return x.Processes
 	}
-	return nil
+	return 0
 }
 
-func (x *Operation) GetOperationRunHook() *OperationRunHook {
-	if x, ok := x.GetOp().(*Operation_OperationRunHook); ok {
-		return x.OperationRunHook
+func (x *ResourceUsage) GetCpuUserMs() int64 {
+	if x != nil {
+		return x.CpuUserMs
 	}
-	return nil
-}
-
-type isOperation_Op interface {
-	isOperation_Op()
-}
-
-type Operation_OperationBackup struct {
-	OperationBackup *OperationBackup `protobuf:"bytes,100,opt,name=operation_backup,json=operationBackup,proto3,oneof"`
-}
-
-type Operation_OperationIndexSnapshot struct {
-	OperationIndexSnapshot *OperationIndexSnapshot `protobuf:"bytes,101,opt,name=operation_index_snapshot,json=operationIndexSnapshot,proto3,oneof"`
+	return 0
 }
 
-type Operation_OperationForget struct {
-	OperationForget *OperationForget `protobuf:"bytes,102,opt,name=operation_forget,json=operationForget,proto3,oneof"`
+func (x *ResourceUsage) GetCpuSystemMs() int64 {
+	if x != nil {
+		return x.CpuSystemMs
+	}
+	return 0
 }
 
-type Operation_OperationPrune struct {
-	OperationPrune *OperationPrune `protobuf:"bytes,103,opt,name=operation_prune,json=operationPrune,proto3,oneof"`
+func (x *ResourceUsage) GetMaxRssBytes() int64 {
+	if x != nil {
+		return x.MaxRssBytes
+	}
+	return 0
 }
 
-type Operation_OperationRestore struct {
-	OperationRestore *OperationRestore `protobuf:"bytes,104,opt,name=operation_restore,json=operationRestore,proto3,oneof"`
+func (x *ResourceUsage) GetReadBytes() int64 {
+	if x != nil {
+		return x.ReadBytes
+	}
+	return 0
 }
 
-type Operation_OperationStats struct {
-	OperationStats *OperationStats `protobuf:"bytes,105,opt,name=operation_stats,json=operationStats,proto3,oneof"`
+func (x *ResourceUsage) GetWriteBytes() int64 {
+	if x != nil {
+		return x.WriteBytes
+	}
+	return 0
 }
 
-type Operation_OperationRunHook struct {
-	OperationRunHook *OperationRunHook `protobuf:"bytes,106,opt,name=operation_run_hook,json=operationRunHook,proto3,oneof"`
+func (x *ResourceUsage) GetUploadBytesEstimate() int64 {
+	if x != nil {
+		return x.UploadBytesEstimate
+	}
+	return 0
 }
 
-func (*Operation_OperationBackup) isOperation_Op() {}
-
-func (*Operation_OperationIndexSnapshot) isOperation_Op() {}
-
-func (*Operation_OperationForget) isOperation_Op() {}
-
-func (*Operation_OperationPrune) isOperation_Op() {}
-
-func (*Operation_OperationRestore) isOperation_Op() {}
-
-func (*Operation_OperationStats) isOperation_Op() {}
-
-func (*Operation_OperationRunHook) isOperation_Op() {}
-
-// OperationEvent is used in the wireformat to stream operation changes to clients
-type OperationEvent struct {
+// ResourceUsageSummary totals the resource usage of a plan's operations of one type.
+type ResourceUsageSummary struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type      OperationEventType `protobuf:"varint,1,opt,name=type,proto3,enum=v1.OperationEventType" json:"type,omitempty"`
-	Operation *Operation         `protobuf:"bytes,2,opt,name=operation,proto3" json:"operation,omitempty"`
+	OperationType             string         `protobuf:"bytes,1,opt,name=operation_type,json=operationType,proto3" json:"operation_type,omitempty"` // e.g. "backup", as in QueryOperationsRequest.types.
+	Operations                int32          `protobuf:"varint,2,opt,name=operations,proto3" json:"operations,omitempty"`                           // number of operations with recorded usage.
+	Total                     *ResourceUsage `protobuf:"bytes,3,opt,name=total,proto3" json:"total,omitempty"`
+	DurationMs                int64          `protobuf:"varint,4,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`                                                    // total duration of the operations.
+	UploadBytesPerSecEstimate int64          `protobuf:"varint,5,opt,name=upload_bytes_per_sec_estimate,json=uploadBytesPerSecEstimate,proto3" json:"upload_bytes_per_sec_estimate,omitempty"` // average upload rate of the operations with an upload estimate, over their durations.
 }
 
-func (x *OperationEvent) Reset() {
-	*x = OperationEvent{}
+func (x *ResourceUsageSummary) Reset() {
+	*x = ResourceUsageSummary{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_operations_proto_msgTypes[2]
+		mi := &file_v1_operations_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OperationEvent) String() string {
+func (x *ResourceUsageSummary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OperationEvent) ProtoMessage() {}
+func (*ResourceUsageSummary) ProtoMessage() {}
 
-func (x *OperationEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_operations_proto_msgTypes[2]
+func (x *ResourceUsageSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_operations_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -443,51 +393,79 @@ func (x *OperationEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OperationEvent.ProtoReflect.Descriptor instead.
-func (*OperationEvent) Descriptor() ([]byte, []int) {
-	return file_v1_operations_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use ResourceUsageSummary.ProtoReflect.Descriptor instead.
+func (*ResourceUsageSummary) Descriptor() ([]byte, []int) {
+	return file_v1_operations_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *OperationEvent) GetType() OperationEventType {
+func (x *ResourceUsageSummary) GetOperationType() string {
 	if x != nil {
-		return x.Type
+		return x.OperationType
 	}
-	return OperationEventType_EVENT_UNKNOWN
+	return ""
 }
 
-func (x *OperationEvent) GetOperation() *Operation {
+func (x *ResourceUsageSummary) GetOperations() int32 {
 	if x != nil {
-		return x.Operation
+		return x.Operations
+	}
+	return 0
+}
+
+func (x *ResourceUsageSummary) GetTotal() *ResourceUsage {
+	if x != nil {
+		return x.Total
 	}
 	return nil
 }
 
-type OperationBackup struct {
+func (x *ResourceUsageSummary) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *ResourceUsageSummary) GetUploadBytesPerSecEstimate() int64 {
+	if x != nil {
+		return x.UploadBytesPerSecEstimate
+	}
+	return 0
+}
+
+// Run groups the operations of a single trigger e.g. a scheduled backup and the forget, prune, stats and hooks it caused.
+type Run struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LastStatus *BackupProgressEntry   `protobuf:"bytes,3,opt,name=last_status,json=lastStatus,proto3" json:"last_status,omitempty"`
-	Errors     []*BackupProgressError `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"`
+	Id              int64           `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // ID of the run's first operation.
+	PlanId          string          `protobuf:"bytes,2,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	RepoId          string          `protobuf:"bytes,3,opt,name=repo_id,json=repoId,proto3" json:"repo_id,omitempty"`
+	Status          OperationStatus `protobuf:"varint,4,opt,name=status,proto3,enum=v1.OperationStatus" json:"status,omitempty"` // in progress while any operation is, otherwise the worst status of its operations.
+	UnixTimeStartMs int64           `protobuf:"varint,5,opt,name=unix_time_start_ms,json=unixTimeStartMs,proto3" json:"unix_time_start_ms,omitempty"`
+	UnixTimeEndMs   int64           `protobuf:"varint,6,opt,name=unix_time_end_ms,json=unixTimeEndMs,proto3" json:"unix_time_end_ms,omitempty"` // 0 while the run is in progress.
+	Operations      []*Operation    `protobuf:"bytes,7,rep,name=operations,proto3" json:"operations,omitempty"`                                 // oldest first.
+	Partial         bool            `protobuf:"varint,8,opt,name=partial,proto3" json:"partial,omitempty"`                                      // set once the run is done if some of its backups failed while others succeeded, e.g. a plan only reached some of its repos.
 }
 
-func (x *OperationBackup) Reset() {
-	*x = OperationBackup{}
+func (x *Run) Reset() {
+	*x = Run{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_operations_proto_msgTypes[3]
+		mi := &file_v1_operations_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OperationBackup) String() string {
+func (x *Run) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OperationBackup) ProtoMessage() {}
+func (*Run) ProtoMessage() {}
 
-func (x *OperationBackup) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_operations_proto_msgTypes[3]
+func (x *Run) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_operations_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -498,53 +476,1067 @@ func (x *OperationBackup) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OperationBackup.ProtoReflect.Descriptor instead.
-func (*OperationBackup) Descriptor() ([]byte, []int) {
-	return file_v1_operations_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use Run.ProtoReflect.Descriptor instead.
+func (*Run) Descriptor() ([]byte, []int) {
+	return file_v1_operations_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *OperationBackup) GetLastStatus() *BackupProgressEntry {
+func (x *Run) GetId() int64 {
 	if x != nil {
-		return x.LastStatus
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-func (x *OperationBackup) GetErrors() []*BackupProgressError {
+func (x *Run) GetPlanId() string {
 	if x != nil {
-		return x.Errors
+		return x.PlanId
 	}
-	return nil
+	return ""
 }
 
-// OperationIndexSnapshot tracks that a snapshot was detected by backrest.
-type OperationIndexSnapshot struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Snapshot   *ResticSnapshot `protobuf:"bytes,2,opt,name=snapshot,proto3" json:"snapshot,omitempty"`                          // the snapshot that was indexed.
-	Forgot     bool            `protobuf:"varint,3,opt,name=forgot,proto3" json:"forgot,omitempty"`                             // tracks whether this snapshot is forgotten yet.
-	ForgotByOp int64           `protobuf:"varint,4,opt,name=forgot_by_op,json=forgotByOp,proto3" json:"forgot_by_op,omitempty"` // ID of a forget operation that removed this snapshot.
+func (x *Run) GetRepoId() string {
+	if x != nil {
+		return x.RepoId
+	}
+	return ""
+}
+
+func (x *Run) GetStatus() OperationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OperationStatus_STATUS_UNKNOWN
+}
+
+func (x *Run) GetUnixTimeStartMs() int64 {
+	if x != nil {
+		return x.UnixTimeStartMs
+	}
+	return 0
+}
+
+func (x *Run) GetUnixTimeEndMs() int64 {
+	if x != nil {
+		return x.UnixTimeEndMs
+	}
+	return 0
+}
+
+func (x *Run) GetOperations() []*Operation {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+func (x *Run) GetPartial() bool {
+	if x != nil {
+		return x.Partial
+	}
+	return false
+}
+
+type Operation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// required, primary ID of the operation. ID is sequential based on creation time of the operation.
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// required, repo id if associated with a repo
+	RepoId string `protobuf:"bytes,2,opt,name=repo_id,json=repoId,proto3" json:"repo_id,omitempty"`
+	// required, plan id if associated with a plan
+	PlanId string `protobuf:"bytes,3,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	// optional snapshot id if associated with a snapshot.
+	SnapshotId string          `protobuf:"bytes,8,opt,name=snapshot_id,json=snapshotId,proto3" json:"snapshot_id,omitempty"`
+	Status     OperationStatus `protobuf:"varint,4,opt,name=status,proto3,enum=v1.OperationStatus" json:"status,omitempty"`
+	// required, unix time in milliseconds of the operation's creation (ID is derived from this)
+	UnixTimeStartMs int64 `protobuf:"varint,5,opt,name=unix_time_start_ms,json=unixTimeStartMs,proto3" json:"unix_time_start_ms,omitempty"`
+	// optional, unix time in milliseconds of the operation's completion
+	UnixTimeEndMs int64 `protobuf:"varint,6,opt,name=unix_time_end_ms,json=unixTimeEndMs,proto3" json:"unix_time_end_ms,omitempty"`
+	// optional, human readable context message, typically an error message.
+	DisplayMessage string `protobuf:"bytes,7,opt,name=display_message,json=displayMessage,proto3" json:"display_message,omitempty"`
+	// optional, ID of the operation that started the run this operation is part of e.g. the backup that scheduled a
+	// forget. Equal to the operation's own ID for the first operation of a run, 0 for operations recorded before runs.
+	RunId int64 `protobuf:"varint,9,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	// optional, average duration in milliseconds of the plan's recent operations of the same type when this operation
+	// started, 0 without history. Compared with the elapsed time for ETAs and overrun detection.
+	ExpectedDurationMs int64 `protobuf:"varint,10,opt,name=expected_duration_ms,json=expectedDurationMs,proto3" json:"expected_duration_ms,omitempty"`
+	// optional, the kind of failure of an operation with STATUS_ERROR.
+	ErrorClass ErrorClass `protobuf:"varint,11,opt,name=error_class,json=errorClass,proto3,enum=v1.ErrorClass" json:"error_class,omitempty"`
+	// optional, resources used by the restic processes the operation ran.
+	ResourceUsage *ResourceUsage `protobuf:"bytes,12,opt,name=resource_usage,json=resourceUsage,proto3" json:"resource_usage,omitempty"`
+	// optional, set once a user triaged the failed operation. Acknowledged failures no longer mark their plan or repo
+	// as having a problem.
+	Acknowledgement *OperationAcknowledgement `protobuf:"bytes,13,opt,name=acknowledgement,proto3" json:"acknowledgement,omitempty"`
+	// Types that are assignable to Op:
+	//
+	//	*Operation_OperationBackup
+	//	*Operation_OperationIndexSnapshot
+	//	*Operation_OperationForget
+	//	*Operation_OperationPrune
+	//	*Operation_OperationRestore
+	//	*Operation_OperationStats
+	//	*Operation_OperationRunHook
+	//	*Operation_OperationMigrate
+	//	*Operation_OperationRestoreDrill
+	//	*Operation_OperationCacheCleanup
+	//	*Operation_OperationWarmup
+	//	*Operation_OperationBenchmark
+	Op isOperation_Op `protobuf_oneof:"op"`
+}
+
+func (x *Operation) Reset() {
+	*x = Operation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_operations_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Operation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Operation) ProtoMessage() {}
+
+func (x *Operation) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_operations_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Operation.ProtoReflect.Descriptor instead.
+func (*Operation) Descriptor() ([]byte, []int) {
+	return file_v1_operations_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Operation) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Operation) GetRepoId() string {
+	if x != nil {
+		return x.RepoId
+	}
+	return ""
+}
+
+func (x *Operation) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
+func (x *Operation) GetSnapshotId() string {
+	if x != nil {
+		return x.SnapshotId
+	}
+	return ""
+}
+
+func (x *Operation) GetStatus() OperationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OperationStatus_STATUS_UNKNOWN
+}
+
+func (x *Operation) GetUnixTimeStartMs() int64 {
+	if x != nil {
+		return x.UnixTimeStartMs
+	}
+	return 0
+}
+
+func (x *Operation) GetUnixTimeEndMs() int64 {
+	if x != nil {
+		return x.UnixTimeEndMs
+	}
+	return 0
+}
+
+func (x *Operation) GetDisplayMessage() string {
+	if x != nil {
+		return x.DisplayMessage
+	}
+	return ""
+}
+
+func (x *Operation) GetRunId() int64 {
+	if x != nil {
+		return x.RunId
+	}
+	return 0
+}
+
+func (x *Operation) GetExpectedDurationMs() int64 {
+	if x != nil {
+		return x.ExpectedDurationMs
+	}
+	return 0
+}
+
+func (x *Operation) GetErrorClass() ErrorClass {
+	if x != nil {
+		return x.ErrorClass
+	}
+	return ErrorClass_ERROR_CLASS_UNKNOWN
+}
+
+func (x *Operation) GetResourceUsage() *ResourceUsage {
+	if x != nil {
+		return x.ResourceUsage
+	}
+	return nil
+}
+
+func (x *Operation) GetAcknowledgement() *OperationAcknowledgement {
+	if x != nil {
+		return x.Acknowledgement
+	}
+	return nil
+}
+
+func (m *Operation) GetOp() isOperation_Op {
+	if m != nil {
+		return m.Op
+	}
+	return nil
+}
+
+func (x *Operation) GetOperationBackup() *OperationBackup {
+	if x, ok := x.GetOp().(*Operation_OperationBackup); ok {
+		return x.OperationBackup
+	}
+	return nil
+}
+
+func (x *Operation) GetOperationIndexSnapshot() *OperationIndexSnapshot {
+	if x, ok := x.GetOp().(*Operation_OperationIndexSnapshot); ok {
+		return x.OperationIndexSnapshot
+	}
+	return nil
+}
+
+func (x *Operation) GetOperationForget() *OperationForget {
+	if x, ok := x.GetOp().(*Operation_OperationForget); ok {
+		return x.OperationForget
+	}
+	return nil
+}
+
+func (x *Operation) GetOperationPrune() *OperationPrune {
+	if x, ok := x.GetOp().(*Operation_OperationPrune); ok {
+		return x.OperationPrune
+	}
+	return nil
+}
+
+func (x *Operation) GetOperationRestore() *OperationRestore {
+	if x, ok := x.GetOp().(*Operation_OperationRestore); ok {
+		return x.OperationRestore
+	}
+	return nil
+}
+
+func (x *Operation) GetOperationStats() *OperationStats {
+	if x, ok := x.GetOp().(*Operation_OperationStats); ok {
+		return x.OperationStats
+	}
+	return nil
+}
+
+func (x *Operation) GetOperationRunHook() *OperationRunHook {
+	if x, ok := x.GetOp().(*Operation_OperationRunHook); ok {
+		return x.OperationRunHook
+	}
+	return nil
+}
+
+func (x *Operation) GetOperationMigrate() *OperationMigrate {
+	if x, ok := x.GetOp().(*Operation_OperationMigrate); ok {
+		return x.OperationMigrate
+	}
+	return nil
+}
+
+func (x *Operation) GetOperationRestoreDrill() *OperationRestoreDrill {
+	if x, ok := x.GetOp().(*Operation_OperationRestoreDrill); ok {
+		return x.OperationRestoreDrill
+	}
+	return nil
+}
+
+func (x *Operation) GetOperationCacheCleanup() *OperationCacheCleanup {
+	if x, ok := x.GetOp().(*Operation_OperationCacheCleanup); ok {
+		return x.OperationCacheCleanup
+	}
+	return nil
+}
+
+func (x *Operation) GetOperationWarmup() *OperationWarmup {
+	if x, ok := x.GetOp().(*Operation_OperationWarmup); ok {
+		return x.OperationWarmup
+	}
+	return nil
+}
+
+func (x *Operation) GetOperationBenchmark() *OperationBenchmark {
+	if x, ok := x.GetOp().(*Operation_OperationBenchmark); ok {
+		return x.OperationBenchmark
+	}
+	return nil
+}
+
+type isOperation_Op interface {
+	isOperation_Op()
+}
+
+type Operation_OperationBackup struct {
+	OperationBackup *OperationBackup `protobuf:"bytes,100,opt,name=operation_backup,json=operationBackup,proto3,oneof"`
+}
+
+type Operation_OperationIndexSnapshot struct {
+	OperationIndexSnapshot *OperationIndexSnapshot `protobuf:"bytes,101,opt,name=operation_index_snapshot,json=operationIndexSnapshot,proto3,oneof"`
+}
+
+type Operation_OperationForget struct {
+	OperationForget *OperationForget `protobuf:"bytes,102,opt,name=operation_forget,json=operationForget,proto3,oneof"`
+}
+
+type Operation_OperationPrune struct {
+	OperationPrune *OperationPrune `protobuf:"bytes,103,opt,name=operation_prune,json=operationPrune,proto3,oneof"`
+}
+
+type Operation_OperationRestore struct {
+	OperationRestore *OperationRestore `protobuf:"bytes,104,opt,name=operation_restore,json=operationRestore,proto3,oneof"`
+}
+
+type Operation_OperationStats struct {
+	OperationStats *OperationStats `protobuf:"bytes,105,opt,name=operation_stats,json=operationStats,proto3,oneof"`
+}
+
+type Operation_OperationRunHook struct {
+	OperationRunHook *OperationRunHook `protobuf:"bytes,106,opt,name=operation_run_hook,json=operationRunHook,proto3,oneof"`
+}
+
+type Operation_OperationMigrate struct {
+	OperationMigrate *OperationMigrate `protobuf:"bytes,107,opt,name=operation_migrate,json=operationMigrate,proto3,oneof"`
+}
+
+type Operation_OperationRestoreDrill struct {
+	OperationRestoreDrill *OperationRestoreDrill `protobuf:"bytes,108,opt,name=operation_restore_drill,json=operationRestoreDrill,proto3,oneof"`
+}
+
+type Operation_OperationCacheCleanup struct {
+	OperationCacheCleanup *OperationCacheCleanup `protobuf:"bytes,109,opt,name=operation_cache_cleanup,json=operationCacheCleanup,proto3,oneof"`
+}
+
+type Operation_OperationWarmup struct {
+	OperationWarmup *OperationWarmup `protobuf:"bytes,110,opt,name=operation_warmup,json=operationWarmup,proto3,oneof"`
+}
+
+type Operation_OperationBenchmark struct {
+	OperationBenchmark *OperationBenchmark `protobuf:"bytes,111,opt,name=operation_benchmark,json=operationBenchmark,proto3,oneof"`
+}
+
+func (*Operation_OperationBackup) isOperation_Op() {}
+
+func (*Operation_OperationIndexSnapshot) isOperation_Op() {}
+
+func (*Operation_OperationForget) isOperation_Op() {}
+
+func (*Operation_OperationPrune) isOperation_Op() {}
+
+func (*Operation_OperationRestore) isOperation_Op() {}
+
+func (*Operation_OperationStats) isOperation_Op() {}
+
+func (*Operation_OperationRunHook) isOperation_Op() {}
+
+func (*Operation_OperationMigrate) isOperation_Op() {}
+
+func (*Operation_OperationRestoreDrill) isOperation_Op() {}
+
+func (*Operation_OperationCacheCleanup) isOperation_Op() {}
+
+func (*Operation_OperationWarmup) isOperation_Op() {}
+
+func (*Operation_OperationBenchmark) isOperation_Op() {}
+
+// OperationEvent is used in the wireformat to stream operation changes to clients
+type OperationEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type      OperationEventType `protobuf:"varint,1,opt,name=type,proto3,enum=v1.OperationEventType" json:"type,omitempty"`
+	Operation *Operation         `protobuf:"bytes,2,opt,name=operation,proto3" json:"operation,omitempty"`
+}
+
+func (x *OperationEvent) Reset() {
+	*x = OperationEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_operations_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OperationEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperationEvent) ProtoMessage() {}
+
+func (x *OperationEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_operations_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperationEvent.ProtoReflect.Descriptor instead.
+func (*OperationEvent) Descriptor() ([]byte, []int) {
+	return file_v1_operations_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *OperationEvent) GetType() OperationEventType {
+	if x != nil {
+		return x.Type
+	}
+	return OperationEventType_EVENT_UNKNOWN
+}
+
+func (x *OperationEvent) GetOperation() *Operation {
+	if x != nil {
+		return x.Operation
+	}
+	return nil
+}
+
+// OperationEventType indicates whether the operation was created or updated
+type OperationAcknowledgement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User       string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"` // user that acknowledged the failure, empty if authentication is disabled.
+	UnixTimeMs int64  `protobuf:"varint,2,opt,name=unix_time_ms,json=unixTimeMs,proto3" json:"unix_time_ms,omitempty"`
+	Note       string `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"` // optional, e.g. the cause or the follow up.
+}
+
+func (x *OperationAcknowledgement) Reset() {
+	*x = OperationAcknowledgement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_operations_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OperationAcknowledgement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperationAcknowledgement) ProtoMessage() {}
+
+func (x *OperationAcknowledgement) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_operations_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperationAcknowledgement.ProtoReflect.Descriptor instead.
+func (*OperationAcknowledgement) Descriptor() ([]byte, []int) {
+	return file_v1_operations_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *OperationAcknowledgement) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *OperationAcknowledgement) GetUnixTimeMs() int64 {
+	if x != nil {
+		return x.UnixTimeMs
+	}
+	return 0
+}
+
+func (x *OperationAcknowledgement) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type OperationBackup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LastStatus   *BackupProgressEntry   `protobuf:"bytes,3,opt,name=last_status,json=lastStatus,proto3" json:"last_status,omitempty"`
+	Errors       []*BackupProgressError `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"`
+	DataAdded    []*DataAddedEntry      `protobuf:"bytes,5,rep,name=data_added,json=dataAdded,proto3" json:"data_added,omitempty"` // paths that added the most data to the repo, largest first.
+	Warnings     []string               `protobuf:"bytes,6,rep,name=warnings,proto3" json:"warnings,omitempty"`                    // pre-flight warnings e.g. paths spanning network file systems.
+	Verification *RestoreVerification   `protobuf:"bytes,7,opt,name=verification,proto3" json:"verification,omitempty"`            // sampled files of the snapshot compared with the source files, set if the plan verifies backups.
+	Anomaly      *BackupAnomaly         `protobuf:"bytes,8,opt,name=anomaly,proto3" json:"anomaly,omitempty"`                      // set if the backup deviated from the plan's baseline.
+}
+
+func (x *OperationBackup) Reset() {
+	*x = OperationBackup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_operations_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OperationBackup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperationBackup) ProtoMessage() {}
+
+func (x *OperationBackup) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_operations_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperationBackup.ProtoReflect.Descriptor instead.
+func (*OperationBackup) Descriptor() ([]byte, []int) {
+	return file_v1_operations_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *OperationBackup) GetLastStatus() *BackupProgressEntry {
+	if x != nil {
+		return x.LastStatus
+	}
+	return nil
+}
+
+func (x *OperationBackup) GetErrors() []*BackupProgressError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *OperationBackup) GetDataAdded() []*DataAddedEntry {
+	if x != nil {
+		return x.DataAdded
+	}
+	return nil
+}
+
+func (x *OperationBackup) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+func (x *OperationBackup) GetVerification() *RestoreVerification {
+	if x != nil {
+		return x.Verification
+	}
+	return nil
+}
+
+func (x *OperationBackup) GetAnomaly() *BackupAnomaly {
+	if x != nil {
+		return x.Anomaly
+	}
+	return nil
+}
+
+// BackupAnomaly describes how a backup deviated from the baseline of the plan's previous backups.
+type BackupAnomaly struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reasons             []string `protobuf:"bytes,1,rep,name=reasons,proto3" json:"reasons,omitempty"`                                                        // human readable descriptions of each deviation.
+	BaselineDataAdded   int64    `protobuf:"varint,2,opt,name=baseline_data_added,json=baselineDataAdded,proto3" json:"baseline_data_added,omitempty"`        // median data added by the baseline's backups.
+	ChangedFilesPercent float64  `protobuf:"fixed64,3,opt,name=changed_files_percent,json=changedFilesPercent,proto3" json:"changed_files_percent,omitempty"` // percentage of the previously existing files the backup found changed.
+}
+
+func (x *BackupAnomaly) Reset() {
+	*x = BackupAnomaly{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_operations_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackupAnomaly) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackupAnomaly) ProtoMessage() {}
+
+func (x *BackupAnomaly) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_operations_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackupAnomaly.ProtoReflect.Descriptor instead.
+func (*BackupAnomaly) Descriptor() ([]byte, []int) {
+	return file_v1_operations_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BackupAnomaly) GetReasons() []string {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+func (x *BackupAnomaly) GetBaselineDataAdded() int64 {
+	if x != nil {
+		return x.BaselineDataAdded
+	}
+	return 0
+}
+
+func (x *BackupAnomaly) GetChangedFilesPercent() float64 {
+	if x != nil {
+		return x.ChangedFilesPercent
+	}
+	return 0
+}
+
+// DataAddedEntry is a file or directory that contributed to the data added by a backup.
+type DataAddedEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path  string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Bytes int64  `protobuf:"varint,2,opt,name=bytes,proto3" json:"bytes,omitempty"` // data added to the repo after deduplication and compression.
+	Files int64  `protobuf:"varint,3,opt,name=files,proto3" json:"files,omitempty"` // new or modified files beneath the path.
+}
+
+func (x *DataAddedEntry) Reset() {
+	*x = DataAddedEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_operations_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DataAddedEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataAddedEntry) ProtoMessage() {}
+
+func (x *DataAddedEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_operations_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataAddedEntry.ProtoReflect.Descriptor instead.
+func (*DataAddedEntry) Descriptor() ([]byte, []int) {
+	return file_v1_operations_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DataAddedEntry) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *DataAddedEntry) GetBytes() int64 {
+	if x != nil {
+		return x.Bytes
+	}
+	return 0
+}
+
+func (x *DataAddedEntry) GetFiles() int64 {
+	if x != nil {
+		return x.Files
+	}
+	return 0
+}
+
+// OperationIndexSnapshot tracks that a snapshot was detected by backrest.
+type OperationIndexSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Snapshot   *ResticSnapshot `protobuf:"bytes,2,opt,name=snapshot,proto3" json:"snapshot,omitempty"`                          // the snapshot that was indexed.
+	Forgot     bool            `protobuf:"varint,3,opt,name=forgot,proto3" json:"forgot,omitempty"`                             // tracks whether this snapshot is forgotten yet.
+	ForgotByOp int64           `protobuf:"varint,4,opt,name=forgot_by_op,json=forgotByOp,proto3" json:"forgot_by_op,omitempty"` // ID of a forget operation that removed this snapshot.
+	Note       string          `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`                                  // note documenting the snapshot, set with AnnotateSnapshot.
 }
 
 func (x *OperationIndexSnapshot) Reset() {
 	*x = OperationIndexSnapshot{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_operations_proto_msgTypes[4]
+		mi := &file_v1_operations_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OperationIndexSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperationIndexSnapshot) ProtoMessage() {}
+
+func (x *OperationIndexSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_operations_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperationIndexSnapshot.ProtoReflect.Descriptor instead.
+func (*OperationIndexSnapshot) Descriptor() ([]byte, []int) {
+	return file_v1_operations_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *OperationIndexSnapshot) GetSnapshot() *ResticSnapshot {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+func (x *OperationIndexSnapshot) GetForgot() bool {
+	if x != nil {
+		return x.Forgot
+	}
+	return false
+}
+
+func (x *OperationIndexSnapshot) GetForgotByOp() int64 {
+	if x != nil {
+		return x.ForgotByOp
+	}
+	return 0
+}
+
+func (x *OperationIndexSnapshot) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+// OperationForget tracks a forget operation.
+type OperationForget struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Forget                   []*ResticSnapshot `protobuf:"bytes,1,rep,name=forget,proto3" json:"forget,omitempty"`
+	Policy                   *RetentionPolicy  `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`
+	ReclaimableBytesEstimate int64             `protobuf:"varint,3,opt,name=reclaimable_bytes_estimate,json=reclaimableBytesEstimate,proto3" json:"reclaimable_bytes_estimate,omitempty"` // estimated bytes a prune would reclaim, only set if the repo's prune policy has a min_reclaimable_bytes threshold.
+	PruneSkipped             bool              `protobuf:"varint,4,opt,name=prune_skipped,json=pruneSkipped,proto3" json:"prune_skipped,omitempty"`                                       // the estimate was below the threshold so no prune was scheduled.
+	Marked                   []*ResticSnapshot `protobuf:"bytes,5,rep,name=marked,proto3" json:"marked,omitempty"`                                                                        // snapshots marked for deletion instead of forgotten since the repo delays deletion.
+}
+
+func (x *OperationForget) Reset() {
+	*x = OperationForget{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_operations_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OperationForget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperationForget) ProtoMessage() {}
+
+func (x *OperationForget) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_operations_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperationForget.ProtoReflect.Descriptor instead.
+func (*OperationForget) Descriptor() ([]byte, []int) {
+	return file_v1_operations_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *OperationForget) GetForget() []*ResticSnapshot {
+	if x != nil {
+		return x.Forget
+	}
+	return nil
+}
+
+func (x *OperationForget) GetPolicy() *RetentionPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+func (x *OperationForget) GetReclaimableBytesEstimate() int64 {
+	if x != nil {
+		return x.ReclaimableBytesEstimate
+	}
+	return 0
+}
+
+func (x *OperationForget) GetPruneSkipped() bool {
+	if x != nil {
+		return x.PruneSkipped
+	}
+	return false
+}
+
+func (x *OperationForget) GetMarked() []*ResticSnapshot {
+	if x != nil {
+		return x.Marked
+	}
+	return nil
+}
+
+// OperationPrune tracks a prune operation.
+type OperationPrune struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Output string      `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"` // output of the prune.
+	Stats  *PruneStats `protobuf:"bytes,2,opt,name=stats,proto3" json:"stats,omitempty"`   // amounts of data the prune removed, parsed from the output. Unset if the output couldn't be parsed.
+}
+
+func (x *OperationPrune) Reset() {
+	*x = OperationPrune{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_operations_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OperationPrune) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperationPrune) ProtoMessage() {}
+
+func (x *OperationPrune) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_operations_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperationPrune.ProtoReflect.Descriptor instead.
+func (*OperationPrune) Descriptor() ([]byte, []int) {
+	return file_v1_operations_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *OperationPrune) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+func (x *OperationPrune) GetStats() *PruneStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+// PruneStats are the amounts of data removed by a prune.
+type PruneStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BytesFreed           int64 `protobuf:"varint,1,opt,name=bytes_freed,json=bytesFreed,proto3" json:"bytes_freed,omitempty"`                                 // size of the unused blobs removed from the repo.
+	BlobsRemoved         int64 `protobuf:"varint,2,opt,name=blobs_removed,json=blobsRemoved,proto3" json:"blobs_removed,omitempty"`                           // number of unused blobs removed from the repo.
+	BytesRepacked        int64 `protobuf:"varint,3,opt,name=bytes_repacked,json=bytesRepacked,proto3" json:"bytes_repacked,omitempty"`                        // size of the still used blobs rewritten from partly unused packs.
+	BytesRemaining       int64 `protobuf:"varint,4,opt,name=bytes_remaining,json=bytesRemaining,proto3" json:"bytes_remaining,omitempty"`                     // size of the blobs in the repo after the prune.
+	BytesUnusedRemaining int64 `protobuf:"varint,5,opt,name=bytes_unused_remaining,json=bytesUnusedRemaining,proto3" json:"bytes_unused_remaining,omitempty"` // size of the unused blobs left in the repo, see the prune policy's max unused.
+	PacksDeleted         int64 `protobuf:"varint,6,opt,name=packs_deleted,json=packsDeleted,proto3" json:"packs_deleted,omitempty"`                           // number of pack files deleted.
+}
+
+func (x *PruneStats) Reset() {
+	*x = PruneStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_operations_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PruneStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PruneStats) ProtoMessage() {}
+
+func (x *PruneStats) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_operations_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PruneStats.ProtoReflect.Descriptor instead.
+func (*PruneStats) Descriptor() ([]byte, []int) {
+	return file_v1_operations_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PruneStats) GetBytesFreed() int64 {
+	if x != nil {
+		return x.BytesFreed
+	}
+	return 0
+}
+
+func (x *PruneStats) GetBlobsRemoved() int64 {
+	if x != nil {
+		return x.BlobsRemoved
+	}
+	return 0
+}
+
+func (x *PruneStats) GetBytesRepacked() int64 {
+	if x != nil {
+		return x.BytesRepacked
+	}
+	return 0
+}
+
+func (x *PruneStats) GetBytesRemaining() int64 {
+	if x != nil {
+		return x.BytesRemaining
+	}
+	return 0
+}
+
+func (x *PruneStats) GetBytesUnusedRemaining() int64 {
+	if x != nil {
+		return x.BytesUnusedRemaining
+	}
+	return 0
+}
+
+func (x *PruneStats) GetPacksDeleted() int64 {
+	if x != nil {
+		return x.PacksDeleted
+	}
+	return 0
+}
+
+type OperationRestore struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path         string                `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`                 // path in the snapshot to restore.
+	Target       string                `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`             // location to restore it to.
+	Status       *RestoreProgressEntry `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`             // status of the restore.
+	Options      *RestoreOptions       `protobuf:"bytes,4,opt,name=options,proto3" json:"options,omitempty"`           // options the restore was run with.
+	Verification *RestoreVerification  `protobuf:"bytes,5,opt,name=verification,proto3" json:"verification,omitempty"` // set if verification was requested.
+}
+
+func (x *OperationRestore) Reset() {
+	*x = OperationRestore{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_operations_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OperationIndexSnapshot) String() string {
+func (x *OperationRestore) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OperationIndexSnapshot) ProtoMessage() {}
+func (*OperationRestore) ProtoMessage() {}
 
-func (x *OperationIndexSnapshot) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_operations_proto_msgTypes[4]
+func (x *OperationRestore) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_operations_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -555,59 +1547,72 @@ func (x *OperationIndexSnapshot) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OperationIndexSnapshot.ProtoReflect.Descriptor instead.
-func (*OperationIndexSnapshot) Descriptor() ([]byte, []int) {
-	return file_v1_operations_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use OperationRestore.ProtoReflect.Descriptor instead.
+func (*OperationRestore) Descriptor() ([]byte, []int) {
+	return file_v1_operations_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *OperationIndexSnapshot) GetSnapshot() *ResticSnapshot {
+func (x *OperationRestore) GetPath() string {
 	if x != nil {
-		return x.Snapshot
+		return x.Path
+	}
+	return ""
+}
+
+func (x *OperationRestore) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *OperationRestore) GetStatus() *RestoreProgressEntry {
+	if x != nil {
+		return x.Status
 	}
 	return nil
 }
 
-func (x *OperationIndexSnapshot) GetForgot() bool {
+func (x *OperationRestore) GetOptions() *RestoreOptions {
 	if x != nil {
-		return x.Forgot
+		return x.Options
 	}
-	return false
+	return nil
 }
 
-func (x *OperationIndexSnapshot) GetForgotByOp() int64 {
+func (x *OperationRestore) GetVerification() *RestoreVerification {
 	if x != nil {
-		return x.ForgotByOp
+		return x.Verification
 	}
-	return 0
+	return nil
 }
 
-// OperationForget tracks a forget operation.
-type OperationForget struct {
+type OperationStats struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Forget []*ResticSnapshot `protobuf:"bytes,1,rep,name=forget,proto3" json:"forget,omitempty"`
-	Policy *RetentionPolicy  `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`
+	Stats          *RepoStats `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats,omitempty"`
+	SnapshotScoped bool       `protobuf:"varint,2,opt,name=snapshot_scoped,json=snapshotScoped,proto3" json:"snapshot_scoped,omitempty"` // stats cover only the operation's snapshot rather than the whole repo.
 }
 
-func (x *OperationForget) Reset() {
-	*x = OperationForget{}
+func (x *OperationStats) Reset() {
+	*x = OperationStats{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_operations_proto_msgTypes[5]
+		mi := &file_v1_operations_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OperationForget) String() string {
+func (x *OperationStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OperationForget) ProtoMessage() {}
+func (*OperationStats) ProtoMessage() {}
 
-func (x *OperationForget) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_operations_proto_msgTypes[5]
+func (x *OperationStats) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_operations_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -618,51 +1623,235 @@ func (x *OperationForget) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OperationForget.ProtoReflect.Descriptor instead.
-func (*OperationForget) Descriptor() ([]byte, []int) {
-	return file_v1_operations_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use OperationStats.ProtoReflect.Descriptor instead.
+func (*OperationStats) Descriptor() ([]byte, []int) {
+	return file_v1_operations_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *OperationForget) GetForget() []*ResticSnapshot {
+func (x *OperationStats) GetStats() *RepoStats {
 	if x != nil {
-		return x.Forget
+		return x.Stats
 	}
 	return nil
 }
 
-func (x *OperationForget) GetPolicy() *RetentionPolicy {
+func (x *OperationStats) GetSnapshotScoped() bool {
 	if x != nil {
-		return x.Policy
+		return x.SnapshotScoped
 	}
-	return nil
+	return false
 }
 
-// OperationPrune tracks a prune operation.
-type OperationPrune struct {
+type OperationRunHook struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name         string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                     // description of the hook that was run. typically repo/hook_idx or plan/hook_idx.
+	OutputLogref string `protobuf:"bytes,2,opt,name=output_logref,json=outputLogref,proto3" json:"output_logref,omitempty"` // logref of the hook's output.
+}
+
+func (x *OperationRunHook) Reset() {
+	*x = OperationRunHook{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_operations_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OperationRunHook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperationRunHook) ProtoMessage() {}
+
+func (x *OperationRunHook) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_operations_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperationRunHook.ProtoReflect.Descriptor instead.
+func (*OperationRunHook) Descriptor() ([]byte, []int) {
+	return file_v1_operations_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *OperationRunHook) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OperationRunHook) GetOutputLogref() string {
+	if x != nil {
+		return x.OutputLogref
+	}
+	return ""
+}
+
+// OperationMigrate tracks a restic migrate operation applied to a repo.
+type OperationMigrate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Migration string `protobuf:"bytes,1,opt,name=migration,proto3" json:"migration,omitempty"` // name of the migration e.g. upgrade_repo_v2.
+	Output    string `protobuf:"bytes,2,opt,name=output,proto3" json:"output,omitempty"`       // output of the migration.
+}
+
+func (x *OperationMigrate) Reset() {
+	*x = OperationMigrate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_operations_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OperationMigrate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperationMigrate) ProtoMessage() {}
+
+func (x *OperationMigrate) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_operations_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperationMigrate.ProtoReflect.Descriptor instead.
+func (*OperationMigrate) Descriptor() ([]byte, []int) {
+	return file_v1_operations_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *OperationMigrate) GetMigration() string {
+	if x != nil {
+		return x.Migration
+	}
+	return ""
+}
+
+func (x *OperationMigrate) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+// OperationCacheCleanup tracks a cleanup of the restic cache used by a repo.
+type OperationCacheCleanup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Output          string `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`                                             // output of restic cache --cleanup.
+	SizeBeforeBytes int64  `protobuf:"varint,2,opt,name=size_before_bytes,json=sizeBeforeBytes,proto3" json:"size_before_bytes,omitempty"` // size of the repo's cache before the cleanup.
+	SizeAfterBytes  int64  `protobuf:"varint,3,opt,name=size_after_bytes,json=sizeAfterBytes,proto3" json:"size_after_bytes,omitempty"`    // size of the repo's cache after the cleanup.
+	Cleared         bool   `protobuf:"varint,4,opt,name=cleared,proto3" json:"cleared,omitempty"`                                          // the repo's cache exceeded the maximum size and was cleared.
+}
+
+func (x *OperationCacheCleanup) Reset() {
+	*x = OperationCacheCleanup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_operations_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OperationCacheCleanup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperationCacheCleanup) ProtoMessage() {}
+
+func (x *OperationCacheCleanup) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_operations_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperationCacheCleanup.ProtoReflect.Descriptor instead.
+func (*OperationCacheCleanup) Descriptor() ([]byte, []int) {
+	return file_v1_operations_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *OperationCacheCleanup) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+func (x *OperationCacheCleanup) GetSizeBeforeBytes() int64 {
+	if x != nil {
+		return x.SizeBeforeBytes
+	}
+	return 0
+}
+
+func (x *OperationCacheCleanup) GetSizeAfterBytes() int64 {
+	if x != nil {
+		return x.SizeAfterBytes
+	}
+	return 0
+}
+
+func (x *OperationCacheCleanup) GetCleared() bool {
+	if x != nil {
+		return x.Cleared
+	}
+	return false
+}
+
+// OperationWarmup tracks a run of the warmup command of a repo on cold storage.
+type OperationWarmup struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Output string `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"` // output of the prune.
+	Output           string `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`                                                  // output of the warmup command.
+	ValidUntilUnixMs int64  `protobuf:"varint,2,opt,name=valid_until_unix_ms,json=validUntilUnixMs,proto3" json:"valid_until_unix_ms,omitempty"` // operations reading pack data are allowed until this time once the warmup succeeded.
 }
 
-func (x *OperationPrune) Reset() {
-	*x = OperationPrune{}
+func (x *OperationWarmup) Reset() {
+	*x = OperationWarmup{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_operations_proto_msgTypes[6]
+		mi := &file_v1_operations_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OperationPrune) String() string {
+func (x *OperationWarmup) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OperationPrune) ProtoMessage() {}
+func (*OperationWarmup) ProtoMessage() {}
 
-func (x *OperationPrune) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_operations_proto_msgTypes[6]
+func (x *OperationWarmup) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_operations_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -673,45 +1862,58 @@ func (x *OperationPrune) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OperationPrune.ProtoReflect.Descriptor instead.
-func (*OperationPrune) Descriptor() ([]byte, []int) {
-	return file_v1_operations_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use OperationWarmup.ProtoReflect.Descriptor instead.
+func (*OperationWarmup) Descriptor() ([]byte, []int) {
+	return file_v1_operations_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *OperationPrune) GetOutput() string {
+func (x *OperationWarmup) GetOutput() string {
 	if x != nil {
 		return x.Output
 	}
 	return ""
 }
 
-type OperationRestore struct {
+func (x *OperationWarmup) GetValidUntilUnixMs() int64 {
+	if x != nil {
+		return x.ValidUntilUnixMs
+	}
+	return 0
+}
+
+// OperationBenchmark tracks a measurement of a repo backend's throughput using temporary random test data, the data
+// is forgotten and pruned afterwards.
+type OperationBenchmark struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Path   string                `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`     // path in the snapshot to restore.
-	Target string                `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"` // location to restore it to.
-	Status *RestoreProgressEntry `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"` // status of the restore.
+	SizeBytes              int64   `protobuf:"varint,1,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`    // size of the test data.
+	LatencyMs              int64   `protobuf:"varint,2,opt,name=latency_ms,json=latencyMs,proto3" json:"latency_ms,omitempty"`    // median duration of a restic command making a single small request, includes restic's startup and key derivation.
+	UploadMs               int64   `protobuf:"varint,3,opt,name=upload_ms,json=uploadMs,proto3" json:"upload_ms,omitempty"`       // duration of the backup of the test data.
+	DownloadMs             int64   `protobuf:"varint,4,opt,name=download_ms,json=downloadMs,proto3" json:"download_ms,omitempty"` // duration of reading the test data back.
+	UploadBytesPerSecond   float64 `protobuf:"fixed64,5,opt,name=upload_bytes_per_second,json=uploadBytesPerSecond,proto3" json:"upload_bytes_per_second,omitempty"`
+	DownloadBytesPerSecond float64 `protobuf:"fixed64,6,opt,name=download_bytes_per_second,json=downloadBytesPerSecond,proto3" json:"download_bytes_per_second,omitempty"`
+	Cleaned                bool    `protobuf:"varint,7,opt,name=cleaned,proto3" json:"cleaned,omitempty"` // the test data was removed from the repo.
 }
 
-func (x *OperationRestore) Reset() {
-	*x = OperationRestore{}
+func (x *OperationBenchmark) Reset() {
+	*x = OperationBenchmark{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_operations_proto_msgTypes[7]
+		mi := &file_v1_operations_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OperationRestore) String() string {
+func (x *OperationBenchmark) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OperationRestore) ProtoMessage() {}
+func (*OperationBenchmark) ProtoMessage() {}
 
-func (x *OperationRestore) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_operations_proto_msgTypes[7]
+func (x *OperationBenchmark) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_operations_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -722,105 +1924,88 @@ func (x *OperationRestore) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OperationRestore.ProtoReflect.Descriptor instead.
-func (*OperationRestore) Descriptor() ([]byte, []int) {
-	return file_v1_operations_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use OperationBenchmark.ProtoReflect.Descriptor instead.
+func (*OperationBenchmark) Descriptor() ([]byte, []int) {
+	return file_v1_operations_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *OperationRestore) GetPath() string {
+func (x *OperationBenchmark) GetSizeBytes() int64 {
 	if x != nil {
-		return x.Path
+		return x.SizeBytes
 	}
-	return ""
+	return 0
 }
 
-func (x *OperationRestore) GetTarget() string {
+func (x *OperationBenchmark) GetLatencyMs() int64 {
 	if x != nil {
-		return x.Target
+		return x.LatencyMs
 	}
-	return ""
+	return 0
 }
 
-func (x *OperationRestore) GetStatus() *RestoreProgressEntry {
+func (x *OperationBenchmark) GetUploadMs() int64 {
 	if x != nil {
-		return x.Status
+		return x.UploadMs
 	}
-	return nil
-}
-
-type OperationStats struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Stats *RepoStats `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats,omitempty"`
+	return 0
 }
 
-func (x *OperationStats) Reset() {
-	*x = OperationStats{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_operations_proto_msgTypes[8]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *OperationBenchmark) GetDownloadMs() int64 {
+	if x != nil {
+		return x.DownloadMs
 	}
+	return 0
 }
 
-func (x *OperationStats) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*OperationStats) ProtoMessage() {}
-
-func (x *OperationStats) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_operations_proto_msgTypes[8]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *OperationBenchmark) GetUploadBytesPerSecond() float64 {
+	if x != nil {
+		return x.UploadBytesPerSecond
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use OperationStats.ProtoReflect.Descriptor instead.
-func (*OperationStats) Descriptor() ([]byte, []int) {
-	return file_v1_operations_proto_rawDescGZIP(), []int{8}
+func (x *OperationBenchmark) GetDownloadBytesPerSecond() float64 {
+	if x != nil {
+		return x.DownloadBytesPerSecond
+	}
+	return 0
 }
 
-func (x *OperationStats) GetStats() *RepoStats {
+func (x *OperationBenchmark) GetCleaned() bool {
 	if x != nil {
-		return x.Stats
+		return x.Cleaned
 	}
-	return nil
+	return false
 }
 
-type OperationRunHook struct {
+// OperationRestoreDrill tracks a test restore of part of a snapshot to a temporary directory.
+type OperationRestoreDrill struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name         string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                                     // description of the hook that was run. typically repo/hook_idx or plan/hook_idx.
-	OutputLogref string `protobuf:"bytes,2,opt,name=output_logref,json=outputLogref,proto3" json:"output_logref,omitempty"` // logref of the hook's output.
+	Paths        []string              `protobuf:"bytes,1,rep,name=paths,proto3" json:"paths,omitempty"`               // paths in the snapshot that were restored.
+	Status       *RestoreProgressEntry `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`             // status of the restore.
+	Verification *RestoreVerification  `protobuf:"bytes,3,opt,name=verification,proto3" json:"verification,omitempty"` // result of verifying the restored files.
 }
 
-func (x *OperationRunHook) Reset() {
-	*x = OperationRunHook{}
+func (x *OperationRestoreDrill) Reset() {
+	*x = OperationRestoreDrill{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_operations_proto_msgTypes[9]
+		mi := &file_v1_operations_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OperationRunHook) String() string {
+func (x *OperationRestoreDrill) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OperationRunHook) ProtoMessage() {}
+func (*OperationRestoreDrill) ProtoMessage() {}
 
-func (x *OperationRunHook) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_operations_proto_msgTypes[9]
+func (x *OperationRestoreDrill) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_operations_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -831,23 +2016,30 @@ func (x *OperationRunHook) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OperationRunHook.ProtoReflect.Descriptor instead.
-func (*OperationRunHook) Descriptor() ([]byte, []int) {
-	return file_v1_operations_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use OperationRestoreDrill.ProtoReflect.Descriptor instead.
+func (*OperationRestoreDrill) Descriptor() ([]byte, []int) {
+	return file_v1_operations_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *OperationRunHook) GetName() string {
+func (x *OperationRestoreDrill) GetPaths() []string {
 	if x != nil {
-		return x.Name
+		return x.Paths
 	}
-	return ""
+	return nil
 }
 
-func (x *OperationRunHook) GetOutputLogref() string {
+func (x *OperationRestoreDrill) GetStatus() *RestoreProgressEntry {
 	if x != nil {
-		return x.OutputLogref
+		return x.Status
 	}
-	return ""
+	return nil
+}
+
+func (x *OperationRestoreDrill) GetVerification() *RestoreVerification {
+	if x != nil {
+		return x.Verification
+	}
+	return nil
 }
 
 var File_v1_operations_proto protoreflect.FileDescriptor
@@ -860,125 +2052,341 @@ var file_v1_operations_proto_rawDesc = []byte{
 	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x0a,
 	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
 	0x32, 0x0d, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x85, 0x06, 0x0a, 0x09,
-	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x65, 0x70,
-	0x6f, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x70, 0x6f,
-	0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
-	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x76,
-	0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2b, 0x0a, 0x12, 0x75, 0x6e, 0x69,
-	0x78, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x6d, 0x73, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x75, 0x6e, 0x69, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x53,
-	0x74, 0x61, 0x72, 0x74, 0x4d, 0x73, 0x12, 0x27, 0x0a, 0x10, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x74,
-	0x69, 0x6d, 0x65, 0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0d, 0x75, 0x6e, 0x69, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x64, 0x4d, 0x73, 0x12,
-	0x27, 0x0a, 0x0f, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
-	0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x40, 0x0a, 0x10, 0x6f, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x18, 0x64, 0x20, 0x01,
+	0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x89, 0x01, 0x0a, 0x10,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65,
+	0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x76, 0x65, 0x72, 0x61,
+	0x67, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x61, 0x76, 0x65,
+	0x72, 0x61, 0x67, 0x65, 0x4d, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6d, 0x61, 0x78, 0x4d, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07,
+	0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x22, 0x89, 0x02, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0b, 0x63, 0x70, 0x75, 0x5f, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x70,
+	0x75, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x63, 0x70, 0x75, 0x5f, 0x73,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x5f, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
+	0x63, 0x70, 0x75, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4d, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x6d,
+	0x61, 0x78, 0x5f, 0x72, 0x73, 0x73, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x52, 0x73, 0x73, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x72, 0x65, 0x61, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1f,
+	0x0a, 0x0b, 0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x77, 0x72, 0x69, 0x74, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12,
+	0x32, 0x0a, 0x15, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f,
+	0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13,
+	0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x45, 0x73, 0x74, 0x69, 0x6d,
+	0x61, 0x74, 0x65, 0x22, 0xe9, 0x01, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x55, 0x73, 0x61, 0x67, 0x65, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x25, 0x0a, 0x0e,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x27, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1f, 0x0a, 0x0b,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x12, 0x40, 0x0a,
+	0x1d, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x70, 0x65,
+	0x72, 0x5f, 0x73, 0x65, 0x63, 0x5f, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x19, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x22,
+	0x93, 0x02, 0x0a, 0x03, 0x52, 0x75, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x6c, 0x61, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x6e, 0x49, 0x64,
+	0x12, 0x17, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x76, 0x31, 0x2e, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2b, 0x0a, 0x12, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0f, 0x75, 0x6e, 0x69, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x4d, 0x73, 0x12, 0x27, 0x0a, 0x10, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x75,
+	0x6e, 0x69, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x64, 0x4d, 0x73, 0x12, 0x2d, 0x0a, 0x0a,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70,
+	0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x61,
+	0x72, 0x74, 0x69, 0x61, 0x6c, 0x22, 0xfd, 0x0a, 0x0a, 0x09, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07,
+	0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70,
+	0x6c, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x2b, 0x0a, 0x12, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0f, 0x75, 0x6e, 0x69, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x73,
+	0x12, 0x27, 0x0a, 0x10, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x65, 0x6e,
+	0x64, 0x5f, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x75, 0x6e, 0x69, 0x78,
+	0x54, 0x69, 0x6d, 0x65, 0x45, 0x6e, 0x64, 0x4d, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x69, 0x73,
+	0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x75, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x72, 0x75, 0x6e, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x65, 0x78, 0x70,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d,
+	0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x12, 0x2f, 0x0a, 0x0b, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x0e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6c, 0x61, 0x73, 0x73,
+	0x52, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x38, 0x0a, 0x0e,
+	0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x46, 0x0a, 0x0f, 0x61, 0x63, 0x6b, 0x6e, 0x6f, 0x77,
+	0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63,
+	0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0f, 0x61,
+	0x63, 0x6b, 0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x40,
+	0x0a, 0x10, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x61, 0x63, 0x6b,
+	0x75, 0x70, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x48, 0x00, 0x52,
+	0x0f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70,
+	0x12, 0x56, 0x0a, 0x18, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x65, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x48, 0x00,
+	0x52, 0x16, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x40, 0x0a, 0x10, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x6f, 0x72, 0x67, 0x65, 0x74, 0x18, 0x66, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x48, 0x00, 0x52, 0x0f, 0x6f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x12, 0x56, 0x0a, 0x18, 0x6f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x5f, 0x73, 0x6e,
-	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x65, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x76,
-	0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78,
-	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x48, 0x00, 0x52, 0x16, 0x6f, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
-	0x6f, 0x74, 0x12, 0x40, 0x0a, 0x10, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x66, 0x6f, 0x72, 0x67, 0x65, 0x74, 0x18, 0x66, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x76,
-	0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x72, 0x67, 0x65,
-	0x74, 0x48, 0x00, 0x52, 0x0f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f,
-	0x72, 0x67, 0x65, 0x74, 0x12, 0x3d, 0x0a, 0x0f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x18, 0x67, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
-	0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x75, 0x6e,
-	0x65, 0x48, 0x00, 0x52, 0x0e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72,
-	0x75, 0x6e, 0x65, 0x12, 0x43, 0x0a, 0x11, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x18, 0x68, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
-	0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
-	0x74, 0x6f, 0x72, 0x65, 0x48, 0x00, 0x52, 0x10, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x3d, 0x0a, 0x0f, 0x6f, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x69, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x12, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x53, 0x74, 0x61, 0x74, 0x73, 0x48, 0x00, 0x52, 0x0e, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x44, 0x0a, 0x12, 0x6f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x68, 0x6f, 0x6f, 0x6b, 0x18, 0x6a, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x75, 0x6e, 0x48, 0x6f, 0x6f, 0x6b, 0x48, 0x00, 0x52, 0x10, 0x6f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6e, 0x48, 0x6f, 0x6f, 0x6b, 0x42, 0x04, 0x0a,
-	0x02, 0x6f, 0x70, 0x22, 0x69, 0x0a, 0x0e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x12, 0x2b, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x7c,
-	0x0a, 0x0f, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x63, 0x6b, 0x75,
-	0x70, 0x12, 0x38, 0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x63, 0x6b,
-	0x75, 0x70, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x0a, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2f, 0x0a, 0x06, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76, 0x31,
-	0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x45,
-	0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x82, 0x01, 0x0a,
-	0x16, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x53,
-	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x2e, 0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73,
-	0x68, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x76, 0x31, 0x2e, 0x52,
-	0x65, 0x73, 0x74, 0x69, 0x63, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x08, 0x73,
-	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x67, 0x6f,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x67, 0x6f, 0x74, 0x12,
-	0x20, 0x0a, 0x0c, 0x66, 0x6f, 0x72, 0x67, 0x6f, 0x74, 0x5f, 0x62, 0x79, 0x5f, 0x6f, 0x70, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x66, 0x6f, 0x72, 0x67, 0x6f, 0x74, 0x42, 0x79, 0x4f,
-	0x70, 0x22, 0x6a, 0x0a, 0x0f, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f,
-	0x72, 0x67, 0x65, 0x74, 0x12, 0x2a, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x69, 0x63,
-	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x67, 0x65, 0x74,
-	0x12, 0x2b, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x13, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50,
-	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x28, 0x0a,
-	0x0e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x12,
+	0x6e, 0x46, 0x6f, 0x72, 0x67, 0x65, 0x74, 0x48, 0x00, 0x52, 0x0f, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x72, 0x67, 0x65, 0x74, 0x12, 0x3d, 0x0a, 0x0f, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x18, 0x67, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x48, 0x00, 0x52, 0x0e, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x12, 0x43, 0x0a, 0x11, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x18, 0x68,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x48, 0x00, 0x52, 0x10, 0x6f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x3d,
+	0x0a, 0x0f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x73, 0x18, 0x69, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x48, 0x00, 0x52, 0x0e, 0x6f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x44, 0x0a,
+	0x12, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x68,
+	0x6f, 0x6f, 0x6b, 0x18, 0x6a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x76, 0x31, 0x2e, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6e, 0x48, 0x6f, 0x6f, 0x6b, 0x48,
+	0x00, 0x52, 0x10, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6e, 0x48,
+	0x6f, 0x6f, 0x6b, 0x12, 0x43, 0x0a, 0x11, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x18, 0x6b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x67,
+	0x72, 0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x10, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x65, 0x12, 0x53, 0x0a, 0x17, 0x6f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x64, 0x72,
+	0x69, 0x6c, 0x6c, 0x18, 0x6c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x76, 0x31, 0x2e, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x44,
+	0x72, 0x69, 0x6c, 0x6c, 0x48, 0x00, 0x52, 0x15, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x44, 0x72, 0x69, 0x6c, 0x6c, 0x12, 0x53, 0x0a,
+	0x17, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x61, 0x63, 0x68, 0x65,
+	0x5f, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x18, 0x6d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x63,
+	0x68, 0x65, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x48, 0x00, 0x52, 0x15, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x63, 0x68, 0x65, 0x43, 0x6c, 0x65, 0x61, 0x6e,
+	0x75, 0x70, 0x12, 0x40, 0x0a, 0x10, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x77, 0x61, 0x72, 0x6d, 0x75, 0x70, 0x18, 0x6e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x76,
+	0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x61, 0x72, 0x6d, 0x75,
+	0x70, 0x48, 0x00, 0x52, 0x0f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x61,
+	0x72, 0x6d, 0x75, 0x70, 0x12, 0x49, 0x0a, 0x13, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x62, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x18, 0x6f, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x48, 0x00, 0x52, 0x12, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x42,
+	0x04, 0x0a, 0x02, 0x6f, 0x70, 0x22, 0x69, 0x0a, 0x0e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x2b, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x64, 0x0a, 0x18, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x6b,
+	0x6e, 0x6f, 0x77, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72,
+	0x12, 0x20, 0x0a, 0x0c, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x75, 0x6e, 0x69, 0x78, 0x54, 0x69, 0x6d, 0x65,
+	0x4d, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x22, 0xb5, 0x02, 0x0a, 0x0f, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x12, 0x38, 0x0a, 0x0b, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x50, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x2f, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70,
+	0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x06, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x31, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x61, 0x64,
+	0x64, 0x65, 0x64, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x61, 0x74, 0x61, 0x41, 0x64, 0x64, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x64,
+	0x61, 0x74, 0x61, 0x41, 0x64, 0x64, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x77, 0x61, 0x72, 0x6e,
+	0x69, 0x6e, 0x67, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x77, 0x61, 0x72, 0x6e,
+	0x69, 0x6e, 0x67, 0x73, 0x12, 0x3b, 0x0a, 0x0c, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x2b, 0x0a, 0x07, 0x61, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x41, 0x6e,
+	0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x52, 0x07, 0x61, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79, 0x22, 0x8d,
+	0x01, 0x0a, 0x0d, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x41, 0x6e, 0x6f, 0x6d, 0x61, 0x6c, 0x79,
+	0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x07, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x62, 0x61,
+	0x73, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x61, 0x64, 0x64, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x62, 0x61, 0x73, 0x65, 0x6c, 0x69, 0x6e,
+	0x65, 0x44, 0x61, 0x74, 0x61, 0x41, 0x64, 0x64, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x15, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x13, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x22, 0x50,
+	0x0a, 0x0e, 0x44, 0x61, 0x74, 0x61, 0x41, 0x64, 0x64, 0x65, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x79, 0x74, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69,
+	0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73,
+	0x22, 0x96, 0x01, 0x0a, 0x16, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x2e, 0x0a, 0x08, 0x73,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x69, 0x63, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x52, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66,
+	0x6f, 0x72, 0x67, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x66, 0x6f, 0x72,
+	0x67, 0x6f, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x66, 0x6f, 0x72, 0x67, 0x6f, 0x74, 0x5f, 0x62, 0x79,
+	0x5f, 0x6f, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x66, 0x6f, 0x72, 0x67, 0x6f,
+	0x74, 0x42, 0x79, 0x4f, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x22, 0xf9, 0x01, 0x0a, 0x0f, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x72, 0x67, 0x65, 0x74, 0x12, 0x2a, 0x0a,
+	0x06, 0x66, 0x6f, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x69, 0x63, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x67, 0x65, 0x74, 0x12, 0x2b, 0x0a, 0x06, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x06,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x3c, 0x0a, 0x1a, 0x72, 0x65, 0x63, 0x6c, 0x61, 0x69,
+	0x6d, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x65, 0x73, 0x74, 0x69,
+	0x6d, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x18, 0x72, 0x65, 0x63, 0x6c,
+	0x61, 0x69, 0x6d, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x45, 0x73, 0x74, 0x69,
+	0x6d, 0x61, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x5f, 0x73, 0x6b,
+	0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x70, 0x72, 0x75,
+	0x6e, 0x65, 0x53, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x06, 0x6d, 0x61, 0x72,
+	0x6b, 0x65, 0x64, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x73, 0x74, 0x69, 0x63, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x06, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x64, 0x22, 0x4e, 0x0a, 0x0e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12,
+	0x24, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x73, 0x22, 0xfd, 0x01, 0x0a, 0x0a, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x66, 0x72,
+	0x65, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x62, 0x79, 0x74, 0x65, 0x73,
+	0x46, 0x72, 0x65, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x72,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x62, 0x6c,
+	0x6f, 0x62, 0x73, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0d, 0x62, 0x79, 0x74, 0x65, 0x73, 0x52, 0x65, 0x70, 0x61, 0x63, 0x6b, 0x65,
+	0x64, 0x12, 0x27, 0x0a, 0x0f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x6d, 0x61, 0x69,
+	0x6e, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x34, 0x0a, 0x16, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x5f, 0x75, 0x6e, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x6d, 0x61, 0x69,
+	0x6e, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x55, 0x6e, 0x75, 0x73, 0x65, 0x64, 0x52, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67,
+	0x12, 0x23, 0x0a, 0x0d, 0x70, 0x61, 0x63, 0x6b, 0x73, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x70, 0x61, 0x63, 0x6b, 0x73, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0xdb, 0x01, 0x0a, 0x10, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x16,
+	0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2c, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3b, 0x0a, 0x0c, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x5e, 0x0a, 0x0e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0e, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x53, 0x63, 0x6f,
+	0x70, 0x65, 0x64, 0x22, 0x4b, 0x0a, 0x10, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x75, 0x6e, 0x48, 0x6f, 0x6f, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x6c, 0x6f, 0x67, 0x72, 0x65, 0x66, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x4c, 0x6f, 0x67, 0x72, 0x65, 0x66,
+	0x22, 0x48, 0x0a, 0x10, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x67,
+	0x72, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x9f, 0x01, 0x0a, 0x15, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x63, 0x68, 0x65, 0x43, 0x6c, 0x65,
+	0x61, 0x6e, 0x75, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x2a, 0x0a, 0x11,
+	0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x65, 0x66,
+	0x6f, 0x72, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x69, 0x7a, 0x65,
+	0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0e, 0x73, 0x69, 0x7a, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x65, 0x64, 0x22, 0x58, 0x0a, 0x0f,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x61, 0x72, 0x6d, 0x75, 0x70, 0x12,
 	0x16, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x22, 0x70, 0x0a, 0x10, 0x4f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70,
-	0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12,
-	0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73,
-	0x74, 0x6f, 0x72, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x35, 0x0a, 0x0e, 0x4f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x23, 0x0a, 0x05, 0x73,
-	0x74, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x76, 0x31, 0x2e,
-	0x52, 0x65, 0x70, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73,
-	0x22, 0x4b, 0x0a, 0x10, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6e,
-	0x48, 0x6f, 0x6f, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x75, 0x74, 0x70,
-	0x75, 0x74, 0x5f, 0x6c, 0x6f, 0x67, 0x72, 0x65, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x4c, 0x6f, 0x67, 0x72, 0x65, 0x66, 0x2a, 0x60, 0x0a,
-	0x12, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x11, 0x0a, 0x0d, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x55, 0x4e, 0x4b,
-	0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f,
-	0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x45, 0x56, 0x45,
-	0x4e, 0x54, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d,
-	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x03, 0x2a,
-	0xc2, 0x01, 0x0a, 0x0f, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e,
-	0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x54, 0x41, 0x54, 0x55,
-	0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x53,
-	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x49, 0x4e, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53,
-	0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x55, 0x43,
-	0x43, 0x45, 0x53, 0x53, 0x10, 0x03, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
-	0x5f, 0x57, 0x41, 0x52, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x07, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x54,
-	0x41, 0x54, 0x55, 0x53, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x04, 0x12, 0x1b, 0x0a, 0x17,
-	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x59, 0x53, 0x54, 0x45, 0x4d, 0x5f, 0x43, 0x41,
-	0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x12, 0x19, 0x0a, 0x15, 0x53, 0x54, 0x41,
-	0x54, 0x55, 0x53, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c,
-	0x45, 0x44, 0x10, 0x06, 0x42, 0x2c, 0x5a, 0x2a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x67, 0x61, 0x72, 0x65, 0x74, 0x68, 0x67, 0x65, 0x6f, 0x72, 0x67, 0x65, 0x2f,
-	0x62, 0x61, 0x63, 0x6b, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f,
-	0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x2d, 0x0a, 0x13, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x5f, 0x75, 0x6e, 0x69, 0x78, 0x5f, 0x6d, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c,
+	0x55, 0x6e, 0x69, 0x78, 0x4d, 0x73, 0x22, 0x9c, 0x02, 0x0a, 0x12, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x65, 0x6e, 0x63, 0x68, 0x6d, 0x61, 0x72, 0x6b, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x73, 0x69, 0x7a, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x75,
+	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x6f, 0x77, 0x6e,
+	0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64,
+	0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x73, 0x12, 0x35, 0x0a, 0x17, 0x75, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x14, 0x75, 0x70, 0x6c, 0x6f,
+	0x61, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x12, 0x39, 0x0a, 0x19, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x16, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6c, 0x65, 0x61, 0x6e, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63, 0x6c,
+	0x65, 0x61, 0x6e, 0x65, 0x64, 0x22, 0x9c, 0x01, 0x0a, 0x15, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x44, 0x72, 0x69, 0x6c, 0x6c, 0x12,
+	0x14, 0x0a, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05,
+	0x70, 0x61, 0x74, 0x68, 0x73, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3b, 0x0a, 0x0c, 0x76, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2a, 0x60, 0x0a, 0x12, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x11, 0x0a, 0x0d, 0x45, 0x56,
+	0x45, 0x4e, 0x54, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x11, 0x0a,
+	0x0d, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x01,
+	0x12, 0x11, 0x0a, 0x0d, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45,
+	0x44, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x44, 0x45, 0x4c,
+	0x45, 0x54, 0x45, 0x44, 0x10, 0x03, 0x2a, 0xc2, 0x01, 0x0a, 0x0f, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x12,
+	0x0a, 0x0e, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47,
+	0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x49, 0x4e, 0x50,
+	0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x03, 0x12, 0x12, 0x0a,
+	0x0e, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x57, 0x41, 0x52, 0x4e, 0x49, 0x4e, 0x47, 0x10,
+	0x07, 0x12, 0x10, 0x0a, 0x0c, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x45, 0x52, 0x52, 0x4f,
+	0x52, 0x10, 0x04, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x59,
+	0x53, 0x54, 0x45, 0x4d, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x05,
+	0x12, 0x19, 0x0a, 0x15, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f,
+	0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x06, 0x42, 0x2c, 0x5a, 0x2a, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x61, 0x72, 0x65, 0x74, 0x68,
+	0x67, 0x65, 0x6f, 0x72, 0x67, 0x65, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x72, 0x65, 0x73, 0x74, 0x2f,
+	0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -994,51 +2402,87 @@ func file_v1_operations_proto_rawDescGZIP() []byte {
 }
 
 var file_v1_operations_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_v1_operations_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_v1_operations_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
 var file_v1_operations_proto_goTypes = []interface{}{
-	(OperationEventType)(0),        // 0: v1.OperationEventType
-	(OperationStatus)(0),           // 1: v1.OperationStatus
-	(*OperationList)(nil),          // 2: v1.OperationList
-	(*Operation)(nil),              // 3: v1.Operation
-	(*OperationEvent)(nil),         // 4: v1.OperationEvent
-	(*OperationBackup)(nil),        // 5: v1.OperationBackup
-	(*OperationIndexSnapshot)(nil), // 6: v1.OperationIndexSnapshot
-	(*OperationForget)(nil),        // 7: v1.OperationForget
-	(*OperationPrune)(nil),         // 8: v1.OperationPrune
-	(*OperationRestore)(nil),       // 9: v1.OperationRestore
-	(*OperationStats)(nil),         // 10: v1.OperationStats
-	(*OperationRunHook)(nil),       // 11: v1.OperationRunHook
-	(*BackupProgressEntry)(nil),    // 12: v1.BackupProgressEntry
-	(*BackupProgressError)(nil),    // 13: v1.BackupProgressError
-	(*ResticSnapshot)(nil),         // 14: v1.ResticSnapshot
-	(*RetentionPolicy)(nil),        // 15: v1.RetentionPolicy
-	(*RestoreProgressEntry)(nil),   // 16: v1.RestoreProgressEntry
-	(*RepoStats)(nil),              // 17: v1.RepoStats
+	(OperationEventType)(0),          // 0: v1.OperationEventType
+	(OperationStatus)(0),             // 1: v1.OperationStatus
+	(*OperationList)(nil),            // 2: v1.OperationList
+	(*DurationEstimate)(nil),         // 3: v1.DurationEstimate
+	(*ResourceUsage)(nil),            // 4: v1.ResourceUsage
+	(*ResourceUsageSummary)(nil),     // 5: v1.ResourceUsageSummary
+	(*Run)(nil),                      // 6: v1.Run
+	(*Operation)(nil),                // 7: v1.Operation
+	(*OperationEvent)(nil),           // 8: v1.OperationEvent
+	(*OperationAcknowledgement)(nil), // 9: v1.OperationAcknowledgement
+	(*OperationBackup)(nil),          // 10: v1.OperationBackup
+	(*BackupAnomaly)(nil),            // 11: v1.BackupAnomaly
+	(*DataAddedEntry)(nil),           // 12: v1.DataAddedEntry
+	(*OperationIndexSnapshot)(nil),   // 13: v1.OperationIndexSnapshot
+	(*OperationForget)(nil),          // 14: v1.OperationForget
+	(*OperationPrune)(nil),           // 15: v1.OperationPrune
+	(*PruneStats)(nil),               // 16: v1.PruneStats
+	(*OperationRestore)(nil),         // 17: v1.OperationRestore
+	(*OperationStats)(nil),           // 18: v1.OperationStats
+	(*OperationRunHook)(nil),         // 19: v1.OperationRunHook
+	(*OperationMigrate)(nil),         // 20: v1.OperationMigrate
+	(*OperationCacheCleanup)(nil),    // 21: v1.OperationCacheCleanup
+	(*OperationWarmup)(nil),          // 22: v1.OperationWarmup
+	(*OperationBenchmark)(nil),       // 23: v1.OperationBenchmark
+	(*OperationRestoreDrill)(nil),    // 24: v1.OperationRestoreDrill
+	(ErrorClass)(0),                  // 25: v1.ErrorClass
+	(*BackupProgressEntry)(nil),      // 26: v1.BackupProgressEntry
+	(*BackupProgressError)(nil),      // 27: v1.BackupProgressError
+	(*RestoreVerification)(nil),      // 28: v1.RestoreVerification
+	(*ResticSnapshot)(nil),           // 29: v1.ResticSnapshot
+	(*RetentionPolicy)(nil),          // 30: v1.RetentionPolicy
+	(*RestoreProgressEntry)(nil),     // 31: v1.RestoreProgressEntry
+	(*RestoreOptions)(nil),           // 32: v1.RestoreOptions
+	(*RepoStats)(nil),                // 33: v1.RepoStats
 }
 var file_v1_operations_proto_depIdxs = []int32{
-	3,  // 0: v1.OperationList.operations:type_name -> v1.Operation
-	1,  // 1: v1.Operation.status:type_name -> v1.OperationStatus
-	5,  // 2: v1.Operation.operation_backup:type_name -> v1.OperationBackup
-	6,  // 3: v1.Operation.operation_index_snapshot:type_name -> v1.OperationIndexSnapshot
-	7,  // 4: v1.Operation.operation_forget:type_name -> v1.OperationForget
-	8,  // 5: v1.Operation.operation_prune:type_name -> v1.OperationPrune
-	9,  // 6: v1.Operation.operation_restore:type_name -> v1.OperationRestore
-	10, // 7: v1.Operation.operation_stats:type_name -> v1.OperationStats
-	11, // 8: v1.Operation.operation_run_hook:type_name -> v1.OperationRunHook
-	0,  // 9: v1.OperationEvent.type:type_name -> v1.OperationEventType
-	3,  // 10: v1.OperationEvent.operation:type_name -> v1.Operation
-	12, // 11: v1.OperationBackup.last_status:type_name -> v1.BackupProgressEntry
-	13, // 12: v1.OperationBackup.errors:type_name -> v1.BackupProgressError
-	14, // 13: v1.OperationIndexSnapshot.snapshot:type_name -> v1.ResticSnapshot
-	14, // 14: v1.OperationForget.forget:type_name -> v1.ResticSnapshot
-	15, // 15: v1.OperationForget.policy:type_name -> v1.RetentionPolicy
-	16, // 16: v1.OperationRestore.status:type_name -> v1.RestoreProgressEntry
-	17, // 17: v1.OperationStats.stats:type_name -> v1.RepoStats
-	18, // [18:18] is the sub-list for method output_type
-	18, // [18:18] is the sub-list for method input_type
-	18, // [18:18] is the sub-list for extension type_name
-	18, // [18:18] is the sub-list for extension extendee
-	0,  // [0:18] is the sub-list for field type_name
+	7,  // 0: v1.OperationList.operations:type_name -> v1.Operation
+	4,  // 1: v1.ResourceUsageSummary.total:type_name -> v1.ResourceUsage
+	1,  // 2: v1.Run.status:type_name -> v1.OperationStatus
+	7,  // 3: v1.Run.operations:type_name -> v1.Operation
+	1,  // 4: v1.Operation.status:type_name -> v1.OperationStatus
+	25, // 5: v1.Operation.error_class:type_name -> v1.ErrorClass
+	4,  // 6: v1.Operation.resource_usage:type_name -> v1.ResourceUsage
+	9,  // 7: v1.Operation.acknowledgement:type_name -> v1.OperationAcknowledgement
+	10, // 8: v1.Operation.operation_backup:type_name -> v1.OperationBackup
+	13, // 9: v1.Operation.operation_index_snapshot:type_name -> v1.OperationIndexSnapshot
+	14, // 10: v1.Operation.operation_forget:type_name -> v1.OperationForget
+	15, // 11: v1.Operation.operation_prune:type_name -> v1.OperationPrune
+	17, // 12: v1.Operation.operation_restore:type_name -> v1.OperationRestore
+	18, // 13: v1.Operation.operation_stats:type_name -> v1.OperationStats
+	19, // 14: v1.Operation.operation_run_hook:type_name -> v1.OperationRunHook
+	20, // 15: v1.Operation.operation_migrate:type_name -> v1.OperationMigrate
+	24, // 16: v1.Operation.operation_restore_drill:type_name -> v1.OperationRestoreDrill
+	21, // 17: v1.Operation.operation_cache_cleanup:type_name -> v1.OperationCacheCleanup
+	22, // 18: v1.Operation.operation_warmup:type_name -> v1.OperationWarmup
+	23, // 19: v1.Operation.operation_benchmark:type_name -> v1.OperationBenchmark
+	0,  // 20: v1.OperationEvent.type:type_name -> v1.OperationEventType
+	7,  // 21: v1.OperationEvent.operation:type_name -> v1.Operation
+	26, // 22: v1.OperationBackup.last_status:type_name -> v1.BackupProgressEntry
+	27, // 23: v1.OperationBackup.errors:type_name -> v1.BackupProgressError
+	12, // 24: v1.OperationBackup.data_added:type_name -> v1.DataAddedEntry
+	28, // 25: v1.OperationBackup.verification:type_name -> v1.RestoreVerification
+	11, // 26: v1.OperationBackup.anomaly:type_name -> v1.BackupAnomaly
+	29, // 27: v1.OperationIndexSnapshot.snapshot:type_name -> v1.ResticSnapshot
+	29, // 28: v1.OperationForget.forget:type_name -> v1.ResticSnapshot
+	30, // 29: v1.OperationForget.policy:type_name -> v1.RetentionPolicy
+	29, // 30: v1.OperationForget.marked:type_name -> v1.ResticSnapshot
+	16, // 31: v1.OperationPrune.stats:type_name -> v1.PruneStats
+	31, // 32: v1.OperationRestore.status:type_name -> v1.RestoreProgressEntry
+	32, // 33: v1.OperationRestore.options:type_name -> v1.RestoreOptions
+	28, // 34: v1.OperationRestore.verification:type_name -> v1.RestoreVerification
+	33, // 35: v1.OperationStats.stats:type_name -> v1.RepoStats
+	31, // 36: v1.OperationRestoreDrill.status:type_name -> v1.RestoreProgressEntry
+	28, // 37: v1.OperationRestoreDrill.verification:type_name -> v1.RestoreVerification
+	38, // [38:38] is the sub-list for method output_type
+	38, // [38:38] is the sub-list for method input_type
+	38, // [38:38] is the sub-list for extension type_name
+	38, // [38:38] is the sub-list for extension extendee
+	0,  // [0:38] is the sub-list for field type_name
 }
 
 func init() { file_v1_operations_proto_init() }
@@ -1062,7 +2506,7 @@ func file_v1_operations_proto_init() {
 			}
 		}
 		file_v1_operations_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Operation); i {
+			switch v := v.(*DurationEstimate); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1074,7 +2518,7 @@ func file_v1_operations_proto_init() {
 			}
 		}
 		file_v1_operations_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OperationEvent); i {
+			switch v := v.(*ResourceUsage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1086,7 +2530,7 @@ func file_v1_operations_proto_init() {
 			}
 		}
 		file_v1_operations_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OperationBackup); i {
+			switch v := v.(*ResourceUsageSummary); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1098,7 +2542,7 @@ func file_v1_operations_proto_init() {
 			}
 		}
 		file_v1_operations_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OperationIndexSnapshot); i {
+			switch v := v.(*Run); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1110,7 +2554,7 @@ func file_v1_operations_proto_init() {
 			}
 		}
 		file_v1_operations_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OperationForget); i {
+			switch v := v.(*Operation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1122,7 +2566,7 @@ func file_v1_operations_proto_init() {
 			}
 		}
 		file_v1_operations_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OperationPrune); i {
+			switch v := v.(*OperationEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1134,7 +2578,7 @@ func file_v1_operations_proto_init() {
 			}
 		}
 		file_v1_operations_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OperationRestore); i {
+			switch v := v.(*OperationAcknowledgement); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1146,7 +2590,7 @@ func file_v1_operations_proto_init() {
 			}
 		}
 		file_v1_operations_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OperationStats); i {
+			switch v := v.(*OperationBackup); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1158,6 +2602,102 @@ func file_v1_operations_proto_init() {
 			}
 		}
 		file_v1_operations_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackupAnomaly); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_operations_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DataAddedEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_operations_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperationIndexSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_operations_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperationForget); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_operations_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperationPrune); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_operations_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PruneStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_operations_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperationRestore); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_operations_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperationStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_operations_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*OperationRunHook); i {
 			case 0:
 				return &v.state
@@ -1169,8 +2709,68 @@ func file_v1_operations_proto_init() {
 				return nil
 			}
 		}
+		file_v1_operations_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperationMigrate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_operations_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperationCacheCleanup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_operations_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperationWarmup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_operations_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperationBenchmark); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_operations_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperationRestoreDrill); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_v1_operations_proto_msgTypes[1].OneofWrappers = []interface{}{
+	file_v1_operations_proto_msgTypes[5].OneofWrappers = []interface{}{
 		(*Operation_OperationBackup)(nil),
 		(*Operation_OperationIndexSnapshot)(nil),
 		(*Operation_OperationForget)(nil),
@@ -1178,6 +2778,11 @@ func file_v1_operations_proto_init() {
 		(*Operation_OperationRestore)(nil),
 		(*Operation_OperationStats)(nil),
 		(*Operation_OperationRunHook)(nil),
+		(*Operation_OperationMigrate)(nil),
+		(*Operation_OperationRestoreDrill)(nil),
+		(*Operation_OperationCacheCleanup)(nil),
+		(*Operation_OperationWarmup)(nil),
+		(*Operation_OperationBenchmark)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1185,7 +2790,7 @@ func file_v1_operations_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_v1_operations_proto_rawDesc,
 			NumEnums:      2,
-			NumMessages:   10,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -20,6 +20,120 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// ErrorClass is the kind of failure a task ran into, derived from restic's output and exit code.
+type ErrorClass int32
+
+const (
+	ErrorClass_ERROR_CLASS_UNKNOWN    ErrorClass = 0 // unclassified or no error.
+	ErrorClass_ERROR_CLASS_AUTH       ErrorClass = 1 // wrong repo password or rejected backend credentials.
+	ErrorClass_ERROR_CLASS_NETWORK    ErrorClass = 2 // the backend could not be reached or the connection timed out.
+	ErrorClass_ERROR_CLASS_LOCKED     ErrorClass = 3 // the repo is locked by another process.
+	ErrorClass_ERROR_CLASS_NO_SPACE   ErrorClass = 4 // a file system or the backend ran out of space.
+	ErrorClass_ERROR_CLASS_PERMISSION ErrorClass = 5 // access to a file or the repo was denied.
+	ErrorClass_ERROR_CLASS_CORRUPTED  ErrorClass = 6 // the repo contains damaged packs, blobs or indexes.
+	ErrorClass_ERROR_CLASS_TIMEOUT    ErrorClass = 7 // the task exceeded its timeout.
+)
+
+// Enum value maps for ErrorClass.
+var (
+	ErrorClass_name = map[int32]string{
+		0: "ERROR_CLASS_UNKNOWN",
+		1: "ERROR_CLASS_AUTH",
+		2: "ERROR_CLASS_NETWORK",
+		3: "ERROR_CLASS_LOCKED",
+		4: "ERROR_CLASS_NO_SPACE",
+		5: "ERROR_CLASS_PERMISSION",
+		6: "ERROR_CLASS_CORRUPTED",
+		7: "ERROR_CLASS_TIMEOUT",
+	}
+	ErrorClass_value = map[string]int32{
+		"ERROR_CLASS_UNKNOWN":    0,
+		"ERROR_CLASS_AUTH":       1,
+		"ERROR_CLASS_NETWORK":    2,
+		"ERROR_CLASS_LOCKED":     3,
+		"ERROR_CLASS_NO_SPACE":   4,
+		"ERROR_CLASS_PERMISSION": 5,
+		"ERROR_CLASS_CORRUPTED":  6,
+		"ERROR_CLASS_TIMEOUT":    7,
+	}
+)
+
+func (x ErrorClass) Enum() *ErrorClass {
+	p := new(ErrorClass)
+	*p = x
+	return p
+}
+
+func (x ErrorClass) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ErrorClass) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_restic_proto_enumTypes[0].Descriptor()
+}
+
+func (ErrorClass) Type() protoreflect.EnumType {
+	return &file_v1_restic_proto_enumTypes[0]
+}
+
+func (x ErrorClass) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ErrorClass.Descriptor instead.
+func (ErrorClass) EnumDescriptor() ([]byte, []int) {
+	return file_v1_restic_proto_rawDescGZIP(), []int{0}
+}
+
+type RestoreOptions_OverwritePolicy int32
+
+const (
+	RestoreOptions_OVERWRITE_ALWAYS   RestoreOptions_OverwritePolicy = 0 // replace files that already exist at the target.
+	RestoreOptions_OVERWRITE_NEVER    RestoreOptions_OverwritePolicy = 1 // skip files that already exist at the target.
+	RestoreOptions_OVERWRITE_IF_NEWER RestoreOptions_OverwritePolicy = 2 // replace existing files only if the snapshot's copy has a newer mtime.
+)
+
+// Enum value maps for RestoreOptions_OverwritePolicy.
+var (
+	RestoreOptions_OverwritePolicy_name = map[int32]string{
+		0: "OVERWRITE_ALWAYS",
+		1: "OVERWRITE_NEVER",
+		2: "OVERWRITE_IF_NEWER",
+	}
+	RestoreOptions_OverwritePolicy_value = map[string]int32{
+		"OVERWRITE_ALWAYS":   0,
+		"OVERWRITE_NEVER":    1,
+		"OVERWRITE_IF_NEWER": 2,
+	}
+)
+
+func (x RestoreOptions_OverwritePolicy) Enum() *RestoreOptions_OverwritePolicy {
+	p := new(RestoreOptions_OverwritePolicy)
+	*p = x
+	return p
+}
+
+func (x RestoreOptions_OverwritePolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RestoreOptions_OverwritePolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_v1_restic_proto_enumTypes[1].Descriptor()
+}
+
+func (RestoreOptions_OverwritePolicy) Type() protoreflect.EnumType {
+	return &file_v1_restic_proto_enumTypes[1]
+}
+
+func (x RestoreOptions_OverwritePolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RestoreOptions_OverwritePolicy.Descriptor instead.
+func (RestoreOptions_OverwritePolicy) EnumDescriptor() ([]byte, []int) {
+	return file_v1_restic_proto_rawDescGZIP(), []int{7, 0}
+}
+
 // ResticSnapshot represents a restic snapshot.
 type ResticSnapshot struct {
 	state         protoimpl.MessageState
@@ -648,6 +762,158 @@ func (x *RestoreProgressEntry) GetPercentDone() float64 {
 	return 0
 }
 
+// RestoreOptions controls how a snapshot is restored.
+type RestoreOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RestoreToOriginal bool                           `protobuf:"varint,1,opt,name=restore_to_original,json=restoreToOriginal,proto3" json:"restore_to_original,omitempty"` // restore files to their original location, target is ignored.
+	Overwrite         RestoreOptions_OverwritePolicy `protobuf:"varint,2,opt,name=overwrite,proto3,enum=v1.RestoreOptions_OverwritePolicy" json:"overwrite,omitempty"`
+	Includes          []string                       `protobuf:"bytes,3,rep,name=includes,proto3" json:"includes,omitempty"`                                       // restic include patterns, relative patterns are matched beneath the restored path.
+	Excludes          []string                       `protobuf:"bytes,4,rep,name=excludes,proto3" json:"excludes,omitempty"`                                       // restic exclude patterns.
+	SkipOwnership     bool                           `protobuf:"varint,5,opt,name=skip_ownership,json=skipOwnership,proto3" json:"skip_ownership,omitempty"`       // restored files are owned by the user running backrest rather than the snapshot's owner.
+	SkipPermissions   bool                           `protobuf:"varint,6,opt,name=skip_permissions,json=skipPermissions,proto3" json:"skip_permissions,omitempty"` // restored files get default permissions rather than the snapshot's mode.
+	Verify            bool                           `protobuf:"varint,7,opt,name=verify,proto3" json:"verify,omitempty"`                                          // verify the restored files against the snapshot once the restore completes.
+}
+
+func (x *RestoreOptions) Reset() {
+	*x = RestoreOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_restic_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreOptions) ProtoMessage() {}
+
+func (x *RestoreOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_restic_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreOptions.ProtoReflect.Descriptor instead.
+func (*RestoreOptions) Descriptor() ([]byte, []int) {
+	return file_v1_restic_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RestoreOptions) GetRestoreToOriginal() bool {
+	if x != nil {
+		return x.RestoreToOriginal
+	}
+	return false
+}
+
+func (x *RestoreOptions) GetOverwrite() RestoreOptions_OverwritePolicy {
+	if x != nil {
+		return x.Overwrite
+	}
+	return RestoreOptions_OVERWRITE_ALWAYS
+}
+
+func (x *RestoreOptions) GetIncludes() []string {
+	if x != nil {
+		return x.Includes
+	}
+	return nil
+}
+
+func (x *RestoreOptions) GetExcludes() []string {
+	if x != nil {
+		return x.Excludes
+	}
+	return nil
+}
+
+func (x *RestoreOptions) GetSkipOwnership() bool {
+	if x != nil {
+		return x.SkipOwnership
+	}
+	return false
+}
+
+func (x *RestoreOptions) GetSkipPermissions() bool {
+	if x != nil {
+		return x.SkipPermissions
+	}
+	return false
+}
+
+func (x *RestoreOptions) GetVerify() bool {
+	if x != nil {
+		return x.Verify
+	}
+	return false
+}
+
+// RestoreVerification is the result of checking restored files against the snapshot.
+type RestoreVerification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FilesChecked int64    `protobuf:"varint,1,opt,name=files_checked,json=filesChecked,proto3" json:"files_checked,omitempty"`
+	Mismatches   []string `protobuf:"bytes,2,rep,name=mismatches,proto3" json:"mismatches,omitempty"` // one entry per restored path that does not match the snapshot.
+}
+
+func (x *RestoreVerification) Reset() {
+	*x = RestoreVerification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v1_restic_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreVerification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreVerification) ProtoMessage() {}
+
+func (x *RestoreVerification) ProtoReflect() protoreflect.Message {
+	mi := &file_v1_restic_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreVerification.ProtoReflect.Descriptor instead.
+func (*RestoreVerification) Descriptor() ([]byte, []int) {
+	return file_v1_restic_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RestoreVerification) GetFilesChecked() int64 {
+	if x != nil {
+		return x.FilesChecked
+	}
+	return 0
+}
+
+func (x *RestoreVerification) GetMismatches() []string {
+	if x != nil {
+		return x.Mismatches
+	}
+	return nil
+}
+
 type RepoStats struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -658,12 +924,14 @@ type RepoStats struct {
 	CompressionRatio      float64 `protobuf:"fixed64,3,opt,name=compression_ratio,json=compressionRatio,proto3" json:"compression_ratio,omitempty"`
 	TotalBlobCount        int64   `protobuf:"varint,5,opt,name=total_blob_count,json=totalBlobCount,proto3" json:"total_blob_count,omitempty"`
 	SnapshotCount         int64   `protobuf:"varint,6,opt,name=snapshot_count,json=snapshotCount,proto3" json:"snapshot_count,omitempty"`
+	RestoreSize           int64   `protobuf:"varint,7,opt,name=restore_size,json=restoreSize,proto3" json:"restore_size,omitempty"`                  // total size of the repo's snapshots if restored, from restic stats --mode restore-size.
+	RestoreFileCount      int64   `protobuf:"varint,8,opt,name=restore_file_count,json=restoreFileCount,proto3" json:"restore_file_count,omitempty"` // number of files and directories in the repo's snapshots if restored.
 }
 
 func (x *RepoStats) Reset() {
 	*x = RepoStats{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v1_restic_proto_msgTypes[7]
+		mi := &file_v1_restic_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -676,7 +944,7 @@ func (x *RepoStats) String() string {
 func (*RepoStats) ProtoMessage() {}
 
 func (x *RepoStats) ProtoReflect() protoreflect.Message {
-	mi := &file_v1_restic_proto_msgTypes[7]
+	mi := &file_v1_restic_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -689,7 +957,7 @@ func (x *RepoStats) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RepoStats.ProtoReflect.Descriptor instead.
 func (*RepoStats) Descriptor() ([]byte, []int) {
-	return file_v1_restic_proto_rawDescGZIP(), []int{7}
+	return file_v1_restic_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *RepoStats) GetTotalSize() int64 {
@@ -727,6 +995,20 @@ func (x *RepoStats) GetSnapshotCount() int64 {
 	return 0
 }
 
+func (x *RepoStats) GetRestoreSize() int64 {
+	if x != nil {
+		return x.RestoreSize
+	}
+	return 0
+}
+
+func (x *RepoStats) GetRestoreFileCount() int64 {
+	if x != nil {
+		return x.RestoreFileCount
+	}
+	return 0
+}
+
 var File_v1_restic_proto protoreflect.FileDescriptor
 
 var file_v1_restic_proto_rawDesc = []byte{
@@ -827,24 +1109,73 @@ var file_v1_restic_proto_rawDesc = []byte{
 	0x01, 0x28, 0x03, 0x52, 0x0d, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72,
 	0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x6f,
 	0x6e, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
-	0x74, 0x44, 0x6f, 0x6e, 0x65, 0x22, 0xe0, 0x01, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6f, 0x53, 0x74,
-	0x61, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x7a,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69,
-	0x7a, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x75, 0x6e, 0x63, 0x6f,
-	0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x15, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x55, 0x6e, 0x63, 0x6f, 0x6d, 0x70,
-	0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f,
-	0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x28, 0x0a, 0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c,
-	0x5f, 0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x6c, 0x6f, 0x62, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x73, 0x6e, 0x61, 0x70, 0x73,
-	0x68, 0x6f, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x2c, 0x5a, 0x2a, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x61, 0x72, 0x65, 0x74, 0x68, 0x67, 0x65, 0x6f,
-	0x72, 0x67, 0x65, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x72, 0x65, 0x73, 0x74, 0x2f, 0x67, 0x65, 0x6e,
-	0x2f, 0x67, 0x6f, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x44, 0x6f, 0x6e, 0x65, 0x22, 0xfa, 0x02, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x72, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x54, 0x6f,
+	0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x12, 0x40, 0x0a, 0x09, 0x6f, 0x76, 0x65, 0x72,
+	0x77, 0x72, 0x69, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x4f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52,
+	0x09, 0x6f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x6b, 0x69, 0x70, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x73, 0x68, 0x69, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x73, 0x6b, 0x69, 0x70,
+	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x29, 0x0a, 0x10, 0x73, 0x6b, 0x69,
+	0x70, 0x5f, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0f, 0x73, 0x6b, 0x69, 0x70, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x22, 0x54, 0x0a, 0x0f,
+	0x4f, 0x76, 0x65, 0x72, 0x77, 0x72, 0x69, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12,
+	0x14, 0x0a, 0x10, 0x4f, 0x56, 0x45, 0x52, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f, 0x41, 0x4c, 0x57,
+	0x41, 0x59, 0x53, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x4f, 0x56, 0x45, 0x52, 0x57, 0x52, 0x49,
+	0x54, 0x45, 0x5f, 0x4e, 0x45, 0x56, 0x45, 0x52, 0x10, 0x01, 0x12, 0x16, 0x0a, 0x12, 0x4f, 0x56,
+	0x45, 0x52, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f, 0x49, 0x46, 0x5f, 0x4e, 0x45, 0x57, 0x45, 0x52,
+	0x10, 0x02, 0x22, 0x5a, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x69, 0x6c,
+	0x65, 0x73, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0c, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x1e,
+	0x0a, 0x0a, 0x6d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0a, 0x6d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x22, 0xb1,
+	0x02, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x75, 0x6e, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65,
+	0x64, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x55, 0x6e, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10,
+	0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x69, 0x6f,
+	0x12, 0x28, 0x0a, 0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x42, 0x6c, 0x6f, 0x62, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0d, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f,
+	0x66, 0x69, 0x6c, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x10, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x2a, 0xd6, 0x01, 0x0a, 0x0a, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6c, 0x61, 0x73,
+	0x73, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53,
+	0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x45, 0x52,
+	0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x10, 0x01,
+	0x12, 0x17, 0x0a, 0x13, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f,
+	0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x45, 0x52, 0x52,
+	0x4f, 0x52, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x45, 0x44, 0x10,
+	0x03, 0x12, 0x18, 0x0a, 0x14, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53,
+	0x5f, 0x4e, 0x4f, 0x5f, 0x53, 0x50, 0x41, 0x43, 0x45, 0x10, 0x04, 0x12, 0x1a, 0x0a, 0x16, 0x45,
+	0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f, 0x50, 0x45, 0x52, 0x4d, 0x49,
+	0x53, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x05, 0x12, 0x19, 0x0a, 0x15, 0x45, 0x52, 0x52, 0x4f, 0x52,
+	0x5f, 0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x55, 0x50, 0x54, 0x45, 0x44,
+	0x10, 0x06, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x43, 0x4c, 0x41, 0x53,
+	0x53, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10, 0x07, 0x42, 0x2c, 0x5a, 0x2a, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x61, 0x72, 0x65, 0x74, 0x68,
+	0x67, 0x65, 0x6f, 0x72, 0x67, 0x65, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x72, 0x65, 0x73, 0x74, 0x2f,
+	0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -859,26 +1190,32 @@ func file_v1_restic_proto_rawDescGZIP() []byte {
 	return file_v1_restic_proto_rawDescData
 }
 
-var file_v1_restic_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_v1_restic_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_v1_restic_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_v1_restic_proto_goTypes = []interface{}{
-	(*ResticSnapshot)(nil),            // 0: v1.ResticSnapshot
-	(*ResticSnapshotList)(nil),        // 1: v1.ResticSnapshotList
-	(*BackupProgressEntry)(nil),       // 2: v1.BackupProgressEntry
-	(*BackupProgressStatusEntry)(nil), // 3: v1.BackupProgressStatusEntry
-	(*BackupProgressSummary)(nil),     // 4: v1.BackupProgressSummary
-	(*BackupProgressError)(nil),       // 5: v1.BackupProgressError
-	(*RestoreProgressEntry)(nil),      // 6: v1.RestoreProgressEntry
-	(*RepoStats)(nil),                 // 7: v1.RepoStats
+	(ErrorClass)(0),                     // 0: v1.ErrorClass
+	(RestoreOptions_OverwritePolicy)(0), // 1: v1.RestoreOptions.OverwritePolicy
+	(*ResticSnapshot)(nil),              // 2: v1.ResticSnapshot
+	(*ResticSnapshotList)(nil),          // 3: v1.ResticSnapshotList
+	(*BackupProgressEntry)(nil),         // 4: v1.BackupProgressEntry
+	(*BackupProgressStatusEntry)(nil),   // 5: v1.BackupProgressStatusEntry
+	(*BackupProgressSummary)(nil),       // 6: v1.BackupProgressSummary
+	(*BackupProgressError)(nil),         // 7: v1.BackupProgressError
+	(*RestoreProgressEntry)(nil),        // 8: v1.RestoreProgressEntry
+	(*RestoreOptions)(nil),              // 9: v1.RestoreOptions
+	(*RestoreVerification)(nil),         // 10: v1.RestoreVerification
+	(*RepoStats)(nil),                   // 11: v1.RepoStats
 }
 var file_v1_restic_proto_depIdxs = []int32{
-	0, // 0: v1.ResticSnapshotList.snapshots:type_name -> v1.ResticSnapshot
-	3, // 1: v1.BackupProgressEntry.status:type_name -> v1.BackupProgressStatusEntry
-	4, // 2: v1.BackupProgressEntry.summary:type_name -> v1.BackupProgressSummary
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	2, // 0: v1.ResticSnapshotList.snapshots:type_name -> v1.ResticSnapshot
+	5, // 1: v1.BackupProgressEntry.status:type_name -> v1.BackupProgressStatusEntry
+	6, // 2: v1.BackupProgressEntry.summary:type_name -> v1.BackupProgressSummary
+	1, // 3: v1.RestoreOptions.overwrite:type_name -> v1.RestoreOptions.OverwritePolicy
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_v1_restic_proto_init() }
@@ -972,6 +1309,30 @@ func file_v1_restic_proto_init() {
 			}
 		}
 		file_v1_restic_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_restic_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreVerification); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v1_restic_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RepoStats); i {
 			case 0:
 				return &v.state
@@ -993,13 +1354,14 @@ func file_v1_restic_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_v1_restic_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   8,
+			NumEnums:      2,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_v1_restic_proto_goTypes,
 		DependencyIndexes: file_v1_restic_proto_depIdxs,
+		EnumInfos:         file_v1_restic_proto_enumTypes,
 		MessageInfos:      file_v1_restic_proto_msgTypes,
 	}.Build()
 	File_v1_restic_proto = out.File
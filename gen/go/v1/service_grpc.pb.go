@@ -21,24 +21,64 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Backrest_GetConfig_FullMethodName          = "/v1.Backrest/GetConfig"
-	Backrest_SetConfig_FullMethodName          = "/v1.Backrest/SetConfig"
-	Backrest_AddRepo_FullMethodName            = "/v1.Backrest/AddRepo"
-	Backrest_GetOperationEvents_FullMethodName = "/v1.Backrest/GetOperationEvents"
-	Backrest_GetOperations_FullMethodName      = "/v1.Backrest/GetOperations"
-	Backrest_ListSnapshots_FullMethodName      = "/v1.Backrest/ListSnapshots"
-	Backrest_ListSnapshotFiles_FullMethodName  = "/v1.Backrest/ListSnapshotFiles"
-	Backrest_IndexSnapshots_FullMethodName     = "/v1.Backrest/IndexSnapshots"
-	Backrest_Backup_FullMethodName             = "/v1.Backrest/Backup"
-	Backrest_Prune_FullMethodName              = "/v1.Backrest/Prune"
-	Backrest_Forget_FullMethodName             = "/v1.Backrest/Forget"
-	Backrest_Restore_FullMethodName            = "/v1.Backrest/Restore"
-	Backrest_Unlock_FullMethodName             = "/v1.Backrest/Unlock"
-	Backrest_Stats_FullMethodName              = "/v1.Backrest/Stats"
-	Backrest_Cancel_FullMethodName             = "/v1.Backrest/Cancel"
-	Backrest_GetLogs_FullMethodName            = "/v1.Backrest/GetLogs"
-	Backrest_ClearHistory_FullMethodName       = "/v1.Backrest/ClearHistory"
-	Backrest_PathAutocomplete_FullMethodName   = "/v1.Backrest/PathAutocomplete"
+	Backrest_GetConfig_FullMethodName                = "/v1.Backrest/GetConfig"
+	Backrest_SetConfig_FullMethodName                = "/v1.Backrest/SetConfig"
+	Backrest_AddRepo_FullMethodName                  = "/v1.Backrest/AddRepo"
+	Backrest_ImportRepo_FullMethodName               = "/v1.Backrest/ImportRepo"
+	Backrest_ProbeRepo_FullMethodName                = "/v1.Backrest/ProbeRepo"
+	Backrest_GenerateRepoPassword_FullMethodName     = "/v1.Backrest/GenerateRepoPassword"
+	Backrest_InitRepo_FullMethodName                 = "/v1.Backrest/InitRepo"
+	Backrest_GetConfigHistory_FullMethodName         = "/v1.Backrest/GetConfigHistory"
+	Backrest_RollbackConfig_FullMethodName           = "/v1.Backrest/RollbackConfig"
+	Backrest_GetOperationEvents_FullMethodName       = "/v1.Backrest/GetOperationEvents"
+	Backrest_SubscribeOperations_FullMethodName      = "/v1.Backrest/SubscribeOperations"
+	Backrest_GetOperations_FullMethodName            = "/v1.Backrest/GetOperations"
+	Backrest_QueryOperations_FullMethodName          = "/v1.Backrest/QueryOperations"
+	Backrest_ListSnapshots_FullMethodName            = "/v1.Backrest/ListSnapshots"
+	Backrest_ListSnapshotFiles_FullMethodName        = "/v1.Backrest/ListSnapshotFiles"
+	Backrest_GetLargestFiles_FullMethodName          = "/v1.Backrest/GetLargestFiles"
+	Backrest_GetFileHistory_FullMethodName           = "/v1.Backrest/GetFileHistory"
+	Backrest_GetReclaimedSpace_FullMethodName        = "/v1.Backrest/GetReclaimedSpace"
+	Backrest_IndexSnapshots_FullMethodName           = "/v1.Backrest/IndexSnapshots"
+	Backrest_Backup_FullMethodName                   = "/v1.Backrest/Backup"
+	Backrest_AdhocBackup_FullMethodName              = "/v1.Backrest/AdhocBackup"
+	Backrest_Prune_FullMethodName                    = "/v1.Backrest/Prune"
+	Backrest_Forget_FullMethodName                   = "/v1.Backrest/Forget"
+	Backrest_Restore_FullMethodName                  = "/v1.Backrest/Restore"
+	Backrest_Unlock_FullMethodName                   = "/v1.Backrest/Unlock"
+	Backrest_Stats_FullMethodName                    = "/v1.Backrest/Stats"
+	Backrest_Cancel_FullMethodName                   = "/v1.Backrest/Cancel"
+	Backrest_GetLogs_FullMethodName                  = "/v1.Backrest/GetLogs"
+	Backrest_ClearHistory_FullMethodName             = "/v1.Backrest/ClearHistory"
+	Backrest_PathAutocomplete_FullMethodName         = "/v1.Backrest/PathAutocomplete"
+	Backrest_GetRepoHealth_FullMethodName            = "/v1.Backrest/GetRepoHealth"
+	Backrest_CheckOplogIntegrity_FullMethodName      = "/v1.Backrest/CheckOplogIntegrity"
+	Backrest_GetAuditLog_FullMethodName              = "/v1.Backrest/GetAuditLog"
+	Backrest_ExportAuditLog_FullMethodName           = "/v1.Backrest/ExportAuditLog"
+	Backrest_ListRepoMigrations_FullMethodName       = "/v1.Backrest/ListRepoMigrations"
+	Backrest_GetRepoCacheStats_FullMethodName        = "/v1.Backrest/GetRepoCacheStats"
+	Backrest_MigrateRepo_FullMethodName              = "/v1.Backrest/MigrateRepo"
+	Backrest_WarmupRepo_FullMethodName               = "/v1.Backrest/WarmupRepo"
+	Backrest_GetRecoveryBundle_FullMethodName        = "/v1.Backrest/GetRecoveryBundle"
+	Backrest_GetNotificationTemplates_FullMethodName = "/v1.Backrest/GetNotificationTemplates"
+	Backrest_GetRemoteStatus_FullMethodName          = "/v1.Backrest/GetRemoteStatus"
+	Backrest_GetRemoteOperations_FullMethodName      = "/v1.Backrest/GetRemoteOperations"
+	Backrest_GetRuns_FullMethodName                  = "/v1.Backrest/GetRuns"
+	Backrest_GetDurationEstimates_FullMethodName     = "/v1.Backrest/GetDurationEstimates"
+	Backrest_GetResourceUsage_FullMethodName         = "/v1.Backrest/GetResourceUsage"
+	Backrest_UpdateRestic_FullMethodName             = "/v1.Backrest/UpdateRestic"
+	Backrest_GetMetricSeries_FullMethodName          = "/v1.Backrest/GetMetricSeries"
+	Backrest_GetExclusionSuggestions_FullMethodName  = "/v1.Backrest/GetExclusionSuggestions"
+	Backrest_AnnotateSnapshot_FullMethodName         = "/v1.Backrest/AnnotateSnapshot"
+	Backrest_PinSnapshot_FullMethodName              = "/v1.Backrest/PinSnapshot"
+	Backrest_RescueSnapshot_FullMethodName           = "/v1.Backrest/RescueSnapshot"
+	Backrest_EmergencyStop_FullMethodName            = "/v1.Backrest/EmergencyStop"
+	Backrest_ResumeSchedules_FullMethodName          = "/v1.Backrest/ResumeSchedules"
+	Backrest_InstantiatePlanTemplate_FullMethodName  = "/v1.Backrest/InstantiatePlanTemplate"
+	Backrest_DeletePlan_FullMethodName               = "/v1.Backrest/DeletePlan"
+	Backrest_DeleteRepo_FullMethodName               = "/v1.Backrest/DeleteRepo"
+	Backrest_AcknowledgeOperation_FullMethodName     = "/v1.Backrest/AcknowledgeOperation"
+	Backrest_BenchmarkRepo_FullMethodName            = "/v1.Backrest/BenchmarkRepo"
 )
 
 // BackrestClient is the client API for Backrest service.
@@ -48,14 +88,40 @@ type BackrestClient interface {
 	GetConfig(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Config, error)
 	SetConfig(ctx context.Context, in *Config, opts ...grpc.CallOption) (*Config, error)
 	AddRepo(ctx context.Context, in *Repo, opts ...grpc.CallOption) (*Config, error)
+	// ImportRepo adds a pre-existing restic repository without initializing it and indexes its snapshots grouped by hostname and tags.
+	ImportRepo(ctx context.Context, in *Repo, opts ...grpc.CallOption) (*Config, error)
+	// ProbeRepo checks whether a restic repo exists at the repo's URI and whether the repo's password opens it.
+	ProbeRepo(ctx context.Context, in *Repo, opts ...grpc.CallOption) (*RepoProbe, error)
+	// GenerateRepoPassword returns a random password for a new repo.
+	GenerateRepoPassword(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*types.StringValue, error)
+	// InitRepo initializes a new restic repo, verifies that it opens and adds it to the config. A local repo it created
+	// is removed again if a step fails. Unlike AddRepo it fails if a repo already exists at the URI.
+	InitRepo(ctx context.Context, in *InitRepoRequest, opts ...grpc.CallOption) (*Config, error)
+	// GetConfigHistory returns the revisions of the config that have been written, oldest first.
+	GetConfigHistory(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ConfigRevisionList, error)
+	// RollbackConfig restores the config to the given revision. The rollback is itself recorded as a new revision.
+	RollbackConfig(ctx context.Context, in *types.Int64Value, opts ...grpc.CallOption) (*Config, error)
 	GetOperationEvents(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (Backrest_GetOperationEventsClient, error)
+	// SubscribeOperations streams the oplog events matching the request's filters as they happen.
+	SubscribeOperations(ctx context.Context, in *SubscribeOperationsRequest, opts ...grpc.CallOption) (Backrest_SubscribeOperationsClient, error)
 	GetOperations(ctx context.Context, in *GetOperationsRequest, opts ...grpc.CallOption) (*OperationList, error)
+	// QueryOperations returns a page of the operations matching the request's filters.
+	QueryOperations(ctx context.Context, in *QueryOperationsRequest, opts ...grpc.CallOption) (*QueryOperationsResponse, error)
 	ListSnapshots(ctx context.Context, in *ListSnapshotsRequest, opts ...grpc.CallOption) (*ResticSnapshotList, error)
 	ListSnapshotFiles(ctx context.Context, in *ListSnapshotFilesRequest, opts ...grpc.CallOption) (*ListSnapshotFilesResponse, error)
+	// GetLargestFiles returns the largest files and directories of a snapshot, computed once per snapshot and cached.
+	GetLargestFiles(ctx context.Context, in *GetLargestFilesRequest, opts ...grpc.CallOption) (*GetLargestFilesResponse, error)
+	// GetFileHistory returns the versions of a path across the snapshots of a repo, oldest first.
+	GetFileHistory(ctx context.Context, in *GetFileHistoryRequest, opts ...grpc.CallOption) (*FileHistory, error)
+	// GetReclaimedSpace returns the space freed by the prunes and the snapshots removed by the forgets of a repo over
+	// time, the value is the repo ID.
+	GetReclaimedSpace(ctx context.Context, in *types.StringValue, opts ...grpc.CallOption) (*ReclaimedSpace, error)
 	// IndexSnapshots triggers indexin. It accepts a repo id and returns empty if the task is enqueued.
 	IndexSnapshots(ctx context.Context, in *types.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Backup schedules a backup operation. It accepts a plan id and returns empty if the task is enqueued.
 	Backup(ctx context.Context, in *types.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// AdhocBackup runs a one-off backup of paths that need not belong to any plan, returns once the backup completes.
+	AdhocBackup(ctx context.Context, in *AdhocBackupRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Prune schedules a prune operation. It accepts a plan id and returns empty if the task is enqueued.
 	Prune(ctx context.Context, in *types.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Forget schedules a forget operation. It accepts a plan id and returns empty if the task is enqueued.
@@ -74,6 +140,74 @@ type BackrestClient interface {
 	ClearHistory(ctx context.Context, in *ClearHistoryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// PathAutocomplete provides path autocompletion options for a given filesystem path.
 	PathAutocomplete(ctx context.Context, in *types.StringValue, opts ...grpc.CallOption) (*types.StringList, error)
+	// GetRepoHealth returns the health of a repo, expensive checks are only run if requested.
+	GetRepoHealth(ctx context.Context, in *GetRepoHealthRequest, opts ...grpc.CallOption) (*RepoHealth, error)
+	// CheckOplogIntegrity runs a consistency pass over the operation log, optionally repairing the problems found.
+	CheckOplogIntegrity(ctx context.Context, in *CheckOplogIntegrityRequest, opts ...grpc.CallOption) (*OplogIntegrityReport, error)
+	// GetAuditLog returns the audit log entries matching the request, oldest first.
+	GetAuditLog(ctx context.Context, in *GetAuditLogRequest, opts ...grpc.CallOption) (*AuditEntryList, error)
+	// ExportAuditLog returns the audit log entries matching the request as newline delimited JSON.
+	ExportAuditLog(ctx context.Context, in *GetAuditLogRequest, opts ...grpc.CallOption) (*types.BytesValue, error)
+	// ListRepoMigrations returns the names of the restic migrations that can be applied to the repo.
+	ListRepoMigrations(ctx context.Context, in *types.StringValue, opts ...grpc.CallOption) (*types.StringList, error)
+	// GetRepoCacheStats returns the location and size of the repo's restic cache.
+	GetRepoCacheStats(ctx context.Context, in *types.StringValue, opts ...grpc.CallOption) (*RepoCacheStats, error)
+	// MigrateRepo applies a restic migration (e.g. upgrade_repo_v2) to the repo, no other tasks run while it is applied.
+	MigrateRepo(ctx context.Context, in *MigrateRepoRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// WarmupRepo runs the warmup command of a repo on cold storage, operations reading pack data are allowed once it
+	// succeeds until the warmup expires.
+	WarmupRepo(ctx context.Context, in *types.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// GetRecoveryBundle returns a text document with what is needed to restore from the repo using restic alone.
+	GetRecoveryBundle(ctx context.Context, in *GetRecoveryBundleRequest, opts ...grpc.CallOption) (*types.BytesValue, error)
+	// GetNotificationTemplates returns every notification message of the locale, or of the configured locale if empty,
+	// with the configured overrides applied.
+	GetNotificationTemplates(ctx context.Context, in *types.StringValue, opts ...grpc.CallOption) (*Notifications, error)
+	// GetRemoteStatus returns the plan status of this instance and of every configured remote instance.
+	GetRemoteStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*RemoteStatusList, error)
+	// GetRemoteOperations returns operations of a remote instance, proxied read-only.
+	GetRemoteOperations(ctx context.Context, in *GetRemoteOperationsRequest, opts ...grpc.CallOption) (*OperationList, error)
+	// GetRuns returns the operations of a plan or repo grouped into runs, oldest first.
+	GetRuns(ctx context.Context, in *GetRunsRequest, opts ...grpc.CallOption) (*RunList, error)
+	// GetDurationEstimates returns the average durations of a plan's recent operations by operation type.
+	GetDurationEstimates(ctx context.Context, in *types.StringValue, opts ...grpc.CallOption) (*DurationEstimateList, error)
+	// GetResourceUsage returns the total resources used by a plan's recorded operations by operation type.
+	GetResourceUsage(ctx context.Context, in *types.StringValue, opts ...grpc.CallOption) (*ResourceUsageSummaryList, error)
+	// UpdateRestic checks for a newer restic release and installs it if apply is set. The release is verified against
+	// its published hashes and the restic signing key, the current binary is kept if the new one fails to run.
+	UpdateRestic(ctx context.Context, in *UpdateResticRequest, opts ...grpc.CallOption) (*ResticUpdateStatus, error)
+	// GetMetricSeries returns the downsampled history of a plan's metrics for charting.
+	GetMetricSeries(ctx context.Context, in *GetMetricSeriesRequest, opts ...grpc.CallOption) (*MetricSeriesList, error)
+	// GetExclusionSuggestions returns the paths that added the most data across the plan's recent backups, candidates for excludes.
+	GetExclusionSuggestions(ctx context.Context, in *types.StringValue, opts ...grpc.CallOption) (*ExclusionSuggestionList, error)
+	// AnnotateSnapshot sets the note documenting a snapshot, an empty note removes it.
+	AnnotateSnapshot(ctx context.Context, in *AnnotateSnapshotRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// PinSnapshot pins or unpins a snapshot, pinned snapshots are kept by retention policies. restic rewrites the
+	// snapshot so the returned ID replaces the snapshot's previous ID.
+	PinSnapshot(ctx context.Context, in *PinSnapshotRequest, opts ...grpc.CallOption) (*types.StringValue, error)
+	// RescueSnapshot unmarks a snapshot marked for delayed deletion and pins it so retention doesn't mark it again.
+	// restic rewrites the snapshot so the returned ID replaces the snapshot's previous ID.
+	RescueSnapshot(ctx context.Context, in *RescueSnapshotRequest, opts ...grpc.CallOption) (*types.StringValue, error)
+	// EmergencyStop cancels the running task and all queued tasks and pauses all schedules, e.g. when a source machine
+	// is suspected compromised. The stop persists across restarts until ResumeSchedules is called. The value is the
+	// optional reason for the stop.
+	EmergencyStop(ctx context.Context, in *types.StringValue, opts ...grpc.CallOption) (*Config, error)
+	// ResumeSchedules lifts an emergency stop and reschedules all plans and repo maintenance.
+	ResumeSchedules(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Config, error)
+	// InstantiatePlanTemplate adds a plan created from the template for each of the instances, all plans are added or
+	// none if any is invalid.
+	InstantiatePlanTemplate(ctx context.Context, in *InstantiatePlanTemplateRequest, opts ...grpc.CallOption) (*Config, error)
+	// DeletePlan removes a plan from the config and tears down what it leaves behind, it fails if other plans still
+	// depend on the plan.
+	DeletePlan(ctx context.Context, in *DeletePlanRequest, opts ...grpc.CallOption) (*TeardownResult, error)
+	// DeleteRepo removes a repo from the config and tears down what it leaves behind, it fails while plans or the self
+	// backup still use the repo. The repo's data is never deleted.
+	DeleteRepo(ctx context.Context, in *DeleteRepoRequest, opts ...grpc.CallOption) (*TeardownResult, error)
+	// AcknowledgeOperation records that a user triaged a failed operation, or removes the acknowledgement. It returns
+	// the updated operation.
+	AcknowledgeOperation(ctx context.Context, in *AcknowledgeOperationRequest, opts ...grpc.CallOption) (*Operation, error)
+	// BenchmarkRepo schedules a measurement of the repo backend's latency and upload and download throughput, the
+	// results are recorded in a benchmark operation of the repo.
+	BenchmarkRepo(ctx context.Context, in *BenchmarkRepoRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type backrestClient struct {
@@ -111,6 +245,60 @@ func (c *backrestClient) AddRepo(ctx context.Context, in *Repo, opts ...grpc.Cal
 	return out, nil
 }
 
+func (c *backrestClient) ImportRepo(ctx context.Context, in *Repo, opts ...grpc.CallOption) (*Config, error) {
+	out := new(Config)
+	err := c.cc.Invoke(ctx, Backrest_ImportRepo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backrestClient) ProbeRepo(ctx context.Context, in *Repo, opts ...grpc.CallOption) (*RepoProbe, error) {
+	out := new(RepoProbe)
+	err := c.cc.Invoke(ctx, Backrest_ProbeRepo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backrestClient) GenerateRepoPassword(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*types.StringValue, error) {
+	out := new(types.StringValue)
+	err := c.cc.Invoke(ctx, Backrest_GenerateRepoPassword_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backrestClient) InitRepo(ctx context.Context, in *InitRepoRequest, opts ...grpc.CallOption) (*Config, error) {
+	out := new(Config)
+	err := c.cc.Invoke(ctx, Backrest_InitRepo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backrestClient) GetConfigHistory(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ConfigRevisionList, error) {
+	out := new(ConfigRevisionList)
+	err := c.cc.Invoke(ctx, Backrest_GetConfigHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backrestClient) RollbackConfig(ctx context.Context, in *types.Int64Value, opts ...grpc.CallOption) (*Config, error) {
+	out := new(Config)
+	err := c.cc.Invoke(ctx, Backrest_RollbackConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *backrestClient) GetOperationEvents(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (Backrest_GetOperationEventsClient, error) {
 	stream, err := c.cc.NewStream(ctx, &Backrest_ServiceDesc.Streams[0], Backrest_GetOperationEvents_FullMethodName, opts...)
 	if err != nil {
@@ -143,6 +331,38 @@ func (x *backrestGetOperationEventsClient) Recv() (*OperationEvent, error) {
 	return m, nil
 }
 
+func (c *backrestClient) SubscribeOperations(ctx context.Context, in *SubscribeOperationsRequest, opts ...grpc.CallOption) (Backrest_SubscribeOperationsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Backrest_ServiceDesc.Streams[1], Backrest_SubscribeOperations_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &backrestSubscribeOperationsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Backrest_SubscribeOperationsClient interface {
+	Recv() (*OperationEvent, error)
+	grpc.ClientStream
+}
+
+type backrestSubscribeOperationsClient struct {
+	grpc.ClientStream
+}
+
+func (x *backrestSubscribeOperationsClient) Recv() (*OperationEvent, error) {
+	m := new(OperationEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *backrestClient) GetOperations(ctx context.Context, in *GetOperationsRequest, opts ...grpc.CallOption) (*OperationList, error) {
 	out := new(OperationList)
 	err := c.cc.Invoke(ctx, Backrest_GetOperations_FullMethodName, in, out, opts...)
@@ -152,6 +372,15 @@ func (c *backrestClient) GetOperations(ctx context.Context, in *GetOperationsReq
 	return out, nil
 }
 
+func (c *backrestClient) QueryOperations(ctx context.Context, in *QueryOperationsRequest, opts ...grpc.CallOption) (*QueryOperationsResponse, error) {
+	out := new(QueryOperationsResponse)
+	err := c.cc.Invoke(ctx, Backrest_QueryOperations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *backrestClient) ListSnapshots(ctx context.Context, in *ListSnapshotsRequest, opts ...grpc.CallOption) (*ResticSnapshotList, error) {
 	out := new(ResticSnapshotList)
 	err := c.cc.Invoke(ctx, Backrest_ListSnapshots_FullMethodName, in, out, opts...)
@@ -170,6 +399,33 @@ func (c *backrestClient) ListSnapshotFiles(ctx context.Context, in *ListSnapshot
 	return out, nil
 }
 
+func (c *backrestClient) GetLargestFiles(ctx context.Context, in *GetLargestFilesRequest, opts ...grpc.CallOption) (*GetLargestFilesResponse, error) {
+	out := new(GetLargestFilesResponse)
+	err := c.cc.Invoke(ctx, Backrest_GetLargestFiles_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backrestClient) GetFileHistory(ctx context.Context, in *GetFileHistoryRequest, opts ...grpc.CallOption) (*FileHistory, error) {
+	out := new(FileHistory)
+	err := c.cc.Invoke(ctx, Backrest_GetFileHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backrestClient) GetReclaimedSpace(ctx context.Context, in *types.StringValue, opts ...grpc.CallOption) (*ReclaimedSpace, error) {
+	out := new(ReclaimedSpace)
+	err := c.cc.Invoke(ctx, Backrest_GetReclaimedSpace_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *backrestClient) IndexSnapshots(ctx context.Context, in *types.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, Backrest_IndexSnapshots_FullMethodName, in, out, opts...)
@@ -188,6 +444,15 @@ func (c *backrestClient) Backup(ctx context.Context, in *types.StringValue, opts
 	return out, nil
 }
 
+func (c *backrestClient) AdhocBackup(ctx context.Context, in *AdhocBackupRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Backrest_AdhocBackup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *backrestClient) Prune(ctx context.Context, in *types.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, Backrest_Prune_FullMethodName, in, out, opts...)
@@ -269,101 +534,567 @@ func (c *backrestClient) PathAutocomplete(ctx context.Context, in *types.StringV
 	return out, nil
 }
 
-// BackrestServer is the server API for Backrest service.
-// All implementations must embed UnimplementedBackrestServer
-// for forward compatibility
-type BackrestServer interface {
-	GetConfig(context.Context, *emptypb.Empty) (*Config, error)
-	SetConfig(context.Context, *Config) (*Config, error)
-	AddRepo(context.Context, *Repo) (*Config, error)
-	GetOperationEvents(*emptypb.Empty, Backrest_GetOperationEventsServer) error
-	GetOperations(context.Context, *GetOperationsRequest) (*OperationList, error)
-	ListSnapshots(context.Context, *ListSnapshotsRequest) (*ResticSnapshotList, error)
-	ListSnapshotFiles(context.Context, *ListSnapshotFilesRequest) (*ListSnapshotFilesResponse, error)
-	// IndexSnapshots triggers indexin. It accepts a repo id and returns empty if the task is enqueued.
-	IndexSnapshots(context.Context, *types.StringValue) (*emptypb.Empty, error)
-	// Backup schedules a backup operation. It accepts a plan id and returns empty if the task is enqueued.
-	Backup(context.Context, *types.StringValue) (*emptypb.Empty, error)
-	// Prune schedules a prune operation. It accepts a plan id and returns empty if the task is enqueued.
-	Prune(context.Context, *types.StringValue) (*emptypb.Empty, error)
-	// Forget schedules a forget operation. It accepts a plan id and returns empty if the task is enqueued.
-	Forget(context.Context, *ForgetRequest) (*emptypb.Empty, error)
-	// Restore schedules a restore operation.
-	Restore(context.Context, *RestoreSnapshotRequest) (*emptypb.Empty, error)
-	// Unlock synchronously attempts to unlock the repo. Will block if other operations are in progress.
-	Unlock(context.Context, *types.StringValue) (*emptypb.Empty, error)
-	// Stats runs 'restic stats` on the repository and appends the results to the operations log.
-	Stats(context.Context, *types.StringValue) (*emptypb.Empty, error)
-	// Cancel attempts to cancel a task with the given operation ID. Not guaranteed to succeed.
-	Cancel(context.Context, *types.Int64Value) (*emptypb.Empty, error)
-	// GetBigOperationData returns the keyed large data for the given operation.
-	GetLogs(context.Context, *LogDataRequest) (*types.BytesValue, error)
-	// Clears the history of operations
-	ClearHistory(context.Context, *ClearHistoryRequest) (*emptypb.Empty, error)
-	// PathAutocomplete provides path autocompletion options for a given filesystem path.
-	PathAutocomplete(context.Context, *types.StringValue) (*types.StringList, error)
-	mustEmbedUnimplementedBackrestServer()
+func (c *backrestClient) GetRepoHealth(ctx context.Context, in *GetRepoHealthRequest, opts ...grpc.CallOption) (*RepoHealth, error) {
+	out := new(RepoHealth)
+	err := c.cc.Invoke(ctx, Backrest_GetRepoHealth_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedBackrestServer must be embedded to have forward compatible implementations.
-type UnimplementedBackrestServer struct {
+func (c *backrestClient) CheckOplogIntegrity(ctx context.Context, in *CheckOplogIntegrityRequest, opts ...grpc.CallOption) (*OplogIntegrityReport, error) {
+	out := new(OplogIntegrityReport)
+	err := c.cc.Invoke(ctx, Backrest_CheckOplogIntegrity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (UnimplementedBackrestServer) GetConfig(context.Context, *emptypb.Empty) (*Config, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetConfig not implemented")
-}
-func (UnimplementedBackrestServer) SetConfig(context.Context, *Config) (*Config, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetConfig not implemented")
-}
-func (UnimplementedBackrestServer) AddRepo(context.Context, *Repo) (*Config, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddRepo not implemented")
-}
-func (UnimplementedBackrestServer) GetOperationEvents(*emptypb.Empty, Backrest_GetOperationEventsServer) error {
-	return status.Errorf(codes.Unimplemented, "method GetOperationEvents not implemented")
-}
-func (UnimplementedBackrestServer) GetOperations(context.Context, *GetOperationsRequest) (*OperationList, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOperations not implemented")
+func (c *backrestClient) GetAuditLog(ctx context.Context, in *GetAuditLogRequest, opts ...grpc.CallOption) (*AuditEntryList, error) {
+	out := new(AuditEntryList)
+	err := c.cc.Invoke(ctx, Backrest_GetAuditLog_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBackrestServer) ListSnapshots(context.Context, *ListSnapshotsRequest) (*ResticSnapshotList, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListSnapshots not implemented")
+
+func (c *backrestClient) ExportAuditLog(ctx context.Context, in *GetAuditLogRequest, opts ...grpc.CallOption) (*types.BytesValue, error) {
+	out := new(types.BytesValue)
+	err := c.cc.Invoke(ctx, Backrest_ExportAuditLog_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBackrestServer) ListSnapshotFiles(context.Context, *ListSnapshotFilesRequest) (*ListSnapshotFilesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListSnapshotFiles not implemented")
+
+func (c *backrestClient) ListRepoMigrations(ctx context.Context, in *types.StringValue, opts ...grpc.CallOption) (*types.StringList, error) {
+	out := new(types.StringList)
+	err := c.cc.Invoke(ctx, Backrest_ListRepoMigrations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBackrestServer) IndexSnapshots(context.Context, *types.StringValue) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IndexSnapshots not implemented")
+
+func (c *backrestClient) GetRepoCacheStats(ctx context.Context, in *types.StringValue, opts ...grpc.CallOption) (*RepoCacheStats, error) {
+	out := new(RepoCacheStats)
+	err := c.cc.Invoke(ctx, Backrest_GetRepoCacheStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBackrestServer) Backup(context.Context, *types.StringValue) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Backup not implemented")
+
+func (c *backrestClient) MigrateRepo(ctx context.Context, in *MigrateRepoRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Backrest_MigrateRepo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBackrestServer) Prune(context.Context, *types.StringValue) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Prune not implemented")
+
+func (c *backrestClient) WarmupRepo(ctx context.Context, in *types.StringValue, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Backrest_WarmupRepo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBackrestServer) Forget(context.Context, *ForgetRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Forget not implemented")
+
+func (c *backrestClient) GetRecoveryBundle(ctx context.Context, in *GetRecoveryBundleRequest, opts ...grpc.CallOption) (*types.BytesValue, error) {
+	out := new(types.BytesValue)
+	err := c.cc.Invoke(ctx, Backrest_GetRecoveryBundle_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBackrestServer) Restore(context.Context, *RestoreSnapshotRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Restore not implemented")
+
+func (c *backrestClient) GetNotificationTemplates(ctx context.Context, in *types.StringValue, opts ...grpc.CallOption) (*Notifications, error) {
+	out := new(Notifications)
+	err := c.cc.Invoke(ctx, Backrest_GetNotificationTemplates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBackrestServer) Unlock(context.Context, *types.StringValue) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Unlock not implemented")
+
+func (c *backrestClient) GetRemoteStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*RemoteStatusList, error) {
+	out := new(RemoteStatusList)
+	err := c.cc.Invoke(ctx, Backrest_GetRemoteStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBackrestServer) Stats(context.Context, *types.StringValue) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Stats not implemented")
+
+func (c *backrestClient) GetRemoteOperations(ctx context.Context, in *GetRemoteOperationsRequest, opts ...grpc.CallOption) (*OperationList, error) {
+	out := new(OperationList)
+	err := c.cc.Invoke(ctx, Backrest_GetRemoteOperations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBackrestServer) Cancel(context.Context, *types.Int64Value) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Cancel not implemented")
+
+func (c *backrestClient) GetRuns(ctx context.Context, in *GetRunsRequest, opts ...grpc.CallOption) (*RunList, error) {
+	out := new(RunList)
+	err := c.cc.Invoke(ctx, Backrest_GetRuns_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBackrestServer) GetLogs(context.Context, *LogDataRequest) (*types.BytesValue, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetLogs not implemented")
+
+func (c *backrestClient) GetDurationEstimates(ctx context.Context, in *types.StringValue, opts ...grpc.CallOption) (*DurationEstimateList, error) {
+	out := new(DurationEstimateList)
+	err := c.cc.Invoke(ctx, Backrest_GetDurationEstimates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBackrestServer) ClearHistory(context.Context, *ClearHistoryRequest) (*emptypb.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClearHistory not implemented")
+
+func (c *backrestClient) GetResourceUsage(ctx context.Context, in *types.StringValue, opts ...grpc.CallOption) (*ResourceUsageSummaryList, error) {
+	out := new(ResourceUsageSummaryList)
+	err := c.cc.Invoke(ctx, Backrest_GetResourceUsage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBackrestServer) PathAutocomplete(context.Context, *types.StringValue) (*types.StringList, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PathAutocomplete not implemented")
+
+func (c *backrestClient) UpdateRestic(ctx context.Context, in *UpdateResticRequest, opts ...grpc.CallOption) (*ResticUpdateStatus, error) {
+	out := new(ResticUpdateStatus)
+	err := c.cc.Invoke(ctx, Backrest_UpdateRestic_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedBackrestServer) mustEmbedUnimplementedBackrestServer() {}
+
+func (c *backrestClient) GetMetricSeries(ctx context.Context, in *GetMetricSeriesRequest, opts ...grpc.CallOption) (*MetricSeriesList, error) {
+	out := new(MetricSeriesList)
+	err := c.cc.Invoke(ctx, Backrest_GetMetricSeries_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backrestClient) GetExclusionSuggestions(ctx context.Context, in *types.StringValue, opts ...grpc.CallOption) (*ExclusionSuggestionList, error) {
+	out := new(ExclusionSuggestionList)
+	err := c.cc.Invoke(ctx, Backrest_GetExclusionSuggestions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backrestClient) AnnotateSnapshot(ctx context.Context, in *AnnotateSnapshotRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Backrest_AnnotateSnapshot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backrestClient) PinSnapshot(ctx context.Context, in *PinSnapshotRequest, opts ...grpc.CallOption) (*types.StringValue, error) {
+	out := new(types.StringValue)
+	err := c.cc.Invoke(ctx, Backrest_PinSnapshot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backrestClient) RescueSnapshot(ctx context.Context, in *RescueSnapshotRequest, opts ...grpc.CallOption) (*types.StringValue, error) {
+	out := new(types.StringValue)
+	err := c.cc.Invoke(ctx, Backrest_RescueSnapshot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backrestClient) EmergencyStop(ctx context.Context, in *types.StringValue, opts ...grpc.CallOption) (*Config, error) {
+	out := new(Config)
+	err := c.cc.Invoke(ctx, Backrest_EmergencyStop_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backrestClient) ResumeSchedules(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Config, error) {
+	out := new(Config)
+	err := c.cc.Invoke(ctx, Backrest_ResumeSchedules_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backrestClient) InstantiatePlanTemplate(ctx context.Context, in *InstantiatePlanTemplateRequest, opts ...grpc.CallOption) (*Config, error) {
+	out := new(Config)
+	err := c.cc.Invoke(ctx, Backrest_InstantiatePlanTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backrestClient) DeletePlan(ctx context.Context, in *DeletePlanRequest, opts ...grpc.CallOption) (*TeardownResult, error) {
+	out := new(TeardownResult)
+	err := c.cc.Invoke(ctx, Backrest_DeletePlan_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backrestClient) DeleteRepo(ctx context.Context, in *DeleteRepoRequest, opts ...grpc.CallOption) (*TeardownResult, error) {
+	out := new(TeardownResult)
+	err := c.cc.Invoke(ctx, Backrest_DeleteRepo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backrestClient) AcknowledgeOperation(ctx context.Context, in *AcknowledgeOperationRequest, opts ...grpc.CallOption) (*Operation, error) {
+	out := new(Operation)
+	err := c.cc.Invoke(ctx, Backrest_AcknowledgeOperation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backrestClient) BenchmarkRepo(ctx context.Context, in *BenchmarkRepoRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Backrest_BenchmarkRepo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BackrestServer is the server API for Backrest service.
+// All implementations must embed UnimplementedBackrestServer
+// for forward compatibility
+type BackrestServer interface {
+	GetConfig(context.Context, *emptypb.Empty) (*Config, error)
+	SetConfig(context.Context, *Config) (*Config, error)
+	AddRepo(context.Context, *Repo) (*Config, error)
+	// ImportRepo adds a pre-existing restic repository without initializing it and indexes its snapshots grouped by hostname and tags.
+	ImportRepo(context.Context, *Repo) (*Config, error)
+	// ProbeRepo checks whether a restic repo exists at the repo's URI and whether the repo's password opens it.
+	ProbeRepo(context.Context, *Repo) (*RepoProbe, error)
+	// GenerateRepoPassword returns a random password for a new repo.
+	GenerateRepoPassword(context.Context, *emptypb.Empty) (*types.StringValue, error)
+	// InitRepo initializes a new restic repo, verifies that it opens and adds it to the config. A local repo it created
+	// is removed again if a step fails. Unlike AddRepo it fails if a repo already exists at the URI.
+	InitRepo(context.Context, *InitRepoRequest) (*Config, error)
+	// GetConfigHistory returns the revisions of the config that have been written, oldest first.
+	GetConfigHistory(context.Context, *emptypb.Empty) (*ConfigRevisionList, error)
+	// RollbackConfig restores the config to the given revision. The rollback is itself recorded as a new revision.
+	RollbackConfig(context.Context, *types.Int64Value) (*Config, error)
+	GetOperationEvents(*emptypb.Empty, Backrest_GetOperationEventsServer) error
+	// SubscribeOperations streams the oplog events matching the request's filters as they happen.
+	SubscribeOperations(*SubscribeOperationsRequest, Backrest_SubscribeOperationsServer) error
+	GetOperations(context.Context, *GetOperationsRequest) (*OperationList, error)
+	// QueryOperations returns a page of the operations matching the request's filters.
+	QueryOperations(context.Context, *QueryOperationsRequest) (*QueryOperationsResponse, error)
+	ListSnapshots(context.Context, *ListSnapshotsRequest) (*ResticSnapshotList, error)
+	ListSnapshotFiles(context.Context, *ListSnapshotFilesRequest) (*ListSnapshotFilesResponse, error)
+	// GetLargestFiles returns the largest files and directories of a snapshot, computed once per snapshot and cached.
+	GetLargestFiles(context.Context, *GetLargestFilesRequest) (*GetLargestFilesResponse, error)
+	// GetFileHistory returns the versions of a path across the snapshots of a repo, oldest first.
+	GetFileHistory(context.Context, *GetFileHistoryRequest) (*FileHistory, error)
+	// GetReclaimedSpace returns the space freed by the prunes and the snapshots removed by the forgets of a repo over
+	// time, the value is the repo ID.
+	GetReclaimedSpace(context.Context, *types.StringValue) (*ReclaimedSpace, error)
+	// IndexSnapshots triggers indexin. It accepts a repo id and returns empty if the task is enqueued.
+	IndexSnapshots(context.Context, *types.StringValue) (*emptypb.Empty, error)
+	// Backup schedules a backup operation. It accepts a plan id and returns empty if the task is enqueued.
+	Backup(context.Context, *types.StringValue) (*emptypb.Empty, error)
+	// AdhocBackup runs a one-off backup of paths that need not belong to any plan, returns once the backup completes.
+	AdhocBackup(context.Context, *AdhocBackupRequest) (*emptypb.Empty, error)
+	// Prune schedules a prune operation. It accepts a plan id and returns empty if the task is enqueued.
+	Prune(context.Context, *types.StringValue) (*emptypb.Empty, error)
+	// Forget schedules a forget operation. It accepts a plan id and returns empty if the task is enqueued.
+	Forget(context.Context, *ForgetRequest) (*emptypb.Empty, error)
+	// Restore schedules a restore operation.
+	Restore(context.Context, *RestoreSnapshotRequest) (*emptypb.Empty, error)
+	// Unlock synchronously attempts to unlock the repo. Will block if other operations are in progress.
+	Unlock(context.Context, *types.StringValue) (*emptypb.Empty, error)
+	// Stats runs 'restic stats` on the repository and appends the results to the operations log.
+	Stats(context.Context, *types.StringValue) (*emptypb.Empty, error)
+	// Cancel attempts to cancel a task with the given operation ID. Not guaranteed to succeed.
+	Cancel(context.Context, *types.Int64Value) (*emptypb.Empty, error)
+	// GetBigOperationData returns the keyed large data for the given operation.
+	GetLogs(context.Context, *LogDataRequest) (*types.BytesValue, error)
+	// Clears the history of operations
+	ClearHistory(context.Context, *ClearHistoryRequest) (*emptypb.Empty, error)
+	// PathAutocomplete provides path autocompletion options for a given filesystem path.
+	PathAutocomplete(context.Context, *types.StringValue) (*types.StringList, error)
+	// GetRepoHealth returns the health of a repo, expensive checks are only run if requested.
+	GetRepoHealth(context.Context, *GetRepoHealthRequest) (*RepoHealth, error)
+	// CheckOplogIntegrity runs a consistency pass over the operation log, optionally repairing the problems found.
+	CheckOplogIntegrity(context.Context, *CheckOplogIntegrityRequest) (*OplogIntegrityReport, error)
+	// GetAuditLog returns the audit log entries matching the request, oldest first.
+	GetAuditLog(context.Context, *GetAuditLogRequest) (*AuditEntryList, error)
+	// ExportAuditLog returns the audit log entries matching the request as newline delimited JSON.
+	ExportAuditLog(context.Context, *GetAuditLogRequest) (*types.BytesValue, error)
+	// ListRepoMigrations returns the names of the restic migrations that can be applied to the repo.
+	ListRepoMigrations(context.Context, *types.StringValue) (*types.StringList, error)
+	// GetRepoCacheStats returns the location and size of the repo's restic cache.
+	GetRepoCacheStats(context.Context, *types.StringValue) (*RepoCacheStats, error)
+	// MigrateRepo applies a restic migration (e.g. upgrade_repo_v2) to the repo, no other tasks run while it is applied.
+	MigrateRepo(context.Context, *MigrateRepoRequest) (*emptypb.Empty, error)
+	// WarmupRepo runs the warmup command of a repo on cold storage, operations reading pack data are allowed once it
+	// succeeds until the warmup expires.
+	WarmupRepo(context.Context, *types.StringValue) (*emptypb.Empty, error)
+	// GetRecoveryBundle returns a text document with what is needed to restore from the repo using restic alone.
+	GetRecoveryBundle(context.Context, *GetRecoveryBundleRequest) (*types.BytesValue, error)
+	// GetNotificationTemplates returns every notification message of the locale, or of the configured locale if empty,
+	// with the configured overrides applied.
+	GetNotificationTemplates(context.Context, *types.StringValue) (*Notifications, error)
+	// GetRemoteStatus returns the plan status of this instance and of every configured remote instance.
+	GetRemoteStatus(context.Context, *emptypb.Empty) (*RemoteStatusList, error)
+	// GetRemoteOperations returns operations of a remote instance, proxied read-only.
+	GetRemoteOperations(context.Context, *GetRemoteOperationsRequest) (*OperationList, error)
+	// GetRuns returns the operations of a plan or repo grouped into runs, oldest first.
+	GetRuns(context.Context, *GetRunsRequest) (*RunList, error)
+	// GetDurationEstimates returns the average durations of a plan's recent operations by operation type.
+	GetDurationEstimates(context.Context, *types.StringValue) (*DurationEstimateList, error)
+	// GetResourceUsage returns the total resources used by a plan's recorded operations by operation type.
+	GetResourceUsage(context.Context, *types.StringValue) (*ResourceUsageSummaryList, error)
+	// UpdateRestic checks for a newer restic release and installs it if apply is set. The release is verified against
+	// its published hashes and the restic signing key, the current binary is kept if the new one fails to run.
+	UpdateRestic(context.Context, *UpdateResticRequest) (*ResticUpdateStatus, error)
+	// GetMetricSeries returns the downsampled history of a plan's metrics for charting.
+	GetMetricSeries(context.Context, *GetMetricSeriesRequest) (*MetricSeriesList, error)
+	// GetExclusionSuggestions returns the paths that added the most data across the plan's recent backups, candidates for excludes.
+	GetExclusionSuggestions(context.Context, *types.StringValue) (*ExclusionSuggestionList, error)
+	// AnnotateSnapshot sets the note documenting a snapshot, an empty note removes it.
+	AnnotateSnapshot(context.Context, *AnnotateSnapshotRequest) (*emptypb.Empty, error)
+	// PinSnapshot pins or unpins a snapshot, pinned snapshots are kept by retention policies. restic rewrites the
+	// snapshot so the returned ID replaces the snapshot's previous ID.
+	PinSnapshot(context.Context, *PinSnapshotRequest) (*types.StringValue, error)
+	// RescueSnapshot unmarks a snapshot marked for delayed deletion and pins it so retention doesn't mark it again.
+	// restic rewrites the snapshot so the returned ID replaces the snapshot's previous ID.
+	RescueSnapshot(context.Context, *RescueSnapshotRequest) (*types.StringValue, error)
+	// EmergencyStop cancels the running task and all queued tasks and pauses all schedules, e.g. when a source machine
+	// is suspected compromised. The stop persists across restarts until ResumeSchedules is called. The value is the
+	// optional reason for the stop.
+	EmergencyStop(context.Context, *types.StringValue) (*Config, error)
+	// ResumeSchedules lifts an emergency stop and reschedules all plans and repo maintenance.
+	ResumeSchedules(context.Context, *emptypb.Empty) (*Config, error)
+	// InstantiatePlanTemplate adds a plan created from the template for each of the instances, all plans are added or
+	// none if any is invalid.
+	InstantiatePlanTemplate(context.Context, *InstantiatePlanTemplateRequest) (*Config, error)
+	// DeletePlan removes a plan from the config and tears down what it leaves behind, it fails if other plans still
+	// depend on the plan.
+	DeletePlan(context.Context, *DeletePlanRequest) (*TeardownResult, error)
+	// DeleteRepo removes a repo from the config and tears down what it leaves behind, it fails while plans or the self
+	// backup still use the repo. The repo's data is never deleted.
+	DeleteRepo(context.Context, *DeleteRepoRequest) (*TeardownResult, error)
+	// AcknowledgeOperation records that a user triaged a failed operation, or removes the acknowledgement. It returns
+	// the updated operation.
+	AcknowledgeOperation(context.Context, *AcknowledgeOperationRequest) (*Operation, error)
+	// BenchmarkRepo schedules a measurement of the repo backend's latency and upload and download throughput, the
+	// results are recorded in a benchmark operation of the repo.
+	BenchmarkRepo(context.Context, *BenchmarkRepoRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedBackrestServer()
+}
+
+// UnimplementedBackrestServer must be embedded to have forward compatible implementations.
+type UnimplementedBackrestServer struct {
+}
+
+func (UnimplementedBackrestServer) GetConfig(context.Context, *emptypb.Empty) (*Config, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConfig not implemented")
+}
+func (UnimplementedBackrestServer) SetConfig(context.Context, *Config) (*Config, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetConfig not implemented")
+}
+func (UnimplementedBackrestServer) AddRepo(context.Context, *Repo) (*Config, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddRepo not implemented")
+}
+func (UnimplementedBackrestServer) ImportRepo(context.Context, *Repo) (*Config, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportRepo not implemented")
+}
+func (UnimplementedBackrestServer) ProbeRepo(context.Context, *Repo) (*RepoProbe, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProbeRepo not implemented")
+}
+func (UnimplementedBackrestServer) GenerateRepoPassword(context.Context, *emptypb.Empty) (*types.StringValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateRepoPassword not implemented")
+}
+func (UnimplementedBackrestServer) InitRepo(context.Context, *InitRepoRequest) (*Config, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InitRepo not implemented")
+}
+func (UnimplementedBackrestServer) GetConfigHistory(context.Context, *emptypb.Empty) (*ConfigRevisionList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConfigHistory not implemented")
+}
+func (UnimplementedBackrestServer) RollbackConfig(context.Context, *types.Int64Value) (*Config, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackConfig not implemented")
+}
+func (UnimplementedBackrestServer) GetOperationEvents(*emptypb.Empty, Backrest_GetOperationEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetOperationEvents not implemented")
+}
+func (UnimplementedBackrestServer) SubscribeOperations(*SubscribeOperationsRequest, Backrest_SubscribeOperationsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeOperations not implemented")
+}
+func (UnimplementedBackrestServer) GetOperations(context.Context, *GetOperationsRequest) (*OperationList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOperations not implemented")
+}
+func (UnimplementedBackrestServer) QueryOperations(context.Context, *QueryOperationsRequest) (*QueryOperationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryOperations not implemented")
+}
+func (UnimplementedBackrestServer) ListSnapshots(context.Context, *ListSnapshotsRequest) (*ResticSnapshotList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSnapshots not implemented")
+}
+func (UnimplementedBackrestServer) ListSnapshotFiles(context.Context, *ListSnapshotFilesRequest) (*ListSnapshotFilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSnapshotFiles not implemented")
+}
+func (UnimplementedBackrestServer) GetLargestFiles(context.Context, *GetLargestFilesRequest) (*GetLargestFilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLargestFiles not implemented")
+}
+func (UnimplementedBackrestServer) GetFileHistory(context.Context, *GetFileHistoryRequest) (*FileHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFileHistory not implemented")
+}
+func (UnimplementedBackrestServer) GetReclaimedSpace(context.Context, *types.StringValue) (*ReclaimedSpace, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReclaimedSpace not implemented")
+}
+func (UnimplementedBackrestServer) IndexSnapshots(context.Context, *types.StringValue) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IndexSnapshots not implemented")
+}
+func (UnimplementedBackrestServer) Backup(context.Context, *types.StringValue) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Backup not implemented")
+}
+func (UnimplementedBackrestServer) AdhocBackup(context.Context, *AdhocBackupRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdhocBackup not implemented")
+}
+func (UnimplementedBackrestServer) Prune(context.Context, *types.StringValue) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Prune not implemented")
+}
+func (UnimplementedBackrestServer) Forget(context.Context, *ForgetRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Forget not implemented")
+}
+func (UnimplementedBackrestServer) Restore(context.Context, *RestoreSnapshotRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Restore not implemented")
+}
+func (UnimplementedBackrestServer) Unlock(context.Context, *types.StringValue) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unlock not implemented")
+}
+func (UnimplementedBackrestServer) Stats(context.Context, *types.StringValue) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stats not implemented")
+}
+func (UnimplementedBackrestServer) Cancel(context.Context, *types.Int64Value) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Cancel not implemented")
+}
+func (UnimplementedBackrestServer) GetLogs(context.Context, *LogDataRequest) (*types.BytesValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLogs not implemented")
+}
+func (UnimplementedBackrestServer) ClearHistory(context.Context, *ClearHistoryRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearHistory not implemented")
+}
+func (UnimplementedBackrestServer) PathAutocomplete(context.Context, *types.StringValue) (*types.StringList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PathAutocomplete not implemented")
+}
+func (UnimplementedBackrestServer) GetRepoHealth(context.Context, *GetRepoHealthRequest) (*RepoHealth, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRepoHealth not implemented")
+}
+func (UnimplementedBackrestServer) CheckOplogIntegrity(context.Context, *CheckOplogIntegrityRequest) (*OplogIntegrityReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckOplogIntegrity not implemented")
+}
+func (UnimplementedBackrestServer) GetAuditLog(context.Context, *GetAuditLogRequest) (*AuditEntryList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuditLog not implemented")
+}
+func (UnimplementedBackrestServer) ExportAuditLog(context.Context, *GetAuditLogRequest) (*types.BytesValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportAuditLog not implemented")
+}
+func (UnimplementedBackrestServer) ListRepoMigrations(context.Context, *types.StringValue) (*types.StringList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRepoMigrations not implemented")
+}
+func (UnimplementedBackrestServer) GetRepoCacheStats(context.Context, *types.StringValue) (*RepoCacheStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRepoCacheStats not implemented")
+}
+func (UnimplementedBackrestServer) MigrateRepo(context.Context, *MigrateRepoRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateRepo not implemented")
+}
+func (UnimplementedBackrestServer) WarmupRepo(context.Context, *types.StringValue) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WarmupRepo not implemented")
+}
+func (UnimplementedBackrestServer) GetRecoveryBundle(context.Context, *GetRecoveryBundleRequest) (*types.BytesValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecoveryBundle not implemented")
+}
+func (UnimplementedBackrestServer) GetNotificationTemplates(context.Context, *types.StringValue) (*Notifications, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNotificationTemplates not implemented")
+}
+func (UnimplementedBackrestServer) GetRemoteStatus(context.Context, *emptypb.Empty) (*RemoteStatusList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRemoteStatus not implemented")
+}
+func (UnimplementedBackrestServer) GetRemoteOperations(context.Context, *GetRemoteOperationsRequest) (*OperationList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRemoteOperations not implemented")
+}
+func (UnimplementedBackrestServer) GetRuns(context.Context, *GetRunsRequest) (*RunList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRuns not implemented")
+}
+func (UnimplementedBackrestServer) GetDurationEstimates(context.Context, *types.StringValue) (*DurationEstimateList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDurationEstimates not implemented")
+}
+func (UnimplementedBackrestServer) GetResourceUsage(context.Context, *types.StringValue) (*ResourceUsageSummaryList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetResourceUsage not implemented")
+}
+func (UnimplementedBackrestServer) UpdateRestic(context.Context, *UpdateResticRequest) (*ResticUpdateStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRestic not implemented")
+}
+func (UnimplementedBackrestServer) GetMetricSeries(context.Context, *GetMetricSeriesRequest) (*MetricSeriesList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMetricSeries not implemented")
+}
+func (UnimplementedBackrestServer) GetExclusionSuggestions(context.Context, *types.StringValue) (*ExclusionSuggestionList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExclusionSuggestions not implemented")
+}
+func (UnimplementedBackrestServer) AnnotateSnapshot(context.Context, *AnnotateSnapshotRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnnotateSnapshot not implemented")
+}
+func (UnimplementedBackrestServer) PinSnapshot(context.Context, *PinSnapshotRequest) (*types.StringValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PinSnapshot not implemented")
+}
+func (UnimplementedBackrestServer) RescueSnapshot(context.Context, *RescueSnapshotRequest) (*types.StringValue, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RescueSnapshot not implemented")
+}
+func (UnimplementedBackrestServer) EmergencyStop(context.Context, *types.StringValue) (*Config, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmergencyStop not implemented")
+}
+func (UnimplementedBackrestServer) ResumeSchedules(context.Context, *emptypb.Empty) (*Config, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeSchedules not implemented")
+}
+func (UnimplementedBackrestServer) InstantiatePlanTemplate(context.Context, *InstantiatePlanTemplateRequest) (*Config, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstantiatePlanTemplate not implemented")
+}
+func (UnimplementedBackrestServer) DeletePlan(context.Context, *DeletePlanRequest) (*TeardownResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePlan not implemented")
+}
+func (UnimplementedBackrestServer) DeleteRepo(context.Context, *DeleteRepoRequest) (*TeardownResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRepo not implemented")
+}
+func (UnimplementedBackrestServer) AcknowledgeOperation(context.Context, *AcknowledgeOperationRequest) (*Operation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcknowledgeOperation not implemented")
+}
+func (UnimplementedBackrestServer) BenchmarkRepo(context.Context, *BenchmarkRepoRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BenchmarkRepo not implemented")
+}
+func (UnimplementedBackrestServer) mustEmbedUnimplementedBackrestServer() {}
 
 // UnsafeBackrestServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to BackrestServer will
@@ -372,333 +1103,1056 @@ type UnsafeBackrestServer interface {
 	mustEmbedUnimplementedBackrestServer()
 }
 
-func RegisterBackrestServer(s grpc.ServiceRegistrar, srv BackrestServer) {
-	s.RegisterService(&Backrest_ServiceDesc, srv)
+func RegisterBackrestServer(s grpc.ServiceRegistrar, srv BackrestServer) {
+	s.RegisterService(&Backrest_ServiceDesc, srv)
+}
+
+func _Backrest_GetConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackrestServer).GetConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Backrest_GetConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackrestServer).GetConfig(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Backrest_SetConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Config)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackrestServer).SetConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Backrest_SetConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackrestServer).SetConfig(ctx, req.(*Config))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Backrest_AddRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Repo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackrestServer).AddRepo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Backrest_AddRepo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackrestServer).AddRepo(ctx, req.(*Repo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Backrest_ImportRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Repo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackrestServer).ImportRepo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Backrest_ImportRepo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackrestServer).ImportRepo(ctx, req.(*Repo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Backrest_ProbeRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Repo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackrestServer).ProbeRepo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Backrest_ProbeRepo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackrestServer).ProbeRepo(ctx, req.(*Repo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Backrest_GenerateRepoPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackrestServer).GenerateRepoPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Backrest_GenerateRepoPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackrestServer).GenerateRepoPassword(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Backrest_InitRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InitRepoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackrestServer).InitRepo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Backrest_InitRepo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackrestServer).InitRepo(ctx, req.(*InitRepoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Backrest_GetConfigHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackrestServer).GetConfigHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Backrest_GetConfigHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackrestServer).GetConfigHistory(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Backrest_RollbackConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Int64Value)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackrestServer).RollbackConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Backrest_RollbackConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackrestServer).RollbackConfig(ctx, req.(*types.Int64Value))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Backrest_GetOperationEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BackrestServer).GetOperationEvents(m, &backrestGetOperationEventsServer{stream})
+}
+
+type Backrest_GetOperationEventsServer interface {
+	Send(*OperationEvent) error
+	grpc.ServerStream
+}
+
+type backrestGetOperationEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *backrestGetOperationEventsServer) Send(m *OperationEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Backrest_SubscribeOperations_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeOperationsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BackrestServer).SubscribeOperations(m, &backrestSubscribeOperationsServer{stream})
+}
+
+type Backrest_SubscribeOperationsServer interface {
+	Send(*OperationEvent) error
+	grpc.ServerStream
+}
+
+type backrestSubscribeOperationsServer struct {
+	grpc.ServerStream
+}
+
+func (x *backrestSubscribeOperationsServer) Send(m *OperationEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Backrest_GetOperations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOperationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackrestServer).GetOperations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Backrest_GetOperations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackrestServer).GetOperations(ctx, req.(*GetOperationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Backrest_QueryOperations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOperationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackrestServer).QueryOperations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Backrest_QueryOperations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackrestServer).QueryOperations(ctx, req.(*QueryOperationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Backrest_ListSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackrestServer).ListSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Backrest_ListSnapshots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackrestServer).ListSnapshots(ctx, req.(*ListSnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Backrest_ListSnapshotFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSnapshotFilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackrestServer).ListSnapshotFiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Backrest_ListSnapshotFiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackrestServer).ListSnapshotFiles(ctx, req.(*ListSnapshotFilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Backrest_GetLargestFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLargestFilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackrestServer).GetLargestFiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Backrest_GetLargestFiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackrestServer).GetLargestFiles(ctx, req.(*GetLargestFilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Backrest_GetFileHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFileHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackrestServer).GetFileHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Backrest_GetFileHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackrestServer).GetFileHistory(ctx, req.(*GetFileHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Backrest_GetReclaimedSpace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.StringValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackrestServer).GetReclaimedSpace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Backrest_GetReclaimedSpace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackrestServer).GetReclaimedSpace(ctx, req.(*types.StringValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Backrest_IndexSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.StringValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackrestServer).IndexSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Backrest_IndexSnapshots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackrestServer).IndexSnapshots(ctx, req.(*types.StringValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Backrest_Backup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.StringValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackrestServer).Backup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Backrest_Backup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackrestServer).Backup(ctx, req.(*types.StringValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Backrest_AdhocBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdhocBackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackrestServer).AdhocBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Backrest_AdhocBackup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackrestServer).AdhocBackup(ctx, req.(*AdhocBackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Backrest_Prune_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.StringValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackrestServer).Prune(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Backrest_Prune_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackrestServer).Prune(ctx, req.(*types.StringValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Backrest_Forget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackrestServer).Forget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Backrest_Forget_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackrestServer).Forget(ctx, req.(*ForgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Backrest_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackrestServer).Restore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Backrest_Restore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackrestServer).Restore(ctx, req.(*RestoreSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Backrest_Unlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.StringValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackrestServer).Unlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Backrest_Unlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackrestServer).Unlock(ctx, req.(*types.StringValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Backrest_Stats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.StringValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackrestServer).Stats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Backrest_Stats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackrestServer).Stats(ctx, req.(*types.StringValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Backrest_Cancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Int64Value)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackrestServer).Cancel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Backrest_Cancel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackrestServer).Cancel(ctx, req.(*types.Int64Value))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Backrest_GetLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackrestServer).GetLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Backrest_GetLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackrestServer).GetLogs(ctx, req.(*LogDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Backrest_ClearHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackrestServer).ClearHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Backrest_ClearHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackrestServer).ClearHistory(ctx, req.(*ClearHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Backrest_PathAutocomplete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.StringValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackrestServer).PathAutocomplete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Backrest_PathAutocomplete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackrestServer).PathAutocomplete(ctx, req.(*types.StringValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Backrest_GetRepoHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRepoHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackrestServer).GetRepoHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Backrest_GetRepoHealth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackrestServer).GetRepoHealth(ctx, req.(*GetRepoHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Backrest_CheckOplogIntegrity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckOplogIntegrityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackrestServer).CheckOplogIntegrity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Backrest_CheckOplogIntegrity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackrestServer).CheckOplogIntegrity(ctx, req.(*CheckOplogIntegrityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Backrest_GetAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackrestServer).GetAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Backrest_GetAuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackrestServer).GetAuditLog(ctx, req.(*GetAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Backrest_ExportAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackrestServer).ExportAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Backrest_ExportAuditLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackrestServer).ExportAuditLog(ctx, req.(*GetAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Backrest_ListRepoMigrations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.StringValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackrestServer).ListRepoMigrations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Backrest_ListRepoMigrations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackrestServer).ListRepoMigrations(ctx, req.(*types.StringValue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Backrest_GetRepoCacheStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.StringValue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackrestServer).GetRepoCacheStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Backrest_GetRepoCacheStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackrestServer).GetRepoCacheStats(ctx, req.(*types.StringValue))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Backrest_GetConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _Backrest_MigrateRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MigrateRepoRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BackrestServer).GetConfig(ctx, in)
+		return srv.(BackrestServer).MigrateRepo(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Backrest_GetConfig_FullMethodName,
+		FullMethod: Backrest_MigrateRepo_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BackrestServer).GetConfig(ctx, req.(*emptypb.Empty))
+		return srv.(BackrestServer).MigrateRepo(ctx, req.(*MigrateRepoRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Backrest_SetConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Config)
+func _Backrest_WarmupRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.StringValue)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BackrestServer).SetConfig(ctx, in)
+		return srv.(BackrestServer).WarmupRepo(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Backrest_SetConfig_FullMethodName,
+		FullMethod: Backrest_WarmupRepo_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BackrestServer).SetConfig(ctx, req.(*Config))
+		return srv.(BackrestServer).WarmupRepo(ctx, req.(*types.StringValue))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Backrest_AddRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Repo)
+func _Backrest_GetRecoveryBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecoveryBundleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BackrestServer).AddRepo(ctx, in)
+		return srv.(BackrestServer).GetRecoveryBundle(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Backrest_AddRepo_FullMethodName,
+		FullMethod: Backrest_GetRecoveryBundle_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BackrestServer).AddRepo(ctx, req.(*Repo))
+		return srv.(BackrestServer).GetRecoveryBundle(ctx, req.(*GetRecoveryBundleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Backrest_GetOperationEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(emptypb.Empty)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _Backrest_GetNotificationTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.StringValue)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(BackrestServer).GetOperationEvents(m, &backrestGetOperationEventsServer{stream})
+	if interceptor == nil {
+		return srv.(BackrestServer).GetNotificationTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Backrest_GetNotificationTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackrestServer).GetNotificationTemplates(ctx, req.(*types.StringValue))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type Backrest_GetOperationEventsServer interface {
-	Send(*OperationEvent) error
-	grpc.ServerStream
+func _Backrest_GetRemoteStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackrestServer).GetRemoteStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Backrest_GetRemoteStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackrestServer).GetRemoteStatus(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type backrestGetOperationEventsServer struct {
-	grpc.ServerStream
+func _Backrest_GetRemoteOperations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRemoteOperationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackrestServer).GetRemoteOperations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Backrest_GetRemoteOperations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackrestServer).GetRemoteOperations(ctx, req.(*GetRemoteOperationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *backrestGetOperationEventsServer) Send(m *OperationEvent) error {
-	return x.ServerStream.SendMsg(m)
+func _Backrest_GetRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRunsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackrestServer).GetRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Backrest_GetRuns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackrestServer).GetRuns(ctx, req.(*GetRunsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Backrest_GetOperations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetOperationsRequest)
+func _Backrest_GetDurationEstimates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.StringValue)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BackrestServer).GetOperations(ctx, in)
+		return srv.(BackrestServer).GetDurationEstimates(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Backrest_GetOperations_FullMethodName,
+		FullMethod: Backrest_GetDurationEstimates_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BackrestServer).GetOperations(ctx, req.(*GetOperationsRequest))
+		return srv.(BackrestServer).GetDurationEstimates(ctx, req.(*types.StringValue))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Backrest_ListSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListSnapshotsRequest)
+func _Backrest_GetResourceUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.StringValue)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BackrestServer).ListSnapshots(ctx, in)
+		return srv.(BackrestServer).GetResourceUsage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Backrest_ListSnapshots_FullMethodName,
+		FullMethod: Backrest_GetResourceUsage_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BackrestServer).ListSnapshots(ctx, req.(*ListSnapshotsRequest))
+		return srv.(BackrestServer).GetResourceUsage(ctx, req.(*types.StringValue))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Backrest_ListSnapshotFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListSnapshotFilesRequest)
+func _Backrest_UpdateRestic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateResticRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BackrestServer).ListSnapshotFiles(ctx, in)
+		return srv.(BackrestServer).UpdateRestic(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Backrest_ListSnapshotFiles_FullMethodName,
+		FullMethod: Backrest_UpdateRestic_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BackrestServer).ListSnapshotFiles(ctx, req.(*ListSnapshotFilesRequest))
+		return srv.(BackrestServer).UpdateRestic(ctx, req.(*UpdateResticRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Backrest_IndexSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.StringValue)
+func _Backrest_GetMetricSeries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMetricSeriesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BackrestServer).IndexSnapshots(ctx, in)
+		return srv.(BackrestServer).GetMetricSeries(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Backrest_IndexSnapshots_FullMethodName,
+		FullMethod: Backrest_GetMetricSeries_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BackrestServer).IndexSnapshots(ctx, req.(*types.StringValue))
+		return srv.(BackrestServer).GetMetricSeries(ctx, req.(*GetMetricSeriesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Backrest_Backup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Backrest_GetExclusionSuggestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(types.StringValue)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BackrestServer).Backup(ctx, in)
+		return srv.(BackrestServer).GetExclusionSuggestions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Backrest_Backup_FullMethodName,
+		FullMethod: Backrest_GetExclusionSuggestions_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BackrestServer).Backup(ctx, req.(*types.StringValue))
+		return srv.(BackrestServer).GetExclusionSuggestions(ctx, req.(*types.StringValue))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Backrest_Prune_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.StringValue)
+func _Backrest_AnnotateSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnnotateSnapshotRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BackrestServer).Prune(ctx, in)
+		return srv.(BackrestServer).AnnotateSnapshot(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Backrest_Prune_FullMethodName,
+		FullMethod: Backrest_AnnotateSnapshot_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BackrestServer).Prune(ctx, req.(*types.StringValue))
+		return srv.(BackrestServer).AnnotateSnapshot(ctx, req.(*AnnotateSnapshotRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Backrest_Forget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ForgetRequest)
+func _Backrest_PinSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinSnapshotRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BackrestServer).Forget(ctx, in)
+		return srv.(BackrestServer).PinSnapshot(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Backrest_Forget_FullMethodName,
+		FullMethod: Backrest_PinSnapshot_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BackrestServer).Forget(ctx, req.(*ForgetRequest))
+		return srv.(BackrestServer).PinSnapshot(ctx, req.(*PinSnapshotRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Backrest_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RestoreSnapshotRequest)
+func _Backrest_RescueSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RescueSnapshotRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BackrestServer).Restore(ctx, in)
+		return srv.(BackrestServer).RescueSnapshot(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Backrest_Restore_FullMethodName,
+		FullMethod: Backrest_RescueSnapshot_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BackrestServer).Restore(ctx, req.(*RestoreSnapshotRequest))
+		return srv.(BackrestServer).RescueSnapshot(ctx, req.(*RescueSnapshotRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Backrest_Unlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _Backrest_EmergencyStop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(types.StringValue)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BackrestServer).Unlock(ctx, in)
+		return srv.(BackrestServer).EmergencyStop(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Backrest_Unlock_FullMethodName,
+		FullMethod: Backrest_EmergencyStop_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BackrestServer).Unlock(ctx, req.(*types.StringValue))
+		return srv.(BackrestServer).EmergencyStop(ctx, req.(*types.StringValue))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Backrest_Stats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.StringValue)
+func _Backrest_ResumeSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BackrestServer).Stats(ctx, in)
+		return srv.(BackrestServer).ResumeSchedules(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Backrest_Stats_FullMethodName,
+		FullMethod: Backrest_ResumeSchedules_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BackrestServer).Stats(ctx, req.(*types.StringValue))
+		return srv.(BackrestServer).ResumeSchedules(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Backrest_Cancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.Int64Value)
+func _Backrest_InstantiatePlanTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstantiatePlanTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BackrestServer).Cancel(ctx, in)
+		return srv.(BackrestServer).InstantiatePlanTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Backrest_Cancel_FullMethodName,
+		FullMethod: Backrest_InstantiatePlanTemplate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BackrestServer).Cancel(ctx, req.(*types.Int64Value))
+		return srv.(BackrestServer).InstantiatePlanTemplate(ctx, req.(*InstantiatePlanTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Backrest_GetLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LogDataRequest)
+func _Backrest_DeletePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePlanRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BackrestServer).GetLogs(ctx, in)
+		return srv.(BackrestServer).DeletePlan(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Backrest_GetLogs_FullMethodName,
+		FullMethod: Backrest_DeletePlan_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BackrestServer).GetLogs(ctx, req.(*LogDataRequest))
+		return srv.(BackrestServer).DeletePlan(ctx, req.(*DeletePlanRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Backrest_ClearHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ClearHistoryRequest)
+func _Backrest_DeleteRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRepoRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BackrestServer).ClearHistory(ctx, in)
+		return srv.(BackrestServer).DeleteRepo(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Backrest_ClearHistory_FullMethodName,
+		FullMethod: Backrest_DeleteRepo_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BackrestServer).ClearHistory(ctx, req.(*ClearHistoryRequest))
+		return srv.(BackrestServer).DeleteRepo(ctx, req.(*DeleteRepoRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Backrest_PathAutocomplete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.StringValue)
+func _Backrest_AcknowledgeOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcknowledgeOperationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(BackrestServer).PathAutocomplete(ctx, in)
+		return srv.(BackrestServer).AcknowledgeOperation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Backrest_PathAutocomplete_FullMethodName,
+		FullMethod: Backrest_AcknowledgeOperation_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BackrestServer).PathAutocomplete(ctx, req.(*types.StringValue))
+		return srv.(BackrestServer).AcknowledgeOperation(ctx, req.(*AcknowledgeOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Backrest_BenchmarkRepo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BenchmarkRepoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackrestServer).BenchmarkRepo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Backrest_BenchmarkRepo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackrestServer).BenchmarkRepo(ctx, req.(*BenchmarkRepoRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -722,10 +2176,38 @@ var Backrest_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddRepo",
 			Handler:    _Backrest_AddRepo_Handler,
 		},
+		{
+			MethodName: "ImportRepo",
+			Handler:    _Backrest_ImportRepo_Handler,
+		},
+		{
+			MethodName: "ProbeRepo",
+			Handler:    _Backrest_ProbeRepo_Handler,
+		},
+		{
+			MethodName: "GenerateRepoPassword",
+			Handler:    _Backrest_GenerateRepoPassword_Handler,
+		},
+		{
+			MethodName: "InitRepo",
+			Handler:    _Backrest_InitRepo_Handler,
+		},
+		{
+			MethodName: "GetConfigHistory",
+			Handler:    _Backrest_GetConfigHistory_Handler,
+		},
+		{
+			MethodName: "RollbackConfig",
+			Handler:    _Backrest_RollbackConfig_Handler,
+		},
 		{
 			MethodName: "GetOperations",
 			Handler:    _Backrest_GetOperations_Handler,
 		},
+		{
+			MethodName: "QueryOperations",
+			Handler:    _Backrest_QueryOperations_Handler,
+		},
 		{
 			MethodName: "ListSnapshots",
 			Handler:    _Backrest_ListSnapshots_Handler,
@@ -734,6 +2216,18 @@ var Backrest_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListSnapshotFiles",
 			Handler:    _Backrest_ListSnapshotFiles_Handler,
 		},
+		{
+			MethodName: "GetLargestFiles",
+			Handler:    _Backrest_GetLargestFiles_Handler,
+		},
+		{
+			MethodName: "GetFileHistory",
+			Handler:    _Backrest_GetFileHistory_Handler,
+		},
+		{
+			MethodName: "GetReclaimedSpace",
+			Handler:    _Backrest_GetReclaimedSpace_Handler,
+		},
 		{
 			MethodName: "IndexSnapshots",
 			Handler:    _Backrest_IndexSnapshots_Handler,
@@ -742,6 +2236,10 @@ var Backrest_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Backup",
 			Handler:    _Backrest_Backup_Handler,
 		},
+		{
+			MethodName: "AdhocBackup",
+			Handler:    _Backrest_AdhocBackup_Handler,
+		},
 		{
 			MethodName: "Prune",
 			Handler:    _Backrest_Prune_Handler,
@@ -778,6 +2276,118 @@ var Backrest_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PathAutocomplete",
 			Handler:    _Backrest_PathAutocomplete_Handler,
 		},
+		{
+			MethodName: "GetRepoHealth",
+			Handler:    _Backrest_GetRepoHealth_Handler,
+		},
+		{
+			MethodName: "CheckOplogIntegrity",
+			Handler:    _Backrest_CheckOplogIntegrity_Handler,
+		},
+		{
+			MethodName: "GetAuditLog",
+			Handler:    _Backrest_GetAuditLog_Handler,
+		},
+		{
+			MethodName: "ExportAuditLog",
+			Handler:    _Backrest_ExportAuditLog_Handler,
+		},
+		{
+			MethodName: "ListRepoMigrations",
+			Handler:    _Backrest_ListRepoMigrations_Handler,
+		},
+		{
+			MethodName: "GetRepoCacheStats",
+			Handler:    _Backrest_GetRepoCacheStats_Handler,
+		},
+		{
+			MethodName: "MigrateRepo",
+			Handler:    _Backrest_MigrateRepo_Handler,
+		},
+		{
+			MethodName: "WarmupRepo",
+			Handler:    _Backrest_WarmupRepo_Handler,
+		},
+		{
+			MethodName: "GetRecoveryBundle",
+			Handler:    _Backrest_GetRecoveryBundle_Handler,
+		},
+		{
+			MethodName: "GetNotificationTemplates",
+			Handler:    _Backrest_GetNotificationTemplates_Handler,
+		},
+		{
+			MethodName: "GetRemoteStatus",
+			Handler:    _Backrest_GetRemoteStatus_Handler,
+		},
+		{
+			MethodName: "GetRemoteOperations",
+			Handler:    _Backrest_GetRemoteOperations_Handler,
+		},
+		{
+			MethodName: "GetRuns",
+			Handler:    _Backrest_GetRuns_Handler,
+		},
+		{
+			MethodName: "GetDurationEstimates",
+			Handler:    _Backrest_GetDurationEstimates_Handler,
+		},
+		{
+			MethodName: "GetResourceUsage",
+			Handler:    _Backrest_GetResourceUsage_Handler,
+		},
+		{
+			MethodName: "UpdateRestic",
+			Handler:    _Backrest_UpdateRestic_Handler,
+		},
+		{
+			MethodName: "GetMetricSeries",
+			Handler:    _Backrest_GetMetricSeries_Handler,
+		},
+		{
+			MethodName: "GetExclusionSuggestions",
+			Handler:    _Backrest_GetExclusionSuggestions_Handler,
+		},
+		{
+			MethodName: "AnnotateSnapshot",
+			Handler:    _Backrest_AnnotateSnapshot_Handler,
+		},
+		{
+			MethodName: "PinSnapshot",
+			Handler:    _Backrest_PinSnapshot_Handler,
+		},
+		{
+			MethodName: "RescueSnapshot",
+			Handler:    _Backrest_RescueSnapshot_Handler,
+		},
+		{
+			MethodName: "EmergencyStop",
+			Handler:    _Backrest_EmergencyStop_Handler,
+		},
+		{
+			MethodName: "ResumeSchedules",
+			Handler:    _Backrest_ResumeSchedules_Handler,
+		},
+		{
+			MethodName: "InstantiatePlanTemplate",
+			Handler:    _Backrest_InstantiatePlanTemplate_Handler,
+		},
+		{
+			MethodName: "DeletePlan",
+			Handler:    _Backrest_DeletePlan_Handler,
+		},
+		{
+			MethodName: "DeleteRepo",
+			Handler:    _Backrest_DeleteRepo_Handler,
+		},
+		{
+			MethodName: "AcknowledgeOperation",
+			Handler:    _Backrest_AcknowledgeOperation_Handler,
+		},
+		{
+			MethodName: "BenchmarkRepo",
+			Handler:    _Backrest_BenchmarkRepo_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -785,6 +2395,11 @@ var Backrest_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Backrest_GetOperationEvents_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "SubscribeOperations",
+			Handler:       _Backrest_SubscribeOperations_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "v1/service.proto",
 }
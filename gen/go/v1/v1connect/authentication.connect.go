@@ -10,6 +10,7 @@ import (
 	errors "errors"
 	types "github.com/garethgeorge/backrest/gen/go/types"
 	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	http "net/http"
 	strings "strings"
 )
@@ -39,19 +40,75 @@ const (
 	// AuthenticationHashPasswordProcedure is the fully-qualified name of the Authentication's
 	// HashPassword RPC.
 	AuthenticationHashPasswordProcedure = "/v1.Authentication/HashPassword"
+	// AuthenticationBeginTotpEnrollmentProcedure is the fully-qualified name of the Authentication's
+	// BeginTotpEnrollment RPC.
+	AuthenticationBeginTotpEnrollmentProcedure = "/v1.Authentication/BeginTotpEnrollment"
+	// AuthenticationConfirmTotpEnrollmentProcedure is the fully-qualified name of the Authentication's
+	// ConfirmTotpEnrollment RPC.
+	AuthenticationConfirmTotpEnrollmentProcedure = "/v1.Authentication/ConfirmTotpEnrollment"
+	// AuthenticationBeginWebAuthnRegistrationProcedure is the fully-qualified name of the
+	// Authentication's BeginWebAuthnRegistration RPC.
+	AuthenticationBeginWebAuthnRegistrationProcedure = "/v1.Authentication/BeginWebAuthnRegistration"
+	// AuthenticationFinishWebAuthnRegistrationProcedure is the fully-qualified name of the
+	// Authentication's FinishWebAuthnRegistration RPC.
+	AuthenticationFinishWebAuthnRegistrationProcedure = "/v1.Authentication/FinishWebAuthnRegistration"
+	// AuthenticationRegenerateRecoveryCodesProcedure is the fully-qualified name of the
+	// Authentication's RegenerateRecoveryCodes RPC.
+	AuthenticationRegenerateRecoveryCodesProcedure = "/v1.Authentication/RegenerateRecoveryCodes"
+	// AuthenticationRemoveSecondFactorsProcedure is the fully-qualified name of the Authentication's
+	// RemoveSecondFactors RPC.
+	AuthenticationRemoveSecondFactorsProcedure = "/v1.Authentication/RemoveSecondFactors"
+	// AuthenticationCreateApiTokenProcedure is the fully-qualified name of the Authentication's
+	// CreateApiToken RPC.
+	AuthenticationCreateApiTokenProcedure = "/v1.Authentication/CreateApiToken"
+	// AuthenticationListLoginLockoutsProcedure is the fully-qualified name of the Authentication's
+	// ListLoginLockouts RPC.
+	AuthenticationListLoginLockoutsProcedure = "/v1.Authentication/ListLoginLockouts"
+	// AuthenticationClearLoginLockoutsProcedure is the fully-qualified name of the Authentication's
+	// ClearLoginLockouts RPC.
+	AuthenticationClearLoginLockoutsProcedure = "/v1.Authentication/ClearLoginLockouts"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
 var (
-	authenticationServiceDescriptor            = v1.File_v1_authentication_proto.Services().ByName("Authentication")
-	authenticationLoginMethodDescriptor        = authenticationServiceDescriptor.Methods().ByName("Login")
-	authenticationHashPasswordMethodDescriptor = authenticationServiceDescriptor.Methods().ByName("HashPassword")
+	authenticationServiceDescriptor                          = v1.File_v1_authentication_proto.Services().ByName("Authentication")
+	authenticationLoginMethodDescriptor                      = authenticationServiceDescriptor.Methods().ByName("Login")
+	authenticationHashPasswordMethodDescriptor               = authenticationServiceDescriptor.Methods().ByName("HashPassword")
+	authenticationBeginTotpEnrollmentMethodDescriptor        = authenticationServiceDescriptor.Methods().ByName("BeginTotpEnrollment")
+	authenticationConfirmTotpEnrollmentMethodDescriptor      = authenticationServiceDescriptor.Methods().ByName("ConfirmTotpEnrollment")
+	authenticationBeginWebAuthnRegistrationMethodDescriptor  = authenticationServiceDescriptor.Methods().ByName("BeginWebAuthnRegistration")
+	authenticationFinishWebAuthnRegistrationMethodDescriptor = authenticationServiceDescriptor.Methods().ByName("FinishWebAuthnRegistration")
+	authenticationRegenerateRecoveryCodesMethodDescriptor    = authenticationServiceDescriptor.Methods().ByName("RegenerateRecoveryCodes")
+	authenticationRemoveSecondFactorsMethodDescriptor        = authenticationServiceDescriptor.Methods().ByName("RemoveSecondFactors")
+	authenticationCreateApiTokenMethodDescriptor             = authenticationServiceDescriptor.Methods().ByName("CreateApiToken")
+	authenticationListLoginLockoutsMethodDescriptor          = authenticationServiceDescriptor.Methods().ByName("ListLoginLockouts")
+	authenticationClearLoginLockoutsMethodDescriptor         = authenticationServiceDescriptor.Methods().ByName("ClearLoginLockouts")
 )
 
 // AuthenticationClient is a client for the v1.Authentication service.
 type AuthenticationClient interface {
 	Login(context.Context, *connect.Request[v1.LoginRequest]) (*connect.Response[v1.LoginResponse], error)
 	HashPassword(context.Context, *connect.Request[types.StringValue]) (*connect.Response[types.StringValue], error)
+	// BeginTotpEnrollment generates a TOTP secret, it is enrolled once confirmed with a code.
+	BeginTotpEnrollment(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.TotpEnrollment], error)
+	// ConfirmTotpEnrollment enrolls the TOTP secret, recovery codes are returned if the user had none.
+	ConfirmTotpEnrollment(context.Context, *connect.Request[v1.ConfirmTotpEnrollmentRequest]) (*connect.Response[v1.RecoveryCodes], error)
+	// BeginWebAuthnRegistration returns the options for navigator.credentials.create().
+	BeginWebAuthnRegistration(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.WebAuthnChallenge], error)
+	// FinishWebAuthnRegistration enrolls the created credential, recovery codes are returned if the user had none.
+	FinishWebAuthnRegistration(context.Context, *connect.Request[v1.FinishWebAuthnRegistrationRequest]) (*connect.Response[v1.RecoveryCodes], error)
+	// RegenerateRecoveryCodes replaces the user's recovery codes.
+	RegenerateRecoveryCodes(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.RecoveryCodes], error)
+	// RemoveSecondFactors removes all second factors of the authenticated user once they prove they still hold one, a
+	// user who lost theirs proves it with a recovery code.
+	RemoveSecondFactors(context.Context, *connect.Request[v1.RemoveSecondFactorsRequest]) (*connect.Response[emptypb.Empty], error)
+	// CreateApiToken returns a token for the user valid for the requested number of days (default 365, at most 3650)
+	// e.g. for another instance to read this instance's status.
+	CreateApiToken(context.Context, *connect.Request[types.Int64Value]) (*connect.Response[types.StringValue], error)
+	// ListLoginLockouts returns the clients and users with recent failed logins and whether they are locked out.
+	ListLoginLockouts(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.LoginLockoutList], error)
+	// ClearLoginLockouts clears the failed logins of the key e.g. "user:alice", or of all keys if empty.
+	ClearLoginLockouts(context.Context, *connect.Request[types.StringValue]) (*connect.Response[emptypb.Empty], error)
 }
 
 // NewAuthenticationClient constructs a client for the v1.Authentication service. By default, it
@@ -76,13 +133,76 @@ func NewAuthenticationClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(authenticationHashPasswordMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		beginTotpEnrollment: connect.NewClient[emptypb.Empty, v1.TotpEnrollment](
+			httpClient,
+			baseURL+AuthenticationBeginTotpEnrollmentProcedure,
+			connect.WithSchema(authenticationBeginTotpEnrollmentMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		confirmTotpEnrollment: connect.NewClient[v1.ConfirmTotpEnrollmentRequest, v1.RecoveryCodes](
+			httpClient,
+			baseURL+AuthenticationConfirmTotpEnrollmentProcedure,
+			connect.WithSchema(authenticationConfirmTotpEnrollmentMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		beginWebAuthnRegistration: connect.NewClient[emptypb.Empty, v1.WebAuthnChallenge](
+			httpClient,
+			baseURL+AuthenticationBeginWebAuthnRegistrationProcedure,
+			connect.WithSchema(authenticationBeginWebAuthnRegistrationMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		finishWebAuthnRegistration: connect.NewClient[v1.FinishWebAuthnRegistrationRequest, v1.RecoveryCodes](
+			httpClient,
+			baseURL+AuthenticationFinishWebAuthnRegistrationProcedure,
+			connect.WithSchema(authenticationFinishWebAuthnRegistrationMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		regenerateRecoveryCodes: connect.NewClient[emptypb.Empty, v1.RecoveryCodes](
+			httpClient,
+			baseURL+AuthenticationRegenerateRecoveryCodesProcedure,
+			connect.WithSchema(authenticationRegenerateRecoveryCodesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		removeSecondFactors: connect.NewClient[v1.RemoveSecondFactorsRequest, emptypb.Empty](
+			httpClient,
+			baseURL+AuthenticationRemoveSecondFactorsProcedure,
+			connect.WithSchema(authenticationRemoveSecondFactorsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		createApiToken: connect.NewClient[types.Int64Value, types.StringValue](
+			httpClient,
+			baseURL+AuthenticationCreateApiTokenProcedure,
+			connect.WithSchema(authenticationCreateApiTokenMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listLoginLockouts: connect.NewClient[emptypb.Empty, v1.LoginLockoutList](
+			httpClient,
+			baseURL+AuthenticationListLoginLockoutsProcedure,
+			connect.WithSchema(authenticationListLoginLockoutsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		clearLoginLockouts: connect.NewClient[types.StringValue, emptypb.Empty](
+			httpClient,
+			baseURL+AuthenticationClearLoginLockoutsProcedure,
+			connect.WithSchema(authenticationClearLoginLockoutsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // authenticationClient implements AuthenticationClient.
 type authenticationClient struct {
-	login        *connect.Client[v1.LoginRequest, v1.LoginResponse]
-	hashPassword *connect.Client[types.StringValue, types.StringValue]
+	login                      *connect.Client[v1.LoginRequest, v1.LoginResponse]
+	hashPassword               *connect.Client[types.StringValue, types.StringValue]
+	beginTotpEnrollment        *connect.Client[emptypb.Empty, v1.TotpEnrollment]
+	confirmTotpEnrollment      *connect.Client[v1.ConfirmTotpEnrollmentRequest, v1.RecoveryCodes]
+	beginWebAuthnRegistration  *connect.Client[emptypb.Empty, v1.WebAuthnChallenge]
+	finishWebAuthnRegistration *connect.Client[v1.FinishWebAuthnRegistrationRequest, v1.RecoveryCodes]
+	regenerateRecoveryCodes    *connect.Client[emptypb.Empty, v1.RecoveryCodes]
+	removeSecondFactors        *connect.Client[v1.RemoveSecondFactorsRequest, emptypb.Empty]
+	createApiToken             *connect.Client[types.Int64Value, types.StringValue]
+	listLoginLockouts          *connect.Client[emptypb.Empty, v1.LoginLockoutList]
+	clearLoginLockouts         *connect.Client[types.StringValue, emptypb.Empty]
 }
 
 // Login calls v1.Authentication.Login.
@@ -95,10 +215,75 @@ func (c *authenticationClient) HashPassword(ctx context.Context, req *connect.Re
 	return c.hashPassword.CallUnary(ctx, req)
 }
 
+// BeginTotpEnrollment calls v1.Authentication.BeginTotpEnrollment.
+func (c *authenticationClient) BeginTotpEnrollment(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[v1.TotpEnrollment], error) {
+	return c.beginTotpEnrollment.CallUnary(ctx, req)
+}
+
+// ConfirmTotpEnrollment calls v1.Authentication.ConfirmTotpEnrollment.
+func (c *authenticationClient) ConfirmTotpEnrollment(ctx context.Context, req *connect.Request[v1.ConfirmTotpEnrollmentRequest]) (*connect.Response[v1.RecoveryCodes], error) {
+	return c.confirmTotpEnrollment.CallUnary(ctx, req)
+}
+
+// BeginWebAuthnRegistration calls v1.Authentication.BeginWebAuthnRegistration.
+func (c *authenticationClient) BeginWebAuthnRegistration(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[v1.WebAuthnChallenge], error) {
+	return c.beginWebAuthnRegistration.CallUnary(ctx, req)
+}
+
+// FinishWebAuthnRegistration calls v1.Authentication.FinishWebAuthnRegistration.
+func (c *authenticationClient) FinishWebAuthnRegistration(ctx context.Context, req *connect.Request[v1.FinishWebAuthnRegistrationRequest]) (*connect.Response[v1.RecoveryCodes], error) {
+	return c.finishWebAuthnRegistration.CallUnary(ctx, req)
+}
+
+// RegenerateRecoveryCodes calls v1.Authentication.RegenerateRecoveryCodes.
+func (c *authenticationClient) RegenerateRecoveryCodes(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[v1.RecoveryCodes], error) {
+	return c.regenerateRecoveryCodes.CallUnary(ctx, req)
+}
+
+// RemoveSecondFactors calls v1.Authentication.RemoveSecondFactors.
+func (c *authenticationClient) RemoveSecondFactors(ctx context.Context, req *connect.Request[v1.RemoveSecondFactorsRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.removeSecondFactors.CallUnary(ctx, req)
+}
+
+// CreateApiToken calls v1.Authentication.CreateApiToken.
+func (c *authenticationClient) CreateApiToken(ctx context.Context, req *connect.Request[types.Int64Value]) (*connect.Response[types.StringValue], error) {
+	return c.createApiToken.CallUnary(ctx, req)
+}
+
+// ListLoginLockouts calls v1.Authentication.ListLoginLockouts.
+func (c *authenticationClient) ListLoginLockouts(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[v1.LoginLockoutList], error) {
+	return c.listLoginLockouts.CallUnary(ctx, req)
+}
+
+// ClearLoginLockouts calls v1.Authentication.ClearLoginLockouts.
+func (c *authenticationClient) ClearLoginLockouts(ctx context.Context, req *connect.Request[types.StringValue]) (*connect.Response[emptypb.Empty], error) {
+	return c.clearLoginLockouts.CallUnary(ctx, req)
+}
+
 // AuthenticationHandler is an implementation of the v1.Authentication service.
 type AuthenticationHandler interface {
 	Login(context.Context, *connect.Request[v1.LoginRequest]) (*connect.Response[v1.LoginResponse], error)
 	HashPassword(context.Context, *connect.Request[types.StringValue]) (*connect.Response[types.StringValue], error)
+	// BeginTotpEnrollment generates a TOTP secret, it is enrolled once confirmed with a code.
+	BeginTotpEnrollment(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.TotpEnrollment], error)
+	// ConfirmTotpEnrollment enrolls the TOTP secret, recovery codes are returned if the user had none.
+	ConfirmTotpEnrollment(context.Context, *connect.Request[v1.ConfirmTotpEnrollmentRequest]) (*connect.Response[v1.RecoveryCodes], error)
+	// BeginWebAuthnRegistration returns the options for navigator.credentials.create().
+	BeginWebAuthnRegistration(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.WebAuthnChallenge], error)
+	// FinishWebAuthnRegistration enrolls the created credential, recovery codes are returned if the user had none.
+	FinishWebAuthnRegistration(context.Context, *connect.Request[v1.FinishWebAuthnRegistrationRequest]) (*connect.Response[v1.RecoveryCodes], error)
+	// RegenerateRecoveryCodes replaces the user's recovery codes.
+	RegenerateRecoveryCodes(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.RecoveryCodes], error)
+	// RemoveSecondFactors removes all second factors of the authenticated user once they prove they still hold one, a
+	// user who lost theirs proves it with a recovery code.
+	RemoveSecondFactors(context.Context, *connect.Request[v1.RemoveSecondFactorsRequest]) (*connect.Response[emptypb.Empty], error)
+	// CreateApiToken returns a token for the user valid for the requested number of days (default 365, at most 3650)
+	// e.g. for another instance to read this instance's status.
+	CreateApiToken(context.Context, *connect.Request[types.Int64Value]) (*connect.Response[types.StringValue], error)
+	// ListLoginLockouts returns the clients and users with recent failed logins and whether they are locked out.
+	ListLoginLockouts(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.LoginLockoutList], error)
+	// ClearLoginLockouts clears the failed logins of the key e.g. "user:alice", or of all keys if empty.
+	ClearLoginLockouts(context.Context, *connect.Request[types.StringValue]) (*connect.Response[emptypb.Empty], error)
 }
 
 // NewAuthenticationHandler builds an HTTP handler from the service implementation. It returns the
@@ -119,12 +304,84 @@ func NewAuthenticationHandler(svc AuthenticationHandler, opts ...connect.Handler
 		connect.WithSchema(authenticationHashPasswordMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	authenticationBeginTotpEnrollmentHandler := connect.NewUnaryHandler(
+		AuthenticationBeginTotpEnrollmentProcedure,
+		svc.BeginTotpEnrollment,
+		connect.WithSchema(authenticationBeginTotpEnrollmentMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	authenticationConfirmTotpEnrollmentHandler := connect.NewUnaryHandler(
+		AuthenticationConfirmTotpEnrollmentProcedure,
+		svc.ConfirmTotpEnrollment,
+		connect.WithSchema(authenticationConfirmTotpEnrollmentMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	authenticationBeginWebAuthnRegistrationHandler := connect.NewUnaryHandler(
+		AuthenticationBeginWebAuthnRegistrationProcedure,
+		svc.BeginWebAuthnRegistration,
+		connect.WithSchema(authenticationBeginWebAuthnRegistrationMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	authenticationFinishWebAuthnRegistrationHandler := connect.NewUnaryHandler(
+		AuthenticationFinishWebAuthnRegistrationProcedure,
+		svc.FinishWebAuthnRegistration,
+		connect.WithSchema(authenticationFinishWebAuthnRegistrationMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	authenticationRegenerateRecoveryCodesHandler := connect.NewUnaryHandler(
+		AuthenticationRegenerateRecoveryCodesProcedure,
+		svc.RegenerateRecoveryCodes,
+		connect.WithSchema(authenticationRegenerateRecoveryCodesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	authenticationRemoveSecondFactorsHandler := connect.NewUnaryHandler(
+		AuthenticationRemoveSecondFactorsProcedure,
+		svc.RemoveSecondFactors,
+		connect.WithSchema(authenticationRemoveSecondFactorsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	authenticationCreateApiTokenHandler := connect.NewUnaryHandler(
+		AuthenticationCreateApiTokenProcedure,
+		svc.CreateApiToken,
+		connect.WithSchema(authenticationCreateApiTokenMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	authenticationListLoginLockoutsHandler := connect.NewUnaryHandler(
+		AuthenticationListLoginLockoutsProcedure,
+		svc.ListLoginLockouts,
+		connect.WithSchema(authenticationListLoginLockoutsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	authenticationClearLoginLockoutsHandler := connect.NewUnaryHandler(
+		AuthenticationClearLoginLockoutsProcedure,
+		svc.ClearLoginLockouts,
+		connect.WithSchema(authenticationClearLoginLockoutsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/v1.Authentication/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case AuthenticationLoginProcedure:
 			authenticationLoginHandler.ServeHTTP(w, r)
 		case AuthenticationHashPasswordProcedure:
 			authenticationHashPasswordHandler.ServeHTTP(w, r)
+		case AuthenticationBeginTotpEnrollmentProcedure:
+			authenticationBeginTotpEnrollmentHandler.ServeHTTP(w, r)
+		case AuthenticationConfirmTotpEnrollmentProcedure:
+			authenticationConfirmTotpEnrollmentHandler.ServeHTTP(w, r)
+		case AuthenticationBeginWebAuthnRegistrationProcedure:
+			authenticationBeginWebAuthnRegistrationHandler.ServeHTTP(w, r)
+		case AuthenticationFinishWebAuthnRegistrationProcedure:
+			authenticationFinishWebAuthnRegistrationHandler.ServeHTTP(w, r)
+		case AuthenticationRegenerateRecoveryCodesProcedure:
+			authenticationRegenerateRecoveryCodesHandler.ServeHTTP(w, r)
+		case AuthenticationRemoveSecondFactorsProcedure:
+			authenticationRemoveSecondFactorsHandler.ServeHTTP(w, r)
+		case AuthenticationCreateApiTokenProcedure:
+			authenticationCreateApiTokenHandler.ServeHTTP(w, r)
+		case AuthenticationListLoginLockoutsProcedure:
+			authenticationListLoginLockoutsHandler.ServeHTTP(w, r)
+		case AuthenticationClearLoginLockoutsProcedure:
+			authenticationClearLoginLockoutsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -141,3 +398,39 @@ func (UnimplementedAuthenticationHandler) Login(context.Context, *connect.Reques
 func (UnimplementedAuthenticationHandler) HashPassword(context.Context, *connect.Request[types.StringValue]) (*connect.Response[types.StringValue], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Authentication.HashPassword is not implemented"))
 }
+
+func (UnimplementedAuthenticationHandler) BeginTotpEnrollment(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.TotpEnrollment], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Authentication.BeginTotpEnrollment is not implemented"))
+}
+
+func (UnimplementedAuthenticationHandler) ConfirmTotpEnrollment(context.Context, *connect.Request[v1.ConfirmTotpEnrollmentRequest]) (*connect.Response[v1.RecoveryCodes], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Authentication.ConfirmTotpEnrollment is not implemented"))
+}
+
+func (UnimplementedAuthenticationHandler) BeginWebAuthnRegistration(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.WebAuthnChallenge], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Authentication.BeginWebAuthnRegistration is not implemented"))
+}
+
+func (UnimplementedAuthenticationHandler) FinishWebAuthnRegistration(context.Context, *connect.Request[v1.FinishWebAuthnRegistrationRequest]) (*connect.Response[v1.RecoveryCodes], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Authentication.FinishWebAuthnRegistration is not implemented"))
+}
+
+func (UnimplementedAuthenticationHandler) RegenerateRecoveryCodes(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.RecoveryCodes], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Authentication.RegenerateRecoveryCodes is not implemented"))
+}
+
+func (UnimplementedAuthenticationHandler) RemoveSecondFactors(context.Context, *connect.Request[v1.RemoveSecondFactorsRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Authentication.RemoveSecondFactors is not implemented"))
+}
+
+func (UnimplementedAuthenticationHandler) CreateApiToken(context.Context, *connect.Request[types.Int64Value]) (*connect.Response[types.StringValue], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Authentication.CreateApiToken is not implemented"))
+}
+
+func (UnimplementedAuthenticationHandler) ListLoginLockouts(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.LoginLockoutList], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Authentication.ListLoginLockouts is not implemented"))
+}
+
+func (UnimplementedAuthenticationHandler) ClearLoginLockouts(context.Context, *connect.Request[types.StringValue]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Authentication.ClearLoginLockouts is not implemented"))
+}
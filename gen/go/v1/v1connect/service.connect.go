@@ -41,20 +41,50 @@ const (
 	BackrestSetConfigProcedure = "/v1.Backrest/SetConfig"
 	// BackrestAddRepoProcedure is the fully-qualified name of the Backrest's AddRepo RPC.
 	BackrestAddRepoProcedure = "/v1.Backrest/AddRepo"
+	// BackrestImportRepoProcedure is the fully-qualified name of the Backrest's ImportRepo RPC.
+	BackrestImportRepoProcedure = "/v1.Backrest/ImportRepo"
+	// BackrestProbeRepoProcedure is the fully-qualified name of the Backrest's ProbeRepo RPC.
+	BackrestProbeRepoProcedure = "/v1.Backrest/ProbeRepo"
+	// BackrestGenerateRepoPasswordProcedure is the fully-qualified name of the Backrest's
+	// GenerateRepoPassword RPC.
+	BackrestGenerateRepoPasswordProcedure = "/v1.Backrest/GenerateRepoPassword"
+	// BackrestInitRepoProcedure is the fully-qualified name of the Backrest's InitRepo RPC.
+	BackrestInitRepoProcedure = "/v1.Backrest/InitRepo"
+	// BackrestGetConfigHistoryProcedure is the fully-qualified name of the Backrest's GetConfigHistory
+	// RPC.
+	BackrestGetConfigHistoryProcedure = "/v1.Backrest/GetConfigHistory"
+	// BackrestRollbackConfigProcedure is the fully-qualified name of the Backrest's RollbackConfig RPC.
+	BackrestRollbackConfigProcedure = "/v1.Backrest/RollbackConfig"
 	// BackrestGetOperationEventsProcedure is the fully-qualified name of the Backrest's
 	// GetOperationEvents RPC.
 	BackrestGetOperationEventsProcedure = "/v1.Backrest/GetOperationEvents"
+	// BackrestSubscribeOperationsProcedure is the fully-qualified name of the Backrest's
+	// SubscribeOperations RPC.
+	BackrestSubscribeOperationsProcedure = "/v1.Backrest/SubscribeOperations"
 	// BackrestGetOperationsProcedure is the fully-qualified name of the Backrest's GetOperations RPC.
 	BackrestGetOperationsProcedure = "/v1.Backrest/GetOperations"
+	// BackrestQueryOperationsProcedure is the fully-qualified name of the Backrest's QueryOperations
+	// RPC.
+	BackrestQueryOperationsProcedure = "/v1.Backrest/QueryOperations"
 	// BackrestListSnapshotsProcedure is the fully-qualified name of the Backrest's ListSnapshots RPC.
 	BackrestListSnapshotsProcedure = "/v1.Backrest/ListSnapshots"
 	// BackrestListSnapshotFilesProcedure is the fully-qualified name of the Backrest's
 	// ListSnapshotFiles RPC.
 	BackrestListSnapshotFilesProcedure = "/v1.Backrest/ListSnapshotFiles"
+	// BackrestGetLargestFilesProcedure is the fully-qualified name of the Backrest's GetLargestFiles
+	// RPC.
+	BackrestGetLargestFilesProcedure = "/v1.Backrest/GetLargestFiles"
+	// BackrestGetFileHistoryProcedure is the fully-qualified name of the Backrest's GetFileHistory RPC.
+	BackrestGetFileHistoryProcedure = "/v1.Backrest/GetFileHistory"
+	// BackrestGetReclaimedSpaceProcedure is the fully-qualified name of the Backrest's
+	// GetReclaimedSpace RPC.
+	BackrestGetReclaimedSpaceProcedure = "/v1.Backrest/GetReclaimedSpace"
 	// BackrestIndexSnapshotsProcedure is the fully-qualified name of the Backrest's IndexSnapshots RPC.
 	BackrestIndexSnapshotsProcedure = "/v1.Backrest/IndexSnapshots"
 	// BackrestBackupProcedure is the fully-qualified name of the Backrest's Backup RPC.
 	BackrestBackupProcedure = "/v1.Backrest/Backup"
+	// BackrestAdhocBackupProcedure is the fully-qualified name of the Backrest's AdhocBackup RPC.
+	BackrestAdhocBackupProcedure = "/v1.Backrest/AdhocBackup"
 	// BackrestPruneProcedure is the fully-qualified name of the Backrest's Prune RPC.
 	BackrestPruneProcedure = "/v1.Backrest/Prune"
 	// BackrestForgetProcedure is the fully-qualified name of the Backrest's Forget RPC.
@@ -74,29 +104,140 @@ const (
 	// BackrestPathAutocompleteProcedure is the fully-qualified name of the Backrest's PathAutocomplete
 	// RPC.
 	BackrestPathAutocompleteProcedure = "/v1.Backrest/PathAutocomplete"
+	// BackrestGetRepoHealthProcedure is the fully-qualified name of the Backrest's GetRepoHealth RPC.
+	BackrestGetRepoHealthProcedure = "/v1.Backrest/GetRepoHealth"
+	// BackrestCheckOplogIntegrityProcedure is the fully-qualified name of the Backrest's
+	// CheckOplogIntegrity RPC.
+	BackrestCheckOplogIntegrityProcedure = "/v1.Backrest/CheckOplogIntegrity"
+	// BackrestGetAuditLogProcedure is the fully-qualified name of the Backrest's GetAuditLog RPC.
+	BackrestGetAuditLogProcedure = "/v1.Backrest/GetAuditLog"
+	// BackrestExportAuditLogProcedure is the fully-qualified name of the Backrest's ExportAuditLog RPC.
+	BackrestExportAuditLogProcedure = "/v1.Backrest/ExportAuditLog"
+	// BackrestListRepoMigrationsProcedure is the fully-qualified name of the Backrest's
+	// ListRepoMigrations RPC.
+	BackrestListRepoMigrationsProcedure = "/v1.Backrest/ListRepoMigrations"
+	// BackrestGetRepoCacheStatsProcedure is the fully-qualified name of the Backrest's
+	// GetRepoCacheStats RPC.
+	BackrestGetRepoCacheStatsProcedure = "/v1.Backrest/GetRepoCacheStats"
+	// BackrestMigrateRepoProcedure is the fully-qualified name of the Backrest's MigrateRepo RPC.
+	BackrestMigrateRepoProcedure = "/v1.Backrest/MigrateRepo"
+	// BackrestWarmupRepoProcedure is the fully-qualified name of the Backrest's WarmupRepo RPC.
+	BackrestWarmupRepoProcedure = "/v1.Backrest/WarmupRepo"
+	// BackrestGetRecoveryBundleProcedure is the fully-qualified name of the Backrest's
+	// GetRecoveryBundle RPC.
+	BackrestGetRecoveryBundleProcedure = "/v1.Backrest/GetRecoveryBundle"
+	// BackrestGetNotificationTemplatesProcedure is the fully-qualified name of the Backrest's
+	// GetNotificationTemplates RPC.
+	BackrestGetNotificationTemplatesProcedure = "/v1.Backrest/GetNotificationTemplates"
+	// BackrestGetRemoteStatusProcedure is the fully-qualified name of the Backrest's GetRemoteStatus
+	// RPC.
+	BackrestGetRemoteStatusProcedure = "/v1.Backrest/GetRemoteStatus"
+	// BackrestGetRemoteOperationsProcedure is the fully-qualified name of the Backrest's
+	// GetRemoteOperations RPC.
+	BackrestGetRemoteOperationsProcedure = "/v1.Backrest/GetRemoteOperations"
+	// BackrestGetRunsProcedure is the fully-qualified name of the Backrest's GetRuns RPC.
+	BackrestGetRunsProcedure = "/v1.Backrest/GetRuns"
+	// BackrestGetDurationEstimatesProcedure is the fully-qualified name of the Backrest's
+	// GetDurationEstimates RPC.
+	BackrestGetDurationEstimatesProcedure = "/v1.Backrest/GetDurationEstimates"
+	// BackrestGetResourceUsageProcedure is the fully-qualified name of the Backrest's GetResourceUsage
+	// RPC.
+	BackrestGetResourceUsageProcedure = "/v1.Backrest/GetResourceUsage"
+	// BackrestUpdateResticProcedure is the fully-qualified name of the Backrest's UpdateRestic RPC.
+	BackrestUpdateResticProcedure = "/v1.Backrest/UpdateRestic"
+	// BackrestGetMetricSeriesProcedure is the fully-qualified name of the Backrest's GetMetricSeries
+	// RPC.
+	BackrestGetMetricSeriesProcedure = "/v1.Backrest/GetMetricSeries"
+	// BackrestGetExclusionSuggestionsProcedure is the fully-qualified name of the Backrest's
+	// GetExclusionSuggestions RPC.
+	BackrestGetExclusionSuggestionsProcedure = "/v1.Backrest/GetExclusionSuggestions"
+	// BackrestAnnotateSnapshotProcedure is the fully-qualified name of the Backrest's AnnotateSnapshot
+	// RPC.
+	BackrestAnnotateSnapshotProcedure = "/v1.Backrest/AnnotateSnapshot"
+	// BackrestPinSnapshotProcedure is the fully-qualified name of the Backrest's PinSnapshot RPC.
+	BackrestPinSnapshotProcedure = "/v1.Backrest/PinSnapshot"
+	// BackrestRescueSnapshotProcedure is the fully-qualified name of the Backrest's RescueSnapshot RPC.
+	BackrestRescueSnapshotProcedure = "/v1.Backrest/RescueSnapshot"
+	// BackrestEmergencyStopProcedure is the fully-qualified name of the Backrest's EmergencyStop RPC.
+	BackrestEmergencyStopProcedure = "/v1.Backrest/EmergencyStop"
+	// BackrestResumeSchedulesProcedure is the fully-qualified name of the Backrest's ResumeSchedules
+	// RPC.
+	BackrestResumeSchedulesProcedure = "/v1.Backrest/ResumeSchedules"
+	// BackrestInstantiatePlanTemplateProcedure is the fully-qualified name of the Backrest's
+	// InstantiatePlanTemplate RPC.
+	BackrestInstantiatePlanTemplateProcedure = "/v1.Backrest/InstantiatePlanTemplate"
+	// BackrestDeletePlanProcedure is the fully-qualified name of the Backrest's DeletePlan RPC.
+	BackrestDeletePlanProcedure = "/v1.Backrest/DeletePlan"
+	// BackrestDeleteRepoProcedure is the fully-qualified name of the Backrest's DeleteRepo RPC.
+	BackrestDeleteRepoProcedure = "/v1.Backrest/DeleteRepo"
+	// BackrestAcknowledgeOperationProcedure is the fully-qualified name of the Backrest's
+	// AcknowledgeOperation RPC.
+	BackrestAcknowledgeOperationProcedure = "/v1.Backrest/AcknowledgeOperation"
+	// BackrestBenchmarkRepoProcedure is the fully-qualified name of the Backrest's BenchmarkRepo RPC.
+	BackrestBenchmarkRepoProcedure = "/v1.Backrest/BenchmarkRepo"
 )
 
 // These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
 var (
-	backrestServiceDescriptor                  = v1.File_v1_service_proto.Services().ByName("Backrest")
-	backrestGetConfigMethodDescriptor          = backrestServiceDescriptor.Methods().ByName("GetConfig")
-	backrestSetConfigMethodDescriptor          = backrestServiceDescriptor.Methods().ByName("SetConfig")
-	backrestAddRepoMethodDescriptor            = backrestServiceDescriptor.Methods().ByName("AddRepo")
-	backrestGetOperationEventsMethodDescriptor = backrestServiceDescriptor.Methods().ByName("GetOperationEvents")
-	backrestGetOperationsMethodDescriptor      = backrestServiceDescriptor.Methods().ByName("GetOperations")
-	backrestListSnapshotsMethodDescriptor      = backrestServiceDescriptor.Methods().ByName("ListSnapshots")
-	backrestListSnapshotFilesMethodDescriptor  = backrestServiceDescriptor.Methods().ByName("ListSnapshotFiles")
-	backrestIndexSnapshotsMethodDescriptor     = backrestServiceDescriptor.Methods().ByName("IndexSnapshots")
-	backrestBackupMethodDescriptor             = backrestServiceDescriptor.Methods().ByName("Backup")
-	backrestPruneMethodDescriptor              = backrestServiceDescriptor.Methods().ByName("Prune")
-	backrestForgetMethodDescriptor             = backrestServiceDescriptor.Methods().ByName("Forget")
-	backrestRestoreMethodDescriptor            = backrestServiceDescriptor.Methods().ByName("Restore")
-	backrestUnlockMethodDescriptor             = backrestServiceDescriptor.Methods().ByName("Unlock")
-	backrestStatsMethodDescriptor              = backrestServiceDescriptor.Methods().ByName("Stats")
-	backrestCancelMethodDescriptor             = backrestServiceDescriptor.Methods().ByName("Cancel")
-	backrestGetLogsMethodDescriptor            = backrestServiceDescriptor.Methods().ByName("GetLogs")
-	backrestClearHistoryMethodDescriptor       = backrestServiceDescriptor.Methods().ByName("ClearHistory")
-	backrestPathAutocompleteMethodDescriptor   = backrestServiceDescriptor.Methods().ByName("PathAutocomplete")
+	backrestServiceDescriptor                        = v1.File_v1_service_proto.Services().ByName("Backrest")
+	backrestGetConfigMethodDescriptor                = backrestServiceDescriptor.Methods().ByName("GetConfig")
+	backrestSetConfigMethodDescriptor                = backrestServiceDescriptor.Methods().ByName("SetConfig")
+	backrestAddRepoMethodDescriptor                  = backrestServiceDescriptor.Methods().ByName("AddRepo")
+	backrestImportRepoMethodDescriptor               = backrestServiceDescriptor.Methods().ByName("ImportRepo")
+	backrestProbeRepoMethodDescriptor                = backrestServiceDescriptor.Methods().ByName("ProbeRepo")
+	backrestGenerateRepoPasswordMethodDescriptor     = backrestServiceDescriptor.Methods().ByName("GenerateRepoPassword")
+	backrestInitRepoMethodDescriptor                 = backrestServiceDescriptor.Methods().ByName("InitRepo")
+	backrestGetConfigHistoryMethodDescriptor         = backrestServiceDescriptor.Methods().ByName("GetConfigHistory")
+	backrestRollbackConfigMethodDescriptor           = backrestServiceDescriptor.Methods().ByName("RollbackConfig")
+	backrestGetOperationEventsMethodDescriptor       = backrestServiceDescriptor.Methods().ByName("GetOperationEvents")
+	backrestSubscribeOperationsMethodDescriptor      = backrestServiceDescriptor.Methods().ByName("SubscribeOperations")
+	backrestGetOperationsMethodDescriptor            = backrestServiceDescriptor.Methods().ByName("GetOperations")
+	backrestQueryOperationsMethodDescriptor          = backrestServiceDescriptor.Methods().ByName("QueryOperations")
+	backrestListSnapshotsMethodDescriptor            = backrestServiceDescriptor.Methods().ByName("ListSnapshots")
+	backrestListSnapshotFilesMethodDescriptor        = backrestServiceDescriptor.Methods().ByName("ListSnapshotFiles")
+	backrestGetLargestFilesMethodDescriptor          = backrestServiceDescriptor.Methods().ByName("GetLargestFiles")
+	backrestGetFileHistoryMethodDescriptor           = backrestServiceDescriptor.Methods().ByName("GetFileHistory")
+	backrestGetReclaimedSpaceMethodDescriptor        = backrestServiceDescriptor.Methods().ByName("GetReclaimedSpace")
+	backrestIndexSnapshotsMethodDescriptor           = backrestServiceDescriptor.Methods().ByName("IndexSnapshots")
+	backrestBackupMethodDescriptor                   = backrestServiceDescriptor.Methods().ByName("Backup")
+	backrestAdhocBackupMethodDescriptor              = backrestServiceDescriptor.Methods().ByName("AdhocBackup")
+	backrestPruneMethodDescriptor                    = backrestServiceDescriptor.Methods().ByName("Prune")
+	backrestForgetMethodDescriptor                   = backrestServiceDescriptor.Methods().ByName("Forget")
+	backrestRestoreMethodDescriptor                  = backrestServiceDescriptor.Methods().ByName("Restore")
+	backrestUnlockMethodDescriptor                   = backrestServiceDescriptor.Methods().ByName("Unlock")
+	backrestStatsMethodDescriptor                    = backrestServiceDescriptor.Methods().ByName("Stats")
+	backrestCancelMethodDescriptor                   = backrestServiceDescriptor.Methods().ByName("Cancel")
+	backrestGetLogsMethodDescriptor                  = backrestServiceDescriptor.Methods().ByName("GetLogs")
+	backrestClearHistoryMethodDescriptor             = backrestServiceDescriptor.Methods().ByName("ClearHistory")
+	backrestPathAutocompleteMethodDescriptor         = backrestServiceDescriptor.Methods().ByName("PathAutocomplete")
+	backrestGetRepoHealthMethodDescriptor            = backrestServiceDescriptor.Methods().ByName("GetRepoHealth")
+	backrestCheckOplogIntegrityMethodDescriptor      = backrestServiceDescriptor.Methods().ByName("CheckOplogIntegrity")
+	backrestGetAuditLogMethodDescriptor              = backrestServiceDescriptor.Methods().ByName("GetAuditLog")
+	backrestExportAuditLogMethodDescriptor           = backrestServiceDescriptor.Methods().ByName("ExportAuditLog")
+	backrestListRepoMigrationsMethodDescriptor       = backrestServiceDescriptor.Methods().ByName("ListRepoMigrations")
+	backrestGetRepoCacheStatsMethodDescriptor        = backrestServiceDescriptor.Methods().ByName("GetRepoCacheStats")
+	backrestMigrateRepoMethodDescriptor              = backrestServiceDescriptor.Methods().ByName("MigrateRepo")
+	backrestWarmupRepoMethodDescriptor               = backrestServiceDescriptor.Methods().ByName("WarmupRepo")
+	backrestGetRecoveryBundleMethodDescriptor        = backrestServiceDescriptor.Methods().ByName("GetRecoveryBundle")
+	backrestGetNotificationTemplatesMethodDescriptor = backrestServiceDescriptor.Methods().ByName("GetNotificationTemplates")
+	backrestGetRemoteStatusMethodDescriptor          = backrestServiceDescriptor.Methods().ByName("GetRemoteStatus")
+	backrestGetRemoteOperationsMethodDescriptor      = backrestServiceDescriptor.Methods().ByName("GetRemoteOperations")
+	backrestGetRunsMethodDescriptor                  = backrestServiceDescriptor.Methods().ByName("GetRuns")
+	backrestGetDurationEstimatesMethodDescriptor     = backrestServiceDescriptor.Methods().ByName("GetDurationEstimates")
+	backrestGetResourceUsageMethodDescriptor         = backrestServiceDescriptor.Methods().ByName("GetResourceUsage")
+	backrestUpdateResticMethodDescriptor             = backrestServiceDescriptor.Methods().ByName("UpdateRestic")
+	backrestGetMetricSeriesMethodDescriptor          = backrestServiceDescriptor.Methods().ByName("GetMetricSeries")
+	backrestGetExclusionSuggestionsMethodDescriptor  = backrestServiceDescriptor.Methods().ByName("GetExclusionSuggestions")
+	backrestAnnotateSnapshotMethodDescriptor         = backrestServiceDescriptor.Methods().ByName("AnnotateSnapshot")
+	backrestPinSnapshotMethodDescriptor              = backrestServiceDescriptor.Methods().ByName("PinSnapshot")
+	backrestRescueSnapshotMethodDescriptor           = backrestServiceDescriptor.Methods().ByName("RescueSnapshot")
+	backrestEmergencyStopMethodDescriptor            = backrestServiceDescriptor.Methods().ByName("EmergencyStop")
+	backrestResumeSchedulesMethodDescriptor          = backrestServiceDescriptor.Methods().ByName("ResumeSchedules")
+	backrestInstantiatePlanTemplateMethodDescriptor  = backrestServiceDescriptor.Methods().ByName("InstantiatePlanTemplate")
+	backrestDeletePlanMethodDescriptor               = backrestServiceDescriptor.Methods().ByName("DeletePlan")
+	backrestDeleteRepoMethodDescriptor               = backrestServiceDescriptor.Methods().ByName("DeleteRepo")
+	backrestAcknowledgeOperationMethodDescriptor     = backrestServiceDescriptor.Methods().ByName("AcknowledgeOperation")
+	backrestBenchmarkRepoMethodDescriptor            = backrestServiceDescriptor.Methods().ByName("BenchmarkRepo")
 )
 
 // BackrestClient is a client for the v1.Backrest service.
@@ -104,14 +245,40 @@ type BackrestClient interface {
 	GetConfig(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.Config], error)
 	SetConfig(context.Context, *connect.Request[v1.Config]) (*connect.Response[v1.Config], error)
 	AddRepo(context.Context, *connect.Request[v1.Repo]) (*connect.Response[v1.Config], error)
+	// ImportRepo adds a pre-existing restic repository without initializing it and indexes its snapshots grouped by hostname and tags.
+	ImportRepo(context.Context, *connect.Request[v1.Repo]) (*connect.Response[v1.Config], error)
+	// ProbeRepo checks whether a restic repo exists at the repo's URI and whether the repo's password opens it.
+	ProbeRepo(context.Context, *connect.Request[v1.Repo]) (*connect.Response[v1.RepoProbe], error)
+	// GenerateRepoPassword returns a random password for a new repo.
+	GenerateRepoPassword(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[types.StringValue], error)
+	// InitRepo initializes a new restic repo, verifies that it opens and adds it to the config. A local repo it created
+	// is removed again if a step fails. Unlike AddRepo it fails if a repo already exists at the URI.
+	InitRepo(context.Context, *connect.Request[v1.InitRepoRequest]) (*connect.Response[v1.Config], error)
+	// GetConfigHistory returns the revisions of the config that have been written, oldest first.
+	GetConfigHistory(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.ConfigRevisionList], error)
+	// RollbackConfig restores the config to the given revision. The rollback is itself recorded as a new revision.
+	RollbackConfig(context.Context, *connect.Request[types.Int64Value]) (*connect.Response[v1.Config], error)
 	GetOperationEvents(context.Context, *connect.Request[emptypb.Empty]) (*connect.ServerStreamForClient[v1.OperationEvent], error)
+	// SubscribeOperations streams the oplog events matching the request's filters as they happen.
+	SubscribeOperations(context.Context, *connect.Request[v1.SubscribeOperationsRequest]) (*connect.ServerStreamForClient[v1.OperationEvent], error)
 	GetOperations(context.Context, *connect.Request[v1.GetOperationsRequest]) (*connect.Response[v1.OperationList], error)
+	// QueryOperations returns a page of the operations matching the request's filters.
+	QueryOperations(context.Context, *connect.Request[v1.QueryOperationsRequest]) (*connect.Response[v1.QueryOperationsResponse], error)
 	ListSnapshots(context.Context, *connect.Request[v1.ListSnapshotsRequest]) (*connect.Response[v1.ResticSnapshotList], error)
 	ListSnapshotFiles(context.Context, *connect.Request[v1.ListSnapshotFilesRequest]) (*connect.Response[v1.ListSnapshotFilesResponse], error)
+	// GetLargestFiles returns the largest files and directories of a snapshot, computed once per snapshot and cached.
+	GetLargestFiles(context.Context, *connect.Request[v1.GetLargestFilesRequest]) (*connect.Response[v1.GetLargestFilesResponse], error)
+	// GetFileHistory returns the versions of a path across the snapshots of a repo, oldest first.
+	GetFileHistory(context.Context, *connect.Request[v1.GetFileHistoryRequest]) (*connect.Response[v1.FileHistory], error)
+	// GetReclaimedSpace returns the space freed by the prunes and the snapshots removed by the forgets of a repo over
+	// time, the value is the repo ID.
+	GetReclaimedSpace(context.Context, *connect.Request[types.StringValue]) (*connect.Response[v1.ReclaimedSpace], error)
 	// IndexSnapshots triggers indexin. It accepts a repo id and returns empty if the task is enqueued.
 	IndexSnapshots(context.Context, *connect.Request[types.StringValue]) (*connect.Response[emptypb.Empty], error)
 	// Backup schedules a backup operation. It accepts a plan id and returns empty if the task is enqueued.
 	Backup(context.Context, *connect.Request[types.StringValue]) (*connect.Response[emptypb.Empty], error)
+	// AdhocBackup runs a one-off backup of paths that need not belong to any plan, returns once the backup completes.
+	AdhocBackup(context.Context, *connect.Request[v1.AdhocBackupRequest]) (*connect.Response[emptypb.Empty], error)
 	// Prune schedules a prune operation. It accepts a plan id and returns empty if the task is enqueued.
 	Prune(context.Context, *connect.Request[types.StringValue]) (*connect.Response[emptypb.Empty], error)
 	// Forget schedules a forget operation. It accepts a plan id and returns empty if the task is enqueued.
@@ -130,6 +297,74 @@ type BackrestClient interface {
 	ClearHistory(context.Context, *connect.Request[v1.ClearHistoryRequest]) (*connect.Response[emptypb.Empty], error)
 	// PathAutocomplete provides path autocompletion options for a given filesystem path.
 	PathAutocomplete(context.Context, *connect.Request[types.StringValue]) (*connect.Response[types.StringList], error)
+	// GetRepoHealth returns the health of a repo, expensive checks are only run if requested.
+	GetRepoHealth(context.Context, *connect.Request[v1.GetRepoHealthRequest]) (*connect.Response[v1.RepoHealth], error)
+	// CheckOplogIntegrity runs a consistency pass over the operation log, optionally repairing the problems found.
+	CheckOplogIntegrity(context.Context, *connect.Request[v1.CheckOplogIntegrityRequest]) (*connect.Response[v1.OplogIntegrityReport], error)
+	// GetAuditLog returns the audit log entries matching the request, oldest first.
+	GetAuditLog(context.Context, *connect.Request[v1.GetAuditLogRequest]) (*connect.Response[v1.AuditEntryList], error)
+	// ExportAuditLog returns the audit log entries matching the request as newline delimited JSON.
+	ExportAuditLog(context.Context, *connect.Request[v1.GetAuditLogRequest]) (*connect.Response[types.BytesValue], error)
+	// ListRepoMigrations returns the names of the restic migrations that can be applied to the repo.
+	ListRepoMigrations(context.Context, *connect.Request[types.StringValue]) (*connect.Response[types.StringList], error)
+	// GetRepoCacheStats returns the location and size of the repo's restic cache.
+	GetRepoCacheStats(context.Context, *connect.Request[types.StringValue]) (*connect.Response[v1.RepoCacheStats], error)
+	// MigrateRepo applies a restic migration (e.g. upgrade_repo_v2) to the repo, no other tasks run while it is applied.
+	MigrateRepo(context.Context, *connect.Request[v1.MigrateRepoRequest]) (*connect.Response[emptypb.Empty], error)
+	// WarmupRepo runs the warmup command of a repo on cold storage, operations reading pack data are allowed once it
+	// succeeds until the warmup expires.
+	WarmupRepo(context.Context, *connect.Request[types.StringValue]) (*connect.Response[emptypb.Empty], error)
+	// GetRecoveryBundle returns a text document with what is needed to restore from the repo using restic alone.
+	GetRecoveryBundle(context.Context, *connect.Request[v1.GetRecoveryBundleRequest]) (*connect.Response[types.BytesValue], error)
+	// GetNotificationTemplates returns every notification message of the locale, or of the configured locale if empty,
+	// with the configured overrides applied.
+	GetNotificationTemplates(context.Context, *connect.Request[types.StringValue]) (*connect.Response[v1.Notifications], error)
+	// GetRemoteStatus returns the plan status of this instance and of every configured remote instance.
+	GetRemoteStatus(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.RemoteStatusList], error)
+	// GetRemoteOperations returns operations of a remote instance, proxied read-only.
+	GetRemoteOperations(context.Context, *connect.Request[v1.GetRemoteOperationsRequest]) (*connect.Response[v1.OperationList], error)
+	// GetRuns returns the operations of a plan or repo grouped into runs, oldest first.
+	GetRuns(context.Context, *connect.Request[v1.GetRunsRequest]) (*connect.Response[v1.RunList], error)
+	// GetDurationEstimates returns the average durations of a plan's recent operations by operation type.
+	GetDurationEstimates(context.Context, *connect.Request[types.StringValue]) (*connect.Response[v1.DurationEstimateList], error)
+	// GetResourceUsage returns the total resources used by a plan's recorded operations by operation type.
+	GetResourceUsage(context.Context, *connect.Request[types.StringValue]) (*connect.Response[v1.ResourceUsageSummaryList], error)
+	// UpdateRestic checks for a newer restic release and installs it if apply is set. The release is verified against
+	// its published hashes and the restic signing key, the current binary is kept if the new one fails to run.
+	UpdateRestic(context.Context, *connect.Request[v1.UpdateResticRequest]) (*connect.Response[v1.ResticUpdateStatus], error)
+	// GetMetricSeries returns the downsampled history of a plan's metrics for charting.
+	GetMetricSeries(context.Context, *connect.Request[v1.GetMetricSeriesRequest]) (*connect.Response[v1.MetricSeriesList], error)
+	// GetExclusionSuggestions returns the paths that added the most data across the plan's recent backups, candidates for excludes.
+	GetExclusionSuggestions(context.Context, *connect.Request[types.StringValue]) (*connect.Response[v1.ExclusionSuggestionList], error)
+	// AnnotateSnapshot sets the note documenting a snapshot, an empty note removes it.
+	AnnotateSnapshot(context.Context, *connect.Request[v1.AnnotateSnapshotRequest]) (*connect.Response[emptypb.Empty], error)
+	// PinSnapshot pins or unpins a snapshot, pinned snapshots are kept by retention policies. restic rewrites the
+	// snapshot so the returned ID replaces the snapshot's previous ID.
+	PinSnapshot(context.Context, *connect.Request[v1.PinSnapshotRequest]) (*connect.Response[types.StringValue], error)
+	// RescueSnapshot unmarks a snapshot marked for delayed deletion and pins it so retention doesn't mark it again.
+	// restic rewrites the snapshot so the returned ID replaces the snapshot's previous ID.
+	RescueSnapshot(context.Context, *connect.Request[v1.RescueSnapshotRequest]) (*connect.Response[types.StringValue], error)
+	// EmergencyStop cancels the running task and all queued tasks and pauses all schedules, e.g. when a source machine
+	// is suspected compromised. The stop persists across restarts until ResumeSchedules is called. The value is the
+	// optional reason for the stop.
+	EmergencyStop(context.Context, *connect.Request[types.StringValue]) (*connect.Response[v1.Config], error)
+	// ResumeSchedules lifts an emergency stop and reschedules all plans and repo maintenance.
+	ResumeSchedules(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.Config], error)
+	// InstantiatePlanTemplate adds a plan created from the template for each of the instances, all plans are added or
+	// none if any is invalid.
+	InstantiatePlanTemplate(context.Context, *connect.Request[v1.InstantiatePlanTemplateRequest]) (*connect.Response[v1.Config], error)
+	// DeletePlan removes a plan from the config and tears down what it leaves behind, it fails if other plans still
+	// depend on the plan.
+	DeletePlan(context.Context, *connect.Request[v1.DeletePlanRequest]) (*connect.Response[v1.TeardownResult], error)
+	// DeleteRepo removes a repo from the config and tears down what it leaves behind, it fails while plans or the self
+	// backup still use the repo. The repo's data is never deleted.
+	DeleteRepo(context.Context, *connect.Request[v1.DeleteRepoRequest]) (*connect.Response[v1.TeardownResult], error)
+	// AcknowledgeOperation records that a user triaged a failed operation, or removes the acknowledgement. It returns
+	// the updated operation.
+	AcknowledgeOperation(context.Context, *connect.Request[v1.AcknowledgeOperationRequest]) (*connect.Response[v1.Operation], error)
+	// BenchmarkRepo schedules a measurement of the repo backend's latency and upload and download throughput, the
+	// results are recorded in a benchmark operation of the repo.
+	BenchmarkRepo(context.Context, *connect.Request[v1.BenchmarkRepoRequest]) (*connect.Response[emptypb.Empty], error)
 }
 
 // NewBackrestClient constructs a client for the v1.Backrest service. By default, it uses the
@@ -160,18 +395,66 @@ func NewBackrestClient(httpClient connect.HTTPClient, baseURL string, opts ...co
 			connect.WithSchema(backrestAddRepoMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		importRepo: connect.NewClient[v1.Repo, v1.Config](
+			httpClient,
+			baseURL+BackrestImportRepoProcedure,
+			connect.WithSchema(backrestImportRepoMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		probeRepo: connect.NewClient[v1.Repo, v1.RepoProbe](
+			httpClient,
+			baseURL+BackrestProbeRepoProcedure,
+			connect.WithSchema(backrestProbeRepoMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		generateRepoPassword: connect.NewClient[emptypb.Empty, types.StringValue](
+			httpClient,
+			baseURL+BackrestGenerateRepoPasswordProcedure,
+			connect.WithSchema(backrestGenerateRepoPasswordMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		initRepo: connect.NewClient[v1.InitRepoRequest, v1.Config](
+			httpClient,
+			baseURL+BackrestInitRepoProcedure,
+			connect.WithSchema(backrestInitRepoMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getConfigHistory: connect.NewClient[emptypb.Empty, v1.ConfigRevisionList](
+			httpClient,
+			baseURL+BackrestGetConfigHistoryProcedure,
+			connect.WithSchema(backrestGetConfigHistoryMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		rollbackConfig: connect.NewClient[types.Int64Value, v1.Config](
+			httpClient,
+			baseURL+BackrestRollbackConfigProcedure,
+			connect.WithSchema(backrestRollbackConfigMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		getOperationEvents: connect.NewClient[emptypb.Empty, v1.OperationEvent](
 			httpClient,
 			baseURL+BackrestGetOperationEventsProcedure,
 			connect.WithSchema(backrestGetOperationEventsMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		subscribeOperations: connect.NewClient[v1.SubscribeOperationsRequest, v1.OperationEvent](
+			httpClient,
+			baseURL+BackrestSubscribeOperationsProcedure,
+			connect.WithSchema(backrestSubscribeOperationsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		getOperations: connect.NewClient[v1.GetOperationsRequest, v1.OperationList](
 			httpClient,
 			baseURL+BackrestGetOperationsProcedure,
 			connect.WithSchema(backrestGetOperationsMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		queryOperations: connect.NewClient[v1.QueryOperationsRequest, v1.QueryOperationsResponse](
+			httpClient,
+			baseURL+BackrestQueryOperationsProcedure,
+			connect.WithSchema(backrestQueryOperationsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		listSnapshots: connect.NewClient[v1.ListSnapshotsRequest, v1.ResticSnapshotList](
 			httpClient,
 			baseURL+BackrestListSnapshotsProcedure,
@@ -184,6 +467,24 @@ func NewBackrestClient(httpClient connect.HTTPClient, baseURL string, opts ...co
 			connect.WithSchema(backrestListSnapshotFilesMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		getLargestFiles: connect.NewClient[v1.GetLargestFilesRequest, v1.GetLargestFilesResponse](
+			httpClient,
+			baseURL+BackrestGetLargestFilesProcedure,
+			connect.WithSchema(backrestGetLargestFilesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getFileHistory: connect.NewClient[v1.GetFileHistoryRequest, v1.FileHistory](
+			httpClient,
+			baseURL+BackrestGetFileHistoryProcedure,
+			connect.WithSchema(backrestGetFileHistoryMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getReclaimedSpace: connect.NewClient[types.StringValue, v1.ReclaimedSpace](
+			httpClient,
+			baseURL+BackrestGetReclaimedSpaceProcedure,
+			connect.WithSchema(backrestGetReclaimedSpaceMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		indexSnapshots: connect.NewClient[types.StringValue, emptypb.Empty](
 			httpClient,
 			baseURL+BackrestIndexSnapshotsProcedure,
@@ -196,6 +497,12 @@ func NewBackrestClient(httpClient connect.HTTPClient, baseURL string, opts ...co
 			connect.WithSchema(backrestBackupMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		adhocBackup: connect.NewClient[v1.AdhocBackupRequest, emptypb.Empty](
+			httpClient,
+			baseURL+BackrestAdhocBackupProcedure,
+			connect.WithSchema(backrestAdhocBackupMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		prune: connect.NewClient[types.StringValue, emptypb.Empty](
 			httpClient,
 			baseURL+BackrestPruneProcedure,
@@ -250,29 +557,237 @@ func NewBackrestClient(httpClient connect.HTTPClient, baseURL string, opts ...co
 			connect.WithSchema(backrestPathAutocompleteMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		getRepoHealth: connect.NewClient[v1.GetRepoHealthRequest, v1.RepoHealth](
+			httpClient,
+			baseURL+BackrestGetRepoHealthProcedure,
+			connect.WithSchema(backrestGetRepoHealthMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		checkOplogIntegrity: connect.NewClient[v1.CheckOplogIntegrityRequest, v1.OplogIntegrityReport](
+			httpClient,
+			baseURL+BackrestCheckOplogIntegrityProcedure,
+			connect.WithSchema(backrestCheckOplogIntegrityMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getAuditLog: connect.NewClient[v1.GetAuditLogRequest, v1.AuditEntryList](
+			httpClient,
+			baseURL+BackrestGetAuditLogProcedure,
+			connect.WithSchema(backrestGetAuditLogMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		exportAuditLog: connect.NewClient[v1.GetAuditLogRequest, types.BytesValue](
+			httpClient,
+			baseURL+BackrestExportAuditLogProcedure,
+			connect.WithSchema(backrestExportAuditLogMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		listRepoMigrations: connect.NewClient[types.StringValue, types.StringList](
+			httpClient,
+			baseURL+BackrestListRepoMigrationsProcedure,
+			connect.WithSchema(backrestListRepoMigrationsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getRepoCacheStats: connect.NewClient[types.StringValue, v1.RepoCacheStats](
+			httpClient,
+			baseURL+BackrestGetRepoCacheStatsProcedure,
+			connect.WithSchema(backrestGetRepoCacheStatsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		migrateRepo: connect.NewClient[v1.MigrateRepoRequest, emptypb.Empty](
+			httpClient,
+			baseURL+BackrestMigrateRepoProcedure,
+			connect.WithSchema(backrestMigrateRepoMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		warmupRepo: connect.NewClient[types.StringValue, emptypb.Empty](
+			httpClient,
+			baseURL+BackrestWarmupRepoProcedure,
+			connect.WithSchema(backrestWarmupRepoMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getRecoveryBundle: connect.NewClient[v1.GetRecoveryBundleRequest, types.BytesValue](
+			httpClient,
+			baseURL+BackrestGetRecoveryBundleProcedure,
+			connect.WithSchema(backrestGetRecoveryBundleMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getNotificationTemplates: connect.NewClient[types.StringValue, v1.Notifications](
+			httpClient,
+			baseURL+BackrestGetNotificationTemplatesProcedure,
+			connect.WithSchema(backrestGetNotificationTemplatesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getRemoteStatus: connect.NewClient[emptypb.Empty, v1.RemoteStatusList](
+			httpClient,
+			baseURL+BackrestGetRemoteStatusProcedure,
+			connect.WithSchema(backrestGetRemoteStatusMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getRemoteOperations: connect.NewClient[v1.GetRemoteOperationsRequest, v1.OperationList](
+			httpClient,
+			baseURL+BackrestGetRemoteOperationsProcedure,
+			connect.WithSchema(backrestGetRemoteOperationsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getRuns: connect.NewClient[v1.GetRunsRequest, v1.RunList](
+			httpClient,
+			baseURL+BackrestGetRunsProcedure,
+			connect.WithSchema(backrestGetRunsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getDurationEstimates: connect.NewClient[types.StringValue, v1.DurationEstimateList](
+			httpClient,
+			baseURL+BackrestGetDurationEstimatesProcedure,
+			connect.WithSchema(backrestGetDurationEstimatesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getResourceUsage: connect.NewClient[types.StringValue, v1.ResourceUsageSummaryList](
+			httpClient,
+			baseURL+BackrestGetResourceUsageProcedure,
+			connect.WithSchema(backrestGetResourceUsageMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		updateRestic: connect.NewClient[v1.UpdateResticRequest, v1.ResticUpdateStatus](
+			httpClient,
+			baseURL+BackrestUpdateResticProcedure,
+			connect.WithSchema(backrestUpdateResticMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getMetricSeries: connect.NewClient[v1.GetMetricSeriesRequest, v1.MetricSeriesList](
+			httpClient,
+			baseURL+BackrestGetMetricSeriesProcedure,
+			connect.WithSchema(backrestGetMetricSeriesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		getExclusionSuggestions: connect.NewClient[types.StringValue, v1.ExclusionSuggestionList](
+			httpClient,
+			baseURL+BackrestGetExclusionSuggestionsProcedure,
+			connect.WithSchema(backrestGetExclusionSuggestionsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		annotateSnapshot: connect.NewClient[v1.AnnotateSnapshotRequest, emptypb.Empty](
+			httpClient,
+			baseURL+BackrestAnnotateSnapshotProcedure,
+			connect.WithSchema(backrestAnnotateSnapshotMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		pinSnapshot: connect.NewClient[v1.PinSnapshotRequest, types.StringValue](
+			httpClient,
+			baseURL+BackrestPinSnapshotProcedure,
+			connect.WithSchema(backrestPinSnapshotMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		rescueSnapshot: connect.NewClient[v1.RescueSnapshotRequest, types.StringValue](
+			httpClient,
+			baseURL+BackrestRescueSnapshotProcedure,
+			connect.WithSchema(backrestRescueSnapshotMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		emergencyStop: connect.NewClient[types.StringValue, v1.Config](
+			httpClient,
+			baseURL+BackrestEmergencyStopProcedure,
+			connect.WithSchema(backrestEmergencyStopMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		resumeSchedules: connect.NewClient[emptypb.Empty, v1.Config](
+			httpClient,
+			baseURL+BackrestResumeSchedulesProcedure,
+			connect.WithSchema(backrestResumeSchedulesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		instantiatePlanTemplate: connect.NewClient[v1.InstantiatePlanTemplateRequest, v1.Config](
+			httpClient,
+			baseURL+BackrestInstantiatePlanTemplateProcedure,
+			connect.WithSchema(backrestInstantiatePlanTemplateMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		deletePlan: connect.NewClient[v1.DeletePlanRequest, v1.TeardownResult](
+			httpClient,
+			baseURL+BackrestDeletePlanProcedure,
+			connect.WithSchema(backrestDeletePlanMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		deleteRepo: connect.NewClient[v1.DeleteRepoRequest, v1.TeardownResult](
+			httpClient,
+			baseURL+BackrestDeleteRepoProcedure,
+			connect.WithSchema(backrestDeleteRepoMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		acknowledgeOperation: connect.NewClient[v1.AcknowledgeOperationRequest, v1.Operation](
+			httpClient,
+			baseURL+BackrestAcknowledgeOperationProcedure,
+			connect.WithSchema(backrestAcknowledgeOperationMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		benchmarkRepo: connect.NewClient[v1.BenchmarkRepoRequest, emptypb.Empty](
+			httpClient,
+			baseURL+BackrestBenchmarkRepoProcedure,
+			connect.WithSchema(backrestBenchmarkRepoMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // backrestClient implements BackrestClient.
 type backrestClient struct {
-	getConfig          *connect.Client[emptypb.Empty, v1.Config]
-	setConfig          *connect.Client[v1.Config, v1.Config]
-	addRepo            *connect.Client[v1.Repo, v1.Config]
-	getOperationEvents *connect.Client[emptypb.Empty, v1.OperationEvent]
-	getOperations      *connect.Client[v1.GetOperationsRequest, v1.OperationList]
-	listSnapshots      *connect.Client[v1.ListSnapshotsRequest, v1.ResticSnapshotList]
-	listSnapshotFiles  *connect.Client[v1.ListSnapshotFilesRequest, v1.ListSnapshotFilesResponse]
-	indexSnapshots     *connect.Client[types.StringValue, emptypb.Empty]
-	backup             *connect.Client[types.StringValue, emptypb.Empty]
-	prune              *connect.Client[types.StringValue, emptypb.Empty]
-	forget             *connect.Client[v1.ForgetRequest, emptypb.Empty]
-	restore            *connect.Client[v1.RestoreSnapshotRequest, emptypb.Empty]
-	unlock             *connect.Client[types.StringValue, emptypb.Empty]
-	stats              *connect.Client[types.StringValue, emptypb.Empty]
-	cancel             *connect.Client[types.Int64Value, emptypb.Empty]
-	getLogs            *connect.Client[v1.LogDataRequest, types.BytesValue]
-	clearHistory       *connect.Client[v1.ClearHistoryRequest, emptypb.Empty]
-	pathAutocomplete   *connect.Client[types.StringValue, types.StringList]
+	getConfig                *connect.Client[emptypb.Empty, v1.Config]
+	setConfig                *connect.Client[v1.Config, v1.Config]
+	addRepo                  *connect.Client[v1.Repo, v1.Config]
+	importRepo               *connect.Client[v1.Repo, v1.Config]
+	probeRepo                *connect.Client[v1.Repo, v1.RepoProbe]
+	generateRepoPassword     *connect.Client[emptypb.Empty, types.StringValue]
+	initRepo                 *connect.Client[v1.InitRepoRequest, v1.Config]
+	getConfigHistory         *connect.Client[emptypb.Empty, v1.ConfigRevisionList]
+	rollbackConfig           *connect.Client[types.Int64Value, v1.Config]
+	getOperationEvents       *connect.Client[emptypb.Empty, v1.OperationEvent]
+	subscribeOperations      *connect.Client[v1.SubscribeOperationsRequest, v1.OperationEvent]
+	getOperations            *connect.Client[v1.GetOperationsRequest, v1.OperationList]
+	queryOperations          *connect.Client[v1.QueryOperationsRequest, v1.QueryOperationsResponse]
+	listSnapshots            *connect.Client[v1.ListSnapshotsRequest, v1.ResticSnapshotList]
+	listSnapshotFiles        *connect.Client[v1.ListSnapshotFilesRequest, v1.ListSnapshotFilesResponse]
+	getLargestFiles          *connect.Client[v1.GetLargestFilesRequest, v1.GetLargestFilesResponse]
+	getFileHistory           *connect.Client[v1.GetFileHistoryRequest, v1.FileHistory]
+	getReclaimedSpace        *connect.Client[types.StringValue, v1.ReclaimedSpace]
+	indexSnapshots           *connect.Client[types.StringValue, emptypb.Empty]
+	backup                   *connect.Client[types.StringValue, emptypb.Empty]
+	adhocBackup              *connect.Client[v1.AdhocBackupRequest, emptypb.Empty]
+	prune                    *connect.Client[types.StringValue, emptypb.Empty]
+	forget                   *connect.Client[v1.ForgetRequest, emptypb.Empty]
+	restore                  *connect.Client[v1.RestoreSnapshotRequest, emptypb.Empty]
+	unlock                   *connect.Client[types.StringValue, emptypb.Empty]
+	stats                    *connect.Client[types.StringValue, emptypb.Empty]
+	cancel                   *connect.Client[types.Int64Value, emptypb.Empty]
+	getLogs                  *connect.Client[v1.LogDataRequest, types.BytesValue]
+	clearHistory             *connect.Client[v1.ClearHistoryRequest, emptypb.Empty]
+	pathAutocomplete         *connect.Client[types.StringValue, types.StringList]
+	getRepoHealth            *connect.Client[v1.GetRepoHealthRequest, v1.RepoHealth]
+	checkOplogIntegrity      *connect.Client[v1.CheckOplogIntegrityRequest, v1.OplogIntegrityReport]
+	getAuditLog              *connect.Client[v1.GetAuditLogRequest, v1.AuditEntryList]
+	exportAuditLog           *connect.Client[v1.GetAuditLogRequest, types.BytesValue]
+	listRepoMigrations       *connect.Client[types.StringValue, types.StringList]
+	getRepoCacheStats        *connect.Client[types.StringValue, v1.RepoCacheStats]
+	migrateRepo              *connect.Client[v1.MigrateRepoRequest, emptypb.Empty]
+	warmupRepo               *connect.Client[types.StringValue, emptypb.Empty]
+	getRecoveryBundle        *connect.Client[v1.GetRecoveryBundleRequest, types.BytesValue]
+	getNotificationTemplates *connect.Client[types.StringValue, v1.Notifications]
+	getRemoteStatus          *connect.Client[emptypb.Empty, v1.RemoteStatusList]
+	getRemoteOperations      *connect.Client[v1.GetRemoteOperationsRequest, v1.OperationList]
+	getRuns                  *connect.Client[v1.GetRunsRequest, v1.RunList]
+	getDurationEstimates     *connect.Client[types.StringValue, v1.DurationEstimateList]
+	getResourceUsage         *connect.Client[types.StringValue, v1.ResourceUsageSummaryList]
+	updateRestic             *connect.Client[v1.UpdateResticRequest, v1.ResticUpdateStatus]
+	getMetricSeries          *connect.Client[v1.GetMetricSeriesRequest, v1.MetricSeriesList]
+	getExclusionSuggestions  *connect.Client[types.StringValue, v1.ExclusionSuggestionList]
+	annotateSnapshot         *connect.Client[v1.AnnotateSnapshotRequest, emptypb.Empty]
+	pinSnapshot              *connect.Client[v1.PinSnapshotRequest, types.StringValue]
+	rescueSnapshot           *connect.Client[v1.RescueSnapshotRequest, types.StringValue]
+	emergencyStop            *connect.Client[types.StringValue, v1.Config]
+	resumeSchedules          *connect.Client[emptypb.Empty, v1.Config]
+	instantiatePlanTemplate  *connect.Client[v1.InstantiatePlanTemplateRequest, v1.Config]
+	deletePlan               *connect.Client[v1.DeletePlanRequest, v1.TeardownResult]
+	deleteRepo               *connect.Client[v1.DeleteRepoRequest, v1.TeardownResult]
+	acknowledgeOperation     *connect.Client[v1.AcknowledgeOperationRequest, v1.Operation]
+	benchmarkRepo            *connect.Client[v1.BenchmarkRepoRequest, emptypb.Empty]
 }
 
 // GetConfig calls v1.Backrest.GetConfig.
@@ -290,16 +805,56 @@ func (c *backrestClient) AddRepo(ctx context.Context, req *connect.Request[v1.Re
 	return c.addRepo.CallUnary(ctx, req)
 }
 
+// ImportRepo calls v1.Backrest.ImportRepo.
+func (c *backrestClient) ImportRepo(ctx context.Context, req *connect.Request[v1.Repo]) (*connect.Response[v1.Config], error) {
+	return c.importRepo.CallUnary(ctx, req)
+}
+
+// ProbeRepo calls v1.Backrest.ProbeRepo.
+func (c *backrestClient) ProbeRepo(ctx context.Context, req *connect.Request[v1.Repo]) (*connect.Response[v1.RepoProbe], error) {
+	return c.probeRepo.CallUnary(ctx, req)
+}
+
+// GenerateRepoPassword calls v1.Backrest.GenerateRepoPassword.
+func (c *backrestClient) GenerateRepoPassword(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[types.StringValue], error) {
+	return c.generateRepoPassword.CallUnary(ctx, req)
+}
+
+// InitRepo calls v1.Backrest.InitRepo.
+func (c *backrestClient) InitRepo(ctx context.Context, req *connect.Request[v1.InitRepoRequest]) (*connect.Response[v1.Config], error) {
+	return c.initRepo.CallUnary(ctx, req)
+}
+
+// GetConfigHistory calls v1.Backrest.GetConfigHistory.
+func (c *backrestClient) GetConfigHistory(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[v1.ConfigRevisionList], error) {
+	return c.getConfigHistory.CallUnary(ctx, req)
+}
+
+// RollbackConfig calls v1.Backrest.RollbackConfig.
+func (c *backrestClient) RollbackConfig(ctx context.Context, req *connect.Request[types.Int64Value]) (*connect.Response[v1.Config], error) {
+	return c.rollbackConfig.CallUnary(ctx, req)
+}
+
 // GetOperationEvents calls v1.Backrest.GetOperationEvents.
 func (c *backrestClient) GetOperationEvents(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.ServerStreamForClient[v1.OperationEvent], error) {
 	return c.getOperationEvents.CallServerStream(ctx, req)
 }
 
+// SubscribeOperations calls v1.Backrest.SubscribeOperations.
+func (c *backrestClient) SubscribeOperations(ctx context.Context, req *connect.Request[v1.SubscribeOperationsRequest]) (*connect.ServerStreamForClient[v1.OperationEvent], error) {
+	return c.subscribeOperations.CallServerStream(ctx, req)
+}
+
 // GetOperations calls v1.Backrest.GetOperations.
 func (c *backrestClient) GetOperations(ctx context.Context, req *connect.Request[v1.GetOperationsRequest]) (*connect.Response[v1.OperationList], error) {
 	return c.getOperations.CallUnary(ctx, req)
 }
 
+// QueryOperations calls v1.Backrest.QueryOperations.
+func (c *backrestClient) QueryOperations(ctx context.Context, req *connect.Request[v1.QueryOperationsRequest]) (*connect.Response[v1.QueryOperationsResponse], error) {
+	return c.queryOperations.CallUnary(ctx, req)
+}
+
 // ListSnapshots calls v1.Backrest.ListSnapshots.
 func (c *backrestClient) ListSnapshots(ctx context.Context, req *connect.Request[v1.ListSnapshotsRequest]) (*connect.Response[v1.ResticSnapshotList], error) {
 	return c.listSnapshots.CallUnary(ctx, req)
@@ -310,6 +865,21 @@ func (c *backrestClient) ListSnapshotFiles(ctx context.Context, req *connect.Req
 	return c.listSnapshotFiles.CallUnary(ctx, req)
 }
 
+// GetLargestFiles calls v1.Backrest.GetLargestFiles.
+func (c *backrestClient) GetLargestFiles(ctx context.Context, req *connect.Request[v1.GetLargestFilesRequest]) (*connect.Response[v1.GetLargestFilesResponse], error) {
+	return c.getLargestFiles.CallUnary(ctx, req)
+}
+
+// GetFileHistory calls v1.Backrest.GetFileHistory.
+func (c *backrestClient) GetFileHistory(ctx context.Context, req *connect.Request[v1.GetFileHistoryRequest]) (*connect.Response[v1.FileHistory], error) {
+	return c.getFileHistory.CallUnary(ctx, req)
+}
+
+// GetReclaimedSpace calls v1.Backrest.GetReclaimedSpace.
+func (c *backrestClient) GetReclaimedSpace(ctx context.Context, req *connect.Request[types.StringValue]) (*connect.Response[v1.ReclaimedSpace], error) {
+	return c.getReclaimedSpace.CallUnary(ctx, req)
+}
+
 // IndexSnapshots calls v1.Backrest.IndexSnapshots.
 func (c *backrestClient) IndexSnapshots(ctx context.Context, req *connect.Request[types.StringValue]) (*connect.Response[emptypb.Empty], error) {
 	return c.indexSnapshots.CallUnary(ctx, req)
@@ -320,6 +890,11 @@ func (c *backrestClient) Backup(ctx context.Context, req *connect.Request[types.
 	return c.backup.CallUnary(ctx, req)
 }
 
+// AdhocBackup calls v1.Backrest.AdhocBackup.
+func (c *backrestClient) AdhocBackup(ctx context.Context, req *connect.Request[v1.AdhocBackupRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.adhocBackup.CallUnary(ctx, req)
+}
+
 // Prune calls v1.Backrest.Prune.
 func (c *backrestClient) Prune(ctx context.Context, req *connect.Request[types.StringValue]) (*connect.Response[emptypb.Empty], error) {
 	return c.prune.CallUnary(ctx, req)
@@ -365,19 +940,185 @@ func (c *backrestClient) PathAutocomplete(ctx context.Context, req *connect.Requ
 	return c.pathAutocomplete.CallUnary(ctx, req)
 }
 
+// GetRepoHealth calls v1.Backrest.GetRepoHealth.
+func (c *backrestClient) GetRepoHealth(ctx context.Context, req *connect.Request[v1.GetRepoHealthRequest]) (*connect.Response[v1.RepoHealth], error) {
+	return c.getRepoHealth.CallUnary(ctx, req)
+}
+
+// CheckOplogIntegrity calls v1.Backrest.CheckOplogIntegrity.
+func (c *backrestClient) CheckOplogIntegrity(ctx context.Context, req *connect.Request[v1.CheckOplogIntegrityRequest]) (*connect.Response[v1.OplogIntegrityReport], error) {
+	return c.checkOplogIntegrity.CallUnary(ctx, req)
+}
+
+// GetAuditLog calls v1.Backrest.GetAuditLog.
+func (c *backrestClient) GetAuditLog(ctx context.Context, req *connect.Request[v1.GetAuditLogRequest]) (*connect.Response[v1.AuditEntryList], error) {
+	return c.getAuditLog.CallUnary(ctx, req)
+}
+
+// ExportAuditLog calls v1.Backrest.ExportAuditLog.
+func (c *backrestClient) ExportAuditLog(ctx context.Context, req *connect.Request[v1.GetAuditLogRequest]) (*connect.Response[types.BytesValue], error) {
+	return c.exportAuditLog.CallUnary(ctx, req)
+}
+
+// ListRepoMigrations calls v1.Backrest.ListRepoMigrations.
+func (c *backrestClient) ListRepoMigrations(ctx context.Context, req *connect.Request[types.StringValue]) (*connect.Response[types.StringList], error) {
+	return c.listRepoMigrations.CallUnary(ctx, req)
+}
+
+// GetRepoCacheStats calls v1.Backrest.GetRepoCacheStats.
+func (c *backrestClient) GetRepoCacheStats(ctx context.Context, req *connect.Request[types.StringValue]) (*connect.Response[v1.RepoCacheStats], error) {
+	return c.getRepoCacheStats.CallUnary(ctx, req)
+}
+
+// MigrateRepo calls v1.Backrest.MigrateRepo.
+func (c *backrestClient) MigrateRepo(ctx context.Context, req *connect.Request[v1.MigrateRepoRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.migrateRepo.CallUnary(ctx, req)
+}
+
+// WarmupRepo calls v1.Backrest.WarmupRepo.
+func (c *backrestClient) WarmupRepo(ctx context.Context, req *connect.Request[types.StringValue]) (*connect.Response[emptypb.Empty], error) {
+	return c.warmupRepo.CallUnary(ctx, req)
+}
+
+// GetRecoveryBundle calls v1.Backrest.GetRecoveryBundle.
+func (c *backrestClient) GetRecoveryBundle(ctx context.Context, req *connect.Request[v1.GetRecoveryBundleRequest]) (*connect.Response[types.BytesValue], error) {
+	return c.getRecoveryBundle.CallUnary(ctx, req)
+}
+
+// GetNotificationTemplates calls v1.Backrest.GetNotificationTemplates.
+func (c *backrestClient) GetNotificationTemplates(ctx context.Context, req *connect.Request[types.StringValue]) (*connect.Response[v1.Notifications], error) {
+	return c.getNotificationTemplates.CallUnary(ctx, req)
+}
+
+// GetRemoteStatus calls v1.Backrest.GetRemoteStatus.
+func (c *backrestClient) GetRemoteStatus(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[v1.RemoteStatusList], error) {
+	return c.getRemoteStatus.CallUnary(ctx, req)
+}
+
+// GetRemoteOperations calls v1.Backrest.GetRemoteOperations.
+func (c *backrestClient) GetRemoteOperations(ctx context.Context, req *connect.Request[v1.GetRemoteOperationsRequest]) (*connect.Response[v1.OperationList], error) {
+	return c.getRemoteOperations.CallUnary(ctx, req)
+}
+
+// GetRuns calls v1.Backrest.GetRuns.
+func (c *backrestClient) GetRuns(ctx context.Context, req *connect.Request[v1.GetRunsRequest]) (*connect.Response[v1.RunList], error) {
+	return c.getRuns.CallUnary(ctx, req)
+}
+
+// GetDurationEstimates calls v1.Backrest.GetDurationEstimates.
+func (c *backrestClient) GetDurationEstimates(ctx context.Context, req *connect.Request[types.StringValue]) (*connect.Response[v1.DurationEstimateList], error) {
+	return c.getDurationEstimates.CallUnary(ctx, req)
+}
+
+// GetResourceUsage calls v1.Backrest.GetResourceUsage.
+func (c *backrestClient) GetResourceUsage(ctx context.Context, req *connect.Request[types.StringValue]) (*connect.Response[v1.ResourceUsageSummaryList], error) {
+	return c.getResourceUsage.CallUnary(ctx, req)
+}
+
+// UpdateRestic calls v1.Backrest.UpdateRestic.
+func (c *backrestClient) UpdateRestic(ctx context.Context, req *connect.Request[v1.UpdateResticRequest]) (*connect.Response[v1.ResticUpdateStatus], error) {
+	return c.updateRestic.CallUnary(ctx, req)
+}
+
+// GetMetricSeries calls v1.Backrest.GetMetricSeries.
+func (c *backrestClient) GetMetricSeries(ctx context.Context, req *connect.Request[v1.GetMetricSeriesRequest]) (*connect.Response[v1.MetricSeriesList], error) {
+	return c.getMetricSeries.CallUnary(ctx, req)
+}
+
+// GetExclusionSuggestions calls v1.Backrest.GetExclusionSuggestions.
+func (c *backrestClient) GetExclusionSuggestions(ctx context.Context, req *connect.Request[types.StringValue]) (*connect.Response[v1.ExclusionSuggestionList], error) {
+	return c.getExclusionSuggestions.CallUnary(ctx, req)
+}
+
+// AnnotateSnapshot calls v1.Backrest.AnnotateSnapshot.
+func (c *backrestClient) AnnotateSnapshot(ctx context.Context, req *connect.Request[v1.AnnotateSnapshotRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.annotateSnapshot.CallUnary(ctx, req)
+}
+
+// PinSnapshot calls v1.Backrest.PinSnapshot.
+func (c *backrestClient) PinSnapshot(ctx context.Context, req *connect.Request[v1.PinSnapshotRequest]) (*connect.Response[types.StringValue], error) {
+	return c.pinSnapshot.CallUnary(ctx, req)
+}
+
+// RescueSnapshot calls v1.Backrest.RescueSnapshot.
+func (c *backrestClient) RescueSnapshot(ctx context.Context, req *connect.Request[v1.RescueSnapshotRequest]) (*connect.Response[types.StringValue], error) {
+	return c.rescueSnapshot.CallUnary(ctx, req)
+}
+
+// EmergencyStop calls v1.Backrest.EmergencyStop.
+func (c *backrestClient) EmergencyStop(ctx context.Context, req *connect.Request[types.StringValue]) (*connect.Response[v1.Config], error) {
+	return c.emergencyStop.CallUnary(ctx, req)
+}
+
+// ResumeSchedules calls v1.Backrest.ResumeSchedules.
+func (c *backrestClient) ResumeSchedules(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[v1.Config], error) {
+	return c.resumeSchedules.CallUnary(ctx, req)
+}
+
+// InstantiatePlanTemplate calls v1.Backrest.InstantiatePlanTemplate.
+func (c *backrestClient) InstantiatePlanTemplate(ctx context.Context, req *connect.Request[v1.InstantiatePlanTemplateRequest]) (*connect.Response[v1.Config], error) {
+	return c.instantiatePlanTemplate.CallUnary(ctx, req)
+}
+
+// DeletePlan calls v1.Backrest.DeletePlan.
+func (c *backrestClient) DeletePlan(ctx context.Context, req *connect.Request[v1.DeletePlanRequest]) (*connect.Response[v1.TeardownResult], error) {
+	return c.deletePlan.CallUnary(ctx, req)
+}
+
+// DeleteRepo calls v1.Backrest.DeleteRepo.
+func (c *backrestClient) DeleteRepo(ctx context.Context, req *connect.Request[v1.DeleteRepoRequest]) (*connect.Response[v1.TeardownResult], error) {
+	return c.deleteRepo.CallUnary(ctx, req)
+}
+
+// AcknowledgeOperation calls v1.Backrest.AcknowledgeOperation.
+func (c *backrestClient) AcknowledgeOperation(ctx context.Context, req *connect.Request[v1.AcknowledgeOperationRequest]) (*connect.Response[v1.Operation], error) {
+	return c.acknowledgeOperation.CallUnary(ctx, req)
+}
+
+// BenchmarkRepo calls v1.Backrest.BenchmarkRepo.
+func (c *backrestClient) BenchmarkRepo(ctx context.Context, req *connect.Request[v1.BenchmarkRepoRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.benchmarkRepo.CallUnary(ctx, req)
+}
+
 // BackrestHandler is an implementation of the v1.Backrest service.
 type BackrestHandler interface {
 	GetConfig(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.Config], error)
 	SetConfig(context.Context, *connect.Request[v1.Config]) (*connect.Response[v1.Config], error)
 	AddRepo(context.Context, *connect.Request[v1.Repo]) (*connect.Response[v1.Config], error)
+	// ImportRepo adds a pre-existing restic repository without initializing it and indexes its snapshots grouped by hostname and tags.
+	ImportRepo(context.Context, *connect.Request[v1.Repo]) (*connect.Response[v1.Config], error)
+	// ProbeRepo checks whether a restic repo exists at the repo's URI and whether the repo's password opens it.
+	ProbeRepo(context.Context, *connect.Request[v1.Repo]) (*connect.Response[v1.RepoProbe], error)
+	// GenerateRepoPassword returns a random password for a new repo.
+	GenerateRepoPassword(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[types.StringValue], error)
+	// InitRepo initializes a new restic repo, verifies that it opens and adds it to the config. A local repo it created
+	// is removed again if a step fails. Unlike AddRepo it fails if a repo already exists at the URI.
+	InitRepo(context.Context, *connect.Request[v1.InitRepoRequest]) (*connect.Response[v1.Config], error)
+	// GetConfigHistory returns the revisions of the config that have been written, oldest first.
+	GetConfigHistory(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.ConfigRevisionList], error)
+	// RollbackConfig restores the config to the given revision. The rollback is itself recorded as a new revision.
+	RollbackConfig(context.Context, *connect.Request[types.Int64Value]) (*connect.Response[v1.Config], error)
 	GetOperationEvents(context.Context, *connect.Request[emptypb.Empty], *connect.ServerStream[v1.OperationEvent]) error
+	// SubscribeOperations streams the oplog events matching the request's filters as they happen.
+	SubscribeOperations(context.Context, *connect.Request[v1.SubscribeOperationsRequest], *connect.ServerStream[v1.OperationEvent]) error
 	GetOperations(context.Context, *connect.Request[v1.GetOperationsRequest]) (*connect.Response[v1.OperationList], error)
+	// QueryOperations returns a page of the operations matching the request's filters.
+	QueryOperations(context.Context, *connect.Request[v1.QueryOperationsRequest]) (*connect.Response[v1.QueryOperationsResponse], error)
 	ListSnapshots(context.Context, *connect.Request[v1.ListSnapshotsRequest]) (*connect.Response[v1.ResticSnapshotList], error)
 	ListSnapshotFiles(context.Context, *connect.Request[v1.ListSnapshotFilesRequest]) (*connect.Response[v1.ListSnapshotFilesResponse], error)
+	// GetLargestFiles returns the largest files and directories of a snapshot, computed once per snapshot and cached.
+	GetLargestFiles(context.Context, *connect.Request[v1.GetLargestFilesRequest]) (*connect.Response[v1.GetLargestFilesResponse], error)
+	// GetFileHistory returns the versions of a path across the snapshots of a repo, oldest first.
+	GetFileHistory(context.Context, *connect.Request[v1.GetFileHistoryRequest]) (*connect.Response[v1.FileHistory], error)
+	// GetReclaimedSpace returns the space freed by the prunes and the snapshots removed by the forgets of a repo over
+	// time, the value is the repo ID.
+	GetReclaimedSpace(context.Context, *connect.Request[types.StringValue]) (*connect.Response[v1.ReclaimedSpace], error)
 	// IndexSnapshots triggers indexin. It accepts a repo id and returns empty if the task is enqueued.
 	IndexSnapshots(context.Context, *connect.Request[types.StringValue]) (*connect.Response[emptypb.Empty], error)
 	// Backup schedules a backup operation. It accepts a plan id and returns empty if the task is enqueued.
 	Backup(context.Context, *connect.Request[types.StringValue]) (*connect.Response[emptypb.Empty], error)
+	// AdhocBackup runs a one-off backup of paths that need not belong to any plan, returns once the backup completes.
+	AdhocBackup(context.Context, *connect.Request[v1.AdhocBackupRequest]) (*connect.Response[emptypb.Empty], error)
 	// Prune schedules a prune operation. It accepts a plan id and returns empty if the task is enqueued.
 	Prune(context.Context, *connect.Request[types.StringValue]) (*connect.Response[emptypb.Empty], error)
 	// Forget schedules a forget operation. It accepts a plan id and returns empty if the task is enqueued.
@@ -396,6 +1137,74 @@ type BackrestHandler interface {
 	ClearHistory(context.Context, *connect.Request[v1.ClearHistoryRequest]) (*connect.Response[emptypb.Empty], error)
 	// PathAutocomplete provides path autocompletion options for a given filesystem path.
 	PathAutocomplete(context.Context, *connect.Request[types.StringValue]) (*connect.Response[types.StringList], error)
+	// GetRepoHealth returns the health of a repo, expensive checks are only run if requested.
+	GetRepoHealth(context.Context, *connect.Request[v1.GetRepoHealthRequest]) (*connect.Response[v1.RepoHealth], error)
+	// CheckOplogIntegrity runs a consistency pass over the operation log, optionally repairing the problems found.
+	CheckOplogIntegrity(context.Context, *connect.Request[v1.CheckOplogIntegrityRequest]) (*connect.Response[v1.OplogIntegrityReport], error)
+	// GetAuditLog returns the audit log entries matching the request, oldest first.
+	GetAuditLog(context.Context, *connect.Request[v1.GetAuditLogRequest]) (*connect.Response[v1.AuditEntryList], error)
+	// ExportAuditLog returns the audit log entries matching the request as newline delimited JSON.
+	ExportAuditLog(context.Context, *connect.Request[v1.GetAuditLogRequest]) (*connect.Response[types.BytesValue], error)
+	// ListRepoMigrations returns the names of the restic migrations that can be applied to the repo.
+	ListRepoMigrations(context.Context, *connect.Request[types.StringValue]) (*connect.Response[types.StringList], error)
+	// GetRepoCacheStats returns the location and size of the repo's restic cache.
+	GetRepoCacheStats(context.Context, *connect.Request[types.StringValue]) (*connect.Response[v1.RepoCacheStats], error)
+	// MigrateRepo applies a restic migration (e.g. upgrade_repo_v2) to the repo, no other tasks run while it is applied.
+	MigrateRepo(context.Context, *connect.Request[v1.MigrateRepoRequest]) (*connect.Response[emptypb.Empty], error)
+	// WarmupRepo runs the warmup command of a repo on cold storage, operations reading pack data are allowed once it
+	// succeeds until the warmup expires.
+	WarmupRepo(context.Context, *connect.Request[types.StringValue]) (*connect.Response[emptypb.Empty], error)
+	// GetRecoveryBundle returns a text document with what is needed to restore from the repo using restic alone.
+	GetRecoveryBundle(context.Context, *connect.Request[v1.GetRecoveryBundleRequest]) (*connect.Response[types.BytesValue], error)
+	// GetNotificationTemplates returns every notification message of the locale, or of the configured locale if empty,
+	// with the configured overrides applied.
+	GetNotificationTemplates(context.Context, *connect.Request[types.StringValue]) (*connect.Response[v1.Notifications], error)
+	// GetRemoteStatus returns the plan status of this instance and of every configured remote instance.
+	GetRemoteStatus(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.RemoteStatusList], error)
+	// GetRemoteOperations returns operations of a remote instance, proxied read-only.
+	GetRemoteOperations(context.Context, *connect.Request[v1.GetRemoteOperationsRequest]) (*connect.Response[v1.OperationList], error)
+	// GetRuns returns the operations of a plan or repo grouped into runs, oldest first.
+	GetRuns(context.Context, *connect.Request[v1.GetRunsRequest]) (*connect.Response[v1.RunList], error)
+	// GetDurationEstimates returns the average durations of a plan's recent operations by operation type.
+	GetDurationEstimates(context.Context, *connect.Request[types.StringValue]) (*connect.Response[v1.DurationEstimateList], error)
+	// GetResourceUsage returns the total resources used by a plan's recorded operations by operation type.
+	GetResourceUsage(context.Context, *connect.Request[types.StringValue]) (*connect.Response[v1.ResourceUsageSummaryList], error)
+	// UpdateRestic checks for a newer restic release and installs it if apply is set. The release is verified against
+	// its published hashes and the restic signing key, the current binary is kept if the new one fails to run.
+	UpdateRestic(context.Context, *connect.Request[v1.UpdateResticRequest]) (*connect.Response[v1.ResticUpdateStatus], error)
+	// GetMetricSeries returns the downsampled history of a plan's metrics for charting.
+	GetMetricSeries(context.Context, *connect.Request[v1.GetMetricSeriesRequest]) (*connect.Response[v1.MetricSeriesList], error)
+	// GetExclusionSuggestions returns the paths that added the most data across the plan's recent backups, candidates for excludes.
+	GetExclusionSuggestions(context.Context, *connect.Request[types.StringValue]) (*connect.Response[v1.ExclusionSuggestionList], error)
+	// AnnotateSnapshot sets the note documenting a snapshot, an empty note removes it.
+	AnnotateSnapshot(context.Context, *connect.Request[v1.AnnotateSnapshotRequest]) (*connect.Response[emptypb.Empty], error)
+	// PinSnapshot pins or unpins a snapshot, pinned snapshots are kept by retention policies. restic rewrites the
+	// snapshot so the returned ID replaces the snapshot's previous ID.
+	PinSnapshot(context.Context, *connect.Request[v1.PinSnapshotRequest]) (*connect.Response[types.StringValue], error)
+	// RescueSnapshot unmarks a snapshot marked for delayed deletion and pins it so retention doesn't mark it again.
+	// restic rewrites the snapshot so the returned ID replaces the snapshot's previous ID.
+	RescueSnapshot(context.Context, *connect.Request[v1.RescueSnapshotRequest]) (*connect.Response[types.StringValue], error)
+	// EmergencyStop cancels the running task and all queued tasks and pauses all schedules, e.g. when a source machine
+	// is suspected compromised. The stop persists across restarts until ResumeSchedules is called. The value is the
+	// optional reason for the stop.
+	EmergencyStop(context.Context, *connect.Request[types.StringValue]) (*connect.Response[v1.Config], error)
+	// ResumeSchedules lifts an emergency stop and reschedules all plans and repo maintenance.
+	ResumeSchedules(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.Config], error)
+	// InstantiatePlanTemplate adds a plan created from the template for each of the instances, all plans are added or
+	// none if any is invalid.
+	InstantiatePlanTemplate(context.Context, *connect.Request[v1.InstantiatePlanTemplateRequest]) (*connect.Response[v1.Config], error)
+	// DeletePlan removes a plan from the config and tears down what it leaves behind, it fails if other plans still
+	// depend on the plan.
+	DeletePlan(context.Context, *connect.Request[v1.DeletePlanRequest]) (*connect.Response[v1.TeardownResult], error)
+	// DeleteRepo removes a repo from the config and tears down what it leaves behind, it fails while plans or the self
+	// backup still use the repo. The repo's data is never deleted.
+	DeleteRepo(context.Context, *connect.Request[v1.DeleteRepoRequest]) (*connect.Response[v1.TeardownResult], error)
+	// AcknowledgeOperation records that a user triaged a failed operation, or removes the acknowledgement. It returns
+	// the updated operation.
+	AcknowledgeOperation(context.Context, *connect.Request[v1.AcknowledgeOperationRequest]) (*connect.Response[v1.Operation], error)
+	// BenchmarkRepo schedules a measurement of the repo backend's latency and upload and download throughput, the
+	// results are recorded in a benchmark operation of the repo.
+	BenchmarkRepo(context.Context, *connect.Request[v1.BenchmarkRepoRequest]) (*connect.Response[emptypb.Empty], error)
 }
 
 // NewBackrestHandler builds an HTTP handler from the service implementation. It returns the path on
@@ -422,18 +1231,66 @@ func NewBackrestHandler(svc BackrestHandler, opts ...connect.HandlerOption) (str
 		connect.WithSchema(backrestAddRepoMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	backrestImportRepoHandler := connect.NewUnaryHandler(
+		BackrestImportRepoProcedure,
+		svc.ImportRepo,
+		connect.WithSchema(backrestImportRepoMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	backrestProbeRepoHandler := connect.NewUnaryHandler(
+		BackrestProbeRepoProcedure,
+		svc.ProbeRepo,
+		connect.WithSchema(backrestProbeRepoMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	backrestGenerateRepoPasswordHandler := connect.NewUnaryHandler(
+		BackrestGenerateRepoPasswordProcedure,
+		svc.GenerateRepoPassword,
+		connect.WithSchema(backrestGenerateRepoPasswordMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	backrestInitRepoHandler := connect.NewUnaryHandler(
+		BackrestInitRepoProcedure,
+		svc.InitRepo,
+		connect.WithSchema(backrestInitRepoMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	backrestGetConfigHistoryHandler := connect.NewUnaryHandler(
+		BackrestGetConfigHistoryProcedure,
+		svc.GetConfigHistory,
+		connect.WithSchema(backrestGetConfigHistoryMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	backrestRollbackConfigHandler := connect.NewUnaryHandler(
+		BackrestRollbackConfigProcedure,
+		svc.RollbackConfig,
+		connect.WithSchema(backrestRollbackConfigMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	backrestGetOperationEventsHandler := connect.NewServerStreamHandler(
 		BackrestGetOperationEventsProcedure,
 		svc.GetOperationEvents,
 		connect.WithSchema(backrestGetOperationEventsMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	backrestSubscribeOperationsHandler := connect.NewServerStreamHandler(
+		BackrestSubscribeOperationsProcedure,
+		svc.SubscribeOperations,
+		connect.WithSchema(backrestSubscribeOperationsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	backrestGetOperationsHandler := connect.NewUnaryHandler(
 		BackrestGetOperationsProcedure,
 		svc.GetOperations,
 		connect.WithSchema(backrestGetOperationsMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	backrestQueryOperationsHandler := connect.NewUnaryHandler(
+		BackrestQueryOperationsProcedure,
+		svc.QueryOperations,
+		connect.WithSchema(backrestQueryOperationsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	backrestListSnapshotsHandler := connect.NewUnaryHandler(
 		BackrestListSnapshotsProcedure,
 		svc.ListSnapshots,
@@ -446,6 +1303,24 @@ func NewBackrestHandler(svc BackrestHandler, opts ...connect.HandlerOption) (str
 		connect.WithSchema(backrestListSnapshotFilesMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	backrestGetLargestFilesHandler := connect.NewUnaryHandler(
+		BackrestGetLargestFilesProcedure,
+		svc.GetLargestFiles,
+		connect.WithSchema(backrestGetLargestFilesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	backrestGetFileHistoryHandler := connect.NewUnaryHandler(
+		BackrestGetFileHistoryProcedure,
+		svc.GetFileHistory,
+		connect.WithSchema(backrestGetFileHistoryMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	backrestGetReclaimedSpaceHandler := connect.NewUnaryHandler(
+		BackrestGetReclaimedSpaceProcedure,
+		svc.GetReclaimedSpace,
+		connect.WithSchema(backrestGetReclaimedSpaceMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	backrestIndexSnapshotsHandler := connect.NewUnaryHandler(
 		BackrestIndexSnapshotsProcedure,
 		svc.IndexSnapshots,
@@ -458,6 +1333,12 @@ func NewBackrestHandler(svc BackrestHandler, opts ...connect.HandlerOption) (str
 		connect.WithSchema(backrestBackupMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	backrestAdhocBackupHandler := connect.NewUnaryHandler(
+		BackrestAdhocBackupProcedure,
+		svc.AdhocBackup,
+		connect.WithSchema(backrestAdhocBackupMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	backrestPruneHandler := connect.NewUnaryHandler(
 		BackrestPruneProcedure,
 		svc.Prune,
@@ -512,6 +1393,174 @@ func NewBackrestHandler(svc BackrestHandler, opts ...connect.HandlerOption) (str
 		connect.WithSchema(backrestPathAutocompleteMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	backrestGetRepoHealthHandler := connect.NewUnaryHandler(
+		BackrestGetRepoHealthProcedure,
+		svc.GetRepoHealth,
+		connect.WithSchema(backrestGetRepoHealthMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	backrestCheckOplogIntegrityHandler := connect.NewUnaryHandler(
+		BackrestCheckOplogIntegrityProcedure,
+		svc.CheckOplogIntegrity,
+		connect.WithSchema(backrestCheckOplogIntegrityMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	backrestGetAuditLogHandler := connect.NewUnaryHandler(
+		BackrestGetAuditLogProcedure,
+		svc.GetAuditLog,
+		connect.WithSchema(backrestGetAuditLogMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	backrestExportAuditLogHandler := connect.NewUnaryHandler(
+		BackrestExportAuditLogProcedure,
+		svc.ExportAuditLog,
+		connect.WithSchema(backrestExportAuditLogMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	backrestListRepoMigrationsHandler := connect.NewUnaryHandler(
+		BackrestListRepoMigrationsProcedure,
+		svc.ListRepoMigrations,
+		connect.WithSchema(backrestListRepoMigrationsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	backrestGetRepoCacheStatsHandler := connect.NewUnaryHandler(
+		BackrestGetRepoCacheStatsProcedure,
+		svc.GetRepoCacheStats,
+		connect.WithSchema(backrestGetRepoCacheStatsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	backrestMigrateRepoHandler := connect.NewUnaryHandler(
+		BackrestMigrateRepoProcedure,
+		svc.MigrateRepo,
+		connect.WithSchema(backrestMigrateRepoMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	backrestWarmupRepoHandler := connect.NewUnaryHandler(
+		BackrestWarmupRepoProcedure,
+		svc.WarmupRepo,
+		connect.WithSchema(backrestWarmupRepoMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	backrestGetRecoveryBundleHandler := connect.NewUnaryHandler(
+		BackrestGetRecoveryBundleProcedure,
+		svc.GetRecoveryBundle,
+		connect.WithSchema(backrestGetRecoveryBundleMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	backrestGetNotificationTemplatesHandler := connect.NewUnaryHandler(
+		BackrestGetNotificationTemplatesProcedure,
+		svc.GetNotificationTemplates,
+		connect.WithSchema(backrestGetNotificationTemplatesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	backrestGetRemoteStatusHandler := connect.NewUnaryHandler(
+		BackrestGetRemoteStatusProcedure,
+		svc.GetRemoteStatus,
+		connect.WithSchema(backrestGetRemoteStatusMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	backrestGetRemoteOperationsHandler := connect.NewUnaryHandler(
+		BackrestGetRemoteOperationsProcedure,
+		svc.GetRemoteOperations,
+		connect.WithSchema(backrestGetRemoteOperationsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	backrestGetRunsHandler := connect.NewUnaryHandler(
+		BackrestGetRunsProcedure,
+		svc.GetRuns,
+		connect.WithSchema(backrestGetRunsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	backrestGetDurationEstimatesHandler := connect.NewUnaryHandler(
+		BackrestGetDurationEstimatesProcedure,
+		svc.GetDurationEstimates,
+		connect.WithSchema(backrestGetDurationEstimatesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	backrestGetResourceUsageHandler := connect.NewUnaryHandler(
+		BackrestGetResourceUsageProcedure,
+		svc.GetResourceUsage,
+		connect.WithSchema(backrestGetResourceUsageMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	backrestUpdateResticHandler := connect.NewUnaryHandler(
+		BackrestUpdateResticProcedure,
+		svc.UpdateRestic,
+		connect.WithSchema(backrestUpdateResticMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	backrestGetMetricSeriesHandler := connect.NewUnaryHandler(
+		BackrestGetMetricSeriesProcedure,
+		svc.GetMetricSeries,
+		connect.WithSchema(backrestGetMetricSeriesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	backrestGetExclusionSuggestionsHandler := connect.NewUnaryHandler(
+		BackrestGetExclusionSuggestionsProcedure,
+		svc.GetExclusionSuggestions,
+		connect.WithSchema(backrestGetExclusionSuggestionsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	backrestAnnotateSnapshotHandler := connect.NewUnaryHandler(
+		BackrestAnnotateSnapshotProcedure,
+		svc.AnnotateSnapshot,
+		connect.WithSchema(backrestAnnotateSnapshotMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	backrestPinSnapshotHandler := connect.NewUnaryHandler(
+		BackrestPinSnapshotProcedure,
+		svc.PinSnapshot,
+		connect.WithSchema(backrestPinSnapshotMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	backrestRescueSnapshotHandler := connect.NewUnaryHandler(
+		BackrestRescueSnapshotProcedure,
+		svc.RescueSnapshot,
+		connect.WithSchema(backrestRescueSnapshotMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	backrestEmergencyStopHandler := connect.NewUnaryHandler(
+		BackrestEmergencyStopProcedure,
+		svc.EmergencyStop,
+		connect.WithSchema(backrestEmergencyStopMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	backrestResumeSchedulesHandler := connect.NewUnaryHandler(
+		BackrestResumeSchedulesProcedure,
+		svc.ResumeSchedules,
+		connect.WithSchema(backrestResumeSchedulesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	backrestInstantiatePlanTemplateHandler := connect.NewUnaryHandler(
+		BackrestInstantiatePlanTemplateProcedure,
+		svc.InstantiatePlanTemplate,
+		connect.WithSchema(backrestInstantiatePlanTemplateMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	backrestDeletePlanHandler := connect.NewUnaryHandler(
+		BackrestDeletePlanProcedure,
+		svc.DeletePlan,
+		connect.WithSchema(backrestDeletePlanMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	backrestDeleteRepoHandler := connect.NewUnaryHandler(
+		BackrestDeleteRepoProcedure,
+		svc.DeleteRepo,
+		connect.WithSchema(backrestDeleteRepoMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	backrestAcknowledgeOperationHandler := connect.NewUnaryHandler(
+		BackrestAcknowledgeOperationProcedure,
+		svc.AcknowledgeOperation,
+		connect.WithSchema(backrestAcknowledgeOperationMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	backrestBenchmarkRepoHandler := connect.NewUnaryHandler(
+		BackrestBenchmarkRepoProcedure,
+		svc.BenchmarkRepo,
+		connect.WithSchema(backrestBenchmarkRepoMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/v1.Backrest/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case BackrestGetConfigProcedure:
@@ -520,18 +1569,42 @@ func NewBackrestHandler(svc BackrestHandler, opts ...connect.HandlerOption) (str
 			backrestSetConfigHandler.ServeHTTP(w, r)
 		case BackrestAddRepoProcedure:
 			backrestAddRepoHandler.ServeHTTP(w, r)
+		case BackrestImportRepoProcedure:
+			backrestImportRepoHandler.ServeHTTP(w, r)
+		case BackrestProbeRepoProcedure:
+			backrestProbeRepoHandler.ServeHTTP(w, r)
+		case BackrestGenerateRepoPasswordProcedure:
+			backrestGenerateRepoPasswordHandler.ServeHTTP(w, r)
+		case BackrestInitRepoProcedure:
+			backrestInitRepoHandler.ServeHTTP(w, r)
+		case BackrestGetConfigHistoryProcedure:
+			backrestGetConfigHistoryHandler.ServeHTTP(w, r)
+		case BackrestRollbackConfigProcedure:
+			backrestRollbackConfigHandler.ServeHTTP(w, r)
 		case BackrestGetOperationEventsProcedure:
 			backrestGetOperationEventsHandler.ServeHTTP(w, r)
+		case BackrestSubscribeOperationsProcedure:
+			backrestSubscribeOperationsHandler.ServeHTTP(w, r)
 		case BackrestGetOperationsProcedure:
 			backrestGetOperationsHandler.ServeHTTP(w, r)
+		case BackrestQueryOperationsProcedure:
+			backrestQueryOperationsHandler.ServeHTTP(w, r)
 		case BackrestListSnapshotsProcedure:
 			backrestListSnapshotsHandler.ServeHTTP(w, r)
 		case BackrestListSnapshotFilesProcedure:
 			backrestListSnapshotFilesHandler.ServeHTTP(w, r)
+		case BackrestGetLargestFilesProcedure:
+			backrestGetLargestFilesHandler.ServeHTTP(w, r)
+		case BackrestGetFileHistoryProcedure:
+			backrestGetFileHistoryHandler.ServeHTTP(w, r)
+		case BackrestGetReclaimedSpaceProcedure:
+			backrestGetReclaimedSpaceHandler.ServeHTTP(w, r)
 		case BackrestIndexSnapshotsProcedure:
 			backrestIndexSnapshotsHandler.ServeHTTP(w, r)
 		case BackrestBackupProcedure:
 			backrestBackupHandler.ServeHTTP(w, r)
+		case BackrestAdhocBackupProcedure:
+			backrestAdhocBackupHandler.ServeHTTP(w, r)
 		case BackrestPruneProcedure:
 			backrestPruneHandler.ServeHTTP(w, r)
 		case BackrestForgetProcedure:
@@ -550,6 +1623,62 @@ func NewBackrestHandler(svc BackrestHandler, opts ...connect.HandlerOption) (str
 			backrestClearHistoryHandler.ServeHTTP(w, r)
 		case BackrestPathAutocompleteProcedure:
 			backrestPathAutocompleteHandler.ServeHTTP(w, r)
+		case BackrestGetRepoHealthProcedure:
+			backrestGetRepoHealthHandler.ServeHTTP(w, r)
+		case BackrestCheckOplogIntegrityProcedure:
+			backrestCheckOplogIntegrityHandler.ServeHTTP(w, r)
+		case BackrestGetAuditLogProcedure:
+			backrestGetAuditLogHandler.ServeHTTP(w, r)
+		case BackrestExportAuditLogProcedure:
+			backrestExportAuditLogHandler.ServeHTTP(w, r)
+		case BackrestListRepoMigrationsProcedure:
+			backrestListRepoMigrationsHandler.ServeHTTP(w, r)
+		case BackrestGetRepoCacheStatsProcedure:
+			backrestGetRepoCacheStatsHandler.ServeHTTP(w, r)
+		case BackrestMigrateRepoProcedure:
+			backrestMigrateRepoHandler.ServeHTTP(w, r)
+		case BackrestWarmupRepoProcedure:
+			backrestWarmupRepoHandler.ServeHTTP(w, r)
+		case BackrestGetRecoveryBundleProcedure:
+			backrestGetRecoveryBundleHandler.ServeHTTP(w, r)
+		case BackrestGetNotificationTemplatesProcedure:
+			backrestGetNotificationTemplatesHandler.ServeHTTP(w, r)
+		case BackrestGetRemoteStatusProcedure:
+			backrestGetRemoteStatusHandler.ServeHTTP(w, r)
+		case BackrestGetRemoteOperationsProcedure:
+			backrestGetRemoteOperationsHandler.ServeHTTP(w, r)
+		case BackrestGetRunsProcedure:
+			backrestGetRunsHandler.ServeHTTP(w, r)
+		case BackrestGetDurationEstimatesProcedure:
+			backrestGetDurationEstimatesHandler.ServeHTTP(w, r)
+		case BackrestGetResourceUsageProcedure:
+			backrestGetResourceUsageHandler.ServeHTTP(w, r)
+		case BackrestUpdateResticProcedure:
+			backrestUpdateResticHandler.ServeHTTP(w, r)
+		case BackrestGetMetricSeriesProcedure:
+			backrestGetMetricSeriesHandler.ServeHTTP(w, r)
+		case BackrestGetExclusionSuggestionsProcedure:
+			backrestGetExclusionSuggestionsHandler.ServeHTTP(w, r)
+		case BackrestAnnotateSnapshotProcedure:
+			backrestAnnotateSnapshotHandler.ServeHTTP(w, r)
+		case BackrestPinSnapshotProcedure:
+			backrestPinSnapshotHandler.ServeHTTP(w, r)
+		case BackrestRescueSnapshotProcedure:
+			backrestRescueSnapshotHandler.ServeHTTP(w, r)
+		case BackrestEmergencyStopProcedure:
+			backrestEmergencyStopHandler.ServeHTTP(w, r)
+		case BackrestResumeSchedulesProcedure:
+			backrestResumeSchedulesHandler.ServeHTTP(w, r)
+		case BackrestInstantiatePlanTemplateProcedure:
+			backrestInstantiatePlanTemplateHandler.ServeHTTP(w, r)
+		case BackrestDeletePlanProcedure:
+			backrestDeletePlanHandler.ServeHTTP(w, r)
+		case BackrestDeleteRepoProcedure:
+			backrestDeleteRepoHandler.ServeHTTP(w, r)
+		case BackrestAcknowledgeOperationProcedure:
+			backrestAcknowledgeOperationHandler.ServeHTTP(w, r)
+		case BackrestBenchmarkRepoProcedure:
+			backrestBenchmarkRepoHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -571,14 +1700,46 @@ func (UnimplementedBackrestHandler) AddRepo(context.Context, *connect.Request[v1
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Backrest.AddRepo is not implemented"))
 }
 
+func (UnimplementedBackrestHandler) ImportRepo(context.Context, *connect.Request[v1.Repo]) (*connect.Response[v1.Config], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Backrest.ImportRepo is not implemented"))
+}
+
+func (UnimplementedBackrestHandler) ProbeRepo(context.Context, *connect.Request[v1.Repo]) (*connect.Response[v1.RepoProbe], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Backrest.ProbeRepo is not implemented"))
+}
+
+func (UnimplementedBackrestHandler) GenerateRepoPassword(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[types.StringValue], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Backrest.GenerateRepoPassword is not implemented"))
+}
+
+func (UnimplementedBackrestHandler) InitRepo(context.Context, *connect.Request[v1.InitRepoRequest]) (*connect.Response[v1.Config], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Backrest.InitRepo is not implemented"))
+}
+
+func (UnimplementedBackrestHandler) GetConfigHistory(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.ConfigRevisionList], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Backrest.GetConfigHistory is not implemented"))
+}
+
+func (UnimplementedBackrestHandler) RollbackConfig(context.Context, *connect.Request[types.Int64Value]) (*connect.Response[v1.Config], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Backrest.RollbackConfig is not implemented"))
+}
+
 func (UnimplementedBackrestHandler) GetOperationEvents(context.Context, *connect.Request[emptypb.Empty], *connect.ServerStream[v1.OperationEvent]) error {
 	return connect.NewError(connect.CodeUnimplemented, errors.New("v1.Backrest.GetOperationEvents is not implemented"))
 }
 
+func (UnimplementedBackrestHandler) SubscribeOperations(context.Context, *connect.Request[v1.SubscribeOperationsRequest], *connect.ServerStream[v1.OperationEvent]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("v1.Backrest.SubscribeOperations is not implemented"))
+}
+
 func (UnimplementedBackrestHandler) GetOperations(context.Context, *connect.Request[v1.GetOperationsRequest]) (*connect.Response[v1.OperationList], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Backrest.GetOperations is not implemented"))
 }
 
+func (UnimplementedBackrestHandler) QueryOperations(context.Context, *connect.Request[v1.QueryOperationsRequest]) (*connect.Response[v1.QueryOperationsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Backrest.QueryOperations is not implemented"))
+}
+
 func (UnimplementedBackrestHandler) ListSnapshots(context.Context, *connect.Request[v1.ListSnapshotsRequest]) (*connect.Response[v1.ResticSnapshotList], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Backrest.ListSnapshots is not implemented"))
 }
@@ -587,6 +1748,18 @@ func (UnimplementedBackrestHandler) ListSnapshotFiles(context.Context, *connect.
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Backrest.ListSnapshotFiles is not implemented"))
 }
 
+func (UnimplementedBackrestHandler) GetLargestFiles(context.Context, *connect.Request[v1.GetLargestFilesRequest]) (*connect.Response[v1.GetLargestFilesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Backrest.GetLargestFiles is not implemented"))
+}
+
+func (UnimplementedBackrestHandler) GetFileHistory(context.Context, *connect.Request[v1.GetFileHistoryRequest]) (*connect.Response[v1.FileHistory], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Backrest.GetFileHistory is not implemented"))
+}
+
+func (UnimplementedBackrestHandler) GetReclaimedSpace(context.Context, *connect.Request[types.StringValue]) (*connect.Response[v1.ReclaimedSpace], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Backrest.GetReclaimedSpace is not implemented"))
+}
+
 func (UnimplementedBackrestHandler) IndexSnapshots(context.Context, *connect.Request[types.StringValue]) (*connect.Response[emptypb.Empty], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Backrest.IndexSnapshots is not implemented"))
 }
@@ -595,6 +1768,10 @@ func (UnimplementedBackrestHandler) Backup(context.Context, *connect.Request[typ
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Backrest.Backup is not implemented"))
 }
 
+func (UnimplementedBackrestHandler) AdhocBackup(context.Context, *connect.Request[v1.AdhocBackupRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Backrest.AdhocBackup is not implemented"))
+}
+
 func (UnimplementedBackrestHandler) Prune(context.Context, *connect.Request[types.StringValue]) (*connect.Response[emptypb.Empty], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Backrest.Prune is not implemented"))
 }
@@ -630,3 +1807,115 @@ func (UnimplementedBackrestHandler) ClearHistory(context.Context, *connect.Reque
 func (UnimplementedBackrestHandler) PathAutocomplete(context.Context, *connect.Request[types.StringValue]) (*connect.Response[types.StringList], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Backrest.PathAutocomplete is not implemented"))
 }
+
+func (UnimplementedBackrestHandler) GetRepoHealth(context.Context, *connect.Request[v1.GetRepoHealthRequest]) (*connect.Response[v1.RepoHealth], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Backrest.GetRepoHealth is not implemented"))
+}
+
+func (UnimplementedBackrestHandler) CheckOplogIntegrity(context.Context, *connect.Request[v1.CheckOplogIntegrityRequest]) (*connect.Response[v1.OplogIntegrityReport], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Backrest.CheckOplogIntegrity is not implemented"))
+}
+
+func (UnimplementedBackrestHandler) GetAuditLog(context.Context, *connect.Request[v1.GetAuditLogRequest]) (*connect.Response[v1.AuditEntryList], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Backrest.GetAuditLog is not implemented"))
+}
+
+func (UnimplementedBackrestHandler) ExportAuditLog(context.Context, *connect.Request[v1.GetAuditLogRequest]) (*connect.Response[types.BytesValue], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Backrest.ExportAuditLog is not implemented"))
+}
+
+func (UnimplementedBackrestHandler) ListRepoMigrations(context.Context, *connect.Request[types.StringValue]) (*connect.Response[types.StringList], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Backrest.ListRepoMigrations is not implemented"))
+}
+
+func (UnimplementedBackrestHandler) GetRepoCacheStats(context.Context, *connect.Request[types.StringValue]) (*connect.Response[v1.RepoCacheStats], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Backrest.GetRepoCacheStats is not implemented"))
+}
+
+func (UnimplementedBackrestHandler) MigrateRepo(context.Context, *connect.Request[v1.MigrateRepoRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Backrest.MigrateRepo is not implemented"))
+}
+
+func (UnimplementedBackrestHandler) WarmupRepo(context.Context, *connect.Request[types.StringValue]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Backrest.WarmupRepo is not implemented"))
+}
+
+func (UnimplementedBackrestHandler) GetRecoveryBundle(context.Context, *connect.Request[v1.GetRecoveryBundleRequest]) (*connect.Response[types.BytesValue], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Backrest.GetRecoveryBundle is not implemented"))
+}
+
+func (UnimplementedBackrestHandler) GetNotificationTemplates(context.Context, *connect.Request[types.StringValue]) (*connect.Response[v1.Notifications], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Backrest.GetNotificationTemplates is not implemented"))
+}
+
+func (UnimplementedBackrestHandler) GetRemoteStatus(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.RemoteStatusList], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Backrest.GetRemoteStatus is not implemented"))
+}
+
+func (UnimplementedBackrestHandler) GetRemoteOperations(context.Context, *connect.Request[v1.GetRemoteOperationsRequest]) (*connect.Response[v1.OperationList], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Backrest.GetRemoteOperations is not implemented"))
+}
+
+func (UnimplementedBackrestHandler) GetRuns(context.Context, *connect.Request[v1.GetRunsRequest]) (*connect.Response[v1.RunList], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Backrest.GetRuns is not implemented"))
+}
+
+func (UnimplementedBackrestHandler) GetDurationEstimates(context.Context, *connect.Request[types.StringValue]) (*connect.Response[v1.DurationEstimateList], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Backrest.GetDurationEstimates is not implemented"))
+}
+
+func (UnimplementedBackrestHandler) GetResourceUsage(context.Context, *connect.Request[types.StringValue]) (*connect.Response[v1.ResourceUsageSummaryList], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Backrest.GetResourceUsage is not implemented"))
+}
+
+func (UnimplementedBackrestHandler) UpdateRestic(context.Context, *connect.Request[v1.UpdateResticRequest]) (*connect.Response[v1.ResticUpdateStatus], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Backrest.UpdateRestic is not implemented"))
+}
+
+func (UnimplementedBackrestHandler) GetMetricSeries(context.Context, *connect.Request[v1.GetMetricSeriesRequest]) (*connect.Response[v1.MetricSeriesList], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Backrest.GetMetricSeries is not implemented"))
+}
+
+func (UnimplementedBackrestHandler) GetExclusionSuggestions(context.Context, *connect.Request[types.StringValue]) (*connect.Response[v1.ExclusionSuggestionList], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Backrest.GetExclusionSuggestions is not implemented"))
+}
+
+func (UnimplementedBackrestHandler) AnnotateSnapshot(context.Context, *connect.Request[v1.AnnotateSnapshotRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Backrest.AnnotateSnapshot is not implemented"))
+}
+
+func (UnimplementedBackrestHandler) PinSnapshot(context.Context, *connect.Request[v1.PinSnapshotRequest]) (*connect.Response[types.StringValue], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Backrest.PinSnapshot is not implemented"))
+}
+
+func (UnimplementedBackrestHandler) RescueSnapshot(context.Context, *connect.Request[v1.RescueSnapshotRequest]) (*connect.Response[types.StringValue], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Backrest.RescueSnapshot is not implemented"))
+}
+
+func (UnimplementedBackrestHandler) EmergencyStop(context.Context, *connect.Request[types.StringValue]) (*connect.Response[v1.Config], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Backrest.EmergencyStop is not implemented"))
+}
+
+func (UnimplementedBackrestHandler) ResumeSchedules(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.Config], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Backrest.ResumeSchedules is not implemented"))
+}
+
+func (UnimplementedBackrestHandler) InstantiatePlanTemplate(context.Context, *connect.Request[v1.InstantiatePlanTemplateRequest]) (*connect.Response[v1.Config], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Backrest.InstantiatePlanTemplate is not implemented"))
+}
+
+func (UnimplementedBackrestHandler) DeletePlan(context.Context, *connect.Request[v1.DeletePlanRequest]) (*connect.Response[v1.TeardownResult], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Backrest.DeletePlan is not implemented"))
+}
+
+func (UnimplementedBackrestHandler) DeleteRepo(context.Context, *connect.Request[v1.DeleteRepoRequest]) (*connect.Response[v1.TeardownResult], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Backrest.DeleteRepo is not implemented"))
+}
+
+func (UnimplementedBackrestHandler) AcknowledgeOperation(context.Context, *connect.Request[v1.AcknowledgeOperationRequest]) (*connect.Response[v1.Operation], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Backrest.AcknowledgeOperation is not implemented"))
+}
+
+func (UnimplementedBackrestHandler) BenchmarkRepo(context.Context, *connect.Request[v1.BenchmarkRepoRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("v1.Backrest.BenchmarkRepo is not implemented"))
+}
@@ -2,44 +2,90 @@ package api
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/garethgeorge/backrest/gen/go/types"
 	v1 "github.com/garethgeorge/backrest/gen/go/v1"
 	"github.com/garethgeorge/backrest/gen/go/v1/v1connect"
+	"github.com/garethgeorge/backrest/internal/auditlog"
 	"github.com/garethgeorge/backrest/internal/auth"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 type AuthenticationHandler struct {
 	// v1connect.UnimplementedAuthenticationHandler
 	authenticator *auth.Authenticator
+	throttle      *auth.LoginThrottle
+	auditLog      *auditlog.AuditLog
 }
 
 var _ v1connect.AuthenticationHandler = &AuthenticationHandler{}
 
-func NewAuthenticationHandler(authenticator *auth.Authenticator) *AuthenticationHandler {
+func NewAuthenticationHandler(authenticator *auth.Authenticator, auditLog *auditlog.AuditLog) *AuthenticationHandler {
 	return &AuthenticationHandler{
 		authenticator: authenticator,
+		throttle:      auth.NewLoginThrottle(),
+		auditLog:      auditLog,
 	}
 }
 
 func (s *AuthenticationHandler) Login(ctx context.Context, req *connect.Request[v1.LoginRequest]) (*connect.Response[v1.LoginResponse], error) {
 	zap.L().Debug("login request", zap.String("username", req.Msg.Username))
-	user, err := s.authenticator.Login(req.Msg.Username, req.Msg.Password)
+	ip := s.authenticator.ClientIP(req.Peer().Addr, req.Header())
+	if err := s.throttle.Allow(ip, req.Msg.Username); err != nil {
+		zap.L().Warn("throttled login attempt", zap.String("username", req.Msg.Username), zap.String("ip", ip))
+		return nil, connect.NewError(connect.CodeResourceExhausted, err)
+	}
+
+	resp, err := s.authenticator.LoginWithSecondFactor(req.Msg, req.Header().Get("Origin"))
 	if err != nil {
-		zap.L().Warn("failed login attempt", zap.Error(err))
+		zap.L().Warn("failed login attempt", zap.String("ip", ip), zap.Error(err))
+		s.recordFailure(ip, req.Msg.Username, err)
+		switch {
+		case errors.Is(err, auth.ErrSecondFactorNotEnrolled):
+			return nil, connect.NewError(connect.CodePermissionDenied, auth.ErrSecondFactorNotEnrolled)
+		case errors.Is(err, auth.ErrInvalidSecondFactor), errors.Is(err, auth.ErrWebAuthn), errors.Is(err, auth.ErrSecondFactorRequired):
+			return nil, connect.NewError(connect.CodeUnauthenticated, auth.ErrInvalidSecondFactor)
+		}
 		return nil, connect.NewError(connect.CodeUnauthenticated, auth.ErrInvalidPassword)
 	}
+	if resp.Token != "" {
+		s.throttle.Success(ip, req.Msg.Username)
+	}
 
-	token, err := s.authenticator.CreateJWT(user)
-	if err != nil {
-		return nil, err
+	return connect.NewResponse(resp), nil
+}
+
+// recordFailure counts a failed login against the client and user and audits it, and any lockout it caused.
+func (s *AuthenticationHandler) recordFailure(ip, username string, loginErr error) {
+	locked := s.throttle.Failure(ip, username)
+	s.audit(&v1.AuditEntry{
+		User:    username,
+		Action:  "login_failed",
+		Details: fmt.Sprintf("failed login from %s: %v", ip, loginErr),
+	})
+	for _, key := range locked {
+		zap.S().Warnf("locked out %s after repeated failed logins", key)
+		s.audit(&v1.AuditEntry{
+			User:    username,
+			Action:  "login_lockout",
+			Details: fmt.Sprintf("locked out %s after repeated failed logins", key),
+		})
 	}
+}
 
-	return connect.NewResponse(&v1.LoginResponse{
-		Token: token,
-	}), nil
+func (s *AuthenticationHandler) audit(entry *v1.AuditEntry) {
+	if s.auditLog == nil {
+		return
+	}
+	if err := s.auditLog.Record(entry); err != nil {
+		zap.S().Errorf("failed to record audit entry for action %q: %v", entry.Action, err)
+	}
 }
 
 func (s *AuthenticationHandler) HashPassword(ctx context.Context, req *connect.Request[types.StringValue]) (*connect.Response[types.StringValue], error) {
@@ -49,3 +95,141 @@ func (s *AuthenticationHandler) HashPassword(ctx context.Context, req *connect.R
 	}
 	return connect.NewResponse(&types.StringValue{Value: hash}), nil
 }
+
+// authenticatedUser returns the user identified by the request's bearer token.
+func (s *AuthenticationHandler) authenticatedUser(header http.Header) (*v1.User, error) {
+	token, err := auth.ParseBearerToken(header.Get("Authorization"))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+	user, err := s.authenticator.VerifyJWT(token)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, err)
+	}
+	return user, nil
+}
+
+func (s *AuthenticationHandler) BeginTotpEnrollment(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[v1.TotpEnrollment], error) {
+	user, err := s.authenticatedUser(req.Header())
+	if err != nil {
+		return nil, err
+	}
+	enrollment, err := s.authenticator.BeginTOTPEnrollment(user)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(enrollment), nil
+}
+
+func (s *AuthenticationHandler) ConfirmTotpEnrollment(ctx context.Context, req *connect.Request[v1.ConfirmTotpEnrollmentRequest]) (*connect.Response[v1.RecoveryCodes], error) {
+	user, err := s.authenticatedUser(req.Header())
+	if err != nil {
+		return nil, err
+	}
+	codes, err := s.authenticator.ConfirmTOTPEnrollment(user.Name, req.Msg.Secret, req.Msg.Code)
+	if err != nil {
+		return nil, fmt.Errorf("failed to enroll TOTP: %w", err)
+	}
+	zap.S().Infof("user %q enrolled a TOTP second factor", user.Name)
+	return connect.NewResponse(&v1.RecoveryCodes{Codes: codes}), nil
+}
+
+func (s *AuthenticationHandler) BeginWebAuthnRegistration(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[v1.WebAuthnChallenge], error) {
+	user, err := s.authenticatedUser(req.Header())
+	if err != nil {
+		return nil, err
+	}
+	challenge, err := s.authenticator.BeginWebAuthnRegistration(user, req.Header().Get("Origin"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin WebAuthn registration: %w", err)
+	}
+	return connect.NewResponse(challenge), nil
+}
+
+func (s *AuthenticationHandler) FinishWebAuthnRegistration(ctx context.Context, req *connect.Request[v1.FinishWebAuthnRegistrationRequest]) (*connect.Response[v1.RecoveryCodes], error) {
+	user, err := s.authenticatedUser(req.Header())
+	if err != nil {
+		return nil, err
+	}
+	codes, err := s.authenticator.FinishWebAuthnRegistration(user.Name, req.Msg.ChallengeToken, req.Msg.CredentialJson, req.Msg.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to register WebAuthn credential: %w", err)
+	}
+	zap.S().Infof("user %q registered a WebAuthn second factor", user.Name)
+	return connect.NewResponse(&v1.RecoveryCodes{Codes: codes}), nil
+}
+
+func (s *AuthenticationHandler) RegenerateRecoveryCodes(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[v1.RecoveryCodes], error) {
+	user, err := s.authenticatedUser(req.Header())
+	if err != nil {
+		return nil, err
+	}
+	codes, err := s.authenticator.RegenerateRecoveryCodes(user.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to regenerate recovery codes: %w", err)
+	}
+	return connect.NewResponse(&v1.RecoveryCodes{Codes: codes}), nil
+}
+
+func (s *AuthenticationHandler) RemoveSecondFactors(ctx context.Context, req *connect.Request[v1.RemoveSecondFactorsRequest]) (*connect.Response[emptypb.Empty], error) {
+	user, err := s.authenticatedUser(req.Header())
+	if err != nil {
+		return nil, err
+	}
+	ip := s.authenticator.ClientIP(req.Peer().Addr, req.Header())
+	if err := s.throttle.Allow(ip, user.Name); err != nil {
+		return nil, connect.NewError(connect.CodeResourceExhausted, err)
+	}
+	if err := s.authenticator.RemoveSecondFactors(user, req.Msg.TotpCode, req.Msg.RecoveryCode); err != nil {
+		if errors.Is(err, auth.ErrInvalidSecondFactor) || errors.Is(err, auth.ErrSecondFactorRequired) {
+			s.throttle.Failure(ip, user.Name)
+			return nil, connect.NewError(connect.CodePermissionDenied, auth.ErrInvalidSecondFactor)
+		}
+		return nil, fmt.Errorf("failed to remove second factors: %w", err)
+	}
+	s.audit(&v1.AuditEntry{User: user.Name, Action: "remove_second_factors", Details: "removed all second factors"})
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+func (s *AuthenticationHandler) CreateApiToken(ctx context.Context, req *connect.Request[types.Int64Value]) (*connect.Response[types.StringValue], error) {
+	user, err := s.authenticatedUser(req.Header())
+	if err != nil {
+		return nil, err
+	}
+	days := req.Msg.Value
+	if days == 0 {
+		days = 365
+	}
+	if days < 0 || days > 3650 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("validity must be between 1 and 3650 days"))
+	}
+	token, err := s.authenticator.CreateAPIToken(user, time.Duration(days)*24*time.Hour)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create token: %w", err)
+	}
+	s.audit(&v1.AuditEntry{User: user.Name, Action: "create_api_token", Details: fmt.Sprintf("created an API token valid for %d days", days)})
+	return connect.NewResponse(&types.StringValue{Value: token}), nil
+}
+
+func (s *AuthenticationHandler) ListLoginLockouts(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[v1.LoginLockoutList], error) {
+	if _, err := s.authenticatedUser(req.Header()); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&v1.LoginLockoutList{Lockouts: s.throttle.Lockouts()}), nil
+}
+
+func (s *AuthenticationHandler) ClearLoginLockouts(ctx context.Context, req *connect.Request[types.StringValue]) (*connect.Response[emptypb.Empty], error) {
+	user, err := s.authenticatedUser(req.Header())
+	if err != nil {
+		return nil, err
+	}
+	if !s.throttle.Clear(req.Msg.Value) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("no failed logins recorded for %q", req.Msg.Value))
+	}
+	details := "cleared all login lockouts"
+	if req.Msg.Value != "" {
+		details = fmt.Sprintf("cleared login lockout of %s", req.Msg.Value)
+	}
+	s.audit(&v1.AuditEntry{User: user.Name, Action: "clear_login_lockouts", Details: details})
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
@@ -6,6 +6,9 @@ import (
 	"fmt"
 	"os"
 	"path"
+	"runtime"
+	"slices"
+	"strconv"
 	"sync"
 	"time"
 
@@ -13,14 +16,21 @@ import (
 	"github.com/garethgeorge/backrest/gen/go/types"
 	v1 "github.com/garethgeorge/backrest/gen/go/v1"
 	"github.com/garethgeorge/backrest/gen/go/v1/v1connect"
+	"github.com/garethgeorge/backrest/internal/auditlog"
+	"github.com/garethgeorge/backrest/internal/auth"
 	"github.com/garethgeorge/backrest/internal/config"
+	"github.com/garethgeorge/backrest/internal/hook"
+	"github.com/garethgeorge/backrest/internal/metrics"
 	"github.com/garethgeorge/backrest/internal/oplog"
 	"github.com/garethgeorge/backrest/internal/oplog/indexutil"
 	"github.com/garethgeorge/backrest/internal/orchestrator"
 	"github.com/garethgeorge/backrest/internal/protoutil"
+	"github.com/garethgeorge/backrest/internal/recovery"
+	"github.com/garethgeorge/backrest/internal/remote"
 	"github.com/garethgeorge/backrest/internal/resticinstaller"
 	"github.com/garethgeorge/backrest/internal/rotatinglog"
 	"github.com/garethgeorge/backrest/pkg/restic"
+	"github.com/hashicorp/go-multierror"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -32,21 +42,54 @@ type BackrestHandler struct {
 	orchestrator *orchestrator.Orchestrator
 	oplog        *oplog.OpLog
 	logStore     *rotatinglog.RotatingLog
+	auditLog     *auditlog.AuditLog
+	metrics      *metrics.Store
+	remotes      *remote.Instances
 }
 
 var _ v1connect.BackrestHandler = &BackrestHandler{}
 
-func NewBackrestHandler(config config.ConfigStore, orchestrator *orchestrator.Orchestrator, oplog *oplog.OpLog, logStore *rotatinglog.RotatingLog) *BackrestHandler {
+func NewBackrestHandler(config config.ConfigStore, orchestrator *orchestrator.Orchestrator, oplog *oplog.OpLog, logStore *rotatinglog.RotatingLog, auditLog *auditlog.AuditLog, metrics *metrics.Store) *BackrestHandler {
 	s := &BackrestHandler{
 		config:       config,
 		orchestrator: orchestrator,
 		oplog:        oplog,
 		logStore:     logStore,
+		auditLog:     auditLog,
+		metrics:      metrics,
+		remotes:      remote.NewInstances(config),
 	}
 
 	return s
 }
 
+// audit records an action taken by the user making the request. Failures are logged but do not fail the request.
+func (s *BackrestHandler) audit(ctx context.Context, entry *v1.AuditEntry) {
+	recordAudit(ctx, s.auditLog, entry)
+}
+
+// recordAudit records the entry attributed to the authenticated user of the request, if any.
+func recordAudit(ctx context.Context, auditLog *auditlog.AuditLog, entry *v1.AuditEntry) {
+	if user, ok := ctx.Value(auth.UserContextKey).(*v1.User); ok {
+		entry.User = user.GetName()
+	}
+	if err := auditLog.Record(entry); err != nil {
+		zap.S().Errorf("failed to record audit entry for action %q: %v", entry.Action, err)
+	}
+}
+
+// validateConfig validates the config including the templates of its notifications and hooks.
+func validateConfig(c *v1.Config) error {
+	var err error
+	if e := config.ValidateConfig(c); e != nil {
+		err = multierror.Append(err, e)
+	}
+	if e := hook.ValidateTemplates(c); e != nil {
+		err = multierror.Append(err, fmt.Errorf("templates: %w", e))
+	}
+	return err
+}
+
 // GetConfig implements GET /v1/config
 func (s *BackrestHandler) GetConfig(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[v1.Config], error) {
 	config, err := s.config.Get()
@@ -68,11 +111,13 @@ func (s *BackrestHandler) SetConfig(ctx context.Context, req *connect.Request[v1
 		return nil, errors.New("config modno mismatch, reload and try again")
 	}
 
-	if err := config.ValidateConfig(req.Msg); err != nil {
+	if err := validateConfig(req.Msg); err != nil {
 		return nil, fmt.Errorf("validation error: %w", err)
 	}
 
 	req.Msg.Modno += 1
+	req.Msg.EmergencyStop = existing.EmergencyStop // only lifted by ResumeSchedules.
+	req.Msg.ResticBinary = existing.ResticBinary   // the pin is only changed by UpdateRestic after verifying the binary.
 
 	if err := s.config.Update(req.Msg); err != nil {
 		return nil, fmt.Errorf("failed to update config: %w", err)
@@ -82,12 +127,139 @@ func (s *BackrestHandler) SetConfig(ctx context.Context, req *connect.Request[v1
 	if err != nil {
 		return nil, fmt.Errorf("failed to get newly set config: %w", err)
 	}
+	diff, err := auditlog.ConfigDiff(existing, newConfig)
+	if err != nil {
+		zap.S().Warnf("failed to diff config for audit log: %v", err)
+	}
+	s.audit(ctx, &v1.AuditEntry{Action: "set_config", Details: diff})
+
+	if err := s.orchestrator.ApplyConfig(newConfig); err != nil {
+		return nil, fmt.Errorf("failed to apply config: %w", err)
+	}
+	return connect.NewResponse(newConfig), nil
+}
+
+// GetConfigHistory returns the config revisions with diffs between each revision and the one before it.
+func (s *BackrestHandler) GetConfigHistory(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[v1.ConfigRevisionList], error) {
+	store, ok := s.config.(config.RevisionStore)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnimplemented, errors.New("config store does not keep history"))
+	}
+	revisions, err := store.Revisions()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get config history: %w", err)
+	}
+
+	var prev *v1.Config
+	for _, rev := range revisions {
+		rev.Diff, err = auditlog.ConfigDiff(prev, rev.Config)
+		if err != nil {
+			return nil, fmt.Errorf("failed to diff revision %d: %w", rev.Revision, err)
+		}
+		prev = rev.Config
+	}
+	for _, rev := range revisions {
+		rev.Config = nil
+	}
+	return connect.NewResponse(&v1.ConfigRevisionList{Revisions: revisions}), nil
+}
+
+// RollbackConfig restores the config to the given revision and applies it.
+func (s *BackrestHandler) RollbackConfig(ctx context.Context, req *connect.Request[types.Int64Value]) (*connect.Response[v1.Config], error) {
+	store, ok := s.config.(config.RevisionStore)
+	if !ok {
+		return nil, connect.NewError(connect.CodeUnimplemented, errors.New("config store does not keep history"))
+	}
+	rev, err := store.Revision(req.Msg.Value)
+	if err != nil {
+		if errors.Is(err, config.ErrRevisionNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, fmt.Errorf("failed to get revision %d: %w", req.Msg.Value, err)
+	}
+
+	existing, err := s.config.Get()
+	if err != nil {
+		return nil, fmt.Errorf("failed to check current config: %w", err)
+	}
+
+	c := rev.Config
+	c.Modno = existing.Modno + 1
+	c.EmergencyStop = existing.EmergencyStop // only lifted by ResumeSchedules.
+	c.ResticBinary = existing.ResticBinary   // the pin is only changed by UpdateRestic after verifying the binary.
+	c.Auth = existing.Auth                   // old password hashes, removed second factors and used recovery codes must not become valid again.
+	if err := validateConfig(c); err != nil {
+		return nil, fmt.Errorf("revision %d is not valid: %w", rev.Revision, err)
+	}
+	if err := s.config.Update(c); err != nil {
+		return nil, fmt.Errorf("failed to update config: %w", err)
+	}
+
+	newConfig, err := s.config.Get()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get newly set config: %w", err)
+	}
+
+	diff, err := auditlog.ConfigDiff(existing, newConfig)
+	if err != nil {
+		zap.S().Warnf("failed to diff config for audit log: %v", err)
+	}
+	s.audit(ctx, &v1.AuditEntry{Action: "rollback_config", Details: fmt.Sprintf("rollback to revision %d\n%v", rev.Revision, diff)})
+
 	if err := s.orchestrator.ApplyConfig(newConfig); err != nil {
 		return nil, fmt.Errorf("failed to apply config: %w", err)
 	}
 	return connect.NewResponse(newConfig), nil
 }
 
+// InstantiatePlanTemplate adds a plan created from the template for each instance, the config is only updated if all
+// the plans are valid.
+func (s *BackrestHandler) InstantiatePlanTemplate(ctx context.Context, req *connect.Request[v1.InstantiatePlanTemplateRequest]) (*connect.Response[v1.Config], error) {
+	existing, err := s.config.Get()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get config: %w", err)
+	}
+	idx := slices.IndexFunc(existing.PlanTemplates, func(t *v1.PlanTemplate) bool { return t.Id == req.Msg.TemplateId })
+	if idx < 0 {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("plan template %q not found", req.Msg.TemplateId))
+	}
+	tmpl := existing.PlanTemplates[idx]
+
+	hostname := existing.Host
+	if hostname == "" {
+		hostname, _ = os.Hostname()
+	}
+
+	c := proto.Clone(existing).(*v1.Config)
+	var ids []string
+	for i, instance := range req.Msg.Instances {
+		vars := map[string]string{"hostname": hostname}
+		for k, v := range instance.Vars {
+			vars[k] = v
+		}
+		plan, err := config.InstantiatePlanTemplate(tmpl, vars)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("instance %d: %w", i, err))
+		}
+		c.Plans = append(c.Plans, plan)
+		ids = append(ids, plan.Id)
+	}
+
+	c.Modno += 1
+	if err := validateConfig(c); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("validation error: %w", err))
+	}
+	if err := s.config.Update(c); err != nil {
+		return nil, fmt.Errorf("failed to update config: %w", err)
+	}
+	s.audit(ctx, &v1.AuditEntry{Action: "instantiate_plan_template", Details: fmt.Sprintf("added plans %v from template %v", ids, tmpl.Id)})
+
+	if err := s.orchestrator.ApplyConfig(c); err != nil {
+		return nil, fmt.Errorf("failed to apply config: %w", err)
+	}
+	return connect.NewResponse(c), nil
+}
+
 // AddRepo implements POST /v1/config/repo, it includes validation that the repo can be initialized.
 func (s *BackrestHandler) AddRepo(ctx context.Context, req *connect.Request[v1.Repo]) (*connect.Response[v1.Config], error) {
 	c, err := s.config.Get()
@@ -98,7 +270,7 @@ func (s *BackrestHandler) AddRepo(ctx context.Context, req *connect.Request[v1.R
 	c = proto.Clone(c).(*v1.Config)
 	c.Repos = append(c.Repos, req.Msg)
 
-	if err := config.ValidateConfig(c); err != nil {
+	if err := validateConfig(c); err != nil {
 		return nil, fmt.Errorf("validation error: %w", err)
 	}
 
@@ -107,7 +279,7 @@ func (s *BackrestHandler) AddRepo(ctx context.Context, req *connect.Request[v1.R
 		return nil, fmt.Errorf("failed to find or install restic binary: %w", err)
 	}
 
-	r := restic.NewRepo(bin, req.Msg)
+	r := restic.NewRepo(bin, req.Msg, backendOpts(req.Msg)...)
 
 	// use background context such that the init op can try to complete even if the connection is closed.
 	if err := r.Init(context.Background(), restic.WithPropagatedEnvVars(restic.EnvToPropagate...)); err != nil {
@@ -119,6 +291,8 @@ func (s *BackrestHandler) AddRepo(ctx context.Context, req *connect.Request[v1.R
 		return nil, fmt.Errorf("failed to update config: %w", err)
 	}
 
+	s.audit(ctx, &v1.AuditEntry{Action: "add_repo", RepoId: req.Msg.Id, Details: fmt.Sprintf("added repo with uri %q", req.Msg.Uri)})
+
 	zap.L().Debug("Applying config")
 	s.orchestrator.ApplyConfig(c)
 
@@ -130,6 +304,63 @@ func (s *BackrestHandler) AddRepo(ctx context.Context, req *connect.Request[v1.R
 	return connect.NewResponse(c), nil
 }
 
+// ImportRepo adds an existing restic repo to the config. Unlike AddRepo the repo is never initialized, it must already exist.
+func (s *BackrestHandler) ImportRepo(ctx context.Context, req *connect.Request[v1.Repo]) (*connect.Response[v1.Config], error) {
+	c, err := s.config.Get()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get config: %w", err)
+	}
+
+	repoCfg := proto.Clone(req.Msg).(*v1.Repo)
+	repoCfg.GroupUntrackedSnapshots = true
+
+	c = proto.Clone(c).(*v1.Config)
+	c.Repos = append(c.Repos, repoCfg)
+
+	if err := validateConfig(c); err != nil {
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+
+	bin, err := resticinstaller.FindOrInstallResticBinary()
+	if err != nil {
+		return nil, fmt.Errorf("failed to find or install restic binary: %w", err)
+	}
+
+	r := restic.NewRepo(bin, repoCfg, append(backendOpts(repoCfg), restic.WithPropagatedEnvVars(restic.EnvToPropagate...))...)
+	if err := r.CheckVersion(ctx); err != nil {
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+	snapshots, err := r.Snapshots(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open existing repo: %w", err)
+	}
+	zap.S().Infof("importing repo %q with %d existing snapshots", repoCfg.Id, len(snapshots))
+
+	if err := s.config.Update(c); err != nil {
+		return nil, fmt.Errorf("failed to update config: %w", err)
+	}
+
+	s.audit(ctx, &v1.AuditEntry{Action: "import_repo", RepoId: repoCfg.Id, Details: fmt.Sprintf("imported repo with uri %q and %d snapshots", repoCfg.Uri, len(snapshots))})
+
+	if err := s.orchestrator.ApplyConfig(c); err != nil {
+		return nil, fmt.Errorf("failed to apply config: %w", err)
+	}
+
+	// index the existing snapshots, this runs before any other queued work.
+	var wg sync.WaitGroup
+	wg.Add(1)
+	s.orchestrator.ScheduleTask(orchestrator.NewOneoffIndexSnapshotsTask(s.orchestrator, repoCfg.Id, time.Now()), orchestrator.TaskPriorityInteractive+orchestrator.TaskPriorityIndexSnapshots, func(e error) {
+		err = e
+		wg.Done()
+	})
+	wg.Wait()
+	if err != nil {
+		return nil, fmt.Errorf("failed to index snapshots: %w", err)
+	}
+
+	return connect.NewResponse(c), nil
+}
+
 // ListSnapshots implements POST /v1/snapshots
 func (s *BackrestHandler) ListSnapshots(ctx context.Context, req *connect.Request[v1.ListSnapshotsRequest]) (*connect.Response[v1.ResticSnapshotList], error) {
 	query := req.Msg
@@ -172,7 +403,12 @@ func (s *BackrestHandler) ListSnapshotFiles(ctx context.Context, req *connect.Re
 		return nil, fmt.Errorf("failed to get repo: %w", err)
 	}
 
-	entries, err := repo.ListSnapshotFiles(ctx, query.SnapshotId, query.Path)
+	var entries []*v1.LsEntry
+	if query.IncludeSizes {
+		entries, err = repo.ListSnapshotFilesWithSizes(ctx, query.SnapshotId, query.Path)
+	} else {
+		entries, err = repo.ListSnapshotFiles(ctx, query.SnapshotId, query.Path)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to list snapshot files: %w", err)
 	}
@@ -183,10 +419,89 @@ func (s *BackrestHandler) ListSnapshotFiles(ctx context.Context, req *connect.Re
 	}), nil
 }
 
+func (s *BackrestHandler) GetReclaimedSpace(ctx context.Context, req *connect.Request[types.StringValue]) (*connect.Response[v1.ReclaimedSpace], error) {
+	if _, err := s.orchestrator.GetRepo(req.Msg.Value); err != nil {
+		return nil, fmt.Errorf("failed to get repo: %w", err)
+	}
+	reclaimed, err := s.oplog.ReclaimedSpace(req.Msg.Value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reclaimed space: %w", err)
+	}
+	return connect.NewResponse(reclaimed), nil
+}
+
+func (s *BackrestHandler) GetFileHistory(ctx context.Context, req *connect.Request[v1.GetFileHistoryRequest]) (*connect.Response[v1.FileHistory], error) {
+	if req.Msg.RepoId == "" || req.Msg.Path == "" {
+		return nil, errors.New("must specify repoId and path")
+	}
+	repo, err := s.orchestrator.GetRepo(req.Msg.RepoId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repo: %w", err)
+	}
+	var plan *v1.Plan
+	if req.Msg.PlanId != "" {
+		if plan, err = s.orchestrator.GetPlan(req.Msg.PlanId); err != nil {
+			return nil, fmt.Errorf("failed to get plan %q: %w", req.Msg.PlanId, err)
+		}
+	}
+
+	versions, err := repo.FileHistory(ctx, plan, req.Msg.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get history of %q: %w", req.Msg.Path, err)
+	}
+	return connect.NewResponse(&v1.FileHistory{Versions: versions}), nil
+}
+
+func (s *BackrestHandler) GetLargestFiles(ctx context.Context, req *connect.Request[v1.GetLargestFilesRequest]) (*connect.Response[v1.GetLargestFilesResponse], error) {
+	if req.Msg.RepoId == "" || req.Msg.SnapshotId == "" {
+		return nil, errors.New("must specify repoId and snapshotId")
+	}
+	repo, err := s.orchestrator.GetRepo(req.Msg.RepoId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repo: %w", err)
+	}
+
+	limit := int(req.Msg.Limit)
+	if limit <= 0 {
+		limit = 100
+	}
+	files, dirs, err := repo.LargestEntries(ctx, req.Msg.SnapshotId, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get largest files: %w", err)
+	}
+	return connect.NewResponse(&v1.GetLargestFilesResponse{Files: files, Dirs: dirs}), nil
+}
+
 // GetOperationEvents implements GET /v1/events/operations
 func (s *BackrestHandler) GetOperationEvents(ctx context.Context, req *connect.Request[emptypb.Empty], resp *connect.ServerStream[v1.OperationEvent]) error {
-	errorChan := make(chan error)
-	defer close(errorChan)
+	return s.streamOperationEvents(ctx, resp, func(oldOp, newOp *v1.Operation, event *v1.OperationEvent) bool {
+		return true
+	})
+}
+
+func (s *BackrestHandler) SubscribeOperations(ctx context.Context, req *connect.Request[v1.SubscribeOperationsRequest], resp *connect.ServerStream[v1.OperationEvent]) error {
+	query := oplog.Query{
+		RepoId:     req.Msg.RepoId,
+		PlanId:     req.Msg.PlanId,
+		SnapshotId: req.Msg.SnapshotId,
+		Statuses:   req.Msg.Statuses,
+		Types:      req.Msg.Types,
+	}
+	return s.streamOperationEvents(ctx, resp, func(oldOp, newOp *v1.Operation, event *v1.OperationEvent) bool {
+		if len(req.Msg.EventTypes) > 0 && !slices.Contains(req.Msg.EventTypes, event.Type) {
+			return false
+		}
+		if req.Msg.StatusChangesOnly && event.Type == v1.OperationEventType_EVENT_UPDATED && oldOp.Status == newOp.Status {
+			return false
+		}
+		return query.Matches(event.Operation)
+	})
+}
+
+// streamOperationEvents sends the oplog events accepted by filter to resp until ctx is done or a send fails.
+func (s *BackrestHandler) streamOperationEvents(ctx context.Context, resp *connect.ServerStream[v1.OperationEvent], filter func(oldOp, newOp *v1.Operation, event *v1.OperationEvent) bool) error {
+	errorChan := make(chan error, 1)
+	var sendMu sync.Mutex
 	callback := func(oldOp *v1.Operation, newOp *v1.Operation) {
 		var event *v1.OperationEvent
 		if oldOp == nil && newOp != nil {
@@ -209,8 +524,17 @@ func (s *BackrestHandler) GetOperationEvents(ctx context.Context, req *connect.R
 			return
 		}
 
+		if !filter(oldOp, newOp, event) {
+			return
+		}
+
+		sendMu.Lock()
+		defer sendMu.Unlock()
 		if err := resp.Send(event); err != nil {
-			errorChan <- fmt.Errorf("failed to send event: %w", err)
+			select {
+			case errorChan <- fmt.Errorf("failed to send event: %w", err):
+			default:
+			}
 		}
 	}
 	s.oplog.Subscribe(&callback)
@@ -265,6 +589,45 @@ func (s *BackrestHandler) GetOperations(ctx context.Context, req *connect.Reques
 	}), nil
 }
 
+func (s *BackrestHandler) QueryOperations(ctx context.Context, req *connect.Request[v1.QueryOperationsRequest]) (*connect.Response[v1.QueryOperationsResponse], error) {
+	pageSize := int(req.Msg.PageSize)
+	if pageSize <= 0 {
+		pageSize = 100
+	}
+	pageSize = min(pageSize, 1000)
+
+	var cursor int64
+	if req.Msg.PageToken != "" {
+		var err error
+		if cursor, err = strconv.ParseInt(req.Msg.PageToken, 10, 64); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid page token %q", req.Msg.PageToken))
+		}
+	}
+
+	ops, next, err := s.oplog.Query(oplog.Query{
+		RepoId:      req.Msg.RepoId,
+		PlanId:      req.Msg.PlanId,
+		SnapshotId:  req.Msg.SnapshotId,
+		Statuses:    req.Msg.Statuses,
+		Types:       req.Msg.Types,
+		SinceUnixMs: req.Msg.SinceUnixMs,
+		UntilUnixMs: req.Msg.UntilUnixMs,
+		Text:        req.Msg.Text,
+		Descending:  req.Msg.Descending,
+		Cursor:      cursor,
+		Limit:       pageSize,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query operations: %w", err)
+	}
+
+	resp := &v1.QueryOperationsResponse{Operations: ops}
+	if next != 0 {
+		resp.NextPageToken = strconv.FormatInt(next, 10)
+	}
+	return connect.NewResponse(resp), nil
+}
+
 func (s *BackrestHandler) IndexSnapshots(ctx context.Context, req *connect.Request[types.StringValue]) (*connect.Response[emptypb.Empty], error) {
 	_, err := s.orchestrator.GetRepo(req.Msg.Value)
 	if err != nil {
@@ -281,6 +644,9 @@ func (s *BackrestHandler) Backup(ctx context.Context, req *connect.Request[types
 	if err != nil {
 		return nil, fmt.Errorf("failed to get plan %q: %w", req.Msg.Value, err)
 	}
+	for _, repoId := range orchestrator.PlanRepos(plan) {
+		s.audit(ctx, &v1.AuditEntry{Action: "backup", RepoId: repoId, PlanId: plan.Id, Details: "triggered backup"})
+	}
 	var wg sync.WaitGroup
 	wg.Add(1)
 	s.orchestrator.ScheduleTask(orchestrator.NewOneoffBackupTask(s.orchestrator, plan, time.Now()), orchestrator.TaskPriorityInteractive, func(e error) {
@@ -291,10 +657,32 @@ func (s *BackrestHandler) Backup(ctx context.Context, req *connect.Request[types
 	return connect.NewResponse(&emptypb.Empty{}), err
 }
 
+func (s *BackrestHandler) AdhocBackup(ctx context.Context, req *connect.Request[v1.AdhocBackupRequest]) (*connect.Response[emptypb.Empty], error) {
+	task, err := orchestrator.NewOneoffAdhocBackupTask(s.orchestrator, orchestrator.AdhocBackupOpts{
+		RepoId:   req.Msg.RepoId,
+		Paths:    req.Msg.Paths,
+		Tags:     req.Msg.Tags,
+		Excludes: req.Msg.Excludes,
+	}, time.Now())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	s.audit(ctx, &v1.AuditEntry{Action: "adhoc_backup", RepoId: req.Msg.RepoId, Details: fmt.Sprintf("triggered ad-hoc backup of %v", req.Msg.Paths)})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	s.orchestrator.ScheduleTask(task, orchestrator.TaskPriorityInteractive, func(e error) {
+		err = e
+		wg.Done()
+	})
+	wg.Wait()
+	return connect.NewResponse(&emptypb.Empty{}), err
+}
+
 func (s *BackrestHandler) Forget(ctx context.Context, req *connect.Request[v1.ForgetRequest]) (*connect.Response[emptypb.Empty], error) {
 	at := time.Now()
 	var err error
 	if req.Msg.SnapshotId != "" && req.Msg.PlanId != "" && req.Msg.RepoId != "" {
+		s.audit(ctx, &v1.AuditEntry{Action: "forget", RepoId: req.Msg.RepoId, PlanId: req.Msg.PlanId, Details: fmt.Sprintf("forget snapshot %v", req.Msg.SnapshotId)})
 		wait := make(chan struct{})
 		s.orchestrator.ScheduleTask(
 			orchestrator.NewOneoffForgetSnapshotTask(s.orchestrator, req.Msg.RepoId, req.Msg.PlanId, req.Msg.SnapshotId, at),
@@ -309,6 +697,7 @@ func (s *BackrestHandler) Forget(ctx context.Context, req *connect.Request[v1.Fo
 			return nil, fmt.Errorf("failed to get plan %q: %w", req.Msg.PlanId, err)
 		}
 
+		s.audit(ctx, &v1.AuditEntry{Action: "forget", RepoId: req.Msg.RepoId, PlanId: req.Msg.PlanId, Details: "apply retention policy"})
 		wait := make(chan struct{})
 		s.orchestrator.ScheduleTask(
 			orchestrator.NewOneoffForgetTask(s.orchestrator, plan, "", at),
@@ -327,12 +716,103 @@ func (s *BackrestHandler) Forget(ctx context.Context, req *connect.Request[v1.Fo
 	return connect.NewResponse(&emptypb.Empty{}), nil
 }
 
+func (s *BackrestHandler) AnnotateSnapshot(ctx context.Context, req *connect.Request[v1.AnnotateSnapshotRequest]) (*connect.Response[emptypb.Empty], error) {
+	if req.Msg.RepoId == "" || req.Msg.SnapshotId == "" {
+		return nil, errors.New("must specify repoId and snapshotId")
+	}
+	if err := s.orchestrator.AnnotateSnapshot(req.Msg.RepoId, req.Msg.SnapshotId, req.Msg.Note); err != nil {
+		return nil, err
+	}
+	s.audit(ctx, &v1.AuditEntry{Action: "annotate_snapshot", RepoId: req.Msg.RepoId, Details: fmt.Sprintf("set note of snapshot %v", req.Msg.SnapshotId)})
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+func (s *BackrestHandler) PinSnapshot(ctx context.Context, req *connect.Request[v1.PinSnapshotRequest]) (*connect.Response[types.StringValue], error) {
+	if req.Msg.RepoId == "" || req.Msg.SnapshotId == "" {
+		return nil, errors.New("must specify repoId and snapshotId")
+	}
+	id, err := s.orchestrator.PinSnapshot(ctx, req.Msg.RepoId, req.Msg.SnapshotId, req.Msg.Pinned)
+	if err != nil {
+		return nil, err
+	}
+	action := "pin_snapshot"
+	if !req.Msg.Pinned {
+		action = "unpin_snapshot"
+	}
+	s.audit(ctx, &v1.AuditEntry{Action: action, RepoId: req.Msg.RepoId, Details: fmt.Sprintf("snapshot %v is now %v", req.Msg.SnapshotId, id)})
+	return connect.NewResponse(&types.StringValue{Value: id}), nil
+}
+
+func (s *BackrestHandler) RescueSnapshot(ctx context.Context, req *connect.Request[v1.RescueSnapshotRequest]) (*connect.Response[types.StringValue], error) {
+	if req.Msg.RepoId == "" || req.Msg.SnapshotId == "" {
+		return nil, errors.New("must specify repoId and snapshotId")
+	}
+	id, err := s.orchestrator.RescueSnapshot(ctx, req.Msg.RepoId, req.Msg.SnapshotId)
+	if err != nil {
+		return nil, err
+	}
+	s.audit(ctx, &v1.AuditEntry{Action: "rescue_snapshot", RepoId: req.Msg.RepoId, Details: fmt.Sprintf("rescued snapshot %v from deletion, it is now pinned as %v", req.Msg.SnapshotId, id)})
+	return connect.NewResponse(&types.StringValue{Value: id}), nil
+}
+
+func (s *BackrestHandler) EmergencyStop(ctx context.Context, req *connect.Request[types.StringValue]) (*connect.Response[v1.Config], error) {
+	stop := &v1.EmergencyStop{
+		UnixTimeMs: time.Now().UnixMilli(),
+		Reason:     req.Msg.Value,
+	}
+	if user, ok := ctx.Value(auth.UserContextKey).(*v1.User); ok {
+		stop.User = user.GetName()
+	}
+	cfg, err := s.setEmergencyStop(stop)
+	if err != nil {
+		return nil, err
+	}
+	s.audit(ctx, &v1.AuditEntry{Action: "emergency_stop", Details: fmt.Sprintf("emergency stop, cancelled all tasks and paused all schedules: %v", req.Msg.Value)})
+	return connect.NewResponse(cfg), nil
+}
+
+func (s *BackrestHandler) ResumeSchedules(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[v1.Config], error) {
+	existing, err := s.config.Get()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get config: %w", err)
+	}
+	if existing.EmergencyStop == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("no emergency stop in effect"))
+	}
+	cfg, err := s.setEmergencyStop(nil)
+	if err != nil {
+		return nil, err
+	}
+	s.audit(ctx, &v1.AuditEntry{Action: "resume_schedules", Details: fmt.Sprintf("lifted the emergency stop triggered at %v", time.UnixMilli(existing.EmergencyStop.UnixTimeMs).Format(time.RFC3339))})
+	return connect.NewResponse(cfg), nil
+}
+
+// setEmergencyStop writes the emergency stop state to the config so it persists across restarts and applies it.
+func (s *BackrestHandler) setEmergencyStop(stop *v1.EmergencyStop) (*v1.Config, error) {
+	cfg, err := s.config.Get()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get config: %w", err)
+	}
+	cfg = proto.Clone(cfg).(*v1.Config)
+	cfg.EmergencyStop = stop
+	cfg.Modno += 1
+	if err := s.config.Update(cfg); err != nil {
+		return nil, fmt.Errorf("failed to update config: %w", err)
+	}
+	if err := s.orchestrator.ApplyConfig(cfg); err != nil {
+		return nil, fmt.Errorf("failed to apply config: %w", err)
+	}
+	return cfg, nil
+}
+
 func (s *BackrestHandler) Prune(ctx context.Context, req *connect.Request[types.StringValue]) (*connect.Response[emptypb.Empty], error) {
 	plan, err := s.orchestrator.GetPlan(req.Msg.Value)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get plan %q: %w", req.Msg.Value, err)
 	}
 
+	s.audit(ctx, &v1.AuditEntry{Action: "prune", RepoId: plan.Repo, PlanId: plan.Id, Details: "triggered prune"})
+
 	at := time.Now()
 	var wg sync.WaitGroup
 	wg.Add(1)
@@ -353,12 +833,36 @@ func (s *BackrestHandler) Restore(ctx context.Context, req *connect.Request[v1.R
 		req.Msg.Path = "/"
 	}
 
-	target := path.Join(req.Msg.Target, fmt.Sprintf("restic-restore-%v", time.Now().Format("2006-01-02T15-04-05")))
-	_, err := os.Stat(target)
-	if !errors.Is(err, os.ErrNotExist) {
-		return nil, fmt.Errorf("restore target dir %q already exists", req.Msg.Target)
+	repo, err := s.orchestrator.GetRepo(req.Msg.RepoId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repo %q: %w", req.Msg.RepoId, err)
+	}
+	if err := s.orchestrator.RequireWarm(repo.Config(), "restore"); err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
 	}
 
+	var target string
+	if req.Msg.Options.GetRestoreToOriginal() {
+		if runtime.GOOS == "windows" {
+			return nil, errors.New("restoring to the original location is not supported on windows")
+		}
+		// snapshot paths are absolute, restic recreates them beneath the target.
+		target = "/"
+	} else {
+		target = path.Join(req.Msg.Target, fmt.Sprintf("restic-restore-%v", time.Now().Format("2006-01-02T15-04-05")))
+		_, err := os.Stat(target)
+		if !errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("restore target dir %q already exists", req.Msg.Target)
+		}
+	}
+
+	s.audit(ctx, &v1.AuditEntry{
+		Action:  "restore",
+		RepoId:  req.Msg.RepoId,
+		PlanId:  req.Msg.PlanId,
+		Details: fmt.Sprintf("restore %q from snapshot %v to %q", req.Msg.Path, req.Msg.SnapshotId, target),
+	})
+
 	at := time.Now()
 
 	s.orchestrator.ScheduleTask(orchestrator.NewOneoffRestoreTask(s.orchestrator, orchestrator.RestoreTaskOpts{
@@ -367,6 +871,7 @@ func (s *BackrestHandler) Restore(ctx context.Context, req *connect.Request[v1.R
 		SnapshotId: req.Msg.SnapshotId,
 		Path:       req.Msg.Path,
 		Target:     target,
+		Options:    req.Msg.Options,
 	}, at), orchestrator.TaskPriorityInteractive+orchestrator.TaskPriorityDefault)
 
 	return connect.NewResponse(&emptypb.Empty{}), nil
@@ -381,14 +886,419 @@ func (s *BackrestHandler) Unlock(ctx context.Context, req *connect.Request[types
 	if err := repo.Unlock(context.Background()); err != nil {
 		return nil, fmt.Errorf("failed to unlock repo %q: %w", req.Msg.Value, err)
 	}
+	s.audit(ctx, &v1.AuditEntry{Action: "unlock", RepoId: req.Msg.Value, Details: "unlocked repo"})
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+// staleLockTimeout matches the age after which restic itself considers a lock stale.
+const staleLockTimeout = 30 * time.Minute
+
+func (s *BackrestHandler) GetRepoHealth(ctx context.Context, req *connect.Request[v1.GetRepoHealthRequest]) (*connect.Response[v1.RepoHealth], error) {
+	repo, err := s.orchestrator.GetRepo(req.Msg.RepoId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repo %q: %w", req.Msg.RepoId, err)
+	}
+
+	health := &v1.RepoHealth{RepoId: req.Msg.RepoId}
+
+	if err := s.oplog.ForEachByRepo(req.Msg.RepoId, indexutil.Reversed(indexutil.CollectAll()), func(op *v1.Operation) error {
+		if op.Status == v1.OperationStatus_STATUS_PENDING || op.Status == v1.OperationStatus_STATUS_INPROGRESS {
+			return nil
+		}
+		switch op.Op.(type) {
+		case *v1.Operation_OperationBackup:
+			if health.LastBackup == nil {
+				health.LastBackup = op
+			}
+		case *v1.Operation_OperationPrune:
+			if health.LastPrune == nil {
+				health.LastPrune = op
+			}
+		case *v1.Operation_OperationStats:
+			if health.LastStats == nil && !op.GetOperationStats().GetSnapshotScoped() {
+				health.LastStats = op
+			}
+		}
+		if health.LastBackup != nil && health.LastPrune != nil && health.LastStats != nil {
+			return oplog.ErrStopIteration
+		}
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("failed to read operations for repo %q: %w", req.Msg.RepoId, err)
+	}
+
+	locks, err := repo.Locks(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, lock := range locks {
+		stale := time.Since(lock.Time) > staleLockTimeout
+		if stale {
+			health.StaleLockCount++
+		}
+		health.Locks = append(health.Locks, &v1.RepoLock{
+			Id:         lock.Id,
+			UnixTimeMs: lock.Time.UnixMilli(),
+			Exclusive:  lock.Exclusive,
+			Hostname:   lock.Hostname,
+			Username:   lock.Username,
+			Pid:        int64(lock.PID),
+			Stale:      stale,
+		})
+	}
+
+	stats, err := repo.Stats(ctx)
+	if err != nil {
+		return nil, err
+	}
+	health.RawDataSize = stats.TotalSize
+	health.RestoreSize = stats.RestoreSize
+	if health.RawDataSize > 0 {
+		health.DedupRatio = float64(health.RestoreSize) / float64(health.RawDataSize)
+	}
+
+	if req.Msg.Check {
+		var opts []restic.GenericOption
+		if req.Msg.ReadData {
+			if err := s.orchestrator.RequireWarm(repo.Config(), "check --read-data"); err != nil {
+				return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+			}
+			opts = append(opts, restic.WithFlags("--read-data"))
+		}
+		output, err := repo.Check(ctx, opts...)
+		health.Check = &v1.RepoCheckResult{
+			Ok:         err == nil,
+			Output:     output,
+			UnixTimeMs: time.Now().UnixMilli(),
+		}
+	}
+
+	if req.Msg.EstimateUnused {
+		estimate, err := repo.PruneEstimate(ctx)
+		if err != nil {
+			return nil, err
+		}
+		health.UnusedBytesEstimate = estimate.Bytes
+	}
+
+	return connect.NewResponse(health), nil
+}
+
+func (s *BackrestHandler) CheckOplogIntegrity(ctx context.Context, req *connect.Request[v1.CheckOplogIntegrityRequest]) (*connect.Response[v1.OplogIntegrityReport], error) {
+	report, err := s.orchestrator.CheckOplogIntegrity(req.Msg.Repair, req.Msg.RemoveOrphaned)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check oplog integrity: %w", err)
+	}
+	if report.Repaired || report.OrphansRemoved {
+		s.audit(ctx, &v1.AuditEntry{Action: "repair_oplog", Details: fmt.Sprintf("removed %d dangling index entries, quarantined %d corrupt operations, found %d orphaned operations (removed: %v)",
+			report.DanglingIndexEntries, len(report.CorruptOperations), len(report.OrphanedOperations), report.OrphansRemoved)})
+	}
+
+	return connect.NewResponse(&v1.OplogIntegrityReport{
+		DanglingIndexEntries: int32(report.DanglingIndexEntries),
+		CorruptOperationIds:  report.CorruptOperations,
+		OrphanedOperationIds: report.OrphanedOperations,
+		Repaired:             report.Repaired,
+		OrphansRemoved:       report.OrphansRemoved,
+	}), nil
+}
+
+func (s *BackrestHandler) ListRepoMigrations(ctx context.Context, req *connect.Request[types.StringValue]) (*connect.Response[types.StringList], error) {
+	repo, err := s.orchestrator.GetRepo(req.Msg.Value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repo %q: %w", req.Msg.Value, err)
+	}
+
+	migrations, err := repo.Migrations(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list migrations: %w", err)
+	}
+
+	names := make([]string, 0, len(migrations))
+	for _, m := range migrations {
+		names = append(names, m.Name)
+	}
+	return connect.NewResponse(&types.StringList{Values: names}), nil
+}
+
+func (s *BackrestHandler) GetRepoCacheStats(ctx context.Context, req *connect.Request[types.StringValue]) (*connect.Response[v1.RepoCacheStats], error) {
+	repo, err := s.orchestrator.GetRepo(req.Msg.Value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repo %q: %w", req.Msg.Value, err)
+	}
+
+	stats, err := repo.CacheStats(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cache stats: %w", err)
+	}
+	return connect.NewResponse(stats), nil
+}
+
+func (s *BackrestHandler) MigrateRepo(ctx context.Context, req *connect.Request[v1.MigrateRepoRequest]) (*connect.Response[emptypb.Empty], error) {
+	if _, err := s.orchestrator.GetRepo(req.Msg.RepoId); err != nil {
+		return nil, fmt.Errorf("failed to get repo %q: %w", req.Msg.RepoId, err)
+	}
+	if req.Msg.Migration == "" {
+		return nil, errors.New("migration is required")
+	}
+
+	s.audit(ctx, &v1.AuditEntry{Action: "migrate_repo", RepoId: req.Msg.RepoId, Details: fmt.Sprintf("triggered migration %q", req.Msg.Migration)})
+
+	var err error
+	var wg sync.WaitGroup
+	wg.Add(1)
+	s.orchestrator.ScheduleTask(orchestrator.NewOneoffMigrateTask(s.orchestrator, req.Msg.RepoId, req.Msg.Migration, time.Now()), orchestrator.TaskPriorityInteractive, func(e error) {
+		err = e
+		wg.Done()
+	})
+	wg.Wait()
+	if err != nil {
+		return nil, fmt.Errorf("failed to migrate repo %q: %w", req.Msg.RepoId, err)
+	}
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+func (s *BackrestHandler) WarmupRepo(ctx context.Context, req *connect.Request[types.StringValue]) (*connect.Response[emptypb.Empty], error) {
+	repo, err := s.orchestrator.GetRepo(req.Msg.Value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repo %q: %w", req.Msg.Value, err)
+	}
+	if repo.Config().GetColdStorage().GetWarmupCommand() == "" || !repo.Config().GetColdStorage().GetEnabled() {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("repo %q is not on cold storage with a warmup command", req.Msg.Value))
+	}
+
+	s.audit(ctx, &v1.AuditEntry{Action: "warmup_repo", RepoId: req.Msg.Value, Details: "triggered cold storage warmup"})
+
+	// warmups take as long as the storage takes to restore the objects, often hours, the warmup operation tracks it.
+	s.orchestrator.ScheduleTask(orchestrator.NewOneoffWarmupTask(s.orchestrator, req.Msg.Value, time.Now()), orchestrator.TaskPriorityInteractive)
 
 	return connect.NewResponse(&emptypb.Empty{}), nil
 }
 
+func (s *BackrestHandler) BenchmarkRepo(ctx context.Context, req *connect.Request[v1.BenchmarkRepoRequest]) (*connect.Response[emptypb.Empty], error) {
+	size := req.Msg.SizeBytes
+	if size == 0 {
+		size = orchestrator.DefaultBenchmarkBytes
+	}
+	if size < 0 || size > orchestrator.MaxBenchmarkBytes {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("size_bytes must be between 1 and %d", orchestrator.MaxBenchmarkBytes))
+	}
+	if _, err := s.orchestrator.GetRepo(req.Msg.RepoId); err != nil {
+		return nil, fmt.Errorf("failed to get repo %q: %w", req.Msg.RepoId, err)
+	}
+
+	s.audit(ctx, &v1.AuditEntry{Action: "benchmark_repo", RepoId: req.Msg.RepoId, Details: fmt.Sprintf("benchmark with %d bytes of test data", size)})
+
+	// the benchmark operation reports the results, uploading the test data to a slow backend can take minutes.
+	s.orchestrator.ScheduleTask(orchestrator.NewOneoffBenchmarkTask(s.orchestrator, req.Msg.RepoId, size, time.Now()), orchestrator.TaskPriorityInteractive)
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
+func (s *BackrestHandler) UpdateRestic(ctx context.Context, req *connect.Request[v1.UpdateResticRequest]) (*connect.Response[v1.ResticUpdateStatus], error) {
+	if !resticinstaller.IsManaged() {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("%w, update it where it is installed", resticinstaller.ErrNotManaged))
+	}
+	status := &v1.ResticUpdateStatus{CurrentVersion: resticinstaller.ActiveVersion()}
+	latest, err := resticinstaller.LatestVersion(ctx)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeUnavailable, fmt.Errorf("check for restic updates: %w", err))
+	}
+	status.LatestVersion = latest
+	status.UpdateAvailable = resticinstaller.CompareVersions(latest, status.CurrentVersion) > 0
+	if !req.Msg.Apply || !status.UpdateAvailable {
+		return connect.NewResponse(status), nil
+	}
+
+	bin, pin, err := resticinstaller.Install(ctx, latest)
+	if err != nil {
+		return nil, fmt.Errorf("update restic: %w", err)
+	}
+
+	// the pin is recorded before the binary is used, if it can't be the update is rolled back.
+	cfg, err := s.config.Get()
+	if err == nil {
+		cfg = proto.Clone(cfg).(*v1.Config)
+		cfg.ResticBinary = pin
+		cfg.Modno += 1
+		err = s.config.Update(cfg)
+	}
+	if err != nil {
+		if rmErr := resticinstaller.Uninstall(latest); rmErr != nil {
+			zap.S().Warnf("roll back restic update: %v", rmErr)
+		}
+		return nil, fmt.Errorf("failed to record restic %v in the config, keeping restic %v: %w", latest, status.CurrentVersion, err)
+	}
+	if err := s.orchestrator.ApplyConfig(cfg); err != nil {
+		return nil, fmt.Errorf("failed to apply config: %w", err)
+	}
+	resticinstaller.Activate(latest)
+	s.orchestrator.SetResticBinary(bin)
+
+	s.audit(ctx, &v1.AuditEntry{Action: "update_restic", Details: fmt.Sprintf("updated restic from %v to %v", status.CurrentVersion, latest)})
+	status.Updated = true
+	return connect.NewResponse(status), nil
+}
+
+func (s *BackrestHandler) GetRecoveryBundle(ctx context.Context, req *connect.Request[v1.GetRecoveryBundleRequest]) (*connect.Response[types.BytesValue], error) {
+	repo, err := s.orchestrator.GetRepo(req.Msg.RepoId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repo %q: %w", req.Msg.RepoId, err)
+	}
+	repoCfg := repo.Config()
+
+	opts := recovery.Options{PublicKey: req.Msg.PublicKey, Now: time.Now()}
+	if bin, err := resticinstaller.FindOrInstallResticBinary(); err == nil {
+		if version, err := restic.NewRepo(bin, repoCfg).Version(ctx); err == nil {
+			opts.ResticVersion = version
+		} else {
+			zap.S().Warnf("recovery bundle for repo %q: failed to get restic version: %v", repoCfg.Id, err)
+		}
+	}
+
+	bundle, err := recovery.Bundle(repoCfg, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate recovery bundle: %w", err)
+	}
+	s.audit(ctx, &v1.AuditEntry{Action: "export_recovery_bundle", RepoId: repoCfg.Id, Details: fmt.Sprintf("exported recovery bundle (password encrypted: %v)", req.Msg.PublicKey != "")})
+
+	return connect.NewResponse(&types.BytesValue{Value: bundle}), nil
+}
+
+func (s *BackrestHandler) GetNotificationTemplates(ctx context.Context, req *connect.Request[types.StringValue]) (*connect.Response[v1.Notifications], error) {
+	cfg, err := s.config.Get()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get config: %w", err)
+	}
+	notifications := proto.Clone(cfg.GetNotifications()).(*v1.Notifications)
+	if notifications == nil {
+		notifications = &v1.Notifications{}
+	}
+	if req.Msg.Value != "" {
+		notifications.Locale = req.Msg.Value
+	}
+	catalog, err := hook.NewCatalog(notifications)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	return connect.NewResponse(&v1.Notifications{Locale: catalog.Locale(), Templates: catalog.Templates()}), nil
+}
+
+func (s *BackrestHandler) GetRemoteStatus(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[v1.RemoteStatusList], error) {
+	statuses, err := s.remotes.StatusAll(ctx, s)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get remote status: %w", err)
+	}
+	return connect.NewResponse(&v1.RemoteStatusList{Instances: statuses}), nil
+}
+
+func (s *BackrestHandler) GetRemoteOperations(ctx context.Context, req *connect.Request[v1.GetRemoteOperationsRequest]) (*connect.Response[v1.OperationList], error) {
+	client, err := s.remotes.Client(req.Msg.InstanceId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+	opsReq := req.Msg.Request
+	if opsReq == nil {
+		opsReq = &v1.GetOperationsRequest{}
+	}
+	resp, err := client.GetOperations(ctx, connect.NewRequest(opsReq))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get operations from remote instance %q: %w", req.Msg.InstanceId, err)
+	}
+	return connect.NewResponse(resp.Msg), nil
+}
+
+const (
+	exclusionSuggestionBackups = 10 // recent backups of a plan that exclusion suggestions are computed from.
+	exclusionSuggestionCount   = 20
+)
+
+func (s *BackrestHandler) GetRuns(ctx context.Context, req *connect.Request[v1.GetRunsRequest]) (*connect.Response[v1.RunList], error) {
+	var ops []*v1.Operation
+	opCollector := func(op *v1.Operation) error {
+		ops = append(ops, op)
+		return nil
+	}
+
+	var err error
+	switch {
+	case req.Msg.PlanId != "":
+		err = s.oplog.ForEachByPlan(req.Msg.PlanId, indexutil.CollectAll(), opCollector)
+	case req.Msg.RepoId != "":
+		err = s.oplog.ForEachByRepo(req.Msg.RepoId, indexutil.CollectAll(), opCollector)
+	default:
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("one of plan_id or repo_id is required"))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get operations: %w", err)
+	}
+
+	runs := oplog.GroupRuns(ops)
+	if req.Msg.LastN > 0 && len(runs) > int(req.Msg.LastN) {
+		runs = runs[len(runs)-int(req.Msg.LastN):]
+	}
+	return connect.NewResponse(&v1.RunList{Runs: runs}), nil
+}
+
+func (s *BackrestHandler) GetDurationEstimates(ctx context.Context, req *connect.Request[types.StringValue]) (*connect.Response[v1.DurationEstimateList], error) {
+	if req.Msg.Value == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("plan id is required"))
+	}
+	estimates, err := s.oplog.DurationEstimates(req.Msg.Value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to estimate durations: %w", err)
+	}
+	return connect.NewResponse(&v1.DurationEstimateList{Estimates: estimates}), nil
+}
+
+func (s *BackrestHandler) GetResourceUsage(ctx context.Context, req *connect.Request[types.StringValue]) (*connect.Response[v1.ResourceUsageSummaryList], error) {
+	if req.Msg.Value == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("plan id is required"))
+	}
+	summaries, err := s.oplog.ResourceUsage(req.Msg.Value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to total resource usage: %w", err)
+	}
+	return connect.NewResponse(&v1.ResourceUsageSummaryList{Summaries: summaries}), nil
+}
+
+func (s *BackrestHandler) GetMetricSeries(ctx context.Context, req *connect.Request[v1.GetMetricSeriesRequest]) (*connect.Response[v1.MetricSeriesList], error) {
+	if req.Msg.PlanId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("plan id is required"))
+	}
+	return connect.NewResponse(&v1.MetricSeriesList{Series: s.metrics.Query(req.Msg.PlanId, req.Msg.Metrics, req.Msg.SinceUnixTimeMs)}), nil
+}
+
+func (s *BackrestHandler) GetExclusionSuggestions(ctx context.Context, req *connect.Request[types.StringValue]) (*connect.Response[v1.ExclusionSuggestionList], error) {
+	if _, err := s.orchestrator.GetPlan(req.Msg.Value); err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+
+	var backups []*v1.OperationBackup
+	if err := s.oplog.ForEachByPlan(req.Msg.Value, indexutil.CollectAll(), func(op *v1.Operation) error {
+		if backup := op.GetOperationBackup(); backup != nil {
+			backups = append(backups, backup)
+		}
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("failed to get operations: %w", err)
+	}
+	if len(backups) > exclusionSuggestionBackups {
+		backups = backups[len(backups)-exclusionSuggestionBackups:]
+	}
+
+	return connect.NewResponse(&v1.ExclusionSuggestionList{
+		Suggestions: orchestrator.SuggestExclusions(backups, exclusionSuggestionCount),
+	}), nil
+}
+
 func (s *BackrestHandler) Cancel(ctx context.Context, req *connect.Request[types.Int64Value]) (*connect.Response[emptypb.Empty], error) {
 	if err := s.orchestrator.CancelOperation(req.Msg.Value, v1.OperationStatus_STATUS_USER_CANCELLED); err != nil {
 		return nil, err
 	}
+	s.audit(ctx, &v1.AuditEntry{Action: "cancel", Details: fmt.Sprintf("cancelled operation %v", req.Msg.Value)})
 
 	return connect.NewResponse(&emptypb.Empty{}), nil
 }
@@ -423,10 +1333,37 @@ func (s *BackrestHandler) ClearHistory(ctx context.Context, req *connect.Request
 	if err := s.oplog.Delete(ids...); err != nil {
 		return nil, fmt.Errorf("failed to delete operations: %w", err)
 	}
+	s.audit(ctx, &v1.AuditEntry{Action: "clear_history", RepoId: req.Msg.RepoId, PlanId: req.Msg.PlanId, Details: fmt.Sprintf("deleted %d operations", len(ids))})
 
 	return connect.NewResponse(&emptypb.Empty{}), err
 }
 
+func (s *BackrestHandler) AcknowledgeOperation(ctx context.Context, req *connect.Request[v1.AcknowledgeOperationRequest]) (*connect.Response[v1.Operation], error) {
+	op, err := s.oplog.Get(req.Msg.OperationId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("operation %d: %w", req.Msg.OperationId, err))
+	}
+	if op.Status != v1.OperationStatus_STATUS_ERROR && op.Status != v1.OperationStatus_STATUS_WARNING {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("operation %d has status %v, only failures can be acknowledged", op.Id, op.Status))
+	}
+
+	details := fmt.Sprintf("acknowledged operation %d", op.Id)
+	if req.Msg.Unacknowledge {
+		op.Acknowledgement = nil
+		details = fmt.Sprintf("removed the acknowledgement of operation %d", op.Id)
+	} else {
+		op.Acknowledgement = &v1.OperationAcknowledgement{UnixTimeMs: time.Now().UnixMilli(), Note: req.Msg.Note}
+		if user, ok := ctx.Value(auth.UserContextKey).(*v1.User); ok {
+			op.Acknowledgement.User = user.GetName()
+		}
+	}
+	if err := s.oplog.Update(op); err != nil {
+		return nil, fmt.Errorf("failed to update operation: %w", err)
+	}
+	s.audit(ctx, &v1.AuditEntry{Action: "acknowledge_operation", RepoId: op.RepoId, PlanId: op.PlanId, Details: details})
+	return connect.NewResponse(op), nil
+}
+
 func (s *BackrestHandler) GetLogs(ctx context.Context, req *connect.Request[v1.LogDataRequest]) (*connect.Response[types.BytesValue], error) {
 	data, err := s.logStore.Read(req.Msg.GetRef())
 	if err != nil {
@@ -456,3 +1393,41 @@ func (s *BackrestHandler) PathAutocomplete(ctx context.Context, path *connect.Re
 
 	return connect.NewResponse(&types.StringList{Values: paths}), nil
 }
+
+func (s *BackrestHandler) GetAuditLog(ctx context.Context, req *connect.Request[v1.GetAuditLogRequest]) (*connect.Response[v1.AuditEntryList], error) {
+	entries, err := s.queryAuditLog(req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&v1.AuditEntryList{Entries: entries}), nil
+}
+
+func (s *BackrestHandler) ExportAuditLog(ctx context.Context, req *connect.Request[v1.GetAuditLogRequest]) (*connect.Response[types.BytesValue], error) {
+	entries, err := s.queryAuditLog(req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	data, err := auditlog.Export(entries)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export audit log: %w", err)
+	}
+	return connect.NewResponse(&types.BytesValue{Value: data}), nil
+}
+
+func (s *BackrestHandler) queryAuditLog(q *v1.GetAuditLogRequest) ([]*v1.AuditEntry, error) {
+	entries, err := s.auditLog.Query(func(e *v1.AuditEntry) bool {
+		return (q.SinceUnixMs == 0 || e.UnixTimeMs >= q.SinceUnixMs) &&
+			(q.UntilUnixMs == 0 || e.UnixTimeMs < q.UntilUnixMs) &&
+			(q.User == "" || e.User == q.User) &&
+			(q.Action == "" || e.Action == q.Action) &&
+			(q.RepoId == "" || e.RepoId == q.RepoId) &&
+			(q.PlanId == "" || e.PlanId == q.PlanId)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to query audit log: %w", err)
+	}
+	if q.LastN > 0 && int64(len(entries)) > q.LastN {
+		entries = entries[int64(len(entries))-q.LastN:]
+	}
+	return entries, nil
+}
@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 	"io/fs"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path"
 	"path/filepath"
@@ -17,13 +19,20 @@ import (
 	"connectrpc.com/connect"
 	"github.com/garethgeorge/backrest/gen/go/types"
 	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/gen/go/v1/v1connect"
+	"github.com/garethgeorge/backrest/internal/auditlog"
+	"github.com/garethgeorge/backrest/internal/auth"
 	"github.com/garethgeorge/backrest/internal/config"
+	"github.com/garethgeorge/backrest/internal/metrics"
 	"github.com/garethgeorge/backrest/internal/oplog"
 	"github.com/garethgeorge/backrest/internal/orchestrator"
 	"github.com/garethgeorge/backrest/internal/resticinstaller"
 	"github.com/garethgeorge/backrest/internal/rotatinglog"
+	"github.com/garethgeorge/backrest/pkg/restic"
+	"github.com/garethgeorge/backrest/test/helpers"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 func TestUpdateConfig(t *testing.T) {
@@ -85,6 +94,132 @@ func TestUpdateConfig(t *testing.T) {
 	}
 }
 
+func TestRollbackConfig(t *testing.T) {
+	t.Parallel()
+
+	store := &config.HistoryStore{
+		ConfigStore: &config.MemoryStore{
+			Config: &v1.Config{
+				Modno: 1,
+				Host:  "original",
+			},
+		},
+		Dir: t.TempDir(),
+	}
+	sut := createSystemUnderTest(t, store)
+
+	if _, err := sut.handler.SetConfig(context.Background(), connect.NewRequest(&v1.Config{Modno: 1, Host: "bad edit"})); err != nil {
+		t.Fatalf("SetConfig() error: %v", err)
+	}
+
+	history, err := sut.handler.GetConfigHistory(context.Background(), connect.NewRequest(&emptypb.Empty{}))
+	if err != nil {
+		t.Fatalf("GetConfigHistory() error: %v", err)
+	}
+	if len(history.Msg.Revisions) != 2 {
+		t.Fatalf("want 2 revisions, got %d", len(history.Msg.Revisions))
+	}
+	if !strings.Contains(history.Msg.Revisions[1].Diff, "bad edit") {
+		t.Errorf("want diff of revision 2 to contain the edit, got %q", history.Msg.Revisions[1].Diff)
+	}
+
+	res, err := sut.handler.RollbackConfig(context.Background(), connect.NewRequest(&types.Int64Value{Value: history.Msg.Revisions[0].Revision}))
+	if err != nil {
+		t.Fatalf("RollbackConfig() error: %v", err)
+	}
+	if res.Msg.Host != "original" {
+		t.Errorf("want host %q after rollback, got %q", "original", res.Msg.Host)
+	}
+	if res.Msg.Modno != 3 {
+		t.Errorf("want modno 3 after rollback, got %d", res.Msg.Modno)
+	}
+
+	if _, err := sut.handler.RollbackConfig(context.Background(), connect.NewRequest(&types.Int64Value{Value: 100})); connect.CodeOf(err) != connect.CodeNotFound {
+		t.Errorf("want not found for unknown revision, got %v", err)
+	}
+}
+
+func TestConfigKeepsResticPin(t *testing.T) {
+	t.Parallel()
+
+	pinned := &v1.ResticBinary{Version: "0.16.4", Sha256: "abcd"}
+	store := &config.HistoryStore{
+		ConfigStore: &config.MemoryStore{
+			Config: &v1.Config{
+				Modno:        1,
+				ResticBinary: pinned,
+			},
+		},
+		Dir: t.TempDir(),
+	}
+	sut := createSystemUnderTest(t, store)
+
+	res, err := sut.handler.SetConfig(context.Background(), connect.NewRequest(&v1.Config{Modno: 1, Host: "edit", ResticBinary: &v1.ResticBinary{Version: "0.16.4", Sha256: "ffff"}}))
+	if err != nil {
+		t.Fatalf("SetConfig() error: %v", err)
+	}
+	if !proto.Equal(res.Msg.ResticBinary, pinned) {
+		t.Errorf("want SetConfig to keep the restic pin %v, got %v", pinned, res.Msg.ResticBinary)
+	}
+
+	// an update of restic pins the new binary, rolling back the config must not restore the old pin.
+	updated := proto.Clone(res.Msg).(*v1.Config)
+	updated.ResticBinary = &v1.ResticBinary{Version: "0.17.0", Sha256: "1234"}
+	updated.Modno += 1
+	if err := store.Update(updated); err != nil {
+		t.Fatalf("Update() error: %v", err)
+	}
+	history, err := sut.handler.GetConfigHistory(context.Background(), connect.NewRequest(&emptypb.Empty{}))
+	if err != nil {
+		t.Fatalf("GetConfigHistory() error: %v", err)
+	}
+	res, err = sut.handler.RollbackConfig(context.Background(), connect.NewRequest(&types.Int64Value{Value: history.Msg.Revisions[0].Revision}))
+	if err != nil {
+		t.Fatalf("RollbackConfig() error: %v", err)
+	}
+	if !proto.Equal(res.Msg.ResticBinary, updated.ResticBinary) {
+		t.Errorf("want RollbackConfig to keep the restic pin %v, got %v", updated.ResticBinary, res.Msg.ResticBinary)
+	}
+}
+
+func TestRollbackKeepsAuth(t *testing.T) {
+	t.Parallel()
+
+	store := &config.HistoryStore{
+		ConfigStore: &config.MemoryStore{
+			Config: &v1.Config{
+				Modno: 1,
+				Auth: &v1.Auth{
+					Users: []*v1.User{{Name: "test", Password: &v1.User_PasswordBcrypt{PasswordBcrypt: "old"}}},
+				},
+			},
+		},
+		Dir: t.TempDir(),
+	}
+	sut := createSystemUnderTest(t, store)
+
+	// enrolling a second factor and changing the password update the config, a rollback must not undo either.
+	enrolled, _ := store.Get()
+	enrolled = proto.Clone(enrolled).(*v1.Config)
+	enrolled.Auth.Users[0].Password = &v1.User_PasswordBcrypt{PasswordBcrypt: "new"}
+	enrolled.Auth.Users[0].SecondFactor = &v1.SecondFactor{TotpSecret: "secret", RecoveryCodesBcrypt: []string{"code"}}
+	enrolled.Modno += 1
+	if err := store.Update(enrolled); err != nil {
+		t.Fatalf("Update() error: %v", err)
+	}
+	history, err := sut.handler.GetConfigHistory(context.Background(), connect.NewRequest(&emptypb.Empty{}))
+	if err != nil {
+		t.Fatalf("GetConfigHistory() error: %v", err)
+	}
+	res, err := sut.handler.RollbackConfig(context.Background(), connect.NewRequest(&types.Int64Value{Value: history.Msg.Revisions[0].Revision}))
+	if err != nil {
+		t.Fatalf("RollbackConfig() error: %v", err)
+	}
+	if !proto.Equal(res.Msg.Auth, enrolled.Auth) {
+		t.Errorf("want RollbackConfig to keep the users and their second factors %v, got %v", enrolled.Auth, res.Msg.Auth)
+	}
+}
+
 func TestBackup(t *testing.T) {
 	t.Parallel()
 
@@ -173,6 +308,323 @@ func TestBackup(t *testing.T) {
 	}
 }
 
+func TestAdhocBackup(t *testing.T) {
+	t.Parallel()
+
+	sut := createSystemUnderTest(t, &config.MemoryStore{
+		Config: &v1.Config{
+			Modno: 1234,
+			Repos: []*v1.Repo{
+				{
+					Id:       "local",
+					Uri:      t.TempDir(),
+					Password: "test",
+				},
+			},
+		},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		sut.orch.Run(ctx)
+	}()
+
+	if _, err := sut.handler.AdhocBackup(context.Background(), connect.NewRequest(&v1.AdhocBackupRequest{
+		RepoId: "local",
+		Paths:  []string{"relative/path"},
+	})); connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("AdhocBackup() with a relative path: want invalid argument, got %v", err)
+	}
+
+	_, err := sut.handler.AdhocBackup(context.Background(), connect.NewRequest(&v1.AdhocBackupRequest{
+		RepoId: "local",
+		Paths:  []string{t.TempDir()},
+		Tags:   []string{"pre-upgrade"},
+	}))
+	if err != nil {
+		t.Fatalf("AdhocBackup() error = %v", err)
+	}
+
+	// Wait for the ad-hoc snapshot to be indexed with its tag.
+	if err := retry(t, 10, 2*time.Second, func() error {
+		operations := getOperations(t, sut.oplog)
+		if slices.IndexFunc(operations, func(op *v1.Operation) bool {
+			index := op.GetOperationIndexSnapshot()
+			return index != nil && op.PlanId == "_adhoc_" && slices.Contains(index.Snapshot.Tags, "pre-upgrade")
+		}) != -1 {
+			return nil
+		}
+		return errors.New("snapshot not indexed")
+	}); err != nil {
+		t.Fatalf("Couldn't find the ad-hoc snapshot in oplog")
+	}
+}
+
+func TestChainedBackup(t *testing.T) {
+	t.Parallel()
+
+	sut := createSystemUnderTest(t, &config.MemoryStore{
+		Config: &v1.Config{
+			Modno: 1234,
+			Repos: []*v1.Repo{
+				{
+					Id:       "local",
+					Uri:      t.TempDir(),
+					Password: "test",
+				},
+			},
+			Plans: []*v1.Plan{
+				{
+					Id:    "first",
+					Repo:  "local",
+					Paths: []string{t.TempDir()},
+					Cron:  "0 0 1 1 *",
+				},
+				{
+					Id:           "second",
+					Repo:         "local",
+					Paths:        []string{t.TempDir()},
+					Dependencies: &v1.PlanDependencies{After: []string{"first"}, Requires: []string{"first"}},
+				},
+			},
+		},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		sut.orch.Run(ctx)
+	}()
+
+	if _, err := sut.handler.Backup(context.Background(), connect.NewRequest(&types.StringValue{Value: "first"})); err != nil {
+		t.Fatalf("Backup() error = %v", err)
+	}
+
+	// Wait for the chained backup to complete as part of the first backup's run.
+	if err := retry(t, 10, 2*time.Second, func() error {
+		operations := getOperations(t, sut.oplog)
+		first := slices.IndexFunc(operations, func(op *v1.Operation) bool {
+			return op.PlanId == "first" && op.GetOperationBackup() != nil && op.Status == v1.OperationStatus_STATUS_SUCCESS
+		})
+		second := slices.IndexFunc(operations, func(op *v1.Operation) bool {
+			return op.PlanId == "second" && op.GetOperationBackup() != nil && op.Status == v1.OperationStatus_STATUS_SUCCESS
+		})
+		if first == -1 || second == -1 {
+			return errors.New("chained backup not complete")
+		}
+		if operations[second].RunId != operations[first].RunId {
+			t.Fatalf("want the chained backup in run %d, got %d", operations[first].RunId, operations[second].RunId)
+		}
+		return nil
+	}); err != nil {
+		t.Fatalf("Couldn't find the chained backup in oplog")
+	}
+}
+
+func TestBackupToAdditionalRepos(t *testing.T) {
+	t.Parallel()
+
+	sut := createSystemUnderTest(t, &config.MemoryStore{
+		Config: &v1.Config{
+			Modno: 1234,
+			Repos: []*v1.Repo{
+				{
+					Id:       "local",
+					Uri:      t.TempDir(),
+					Password: "test",
+				},
+				{
+					Id:       "offsite",
+					Uri:      t.TempDir(),
+					Password: "test",
+				},
+			},
+			Plans: []*v1.Plan{
+				{
+					Id:              "test",
+					Repo:            "local",
+					AdditionalRepos: []string{"offsite"},
+					Paths:           []string{t.TempDir()},
+					Cron:            "0 0 1 1 *",
+				},
+				{
+					Id:           "after",
+					Repo:         "local",
+					Paths:        []string{t.TempDir()},
+					Dependencies: &v1.PlanDependencies{After: []string{"test"}},
+				},
+			},
+		},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		sut.orch.Run(ctx)
+	}()
+
+	if _, err := sut.handler.Backup(context.Background(), connect.NewRequest(&types.StringValue{Value: "test"})); err != nil {
+		t.Fatalf("Backup() error = %v", err)
+	}
+
+	// Wait for the backups to both repos and the dependent plan's backup to complete in one run.
+	if err := retry(t, 10, 2*time.Second, func() error {
+		operations := getOperations(t, sut.oplog)
+		backup := func(planId, repoId string) int {
+			return slices.IndexFunc(operations, func(op *v1.Operation) bool {
+				return op.PlanId == planId && op.RepoId == repoId && op.GetOperationBackup() != nil && op.Status == v1.OperationStatus_STATUS_SUCCESS
+			})
+		}
+		local, offsite, after := backup("test", "local"), backup("test", "offsite"), backup("after", "local")
+		if local == -1 || offsite == -1 || after == -1 {
+			return errors.New("backups not complete")
+		}
+		if operations[offsite].RunId != operations[local].RunId || operations[after].RunId != operations[local].RunId {
+			t.Fatalf("want the backups in run %d, got %d and %d", operations[local].RunId, operations[offsite].RunId, operations[after].RunId)
+		}
+		if operations[after].UnixTimeStartMs < operations[offsite].UnixTimeEndMs {
+			t.Fatalf("want the dependent plan backed up after the backup to the additional repo, it started at %d before %d", operations[after].UnixTimeStartMs, operations[offsite].UnixTimeEndMs)
+		}
+		return nil
+	}); err != nil {
+		t.Fatalf("Couldn't find the backups to both repos in oplog")
+	}
+
+	var dependents int
+	for _, op := range getOperations(t, sut.oplog) {
+		if op.PlanId == "after" && op.GetOperationBackup() != nil {
+			dependents++
+		}
+	}
+	if dependents != 1 {
+		t.Errorf("want the dependent plan to be backed up once, got %d backups", dependents)
+	}
+}
+
+func TestImportRepo(t *testing.T) {
+	t.Parallel()
+
+	repoConfig := &v1.Repo{
+		Id:       "existing",
+		Uri:      t.TempDir(),
+		Password: "test",
+	}
+
+	// create a repo with a snapshot taken outside of backrest.
+	r := restic.NewRepo(helpers.ResticBinary(t), repoConfig, restic.WithFlags("--no-cache"))
+	if err := r.Init(context.Background()); err != nil {
+		t.Fatalf("failed to init repo: %v", err)
+	}
+	if _, err := r.Backup(context.Background(), nil, restic.WithBackupPaths(helpers.CreateTestData(t)), restic.WithBackupTags("manual", "daily")); err != nil {
+		t.Fatalf("failed to backup: %v", err)
+	}
+
+	sut := createSystemUnderTest(t, &config.MemoryStore{
+		Config: &v1.Config{
+			Modno: 1234,
+		},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		sut.orch.Run(ctx)
+	}()
+
+	if _, err := sut.handler.ImportRepo(context.Background(), connect.NewRequest(&v1.Repo{Id: "missing", Uri: t.TempDir(), Password: "test"})); err == nil {
+		t.Errorf("ImportRepo() should fail for a repo that does not exist")
+	}
+
+	res, err := sut.handler.ImportRepo(context.Background(), connect.NewRequest(repoConfig))
+	if err != nil {
+		t.Fatalf("ImportRepo() error = %v", err)
+	}
+	if len(res.Msg.Repos) != 1 || !res.Msg.Repos[0].GroupUntrackedSnapshots {
+		t.Errorf("want imported repo with grouping enabled, got %v", res.Msg.Repos)
+	}
+
+	hostname, _ := os.Hostname()
+	ops, err := sut.handler.GetOperations(context.Background(), connect.NewRequest(&v1.GetOperationsRequest{PlanId: "_imported_:" + hostname + ":daily,manual"}))
+	if err != nil {
+		t.Fatalf("GetOperations() error = %v", err)
+	}
+	if len(ops.Msg.Operations) != 1 || ops.Msg.Operations[0].GetOperationIndexSnapshot() == nil {
+		t.Fatalf("want one index snapshot operation in the imported group, got %v", ops.Msg.Operations)
+	}
+}
+
+func TestInitRepo(t *testing.T) {
+	t.Parallel()
+
+	sut := createSystemUnderTest(t, &config.MemoryStore{
+		Config: &v1.Config{
+			Modno: 1234,
+		},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		sut.orch.Run(ctx)
+	}()
+
+	password, err := sut.handler.GenerateRepoPassword(context.Background(), connect.NewRequest(&emptypb.Empty{}))
+	if err != nil || len(password.Msg.Value) != 32 {
+		t.Fatalf("GenerateRepoPassword() = %v, %v, want a 32 character password", password, err)
+	}
+	repo := &v1.Repo{
+		Id:          "new",
+		Uri:         filepath.Join(t.TempDir(), "repo"),
+		Password:    password.Msg.Value,
+		Compression: "max",
+	}
+
+	probe, err := sut.handler.ProbeRepo(context.Background(), connect.NewRequest(repo))
+	if err != nil || probe.Msg.Exists {
+		t.Fatalf("ProbeRepo() = %v, %v, want no repo before init", probe, err)
+	}
+
+	// a failed init removes the directory it created.
+	failing := proto.Clone(repo).(*v1.Repo)
+	failing.Id = "failing"
+	failing.Flags = []string{"--no-such-flag"}
+	if _, err := sut.handler.InitRepo(context.Background(), connect.NewRequest(&v1.InitRepoRequest{Repo: failing})); err == nil {
+		t.Fatalf("InitRepo() should fail with an invalid flag")
+	}
+	if _, err := os.Stat(repo.Uri); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("want the failed init rolled back, stat: %v", err)
+	}
+
+	res, err := sut.handler.InitRepo(context.Background(), connect.NewRequest(&v1.InitRepoRequest{Repo: repo, RepositoryVersion: 2}))
+	if err != nil {
+		t.Fatalf("InitRepo() error = %v", err)
+	}
+	if len(res.Msg.Repos) != 1 || res.Msg.Repos[0].Id != "new" {
+		t.Errorf("want the repo added to the config, got %v", res.Msg.Repos)
+	}
+
+	probe, err = sut.handler.ProbeRepo(context.Background(), connect.NewRequest(repo))
+	if err != nil || !probe.Msg.Exists || !probe.Msg.PasswordAccepted || probe.Msg.Version != 2 || probe.Msg.ResticRepoId == "" {
+		t.Errorf("ProbeRepo() = %v, %v, want an opened version 2 repo", probe, err)
+	}
+	wrongPassword := proto.Clone(repo).(*v1.Repo)
+	wrongPassword.Password = "wrong"
+	probe, err = sut.handler.ProbeRepo(context.Background(), connect.NewRequest(wrongPassword))
+	if err != nil || !probe.Msg.Exists || probe.Msg.PasswordAccepted {
+		t.Errorf("ProbeRepo() = %v, %v, want an existing repo the password doesn't open", probe, err)
+	}
+
+	other := proto.Clone(repo).(*v1.Repo)
+	other.Id = "other"
+	if _, err := sut.handler.InitRepo(context.Background(), connect.NewRequest(&v1.InitRepoRequest{Repo: other})); connect.CodeOf(err) != connect.CodeAlreadyExists {
+		t.Errorf("InitRepo() of an existing repo = %v, want AlreadyExists", err)
+	}
+	if _, err := os.Stat(filepath.Join(repo.Uri, "config")); err != nil {
+		t.Errorf("want the existing repo kept: %v", err)
+	}
+}
+
 func TestMultipleBackup(t *testing.T) {
 	t.Parallel()
 
@@ -525,6 +977,203 @@ func TestRestore(t *testing.T) {
 	}
 }
 
+func TestGetRepoHealth(t *testing.T) {
+	t.Parallel()
+
+	sut := createSystemUnderTest(t, &config.MemoryStore{
+		Config: &v1.Config{
+			Modno: 1234,
+			Repos: []*v1.Repo{
+				{
+					Id:       "local",
+					Uri:      t.TempDir(),
+					Password: "test",
+				},
+			},
+			Plans: []*v1.Plan{
+				{
+					Id:    "test",
+					Repo:  "local",
+					Paths: []string{helpers.CreateTestData(t)},
+					Cron:  "0 0 1 1 *",
+					Retention: &v1.RetentionPolicy{
+						KeepHourly: 1,
+					},
+				},
+			},
+		},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		sut.orch.Run(ctx)
+	}()
+
+	if _, err := sut.handler.Backup(context.Background(), connect.NewRequest(&types.StringValue{Value: "test"})); err != nil {
+		t.Fatalf("Backup() error = %v", err)
+	}
+
+	res, err := sut.handler.GetRepoHealth(context.Background(), connect.NewRequest(&v1.GetRepoHealthRequest{
+		RepoId:         "local",
+		Check:          true,
+		EstimateUnused: true,
+	}))
+	if err != nil {
+		t.Fatalf("GetRepoHealth() error = %v", err)
+	}
+	health := res.Msg
+
+	if health.LastBackup.GetStatus() != v1.OperationStatus_STATUS_SUCCESS {
+		t.Errorf("want last successful backup, got %v", health.LastBackup)
+	}
+	if !health.Check.GetOk() {
+		t.Errorf("want check to pass, got output %q", health.Check.GetOutput())
+	}
+	if len(health.Locks) != 0 || health.StaleLockCount != 0 {
+		t.Errorf("want no locks, got %v", health.Locks)
+	}
+	if health.RawDataSize == 0 || health.RestoreSize == 0 {
+		t.Errorf("want non-zero sizes, got raw %d, restore %d", health.RawDataSize, health.RestoreSize)
+	}
+	if want := float64(health.RestoreSize) / float64(health.RawDataSize); health.DedupRatio != want {
+		t.Errorf("want dedup ratio %v, got %v", want, health.DedupRatio)
+	}
+	if health.UnusedBytesEstimate != 0 {
+		t.Errorf("want no unused data before forget, got %d", health.UnusedBytesEstimate)
+	}
+}
+
+func TestAcknowledgeOperation(t *testing.T) {
+	t.Parallel()
+
+	sut := createSystemUnderTest(t, &config.MemoryStore{
+		Config: &v1.Config{
+			Modno: 1234,
+		},
+	})
+
+	failed := &v1.Operation{PlanId: "test", RepoId: "local", Status: v1.OperationStatus_STATUS_ERROR, UnixTimeStartMs: 1000, Op: &v1.Operation_OperationBackup{}}
+	succeeded := &v1.Operation{PlanId: "test", RepoId: "local", Status: v1.OperationStatus_STATUS_SUCCESS, UnixTimeStartMs: 2000, Op: &v1.Operation_OperationBackup{}}
+	if err := sut.oplog.Add(failed); err != nil {
+		t.Fatalf("add operation: %v", err)
+	}
+	if err := sut.oplog.Add(succeeded); err != nil {
+		t.Fatalf("add operation: %v", err)
+	}
+
+	ctx := context.WithValue(context.Background(), auth.UserContextKey, &v1.User{Name: "alice"})
+	res, err := sut.handler.AcknowledgeOperation(ctx, connect.NewRequest(&v1.AcknowledgeOperationRequest{OperationId: failed.Id, Note: "network outage"}))
+	if err != nil {
+		t.Fatalf("AcknowledgeOperation() error = %v", err)
+	}
+	if ack := res.Msg.Acknowledgement; ack.GetUser() != "alice" || ack.GetNote() != "network outage" || ack.GetUnixTimeMs() == 0 {
+		t.Errorf("want the acknowledgement recorded with the user and note, got %v", ack)
+	}
+	if op, err := sut.oplog.Get(failed.Id); err != nil || op.Acknowledgement == nil {
+		t.Errorf("want the acknowledgement stored in the oplog, got %v, %v", op, err)
+	}
+
+	if _, err := sut.handler.AcknowledgeOperation(ctx, connect.NewRequest(&v1.AcknowledgeOperationRequest{OperationId: succeeded.Id})); connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Errorf("want a failed precondition acknowledging a successful operation, got %v", err)
+	}
+
+	res, err = sut.handler.AcknowledgeOperation(ctx, connect.NewRequest(&v1.AcknowledgeOperationRequest{OperationId: failed.Id, Unacknowledge: true}))
+	if err != nil {
+		t.Fatalf("AcknowledgeOperation() error = %v", err)
+	}
+	if res.Msg.Acknowledgement != nil {
+		t.Errorf("want the acknowledgement removed, got %v", res.Msg.Acknowledgement)
+	}
+}
+
+func TestSubscribeOperations(t *testing.T) {
+	t.Parallel()
+
+	sut := createSystemUnderTest(t, &config.MemoryStore{
+		Config: &v1.Config{
+			Modno: 1234,
+		},
+	})
+
+	mux := http.NewServeMux()
+	mux.Handle(v1connect.NewBackrestHandler(sut.handler))
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	client := v1connect.NewBackrestClient(server.Client(), server.URL)
+	stream, err := client.SubscribeOperations(ctx, connect.NewRequest(&v1.SubscribeOperationsRequest{
+		PlanId:            "plan1",
+		Statuses:          []v1.OperationStatus{v1.OperationStatus_STATUS_INPROGRESS, v1.OperationStatus_STATUS_SUCCESS},
+		EventTypes:        []v1.OperationEventType{v1.OperationEventType_EVENT_UPDATED},
+		StatusChangesOnly: true,
+	}))
+	if err != nil {
+		t.Fatalf("SubscribeOperations() error = %v", err)
+	}
+	defer func() {
+		cancel() // closing the stream drains it, end the subscription first.
+		stream.Close()
+	}()
+
+	received := make(chan *v1.OperationEvent, 1)
+	go func() {
+		if stream.Receive() {
+			received <- stream.Msg()
+		}
+		close(received)
+	}()
+
+	// keep generating events until the subscription has been established, only the status change of plan1 matches.
+	publish := func() {
+		if err := sut.oplog.Add(&v1.Operation{
+			UnixTimeStartMs: time.Now().UnixMilli(),
+			RepoId:          "repo1",
+			PlanId:          "plan2",
+			Status:          v1.OperationStatus_STATUS_INPROGRESS,
+			Op:              &v1.Operation_OperationBackup{},
+		}); err != nil {
+			t.Errorf("Add() error = %v", err)
+		}
+		op := &v1.Operation{
+			UnixTimeStartMs: time.Now().UnixMilli(),
+			RepoId:          "repo1",
+			PlanId:          "plan1",
+			Status:          v1.OperationStatus_STATUS_INPROGRESS,
+			Op:              &v1.Operation_OperationBackup{},
+		}
+		if err := sut.oplog.Add(op); err != nil {
+			t.Errorf("Add() error = %v", err)
+		}
+		if err := sut.oplog.Update(op); err != nil {
+			t.Errorf("Update() error = %v", err)
+		}
+		op.Status = v1.OperationStatus_STATUS_SUCCESS
+		if err := sut.oplog.Update(op); err != nil {
+			t.Errorf("Update() error = %v", err)
+		}
+	}
+
+	for {
+		publish()
+		select {
+		case event, ok := <-received:
+			if !ok {
+				t.Fatalf("stream closed: %v", stream.Err())
+			}
+			op := event.Operation
+			if event.Type != v1.OperationEventType_EVENT_UPDATED || op.PlanId != "plan1" || op.Status != v1.OperationStatus_STATUS_SUCCESS {
+				t.Errorf("got unexpected event %v", event)
+			}
+			return
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+}
+
 type systemUnderTest struct {
 	handler  *BackrestHandler
 	oplog    *oplog.OpLog
@@ -560,7 +1209,11 @@ func createSystemUnderTest(t *testing.T, config config.ConfigStore) systemUnderT
 		t.Fatalf("Failed to create orchestrator: %v", err)
 	}
 
-	h := NewBackrestHandler(config, orch, oplog, logStore)
+	metricsStore, _, err := metrics.NewStore(dir + "/metrics.binpb")
+	if err != nil {
+		t.Fatalf("Failed to create metrics store: %v", err)
+	}
+	h := NewBackrestHandler(config, orch, oplog, logStore, auditlog.NewAuditLog(dir+"/audit.log"), metricsStore)
 
 	return systemUnderTest{
 		handler:  h,
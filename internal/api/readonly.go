@@ -0,0 +1,82 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/gen/go/v1/v1connect"
+	"github.com/garethgeorge/backrest/internal/auditlog"
+)
+
+var errReadOnly = errors.New("backrest is running in read-only mode")
+
+// readOnlyProcedures are the RPCs that don't change the config, the operation history or repos, they are the only
+// RPCs served in read-only mode. RPCs added later are rejected until they are listed here.
+var readOnlyProcedures = map[string]bool{
+	v1connect.BackrestGetConfigProcedure:                true,
+	v1connect.BackrestGetOperationEventsProcedure:       true,
+	v1connect.BackrestSubscribeOperationsProcedure:      true,
+	v1connect.BackrestGetOperationsProcedure:            true,
+	v1connect.BackrestQueryOperationsProcedure:          true,
+	v1connect.BackrestListSnapshotsProcedure:            true,
+	v1connect.BackrestListSnapshotFilesProcedure:        true,
+	v1connect.BackrestGetLargestFilesProcedure:          true,
+	v1connect.BackrestGetFileHistoryProcedure:           true,
+	v1connect.BackrestGetReclaimedSpaceProcedure:        true,
+	v1connect.BackrestGetLogsProcedure:                  true,
+	v1connect.BackrestGetRepoHealthProcedure:            true,
+	v1connect.BackrestGetAuditLogProcedure:              true,
+	v1connect.BackrestListRepoMigrationsProcedure:       true,
+	v1connect.BackrestGetRepoCacheStatsProcedure:        true,
+	v1connect.BackrestGetNotificationTemplatesProcedure: true,
+	v1connect.BackrestGetRemoteStatusProcedure:          true,
+	v1connect.BackrestGetRemoteOperationsProcedure:      true,
+	v1connect.BackrestGetRunsProcedure:                  true,
+	v1connect.BackrestGetDurationEstimatesProcedure:     true,
+	v1connect.BackrestGetResourceUsageProcedure:         true,
+	v1connect.BackrestGetExclusionSuggestionsProcedure:  true,
+	v1connect.BackrestGetMetricSeriesProcedure:          true,
+	v1connect.AuthenticationLoginProcedure:              true,
+}
+
+// NewReadOnlyInterceptor returns an interceptor rejecting RPCs that aren't in readOnlyProcedures. The config is
+// redacted since a read-only instance is meant to be shown to a wider audience than its operators.
+func NewReadOnlyInterceptor() connect.Interceptor {
+	return &readOnlyInterceptor{}
+}
+
+type readOnlyInterceptor struct{}
+
+func (i *readOnlyInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		procedure := req.Spec().Procedure
+		if !readOnlyProcedures[procedure] {
+			return nil, connect.NewError(connect.CodePermissionDenied, fmt.Errorf("%s: %w", procedure, errReadOnly))
+		}
+		res, err := next(ctx, req)
+		if err != nil || procedure != v1connect.BackrestGetConfigProcedure {
+			return res, err
+		}
+		config, ok := res.Any().(*v1.Config)
+		if !ok {
+			return nil, connect.NewError(connect.CodeInternal, errors.New("unexpected config response"))
+		}
+		return connect.NewResponse(auditlog.Redact(config)), nil
+	}
+}
+
+func (i *readOnlyInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *readOnlyInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		if procedure := conn.Spec().Procedure; !readOnlyProcedures[procedure] {
+			return connect.NewError(connect.CodePermissionDenied, fmt.Errorf("%s: %w", procedure, errReadOnly))
+		}
+		return next(ctx, conn)
+	}
+}
@@ -0,0 +1,85 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/garethgeorge/backrest/gen/go/types"
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/gen/go/v1/v1connect"
+	"github.com/garethgeorge/backrest/internal/config"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestReadOnlyInterceptor(t *testing.T) {
+	t.Parallel()
+
+	hooks := []*v1.Hook{
+		{Conditions: []v1.Hook_Condition{v1.Hook_CONDITION_ANY_ERROR}, Action: &v1.Hook_ActionWebhook{ActionWebhook: &v1.Hook_Webhook{WebhookUrl: "https://example.com/hook/webhook-secret"}}},
+		{Conditions: []v1.Hook_Condition{v1.Hook_CONDITION_ANY_ERROR}, Action: &v1.Hook_ActionDiscord{ActionDiscord: &v1.Hook_Discord{WebhookUrl: "https://discord.com/api/webhooks/discord-secret"}}},
+		{Conditions: []v1.Hook_Condition{v1.Hook_CONDITION_ANY_ERROR}, Action: &v1.Hook_ActionSlack{ActionSlack: &v1.Hook_Slack{WebhookUrl: "https://hooks.slack.com/services/slack-secret"}}},
+		{Conditions: []v1.Hook_Condition{v1.Hook_CONDITION_ANY_ERROR}, Action: &v1.Hook_ActionGotify{ActionGotify: &v1.Hook_Gotify{BaseUrl: "https://gotify.example.com", Token: "gotify-secret"}}},
+		{Conditions: []v1.Hook_Condition{v1.Hook_CONDITION_ANY_ERROR}, Action: &v1.Hook_ActionPlugin{ActionPlugin: &v1.Hook_Plugin{Name: "pager", Settings: map[string]string{"api_key": "plugin-secret"}}}},
+	}
+	sut := createSystemUnderTest(t, &config.MemoryStore{
+		Config: &v1.Config{
+			Modno: 1234,
+			Repos: []*v1.Repo{
+				{
+					Id:       "local",
+					Uri:      t.TempDir(),
+					Password: "secret",
+					Flags:    []string{"--no-cache"},
+					Hooks:    hooks,
+				},
+			},
+			Plans: []*v1.Plan{
+				{
+					Id:    "test",
+					Repo:  "local",
+					Paths: []string{t.TempDir()},
+					Cron:  "0 0 1 1 *",
+					Hooks: hooks,
+				},
+			},
+		},
+	})
+
+	mux := http.NewServeMux()
+	mux.Handle(v1connect.NewBackrestHandler(sut.handler, connect.WithInterceptors(NewReadOnlyInterceptor())))
+	server := httptest.NewServer(mux)
+	defer server.Close()
+	client := v1connect.NewBackrestClient(server.Client(), server.URL)
+
+	res, err := client.GetConfig(context.Background(), connect.NewRequest(&emptypb.Empty{}))
+	if err != nil {
+		t.Fatalf("GetConfig() error = %v", err)
+	}
+	if password := res.Msg.Repos[0].Password; password == "secret" || password == "" {
+		t.Errorf("want the repo password redacted, got %q", password)
+	}
+	data, err := protojson.Marshal(res.Msg)
+	if err != nil {
+		t.Fatalf("marshal config: %v", err)
+	}
+	for _, secret := range []string{"webhook-secret", "discord-secret", "slack-secret", "gotify-secret", "plugin-secret"} {
+		if strings.Contains(string(data), secret) {
+			t.Errorf("want hook secrets redacted, found %q in %s", secret, data)
+		}
+	}
+
+	if _, err := client.SetConfig(context.Background(), connect.NewRequest(res.Msg)); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("want SetConfig rejected with permission denied, got %v", err)
+	}
+	if _, err := client.IndexSnapshots(context.Background(), connect.NewRequest(&types.StringValue{Value: "local"})); connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Errorf("want IndexSnapshots rejected with permission denied, got %v", err)
+	}
+	if cfg, err := sut.handler.config.Get(); err != nil || cfg.Repos[0].Password != "secret" {
+		t.Errorf("want the stored config unchanged, got %v, %v", cfg, err)
+	}
+}
@@ -0,0 +1,193 @@
+package api
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/garethgeorge/backrest/gen/go/types"
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/backendopts"
+	"github.com/garethgeorge/backrest/internal/orchestrator"
+	"github.com/garethgeorge/backrest/internal/resticflags"
+	"github.com/garethgeorge/backrest/internal/resticinstaller"
+	"github.com/garethgeorge/backrest/pkg/restic"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// generatedPasswordBytes is the entropy of generated repo passwords, 192 bits.
+const generatedPasswordBytes = 24
+
+func (s *BackrestHandler) ProbeRepo(ctx context.Context, req *connect.Request[v1.Repo]) (*connect.Response[v1.RepoProbe], error) {
+	if req.Msg.Uri == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("uri is required"))
+	}
+	r, err := newWizardRepo(req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	probe, err := probeRepo(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(probe), nil
+}
+
+func (s *BackrestHandler) GenerateRepoPassword(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[types.StringValue], error) {
+	b := make([]byte, generatedPasswordBytes)
+	if _, err := rand.Read(b); err != nil {
+		return nil, fmt.Errorf("failed to generate password: %w", err)
+	}
+	return connect.NewResponse(&types.StringValue{Value: base64.RawURLEncoding.EncodeToString(b)}), nil
+}
+
+func (s *BackrestHandler) InitRepo(ctx context.Context, req *connect.Request[v1.InitRepoRequest]) (*connect.Response[v1.Config], error) {
+	repoCfg := req.Msg.GetRepo()
+	if repoCfg == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("repo is required"))
+	}
+	if v := req.Msg.RepositoryVersion; v != 0 && v != 1 && v != 2 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("repository_version %d must be 1 or 2", v))
+	}
+
+	c, err := s.config.Get()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get config: %w", err)
+	}
+	c = proto.Clone(c).(*v1.Config)
+	c.Repos = append(c.Repos, repoCfg)
+	if err := validateConfig(c); err != nil {
+		return nil, fmt.Errorf("validation error: %w", err)
+	}
+
+	r, err := newWizardRepo(repoCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	// use background context such that the init can complete and be rolled back even if the connection is closed.
+	initCtx := context.Background()
+	probe, err := probeRepo(initCtx, r)
+	if err != nil {
+		return nil, err
+	}
+	if probe.Exists {
+		return nil, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("a repo already exists at %q, import it instead", repoCfg.Uri))
+	}
+
+	rollback := prepareRollback(repoCfg.Uri)
+	fail := func(step string, err error) error {
+		if rbErr := rollback(); rbErr != nil {
+			return fmt.Errorf("%s: %w, rolling back failed: %v", step, err, rbErr)
+		}
+		return fmt.Errorf("%s: %w", step, err)
+	}
+
+	var opts []restic.GenericOption
+	if v := req.Msg.RepositoryVersion; v != 0 {
+		opts = append(opts, restic.WithFlags("--repository-version", strconv.Itoa(int(v))))
+	}
+	if err := r.Init(initCtx, opts...); err != nil {
+		return nil, fail("failed to init repo", err)
+	}
+	resticCfg, err := r.Config(initCtx)
+	if err != nil {
+		return nil, fail("failed to open the initialized repo", err)
+	}
+	if err := s.config.Update(c); err != nil {
+		return nil, fail("failed to update config", err)
+	}
+	zap.S().Infof("initialized repo %q with restic id %v and repository version %d", repoCfg.Id, resticCfg.Id, resticCfg.Version)
+
+	s.audit(ctx, &v1.AuditEntry{Action: "init_repo", RepoId: repoCfg.Id, Details: fmt.Sprintf("initialized repo with uri %q and repository version %d", repoCfg.Uri, resticCfg.Version)})
+
+	if err := s.orchestrator.ApplyConfig(c); err != nil {
+		return nil, fmt.Errorf("failed to apply config: %w", err)
+	}
+	s.orchestrator.ScheduleTask(orchestrator.NewOneoffIndexSnapshotsTask(s.orchestrator, repoCfg.Id, time.Now()), orchestrator.TaskPriorityInteractive+orchestrator.TaskPriorityIndexSnapshots)
+
+	return connect.NewResponse(c), nil
+}
+
+func newWizardRepo(repoCfg *v1.Repo) (*restic.Repo, error) {
+	bin, err := resticinstaller.FindOrInstallResticBinary()
+	if err != nil {
+		return nil, fmt.Errorf("failed to find or install restic binary: %w", err)
+	}
+	// the flags are applied as by the orchestrator, the repo is checked the way backrest will access it.
+	opts := []restic.GenericOption{restic.WithPropagatedEnvVars(restic.EnvToPropagate...)}
+	if len(repoCfg.GetFlags()) > 0 {
+		opts = append(opts, restic.WithFlags(repoCfg.GetFlags()...))
+	}
+	if len(repoCfg.GetExtraFlags()) > 0 {
+		opts = append(opts, restic.WithFlags(resticflags.Args(repoCfg.GetExtraFlags())...))
+	}
+	opts = append(opts, backendOpts(repoCfg)...)
+	return restic.NewRepo(bin, repoCfg, opts...), nil
+}
+
+// backendOpts are the restic options applying the repo's backend options.
+func backendOpts(repoCfg *v1.Repo) []restic.GenericOption {
+	if repoCfg.GetBackendOptions() == nil {
+		return nil
+	}
+	return []restic.GenericOption{restic.WithEnv(backendopts.Env(repoCfg)...), restic.WithFlags(backendopts.Args(repoCfg)...)}
+}
+
+// probeRepo checks for a repo at the URI, a repo the password doesn't open exists all the same. Other failures
+// e.g. an unreachable backend are errors, the wizard can't tell whether a repo exists.
+func probeRepo(ctx context.Context, r *restic.Repo) (*v1.RepoProbe, error) {
+	cfg, err := r.Config(ctx)
+	switch {
+	case err == nil:
+		return &v1.RepoProbe{Exists: true, PasswordAccepted: true, ResticRepoId: cfg.Id, Version: int32(cfg.Version)}, nil
+	case errors.Is(err, restic.ErrRepoNotFound):
+		return &v1.RepoProbe{}, nil
+	case restic.ClassifyError(err) == v1.ErrorClass_ERROR_CLASS_AUTH:
+		return &v1.RepoProbe{Exists: true}, nil
+	default:
+		return nil, fmt.Errorf("failed to check for a repo: %w", err)
+	}
+}
+
+// prepareRollback returns a func removing what an init of the repo at the URI created. Only local repos can be
+// removed, a directory that existed before is emptied rather than removed and one that wasn't empty is left alone.
+func prepareRollback(uri string) func() error {
+	dir, _ := strings.CutPrefix(uri, "local:")
+	if !filepath.IsAbs(dir) {
+		return func() error {
+			return fmt.Errorf("remove the partially initialized repo at %q manually", uri)
+		}
+	}
+
+	entries, err := os.ReadDir(dir)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+		return func() error { return os.RemoveAll(dir) }
+	case err == nil && len(entries) == 0:
+		return func() error {
+			entries, err := os.ReadDir(dir)
+			if err != nil {
+				return err
+			}
+			for _, e := range entries {
+				if err := os.RemoveAll(filepath.Join(dir, e.Name())); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	default:
+		return func() error { return nil }
+	}
+}
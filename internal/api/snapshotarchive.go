@@ -0,0 +1,95 @@
+package api
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"regexp"
+	"strings"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/auditlog"
+	"github.com/garethgeorge/backrest/internal/orchestrator"
+	"go.uber.org/zap"
+)
+
+// SnapshotArchivePath prefixes the endpoint streaming snapshots as tar archives, requests GET the path followed by
+// <repo id>/<snapshot id> and optionally select a directory with the path query parameter. Entries keep their full
+// path in the snapshot. For example:
+//
+//	curl -H "Authorization: Bearer $TOKEN" "https://backrest/api/snapshot-archive/repo/1a2b3c4d?path=/home" | tar x
+const SnapshotArchivePath = "/api/snapshot-archive/"
+
+// snapshotIdRegex matches snapshot ids and their prefixes, anything else is rejected before it reaches restic.
+var snapshotIdRegex = regexp.MustCompile(`^([0-9a-f]{8,64}|latest)$`)
+
+// SnapshotArchiveHandler streams snapshots as tar archives so they can be restored on hosts without restic.
+type SnapshotArchiveHandler struct {
+	orchestrator *orchestrator.Orchestrator
+	auditLog     *auditlog.AuditLog
+}
+
+func NewSnapshotArchiveHandler(orchestrator *orchestrator.Orchestrator, auditLog *auditlog.AuditLog) *SnapshotArchiveHandler {
+	return &SnapshotArchiveHandler{
+		orchestrator: orchestrator,
+		auditLog:     auditLog,
+	}
+}
+
+func (h *SnapshotArchiveHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	repoId, snapshotId, ok := strings.Cut(strings.TrimPrefix(r.URL.Path, SnapshotArchivePath), "/")
+	if !ok || !snapshotIdRegex.MatchString(snapshotId) {
+		http.Error(w, fmt.Sprintf("want %s<repo id>/<snapshot id>", SnapshotArchivePath), http.StatusBadRequest)
+		return
+	}
+	dir := path.Clean("/" + r.URL.Query().Get("path"))
+
+	repo, err := h.orchestrator.GetRepo(repoId)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("repo %q not found", repoId), http.StatusNotFound)
+		return
+	}
+	if err := h.orchestrator.RequireWarm(repo.Config(), "streaming a snapshot"); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	recordAudit(r.Context(), h.auditLog, &v1.AuditEntry{Action: "snapshot_archive", RepoId: repoId, Details: fmt.Sprintf("streamed %q of snapshot %v as tar", dir, snapshotId)})
+
+	name := snapshotId
+	if dir != "/" {
+		name += "-" + path.Base(dir)
+	}
+	w.Header().Set("Content-Type", "application/x-tar")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", name+".tar"))
+	out := &countingWriter{w: w}
+	if err := repo.DumpArchive(r.Context(), snapshotId, dir, out); err != nil {
+		if out.n == 0 {
+			w.Header().Del("Content-Disposition")
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		// the status was sent with the first bytes, aborting the response tells the client the archive is truncated.
+		zap.S().Errorf("streaming snapshot %v of repo %q failed after %d bytes: %v", snapshotId, repoId, out.n, err)
+		panic(http.ErrAbortHandler)
+	}
+}
+
+// countingWriter counts the bytes written to w.
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,135 @@
+package api
+
+import (
+	"archive/tar"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	types "github.com/garethgeorge/backrest/gen/go/types"
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/config"
+)
+
+func TestSnapshotArchive(t *testing.T) {
+	t.Parallel()
+
+	data := t.TempDir()
+	if err := os.MkdirAll(path.Join(data, "sub"), 0755); err != nil {
+		t.Fatalf("create test data: %v", err)
+	}
+	for _, name := range []string{"a.txt", "sub/b.txt"} {
+		if err := os.WriteFile(path.Join(data, name), []byte("contents of "+name), 0644); err != nil {
+			t.Fatalf("create test data: %v", err)
+		}
+	}
+
+	sut := createSystemUnderTest(t, &config.MemoryStore{
+		Config: &v1.Config{
+			Modno: 1234,
+			Repos: []*v1.Repo{
+				{
+					Id:       "local",
+					Uri:      t.TempDir(),
+					Password: "test",
+				},
+			},
+			Plans: []*v1.Plan{
+				{
+					Id:    "test",
+					Repo:  "local",
+					Paths: []string{data},
+					Cron:  "0 0 1 1 *",
+				},
+			},
+		},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		sut.orch.Run(ctx)
+	}()
+
+	if _, err := sut.handler.Backup(context.Background(), connect.NewRequest(&types.StringValue{Value: "test"})); err != nil {
+		t.Fatalf("Backup() error = %v", err)
+	}
+	var snapshotId string
+	if err := retry(t, 10, 2*time.Second, func() error {
+		operations := getOperations(t, sut.oplog)
+		if index := slices.IndexFunc(operations, func(op *v1.Operation) bool {
+			return op.GetOperationIndexSnapshot() != nil
+		}); index != -1 {
+			snapshotId = operations[index].SnapshotId
+			return nil
+		}
+		return errors.New("snapshot not indexed")
+	}); err != nil {
+		t.Fatalf("Couldn't find snapshot in oplog")
+	}
+
+	server := httptest.NewServer(NewSnapshotArchiveHandler(sut.orch, sut.handler.auditLog))
+	t.Cleanup(server.Close)
+	get := func(target string) (*http.Response, []byte) {
+		res, err := http.Get(server.URL + target)
+		if err != nil {
+			t.Fatalf("get %v: %v", target, err)
+		}
+		defer res.Body.Close()
+		body, err := io.ReadAll(res.Body)
+		if err != nil {
+			t.Fatalf("read %v: %v", target, err)
+		}
+		return res, body
+	}
+	files := func(archive []byte) map[string]string {
+		files := make(map[string]string)
+		r := tar.NewReader(strings.NewReader(string(archive)))
+		for {
+			hdr, err := r.Next()
+			if err == io.EOF {
+				return files
+			} else if err != nil {
+				t.Fatalf("read archive: %v", err)
+			}
+			if hdr.Typeflag == tar.TypeReg {
+				contents, _ := io.ReadAll(r)
+				files[strings.TrimPrefix(hdr.Name, "/")] = string(contents)
+			}
+		}
+	}
+
+	res, body := get(SnapshotArchivePath + "local/" + snapshotId)
+	if res.StatusCode != http.StatusOK || res.Header.Get("Content-Type") != "application/x-tar" {
+		t.Fatalf("want a tar archive, got status %d: %s", res.StatusCode, body)
+	}
+	root := strings.TrimPrefix(data, "/")
+	if got := files(body); got[root+"/a.txt"] != "contents of a.txt" || got[root+"/sub/b.txt"] != "contents of sub/b.txt" {
+		t.Errorf("want the snapshot's files in the archive, got %v", got)
+	}
+
+	res, body = get(SnapshotArchivePath + "local/" + snapshotId + "?path=" + data + "/sub")
+	if got := files(body); res.StatusCode != http.StatusOK || len(got) != 1 || got[root+"/sub/b.txt"] != "contents of sub/b.txt" {
+		t.Errorf("want only the subtree in the archive, got %v", got)
+	}
+
+	for target, want := range map[string]int{
+		SnapshotArchivePath + "local/--help":           http.StatusBadRequest,
+		SnapshotArchivePath + "local":                  http.StatusBadRequest,
+		SnapshotArchivePath + "missing/" + snapshotId:  http.StatusNotFound,
+		SnapshotArchivePath + "local/0123456789abcdef": http.StatusInternalServerError,
+	} {
+		if res, body := get(target); res.StatusCode != want {
+			t.Errorf("get %v: want status %d, got %d: %s", target, want, res.StatusCode, body)
+		}
+	}
+}
@@ -0,0 +1,197 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"slices"
+	"time"
+
+	"connectrpc.com/connect"
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/config"
+	"github.com/garethgeorge/backrest/internal/oplog/indexutil"
+	"github.com/garethgeorge/backrest/internal/orchestrator"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+func (s *BackrestHandler) DeletePlan(ctx context.Context, req *connect.Request[v1.DeletePlanRequest]) (*connect.Response[v1.TeardownResult], error) {
+	existing, err := s.config.Get()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get config: %w", err)
+	}
+	idx := slices.IndexFunc(existing.Plans, func(p *v1.Plan) bool { return p.Id == req.Msg.PlanId })
+	if idx < 0 {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("plan %q not found", req.Msg.PlanId))
+	}
+	plan := existing.Plans[idx]
+	if req.Msg.ForgetSnapshots && req.Msg.Confirm != plan.Id {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("confirm must repeat the plan id to forget its snapshots"))
+	}
+
+	c := proto.Clone(existing).(*v1.Config)
+	c.Plans = slices.Delete(c.Plans, idx, idx+1)
+	if err := validateConfig(c); err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("plan %q can't be deleted: %w", plan.Id, err))
+	}
+
+	result := &v1.TeardownResult{}
+	for _, repoId := range orchestrator.PlanRepos(plan) {
+		repoPlan := orchestrator.PlanForRepo(plan, repoId)
+		if req.Msg.FinalRetention && plan.Retention != nil {
+			wait := make(chan struct{})
+			s.orchestrator.ScheduleTask(orchestrator.NewOneoffForgetTask(s.orchestrator, repoPlan, "", time.Now()), orchestrator.TaskPriorityInteractive+orchestrator.TaskPriorityForget, func(e error) {
+				err = e
+				close(wait)
+			})
+			<-wait
+			if err != nil {
+				return nil, fmt.Errorf("final retention of plan %q in repo %q: %w", plan.Id, repoId, err)
+			}
+		}
+		if req.Msg.ForgetSnapshots {
+			forgotten, pinned, err := s.orchestrator.ForgetPlanSnapshots(ctx, repoPlan)
+			if err != nil {
+				return nil, fmt.Errorf("forget snapshots of plan %q in repo %q: %w", plan.Id, repoId, err)
+			}
+			result.SnapshotsForgotten += int32(forgotten)
+			result.SnapshotsPinned += int32(pinned)
+		}
+	}
+
+	if result.Config, err = s.updateForTeardown(existing, c); err != nil {
+		return nil, err
+	}
+	if err := s.disposeHistory(req.Msg.History, "plan-"+plan.Id, func(f func(*v1.Operation) error) error {
+		return s.oplog.ForEachByPlan(plan.Id, indexutil.CollectAll(), f)
+	}, result); err != nil {
+		return nil, err
+	}
+	if req.Msg.History != v1.HistoryDisposition_HISTORY_KEEP {
+		s.metrics.RemovePlan(plan.Id)
+	}
+	s.audit(ctx, &v1.AuditEntry{Action: "delete_plan", RepoId: plan.Repo, PlanId: plan.Id, Details: teardownDetails(req.Msg.History, result)})
+	return connect.NewResponse(result), nil
+}
+
+func (s *BackrestHandler) DeleteRepo(ctx context.Context, req *connect.Request[v1.DeleteRepoRequest]) (*connect.Response[v1.TeardownResult], error) {
+	existing, err := s.config.Get()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get config: %w", err)
+	}
+	idx := slices.IndexFunc(existing.Repos, func(r *v1.Repo) bool { return r.Id == req.Msg.RepoId })
+	if idx < 0 {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("repo %q not found", req.Msg.RepoId))
+	}
+	var users []string
+	for _, plan := range existing.Plans {
+		if slices.Contains(orchestrator.PlanRepos(plan), req.Msg.RepoId) {
+			users = append(users, plan.Id)
+		}
+	}
+	if len(users) > 0 {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("repo %q is used by plans %v, delete them first", req.Msg.RepoId, users))
+	}
+
+	c := proto.Clone(existing).(*v1.Config)
+	c.Repos = slices.Delete(c.Repos, idx, idx+1)
+	if err := validateConfig(c); err != nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("repo %q can't be deleted: %w", req.Msg.RepoId, err))
+	}
+
+	result := &v1.TeardownResult{}
+	if req.Msg.RemoveCache {
+		repo, err := s.orchestrator.GetRepo(req.Msg.RepoId)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get repo %q: %w", req.Msg.RepoId, err)
+		}
+		if result.CacheBytesRemoved, err = repo.RemoveCache(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	if result.Config, err = s.updateForTeardown(existing, c); err != nil {
+		return nil, err
+	}
+	if err := s.disposeHistory(req.Msg.History, "repo-"+req.Msg.RepoId, func(f func(*v1.Operation) error) error {
+		return s.oplog.ForEachByRepo(req.Msg.RepoId, indexutil.CollectAll(), f)
+	}, result); err != nil {
+		return nil, err
+	}
+	s.audit(ctx, &v1.AuditEntry{Action: "delete_repo", RepoId: req.Msg.RepoId, Details: teardownDetails(req.Msg.History, result)})
+	return connect.NewResponse(result), nil
+}
+
+// updateForTeardown stores the config with the plan or repo removed, it fails if the config changed while the plan
+// or repo was torn down.
+func (s *BackrestHandler) updateForTeardown(existing, c *v1.Config) (*v1.Config, error) {
+	current, err := s.config.Get()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get config: %w", err)
+	}
+	if current.Modno != existing.Modno {
+		return nil, connect.NewError(connect.CodeAborted, errors.New("config modno mismatch, the config changed during the teardown, reload and try again"))
+	}
+	c.Modno += 1
+	if err := s.config.Update(c); err != nil {
+		return nil, fmt.Errorf("failed to update config: %w", err)
+	}
+	if err := s.orchestrator.ApplyConfig(c); err != nil {
+		return nil, fmt.Errorf("failed to apply config: %w", err)
+	}
+	return c, nil
+}
+
+// disposeHistory archives or deletes the operations iterated by forEach, archives are written to the archive
+// directory of the data directory.
+func (s *BackrestHandler) disposeHistory(disposition v1.HistoryDisposition, name string, forEach func(func(*v1.Operation) error) error, result *v1.TeardownResult) error {
+	if disposition == v1.HistoryDisposition_HISTORY_KEEP {
+		return nil
+	}
+	var ops []*v1.Operation
+	if err := forEach(func(op *v1.Operation) error {
+		ops = append(ops, op)
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed to get operations: %w", err)
+	}
+
+	if disposition == v1.HistoryDisposition_HISTORY_ARCHIVE {
+		data, err := protojson.MarshalOptions{Indent: "  "}.Marshal(&v1.OperationList{Operations: ops})
+		if err != nil {
+			return fmt.Errorf("marshal operations: %w", err)
+		}
+		dir := path.Join(config.DataDir(), "archive")
+		if err := os.MkdirAll(dir, 0700); err != nil {
+			return fmt.Errorf("create archive directory: %w", err)
+		}
+		result.HistoryArchive = path.Join(dir, fmt.Sprintf("%s-%s.json", name, time.Now().UTC().Format("20060102T150405Z")))
+		if err := os.WriteFile(result.HistoryArchive, data, 0600); err != nil {
+			return fmt.Errorf("write history archive: %w", err)
+		}
+	}
+
+	ids := make([]int64, 0, len(ops))
+	for _, op := range ops {
+		ids = append(ids, op.Id)
+	}
+	if err := s.oplog.Delete(ids...); err != nil {
+		return fmt.Errorf("failed to delete operations: %w", err)
+	}
+	result.OperationsRemoved = int64(len(ids))
+	return nil
+}
+
+func teardownDetails(disposition v1.HistoryDisposition, result *v1.TeardownResult) string {
+	details := fmt.Sprintf("history %v, removed %d operations", disposition, result.OperationsRemoved)
+	if result.SnapshotsForgotten > 0 || result.SnapshotsPinned > 0 {
+		details += fmt.Sprintf(", forgot %d snapshots and kept %d pinned", result.SnapshotsForgotten, result.SnapshotsPinned)
+	}
+	if result.CacheBytesRemoved > 0 {
+		details += fmt.Sprintf(", removed %d bytes of cache", result.CacheBytesRemoved)
+	}
+	return details
+}
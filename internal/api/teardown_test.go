@@ -0,0 +1,144 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"os"
+	"slices"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/garethgeorge/backrest/gen/go/types"
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/config"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func TestDeletePlanAndRepo(t *testing.T) {
+	t.Setenv(config.EnvVarDataDir, t.TempDir())
+
+	sut := createSystemUnderTest(t, &config.MemoryStore{
+		Config: &v1.Config{
+			Modno: 1234,
+			Repos: []*v1.Repo{
+				{
+					Id:       "local",
+					Uri:      t.TempDir(),
+					Password: "test",
+				},
+			},
+			Plans: []*v1.Plan{
+				{
+					Id:    "test",
+					Repo:  "local",
+					Paths: []string{t.TempDir()},
+					Cron:  "0 0 1 1 *",
+				},
+				{
+					Id:           "follower",
+					Repo:         "local",
+					Paths:        []string{t.TempDir()},
+					Cron:         "0 0 1 1 *",
+					Dependencies: &v1.PlanDependencies{Requires: []string{"test"}},
+				},
+			},
+		},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		sut.orch.Run(ctx)
+	}()
+
+	if _, err := sut.handler.Backup(context.Background(), connect.NewRequest(&types.StringValue{Value: "test"})); err != nil {
+		t.Fatalf("Backup() error = %v", err)
+	}
+	if err := retry(t, 10, 2*time.Second, func() error {
+		if slices.IndexFunc(getOperations(t, sut.oplog), func(op *v1.Operation) bool {
+			return op.GetOperationIndexSnapshot() != nil
+		}) == -1 {
+			return errors.New("snapshot not indexed")
+		}
+		return nil
+	}); err != nil {
+		t.Fatalf("Couldn't find snapshot in oplog")
+	}
+
+	deletePlan := func(req *v1.DeletePlanRequest) (*v1.TeardownResult, error) {
+		res, err := sut.handler.DeletePlan(context.Background(), connect.NewRequest(req))
+		if err != nil {
+			return nil, err
+		}
+		return res.Msg, nil
+	}
+	wantCode := func(err error, code connect.Code) {
+		t.Helper()
+		var connectErr *connect.Error
+		if !errors.As(err, &connectErr) || connectErr.Code() != code {
+			t.Errorf("want a %v error, got %v", code, err)
+		}
+	}
+
+	_, err := deletePlan(&v1.DeletePlanRequest{PlanId: "test"})
+	wantCode(err, connect.CodeFailedPrecondition) // the follower depends on the plan.
+	_, err = deletePlan(&v1.DeletePlanRequest{PlanId: "follower", ForgetSnapshots: true})
+	wantCode(err, connect.CodeInvalidArgument) // forgetting snapshots needs confirmation.
+	_, err = sut.handler.DeleteRepo(context.Background(), connect.NewRequest(&v1.DeleteRepoRequest{RepoId: "local"}))
+	wantCode(err, connect.CodeFailedPrecondition) // plans still use the repo.
+
+	if _, err := deletePlan(&v1.DeletePlanRequest{PlanId: "follower"}); err != nil {
+		t.Fatalf("delete follower: %v", err)
+	}
+	result, err := deletePlan(&v1.DeletePlanRequest{PlanId: "test", History: v1.HistoryDisposition_HISTORY_ARCHIVE, ForgetSnapshots: true, Confirm: "test"})
+	if err != nil {
+		t.Fatalf("delete plan: %v", err)
+	}
+	if result.SnapshotsForgotten != 1 || len(result.Config.Plans) != 0 {
+		t.Errorf("want the plan's snapshot forgotten and the plan removed, got %v", result)
+	}
+	data, err := os.ReadFile(result.HistoryArchive)
+	if err != nil {
+		t.Fatalf("read history archive: %v", err)
+	}
+	var archived v1.OperationList
+	if err := protojson.Unmarshal(data, &archived); err != nil {
+		t.Fatalf("unmarshal history archive: %v", err)
+	}
+	if result.OperationsRemoved == 0 || int64(len(archived.Operations)) != result.OperationsRemoved {
+		t.Errorf("want the %d removed operations archived, got %d", result.OperationsRemoved, len(archived.Operations))
+	}
+	for _, op := range getOperations(t, sut.oplog) {
+		if op.PlanId == "test" {
+			t.Errorf("want the plan's operations removed from the history, got %v", op)
+		}
+	}
+
+	repo, err := sut.orch.GetRepo("local")
+	if err != nil {
+		t.Fatalf("get repo: %v", err)
+	}
+	snapshots, err := repo.Snapshots(context.Background())
+	if err != nil {
+		t.Fatalf("snapshots: %v", err)
+	}
+	for _, s := range snapshots {
+		if slices.Contains(s.Tags, "plan:test") {
+			t.Errorf("want the plan's snapshots forgotten, got %v", s)
+		}
+	}
+
+	res, err := sut.handler.DeleteRepo(context.Background(), connect.NewRequest(&v1.DeleteRepoRequest{RepoId: "local", History: v1.HistoryDisposition_HISTORY_DELETE, RemoveCache: true}))
+	if err != nil {
+		t.Fatalf("delete repo: %v", err)
+	}
+	if len(res.Msg.Config.Repos) != 0 || res.Msg.CacheBytesRemoved == 0 {
+		t.Errorf("want the repo and its cache removed, got %v", res.Msg)
+	}
+	for _, op := range getOperations(t, sut.oplog) {
+		if op.RepoId == "local" {
+			t.Errorf("want the repo's operations removed from the history, got %v", op)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package api
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/auditlog"
+	"github.com/garethgeorge/backrest/internal/auth"
+	"github.com/garethgeorge/backrest/internal/orchestrator"
+	"go.uber.org/zap"
+)
+
+// WebhookTriggerPath prefixes the endpoint external systems trigger backups with, requests POST to the path followed
+// by the plan's id.
+const WebhookTriggerPath = "/api/trigger/"
+
+// WebhookTriggerHandler schedules backups of plans with a webhook trigger for requests carrying the plan's token.
+type WebhookTriggerHandler struct {
+	orchestrator *orchestrator.Orchestrator
+	auditLog     *auditlog.AuditLog
+}
+
+func NewWebhookTriggerHandler(orchestrator *orchestrator.Orchestrator, auditLog *auditlog.AuditLog) *WebhookTriggerHandler {
+	return &WebhookTriggerHandler{
+		orchestrator: orchestrator,
+		auditLog:     auditLog,
+	}
+}
+
+func (h *WebhookTriggerHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	// unknown plans, plans without a trigger and wrong tokens are indistinguishable so plan ids can't be probed.
+	planId := strings.TrimPrefix(r.URL.Path, WebhookTriggerPath)
+	plan, err := h.orchestrator.GetPlan(planId)
+	if err != nil || !validTriggerToken(plan.GetWebhookTrigger().GetToken(), requestToken(r)) {
+		zap.S().Warnf("webhook trigger rejected request for plan %q from %v", planId, r.RemoteAddr)
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+	if h.orchestrator.EmergencyStopped() {
+		http.Error(w, orchestrator.ErrEmergencyStop.Error(), http.StatusServiceUnavailable)
+		return
+	}
+
+	for _, repoId := range orchestrator.PlanRepos(plan) {
+		recordAudit(r.Context(), h.auditLog, &v1.AuditEntry{Action: "webhook_trigger", RepoId: repoId, PlanId: plan.Id, Details: fmt.Sprintf("backup triggered by webhook from %v", r.RemoteAddr)})
+	}
+	h.orchestrator.ScheduleTask(orchestrator.NewOneoffBackupTask(h.orchestrator, plan, time.Now()), orchestrator.TaskPriorityInteractive, func(err error) {
+		if err != nil {
+			zap.S().Warnf("backup of plan %q triggered by webhook failed: %v", plan.Id, err)
+		}
+	})
+	w.WriteHeader(http.StatusAccepted)
+	fmt.Fprintf(w, "backup of plan %q scheduled\n", plan.Id)
+}
+
+// requestToken returns the token of the request, either a bearer token or the token query parameter for systems
+// that can't set headers.
+func requestToken(r *http.Request) string {
+	if token, err := auth.ParseBearerToken(r.Header.Get("Authorization")); err == nil {
+		return token
+	}
+	return r.URL.Query().Get("token")
+}
+
+func validTriggerToken(want, got string) bool {
+	return want != "" && subtle.ConstantTimeCompare([]byte(want), []byte(got)) == 1
+}
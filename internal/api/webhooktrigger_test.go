@@ -0,0 +1,117 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+	"time"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/config"
+)
+
+func TestWebhookTrigger(t *testing.T) {
+	t.Parallel()
+
+	const token = "0123456789abcdef"
+	sut := createSystemUnderTest(t, &config.MemoryStore{
+		Config: &v1.Config{
+			Modno: 1234,
+			Repos: []*v1.Repo{
+				{
+					Id:       "local",
+					Uri:      t.TempDir(),
+					Password: "test",
+				},
+				{
+					Id:       "offsite",
+					Uri:      t.TempDir(),
+					Password: "test",
+				},
+			},
+			Plans: []*v1.Plan{
+				{
+					Id:              "test",
+					Repo:            "local",
+					AdditionalRepos: []string{"offsite"},
+					Paths:           []string{t.TempDir()},
+					Cron:            "0 0 1 1 *",
+					WebhookTrigger:  &v1.WebhookTrigger{Token: token},
+				},
+				{
+					Id:    "untriggered",
+					Repo:  "local",
+					Paths: []string{t.TempDir()},
+					Cron:  "0 0 1 1 *",
+				},
+			},
+		},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		sut.orch.Run(ctx)
+	}()
+
+	handler := NewWebhookTriggerHandler(sut.orch, sut.handler.auditLog)
+	trigger := func(method, target, authorization string) int {
+		req := httptest.NewRequest(method, target, nil)
+		if authorization != "" {
+			req.Header.Set("Authorization", authorization)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	tests := []struct {
+		name          string
+		method        string
+		target        string
+		authorization string
+		want          int
+	}{
+		{name: "wrong token", method: http.MethodPost, target: WebhookTriggerPath + "test", authorization: "Bearer wrong", want: http.StatusUnauthorized},
+		{name: "no token", method: http.MethodPost, target: WebhookTriggerPath + "test", want: http.StatusUnauthorized},
+		{name: "plan without trigger", method: http.MethodPost, target: WebhookTriggerPath + "untriggered?token=", want: http.StatusUnauthorized},
+		{name: "unknown plan", method: http.MethodPost, target: WebhookTriggerPath + "missing?token=" + token, want: http.StatusUnauthorized},
+		{name: "get", method: http.MethodGet, target: WebhookTriggerPath + "test?token=" + token, want: http.StatusMethodNotAllowed},
+		{name: "query token", method: http.MethodPost, target: WebhookTriggerPath + "test?token=" + token, want: http.StatusAccepted},
+		{name: "bearer token", method: http.MethodPost, target: WebhookTriggerPath + "test", authorization: "Bearer " + token, want: http.StatusAccepted},
+	}
+	for _, tc := range tests {
+		if got := trigger(tc.method, tc.target, tc.authorization); got != tc.want {
+			t.Errorf("%s: want status %d, got %d", tc.name, tc.want, got)
+		}
+	}
+
+	entries, err := sut.handler.auditLog.Query(func(e *v1.AuditEntry) bool { return e.Action == "webhook_trigger" })
+	if err != nil {
+		t.Fatalf("Query() error: %v", err)
+	}
+	for _, repoId := range []string{"local", "offsite"} {
+		if slices.IndexFunc(entries, func(e *v1.AuditEntry) bool { return e.RepoId == repoId && e.PlanId == "test" }) == -1 {
+			t.Errorf("want the webhook trigger audited for repo %q, got %v", repoId, entries)
+		}
+	}
+
+	if err := retry(t, 10, 2*time.Second, func() error {
+		if slices.IndexFunc(getOperations(t, sut.oplog), func(op *v1.Operation) bool {
+			return op.PlanId == "test" && op.GetOperationBackup() != nil && op.Status == v1.OperationStatus_STATUS_SUCCESS
+		}) == -1 {
+			return errors.New("no successful backup")
+		}
+		return nil
+	}); err != nil {
+		t.Fatalf("want the triggered backup to run: %v", err)
+	}
+	for _, op := range getOperations(t, sut.oplog) {
+		if op.PlanId == "untriggered" && op.GetOperationBackup() != nil && op.Status != v1.OperationStatus_STATUS_PENDING {
+			t.Errorf("want no backup of the plan without a trigger, got %v", op)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package auditlog
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"path"
+	"sync"
+	"time"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// AuditLog is an append only log of configuration changes and user actions stored as newline delimited JSON.
+type AuditLog struct {
+	mu   sync.Mutex
+	path string
+	now  func() time.Time
+}
+
+func NewAuditLog(path string) *AuditLog {
+	return &AuditLog{path: path}
+}
+
+// Record appends an entry to the log, the entry's time is set if it is not already.
+func (l *AuditLog) Record(entry *v1.AuditEntry) error {
+	if entry.UnixTimeMs == 0 {
+		t := time.Now()
+		if l.now != nil {
+			t = l.now() // for testing
+		}
+		entry.UnixTimeMs = t.UnixMilli()
+	}
+
+	data, err := protojson.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("marshal audit entry: %w", err)
+	}
+	data = append(data, '\n')
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if err := os.MkdirAll(path.Dir(l.path), 0700); err != nil {
+		return fmt.Errorf("create audit log dir: %w", err)
+	}
+	f, err := os.OpenFile(l.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("open audit log: %w", err)
+	}
+	defer f.Close()
+	if _, err := f.Write(data); err != nil {
+		return fmt.Errorf("write audit log: %w", err)
+	}
+	return nil
+}
+
+// Query returns the entries for which the filter returns true in the order they were recorded.
+func (l *AuditLog) Query(filter func(*v1.AuditEntry) bool) ([]*v1.AuditEntry, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	data, err := os.ReadFile(l.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("read audit log: %w", err)
+	}
+
+	var entries []*v1.AuditEntry
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Buffer(nil, 16*1024*1024) // config diffs can be large.
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		entry := &v1.AuditEntry{}
+		if err := protojson.Unmarshal(line, entry); err != nil {
+			return nil, fmt.Errorf("unmarshal audit entry: %w", err)
+		}
+		if filter == nil || filter(entry) {
+			entries = append(entries, entry)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("scan audit log: %w", err)
+	}
+	return entries, nil
+}
+
+// Export encodes entries as newline delimited JSON, the same format the log is stored in.
+func Export(entries []*v1.AuditEntry) ([]byte, error) {
+	var buf bytes.Buffer
+	for _, entry := range entries {
+		data, err := protojson.Marshal(entry)
+		if err != nil {
+			return nil, fmt.Errorf("marshal audit entry: %w", err)
+		}
+		buf.Write(data)
+		buf.WriteByte('\n')
+	}
+	return buf.Bytes(), nil
+}
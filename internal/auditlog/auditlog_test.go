@@ -0,0 +1,104 @@
+package auditlog
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+)
+
+func TestRecordAndQuery(t *testing.T) {
+	log := NewAuditLog(t.TempDir() + "/audit/audit.log")
+	log.now = func() time.Time { return time.UnixMilli(1000) }
+
+	entries := []*v1.AuditEntry{
+		{User: "alice", Action: "set_config", Details: "+ foo"},
+		{User: "bob", Action: "backup", RepoId: "repo1", PlanId: "plan1"},
+		{User: "alice", Action: "restore", RepoId: "repo1", PlanId: "plan1", UnixTimeMs: 5000},
+	}
+	for _, e := range entries {
+		if err := log.Record(e); err != nil {
+			t.Fatalf("Record() error: %v", err)
+		}
+	}
+
+	all, err := log.Query(nil)
+	if err != nil {
+		t.Fatalf("Query() error: %v", err)
+	}
+	if len(all) != 3 {
+		t.Fatalf("want 3 entries, got %d", len(all))
+	}
+	if all[0].UnixTimeMs != 1000 || all[2].UnixTimeMs != 5000 {
+		t.Errorf("unexpected entry times: %v, %v", all[0].UnixTimeMs, all[2].UnixTimeMs)
+	}
+
+	alice, err := log.Query(func(e *v1.AuditEntry) bool { return e.User == "alice" })
+	if err != nil {
+		t.Fatalf("Query() error: %v", err)
+	}
+	if len(alice) != 2 || alice[1].Action != "restore" {
+		t.Errorf("unexpected entries for alice: %v", alice)
+	}
+
+	data, err := Export(all)
+	if err != nil {
+		t.Fatalf("Export() error: %v", err)
+	}
+	if lines := strings.Count(string(data), "\n"); lines != 3 {
+		t.Errorf("want 3 exported lines, got %d", lines)
+	}
+}
+
+func TestQueryMissingFile(t *testing.T) {
+	log := NewAuditLog(t.TempDir() + "/audit.log")
+	entries, err := log.Query(nil)
+	if err != nil {
+		t.Fatalf("Query() error: %v", err)
+	}
+	if len(entries) != 0 {
+		t.Errorf("want no entries, got %d", len(entries))
+	}
+}
+
+func TestConfigDiff(t *testing.T) {
+	old := &v1.Config{
+		Repos: []*v1.Repo{
+			{Id: "repo1", Uri: "/tmp/repo", Password: "secret", Env: []string{"AWS_SECRET_ACCESS_KEY=hunter2"}},
+			{Id: "repo2", Uri: "azure:container:/", BackendOptions: &v1.BackendOptions{Backend: &v1.BackendOptions_Azure_{Azure: &v1.BackendOptions_Azure{AccountName: "name", AccountSas: "hunter2"}}}},
+		},
+		Plans: []*v1.Plan{
+			{Id: "plan1", Repo: "repo1", Env: []string{"B2_ACCOUNT_KEY=hunter2"}, Retention: &v1.RetentionPolicy{Policy: &v1.RetentionPolicy_PolicyKeepLastN{PolicyKeepLastN: 10}}},
+		},
+	}
+	new := &v1.Config{
+		Repos: old.Repos,
+		Plans: []*v1.Plan{
+			{Id: "plan1", Repo: "repo1", Env: []string{"B2_ACCOUNT_KEY=hunter2"}, Retention: &v1.RetentionPolicy{Policy: &v1.RetentionPolicy_PolicyKeepLastN{PolicyKeepLastN: 3}}},
+		},
+	}
+
+	diff, err := ConfigDiff(old, new)
+	if err != nil {
+		t.Fatalf("ConfigDiff() error: %v", err)
+	}
+	want := "- \"policyKeepLastN\": 10\n+ \"policyKeepLastN\": 3\n"
+	if strings.Join(strings.Fields(diff), " ") != strings.Join(strings.Fields(want), " ") {
+		t.Errorf("unexpected diff:\n%v\nwant:\n%v", diff, want)
+	}
+	if strings.Contains(diff, "secret") || strings.Contains(diff, "hunter2") {
+		t.Errorf("diff contains secrets: %v", diff)
+	}
+
+	full, err := ConfigDiff(nil, new)
+	if err != nil {
+		t.Fatalf("ConfigDiff() error: %v", err)
+	}
+	if strings.Contains(full, "secret") || strings.Contains(full, "hunter2") {
+		t.Errorf("diff contains secrets: %v", full)
+	}
+	if !strings.Contains(full, "AWS_SECRET_ACCESS_KEY") {
+		t.Errorf("diff should include redacted env var names: %v", full)
+	}
+}
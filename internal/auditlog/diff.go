@@ -0,0 +1,200 @@
+package auditlog
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const redacted = "<redacted>"
+
+// ConfigDiff returns a line diff of two configs with secrets redacted, suitable for recording in the audit log.
+func ConfigDiff(old, new *v1.Config) (string, error) {
+	oldText, err := formatConfig(old)
+	if err != nil {
+		return "", err
+	}
+	newText, err := formatConfig(new)
+	if err != nil {
+		return "", err
+	}
+	return diffLines(strings.Split(oldText, "\n"), strings.Split(newText, "\n")), nil
+}
+
+// formatConfig renders the config as indented JSON with sorted keys such that lines are stable across versions.
+func formatConfig(c *v1.Config) (string, error) {
+	if c == nil {
+		c = &v1.Config{}
+	}
+	data, err := protojson.Marshal(Redact(c))
+	if err != nil {
+		return "", fmt.Errorf("marshal config: %w", err)
+	}
+	var v any
+	if err := json.Unmarshal(data, &v); err != nil {
+		return "", fmt.Errorf("unmarshal config: %w", err)
+	}
+	data, err = json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("format config: %w", err)
+	}
+	return string(data), nil
+}
+
+// Redact returns a copy of the config with passwords, secrets and environment variable values removed.
+func Redact(c *v1.Config) *v1.Config {
+	c = proto.Clone(c).(*v1.Config)
+	for _, repo := range c.Repos {
+		if repo.Password != "" {
+			repo.Password = redacted
+		}
+		for i, env := range repo.Env {
+			if key, _, ok := strings.Cut(env, "="); ok {
+				repo.Env[i] = key + "=" + redacted
+			}
+		}
+		redactBackendOptions(repo.GetBackendOptions())
+		redactHooks(repo.Hooks)
+	}
+	for _, plan := range c.Plans {
+		for i, env := range plan.Env {
+			if key, _, ok := strings.Cut(env, "="); ok {
+				plan.Env[i] = key + "=" + redacted
+			}
+		}
+		if plan.GetWebhookTrigger().GetToken() != "" {
+			plan.WebhookTrigger.Token = redacted
+		}
+		redactHooks(plan.Hooks)
+	}
+	for _, user := range c.GetAuth().GetUsers() {
+		if user.Password != nil {
+			user.Password = &v1.User_PasswordBcrypt{PasswordBcrypt: redacted}
+		}
+		if sf := user.GetSecondFactor(); sf != nil {
+			if sf.TotpSecret != "" {
+				sf.TotpSecret = redacted
+			}
+			for i := range sf.RecoveryCodesBcrypt {
+				sf.RecoveryCodesBcrypt[i] = redacted
+			}
+		}
+	}
+	if c.GetAuth().GetOidc().GetClientSecret() != "" {
+		c.Auth.Oidc.ClientSecret = redacted
+	}
+	for _, instance := range c.RemoteInstances {
+		if instance.Token != "" {
+			instance.Token = redacted
+		}
+	}
+	if c.GetMqtt().GetPassword() != "" {
+		c.Mqtt.Password = redacted
+	}
+	return c
+}
+
+func redactBackendOptions(opts *v1.BackendOptions) {
+	redact := func(s *string) {
+		if *s != "" {
+			*s = redacted
+		}
+	}
+	if s3 := opts.GetS3(); s3 != nil {
+		redact(&s3.SecretAccessKey)
+		redact(&s3.SessionToken)
+	}
+	if b2 := opts.GetB2(); b2 != nil {
+		redact(&b2.AccountKey)
+	}
+	if azure := opts.GetAzure(); azure != nil {
+		redact(&azure.AccountKey)
+		redact(&azure.AccountSas)
+	}
+}
+
+// redactHooks removes the credentials of notification hooks, webhook URLs embed the token that authorizes posting.
+// Plugin settings often hold API keys, only their names are kept.
+func redactHooks(hooks []*v1.Hook) {
+	redact := func(s *string) {
+		if *s != "" {
+			*s = redacted
+		}
+	}
+	for _, h := range hooks {
+		switch action := h.Action.(type) {
+		case *v1.Hook_ActionWebhook:
+			redact(&action.ActionWebhook.WebhookUrl)
+		case *v1.Hook_ActionDiscord:
+			redact(&action.ActionDiscord.WebhookUrl)
+		case *v1.Hook_ActionSlack:
+			redact(&action.ActionSlack.WebhookUrl)
+		case *v1.Hook_ActionGotify:
+			redact(&action.ActionGotify.Token)
+		case *v1.Hook_ActionPlugin:
+			for k := range action.ActionPlugin.Settings {
+				action.ActionPlugin.Settings[k] = redacted
+			}
+		}
+	}
+}
+
+// maxDiffCells caps the size of the longest common subsequence table, larger changes are diffed as the removal of
+// all changed lines followed by their additions.
+const maxDiffCells = 1 << 20
+
+// diffLines computes a minimal line diff using the longest common subsequence, only changed lines are included. The
+// lines the configs start and end with are trimmed first such that the table only spans the changed region.
+func diffLines(a, b []string) string {
+	for len(a) > 0 && len(b) > 0 && a[0] == b[0] {
+		a, b = a[1:], b[1:]
+	}
+	for len(a) > 0 && len(b) > 0 && a[len(a)-1] == b[len(b)-1] {
+		a, b = a[:len(a)-1], b[:len(b)-1]
+	}
+
+	var sb strings.Builder
+	if (len(a)+1)*(len(b)+1) > maxDiffCells {
+		for _, line := range a {
+			sb.WriteString("- " + line + "\n")
+		}
+		for _, line := range b {
+			sb.WriteString("+ " + line + "\n")
+		}
+		return sb.String()
+	}
+
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			i++
+			j++
+		case i < len(a) && (j == len(b) || lcs[i+1][j] >= lcs[i][j+1]):
+			sb.WriteString("- " + a[i] + "\n")
+			i++
+		default:
+			sb.WriteString("+ " + b[j] + "\n")
+			j++
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,73 @@
+package auditlog
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func TestRedactHooks(t *testing.T) {
+	hooks := []*v1.Hook{
+		{Action: &v1.Hook_ActionWebhook{ActionWebhook: &v1.Hook_Webhook{WebhookUrl: "https://example.com/hook/hunter2"}}},
+		{Action: &v1.Hook_ActionDiscord{ActionDiscord: &v1.Hook_Discord{WebhookUrl: "https://discord.com/api/webhooks/1/hunter2"}}},
+		{Action: &v1.Hook_ActionSlack{ActionSlack: &v1.Hook_Slack{WebhookUrl: "https://hooks.slack.com/services/hunter2"}}},
+		{Action: &v1.Hook_ActionGotify{ActionGotify: &v1.Hook_Gotify{BaseUrl: "https://gotify.example.com", Token: "hunter2"}}},
+		{Action: &v1.Hook_ActionPlugin{ActionPlugin: &v1.Hook_Plugin{Name: "pagerduty", Settings: map[string]string{"routing_key": "hunter2"}}}},
+	}
+	c := &v1.Config{
+		Repos: []*v1.Repo{{Id: "repo1", Hooks: hooks}},
+		Plans: []*v1.Plan{{Id: "plan1", Repo: "repo1", Hooks: hooks}},
+	}
+
+	data, err := protojson.Marshal(Redact(c))
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	if strings.Contains(string(data), "hunter2") {
+		t.Errorf("redacted config contains hook secrets: %s", data)
+	}
+	if !strings.Contains(string(data), "https://gotify.example.com") || !strings.Contains(string(data), "routing_key") {
+		t.Errorf("redacted config should keep the gotify base url and plugin setting names: %s", data)
+	}
+	if c.Repos[0].Hooks[3].GetActionGotify().Token != "hunter2" {
+		t.Errorf("Redact must not modify its argument")
+	}
+
+	diff, err := ConfigDiff(nil, c)
+	if err != nil {
+		t.Fatalf("ConfigDiff() error: %v", err)
+	}
+	if strings.Contains(diff, "hunter2") {
+		t.Errorf("diff contains hook secrets: %v", diff)
+	}
+}
+
+func TestDiffLines(t *testing.T) {
+	if got, want := diffLines([]string{"a", "b", "c"}, []string{"a", "c", "d"}), "- b\n+ d\n"; got != want {
+		t.Errorf("diffLines() = %q, want %q", got, want)
+	}
+
+	// a change in a large config only spans the changed lines.
+	var a, b []string
+	for i := 0; i < 100000; i++ {
+		a = append(a, fmt.Sprintf("line %d", i))
+	}
+	b = append(b, a...)
+	b[50000] = "changed"
+	if got, want := diffLines(a, b), "- line 50000\n+ changed\n"; got != want {
+		t.Errorf("diffLines() = %q, want %q", got, want)
+	}
+
+	// changes too large for the table list the removed lines, then the added ones.
+	b = nil
+	for i := 0; i < 2000; i++ {
+		b = append(b, fmt.Sprintf("other %d", i))
+	}
+	got := strings.Split(strings.TrimSuffix(diffLines(a[:2000], b), "\n"), "\n")
+	if len(got) != 4000 || got[0] != "- line 0" || got[1999] != "- line 1999" || got[2000] != "+ other 0" {
+		t.Errorf("want all 2000 lines removed and added, got %d lines starting %q", len(got), got[0])
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	v1 "github.com/garethgeorge/backrest/gen/go/v1"
@@ -22,12 +23,20 @@ var defaultUsers = []*v1.User{
 type Authenticator struct {
 	config config.ConfigStore
 	key    []byte
+
+	mu             sync.Mutex
+	totpSteps      map[string]int64     // last TOTP time step used by each user, codes can not be reused.
+	usedChallenges map[string]time.Time // answered WebAuthn challenges and when they expire.
+	signCounts     map[string]uint32    // WebAuthn signature counters seen since startup, kept out of the config such that logins don't add config revisions.
 }
 
 func NewAuthenticator(key []byte, configProvider config.ConfigStore) *Authenticator {
 	return &Authenticator{
-		config: configProvider,
-		key:    key,
+		config:         configProvider,
+		key:            key,
+		totpSteps:      make(map[string]int64),
+		usedChallenges: make(map[string]time.Time),
+		signCounts:     make(map[string]uint32),
 	}
 }
 
@@ -88,8 +97,14 @@ func (a *Authenticator) VerifyJWT(token string) (*v1.User, error) {
 }
 
 func (a *Authenticator) CreateJWT(user *v1.User) (string, error) {
+	return a.CreateAPIToken(user, 7*24*time.Hour)
+}
+
+// CreateAPIToken returns a session token for the user that is valid for the given duration.
+func (a *Authenticator) CreateAPIToken(user *v1.User, validity time.Duration) (string, error) {
 	claims := &jwt.RegisteredClaims{
-		ExpiresAt: jwt.NewNumericDate(time.Now().Add(7 * 24 * time.Hour)),
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(validity)),
+		IssuedAt:  jwt.NewNumericDate(time.Now()),
 		Subject:   user.Name,
 	}
 
@@ -0,0 +1,125 @@
+package auth
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+)
+
+var errCBOR = errors.New("invalid cbor")
+
+const cborMaxDepth = 16
+
+// decodeCBOR decodes the subset of CBOR (RFC 8949) used by WebAuthn attestation objects and COSE keys: integers,
+// byte and text strings, arrays, maps and simple values. Integers decode as int64, maps as map[any]any. It returns
+// the number of bytes consumed such that trailing data can be found.
+func decodeCBOR(data []byte) (any, int, error) {
+	return decodeCBORItem(data, 0)
+}
+
+func decodeCBORItem(data []byte, depth int) (any, int, error) {
+	if depth > cborMaxDepth {
+		return nil, 0, fmt.Errorf("%w: nested too deeply", errCBOR)
+	}
+	if len(data) == 0 {
+		return nil, 0, fmt.Errorf("%w: unexpected end of data", errCBOR)
+	}
+
+	major := data[0] >> 5
+	info := data[0] & 0x1f
+	arg, n, err := decodeCBORArgument(data, info)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	switch major {
+	case 0:
+		if arg > math.MaxInt64 {
+			return nil, 0, fmt.Errorf("%w: integer overflow", errCBOR)
+		}
+		return int64(arg), n, nil
+	case 1:
+		if arg > math.MaxInt64 {
+			return nil, 0, fmt.Errorf("%w: integer overflow", errCBOR)
+		}
+		return -1 - int64(arg), n, nil
+	case 2, 3:
+		if arg > uint64(len(data)-n) {
+			return nil, 0, fmt.Errorf("%w: string exceeds data", errCBOR)
+		}
+		value := data[n : n+int(arg)]
+		if major == 3 {
+			return string(value), n + int(arg), nil
+		}
+		return append([]byte(nil), value...), n + int(arg), nil
+	case 4:
+		if arg > uint64(len(data)) {
+			return nil, 0, fmt.Errorf("%w: array exceeds data", errCBOR)
+		}
+		items := make([]any, 0, arg)
+		for i := uint64(0); i < arg; i++ {
+			item, m, err := decodeCBORItem(data[n:], depth+1)
+			if err != nil {
+				return nil, 0, err
+			}
+			items = append(items, item)
+			n += m
+		}
+		return items, n, nil
+	case 5:
+		if arg > uint64(len(data)) {
+			return nil, 0, fmt.Errorf("%w: map exceeds data", errCBOR)
+		}
+		m := make(map[any]any, arg)
+		for i := uint64(0); i < arg; i++ {
+			key, kn, err := decodeCBORItem(data[n:], depth+1)
+			if err != nil {
+				return nil, 0, err
+			}
+			n += kn
+			switch key.(type) {
+			case int64, string:
+			default:
+				return nil, 0, fmt.Errorf("%w: unsupported map key type %T", errCBOR, key)
+			}
+			value, vn, err := decodeCBORItem(data[n:], depth+1)
+			if err != nil {
+				return nil, 0, err
+			}
+			n += vn
+			m[key] = value
+		}
+		return m, n, nil
+	case 7:
+		switch info {
+		case 20:
+			return false, n, nil
+		case 21:
+			return true, n, nil
+		case 22, 23:
+			return nil, n, nil
+		}
+		return nil, 0, fmt.Errorf("%w: unsupported simple value %d", errCBOR, info)
+	default:
+		return nil, 0, fmt.Errorf("%w: unsupported major type %d", errCBOR, major)
+	}
+}
+
+// decodeCBORArgument decodes the argument following the initial byte, returning it and the size of the header.
+func decodeCBORArgument(data []byte, info byte) (uint64, int, error) {
+	switch {
+	case info < 24:
+		return uint64(info), 1, nil
+	case info <= 27:
+		size := 1 << (info - 24)
+		if len(data) < 1+size {
+			return 0, 0, fmt.Errorf("%w: unexpected end of data", errCBOR)
+		}
+		buf := make([]byte, 8)
+		copy(buf[8-size:], data[1:1+size])
+		return binary.BigEndian.Uint64(buf), 1 + size, nil
+	default:
+		return 0, 0, fmt.Errorf("%w: indefinite lengths are not supported", errCBOR)
+	}
+}
@@ -0,0 +1,146 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/netip"
+	"slices"
+	"strings"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultProxyUserHeader   = "Remote-User"
+	defaultProxyGroupsHeader = "Remote-Groups"
+)
+
+var ErrUnmappedIdentity = errors.New("external identity does not map to a configured user")
+
+// UserForExternalIdentity returns the user an identity authenticated by an upstream provider logs in as. The first
+// identity mapping matching the username or one of the groups is used, otherwise the user with the same name.
+func (a *Authenticator) UserForExternalIdentity(name string, groups []string) (*v1.User, error) {
+	config, err := a.config.Get()
+	if err != nil {
+		return nil, fmt.Errorf("get config: %w", err)
+	}
+
+	target := name
+	for _, mapping := range config.GetAuth().GetIdentityMappings() {
+		if m, ok := mapping.Match.(*v1.IdentityMapping_ExternalUser); ok && m.ExternalUser == name {
+			target = mapping.User
+			break
+		}
+		if m, ok := mapping.Match.(*v1.IdentityMapping_Group); ok && slices.Contains(groups, m.Group) {
+			target = mapping.User
+			break
+		}
+	}
+
+	// only configured users are considered, the default user must never be reachable without its password.
+	for _, user := range config.GetAuth().GetUsers() {
+		if user.Name == target {
+			return user, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %q", ErrUnmappedIdentity, name)
+}
+
+// ProxyHeaderUser authenticates a request by the identity headers of a trusted reverse proxy. It returns false if
+// proxy header authentication is disabled or the request did not come through a trusted proxy with the headers set.
+func (a *Authenticator) ProxyHeaderUser(r *http.Request) (*v1.User, bool, error) {
+	config, err := a.config.Get()
+	if err != nil {
+		return nil, false, fmt.Errorf("get config: %w", err)
+	}
+	proxy := config.GetAuth().GetProxyHeader()
+	if !proxy.GetEnabled() {
+		return nil, false, nil
+	}
+
+	userHeader := proxy.UserHeader
+	if userHeader == "" {
+		userHeader = defaultProxyUserHeader
+	}
+	name := strings.TrimSpace(r.Header.Get(userHeader))
+	if name == "" {
+		return nil, false, nil
+	}
+	if !trustedProxy(proxy.TrustedProxies, r.RemoteAddr) {
+		zap.S().Warnf("ignoring %s header from untrusted address %s", userHeader, r.RemoteAddr)
+		return nil, false, nil
+	}
+
+	groupsHeader := proxy.GroupsHeader
+	if groupsHeader == "" {
+		groupsHeader = defaultProxyGroupsHeader
+	}
+	var groups []string
+	for _, group := range strings.Split(r.Header.Get(groupsHeader), ",") {
+		if group = strings.TrimSpace(group); group != "" {
+			groups = append(groups, group)
+		}
+	}
+
+	user, err := a.UserForExternalIdentity(name, groups)
+	if err != nil {
+		return nil, true, err
+	}
+	return user, true, nil
+}
+
+// ClientCertUser authenticates a request by its TLS client certificate, the common name of a certificate verified
+// against the configured client CAs is treated as an external username. It returns false if no verified certificate
+// was presented.
+func (a *Authenticator) ClientCertUser(r *http.Request) (*v1.User, bool, error) {
+	if r.TLS == nil || len(r.TLS.VerifiedChains) == 0 || len(r.TLS.VerifiedChains[0]) == 0 {
+		return nil, false, nil
+	}
+	name := r.TLS.VerifiedChains[0][0].Subject.CommonName
+	if name == "" {
+		return nil, true, errors.New("client certificate has no common name")
+	}
+	user, err := a.UserForExternalIdentity(name, nil)
+	if err != nil {
+		return nil, true, err
+	}
+	return user, true, nil
+}
+
+// trustedProxy returns true if the remote address is one of the trusted IPs or within one of the trusted CIDRs.
+func trustedProxy(trusted []string, remoteAddr string) bool {
+	addrPort, err := netip.ParseAddrPort(remoteAddr)
+	if err != nil {
+		return false
+	}
+	addr := addrPort.Addr().Unmap()
+	for _, t := range trusted {
+		prefix, err := parseTrustedProxy(t)
+		if err != nil {
+			continue
+		}
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseTrustedProxy parses an IP or CIDR, an IP is treated as a prefix matching only that address.
+func parseTrustedProxy(s string) (netip.Prefix, error) {
+	if strings.Contains(s, "/") {
+		prefix, err := netip.ParsePrefix(s)
+		if err != nil {
+			return netip.Prefix{}, err
+		}
+		return prefix.Masked(), nil
+	}
+	addr, err := netip.ParseAddr(s)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	addr = addr.Unmap()
+	return netip.PrefixFrom(addr, addr.BitLen()), nil
+}
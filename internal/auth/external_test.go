@@ -0,0 +1,288 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/config"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func newExternalAuthenticator(t *testing.T, auth *v1.Auth) *Authenticator {
+	t.Helper()
+	auth.Users = []*v1.User{
+		{Name: "admin", Password: &v1.User_PasswordBcrypt{PasswordBcrypt: makePass(t, "adminPass")}},
+		{Name: "alice", Password: &v1.User_PasswordBcrypt{PasswordBcrypt: makePass(t, "alicePass")}},
+	}
+	return NewAuthenticator([]byte("key"), &config.MemoryStore{Config: &v1.Config{Auth: auth}})
+}
+
+func TestUserForExternalIdentity(t *testing.T) {
+	auth := newExternalAuthenticator(t, &v1.Auth{
+		IdentityMappings: []*v1.IdentityMapping{
+			{Match: &v1.IdentityMapping_ExternalUser{ExternalUser: "bob@example.com"}, User: "alice"},
+			{Match: &v1.IdentityMapping_Group{Group: "backup-admins"}, User: "admin"},
+		},
+	})
+
+	tests := []struct {
+		name     string
+		external string
+		groups   []string
+		want     string
+	}{
+		{name: "same name", external: "alice", want: "alice"},
+		{name: "mapped user", external: "bob@example.com", want: "alice"},
+		{name: "mapped group", external: "carol", groups: []string{"users", "backup-admins"}, want: "admin"},
+		{name: "user mapping first", external: "bob@example.com", groups: []string{"backup-admins"}, want: "alice"},
+		{name: "unmapped", external: "carol", groups: []string{"users"}},
+		{name: "default user not reachable", external: "default"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			user, err := auth.UserForExternalIdentity(tc.external, tc.groups)
+			if tc.want == "" {
+				if !errors.Is(err, ErrUnmappedIdentity) {
+					t.Fatalf("want ErrUnmappedIdentity, got user %v error %v", user, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("UserForExternalIdentity() error: %v", err)
+			}
+			if user.Name != tc.want {
+				t.Errorf("want user %q, got %q", tc.want, user.Name)
+			}
+		})
+	}
+}
+
+func TestRequireAuthenticationProxyHeader(t *testing.T) {
+	auth := newExternalAuthenticator(t, &v1.Auth{
+		ProxyHeader: &v1.ProxyHeaderAuth{
+			Enabled:        true,
+			TrustedProxies: []string{"10.0.0.0/8", "::1"},
+		},
+	})
+	handler := RequireAuthentication(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(r.Context().Value(UserContextKey).(*v1.User).Name))
+	}), auth)
+
+	tests := []struct {
+		name       string
+		remoteAddr string
+		user       string
+		wantStatus int
+		wantUser   string
+	}{
+		{name: "trusted cidr", remoteAddr: "10.1.2.3:4567", user: "alice", wantStatus: http.StatusOK, wantUser: "alice"},
+		{name: "trusted ip", remoteAddr: "[::1]:4567", user: "admin", wantStatus: http.StatusOK, wantUser: "admin"},
+		{name: "untrusted address", remoteAddr: "192.168.1.2:4567", user: "alice", wantStatus: http.StatusUnauthorized},
+		{name: "unknown user", remoteAddr: "10.1.2.3:4567", user: "mallory", wantStatus: http.StatusUnauthorized},
+		{name: "no header", remoteAddr: "10.1.2.3:4567", wantStatus: http.StatusUnauthorized},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.RemoteAddr = tc.remoteAddr
+			if tc.user != "" {
+				req.Header.Set("Remote-User", tc.user)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			if rec.Code != tc.wantStatus {
+				t.Fatalf("want status %d, got %d", tc.wantStatus, rec.Code)
+			}
+			if tc.wantUser != "" && rec.Body.String() != tc.wantUser {
+				t.Errorf("want user %q, got %q", tc.wantUser, rec.Body.String())
+			}
+		})
+	}
+}
+
+// testOIDCProvider is a minimal OpenID Connect provider issuing ID tokens for a fixed user.
+type testOIDCProvider struct {
+	server   *httptest.Server
+	key      *rsa.PrivateKey
+	username string
+	groups   []string
+
+	challenge string // code challenge of the last authorization request.
+	nonce     string
+}
+
+func newTestOIDCProvider(t *testing.T) *testOIDCProvider {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	p := &testOIDCProvider{key: key}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 p.server.URL,
+			"authorization_endpoint": p.server.URL + "/authorize",
+			"token_endpoint":         p.server.URL + "/token",
+			"jwks_uri":               p.server.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]any{"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": "key-1",
+			"use": "sig",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}}})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		id, secret, _ := r.BasicAuth()
+		if id != "backrest" || secret != "secret" || r.FormValue("code") != "the-code" {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error":"invalid_grant"}`))
+			return
+		}
+		if challengeFor(r.FormValue("code_verifier")) != p.challenge {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error":"invalid_grant","error_description":"pkce"}`))
+			return
+		}
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+			"iss":                p.server.URL,
+			"aud":                "backrest",
+			"sub":                "1234",
+			"exp":                jwt.NewNumericDate(time.Now().Add(time.Hour)),
+			"nonce":              p.nonce,
+			"preferred_username": p.username,
+			"groups":             p.groups,
+		})
+		token.Header["kid"] = "key-1"
+		signed, _ := token.SignedString(key)
+		json.NewEncoder(w).Encode(map[string]string{"id_token": signed})
+	})
+	p.server = httptest.NewServer(mux)
+	t.Cleanup(p.server.Close)
+	return p
+}
+
+func challengeFor(verifier string) string {
+	h := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(h[:])
+}
+
+func TestOIDCLogin(t *testing.T) {
+	provider := newTestOIDCProvider(t)
+	auth := newExternalAuthenticator(t, &v1.Auth{
+		Oidc: &v1.OidcAuth{
+			Issuer:       provider.server.URL,
+			ClientId:     "backrest",
+			ClientSecret: "secret",
+			RedirectUrl:  "https://backrest.example.com/auth/oidc/callback",
+		},
+		IdentityMappings: []*v1.IdentityMapping{
+			{Match: &v1.IdentityMapping_Group{Group: "admins"}, User: "admin"},
+		},
+	})
+	handler := NewOIDCHandler(auth)
+
+	// login redirects to the provider and sets the state cookie.
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/auth/oidc/login", nil))
+	if rec.Code != http.StatusFound {
+		t.Fatalf("want redirect, got %d: %s", rec.Code, rec.Body.String())
+	}
+	location, _ := url.Parse(rec.Header().Get("Location"))
+	if !strings.HasPrefix(location.String(), provider.server.URL+"/authorize?") {
+		t.Fatalf("want redirect to the authorization endpoint, got %s", location)
+	}
+	query := location.Query()
+	provider.challenge = query.Get("code_challenge")
+	provider.nonce = query.Get("nonce")
+	cookies := rec.Result().Cookies()
+	if len(cookies) != 1 || cookies[0].Name != oidcStateCookie {
+		t.Fatalf("want state cookie, got %v", cookies)
+	}
+
+	callback := func(state string, cookie *http.Cookie) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/auth/oidc/callback?code=the-code&state="+url.QueryEscape(state), nil)
+		if cookie != nil {
+			req.AddCookie(cookie)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	if rec := callback(query.Get("state"), nil); rec.Code != http.StatusUnauthorized {
+		t.Errorf("want callback without state cookie rejected, got %d", rec.Code)
+	}
+	if rec := callback("other-state", cookies[0]); rec.Code != http.StatusUnauthorized {
+		t.Errorf("want callback with mismatched state rejected, got %d", rec.Code)
+	}
+
+	provider.username = "carol"
+	if rec := callback(query.Get("state"), cookies[0]); rec.Code != http.StatusUnauthorized {
+		t.Errorf("want unmapped identity rejected, got %d", rec.Code)
+	}
+
+	provider.groups = []string{"admins"}
+	rec = callback(query.Get("state"), cookies[0])
+	if rec.Code != http.StatusOK {
+		t.Fatalf("want callback to succeed, got %d: %s", rec.Code, rec.Body.String())
+	}
+	body := rec.Body.String()
+	start := strings.Index(body, `"backrest-ui-authToken", "`)
+	if start == -1 {
+		t.Fatalf("want token stored by the response, got %s", body)
+	}
+	token := body[start+len(`"backrest-ui-authToken", "`):]
+	token = token[:strings.Index(token, `"`)]
+	user, err := auth.VerifyJWT(token)
+	if err != nil {
+		t.Fatalf("VerifyJWT() error: %v", err)
+	}
+	if user.Name != "admin" {
+		t.Errorf("want user admin, got %q", user.Name)
+	}
+
+	// a nonce that does not match the one in the state cookie is rejected.
+	provider.nonce = "other"
+	if rec := callback(query.Get("state"), cookies[0]); rec.Code != http.StatusUnauthorized {
+		t.Errorf("want id token with wrong nonce rejected, got %d", rec.Code)
+	}
+}
+
+func TestClientCertUser(t *testing.T) {
+	auth := newExternalAuthenticator(t, &v1.Auth{})
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	if _, ok, _ := auth.ClientCertUser(req); ok {
+		t.Errorf("want no identity without TLS")
+	}
+
+	req.TLS = &tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{{Subject: pkix.Name{CommonName: "alice"}}}}}
+	user, ok, err := auth.ClientCertUser(req)
+	if !ok || err != nil || user.Name != "alice" {
+		t.Errorf("want user alice, got %v %v %v", user, ok, err)
+	}
+
+	req.TLS.VerifiedChains[0][0].Subject.CommonName = "mallory"
+	if _, ok, err := auth.ClientCertUser(req); !ok || !errors.Is(err, ErrUnmappedIdentity) {
+		t.Errorf("want ErrUnmappedIdentity, got %v %v", ok, err)
+	}
+}
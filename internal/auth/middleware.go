@@ -17,6 +17,28 @@ const UserContextKey contextKey = "user"
 
 func RequireAuthentication(h http.Handler, auth *Authenticator) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") == "" {
+			user, ok, err := auth.ProxyHeaderUser(r)
+			if err != nil {
+				zap.S().Warnf("auth middleware blocked proxy identity: %v", err)
+				http.Error(w, "Unauthorized (Unknown Proxy User)", http.StatusUnauthorized)
+				return
+			}
+			if !ok {
+				user, ok, err = auth.ClientCertUser(r)
+				if err != nil {
+					zap.S().Warnf("auth middleware blocked client certificate: %v", err)
+					http.Error(w, "Unauthorized (Unknown Client Certificate)", http.StatusUnauthorized)
+					return
+				}
+			}
+			if ok {
+				ctx := context.WithValue(r.Context(), UserContextKey, user)
+				h.ServeHTTP(w, r.WithContext(ctx))
+				return
+			}
+		}
+
 		token, err := ParseBearerToken(r.Header.Get("Authorization"))
 		if err != nil {
 			http.Error(w, "Unauthorized (No Authorization Header)", http.StatusUnauthorized)
@@ -0,0 +1,465 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html/template"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/golang-jwt/jwt/v5"
+	"go.uber.org/zap"
+)
+
+const (
+	OIDCPathPrefix   = "/auth/oidc/"
+	oidcLoginPath    = OIDCPathPrefix + "login"
+	oidcCallbackPath = OIDCPathPrefix + "callback"
+
+	oidcStateCookie     = "backrest-oidc-state"
+	oidcStateTTL        = 10 * time.Minute
+	oidcKeyRefreshDelay = time.Minute // minimum time between JWKS fetches triggered by unknown key IDs.
+
+	defaultOIDCUsernameClaim = "preferred_username"
+	defaultOIDCGroupsClaim   = "groups"
+)
+
+var defaultOIDCScopes = []string{"openid", "profile", "email", "groups"}
+
+var ErrOIDC = errors.New("oidc login failed")
+
+// oidcProvider holds the discovered endpoints and signing keys of an OpenID Connect provider.
+type oidcProvider struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+
+	keys        map[string]any
+	keysFetched time.Time
+}
+
+// OIDCHandler serves the login and callback endpoints of the OpenID Connect authorization code flow. On success the
+// browser is handed a regular backrest session token for the user the external identity maps to.
+type OIDCHandler struct {
+	auth   *Authenticator
+	client *http.Client
+
+	mu       sync.Mutex
+	provider *oidcProvider
+}
+
+func NewOIDCHandler(auth *Authenticator) *OIDCHandler {
+	return &OIDCHandler{
+		auth:   auth,
+		client: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+func (h *OIDCHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	config, err := h.auth.config.Get()
+	if err != nil {
+		http.Error(w, "failed to load config", http.StatusInternalServerError)
+		return
+	}
+	oidc := config.GetAuth().GetOidc()
+	if oidc.GetIssuer() == "" {
+		http.NotFound(w, r)
+		return
+	}
+
+	switch r.URL.Path {
+	case oidcLoginPath:
+		err = h.login(w, r, oidc)
+	case oidcCallbackPath:
+		err = h.callback(w, r, oidc)
+	default:
+		http.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		zap.S().Warnf("oidc login: %v", err)
+		if connect.CodeOf(err) == connect.CodeInternal {
+			http.Error(w, "Internal Server Error (OIDC login failed)", http.StatusInternalServerError)
+			return
+		}
+		http.Error(w, "Unauthorized (OIDC login failed)", http.StatusUnauthorized)
+	}
+}
+
+// login redirects to the provider's authorization endpoint. The state, nonce and PKCE verifier are kept in a signed
+// cookie such that the callback can only be completed by the browser that started the login.
+func (h *OIDCHandler) login(w http.ResponseWriter, r *http.Request, oidc *v1.OidcAuth) error {
+	provider, err := h.getProvider(r.Context(), oidc.Issuer)
+	if err != nil {
+		return err
+	}
+
+	var state, nonce, verifier string
+	for _, token := range []*string{&state, &nonce, &verifier} {
+		if *token, err = randomToken(); err != nil {
+			return connect.NewError(connect.CodeInternal, err)
+		}
+	}
+	cookie, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"exp":      jwt.NewNumericDate(time.Now().Add(oidcStateTTL)),
+		"state":    state,
+		"nonce":    nonce,
+		"verifier": verifier,
+	}).SignedString(h.auth.derivedKey("oidc state"))
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("sign state: %w", err))
+	}
+	http.SetCookie(w, &http.Cookie{
+		Name:     oidcStateCookie,
+		Value:    cookie,
+		Path:     OIDCPathPrefix,
+		MaxAge:   int(oidcStateTTL.Seconds()),
+		HttpOnly: true,
+		Secure:   strings.HasPrefix(oidc.RedirectUrl, "https://"),
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	scopes := oidc.Scopes
+	if len(scopes) == 0 {
+		scopes = defaultOIDCScopes
+	}
+	challenge := sha256.Sum256([]byte(verifier))
+	query := url.Values{
+		"response_type":         {"code"},
+		"client_id":             {oidc.ClientId},
+		"redirect_uri":          {oidc.RedirectUrl},
+		"scope":                 {strings.Join(scopes, " ")},
+		"state":                 {state},
+		"nonce":                 {nonce},
+		"code_challenge":        {base64.RawURLEncoding.EncodeToString(challenge[:])},
+		"code_challenge_method": {"S256"},
+	}
+	target := provider.AuthorizationEndpoint
+	if strings.Contains(target, "?") {
+		target += "&" + query.Encode()
+	} else {
+		target += "?" + query.Encode()
+	}
+	http.Redirect(w, r, target, http.StatusFound)
+	return nil
+}
+
+func (h *OIDCHandler) callback(w http.ResponseWriter, r *http.Request, oidc *v1.OidcAuth) error {
+	query := r.URL.Query()
+	if e := query.Get("error"); e != "" {
+		return fmt.Errorf("%w: provider returned %s: %s", ErrOIDC, e, query.Get("error_description"))
+	}
+
+	cookie, err := r.Cookie(oidcStateCookie)
+	if err != nil {
+		return fmt.Errorf("%w: missing state cookie", ErrOIDC)
+	}
+	http.SetCookie(w, &http.Cookie{Name: oidcStateCookie, Path: OIDCPathPrefix, MaxAge: -1})
+	state := jwt.MapClaims{}
+	if _, err := jwt.ParseWithClaims(cookie.Value, state, func(t *jwt.Token) (interface{}, error) {
+		return h.auth.derivedKey("oidc state"), nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()})); err != nil {
+		return fmt.Errorf("%w: invalid state cookie: %v", ErrOIDC, err)
+	}
+	expectedState, _ := state["state"].(string)
+	if expectedState == "" || subtle.ConstantTimeCompare([]byte(expectedState), []byte(query.Get("state"))) != 1 {
+		return fmt.Errorf("%w: state mismatch", ErrOIDC)
+	}
+	verifier, _ := state["verifier"].(string)
+	nonce, _ := state["nonce"].(string)
+
+	provider, err := h.getProvider(r.Context(), oidc.Issuer)
+	if err != nil {
+		return err
+	}
+	idToken, err := h.exchangeCode(r.Context(), provider, oidc, query.Get("code"), verifier)
+	if err != nil {
+		return err
+	}
+	name, groups, err := h.verifyIDToken(r.Context(), provider, oidc, idToken, nonce)
+	if err != nil {
+		return err
+	}
+
+	user, err := h.auth.UserForExternalIdentity(name, groups)
+	if err != nil {
+		return err
+	}
+	token, err := h.auth.CreateJWT(user)
+	if err != nil {
+		return err
+	}
+	zap.S().Infof("oidc login of external user %q as user %q", name, user.Name)
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	return oidcCompleteTemplate.Execute(w, token)
+}
+
+// oidcCompleteTemplate stores the session token where the web UI expects it and returns to the UI.
+var oidcCompleteTemplate = template.Must(template.New("oidc").Parse(`<!DOCTYPE html>
+<html><head><title>Backrest</title></head><body>
+<script>
+localStorage.setItem("backrest-ui-authToken", {{.}});
+window.location.replace("/");
+</script>
+</body></html>
+`))
+
+func (h *OIDCHandler) exchangeCode(ctx context.Context, provider *oidcProvider, oidc *v1.OidcAuth, code, verifier string) (string, error) {
+	if code == "" {
+		return "", fmt.Errorf("%w: missing authorization code", ErrOIDC)
+	}
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {oidc.RedirectUrl},
+		"code_verifier": {verifier},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, provider.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", fmt.Errorf("create token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	req.SetBasicAuth(url.QueryEscape(oidc.ClientId), url.QueryEscape(oidc.ClientSecret))
+
+	var resp struct {
+		IDToken          string `json:"id_token"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"error_description"`
+	}
+	if err := h.getJSON(req, &resp); err != nil && resp.Error == "" {
+		return "", fmt.Errorf("%w: token request: %v", ErrOIDC, err)
+	}
+	if resp.Error != "" {
+		return "", fmt.Errorf("%w: token request: %s: %s", ErrOIDC, resp.Error, resp.ErrorDescription)
+	}
+	if resp.IDToken == "" {
+		return "", fmt.Errorf("%w: token response has no id_token", ErrOIDC)
+	}
+	return resp.IDToken, nil
+}
+
+// verifyIDToken checks the ID token's signature, issuer, audience, expiry and nonce and returns the external
+// username and groups from its claims.
+func (h *OIDCHandler) verifyIDToken(ctx context.Context, provider *oidcProvider, oidc *v1.OidcAuth, idToken, nonce string) (string, []string, error) {
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(idToken, claims, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		return h.getKey(ctx, provider, kid)
+	},
+		jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}),
+		jwt.WithIssuer(provider.Issuer),
+		jwt.WithAudience(oidc.ClientId),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(time.Minute),
+	)
+	if err != nil {
+		return "", nil, fmt.Errorf("%w: invalid id token: %v", ErrOIDC, err)
+	}
+	if got, _ := claims["nonce"].(string); nonce == "" || subtle.ConstantTimeCompare([]byte(got), []byte(nonce)) != 1 {
+		return "", nil, fmt.Errorf("%w: id token nonce mismatch", ErrOIDC)
+	}
+
+	usernameClaim := oidc.UsernameClaim
+	if usernameClaim == "" {
+		usernameClaim = defaultOIDCUsernameClaim
+	}
+	name, _ := claims[usernameClaim].(string)
+	if name == "" {
+		return "", nil, fmt.Errorf("%w: id token has no %q claim", ErrOIDC, usernameClaim)
+	}
+
+	groupsClaim := oidc.GroupsClaim
+	if groupsClaim == "" {
+		groupsClaim = defaultOIDCGroupsClaim
+	}
+	var groups []string
+	switch g := claims[groupsClaim].(type) {
+	case string:
+		groups = append(groups, g)
+	case []any:
+		for _, v := range g {
+			if s, ok := v.(string); ok {
+				groups = append(groups, s)
+			}
+		}
+	}
+	return name, groups, nil
+}
+
+// getProvider returns the provider for the issuer, running discovery if the issuer is not cached.
+func (h *OIDCHandler) getProvider(ctx context.Context, issuer string) (*oidcProvider, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.provider != nil && h.provider.Issuer == issuer {
+		return h.provider, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(issuer, "/")+"/.well-known/openid-configuration", nil)
+	if err != nil {
+		return nil, fmt.Errorf("create discovery request: %w", err)
+	}
+	var provider oidcProvider
+	if err := h.getJSON(req, &provider); err != nil {
+		return nil, fmt.Errorf("%w: discovery: %v", ErrOIDC, err)
+	}
+	if provider.Issuer != issuer {
+		return nil, fmt.Errorf("%w: discovery returned issuer %q, expected %q", ErrOIDC, provider.Issuer, issuer)
+	}
+	if provider.AuthorizationEndpoint == "" || provider.TokenEndpoint == "" || provider.JWKSURI == "" {
+		return nil, fmt.Errorf("%w: discovery document is missing endpoints", ErrOIDC)
+	}
+	h.provider = &provider
+	return h.provider, nil
+}
+
+// getKey returns the provider's signing key with the ID, refetching the key set if the ID is unknown.
+func (h *OIDCHandler) getKey(ctx context.Context, provider *oidcProvider, kid string) (any, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if key, ok := provider.keys[kid]; ok {
+		return key, nil
+	}
+	if time.Since(provider.keysFetched) < oidcKeyRefreshDelay {
+		return nil, fmt.Errorf("unknown key id %q", kid)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, provider.JWKSURI, nil)
+	if err != nil {
+		return nil, fmt.Errorf("create jwks request: %w", err)
+	}
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := h.getJSON(req, &set); err != nil {
+		return nil, fmt.Errorf("fetch jwks: %w", err)
+	}
+	provider.keysFetched = time.Now()
+	provider.keys = make(map[string]any)
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		key, err := k.publicKey()
+		if err != nil {
+			zap.S().Warnf("oidc: skipping key %q: %v", k.Kid, err)
+			continue
+		}
+		provider.keys[k.Kid] = key
+	}
+	if key, ok := provider.keys[kid]; ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("unknown key id %q", kid)
+}
+
+// getJSON sends the request and decodes the JSON response, the body is decoded even if the status is an error.
+func (h *OIDCHandler) getJSON(req *http.Request, v any) error {
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return fmt.Errorf("read response: %w", err)
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("decode response with status %d: %w", resp.StatusCode, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// jwk is a JSON Web Key (RFC 7517), only RSA and EC public keys are supported.
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func (k jwk) publicKey() (any, error) {
+	decode := func(s string) (*big.Int, error) {
+		b, err := base64.RawURLEncoding.DecodeString(s)
+		if err != nil || len(b) == 0 {
+			return nil, fmt.Errorf("invalid key parameter")
+		}
+		return new(big.Int).SetBytes(b), nil
+	}
+
+	switch k.Kty {
+	case "RSA":
+		n, err := decode(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decode(k.E)
+		if err != nil {
+			return nil, err
+		}
+		if n.BitLen() < 2048 || !e.IsInt64() {
+			return nil, fmt.Errorf("unsupported rsa key")
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := decode(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decode(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		if !curve.IsOnCurve(x, y) {
+			return nil, fmt.Errorf("point is not on curve")
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+}
+
+func randomToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("read random: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
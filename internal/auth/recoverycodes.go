@@ -0,0 +1,51 @@
+package auth
+
+import (
+	"crypto/rand"
+	"encoding/base32"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+const recoveryCodeCount = 10
+
+var recoveryCodeEncoding = base32.NewEncoding("abcdefghijkmnpqrstuvwxyz23456789").WithPadding(base32.NoPadding)
+
+// generateRecoveryCodes returns new recovery codes formatted for display and their bcrypt hashes for storage.
+func generateRecoveryCodes() (codes []string, hashes []string, err error) {
+	for i := 0; i < recoveryCodeCount; i++ {
+		raw := make([]byte, 7)
+		if _, err := rand.Read(raw); err != nil {
+			return nil, nil, fmt.Errorf("generate recovery code: %w", err)
+		}
+		code := recoveryCodeEncoding.EncodeToString(raw)[:10]
+		hash, err := bcrypt.GenerateFromPassword([]byte(code), bcrypt.DefaultCost)
+		if err != nil {
+			return nil, nil, fmt.Errorf("hash recovery code: %w", err)
+		}
+		codes = append(codes, code[:5]+"-"+code[5:])
+		hashes = append(hashes, base64.StdEncoding.EncodeToString(hash))
+	}
+	return codes, hashes, nil
+}
+
+// matchRecoveryCode returns the index of the hash matching the code, or -1.
+func matchRecoveryCode(hashes []string, code string) int {
+	code = strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+	if code == "" {
+		return -1
+	}
+	for i, h := range hashes {
+		hash, err := base64.StdEncoding.DecodeString(h)
+		if err != nil {
+			continue
+		}
+		if bcrypt.CompareHashAndPassword(hash, []byte(code)) == nil {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,344 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/protobuf/proto"
+)
+
+var ErrSecondFactorRequired = errors.New("second factor required")
+var ErrInvalidSecondFactor = errors.New("invalid second factor")
+var ErrSecondFactorNotEnrolled = errors.New("second factor required but none is enrolled")
+var ErrUserNotConfigured = errors.New("add the user to the config before enrolling a second factor")
+
+// challengeTTL is how long a WebAuthn challenge may be answered.
+const challengeTTL = 5 * time.Minute
+
+const (
+	purposeRegister = "webauthn.register"
+	purposeLogin    = "webauthn.login"
+)
+
+// SecondFactorEnrolled reports whether the user has any second factor enrolled.
+func SecondFactorEnrolled(user *v1.User) bool {
+	sf := user.GetSecondFactor()
+	return sf.GetTotpSecret() != "" || len(sf.GetWebauthnCredentials()) > 0
+}
+
+// LoginWithSecondFactor checks the password and, if the user has a second factor enrolled, the second factor in the
+// request. If none was provided the response requests one. origin is the request's Origin header, used for WebAuthn.
+func (a *Authenticator) LoginWithSecondFactor(req *v1.LoginRequest, origin string) (*v1.LoginResponse, error) {
+	user, err := a.Login(req.Username, req.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	if !SecondFactorEnrolled(user) {
+		if user.GetSecondFactor().GetRequired() {
+			return nil, ErrSecondFactorNotEnrolled
+		}
+		return a.loginResponse(user)
+	}
+
+	if req.TotpCode == "" && req.RecoveryCode == "" && req.WebauthnAssertionJson == "" {
+		resp := &v1.LoginResponse{
+			SecondFactorRequired: true,
+			TotpEnrolled:         user.GetSecondFactor().GetTotpSecret() != "",
+		}
+		if len(user.GetSecondFactor().GetWebauthnCredentials()) > 0 {
+			challenge, token, err := a.newChallenge(user.Name, purposeLogin, origin)
+			if err != nil {
+				return nil, err
+			}
+			options, err := assertionOptions(user, challenge)
+			if err != nil {
+				return nil, fmt.Errorf("webauthn options: %w", err)
+			}
+			resp.Webauthn = &v1.WebAuthnChallenge{ChallengeToken: token, OptionsJson: options}
+		}
+		return resp, nil
+	}
+
+	if err := a.verifySecondFactor(user, req); err != nil {
+		return nil, err
+	}
+	return a.loginResponse(user)
+}
+
+func (a *Authenticator) loginResponse(user *v1.User) (*v1.LoginResponse, error) {
+	token, err := a.CreateJWT(user)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.LoginResponse{Token: token}, nil
+}
+
+func (a *Authenticator) verifySecondFactor(user *v1.User, req *v1.LoginRequest) error {
+	sf := user.GetSecondFactor()
+	switch {
+	case req.TotpCode != "" && sf.GetTotpSecret() != "":
+		a.mu.Lock()
+		defer a.mu.Unlock()
+		step, ok := validateTOTP(sf.TotpSecret, req.TotpCode, time.Now(), a.totpSteps[user.Name])
+		if !ok {
+			return fmt.Errorf("%w: incorrect TOTP code", ErrInvalidSecondFactor)
+		}
+		a.totpSteps[user.Name] = step
+		return nil
+	case req.RecoveryCode != "":
+		if matchRecoveryCode(sf.GetRecoveryCodesBcrypt(), req.RecoveryCode) == -1 {
+			return fmt.Errorf("%w: incorrect recovery code", ErrInvalidSecondFactor)
+		}
+		return a.updateUser(user.Name, func(u *v1.User) error {
+			idx := matchRecoveryCode(u.GetSecondFactor().GetRecoveryCodesBcrypt(), req.RecoveryCode)
+			if idx == -1 {
+				return fmt.Errorf("%w: recovery code was already used", ErrInvalidSecondFactor)
+			}
+			u.SecondFactor.RecoveryCodesBcrypt = slices.Delete(u.SecondFactor.RecoveryCodesBcrypt, idx, idx+1)
+			return nil
+		})
+	case req.WebauthnAssertionJson != "":
+		challenge, origin, err := a.verifyChallenge(req.WebauthnChallengeToken, user.Name, purposeLogin)
+		if err != nil {
+			return err
+		}
+		a.mu.Lock()
+		defer a.mu.Unlock()
+		cred, signCount, err := verifyAssertion(user, req.WebauthnAssertionJson, challenge, origin, a.signCounts)
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidSecondFactor, err)
+		}
+		a.signCounts[cred.Id] = signCount
+		return nil
+	default:
+		return ErrSecondFactorRequired
+	}
+}
+
+// BeginTOTPEnrollment generates a secret for the user to add to their authenticator app.
+func (a *Authenticator) BeginTOTPEnrollment(user *v1.User) (*v1.TotpEnrollment, error) {
+	secret, err := GenerateTOTPSecret()
+	if err != nil {
+		return nil, err
+	}
+	return &v1.TotpEnrollment{Secret: secret, OtpauthUrl: TOTPURL(user.Name, secret)}, nil
+}
+
+// ConfirmTOTPEnrollment enrolls the secret once the user proves their authenticator app generates matching codes.
+// Recovery codes are returned if the user has none.
+func (a *Authenticator) ConfirmTOTPEnrollment(username, secret, code string) ([]string, error) {
+	step, ok := validateTOTP(secret, code, time.Now(), 0)
+	if !ok {
+		return nil, fmt.Errorf("%w: incorrect TOTP code", ErrInvalidSecondFactor)
+	}
+
+	var codes []string
+	err := a.updateUser(username, func(u *v1.User) error {
+		u.SecondFactor.TotpSecret = secret
+		var err error
+		codes, err = ensureRecoveryCodes(u.SecondFactor)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	a.mu.Lock()
+	a.totpSteps[username] = step
+	a.mu.Unlock()
+	return codes, nil
+}
+
+// BeginWebAuthnRegistration returns the options to create a credential for the user from the given origin.
+func (a *Authenticator) BeginWebAuthnRegistration(user *v1.User, origin string) (*v1.WebAuthnChallenge, error) {
+	rpID, err := rpIDForOrigin(origin)
+	if err != nil {
+		return nil, err
+	}
+	challenge, token, err := a.newChallenge(user.Name, purposeRegister, origin)
+	if err != nil {
+		return nil, err
+	}
+	options, err := registrationOptions(user, rpID, challenge)
+	if err != nil {
+		return nil, fmt.Errorf("webauthn options: %w", err)
+	}
+	return &v1.WebAuthnChallenge{ChallengeToken: token, OptionsJson: options}, nil
+}
+
+// FinishWebAuthnRegistration verifies and enrolls the created credential. Recovery codes are returned if the user
+// has none.
+func (a *Authenticator) FinishWebAuthnRegistration(username, challengeToken, credentialJSON, name string) ([]string, error) {
+	challenge, origin, err := a.verifyChallenge(challengeToken, username, purposeRegister)
+	if err != nil {
+		return nil, err
+	}
+	cred, err := verifyRegistration(credentialJSON, challenge, origin)
+	if err != nil {
+		return nil, err
+	}
+	cred.Name = name
+
+	var codes []string
+	err = a.updateUser(username, func(u *v1.User) error {
+		for _, c := range u.SecondFactor.WebauthnCredentials {
+			if c.Id == cred.Id {
+				return fmt.Errorf("%w: credential is already registered", ErrWebAuthn)
+			}
+		}
+		u.SecondFactor.WebauthnCredentials = append(u.SecondFactor.WebauthnCredentials, cred)
+		var err error
+		codes, err = ensureRecoveryCodes(u.SecondFactor)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+// RegenerateRecoveryCodes replaces the user's recovery codes.
+func (a *Authenticator) RegenerateRecoveryCodes(username string) ([]string, error) {
+	var codes []string
+	err := a.updateUser(username, func(u *v1.User) error {
+		if !SecondFactorEnrolled(u) {
+			return errors.New("no second factor is enrolled")
+		}
+		u.SecondFactor.RecoveryCodesBcrypt = nil
+		var err error
+		codes, err = ensureRecoveryCodes(u.SecondFactor)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+// RemoveSecondFactors removes the user's second factors and recovery codes and clears the requirement for one. The
+// user must prove they hold a second factor with a TOTP or recovery code, a session alone doesn't suffice.
+func (a *Authenticator) RemoveSecondFactors(user *v1.User, totpCode, recoveryCode string) error {
+	if totpCode == "" && recoveryCode == "" {
+		return ErrSecondFactorRequired
+	}
+	if err := a.verifySecondFactor(user, &v1.LoginRequest{TotpCode: totpCode, RecoveryCode: recoveryCode}); err != nil {
+		return err
+	}
+	return a.updateUser(user.Name, func(u *v1.User) error {
+		u.SecondFactor = nil
+		return nil
+	})
+}
+
+func ensureRecoveryCodes(sf *v1.SecondFactor) ([]string, error) {
+	if len(sf.RecoveryCodesBcrypt) > 0 {
+		return nil, nil
+	}
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		return nil, err
+	}
+	sf.RecoveryCodesBcrypt = hashes
+	return codes, nil
+}
+
+// updateUser applies fn to the named user in the config and saves the config.
+func (a *Authenticator) updateUser(username string, fn func(u *v1.User) error) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	cfg, err := a.config.Get()
+	if err != nil {
+		return fmt.Errorf("get config: %w", err)
+	}
+	cfg = proto.Clone(cfg).(*v1.Config)
+
+	idx := slices.IndexFunc(cfg.GetAuth().GetUsers(), func(u *v1.User) bool { return u.Name == username })
+	if idx == -1 {
+		return ErrUserNotConfigured
+	}
+	user := cfg.Auth.Users[idx]
+	if user.SecondFactor == nil {
+		user.SecondFactor = &v1.SecondFactor{}
+	}
+	if err := fn(user); err != nil {
+		return err
+	}
+	cfg.Modno += 1
+	if err := a.config.Update(cfg); err != nil {
+		return fmt.Errorf("update config: %w", err)
+	}
+	return nil
+}
+
+// challengeKey derives the key WebAuthn challenge tokens are signed with, distinct from the session token key such
+// that a challenge token is never accepted as a session.
+func (a *Authenticator) challengeKey() []byte {
+	return a.derivedKey("webauthn challenge")
+}
+
+// derivedKey derives a key for signing tokens with the given label from the session token key.
+func (a *Authenticator) derivedKey(label string) []byte {
+	mac := hmac.New(sha256.New, a.key)
+	mac.Write([]byte(label))
+	return mac.Sum(nil)
+}
+
+// newChallenge returns a random challenge and a signed token binding it to the user, purpose and origin.
+func (a *Authenticator) newChallenge(username, purpose, origin string) (challenge string, token string, err error) {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", "", fmt.Errorf("generate challenge: %w", err)
+	}
+	challenge = webauthnEncoding.EncodeToString(raw)
+
+	t := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"sub":       username,
+		"exp":       jwt.NewNumericDate(time.Now().Add(challengeTTL)),
+		"purpose":   purpose,
+		"challenge": challenge,
+		"origin":    origin,
+	})
+	token, err = t.SignedString(a.challengeKey())
+	if err != nil {
+		return "", "", fmt.Errorf("sign challenge: %w", err)
+	}
+	return challenge, token, nil
+}
+
+// verifyChallenge checks the token was issued to the user for the purpose and has not been used before.
+func (a *Authenticator) verifyChallenge(token, username, purpose string) (challenge string, origin string, err error) {
+	claims := jwt.MapClaims{}
+	if _, err := jwt.ParseWithClaims(token, claims, func(t *jwt.Token) (interface{}, error) {
+		return a.challengeKey(), nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()})); err != nil {
+		return "", "", fmt.Errorf("%w: invalid challenge token: %v", ErrWebAuthn, err)
+	}
+	if sub, _ := claims.GetSubject(); sub != username || claims["purpose"] != purpose {
+		return "", "", fmt.Errorf("%w: challenge token was issued for another user or purpose", ErrWebAuthn)
+	}
+	challenge, _ = claims["challenge"].(string)
+	origin, _ = claims["origin"].(string)
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	now := time.Now()
+	for c, expiry := range a.usedChallenges {
+		if now.After(expiry) {
+			delete(a.usedChallenges, c)
+		}
+	}
+	if _, used := a.usedChallenges[challenge]; used {
+		return "", "", fmt.Errorf("%w: challenge was already used", ErrWebAuthn)
+	}
+	a.usedChallenges[challenge] = now.Add(challengeTTL)
+	return challenge, origin, nil
+}
@@ -0,0 +1,367 @@
+package auth
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/config"
+)
+
+func TestTOTPCode(t *testing.T) {
+	// RFC 6238 appendix B test vectors for SHA-1, truncated to 6 digits.
+	key := []byte("12345678901234567890")
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1234567890, "005924"},
+	}
+	for _, tc := range tests {
+		if got := totpCode(key, tc.unix/totpPeriod); got != tc.want {
+			t.Errorf("totpCode at %d = %s, want %s", tc.unix, got, tc.want)
+		}
+	}
+
+	secret := base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(key)
+	now := time.Unix(59, 0)
+	step, ok := validateTOTP(secret, "287082", now, 0)
+	if !ok {
+		t.Fatalf("want code valid")
+	}
+	if _, ok := validateTOTP(secret, "287082", now, step); ok {
+		t.Errorf("want code rejected once used")
+	}
+}
+
+func newSecondFactorAuthenticator(t *testing.T) *Authenticator {
+	t.Helper()
+	return NewAuthenticator([]byte("key"), &config.MemoryStore{
+		Config: &v1.Config{
+			Auth: &v1.Auth{
+				Users: []*v1.User{
+					{Name: "test", Password: &v1.User_PasswordBcrypt{PasswordBcrypt: makePass(t, "testPass")}},
+				},
+			},
+		},
+	})
+}
+
+func TestLoginWithTOTP(t *testing.T) {
+	auth := newSecondFactorAuthenticator(t)
+	user, err := auth.Login("test", "testPass")
+	if err != nil {
+		t.Fatalf("Login() error: %v", err)
+	}
+
+	enrollment, err := auth.BeginTOTPEnrollment(user)
+	if err != nil {
+		t.Fatalf("BeginTOTPEnrollment() error: %v", err)
+	}
+	key, _ := decodeTOTPSecret(enrollment.Secret)
+	step := time.Now().Unix() / totpPeriod
+
+	if _, err := auth.ConfirmTOTPEnrollment("test", enrollment.Secret, "000000x"); !errors.Is(err, ErrInvalidSecondFactor) {
+		t.Fatalf("want ErrInvalidSecondFactor confirming with a wrong code, got %v", err)
+	}
+	codes, err := auth.ConfirmTOTPEnrollment("test", enrollment.Secret, totpCode(key, step))
+	if err != nil {
+		t.Fatalf("ConfirmTOTPEnrollment() error: %v", err)
+	}
+	if len(codes) != recoveryCodeCount {
+		t.Fatalf("want %d recovery codes, got %d", recoveryCodeCount, len(codes))
+	}
+	if cfg, _ := auth.config.Get(); cfg.Modno != 1 {
+		t.Errorf("want enrollment to bump the config modno to 1, got %d", cfg.Modno)
+	}
+
+	resp, err := auth.LoginWithSecondFactor(&v1.LoginRequest{Username: "test", Password: "testPass"}, "")
+	if err != nil {
+		t.Fatalf("LoginWithSecondFactor() error: %v", err)
+	}
+	if resp.Token != "" || !resp.SecondFactorRequired || !resp.TotpEnrolled {
+		t.Fatalf("want second factor requested without a token, got %v", resp)
+	}
+
+	// the code used to enroll can not be reused.
+	if _, err := auth.LoginWithSecondFactor(&v1.LoginRequest{Username: "test", Password: "testPass", TotpCode: totpCode(key, step)}, ""); !errors.Is(err, ErrInvalidSecondFactor) {
+		t.Errorf("want reused code rejected, got %v", err)
+	}
+	resp, err = auth.LoginWithSecondFactor(&v1.LoginRequest{Username: "test", Password: "testPass", TotpCode: totpCode(key, step+1)}, "")
+	if err != nil || resp.Token == "" {
+		t.Fatalf("want token for valid code, got %v (error: %v)", resp, err)
+	}
+
+	// recovery codes work once.
+	req := &v1.LoginRequest{Username: "test", Password: "testPass", RecoveryCode: codes[3]}
+	if resp, err := auth.LoginWithSecondFactor(req, ""); err != nil || resp.Token == "" {
+		t.Fatalf("want token for recovery code, got %v (error: %v)", resp, err)
+	}
+	if _, err := auth.LoginWithSecondFactor(req, ""); !errors.Is(err, ErrInvalidSecondFactor) {
+		t.Errorf("want used recovery code rejected, got %v", err)
+	}
+
+	// the password is still checked first.
+	if _, err := auth.LoginWithSecondFactor(&v1.LoginRequest{Username: "test", Password: "wrong", RecoveryCode: codes[4]}, ""); !errors.Is(err, ErrInvalidPassword) {
+		t.Errorf("want ErrInvalidPassword, got %v", err)
+	}
+
+	// removing the second factors requires proof of holding one.
+	user, _ = auth.Login("test", "testPass")
+	if err := auth.RemoveSecondFactors(user, "", ""); !errors.Is(err, ErrSecondFactorRequired) {
+		t.Errorf("want ErrSecondFactorRequired removing without a code, got %v", err)
+	}
+	if err := auth.RemoveSecondFactors(user, "", codes[3]); !errors.Is(err, ErrInvalidSecondFactor) {
+		t.Errorf("want used recovery code rejected, got %v", err)
+	}
+	if err := auth.RemoveSecondFactors(user, "", codes[5]); err != nil {
+		t.Fatalf("RemoveSecondFactors() error: %v", err)
+	}
+	if resp, err := auth.LoginWithSecondFactor(&v1.LoginRequest{Username: "test", Password: "testPass"}, ""); err != nil || resp.Token == "" {
+		t.Errorf("want token without second factor after removal, got %v (error: %v)", resp, err)
+	}
+}
+
+func TestLoginWithWebAuthn(t *testing.T) {
+	const origin = "https://backrest.example.com"
+	auth := newSecondFactorAuthenticator(t)
+	user, _ := auth.Login("test", "testPass")
+	authenticator := newTestAuthenticator(t)
+
+	challenge, err := auth.BeginWebAuthnRegistration(user, origin)
+	if err != nil {
+		t.Fatalf("BeginWebAuthnRegistration() error: %v", err)
+	}
+	var creationOptions struct {
+		Challenge string `json:"challenge"`
+		RP        struct {
+			ID string `json:"id"`
+		} `json:"rp"`
+	}
+	if err := json.Unmarshal([]byte(challenge.OptionsJson), &creationOptions); err != nil {
+		t.Fatalf("parse options: %v", err)
+	}
+	if creationOptions.RP.ID != "backrest.example.com" {
+		t.Errorf("want rp id backrest.example.com, got %q", creationOptions.RP.ID)
+	}
+
+	credential := authenticator.create(t, creationOptions.Challenge, origin)
+	codes, err := auth.FinishWebAuthnRegistration("test", challenge.ChallengeToken, credential, "test key")
+	if err != nil {
+		t.Fatalf("FinishWebAuthnRegistration() error: %v", err)
+	}
+	if len(codes) != recoveryCodeCount {
+		t.Errorf("want recovery codes on first enrollment, got %d", len(codes))
+	}
+	if _, err := auth.FinishWebAuthnRegistration("test", challenge.ChallengeToken, credential, "test key"); !errors.Is(err, ErrWebAuthn) {
+		t.Errorf("want challenge rejected once used, got %v", err)
+	}
+
+	resp, err := auth.LoginWithSecondFactor(&v1.LoginRequest{Username: "test", Password: "testPass"}, origin)
+	if err != nil {
+		t.Fatalf("LoginWithSecondFactor() error: %v", err)
+	}
+	if !resp.SecondFactorRequired || resp.Webauthn == nil {
+		t.Fatalf("want webauthn challenge, got %v", resp)
+	}
+	var requestOptions struct {
+		Challenge string `json:"challenge"`
+	}
+	if err := json.Unmarshal([]byte(resp.Webauthn.OptionsJson), &requestOptions); err != nil {
+		t.Fatalf("parse options: %v", err)
+	}
+
+	login := func(challengeToken, assertion string) (*v1.LoginResponse, error) {
+		return auth.LoginWithSecondFactor(&v1.LoginRequest{
+			Username:               "test",
+			Password:               "testPass",
+			WebauthnChallengeToken: challengeToken,
+			WebauthnAssertionJson:  assertion,
+		}, origin)
+	}
+
+	// an assertion made for another site is rejected.
+	if _, err := login(resp.Webauthn.ChallengeToken, authenticator.get(t, requestOptions.Challenge, "https://evil.example.net")); !errors.Is(err, ErrInvalidSecondFactor) {
+		t.Errorf("want assertion from another origin rejected, got %v", err)
+	}
+
+	before, _ := auth.config.Get()
+	resp, _ = auth.LoginWithSecondFactor(&v1.LoginRequest{Username: "test", Password: "testPass"}, origin)
+	json.Unmarshal([]byte(resp.Webauthn.OptionsJson), &requestOptions)
+	loginResp, err := login(resp.Webauthn.ChallengeToken, authenticator.get(t, requestOptions.Challenge, origin))
+	if err != nil || loginResp.Token == "" {
+		t.Fatalf("want token for valid assertion, got %v (error: %v)", loginResp, err)
+	}
+
+	// the sign count is kept out of the config, logins don't add config revisions.
+	if cfg, _ := auth.config.Get(); cfg.Modno != before.Modno {
+		t.Errorf("want config unchanged by a login, modno went from %d to %d", before.Modno, cfg.Modno)
+	}
+
+	// an assertion that doesn't advance the counter is rejected, the credential may have been cloned.
+	authenticator.counter--
+	resp, _ = auth.LoginWithSecondFactor(&v1.LoginRequest{Username: "test", Password: "testPass"}, origin)
+	json.Unmarshal([]byte(resp.Webauthn.OptionsJson), &requestOptions)
+	if _, err := login(resp.Webauthn.ChallengeToken, authenticator.get(t, requestOptions.Challenge, origin)); !errors.Is(err, ErrInvalidSecondFactor) {
+		t.Errorf("want assertion with a stale counter rejected, got %v", err)
+	}
+
+	// a challenge token is not a session token.
+	if _, err := auth.VerifyJWT(resp.Webauthn.ChallengeToken); err == nil {
+		t.Errorf("want challenge token rejected as a session token")
+	}
+}
+
+// testAuthenticator simulates a WebAuthn authenticator with a P-256 key.
+type testAuthenticator struct {
+	key     *ecdsa.PrivateKey
+	id      []byte
+	counter uint32
+}
+
+func newTestAuthenticator(t *testing.T) *testAuthenticator {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	return &testAuthenticator{key: key, id: []byte("credential-1")}
+}
+
+func (a *testAuthenticator) authData(origin string, attested bool) []byte {
+	rpID, _ := rpIDForOrigin(origin)
+	rpIDHash := sha256.Sum256([]byte(rpID))
+	a.counter++
+
+	data := append([]byte(nil), rpIDHash[:]...)
+	flags := byte(flagUserPresent)
+	if attested {
+		flags |= flagAttestedCredential
+	}
+	data = append(data, flags)
+	data = binary.BigEndian.AppendUint32(data, a.counter)
+	if attested {
+		data = append(data, make([]byte, 16)...) // aaguid
+		data = binary.BigEndian.AppendUint16(data, uint16(len(a.id)))
+		data = append(data, a.id...)
+		data = append(data, encodeTestCBOR(map[any]any{
+			int64(1):  int64(2), // EC2
+			int64(3):  int64(coseAlgES256),
+			int64(-1): int64(1), // P-256
+			int64(-2): a.key.X.FillBytes(make([]byte, 32)),
+			int64(-3): a.key.Y.FillBytes(make([]byte, 32)),
+		})...)
+	}
+	return data
+}
+
+func testClientData(typ, challenge, origin string) []byte {
+	data, _ := json.Marshal(clientData{Type: typ, Challenge: challenge, Origin: origin})
+	return data
+}
+
+func (a *testAuthenticator) create(t *testing.T, challenge, origin string) string {
+	attestation := encodeTestCBOR(map[any]any{
+		"fmt":      "none",
+		"attStmt":  map[any]any{},
+		"authData": a.authData(origin, true),
+	})
+	var cred credentialJSON
+	cred.ID = webauthnEncoding.EncodeToString(a.id)
+	cred.Type = "public-key"
+	cred.Response.ClientDataJSON = webauthnEncoding.EncodeToString(testClientData("webauthn.create", challenge, origin))
+	cred.Response.AttestationObject = webauthnEncoding.EncodeToString(attestation)
+	data, _ := json.Marshal(cred)
+	return string(data)
+}
+
+func (a *testAuthenticator) get(t *testing.T, challenge, origin string) string {
+	authData := a.authData(origin, false)
+	clientDataJSON := testClientData("webauthn.get", challenge, origin)
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	digest := sha256.Sum256(append(append([]byte(nil), authData...), clientDataHash[:]...))
+	sig, err := ecdsa.SignASN1(rand.Reader, a.key, digest[:])
+	if err != nil {
+		t.Fatalf("sign: %v", err)
+	}
+
+	var cred credentialJSON
+	cred.ID = webauthnEncoding.EncodeToString(a.id)
+	cred.Type = "public-key"
+	cred.Response.ClientDataJSON = webauthnEncoding.EncodeToString(clientDataJSON)
+	cred.Response.AuthenticatorData = webauthnEncoding.EncodeToString(authData)
+	cred.Response.Signature = webauthnEncoding.EncodeToString(sig)
+	data, _ := json.Marshal(cred)
+	return string(data)
+}
+
+// encodeTestCBOR encodes the value types produced by decodeCBOR.
+func encodeTestCBOR(v any) []byte {
+	header := func(major byte, n uint64) []byte {
+		switch {
+		case n < 24:
+			return []byte{major<<5 | byte(n)}
+		case n < 1<<8:
+			return []byte{major<<5 | 24, byte(n)}
+		default:
+			return binary.BigEndian.AppendUint16([]byte{major<<5 | 25}, uint16(n))
+		}
+	}
+	switch v := v.(type) {
+	case int64:
+		if v < 0 {
+			return header(1, uint64(-1-v))
+		}
+		return header(0, uint64(v))
+	case []byte:
+		return append(header(2, uint64(len(v))), v...)
+	case string:
+		return append(header(3, uint64(len(v))), v...)
+	case map[any]any:
+		keys := make([]any, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i, j int) bool { return string(encodeTestCBOR(keys[i])) < string(encodeTestCBOR(keys[j])) })
+		out := header(5, uint64(len(v)))
+		for _, k := range keys {
+			out = append(out, encodeTestCBOR(k)...)
+			out = append(out, encodeTestCBOR(v[k])...)
+		}
+		return out
+	}
+	panic("unsupported type")
+}
+
+func TestDecodeCBOR(t *testing.T) {
+	want := map[any]any{"a": int64(-300), int64(7): []byte{1, 2, 3}}
+	data := encodeTestCBOR(want)
+	got, n, err := decodeCBOR(append(data, 0xff))
+	if err != nil {
+		t.Fatalf("decodeCBOR() error: %v", err)
+	}
+	if n != len(data) {
+		t.Errorf("want %d bytes consumed, got %d", len(data), n)
+	}
+	m := got.(map[any]any)
+	if m["a"] != int64(-300) || string(m[int64(7)].([]byte)) != "\x01\x02\x03" {
+		t.Errorf("want %v, got %v", want, got)
+	}
+
+	if _, _, err := decodeCBOR([]byte{0x5f}); !errors.Is(err, errCBOR) {
+		t.Errorf("want error for indefinite length string, got %v", err)
+	}
+	if _, _, err := decodeCBOR([]byte{0x58, 0x10, 0x01}); !errors.Is(err, errCBOR) {
+		t.Errorf("want error for truncated string, got %v", err)
+	}
+}
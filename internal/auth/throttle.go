@@ -0,0 +1,225 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/netip"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+)
+
+const (
+	throttleIPMaxFailures   = 10               // failed logins from one client before it is locked out.
+	throttleUserMaxFailures = 5                // failed logins for one user before logins as that user are locked out.
+	throttleWindow          = 15 * time.Minute // failures are forgotten after this long without a failure or lockout.
+	throttleBaseLockout     = time.Minute      // first lockout, doubled for every further failure.
+	throttleMaxLockout      = time.Hour
+	throttleMaxEntries      = 10000 // bound on tracked keys, the oldest unlocked keys are dropped beyond it.
+)
+
+var ErrLoginThrottled = errors.New("too many failed login attempts")
+
+type loginAttempts struct {
+	failures    int
+	lastFailure time.Time
+	lockedUntil time.Time
+}
+
+// LoginThrottle tracks failed logins per client IP and per username and locks out keys with too many failures,
+// with a lockout that doubles for each failure after the limit.
+type LoginThrottle struct {
+	now func() time.Time
+
+	mu       sync.Mutex
+	attempts map[string]*loginAttempts
+}
+
+func NewLoginThrottle() *LoginThrottle {
+	return &LoginThrottle{
+		now:      time.Now,
+		attempts: make(map[string]*loginAttempts),
+	}
+}
+
+func throttleKeys(ip, username string) []string {
+	var keys []string
+	if ip != "" {
+		keys = append(keys, "ip:"+ip)
+	}
+	if username != "" {
+		keys = append(keys, "user:"+username)
+	}
+	return keys
+}
+
+// Allow returns an error wrapping ErrLoginThrottled if the client or user is locked out.
+func (t *LoginThrottle) Allow(ip, username string) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	now := t.now()
+	for _, key := range throttleKeys(ip, username) {
+		if a := t.get(key, now); a != nil && now.Before(a.lockedUntil) {
+			return fmt.Errorf("%w, retry in %v", ErrLoginThrottled, a.lockedUntil.Sub(now).Round(time.Second))
+		}
+	}
+	return nil
+}
+
+// Failure records a failed login and returns the keys that became locked out by it.
+func (t *LoginThrottle) Failure(ip, username string) []string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	now := t.now()
+	t.prune(now)
+
+	var locked []string
+	for _, key := range throttleKeys(ip, username) {
+		a := t.get(key, now)
+		if a == nil {
+			a = &loginAttempts{}
+			t.attempts[key] = a
+		}
+		a.failures++
+		a.lastFailure = now
+
+		limit := throttleUserMaxFailures
+		if strings.HasPrefix(key, "ip:") {
+			limit = throttleIPMaxFailures
+		}
+		if a.failures >= limit {
+			lockout := throttleMaxLockout
+			if shift := a.failures - limit; shift < 8 {
+				lockout = min(throttleBaseLockout<<shift, throttleMaxLockout)
+			}
+			a.lockedUntil = now.Add(lockout)
+			locked = append(locked, key)
+		}
+	}
+	return locked
+}
+
+// Success clears the failures of the client and user after a successful login.
+func (t *LoginThrottle) Success(ip, username string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, key := range throttleKeys(ip, username) {
+		delete(t.attempts, key)
+	}
+}
+
+// Lockouts returns the tracked keys ordered by key.
+func (t *LoginThrottle) Lockouts() []*v1.LoginLockout {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	now := t.now()
+	t.prune(now)
+
+	var lockouts []*v1.LoginLockout
+	for key, a := range t.attempts {
+		lockout := &v1.LoginLockout{
+			Key:           key,
+			Failures:      int32(a.failures),
+			LastFailureMs: a.lastFailure.UnixMilli(),
+		}
+		if now.Before(a.lockedUntil) {
+			lockout.LockedUntilMs = a.lockedUntil.UnixMilli()
+		}
+		lockouts = append(lockouts, lockout)
+	}
+	sort.Slice(lockouts, func(i, j int) bool { return lockouts[i].Key < lockouts[j].Key })
+	return lockouts
+}
+
+// Clear forgets the failures of the key, or of all keys if the key is empty. It returns false if the key was unknown.
+func (t *LoginThrottle) Clear(key string) bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if key == "" {
+		t.attempts = make(map[string]*loginAttempts)
+		return true
+	}
+	_, ok := t.attempts[key]
+	delete(t.attempts, key)
+	return ok
+}
+
+// get returns the attempts of the key, or nil if there are none or they have expired.
+func (t *LoginThrottle) get(key string, now time.Time) *loginAttempts {
+	a, ok := t.attempts[key]
+	if !ok {
+		return nil
+	}
+	if a.expired(now) {
+		delete(t.attempts, key)
+		return nil
+	}
+	return a
+}
+
+func (a *loginAttempts) expired(now time.Time) bool {
+	last := a.lastFailure
+	if a.lockedUntil.After(last) {
+		last = a.lockedUntil
+	}
+	return now.Sub(last) > throttleWindow
+}
+
+// prune drops expired keys, and the least recently failed unlocked keys if too many are tracked.
+func (t *LoginThrottle) prune(now time.Time) {
+	for key, a := range t.attempts {
+		if a.expired(now) {
+			delete(t.attempts, key)
+		}
+	}
+	if len(t.attempts) < throttleMaxEntries {
+		return
+	}
+	var unlocked []string
+	for key, a := range t.attempts {
+		if !now.Before(a.lockedUntil) {
+			unlocked = append(unlocked, key)
+		}
+	}
+	sort.Slice(unlocked, func(i, j int) bool {
+		return t.attempts[unlocked[i]].lastFailure.Before(t.attempts[unlocked[j]].lastFailure)
+	})
+	for _, key := range unlocked[:min(len(unlocked), len(t.attempts)-throttleMaxEntries+1)] {
+		delete(t.attempts, key)
+	}
+}
+
+// ClientIP returns the IP of the client making a request from the remote address. If the remote address is a trusted
+// proxy the nearest untrusted address in its X-Forwarded-For header is used instead.
+func (a *Authenticator) ClientIP(remoteAddr string, header http.Header) string {
+	addrPort, err := netip.ParseAddrPort(remoteAddr)
+	if err != nil {
+		return remoteAddr
+	}
+	ip := addrPort.Addr().Unmap().String()
+
+	config, err := a.config.Get()
+	if err != nil {
+		return ip
+	}
+	trusted := config.GetAuth().GetProxyHeader().GetTrustedProxies()
+	if !trustedProxy(trusted, remoteAddr) {
+		return ip
+	}
+	forwarded := strings.Split(strings.Join(header.Values("X-Forwarded-For"), ","), ",")
+	for i := len(forwarded) - 1; i >= 0; i-- {
+		addr, err := netip.ParseAddr(strings.TrimSpace(forwarded[i]))
+		if err != nil {
+			break
+		}
+		ip = addr.Unmap().String()
+		if !trustedProxy(trusted, netip.AddrPortFrom(addr, 0).String()) {
+			break
+		}
+	}
+	return ip
+}
@@ -0,0 +1,112 @@
+package auth
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/config"
+)
+
+func TestLoginThrottle(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	throttle := NewLoginThrottle()
+	throttle.now = func() time.Time { return now }
+
+	for i := 0; i < throttleUserMaxFailures-1; i++ {
+		if locked := throttle.Failure("10.0.0.1", "alice"); len(locked) != 0 {
+			t.Fatalf("want no lockout after %d failures, got %v", i+1, locked)
+		}
+	}
+	if err := throttle.Allow("10.0.0.1", "alice"); err != nil {
+		t.Fatalf("want login allowed below the limit, got %v", err)
+	}
+
+	if locked := throttle.Failure("10.0.0.2", "alice"); len(locked) != 1 || locked[0] != "user:alice" {
+		t.Fatalf("want user:alice locked out, got %v", locked)
+	}
+	if err := throttle.Allow("10.0.0.3", "alice"); !errors.Is(err, ErrLoginThrottled) {
+		t.Errorf("want user locked out from any client, got %v", err)
+	}
+	if err := throttle.Allow("10.0.0.1", "bob"); err != nil {
+		t.Errorf("want other users allowed from the client, got %v", err)
+	}
+
+	// the lockout expires and doubles with the next failure.
+	now = now.Add(throttleBaseLockout + time.Second)
+	if err := throttle.Allow("10.0.0.1", "alice"); err != nil {
+		t.Fatalf("want lockout expired, got %v", err)
+	}
+	throttle.Failure("10.0.0.1", "alice")
+	now = now.Add(throttleBaseLockout + time.Second)
+	if err := throttle.Allow("10.0.0.1", "alice"); !errors.Is(err, ErrLoginThrottled) {
+		t.Errorf("want doubled lockout still active, got %v", err)
+	}
+
+	lockouts := throttle.Lockouts()
+	if len(lockouts) != 3 || lockouts[2].Key != "user:alice" || lockouts[2].Failures != int32(throttleUserMaxFailures+1) || lockouts[2].LockedUntilMs == 0 {
+		t.Errorf("unexpected lockouts: %v", lockouts)
+	}
+
+	if !throttle.Clear("user:alice") {
+		t.Errorf("want user:alice cleared")
+	}
+	if throttle.Clear("user:alice") {
+		t.Errorf("want clearing an unknown key reported")
+	}
+	if err := throttle.Allow("10.0.0.1", "alice"); err != nil {
+		t.Errorf("want login allowed after clearing, got %v", err)
+	}
+
+	// failures are forgotten after the window.
+	now = now.Add(throttleWindow + time.Second)
+	if lockouts := throttle.Lockouts(); len(lockouts) != 0 {
+		t.Errorf("want failures forgotten, got %v", lockouts)
+	}
+}
+
+func TestLoginThrottleIPLimit(t *testing.T) {
+	throttle := NewLoginThrottle()
+	for i := 0; i < throttleIPMaxFailures; i++ {
+		throttle.Failure("10.0.0.1", "user"+string(rune('a'+i)))
+	}
+	if err := throttle.Allow("10.0.0.1", "someone"); !errors.Is(err, ErrLoginThrottled) {
+		t.Errorf("want client locked out, got %v", err)
+	}
+	throttle.Success("10.0.0.1", "usera")
+	if err := throttle.Allow("10.0.0.1", "someone"); err != nil {
+		t.Errorf("want client allowed after a successful login, got %v", err)
+	}
+}
+
+func TestClientIP(t *testing.T) {
+	auth := NewAuthenticator([]byte("key"), &config.MemoryStore{Config: &v1.Config{
+		Auth: &v1.Auth{ProxyHeader: &v1.ProxyHeaderAuth{TrustedProxies: []string{"10.0.0.0/8"}}},
+	}})
+
+	tests := []struct {
+		name       string
+		remoteAddr string
+		forwarded  string
+		want       string
+	}{
+		{name: "direct", remoteAddr: "192.168.1.5:1234", want: "192.168.1.5"},
+		{name: "untrusted forwarded header ignored", remoteAddr: "192.168.1.5:1234", forwarded: "1.2.3.4", want: "192.168.1.5"},
+		{name: "trusted proxy", remoteAddr: "10.0.0.1:1234", forwarded: "1.2.3.4", want: "1.2.3.4"},
+		{name: "chained proxies", remoteAddr: "10.0.0.1:1234", forwarded: "6.6.6.6, 1.2.3.4, 10.0.0.2", want: "1.2.3.4"},
+		{name: "trusted proxy without header", remoteAddr: "10.0.0.1:1234", want: "10.0.0.1"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			header := http.Header{}
+			if tc.forwarded != "" {
+				header.Set("X-Forwarded-For", tc.forwarded)
+			}
+			if got := auth.ClientIP(tc.remoteAddr, header); got != tc.want {
+				t.Errorf("ClientIP() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
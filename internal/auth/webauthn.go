@@ -0,0 +1,364 @@
+package auth
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/url"
+	"strings"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+)
+
+// WebAuthn relying party support. Attestation statements are not verified, any authenticator may be registered by
+// a logged in user, as is usual for relying parties that do not restrict authenticator models.
+
+var ErrWebAuthn = errors.New("webauthn verification failed")
+
+const (
+	flagUserPresent        = 0x01
+	flagAttestedCredential = 0x40
+
+	coseAlgES256 = -7
+	coseAlgEdDSA = -8
+	coseAlgRS256 = -257
+)
+
+var webauthnEncoding = base64.RawURLEncoding
+
+type clientData struct {
+	Type      string `json:"type"`
+	Challenge string `json:"challenge"`
+	Origin    string `json:"origin"`
+}
+
+// credentialJSON is the JSON serialization of a PublicKeyCredential (PublicKeyCredential.toJSON()).
+type credentialJSON struct {
+	ID       string `json:"id"`
+	Type     string `json:"type"`
+	Response struct {
+		ClientDataJSON    string `json:"clientDataJSON"`
+		AttestationObject string `json:"attestationObject"`
+		AuthenticatorData string `json:"authenticatorData"`
+		Signature         string `json:"signature"`
+	} `json:"response"`
+}
+
+type authenticatorData struct {
+	rpIDHash     []byte
+	flags        byte
+	signCount    uint32
+	credentialID []byte
+	publicKey    []byte // COSE key, only present in attested credential data.
+}
+
+// rpIDForOrigin returns the relying party ID for an origin, the host name it is served from.
+func rpIDForOrigin(origin string) (string, error) {
+	u, err := url.Parse(origin)
+	if err != nil || u.Hostname() == "" {
+		return "", fmt.Errorf("%w: invalid origin %q", ErrWebAuthn, origin)
+	}
+	return u.Hostname(), nil
+}
+
+// registrationOptions returns the PublicKeyCredentialCreationOptions in their JSON serialization.
+func registrationOptions(user *v1.User, rpID, challenge string) (string, error) {
+	var exclude []map[string]string
+	for _, cred := range user.GetSecondFactor().GetWebauthnCredentials() {
+		exclude = append(exclude, map[string]string{"type": "public-key", "id": cred.Id})
+	}
+	data, err := json.Marshal(map[string]any{
+		"challenge": challenge,
+		"rp":        map[string]string{"id": rpID, "name": "Backrest"},
+		"user": map[string]string{
+			"id":          webauthnEncoding.EncodeToString([]byte(user.Name)),
+			"name":        user.Name,
+			"displayName": user.Name,
+		},
+		"pubKeyCredParams": []map[string]any{
+			{"type": "public-key", "alg": coseAlgES256},
+			{"type": "public-key", "alg": coseAlgEdDSA},
+			{"type": "public-key", "alg": coseAlgRS256},
+		},
+		"timeout":            int(challengeTTL.Milliseconds()),
+		"attestation":        "none",
+		"excludeCredentials": exclude,
+		"authenticatorSelection": map[string]string{
+			"userVerification": "preferred",
+		},
+	})
+	return string(data), err
+}
+
+// assertionOptions returns the PublicKeyCredentialRequestOptions in their JSON serialization.
+func assertionOptions(user *v1.User, challenge string) (string, error) {
+	var allow []map[string]string
+	rpID := ""
+	for _, cred := range user.GetSecondFactor().GetWebauthnCredentials() {
+		allow = append(allow, map[string]string{"type": "public-key", "id": cred.Id})
+		rpID = cred.RpId
+	}
+	data, err := json.Marshal(map[string]any{
+		"challenge":        challenge,
+		"rpId":             rpID,
+		"allowCredentials": allow,
+		"timeout":          int(challengeTTL.Milliseconds()),
+		"userVerification": "preferred",
+	})
+	return string(data), err
+}
+
+// verifyRegistration verifies a credential created for the challenge and returns it for storage.
+func verifyRegistration(credJSON string, challenge, origin string) (*v1.WebAuthnCredential, error) {
+	var cred credentialJSON
+	if err := json.Unmarshal([]byte(credJSON), &cred); err != nil {
+		return nil, fmt.Errorf("%w: parse credential: %v", ErrWebAuthn, err)
+	}
+	rpID, err := rpIDForOrigin(origin)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := verifyClientData(cred.Response.ClientDataJSON, "webauthn.create", challenge, origin); err != nil {
+		return nil, err
+	}
+
+	attestation, err := webauthnEncoding.DecodeString(cred.Response.AttestationObject)
+	if err != nil {
+		return nil, fmt.Errorf("%w: decode attestation object: %v", ErrWebAuthn, err)
+	}
+	obj, _, err := decodeCBOR(attestation)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrWebAuthn, err)
+	}
+	objMap, _ := obj.(map[any]any)
+	rawAuthData, ok := objMap["authData"].([]byte)
+	if !ok {
+		return nil, fmt.Errorf("%w: attestation object has no authData", ErrWebAuthn)
+	}
+
+	authData, err := parseAuthenticatorData(rawAuthData)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkAuthenticatorData(authData, rpID); err != nil {
+		return nil, err
+	}
+	if authData.flags&flagAttestedCredential == 0 {
+		return nil, fmt.Errorf("%w: no attested credential data", ErrWebAuthn)
+	}
+
+	pub, err := parseCOSEKey(authData.publicKey)
+	if err != nil {
+		return nil, err
+	}
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	if err != nil {
+		return nil, fmt.Errorf("%w: encode public key: %v", ErrWebAuthn, err)
+	}
+
+	return &v1.WebAuthnCredential{
+		Id:        webauthnEncoding.EncodeToString(authData.credentialID),
+		PublicKey: der,
+		RpId:      rpID,
+		SignCount: authData.signCount,
+	}, nil
+}
+
+// verifyAssertion verifies an assertion for the challenge against the user's credentials, returning the credential
+// used and its new signature counter. signCounts holds counters newer than those in the config.
+func verifyAssertion(user *v1.User, assertionJSON string, challenge, origin string, signCounts map[string]uint32) (*v1.WebAuthnCredential, uint32, error) {
+	var assertion credentialJSON
+	if err := json.Unmarshal([]byte(assertionJSON), &assertion); err != nil {
+		return nil, 0, fmt.Errorf("%w: parse assertion: %v", ErrWebAuthn, err)
+	}
+
+	var cred *v1.WebAuthnCredential
+	for _, c := range user.GetSecondFactor().GetWebauthnCredentials() {
+		if c.Id == assertion.ID {
+			cred = c
+		}
+	}
+	if cred == nil {
+		return nil, 0, fmt.Errorf("%w: unknown credential", ErrWebAuthn)
+	}
+
+	originRPID, err := rpIDForOrigin(origin)
+	if err != nil {
+		return nil, 0, err
+	}
+	if originRPID != cred.RpId && !strings.HasSuffix(originRPID, "."+cred.RpId) {
+		return nil, 0, fmt.Errorf("%w: origin %q is not within %q", ErrWebAuthn, origin, cred.RpId)
+	}
+
+	clientDataJSON, err := verifyClientData(assertion.Response.ClientDataJSON, "webauthn.get", challenge, origin)
+	if err != nil {
+		return nil, 0, err
+	}
+	rawAuthData, err := webauthnEncoding.DecodeString(assertion.Response.AuthenticatorData)
+	if err != nil {
+		return nil, 0, fmt.Errorf("%w: decode authenticator data: %v", ErrWebAuthn, err)
+	}
+	authData, err := parseAuthenticatorData(rawAuthData)
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := checkAuthenticatorData(authData, cred.RpId); err != nil {
+		return nil, 0, err
+	}
+
+	sig, err := webauthnEncoding.DecodeString(assertion.Response.Signature)
+	if err != nil {
+		return nil, 0, fmt.Errorf("%w: decode signature: %v", ErrWebAuthn, err)
+	}
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	signed := append(append([]byte(nil), rawAuthData...), clientDataHash[:]...)
+	if err := verifySignature(cred.PublicKey, signed, sig); err != nil {
+		return nil, 0, err
+	}
+
+	// authenticators that do not count report 0, otherwise the counter must increase or the credential was cloned.
+	lastCount := max(cred.SignCount, signCounts[cred.Id])
+	if (authData.signCount != 0 || lastCount != 0) && authData.signCount <= lastCount {
+		return nil, 0, fmt.Errorf("%w: signature counter did not increase", ErrWebAuthn)
+	}
+	return cred, authData.signCount, nil
+}
+
+// verifyClientData checks the client data and returns its raw JSON.
+func verifyClientData(encoded, typ, challenge, origin string) ([]byte, error) {
+	raw, err := webauthnEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("%w: decode client data: %v", ErrWebAuthn, err)
+	}
+	var cd clientData
+	if err := json.Unmarshal(raw, &cd); err != nil {
+		return nil, fmt.Errorf("%w: parse client data: %v", ErrWebAuthn, err)
+	}
+	if cd.Type != typ {
+		return nil, fmt.Errorf("%w: client data type %q, want %q", ErrWebAuthn, cd.Type, typ)
+	}
+	if cd.Challenge != challenge {
+		return nil, fmt.Errorf("%w: challenge mismatch", ErrWebAuthn)
+	}
+	if cd.Origin != origin {
+		return nil, fmt.Errorf("%w: origin %q, want %q", ErrWebAuthn, cd.Origin, origin)
+	}
+	return raw, nil
+}
+
+func checkAuthenticatorData(authData *authenticatorData, rpID string) error {
+	want := sha256.Sum256([]byte(rpID))
+	if !bytes.Equal(authData.rpIDHash, want[:]) {
+		return fmt.Errorf("%w: relying party ID mismatch", ErrWebAuthn)
+	}
+	if authData.flags&flagUserPresent == 0 {
+		return fmt.Errorf("%w: user not present", ErrWebAuthn)
+	}
+	return nil
+}
+
+func parseAuthenticatorData(data []byte) (*authenticatorData, error) {
+	if len(data) < 37 {
+		return nil, fmt.Errorf("%w: authenticator data too short", ErrWebAuthn)
+	}
+	ad := &authenticatorData{
+		rpIDHash:  data[:32],
+		flags:     data[32],
+		signCount: binary.BigEndian.Uint32(data[33:37]),
+	}
+	if ad.flags&flagAttestedCredential == 0 {
+		return ad, nil
+	}
+
+	rest := data[37:]
+	if len(rest) < 18 {
+		return nil, fmt.Errorf("%w: attested credential data too short", ErrWebAuthn)
+	}
+	idLen := int(binary.BigEndian.Uint16(rest[16:18]))
+	rest = rest[18:]
+	if len(rest) < idLen {
+		return nil, fmt.Errorf("%w: credential ID exceeds data", ErrWebAuthn)
+	}
+	ad.credentialID = rest[:idLen]
+	rest = rest[idLen:]
+	_, n, err := decodeCBOR(rest)
+	if err != nil {
+		return nil, fmt.Errorf("%w: credential public key: %v", ErrWebAuthn, err)
+	}
+	ad.publicKey = rest[:n]
+	return ad, nil
+}
+
+// parseCOSEKey converts a COSE_Key (RFC 9053) for one of the supported algorithms to a public key.
+func parseCOSEKey(data []byte) (crypto.PublicKey, error) {
+	v, _, err := decodeCBOR(data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: public key: %v", ErrWebAuthn, err)
+	}
+	key, ok := v.(map[any]any)
+	if !ok {
+		return nil, fmt.Errorf("%w: public key is not a map", ErrWebAuthn)
+	}
+	alg, _ := key[int64(3)].(int64)
+
+	switch alg {
+	case coseAlgES256:
+		x, _ := key[int64(-2)].([]byte)
+		y, _ := key[int64(-3)].([]byte)
+		if crv, _ := key[int64(-1)].(int64); crv != 1 || len(x) != 32 || len(y) != 32 {
+			return nil, fmt.Errorf("%w: invalid P-256 key", ErrWebAuthn)
+		}
+		pub := &ecdsa.PublicKey{Curve: elliptic.P256(), X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+		if !pub.Curve.IsOnCurve(pub.X, pub.Y) {
+			return nil, fmt.Errorf("%w: point is not on curve", ErrWebAuthn)
+		}
+		return pub, nil
+	case coseAlgEdDSA:
+		x, _ := key[int64(-2)].([]byte)
+		if crv, _ := key[int64(-1)].(int64); crv != 6 || len(x) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("%w: invalid Ed25519 key", ErrWebAuthn)
+		}
+		return ed25519.PublicKey(x), nil
+	case coseAlgRS256:
+		n, _ := key[int64(-1)].([]byte)
+		e, _ := key[int64(-2)].([]byte)
+		if len(n) < 256 || len(e) == 0 || len(e) > 4 {
+			return nil, fmt.Errorf("%w: invalid RSA key", ErrWebAuthn)
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	default:
+		return nil, fmt.Errorf("%w: unsupported algorithm %d", ErrWebAuthn, alg)
+	}
+}
+
+func verifySignature(der, signed, sig []byte) error {
+	pub, err := x509.ParsePKIXPublicKey(der)
+	if err != nil {
+		return fmt.Errorf("%w: parse stored public key: %v", ErrWebAuthn, err)
+	}
+	digest := sha256.Sum256(signed)
+	ok := false
+	switch pub := pub.(type) {
+	case *ecdsa.PublicKey:
+		ok = ecdsa.VerifyASN1(pub, digest[:], sig)
+	case ed25519.PublicKey:
+		ok = ed25519.Verify(pub, signed, sig)
+	case *rsa.PublicKey:
+		ok = rsa.VerifyPKCS1v15(pub, crypto.SHA256, digest[:], sig) == nil
+	}
+	if !ok {
+		return fmt.Errorf("%w: invalid signature", ErrWebAuthn)
+	}
+	return nil
+}
@@ -0,0 +1,172 @@
+// Package backendopts translates the structured storage backend options of repos to the environment variables and
+// extended options restic reads, and validates them.
+package backendopts
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/hashicorp/go-multierror"
+)
+
+// s3StorageClasses are the storage classes restic accepts for s3.storage-class.
+var s3StorageClasses = []string{"STANDARD", "STANDARD_IA", "ONEZONE_IA", "INTELLIGENT_TIERING", "REDUCED_REDUNDANCY"}
+
+// rcloneArgsKeepVersions runs rclone without --b2-hard-delete, restic's default arguments delete every version.
+const rcloneArgsKeepVersions = "serve restic --stdio"
+
+// Env returns the environment variables configuring the repo's backend.
+func Env(repo *v1.Repo) []string {
+	var env []string
+	add := func(name, value string) {
+		if value != "" {
+			env = append(env, name+"="+value)
+		}
+	}
+
+	switch b := repo.GetBackendOptions().GetBackend().(type) {
+	case *v1.BackendOptions_S3_:
+		add("AWS_ACCESS_KEY_ID", b.S3.AccessKeyId)
+		add("AWS_SECRET_ACCESS_KEY", b.S3.SecretAccessKey)
+		add("AWS_SESSION_TOKEN", b.S3.SessionToken)
+		add("AWS_PROFILE", b.S3.Profile)
+	case *v1.BackendOptions_B2_:
+		add("B2_ACCOUNT_ID", b.B2.AccountId)
+		add("B2_ACCOUNT_KEY", b.B2.AccountKey)
+	case *v1.BackendOptions_Azure_:
+		add("AZURE_ACCOUNT_NAME", b.Azure.AccountName)
+		add("AZURE_ACCOUNT_KEY", b.Azure.AccountKey)
+		add("AZURE_ACCOUNT_SAS", b.Azure.AccountSas)
+		add("AZURE_ENDPOINT_SUFFIX", b.Azure.EndpointSuffix)
+	}
+	return env
+}
+
+// Args returns the restic arguments configuring the repo's backend as extended options.
+func Args(repo *v1.Repo) []string {
+	var args []string
+	add := func(name, value string) {
+		if value != "" {
+			args = append(args, "-o", name+"="+value)
+		}
+	}
+
+	opts := repo.GetBackendOptions()
+	switch b := opts.GetBackend().(type) {
+	case *v1.BackendOptions_S3_:
+		add("s3.region", b.S3.Region)
+		add("s3.storage-class", b.S3.StorageClass)
+		add("s3.bucket-lookup", b.S3.BucketLookup)
+	case *v1.BackendOptions_B2_:
+		if b.B2.HideDeleted {
+			add("rclone.args", rcloneArgsKeepVersions)
+		}
+	}
+	if opts.GetConnections() > 0 {
+		if scheme := uriScheme(repo.GetUri()); scheme != "" {
+			add(scheme+".connections", fmt.Sprintf("%d", opts.GetConnections()))
+		}
+	}
+	return args
+}
+
+// Validate checks that the backend options match the repo's uri, that mutually exclusive settings aren't combined
+// and that the repo's env and flags don't also set what the options do.
+func Validate(repo *v1.Repo) error {
+	opts := repo.GetBackendOptions()
+	if opts == nil {
+		return nil
+	}
+
+	var err error
+	scheme := uriScheme(repo.GetUri())
+	if opts.Connections < 0 {
+		err = multierror.Append(err, errors.New("connections must not be negative"))
+	} else if opts.Connections > 0 && scheme == "" {
+		err = multierror.Append(err, fmt.Errorf("connections can't be set for uri %q, it names no backend", repo.GetUri()))
+	}
+
+	switch b := opts.GetBackend().(type) {
+	case *v1.BackendOptions_S3_:
+		if scheme != "s3" {
+			err = multierror.Append(err, fmt.Errorf("s3 options require an s3: uri, got %q", repo.GetUri()))
+		}
+		s3 := b.S3
+		if (s3.AccessKeyId == "") != (s3.SecretAccessKey == "") {
+			err = multierror.Append(err, errors.New("s3 access_key_id and secret_access_key must be set together"))
+		}
+		if s3.SessionToken != "" && s3.AccessKeyId == "" {
+			err = multierror.Append(err, errors.New("s3 session_token requires access keys"))
+		}
+		if s3.Profile != "" && s3.AccessKeyId != "" {
+			err = multierror.Append(err, errors.New("s3 profile and access keys are mutually exclusive"))
+		}
+		if s3.StorageClass != "" && !slices.Contains(s3StorageClasses, s3.StorageClass) {
+			err = multierror.Append(err, fmt.Errorf("invalid s3 storage_class %q, must be one of %s", s3.StorageClass, strings.Join(s3StorageClasses, ", ")))
+		}
+		switch s3.BucketLookup {
+		case "", "auto", "dns", "path":
+		default:
+			err = multierror.Append(err, fmt.Errorf("invalid s3 bucket_lookup %q, must be one of auto, dns, path", s3.BucketLookup))
+		}
+	case *v1.BackendOptions_B2_:
+		b2 := b.B2
+		switch {
+		case scheme == "b2" && b2.HideDeleted:
+			err = multierror.Append(err, errors.New("b2 hide_deleted requires an rclone: uri, restic's b2 backend always deletes every version"))
+		case scheme == "rclone" && (b2.AccountId != "" || b2.AccountKey != ""):
+			err = multierror.Append(err, errors.New("b2 credentials are configured in the rclone remote for rclone: uris"))
+		case scheme == "rclone" && !b2.HideDeleted:
+			err = multierror.Append(err, errors.New("b2 options of an rclone: uri only set hide_deleted"))
+		case scheme != "b2" && scheme != "rclone":
+			err = multierror.Append(err, fmt.Errorf("b2 options require a b2: or rclone: uri, got %q", repo.GetUri()))
+		}
+		if (b2.AccountId == "") != (b2.AccountKey == "") {
+			err = multierror.Append(err, errors.New("b2 account_id and account_key must be set together"))
+		}
+	case *v1.BackendOptions_Azure_:
+		if scheme != "azure" {
+			err = multierror.Append(err, fmt.Errorf("azure options require an azure: uri, got %q", repo.GetUri()))
+		}
+		azure := b.Azure
+		if azure.AccountKey != "" && azure.AccountSas != "" {
+			err = multierror.Append(err, errors.New("azure account_key and account_sas are mutually exclusive"))
+		}
+		if azure.AccountName == "" && (azure.AccountKey != "" || azure.AccountSas != "") {
+			err = multierror.Append(err, errors.New("azure account_name is required with account_key or account_sas"))
+		}
+	}
+
+	for _, kv := range Env(repo) {
+		name, _, _ := strings.Cut(kv, "=")
+		if slices.ContainsFunc(repo.GetEnv(), func(env string) bool { return strings.HasPrefix(env, name+"=") }) {
+			err = multierror.Append(err, fmt.Errorf("env var %s is also set by the backend options", name))
+		}
+	}
+	args := Args(repo)
+	for i := 1; i < len(args); i += 2 {
+		option, _, _ := strings.Cut(args[i], "=")
+		if slices.ContainsFunc(repo.GetFlags(), func(flag string) bool { return strings.Contains(flag, option+"=") }) ||
+			slices.ContainsFunc(repo.GetExtraFlags(), func(flag *v1.ResticFlag) bool { return strings.HasPrefix(flag.GetValue(), option+"=") }) {
+			err = multierror.Append(err, fmt.Errorf("extended option %s is also set by the repo's flags", option))
+		}
+	}
+	return err
+}
+
+// uriScheme returns the backend named by the uri's scheme e.g. "s3", "" for local repos.
+func uriScheme(uri string) string {
+	scheme, _, ok := strings.Cut(uri, ":")
+	if !ok {
+		return ""
+	}
+	switch scheme {
+	case "s3", "b2", "azure", "gs", "rclone", "rest", "sftp", "swift":
+		return scheme
+	default:
+		return ""
+	}
+}
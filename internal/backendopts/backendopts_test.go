@@ -0,0 +1,82 @@
+package backendopts
+
+import (
+	"slices"
+	"testing"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+)
+
+func TestEnvAndArgs(t *testing.T) {
+	repo := &v1.Repo{
+		Uri: "s3:s3.us-west-2.amazonaws.com/bucket",
+		BackendOptions: &v1.BackendOptions{
+			Backend: &v1.BackendOptions_S3_{S3: &v1.BackendOptions_S3{
+				AccessKeyId:     "id",
+				SecretAccessKey: "secret",
+				Region:          "us-west-2",
+				StorageClass:    "STANDARD_IA",
+			}},
+			Connections: 8,
+		},
+	}
+	if got, want := Env(repo), []string{"AWS_ACCESS_KEY_ID=id", "AWS_SECRET_ACCESS_KEY=secret"}; !slices.Equal(got, want) {
+		t.Errorf("Env() = %v, want %v", got, want)
+	}
+	if got, want := Args(repo), []string{"-o", "s3.region=us-west-2", "-o", "s3.storage-class=STANDARD_IA", "-o", "s3.connections=8"}; !slices.Equal(got, want) {
+		t.Errorf("Args() = %v, want %v", got, want)
+	}
+
+	rclone := &v1.Repo{
+		Uri:            "rclone:b2remote:bucket/path",
+		BackendOptions: &v1.BackendOptions{Backend: &v1.BackendOptions_B2_{B2: &v1.BackendOptions_B2{HideDeleted: true}}},
+	}
+	if got, want := Args(rclone), []string{"-o", "rclone.args=serve restic --stdio"}; !slices.Equal(got, want) {
+		t.Errorf("Args() = %v, want %v", got, want)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	s3 := func(uri string, opts *v1.BackendOptions_S3) *v1.Repo {
+		return &v1.Repo{Uri: uri, BackendOptions: &v1.BackendOptions{Backend: &v1.BackendOptions_S3_{S3: opts}}}
+	}
+	b2 := func(uri string, opts *v1.BackendOptions_B2) *v1.Repo {
+		return &v1.Repo{Uri: uri, BackendOptions: &v1.BackendOptions{Backend: &v1.BackendOptions_B2_{B2: opts}}}
+	}
+	azure := func(opts *v1.BackendOptions_Azure) *v1.Repo {
+		return &v1.Repo{Uri: "azure:container:/", BackendOptions: &v1.BackendOptions{Backend: &v1.BackendOptions_Azure_{Azure: opts}}}
+	}
+	withEnv := s3("s3:host/bucket", &v1.BackendOptions_S3{AccessKeyId: "id", SecretAccessKey: "secret"})
+	withEnv.Env = []string{"AWS_ACCESS_KEY_ID=other"}
+	withFlag := s3("s3:host/bucket", &v1.BackendOptions_S3{Region: "eu-west-1"})
+	withFlag.Flags = []string{"-o s3.region=us-east-1"}
+
+	tests := []struct {
+		name    string
+		repo    *v1.Repo
+		wantErr bool
+	}{
+		{name: "no options", repo: &v1.Repo{Uri: "/tmp/repo"}},
+		{name: "s3", repo: s3("s3:host/bucket", &v1.BackendOptions_S3{AccessKeyId: "id", SecretAccessKey: "secret", BucketLookup: "path"})},
+		{name: "s3 options on a b2 uri", repo: s3("b2:bucket", &v1.BackendOptions_S3{Region: "us-east-1"}), wantErr: true},
+		{name: "s3 key without secret", repo: s3("s3:host/bucket", &v1.BackendOptions_S3{AccessKeyId: "id"}), wantErr: true},
+		{name: "s3 profile and keys", repo: s3("s3:host/bucket", &v1.BackendOptions_S3{Profile: "p", AccessKeyId: "id", SecretAccessKey: "secret"}), wantErr: true},
+		{name: "s3 invalid storage class", repo: s3("s3:host/bucket", &v1.BackendOptions_S3{StorageClass: "GLACIER"}), wantErr: true},
+		{name: "s3 invalid bucket lookup", repo: s3("s3:host/bucket", &v1.BackendOptions_S3{BucketLookup: "virtual"}), wantErr: true},
+		{name: "b2", repo: b2("b2:bucket", &v1.BackendOptions_B2{AccountId: "id", AccountKey: "key"})},
+		{name: "b2 hide deleted", repo: b2("b2:bucket", &v1.BackendOptions_B2{HideDeleted: true}), wantErr: true},
+		{name: "b2 hide deleted with rclone", repo: b2("rclone:remote:bucket", &v1.BackendOptions_B2{HideDeleted: true})},
+		{name: "b2 credentials with rclone", repo: b2("rclone:remote:bucket", &v1.BackendOptions_B2{AccountId: "id", AccountKey: "key", HideDeleted: true}), wantErr: true},
+		{name: "azure sas", repo: azure(&v1.BackendOptions_Azure{AccountName: "name", AccountSas: "sas"})},
+		{name: "azure key and sas", repo: azure(&v1.BackendOptions_Azure{AccountName: "name", AccountKey: "key", AccountSas: "sas"}), wantErr: true},
+		{name: "azure key without name", repo: azure(&v1.BackendOptions_Azure{AccountKey: "key"}), wantErr: true},
+		{name: "connections of a local repo", repo: &v1.Repo{Uri: "/tmp/repo", BackendOptions: &v1.BackendOptions{Connections: 4}}, wantErr: true},
+		{name: "env conflict", repo: withEnv, wantErr: true},
+		{name: "flag conflict", repo: withFlag, wantErr: true},
+	}
+	for _, tc := range tests {
+		if err := Validate(tc.repo); (err != nil) != tc.wantErr {
+			t.Errorf("%s: Validate() error = %v, wantErr %v", tc.name, err, tc.wantErr)
+		}
+	}
+}
@@ -84,6 +84,206 @@ func TestConfig(t *testing.T) {
 			wantErr:         true,
 			wantErrContains: "invalid cron \"bad cron\"",
 		},
+		{
+			name: "restore drill with bad cron",
+			config: &v1.Config{
+				Repos: []*v1.Repo{
+					testRepo,
+				},
+				Plans: []*v1.Plan{
+					{
+						Id:           "test-plan",
+						Repo:         "test-repo",
+						Paths:        []string{"/tmp/foo"},
+						Cron:         "* * * * *",
+						RestoreDrill: &v1.RestoreDrill{Cron: "bad cron"},
+					},
+				},
+			},
+			store:           &CachingValidatingStore{ConfigStore: &JsonFileStore{Path: dir + "/invalid-config5.json"}},
+			wantErr:         true,
+			wantErrContains: "invalid restore_drill cron",
+		},
+		{
+			name: "plan with disallowed extra flag",
+			config: &v1.Config{
+				Repos: []*v1.Repo{
+					testRepo,
+				},
+				Plans: []*v1.Plan{
+					{
+						Id:         "test-plan",
+						Repo:       "test-repo",
+						Paths:      []string{"/tmp/foo"},
+						Cron:       "* * * * *",
+						ExtraFlags: []*v1.ResticFlag{{Name: "--one-file-system"}, {Name: "--repo", Value: "/elsewhere"}},
+					},
+				},
+			},
+			store:           &CachingValidatingStore{ConfigStore: &JsonFileStore{Path: dir + "/invalid-config6.json"}},
+			wantErr:         true,
+			wantErrContains: "flag is not allowed: \"--repo\"",
+		},
+		{
+			name: "path options for a path not in the plan",
+			config: &v1.Config{
+				Repos: []*v1.Repo{
+					testRepo,
+				},
+				Plans: []*v1.Plan{
+					{
+						Id:          "test-plan",
+						Repo:        "test-repo",
+						Paths:       []string{"/tmp/foo"},
+						Cron:        "* * * * *",
+						PathOptions: []*v1.PathOptions{{Path: "/tmp/bar", OneFileSystem: true}},
+					},
+				},
+			},
+			store:           &CachingValidatingStore{ConfigStore: &JsonFileStore{Path: dir + "/invalid-config7.json"}},
+			wantErr:         true,
+			wantErrContains: "is not one of the plan's paths",
+		},
+		{
+			name: "plans with cyclic dependencies",
+			config: &v1.Config{
+				Repos: []*v1.Repo{
+					testRepo,
+				},
+				Plans: []*v1.Plan{
+					{
+						Id:           "local",
+						Repo:         "test-repo",
+						Paths:        []string{"/tmp/foo"},
+						Cron:         "* * * * *",
+						Dependencies: &v1.PlanDependencies{Requires: []string{"offsite"}},
+					},
+					{
+						Id:           "offsite",
+						Repo:         "test-repo",
+						Paths:        []string{"/tmp/foo"},
+						Dependencies: &v1.PlanDependencies{After: []string{"local"}},
+					},
+				},
+			},
+			store:           &CachingValidatingStore{ConfigStore: &JsonFileStore{Path: dir + "/invalid-config8.json"}},
+			wantErr:         true,
+			wantErrContains: "cycle local -> offsite -> local",
+		},
+		{
+			name: "restore drill of a cold storage repo",
+			config: &v1.Config{
+				Repos: []*v1.Repo{
+					{Id: "cold-repo", Uri: "/tmp/cold", Password: "test", ColdStorage: &v1.ColdStorage{Enabled: true}},
+				},
+				Plans: []*v1.Plan{
+					{
+						Id:           "test-plan",
+						Repo:         "cold-repo",
+						Paths:        []string{"/tmp/foo"},
+						Cron:         "* * * * *",
+						RestoreDrill: &v1.RestoreDrill{Cron: "0 0 * * *"},
+					},
+				},
+			},
+			store:           &CachingValidatingStore{ConfigStore: &JsonFileStore{Path: dir + "/invalid-config9.json"}},
+			wantErr:         true,
+			wantErrContains: "restore_drill can't be set, repo \"cold-repo\" is on cold storage",
+		},
+		{
+			name: "retention policy of an append-only repo",
+			config: &v1.Config{
+				Repos: []*v1.Repo{
+					{Id: "append-only-repo", Uri: "rest:https://host/repo", Password: "test", AppendOnly: &v1.AppendOnly{Enabled: true}},
+				},
+				Plans: []*v1.Plan{
+					{
+						Id:        "test-plan",
+						Repo:      "append-only-repo",
+						Paths:     []string{"/tmp/foo"},
+						Cron:      "* * * * *",
+						Retention: &v1.RetentionPolicy{KeepLastN: 10},
+					},
+				},
+			},
+			store:           &CachingValidatingStore{ConfigStore: &JsonFileStore{Path: dir + "/invalid-config10.json"}},
+			wantErr:         true,
+			wantErrContains: "repo \"append-only-repo\" is append-only",
+		},
+		{
+			name: "plan template without plan id",
+			config: &v1.Config{
+				PlanTemplates: []*v1.PlanTemplate{{Id: "home", Plan: &v1.Plan{Repo: "repo", Paths: []string{"${path}"}}}},
+			},
+			store:           &CachingValidatingStore{ConfigStore: &JsonFileStore{Path: dir + "/invalid-config11.json"}},
+			wantErr:         true,
+			wantErrContains: "plan id is required",
+		},
+		{
+			name: "webhook trigger with a short token",
+			config: &v1.Config{
+				Repos: []*v1.Repo{
+					{Id: "test-repo", Uri: "/tmp/test", Password: "test"},
+				},
+				Plans: []*v1.Plan{
+					{
+						Id:             "test-plan",
+						Repo:           "test-repo",
+						Paths:          []string{"/tmp/foo"},
+						Cron:           "* * * * *",
+						WebhookTrigger: &v1.WebhookTrigger{Token: "short"},
+					},
+				},
+			},
+			store:           &CachingValidatingStore{ConfigStore: &JsonFileStore{Path: dir + "/invalid-config12.json"}},
+			wantErr:         true,
+			wantErrContains: "webhook_trigger token must be at least 16 characters",
+		},
+		{
+			name: "proxy header auth without trusted proxies",
+			config: &v1.Config{
+				Auth: &v1.Auth{
+					ProxyHeader: &v1.ProxyHeaderAuth{Enabled: true},
+				},
+			},
+			store:           &CachingValidatingStore{ConfigStore: &JsonFileStore{Path: dir + "/invalid-config4.json"}},
+			wantErr:         true,
+			wantErrContains: "trusted_proxies is required",
+		},
+		{
+			name: "plan with additional repos",
+			config: &v1.Config{
+				Repos: []*v1.Repo{testRepo, {Id: "offsite-repo", Uri: "/tmp/offsite", Password: "test"}},
+				Plans: []*v1.Plan{
+					{
+						Id:              "test-plan",
+						Repo:            "test-repo",
+						AdditionalRepos: []string{"offsite-repo"},
+						Paths:           []string{"/tmp/foo"},
+						Cron:            "* * * * *",
+					},
+				},
+			},
+			store: &CachingValidatingStore{ConfigStore: &JsonFileStore{Path: dir + "/valid-config2.json"}},
+		},
+		{
+			name: "plan lists its repo as an additional repo",
+			config: &v1.Config{
+				Repos: []*v1.Repo{testRepo},
+				Plans: []*v1.Plan{
+					{
+						Id:              "test-plan",
+						Repo:            "test-repo",
+						AdditionalRepos: []string{"test-repo"},
+						Paths:           []string{"/tmp/foo"},
+						Cron:            "* * * * *",
+					},
+				},
+			},
+			store:           &CachingValidatingStore{ConfigStore: &JsonFileStore{Path: dir + "/invalid-config13.json"}},
+			wantErr:         true,
+			wantErrContains: "additional repo \"test-repo\" is listed more than once",
+		},
 	}
 
 	for _, tc := range tests {
@@ -6,6 +6,7 @@ import (
 	"os"
 	"path"
 	"runtime"
+	"strconv"
 	"strings"
 )
 
@@ -14,11 +15,18 @@ var (
 	EnvVarDataDir     = "BACKREST_DATA"           // path to data directory
 	EnvVarBindAddress = "BACKREST_PORT"           // port to bind to (default 9898)
 	EnvVarBinPath     = "BACKREST_RESTIC_COMMAND" // path to restic binary (default restic)
+	EnvVarUnixSocket  = "BACKREST_UNIX_SOCKET"    // path to a unix socket to serve the API on (default none)
+	EnvVarPluginsDir  = "BACKREST_PLUGINS_DIR"    // path to the directory hook plugins are discovered in (default DataDir()/plugins)
+	EnvVarReadOnly    = "BACKREST_READ_ONLY"      // if true the API only serves RPCs that don't change anything (default false)
 )
 
 var flagDataDir = flag.String("data-dir", "", "path to data directory, defaults to XDG_DATA_HOME/.local/backrest. Overrides BACKREST_DATA environment variable.")
 var flagConfigPath = flag.String("config-file", "", "path to config file, defaults to XDG_CONFIG_HOME/backrest/config.json. Overrides BACKREST_CONFIG environment variable.")
 var flagBindAddress = flag.String("bind-address", "", "address to bind to, defaults to :9898. Use 127.0.0.1:9898 to listen only on localhost. Overrides BACKREST_PORT environment variable.")
+var flagUnixSocket = flag.String("unix-socket", "", "path to a unix socket to serve the API on in addition to the bind address, disabled by default. Overrides BACKREST_UNIX_SOCKET environment variable.")
+var flagUnixSocketMode = flag.String("unix-socket-mode", "0660", "file mode of the unix socket, as an octal number.")
+var flagPluginsDir = flag.String("plugins-dir", "", "path to the directory hook plugins are discovered in, defaults to the plugins directory in the data directory. Overrides BACKREST_PLUGINS_DIR environment variable.")
+var flagReadOnly = flag.Bool("read-only", false, "serve only the API calls that don't change the config, history or repos e.g. for a status dashboard. Overrides BACKREST_READ_ONLY environment variable.")
 var flagResticBinPath = flag.String("restic-cmd", "", "path to restic binary, defaults to a backrest managed version of restic. Overrides BACKREST_RESTIC_COMMAND environment variable.")
 
 // ConfigFilePath
@@ -64,6 +72,43 @@ func BindAddress() string {
 	return ":9898"
 }
 
+// UnixSocket returns the path of the unix socket to serve the API on, empty if it isn't served on a socket.
+func UnixSocket() string {
+	if *flagUnixSocket != "" {
+		return *flagUnixSocket
+	}
+	return os.Getenv(EnvVarUnixSocket)
+}
+
+// UnixSocketMode returns the file mode of the unix socket.
+func UnixSocketMode() (os.FileMode, error) {
+	mode, err := strconv.ParseUint(*flagUnixSocketMode, 8, 32)
+	if err != nil || mode > 0777 {
+		return 0, fmt.Errorf("invalid unix socket mode %q, want an octal permission like 0660", *flagUnixSocketMode)
+	}
+	return os.FileMode(mode), nil
+}
+
+// PluginsDir returns the directory hook plugins are discovered in.
+func PluginsDir() string {
+	if *flagPluginsDir != "" {
+		return *flagPluginsDir
+	}
+	if val := os.Getenv(EnvVarPluginsDir); val != "" {
+		return val
+	}
+	return path.Join(DataDir(), "plugins")
+}
+
+// ReadOnly returns whether the API rejects calls that change the config, the operation history or repos.
+func ReadOnly() bool {
+	if *flagReadOnly {
+		return true
+	}
+	readOnly, _ := strconv.ParseBool(os.Getenv(EnvVarReadOnly))
+	return readOnly
+}
+
 func ResticBinPath() string {
 	if *flagResticBinPath != "" {
 		return *flagResticBinPath
@@ -0,0 +1,177 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/natefinch/atomic"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+var ErrRevisionNotFound = errors.New("config revision not found")
+
+// RevisionStore is implemented by config stores that keep a history of the revisions written to them.
+type RevisionStore interface {
+	// Revisions returns the revisions available, oldest first.
+	Revisions() ([]*v1.ConfigRevision, error)
+	// Revision returns the revision with the given number or ErrRevisionNotFound.
+	Revision(revision int64) (*v1.ConfigRevision, error)
+}
+
+// HistoryStore is a ConfigStore that keeps a copy of every config written to it in Dir.
+type HistoryStore struct {
+	ConfigStore
+	Dir          string
+	MaxRevisions int // number of revisions to keep, defaults to 100.
+
+	mu sync.Mutex
+}
+
+var _ ConfigStore = &HistoryStore{}
+var _ RevisionStore = &HistoryStore{}
+
+func (h *HistoryStore) Update(config *v1.Config) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	revisions, err := h.revisionNumbers()
+	if err != nil {
+		return err
+	}
+
+	// record the config as it was before history was first kept so that the first edit can also be rolled back.
+	if len(revisions) == 0 {
+		if existing, err := h.ConfigStore.Get(); err == nil && existing != nil {
+			if err := h.writeRevision(1, existing); err != nil {
+				return err
+			}
+			revisions = append(revisions, 1)
+		}
+	}
+
+	if err := h.ConfigStore.Update(config); err != nil {
+		return err
+	}
+
+	next := int64(1)
+	if len(revisions) > 0 {
+		next = revisions[len(revisions)-1] + 1
+	}
+	if err := h.writeRevision(next, config); err != nil {
+		return err
+	}
+	revisions = append(revisions, next)
+
+	maxRevisions := h.MaxRevisions
+	if maxRevisions == 0 {
+		maxRevisions = 100
+	}
+	for len(revisions) > maxRevisions {
+		if err := os.Remove(h.revisionPath(revisions[0])); err != nil {
+			return fmt.Errorf("remove expired revision %d: %w", revisions[0], err)
+		}
+		revisions = revisions[1:]
+	}
+	return nil
+}
+
+func (h *HistoryStore) Revisions() ([]*v1.ConfigRevision, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	numbers, err := h.revisionNumbers()
+	if err != nil {
+		return nil, err
+	}
+	revisions := make([]*v1.ConfigRevision, 0, len(numbers))
+	for _, n := range numbers {
+		rev, err := h.readRevision(n)
+		if err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, rev)
+	}
+	return revisions, nil
+}
+
+func (h *HistoryStore) Revision(revision int64) (*v1.ConfigRevision, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.readRevision(revision)
+}
+
+func (h *HistoryStore) revisionPath(revision int64) string {
+	return path.Join(h.Dir, fmt.Sprintf("%08d.json", revision))
+}
+
+// revisionNumbers returns the revision numbers stored in Dir in ascending order.
+func (h *HistoryStore) revisionNumbers() ([]int64, error) {
+	ents, err := os.ReadDir(h.Dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("read config history dir: %w", err)
+	}
+	var revisions []int64
+	for _, ent := range ents {
+		name, ok := strings.CutSuffix(ent.Name(), ".json")
+		if !ok {
+			continue
+		}
+		n, err := strconv.ParseInt(name, 10, 64)
+		if err != nil {
+			continue
+		}
+		revisions = append(revisions, n)
+	}
+	sort.Slice(revisions, func(i, j int) bool { return revisions[i] < revisions[j] })
+	return revisions, nil
+}
+
+func (h *HistoryStore) writeRevision(revision int64, config *v1.Config) error {
+	data, err := protojson.MarshalOptions{Indent: "  ", Multiline: true}.Marshal(&v1.ConfigRevision{
+		Revision:   revision,
+		UnixTimeMs: time.Now().UnixMilli(),
+		Config:     config,
+	})
+	if err != nil {
+		return fmt.Errorf("marshal config revision: %w", err)
+	}
+	if err := os.MkdirAll(h.Dir, 0700); err != nil {
+		return fmt.Errorf("create config history dir: %w", err)
+	}
+	p := h.revisionPath(revision)
+	if err := atomic.WriteFile(p, bytes.NewReader(data)); err != nil {
+		return fmt.Errorf("write config revision: %w", err)
+	}
+	// revisions contain the same secrets as the config itself.
+	if err := os.Chmod(p, 0600); err != nil {
+		return fmt.Errorf("chmod(0600) config revision: %w", err)
+	}
+	return nil
+}
+
+func (h *HistoryStore) readRevision(revision int64) (*v1.ConfigRevision, error) {
+	data, err := os.ReadFile(h.revisionPath(revision))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("revision %d: %w", revision, ErrRevisionNotFound)
+		}
+		return nil, fmt.Errorf("read config revision %d: %w", revision, err)
+	}
+	var rev v1.ConfigRevision
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(data, &rev); err != nil {
+		return nil, fmt.Errorf("unmarshal config revision %d: %w", revision, err)
+	}
+	return &rev, nil
+}
@@ -0,0 +1,69 @@
+package config
+
+import (
+	"errors"
+	"testing"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+)
+
+func TestHistoryStore(t *testing.T) {
+	store := &HistoryStore{
+		ConfigStore:  &MemoryStore{Config: &v1.Config{Modno: 1, Host: "initial"}},
+		Dir:          t.TempDir(),
+		MaxRevisions: 3,
+	}
+
+	for i, host := range []string{"a", "b", "c"} {
+		if err := store.Update(&v1.Config{Modno: int32(i + 2), Host: host}); err != nil {
+			t.Fatalf("Update() error: %v", err)
+		}
+	}
+
+	revisions, err := store.Revisions()
+	if err != nil {
+		t.Fatalf("Revisions() error: %v", err)
+	}
+	if len(revisions) != 3 {
+		t.Fatalf("want 3 revisions after expiry, got %d", len(revisions))
+	}
+	wantHosts := []string{"a", "b", "c"}
+	for i, rev := range revisions {
+		if rev.Revision != int64(i+2) {
+			t.Errorf("revision %d: want number %d, got %d", i, i+2, rev.Revision)
+		}
+		if rev.Config.GetHost() != wantHosts[i] {
+			t.Errorf("revision %d: want host %q, got %q", i, wantHosts[i], rev.Config.GetHost())
+		}
+	}
+
+	rev, err := store.Revision(3)
+	if err != nil {
+		t.Fatalf("Revision(3) error: %v", err)
+	}
+	if rev.Config.GetHost() != "b" {
+		t.Errorf("want host b, got %q", rev.Config.GetHost())
+	}
+
+	if _, err := store.Revision(1); !errors.Is(err, ErrRevisionNotFound) {
+		t.Errorf("want ErrRevisionNotFound for expired revision, got %v", err)
+	}
+}
+
+func TestHistoryStoreRecordsInitialConfig(t *testing.T) {
+	store := &HistoryStore{
+		ConfigStore: &MemoryStore{Config: &v1.Config{Host: "initial"}},
+		Dir:         t.TempDir(),
+	}
+	if err := store.Update(&v1.Config{Host: "updated"}); err != nil {
+		t.Fatalf("Update() error: %v", err)
+	}
+
+	rev, err := store.Revision(1)
+	if err != nil {
+		t.Fatalf("Revision(1) error: %v", err)
+	}
+	if rev.Config.GetHost() != "initial" {
+		t.Errorf("want initial config recorded as revision 1, got host %q", rev.Config.GetHost())
+	}
+}
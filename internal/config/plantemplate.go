@@ -0,0 +1,74 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/hashicorp/go-multierror"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+var templateVarRegex = regexp.MustCompile(`\$\{([a-zA-Z_][a-zA-Z0-9_]*)\}`)
+
+// InstantiatePlanTemplate returns the template's plan with the ${name} variables in its string fields substituted,
+// the hooks of the plan are copied as is.
+func InstantiatePlanTemplate(tmpl *v1.PlanTemplate, vars map[string]string) (*v1.Plan, error) {
+	if tmpl.GetPlan() == nil {
+		return nil, fmt.Errorf("template %q has no plan", tmpl.GetId())
+	}
+	plan := proto.Clone(tmpl.Plan).(*v1.Plan)
+
+	var err error
+	expand := func(s string) string {
+		return templateVarRegex.ReplaceAllStringFunc(s, func(match string) string {
+			name := templateVarRegex.FindStringSubmatch(match)[1]
+			value, ok := vars[name]
+			if !ok {
+				err = multierror.Append(err, fmt.Errorf("undefined variable %q", name))
+				return match
+			}
+			return value
+		})
+	}
+	expandMessage(plan.ProtoReflect(), expand)
+	if err != nil {
+		return nil, fmt.Errorf("template %q: %w", tmpl.Id, err)
+	}
+	return plan, nil
+}
+
+// expandMessage replaces the populated string fields of the message and its nested messages, other than hooks, with
+// their expansion.
+func expandMessage(m protoreflect.Message, expand func(string) string) {
+	if _, ok := m.Interface().(*v1.Hook); ok {
+		return
+	}
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsMap():
+			if fd.MapValue().Kind() == protoreflect.StringKind {
+				v.Map().Range(func(k protoreflect.MapKey, val protoreflect.Value) bool {
+					v.Map().Set(k, protoreflect.ValueOfString(expand(val.String())))
+					return true
+				})
+			}
+		case fd.IsList():
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				switch fd.Kind() {
+				case protoreflect.StringKind:
+					list.Set(i, protoreflect.ValueOfString(expand(list.Get(i).String())))
+				case protoreflect.MessageKind:
+					expandMessage(list.Get(i).Message(), expand)
+				}
+			}
+		case fd.Kind() == protoreflect.StringKind:
+			m.Set(fd, protoreflect.ValueOfString(expand(v.String())))
+		case fd.Kind() == protoreflect.MessageKind:
+			expandMessage(v.Message(), expand)
+		}
+		return true
+	})
+}
@@ -0,0 +1,44 @@
+package config
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+)
+
+func TestInstantiatePlanTemplate(t *testing.T) {
+	tmpl := &v1.PlanTemplate{
+		Id: "home",
+		Plan: &v1.Plan{
+			Id:       "home-${hostname}",
+			Repo:     "repo",
+			Paths:    []string{"${path}"},
+			Excludes: []string{"${path}/.cache"},
+			Host:     "${hostname}",
+			Hooks: []*v1.Hook{{
+				Action: &v1.Hook_ActionCommand{ActionCommand: &v1.Hook_Command{Command: "echo ${HOME}"}},
+			}},
+		},
+	}
+
+	plan, err := InstantiatePlanTemplate(tmpl, map[string]string{"hostname": "laptop", "path": "/home/alice"})
+	if err != nil {
+		t.Fatalf("instantiate: %v", err)
+	}
+	if plan.Id != "home-laptop" || plan.Host != "laptop" || !slices.Equal(plan.Paths, []string{"/home/alice"}) ||
+		!slices.Equal(plan.Excludes, []string{"/home/alice/.cache"}) {
+		t.Errorf("want the variables substituted, got %v", plan)
+	}
+	if cmd := plan.Hooks[0].GetActionCommand().GetCommand(); cmd != "echo ${HOME}" {
+		t.Errorf("want hooks copied as is, got command %q", cmd)
+	}
+	if tmpl.Plan.Id != "home-${hostname}" {
+		t.Errorf("want the template unchanged, got plan id %q", tmpl.Plan.Id)
+	}
+
+	if _, err := InstantiatePlanTemplate(tmpl, map[string]string{"hostname": "laptop"}); err == nil || !strings.Contains(err.Error(), `undefined variable "path"`) {
+		t.Errorf("want an error for the undefined variable, got %v", err)
+	}
+}
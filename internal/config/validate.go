@@ -1,16 +1,29 @@
 package config
 
 import (
+	"encoding/base32"
 	"errors"
 	"fmt"
+	"net/netip"
+	"net/url"
+	"path/filepath"
 	"regexp"
+	"slices"
 	"strings"
+	"time"
 
 	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/backendopts"
+	"github.com/garethgeorge/backrest/internal/hook/expr"
+	"github.com/garethgeorge/backrest/internal/resticflags"
 	"github.com/gitploy-io/cronexpr"
 	"github.com/hashicorp/go-multierror"
+	"google.golang.org/protobuf/proto"
 )
 
+// minWebhookTriggerTokenLength keeps webhook trigger tokens long enough that they can't be guessed.
+const minWebhookTriggerTokenLength = 16
+
 func ValidateConfig(c *v1.Config) error {
 	var err error
 	repos := make(map[string]*v1.Repo)
@@ -26,6 +39,12 @@ func ValidateConfig(c *v1.Config) error {
 		}
 	}
 
+	for _, repo := range c.Repos {
+		if e := validateAppendOnly(repo, repos); e != nil {
+			err = multierror.Append(err, fmt.Errorf("repo %s: %w", repo.GetId(), e))
+		}
+	}
+
 	if c.Plans != nil {
 		plans := make(map[string]*v1.Plan)
 		for _, plan := range c.Plans {
@@ -37,11 +56,192 @@ func ValidateConfig(c *v1.Config) error {
 				err = multierror.Append(err, fmt.Errorf("plan %s: %w", plan.GetId(), e))
 			}
 		}
+		if e := validatePlanDependencies(c.Plans, plans); e != nil {
+			err = multierror.Append(err, e)
+		}
+	}
+
+	if e := validatePlanTemplates(c.PlanTemplates); e != nil {
+		err = multierror.Append(err, e)
+	}
+
+	if c.GetSelfBackup().GetRepo() != "" {
+		if e := validateSelfBackup(c.SelfBackup, repos); e != nil {
+			err = multierror.Append(err, fmt.Errorf("self backup: %w", e))
+		}
+	}
+
+	if c.GetMqtt() != nil {
+		if e := validateMqtt(c.Mqtt); e != nil {
+			err = multierror.Append(err, fmt.Errorf("mqtt: %w", e))
+		}
+	}
+
+	for _, user := range c.GetAuth().GetUsers() {
+		if e := validateSecondFactor(user.GetSecondFactor()); e != nil {
+			err = multierror.Append(err, fmt.Errorf("user %s: %w", user.GetName(), e))
+		}
+	}
+
+	instances := make(map[string]bool)
+	for _, instance := range c.RemoteInstances {
+		if instances[instance.Id] {
+			err = multierror.Append(err, fmt.Errorf("remote instance %s: duplicate id", instance.Id))
+		}
+		instances[instance.Id] = true
+		if e := validateRemoteInstance(instance); e != nil {
+			err = multierror.Append(err, fmt.Errorf("remote instance %s: %w", instance.Id, e))
+		}
+	}
+
+	if c.GetTls() != nil {
+		if e := validateTls(c.Tls); e != nil {
+			err = multierror.Append(err, fmt.Errorf("tls: %w", e))
+		}
+	}
+
+	if c.GetAuth() != nil {
+		if e := validateExternalAuth(c.Auth); e != nil {
+			err = multierror.Append(err, fmt.Errorf("auth: %w", e))
+		}
+	}
+
+	return err
+}
+
+func validateRemoteInstance(r *v1.RemoteInstance) error {
+	var err error
+	if r.Id == "" || r.Id == "local" {
+		err = multierror.Append(err, errors.New("id is required and must not be \"local\""))
+	}
+	if u, e := url.Parse(r.Url); e != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		err = multierror.Append(err, fmt.Errorf("invalid url %q", r.Url))
+	}
+	if r.Token == "" {
+		err = multierror.Append(err, errors.New("token is required"))
+	}
+	return err
+}
+
+func validateTls(t *v1.Tls) error {
+	var err error
+	hasFiles := t.CertFile != "" || t.KeyFile != ""
+	hasAcme := len(t.GetAcme().GetDomains()) > 0
+	switch {
+	case hasFiles && hasAcme:
+		err = multierror.Append(err, errors.New("cert_file and acme are mutually exclusive"))
+	case hasFiles && (t.CertFile == "" || t.KeyFile == ""):
+		err = multierror.Append(err, errors.New("cert_file and key_file must be set together"))
+	case !hasFiles && !hasAcme:
+		err = multierror.Append(err, errors.New("cert_file and key_file or acme domains are required"))
+	}
+	if t.GetAcme().GetDirectoryUrl() != "" {
+		if u, e := url.Parse(t.Acme.DirectoryUrl); e != nil || u.Scheme != "https" || u.Host == "" {
+			err = multierror.Append(err, fmt.Errorf("invalid acme directory_url %q", t.Acme.DirectoryUrl))
+		}
+	}
+	if t.RequireClientCert && t.ClientCaFile == "" {
+		err = multierror.Append(err, errors.New("require_client_cert requires client_ca_file"))
+	}
+	return err
+}
+
+func validateExternalAuth(a *v1.Auth) error {
+	var err error
+	if proxy := a.GetProxyHeader(); proxy.GetEnabled() {
+		if len(proxy.TrustedProxies) == 0 {
+			err = multierror.Append(err, errors.New("proxy_header: trusted_proxies is required"))
+		}
+		for _, p := range proxy.TrustedProxies {
+			var e error
+			if strings.Contains(p, "/") {
+				_, e = netip.ParsePrefix(p)
+			} else {
+				_, e = netip.ParseAddr(p)
+			}
+			if e != nil {
+				err = multierror.Append(err, fmt.Errorf("proxy_header: invalid trusted proxy %q: %w", p, e))
+			}
+		}
+	}
+
+	if oidc := a.GetOidc(); oidc.GetIssuer() != "" {
+		if u, e := url.Parse(oidc.Issuer); e != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+			err = multierror.Append(err, fmt.Errorf("oidc: invalid issuer %q", oidc.Issuer))
+		}
+		if oidc.ClientId == "" {
+			err = multierror.Append(err, errors.New("oidc: client_id is required"))
+		}
+		if u, e := url.Parse(oidc.RedirectUrl); e != nil || u.Host == "" || !strings.HasSuffix(u.Path, "/auth/oidc/callback") {
+			err = multierror.Append(err, fmt.Errorf("oidc: redirect_url %q must be an absolute URL ending in /auth/oidc/callback", oidc.RedirectUrl))
+		}
 	}
 
+	users := make(map[string]bool)
+	for _, user := range a.Users {
+		users[user.Name] = true
+	}
+	for _, mapping := range a.IdentityMappings {
+		if mapping.Match == nil {
+			err = multierror.Append(err, fmt.Errorf("identity mapping to %q: external_user or group is required", mapping.User))
+		}
+		if !users[mapping.User] {
+			err = multierror.Append(err, fmt.Errorf("identity mapping: user %q not found", mapping.User))
+		}
+	}
 	return err
 }
 
+func validateSecondFactor(sf *v1.SecondFactor) error {
+	if sf == nil {
+		return nil
+	}
+	var err error
+	if sf.Required && sf.TotpSecret == "" && len(sf.WebauthnCredentials) == 0 {
+		err = multierror.Append(err, errors.New("a second factor must be enrolled before it can be required"))
+	}
+	if sf.TotpSecret != "" {
+		if _, e := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(strings.ToUpper(strings.TrimRight(sf.TotpSecret, "="))); e != nil {
+			err = multierror.Append(err, fmt.Errorf("invalid totp_secret: %w", e))
+		}
+	}
+	return err
+}
+
+func validateSelfBackup(s *v1.SelfBackup, repos map[string]*v1.Repo) error {
+	var err error
+	if _, ok := repos[s.Repo]; !ok {
+		err = multierror.Append(err, fmt.Errorf("repo %q not found", s.Repo))
+	}
+	if s.Cron != "" {
+		if _, e := cronexpr.Parse(s.Cron); e != nil {
+			err = multierror.Append(err, fmt.Errorf("invalid cron %q: %w", s.Cron, e))
+		}
+	}
+	if s.GetRetention() != nil {
+		if e := validateRetention(s.Retention); e != nil {
+			err = multierror.Append(err, fmt.Errorf("invalid retention policy: %w", e))
+		}
+	}
+	return err
+}
+
+func validateMqtt(m *v1.Mqtt) error {
+	if m.Broker == "" {
+		return nil
+	}
+	u, err := url.Parse(m.Broker)
+	if err != nil {
+		return fmt.Errorf("invalid broker %q: %w", m.Broker, err)
+	}
+	switch u.Scheme {
+	case "tcp", "mqtt", "ssl", "tls", "mqtts":
+	default:
+		return fmt.Errorf("invalid broker %q: scheme must be one of tcp, mqtt, ssl, tls, or mqtts", m.Broker)
+	}
+	return nil
+}
+
 func validateRepo(repo *v1.Repo) error {
 	var err error
 	if repo.Id == "" {
@@ -58,9 +258,93 @@ func validateRepo(repo *v1.Repo) error {
 		}
 	}
 
+	if e := resticflags.Validate(repo.ExtraFlags, resticflags.ScopeGlobal); e != nil {
+		err = multierror.Append(err, e)
+	}
+
+	if e := backendopts.Validate(repo); e != nil {
+		err = multierror.Append(err, fmt.Errorf("backend_options: %w", e))
+	}
+
+	if repo.PackSizeMb != 0 && (repo.PackSizeMb < 4 || repo.PackSizeMb > 128) {
+		err = multierror.Append(err, fmt.Errorf("pack_size_mb %d must be between 4 and 128", repo.PackSizeMb))
+	}
+
+	switch repo.Compression {
+	case "", "auto", "max", "off":
+	default:
+		err = multierror.Append(err, fmt.Errorf("invalid compression %q, must be one of auto, max, off", repo.Compression))
+	}
+
+	if repo.CacheDir != "" && !filepath.IsAbs(repo.CacheDir) {
+		err = multierror.Append(err, fmt.Errorf("cache_dir %q must be an absolute path", repo.CacheDir))
+	}
+
+	if p := repo.GetCachePolicy(); p != nil {
+		if p.Cron != "" {
+			if _, e := cronexpr.Parse(p.Cron); e != nil {
+				err = multierror.Append(err, fmt.Errorf("invalid cache_policy cron %q: %w", p.Cron, e))
+			}
+		}
+		if p.MaxAgeDays < 0 || p.MaxSizeMb < 0 {
+			err = multierror.Append(err, errors.New("cache_policy max_age_days and max_size_mb must not be negative"))
+		}
+	}
+
+	if cold := repo.GetColdStorage(); cold.GetWarmupValidHours() < 0 {
+		err = multierror.Append(err, errors.New("cold_storage warmup_valid_hours must not be negative"))
+	} else if !cold.GetEnabled() && cold.GetWarmupCommand() != "" {
+		err = multierror.Append(err, errors.New("cold_storage warmup_command requires cold storage to be enabled"))
+	}
+
+	if d := repo.GetDelayedDeletion(); d != nil {
+		if d.CoolOffHours < 0 {
+			err = multierror.Append(err, errors.New("delayed_deletion cool_off_hours must not be negative"))
+		}
+		if d.Cron != "" {
+			if _, e := cronexpr.Parse(d.Cron); e != nil {
+				err = multierror.Append(err, fmt.Errorf("invalid delayed_deletion cron %q: %w", d.Cron, e))
+			}
+		}
+		if d.CoolOffHours > 0 && repo.GetAppendOnly().GetEnabled() && repo.GetAppendOnly().GetRetentionRepo() == "" {
+			err = multierror.Append(err, errors.New("delayed_deletion requires an append_only retention_repo, append-only repos can't be deleted from"))
+		}
+	}
+
+	if space := repo.GetDiskSpace(); space.GetMinFreeMb() < 0 || space.GetWarnFreeMb() < 0 {
+		err = multierror.Append(err, errors.New("disk space thresholds must not be negative"))
+	}
+
+	for idx, hook := range repo.Hooks {
+		if e := validateHook(hook); e != nil {
+			err = multierror.Append(err, fmt.Errorf("hook %d: %w", idx, e))
+		}
+	}
+
 	return err
 }
 
+// validateAppendOnly checks that the retention repo of an append-only repo is another repo that can delete.
+func validateAppendOnly(repo *v1.Repo, repos map[string]*v1.Repo) error {
+	appendOnly := repo.GetAppendOnly()
+	if appendOnly.GetRetentionRepo() == "" {
+		return nil
+	}
+	if !appendOnly.GetEnabled() {
+		return errors.New("append_only retention_repo requires append_only to be enabled")
+	}
+	retention, ok := repos[appendOnly.GetRetentionRepo()]
+	switch {
+	case appendOnly.GetRetentionRepo() == repo.GetId():
+		return errors.New("append_only retention_repo must be another repo")
+	case !ok:
+		return fmt.Errorf("append_only retention_repo %q not found", appendOnly.GetRetentionRepo())
+	case retention.GetAppendOnly().GetEnabled():
+		return fmt.Errorf("append_only retention_repo %q is append-only itself", appendOnly.GetRetentionRepo())
+	}
+	return nil
+}
+
 func validatePlan(plan *v1.Plan, repos map[string]*v1.Repo) error {
 	var err error
 	if plan.Paths == nil || len(plan.Paths) == 0 {
@@ -81,8 +365,20 @@ func validatePlan(plan *v1.Plan, repos map[string]*v1.Repo) error {
 		err = multierror.Append(err, fmt.Errorf("repo %q not found", plan.Repo))
 	}
 
-	if _, e := cronexpr.Parse(plan.Cron); e != nil {
-		err = multierror.Append(err, fmt.Errorf("invalid cron %q: %w", plan.Cron, e))
+	for idx, repo := range plan.AdditionalRepos {
+		if _, ok := repos[repo]; !ok {
+			err = multierror.Append(err, fmt.Errorf("additional repo %q not found", repo))
+		} else if repo == plan.Repo || slices.Contains(plan.AdditionalRepos[:idx], repo) {
+			err = multierror.Append(err, fmt.Errorf("additional repo %q is listed more than once", repo))
+		}
+	}
+	planRepos := append([]string{plan.Repo}, plan.AdditionalRepos...)
+
+	// plans that follow other plans may leave the cron empty to only back up after them.
+	if plan.Cron != "" || len(plan.GetDependencies().GetAfter()) == 0 {
+		if _, e := cronexpr.Parse(plan.Cron); e != nil {
+			err = multierror.Append(err, fmt.Errorf("invalid cron %q: %w", plan.Cron, e))
+		}
 	}
 
 	if plan.GetRetention() != nil {
@@ -91,9 +387,216 @@ func validatePlan(plan *v1.Plan, repos map[string]*v1.Repo) error {
 		}
 	}
 
+	for _, g := range plan.GroupBy {
+		if g != "host" && g != "paths" && g != "tags" {
+			err = multierror.Append(err, fmt.Errorf("invalid group_by %q, must be one of host, paths, tags", g))
+		}
+	}
+
+	if t := plan.GetTimeouts(); t != nil {
+		if t.BackupMinutes < 0 || t.PruneMinutes < 0 || t.Retries < 0 || t.RetryDelayMinutes < 0 {
+			err = multierror.Append(err, errors.New("timeouts must not be negative"))
+		}
+		for _, class := range t.RetryOn {
+			if class == v1.ErrorClass_ERROR_CLASS_UNKNOWN {
+				err = multierror.Append(err, errors.New("timeouts retry_on must not include unknown errors"))
+			}
+		}
+	}
+
+	if p := plan.GetPreconditions(); p != nil && p.DeferMinutes < 0 {
+		err = multierror.Append(err, errors.New("preconditions defer_minutes must not be negative"))
+	}
+
+	if plan.ScheduleJitterMinutes < 0 || plan.ScheduleJitterMinutes > 24*60 {
+		err = multierror.Append(err, errors.New("schedule_jitter_minutes must be between 0 and 1440"))
+	}
+
+	if plan.Timezone != "" {
+		if _, e := time.LoadLocation(plan.Timezone); e != nil {
+			err = multierror.Append(err, fmt.Errorf("invalid timezone %q: %w", plan.Timezone, e))
+		}
+	}
+
+	if trigger := plan.GetWebhookTrigger(); trigger != nil && len(trigger.Token) < minWebhookTriggerTokenLength {
+		err = multierror.Append(err, fmt.Errorf("webhook_trigger token must be at least %d characters", minWebhookTriggerTokenLength))
+	}
+
+	if plan.VerifySampleFiles < 0 || plan.VerifySampleFiles > 1000 {
+		err = multierror.Append(err, errors.New("verify_sample_files must be between 0 and 1000"))
+	}
+
+	for _, repo := range planRepos {
+		if appendOnly := repos[repo].GetAppendOnly(); appendOnly.GetEnabled() && appendOnly.GetRetentionRepo() == "" &&
+			plan.GetRetention() != nil && !proto.Equal(plan.GetRetention(), &v1.RetentionPolicy{}) {
+			err = multierror.Append(err, fmt.Errorf("retention can't be applied, repo %q is append-only, set its append_only retention_repo or remove the retention policy", repo))
+		}
+	}
+
+	if a := plan.GetAnomalyDetection(); a != nil {
+		if a.DataAddedFactor < 0 || a.MinDataAddedBytes < 0 {
+			err = multierror.Append(err, errors.New("anomaly_detection data_added_factor and min_data_added_bytes must not be negative"))
+		}
+		if a.ChangedFilesPercent < 0 || a.ChangedFilesPercent > 100 {
+			err = multierror.Append(err, errors.New("anomaly_detection changed_files_percent must be between 0 and 100"))
+		}
+		if a.BaselineBackups != 0 && a.BaselineBackups < 3 {
+			err = multierror.Append(err, errors.New("anomaly_detection baseline_backups must be at least 3"))
+		}
+	}
+
+	// scheduled reads of archived data would fail whenever the repo isn't warmed up.
+	for _, repo := range planRepos {
+		if repos[repo].GetColdStorage().GetEnabled() && plan.VerifySampleFiles > 0 {
+			err = multierror.Append(err, fmt.Errorf("verify_sample_files can't be set, repo %q is on cold storage", repo))
+		}
+	}
+	if repos[plan.Repo].GetColdStorage().GetEnabled() && plan.RestoreDrill != nil {
+		err = multierror.Append(err, fmt.Errorf("restore_drill can't be set, repo %q is on cold storage", plan.Repo))
+	}
+
+	if e := resticflags.Validate(plan.ExtraFlags, resticflags.ScopeBackup); e != nil {
+		err = multierror.Append(err, e)
+	}
+
+	for _, env := range plan.Env {
+		key, _, ok := strings.Cut(env, "=")
+		if !ok || key == "" {
+			err = multierror.Append(err, fmt.Errorf("invalid env var %s, must take format KEY=VALUE", env))
+		} else if strings.HasPrefix(key, "RESTIC_REPOSITORY") || strings.HasPrefix(key, "RESTIC_PASSWORD") {
+			err = multierror.Append(err, fmt.Errorf("env var %s is set by the repo and cannot be overridden by a plan", key))
+		}
+	}
+
+	seenPathOptions := make(map[string]bool)
+	for idx, o := range plan.PathOptions {
+		if !slices.Contains(plan.Paths, o.Path) {
+			err = multierror.Append(err, fmt.Errorf("path_options[%d]: path %q is not one of the plan's paths", idx, o.Path))
+		}
+		if seenPathOptions[o.Path] {
+			err = multierror.Append(err, fmt.Errorf("path_options[%d]: duplicate options for path %q", idx, o.Path))
+		}
+		seenPathOptions[o.Path] = true
+		for _, m := range o.IncludeMounts {
+			if rel, e := filepath.Rel(o.Path, m); e != nil || rel == "." || strings.HasPrefix(rel, "..") {
+				err = multierror.Append(err, fmt.Errorf("path_options[%d]: include mount %q must be beneath %q", idx, m, o.Path))
+			}
+		}
+	}
+
+	if d := plan.GetRestoreDrill(); d != nil {
+		if _, e := cronexpr.Parse(d.Cron); e != nil {
+			err = multierror.Append(err, fmt.Errorf("invalid restore_drill cron %q: %w", d.Cron, e))
+		}
+		if d.SampleFiles < 0 {
+			err = multierror.Append(err, errors.New("restore_drill sample_files must not be negative"))
+		}
+	}
+
+	if l := plan.GetResourceLimits(); l != nil {
+		if l.Nice < -20 || l.Nice > 19 {
+			err = multierror.Append(err, fmt.Errorf("resource_limits nice %d must be between -20 and 19", l.Nice))
+		}
+		if l.IoPriority < 0 || l.IoPriority > 7 {
+			err = multierror.Append(err, fmt.Errorf("resource_limits io_priority %d must be between 0 and 7", l.IoPriority))
+		}
+		if l.IoWeight < 0 || l.IoWeight > 10000 {
+			err = multierror.Append(err, fmt.Errorf("resource_limits io_weight %d must be between 1 and 10000", l.IoWeight))
+		}
+		if l.Gomaxprocs < 0 {
+			err = multierror.Append(err, errors.New("resource_limits gomaxprocs must not be negative"))
+		}
+	}
+
+	for idx, hook := range plan.Hooks {
+		if e := validateHook(hook); e != nil {
+			err = multierror.Append(err, fmt.Errorf("hook %d: %w", idx, e))
+		}
+	}
+
 	return err
 }
 
+func validateHook(hook *v1.Hook) error {
+	var err error
+	if hook.TimeoutSeconds < 0 {
+		err = multierror.Append(err, errors.New("timeout_seconds must not be negative"))
+	}
+	if hook.ConditionExpr != "" {
+		if _, e := expr.Parse(hook.ConditionExpr); e != nil {
+			err = multierror.Append(err, fmt.Errorf("invalid condition_expr %q: %w", hook.ConditionExpr, e))
+		}
+	}
+	if cmd := hook.GetActionCommand(); cmd != nil {
+		if cmd.WorkingDir != "" && !filepath.IsAbs(cmd.WorkingDir) {
+			err = multierror.Append(err, fmt.Errorf("working_dir %q must be an absolute path", cmd.WorkingDir))
+		}
+		for _, name := range cmd.EnvAllowlist {
+			if name == "" || strings.Contains(name, "=") {
+				err = multierror.Append(err, fmt.Errorf("invalid env_allowlist entry %q, must be a variable name", name))
+			}
+		}
+	}
+	if plugin := hook.GetActionPlugin(); plugin != nil && (plugin.Name == "" || strings.ContainsAny(plugin.Name, `/\`)) {
+		err = multierror.Append(err, fmt.Errorf("invalid plugin name %q, must be the name of an executable in the plugins directory", plugin.Name))
+	}
+	return err
+}
+
+// validatePlanDependencies checks that dependencies name other existing plans and that following them never leads
+// back to the plan, a cycle would chain backups forever or block them once one fails.
+func validatePlanDependencies(planList []*v1.Plan, plans map[string]*v1.Plan) error {
+	var err error
+	for _, plan := range planList {
+		for _, dep := range planDependencies(plan) {
+			if dep == plan.Id {
+				err = multierror.Append(err, fmt.Errorf("plan %s: dependencies: plan depends on itself", plan.Id))
+			} else if _, ok := plans[dep]; !ok {
+				err = multierror.Append(err, fmt.Errorf("plan %s: dependencies: plan %q not found", plan.Id, dep))
+			}
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	// depth first search, a plan still on the stack when reached again closes a cycle.
+	const (
+		unvisited = iota
+		onStack
+		done
+	)
+	state := make(map[string]int)
+	var visit func(plan *v1.Plan, path []string) error
+	visit = func(plan *v1.Plan, path []string) error {
+		switch state[plan.Id] {
+		case onStack:
+			cycle := append(path[slices.Index(path, plan.Id):], plan.Id)
+			return fmt.Errorf("plan %s: dependencies: cycle %s", plan.Id, strings.Join(cycle, " -> "))
+		case done:
+			return nil
+		}
+		state[plan.Id] = onStack
+		for _, dep := range planDependencies(plan) {
+			if e := visit(plans[dep], append(path, plan.Id)); e != nil {
+				return e
+			}
+		}
+		state[plan.Id] = done
+		return nil
+	}
+	for _, plan := range planList {
+		if e := visit(plan, nil); e != nil {
+			return e
+		}
+	}
+	return nil
+}
+
+func planDependencies(plan *v1.Plan) []string {
+	return append(slices.Clone(plan.GetDependencies().GetAfter()), plan.GetDependencies().GetRequires()...)
+}
+
 func validateRetention(policy *v1.RetentionPolicy) error {
 	var err error
 	if policy.KeepWithinDuration != "" {
@@ -114,3 +617,24 @@ func validateRetention(policy *v1.RetentionPolicy) error {
 	}
 	return err
 }
+
+func validatePlanTemplates(templates []*v1.PlanTemplate) error {
+	var err error
+	ids := make(map[string]bool)
+	for _, tmpl := range templates {
+		if tmpl.Id == "" {
+			err = multierror.Append(err, fmt.Errorf("plan template: id is required"))
+			continue
+		}
+		if ids[tmpl.Id] {
+			err = multierror.Append(err, fmt.Errorf("plan template %s: duplicate id", tmpl.Id))
+		}
+		ids[tmpl.Id] = true
+		if tmpl.Plan == nil {
+			err = multierror.Append(err, fmt.Errorf("plan template %s: plan is required", tmpl.Id))
+		} else if tmpl.Plan.Id == "" {
+			err = multierror.Append(err, fmt.Errorf("plan template %s: plan id is required, use variables to make it unique e.g. \"home-${hostname}\"", tmpl.Id))
+		}
+	}
+	return err
+}
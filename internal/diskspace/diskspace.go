@@ -0,0 +1,55 @@
+// Package diskspace reports the free space of the file systems backrest writes to.
+package diskspace
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+)
+
+var ErrUnsupported = errors.New("checking free space is not supported on " + runtime.GOOS)
+
+const MB = 1024 * 1024
+
+// InsufficientError is returned when a path has less free space than is required.
+type InsufficientError struct {
+	Path      string // the path that was checked.
+	Purpose   string // what the path is used for e.g. "restic cache".
+	FreeBytes uint64
+	MinBytes  uint64
+}
+
+func (e *InsufficientError) Error() string {
+	return fmt.Sprintf("insufficient disk space for the %s at %q: %d MB free, %d MB required", e.Purpose, e.Path, e.FreeBytes/MB, e.MinBytes/MB)
+}
+
+// Free returns the bytes available to unprivileged users on the file system of the path. Paths that don't exist yet
+// are checked at their nearest existing parent since that is where they will be created.
+func Free(path string) (uint64, error) {
+	dir, err := nearestExisting(path)
+	if err != nil {
+		return 0, err
+	}
+	return freeBytes(dir)
+}
+
+func nearestExisting(path string) (string, error) {
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+	for {
+		if _, err := os.Stat(path); err == nil {
+			return path, nil
+		} else if !errors.Is(err, os.ErrNotExist) {
+			return "", fmt.Errorf("stat %q: %w", path, err)
+		}
+		parent := filepath.Dir(path)
+		if parent == path {
+			return "", fmt.Errorf("no existing parent of %q", path)
+		}
+		path = parent
+	}
+}
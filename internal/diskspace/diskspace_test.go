@@ -0,0 +1,43 @@
+package diskspace
+
+import (
+	"errors"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+func TestFree(t *testing.T) {
+	if runtime.GOOS != "linux" && runtime.GOOS != "darwin" {
+		t.Skip("free space is only checked on linux and darwin")
+	}
+	dir := t.TempDir()
+
+	free, err := Free(dir)
+	if err != nil {
+		t.Fatalf("Free(%q): %v", dir, err)
+	}
+	if free == 0 {
+		t.Errorf("Free(%q) = 0, want free space of the temp dir", dir)
+	}
+
+	missing, err := Free(filepath.Join(dir, "does", "not", "exist"))
+	if err != nil {
+		t.Fatalf("Free of a path that doesn't exist: %v", err)
+	}
+	if missing == 0 {
+		t.Errorf("Free of a path that doesn't exist = 0, want the free space of its parent")
+	}
+}
+
+func TestInsufficientError(t *testing.T) {
+	var err error = &InsufficientError{Path: "/cache", Purpose: "restic cache", FreeBytes: 10 * MB, MinBytes: 100 * MB}
+	want := `insufficient disk space for the restic cache at "/cache": 10 MB free, 100 MB required`
+	if err.Error() != want {
+		t.Errorf("got %q, want %q", err.Error(), want)
+	}
+	var insufficient *InsufficientError
+	if !errors.As(err, &insufficient) || insufficient.Path != "/cache" {
+		t.Errorf("errors.As didn't unwrap the InsufficientError")
+	}
+}
@@ -0,0 +1,17 @@
+//go:build linux || darwin
+// +build linux darwin
+
+package diskspace
+
+import (
+	"fmt"
+	"syscall"
+)
+
+func freeBytes(path string) (uint64, error) {
+	var stat syscall.Statfs_t
+	if err := syscall.Statfs(path, &stat); err != nil {
+		return 0, fmt.Errorf("statfs %q: %w", path, err)
+	}
+	return uint64(stat.Bavail) * uint64(stat.Bsize), nil
+}
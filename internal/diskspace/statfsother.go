@@ -0,0 +1,8 @@
+//go:build !linux && !darwin
+// +build !linux,!darwin
+
+package diskspace
+
+func freeBytes(path string) (uint64, error) {
+	return 0, ErrUnsupported
+}
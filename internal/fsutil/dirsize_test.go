@@ -0,0 +1,32 @@
+package fsutil
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestDirSize(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "a", "b"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	for name, size := range map[string]int{"f1": 10, "a/f2": 20, "a/b/f3": 30} {
+		if err := os.WriteFile(filepath.Join(dir, name), make([]byte, size), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	size, err := DirSize(dir)
+	if err != nil {
+		t.Fatalf("DirSize: %v", err)
+	}
+	if size != 60 {
+		t.Errorf("got size %d, want 60", size)
+	}
+
+	size, err = DirSize(filepath.Join(dir, "missing"))
+	if err != nil || size != 0 {
+		t.Errorf("DirSize of a missing dir = %d, %v, want 0, nil", size, err)
+	}
+}
@@ -0,0 +1,87 @@
+// Package grpcreflect serves the gRPC server reflection service over connect so tools like grpcurl can discover the
+// API without a copy of its protos.
+package grpcreflect
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+
+	"connectrpc.com/connect"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/reflection"
+	reflectionv1 "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	reflectionv1alpha "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	V1Procedure      = "/grpc.reflection.v1.ServerReflection/ServerReflectionInfo"
+	V1AlphaProcedure = "/grpc.reflection.v1alpha.ServerReflection/ServerReflectionInfo"
+)
+
+// NewHandlers returns the handlers of the v1 and v1alpha reflection services by procedure, they describe the named
+// services using the descriptors registered in protoregistry.GlobalFiles.
+func NewHandlers(services ...string) map[string]http.Handler {
+	opts := reflection.ServerOptions{Services: serviceNames(append(services, "grpc.reflection.v1.ServerReflection", "grpc.reflection.v1alpha.ServerReflection"))}
+	v1 := reflection.NewServerV1(opts)
+	v1alpha := reflection.NewServer(opts)
+
+	return map[string]http.Handler{
+		V1Procedure: connect.NewBidiStreamHandler(V1Procedure, func(ctx context.Context, bidi *connect.BidiStream[reflectionv1.ServerReflectionRequest, reflectionv1.ServerReflectionResponse]) error {
+			return connectError(v1.ServerReflectionInfo(&stream[reflectionv1.ServerReflectionRequest, reflectionv1.ServerReflectionResponse]{ctx: ctx, bidi: bidi}))
+		}),
+		V1AlphaProcedure: connect.NewBidiStreamHandler(V1AlphaProcedure, func(ctx context.Context, bidi *connect.BidiStream[reflectionv1alpha.ServerReflectionRequest, reflectionv1alpha.ServerReflectionResponse]) error {
+			return connectError(v1alpha.ServerReflectionInfo(&stream[reflectionv1alpha.ServerReflectionRequest, reflectionv1alpha.ServerReflectionResponse]{ctx: ctx, bidi: bidi}))
+		}),
+	}
+}
+
+// serviceNames implements reflection.ServiceInfoProvider, the reflection service only uses the names.
+type serviceNames []string
+
+func (s serviceNames) GetServiceInfo() map[string]grpc.ServiceInfo {
+	info := make(map[string]grpc.ServiceInfo, len(s))
+	for _, name := range s {
+		info[name] = grpc.ServiceInfo{}
+	}
+	return info
+}
+
+// connectError converts the gRPC status errors returned by the reflection service to connect errors, both use the
+// same codes.
+func connectError(err error) error {
+	if st, ok := status.FromError(err); ok && err != nil {
+		return connect.NewError(connect.Code(st.Code()), errors.New(st.Message()))
+	}
+	return err
+}
+
+// stream adapts a connect bidi stream to the gRPC server stream the reflection service is implemented against.
+type stream[Req, Res any] struct {
+	ctx  context.Context
+	bidi *connect.BidiStream[Req, Res]
+}
+
+func (s *stream[Req, Res]) Send(res *Res) error {
+	return s.bidi.Send(res)
+}
+
+func (s *stream[Req, Res]) Recv() (*Req, error) {
+	req, err := s.bidi.Receive()
+	if errors.Is(err, io.EOF) {
+		return nil, io.EOF // the reflection service compares with io.EOF to end the stream.
+	}
+	return req, err
+}
+
+func (s *stream[Req, Res]) Context() context.Context     { return s.ctx }
+func (s *stream[Req, Res]) SetHeader(metadata.MD) error  { return nil }
+func (s *stream[Req, Res]) SendHeader(metadata.MD) error { return nil }
+func (s *stream[Req, Res]) SetTrailer(metadata.MD)       {}
+func (s *stream[Req, Res]) SendMsg(m any) error          { return s.Send(m.(*Res)) }
+func (s *stream[Req, Res]) RecvMsg(m any) error {
+	return errors.New("RecvMsg is not supported, use Recv")
+}
@@ -0,0 +1,76 @@
+package grpcreflect
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	_ "github.com/garethgeorge/backrest/gen/go/v1/v1connect"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	reflectionv1 "google.golang.org/grpc/reflection/grpc_reflection_v1"
+)
+
+func TestReflection(t *testing.T) {
+	mux := http.NewServeMux()
+	for path, handler := range NewHandlers("v1.Backrest") {
+		mux.Handle(path, handler)
+	}
+	server := httptest.NewServer(h2c.NewHandler(mux, &http2.Server{}))
+	t.Cleanup(server.Close)
+
+	conn, err := grpc.Dial(strings.TrimPrefix(server.URL, "http://"), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	stream, err := reflectionv1.NewServerReflectionClient(conn).ServerReflectionInfo(context.Background())
+	if err != nil {
+		t.Fatalf("open reflection stream: %v", err)
+	}
+
+	if err := stream.Send(&reflectionv1.ServerReflectionRequest{MessageRequest: &reflectionv1.ServerReflectionRequest_ListServices{}}); err != nil {
+		t.Fatalf("send list services: %v", err)
+	}
+	res, err := stream.Recv()
+	if err != nil {
+		t.Fatalf("receive services: %v", err)
+	}
+	var services []string
+	for _, s := range res.GetListServicesResponse().GetService() {
+		services = append(services, s.Name)
+	}
+	if !slices.Contains(services, "v1.Backrest") {
+		t.Errorf("want v1.Backrest listed, got %v", services)
+	}
+
+	if err := stream.Send(&reflectionv1.ServerReflectionRequest{MessageRequest: &reflectionv1.ServerReflectionRequest_FileContainingSymbol{FileContainingSymbol: "v1.Backrest"}}); err != nil {
+		t.Fatalf("send file containing symbol: %v", err)
+	}
+	res, err = stream.Recv()
+	if err != nil {
+		t.Fatalf("receive file: %v", err)
+	}
+	if len(res.GetFileDescriptorResponse().GetFileDescriptorProto()) == 0 {
+		t.Errorf("want the descriptors of the file defining v1.Backrest, got %v", res)
+	}
+
+	if err := stream.Send(&reflectionv1.ServerReflectionRequest{MessageRequest: &reflectionv1.ServerReflectionRequest_FileContainingSymbol{FileContainingSymbol: "v1.Missing"}}); err != nil {
+		t.Fatalf("send missing symbol: %v", err)
+	}
+	res, err = stream.Recv()
+	if err != nil {
+		t.Fatalf("receive missing symbol: %v", err)
+	}
+	if res.GetErrorResponse() == nil {
+		t.Errorf("want an error response for an unknown symbol, got %v", res)
+	}
+	if err := stream.CloseSend(); err != nil {
+		t.Fatalf("close send: %v", err)
+	}
+}
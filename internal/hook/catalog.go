@@ -0,0 +1,294 @@
+package hook
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"text/template"
+	"time"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/pkg/restic"
+	"github.com/hashicorp/go-multierror"
+)
+
+// Names of the messages in a catalog. Every locale defines all of them.
+const (
+	MsgPayload                = "payload"                // default payload of notification hooks without a template.
+	MsgChatHeader             = "chat_header"            // first line of Discord and Slack messages.
+	MsgGotifyTitle            = "gotify_title"           // default title of Gotify messages.
+	MsgSummarySnapshotStart   = "summary_snapshot_start" // .Summary for a started backup.
+	MsgSummarySnapshotEnd     = "summary_snapshot_end"   // .Summary for a finished backup.
+	MsgSummaryError           = "summary_error"          // .Summary for errors and timeouts.
+	MsgSummaryWarning         = "summary_warning"        // .Summary for warnings e.g. low disk space.
+	MsgEventSnapshotStart     = "event_snapshot_start"   // .EventName of each condition.
+	MsgEventSnapshotEnd       = "event_snapshot_end"
+	MsgEventAnyError          = "event_any_error"
+	MsgEventSnapshotError     = "event_snapshot_error"
+	MsgEventTimeout           = "event_timeout"
+	MsgEventRestoreDrillError = "event_restore_drill_error"
+	MsgEventLowDiskSpace      = "event_low_disk_space"
+	MsgEventBackupAnomaly     = "event_backup_anomaly"
+	MsgEventUnknown           = "event_unknown"
+)
+
+const (
+	DefaultLocale = "en"
+
+	maxRenderDepth = 4 // bound on messages rendering other messages e.g. a summary using .EventName.
+)
+
+var ErrUnknownMessage = errors.New("unknown message")
+
+var locales = map[string]map[string]string{
+	"en": {
+		MsgPayload:                `{{ .Summary }}`,
+		MsgChatHeader:             `Backrest Notification`,
+		MsgGotifyTitle:            `Backrest Event`,
+		MsgSummarySnapshotStart:   templateForSnapshotStart,
+		MsgSummarySnapshotEnd:     templateForSnapshotEnd,
+		MsgSummaryError:           templateForError,
+		MsgSummaryWarning:         templateForWarning,
+		MsgEventSnapshotStart:     `snapshot start`,
+		MsgEventSnapshotEnd:       `snapshot end`,
+		MsgEventAnyError:          `error`,
+		MsgEventSnapshotError:     `snapshot error`,
+		MsgEventTimeout:           `timeout`,
+		MsgEventRestoreDrillError: `restore drill error`,
+		MsgEventLowDiskSpace:      `low disk space`,
+		MsgEventBackupAnomaly:     `backup anomaly`,
+		MsgEventUnknown:           `unknown`,
+	},
+	"de": {
+		MsgPayload:     `{{ .Summary }}`,
+		MsgChatHeader:  `Backrest-Benachrichtigung`,
+		MsgGotifyTitle: `Backrest-Ereignis`,
+		MsgSummarySnapshotStart: `Aufgabe: "{{ .Task }}" um {{ .FormatTime .CurTime }}
+Ereignis: {{ .EventName .Event }}
+Repo: {{ .Repo.Id }}
+Plan: {{ .Plan.Id }}
+Pfade:
+{{ range .Plan.Paths -}}
+ - {{ . }}
+{{ end }}`,
+		MsgSummarySnapshotEnd: `Aufgabe: "{{ .Task }}" um {{ .FormatTime .CurTime }}
+Ereignis: {{ .EventName .Event }}
+Repo: {{ .Repo.Id }}
+Plan: {{ .Plan.Id }}
+Snapshot: {{ .SnapshotId }}
+{{ if .Error -}}
+Snapshot konnte nicht erstellt werden: {{ .Error }}
+{{ else -}}
+{{ if .SnapshotStats -}}
+
+Übersicht:
+- Hinzugefügte Daten: {{ .FormatSizeBytes .SnapshotStats.DataAdded }}
+- Verarbeitete Dateien: {{ .SnapshotStats.TotalFilesProcessed }}
+- Verarbeitete Bytes: {{ .FormatSizeBytes .SnapshotStats.TotalBytesProcessed }}
+
+Sicherungsstatistik:
+- Neue Dateien: {{ .SnapshotStats.FilesNew }}
+- Geänderte Dateien: {{ .SnapshotStats.FilesChanged }}
+- Unveränderte Dateien: {{ .SnapshotStats.FilesUnmodified }}
+- Neue Verzeichnisse: {{ .SnapshotStats.DirsNew }}
+- Geänderte Verzeichnisse: {{ .SnapshotStats.DirsChanged }}
+- Unveränderte Verzeichnisse: {{ .SnapshotStats.DirsUnmodified }}
+- Daten-Blobs: {{ .SnapshotStats.DataBlobs }}
+- Baum-Blobs: {{ .SnapshotStats.TreeBlobs }}
+- Gesamtdauer: {{ .SnapshotStats.TotalDuration }}s
+{{ end }}
+{{ end }}`,
+		MsgSummaryError: `Aufgabe: "{{ .Task }}" um {{ .FormatTime .CurTime }}
+{{ if .Error -}}
+Fehler: {{ .Error }}
+{{ end }}`,
+		MsgSummaryWarning: `Aufgabe: "{{ .Task }}" um {{ .FormatTime .CurTime }}
+{{ if .Error -}}
+Warnung: {{ .Error }}
+{{ end }}`,
+		MsgEventSnapshotStart:     `Snapshot gestartet`,
+		MsgEventSnapshotEnd:       `Snapshot beendet`,
+		MsgEventAnyError:          `Fehler`,
+		MsgEventSnapshotError:     `Snapshot-Fehler`,
+		MsgEventTimeout:           `Zeitüberschreitung`,
+		MsgEventRestoreDrillError: `Wiederherstellungstest fehlgeschlagen`,
+		MsgEventLowDiskSpace:      `Wenig Speicherplatz`,
+		MsgEventBackupAnomaly:     `Auffällige Sicherung`,
+		MsgEventUnknown:           `unbekannt`,
+	},
+}
+
+var defaultCatalog = func() *Catalog {
+	c, err := NewCatalog(nil)
+	if err != nil {
+		panic(fmt.Sprintf("default catalog: %v", err))
+	}
+	return c
+}()
+
+// Catalog holds the parsed templates of all user facing notification text, the templates of a built-in locale with
+// any overrides from the config applied.
+type Catalog struct {
+	locale    string
+	sources   map[string]string
+	templates map[string]*template.Template
+}
+
+// NewCatalog returns the catalog for the config's locale and overrides, nil selects the default catalog.
+func NewCatalog(cfg *v1.Notifications) (*Catalog, error) {
+	locale := cfg.GetLocale()
+	if locale == "" {
+		locale = DefaultLocale
+	}
+	builtin, ok := locales[locale]
+	if !ok {
+		return nil, fmt.Errorf("unknown locale %q, available locales are %v", locale, Locales())
+	}
+
+	c := &Catalog{
+		locale:    locale,
+		sources:   make(map[string]string, len(builtin)),
+		templates: make(map[string]*template.Template, len(builtin)),
+	}
+	for name, text := range builtin {
+		c.sources[name] = text
+	}
+	for name, text := range cfg.GetTemplates() {
+		if _, ok := builtin[name]; !ok {
+			return nil, fmt.Errorf("%w %q", ErrUnknownMessage, name)
+		}
+		c.sources[name] = text
+	}
+	for name, text := range c.sources {
+		t, err := template.New(name).Parse(text)
+		if err != nil {
+			return nil, fmt.Errorf("message %q: %w", name, err)
+		}
+		c.templates[name] = t
+	}
+	return c, nil
+}
+
+// Locales returns the names of the built-in locales.
+func Locales() []string {
+	var names []string
+	for name := range locales {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Locale returns the locale the catalog's built-in messages are from.
+func (c *Catalog) Locale() string {
+	return c.locale
+}
+
+// Templates returns the source of every message in the catalog.
+func (c *Catalog) Templates() map[string]string {
+	templates := make(map[string]string, len(c.sources))
+	for name, text := range c.sources {
+		templates[name] = text
+	}
+	return templates
+}
+
+// Render executes the named message with the vars.
+func (c *Catalog) Render(name string, vars HookVars) (string, error) {
+	t, ok := c.templates[name]
+	if !ok {
+		return "", fmt.Errorf("%w %q", ErrUnknownMessage, name)
+	}
+	if vars.depth >= maxRenderDepth {
+		return "", fmt.Errorf("message %q: messages nested too deeply", name)
+	}
+	vars.catalog = c
+	vars.depth++
+
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, vars); err != nil {
+		return "", fmt.Errorf("message %q: %w", name, err)
+	}
+	return buf.String(), nil
+}
+
+// ValidateTemplates checks that the notification catalog and the templates of every hook in the config parse and
+// render for each hook condition.
+func ValidateTemplates(cfg *v1.Config) error {
+	catalog, err := NewCatalog(cfg.GetNotifications())
+	if err != nil {
+		return fmt.Errorf("notifications: %w", err)
+	}
+
+	var errs error
+	check := func(owner string, hooks []*v1.Hook) {
+		for idx, hook := range hooks {
+			for _, text := range hookTemplates(hook) {
+				if err := catalog.dryRun(text); err != nil {
+					errs = multierror.Append(errs, fmt.Errorf("%s hook %d: %w", owner, idx, err))
+				}
+			}
+		}
+	}
+	for _, repo := range cfg.Repos {
+		check("repo "+repo.Id, repo.Hooks)
+	}
+	for _, plan := range cfg.Plans {
+		check("plan "+plan.Id, plan.Hooks)
+	}
+	for name := range catalog.sources {
+		if err := catalog.dryRun(catalog.sources[name]); err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("notifications: message %q: %w", name, err))
+		}
+	}
+	return errs
+}
+
+// hookTemplates returns the non-empty templates of a hook's action.
+func hookTemplates(hook *v1.Hook) []string {
+	var templates []string
+	switch action := hook.Action.(type) {
+	case *v1.Hook_ActionCommand:
+		templates = append(templates, action.ActionCommand.GetCommand())
+	case *v1.Hook_ActionDiscord:
+		templates = append(templates, action.ActionDiscord.GetTemplate())
+	case *v1.Hook_ActionGotify:
+		templates = append(templates, action.ActionGotify.GetTemplate(), action.ActionGotify.GetTitleTemplate())
+	case *v1.Hook_ActionSlack:
+		templates = append(templates, action.ActionSlack.GetTemplate())
+	}
+	var nonEmpty []string
+	for _, t := range templates {
+		if t != "" {
+			nonEmpty = append(nonEmpty, t)
+		}
+	}
+	return nonEmpty
+}
+
+// dryRun renders the template with sample vars for every condition.
+func (c *Catalog) dryRun(text string) error {
+	t, err := template.New("template").Parse(text)
+	if err != nil {
+		return err
+	}
+	for cond := range v1.Hook_Condition_name {
+		vars := HookVars{
+			Task:          "sample task",
+			Event:         v1.Hook_Condition(cond),
+			Repo:          &v1.Repo{Id: "sample-repo"},
+			Plan:          &v1.Plan{Id: "sample-plan", Paths: []string{"/sample"}},
+			SnapshotId:    "sample",
+			SnapshotStats: &restic.BackupProgressEntry{},
+			CurTime:       time.Unix(0, 0),
+			Error:         "sample error",
+			catalog:       c,
+		}
+		if err := t.Execute(io.Discard, vars); err != nil {
+			return err
+		}
+	}
+	return nil
+}
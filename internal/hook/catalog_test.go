@@ -0,0 +1,119 @@
+package hook
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+)
+
+func TestLocalesDefineAllMessages(t *testing.T) {
+	for locale, messages := range locales {
+		for name := range locales[DefaultLocale] {
+			if _, ok := messages[name]; !ok {
+				t.Errorf("locale %q is missing message %q", locale, name)
+			}
+		}
+		if err := ValidateTemplates(&v1.Config{Notifications: &v1.Notifications{Locale: locale}}); err != nil {
+			t.Errorf("locale %q: %v", locale, err)
+		}
+	}
+}
+
+func TestCatalogSummary(t *testing.T) {
+	vars := HookVars{
+		Task:  "backup for plan \"test\"",
+		Event: v1.Hook_CONDITION_SNAPSHOT_ERROR,
+		Error: "disk full",
+	}
+
+	summary, err := vars.Summary()
+	if err != nil {
+		t.Fatalf("Summary() error: %v", err)
+	}
+	if !strings.Contains(summary, "Error: disk full") {
+		t.Errorf("want default summary, got %q", summary)
+	}
+
+	catalog, err := NewCatalog(&v1.Notifications{
+		Locale:    "de",
+		Templates: map[string]string{MsgSummaryError: "{{ .EventName .Event }}: {{ .Error }}"},
+	})
+	if err != nil {
+		t.Fatalf("NewCatalog() error: %v", err)
+	}
+	vars.catalog = catalog
+	summary, err = vars.Summary()
+	if err != nil {
+		t.Fatalf("Summary() error: %v", err)
+	}
+	if summary != "Snapshot-Fehler: disk full" {
+		t.Errorf("want overridden summary with localized event name, got %q", summary)
+	}
+}
+
+func TestValidateTemplates(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  *v1.Config
+		wantErr string
+	}{
+		{
+			name: "valid",
+			config: &v1.Config{
+				Notifications: &v1.Notifications{Templates: map[string]string{MsgGotifyTitle: "Backup {{ .EventName .Event }} on {{ .Plan.Id }}"}},
+				Plans: []*v1.Plan{{Id: "plan", Hooks: []*v1.Hook{{
+					Action: &v1.Hook_ActionCommand{ActionCommand: &v1.Hook_Command{Command: "echo {{ .ShellEscape .Summary }}"}},
+				}}}},
+			},
+		},
+		{
+			name:    "unknown locale",
+			config:  &v1.Config{Notifications: &v1.Notifications{Locale: "xx"}},
+			wantErr: "unknown locale",
+		},
+		{
+			name:    "unknown message",
+			config:  &v1.Config{Notifications: &v1.Notifications{Templates: map[string]string{"nope": "x"}}},
+			wantErr: "unknown message",
+		},
+		{
+			name:    "message does not parse",
+			config:  &v1.Config{Notifications: &v1.Notifications{Templates: map[string]string{MsgChatHeader: "{{ .Task"}}},
+			wantErr: "chat_header",
+		},
+		{
+			name:    "recursive message",
+			config:  &v1.Config{Notifications: &v1.Notifications{Templates: map[string]string{MsgSummaryError: "{{ .Summary }}"}}},
+			wantErr: "nested too deeply",
+		},
+		{
+			name: "hook uses an unknown field",
+			config: &v1.Config{
+				Repos: []*v1.Repo{{Id: "repo", Hooks: []*v1.Hook{{
+					Action: &v1.Hook_ActionSlack{ActionSlack: &v1.Hook_Slack{Template: "{{ .Snapshot }}"}},
+				}}}},
+			},
+			wantErr: "repo repo hook 0",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateTemplates(tc.config)
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatalf("ValidateTemplates() error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("want error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+
+	if _, err := NewCatalog(&v1.Notifications{Templates: map[string]string{"nope": ""}}); !errors.Is(err, ErrUnknownMessage) {
+		t.Errorf("want ErrUnknownMessage, got %v", err)
+	}
+}
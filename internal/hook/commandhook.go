@@ -1,15 +1,19 @@
 package hook
 
 import (
+	"context"
 	"fmt"
 	"io"
+	"os"
 	"os/exec"
+	"slices"
 	"strings"
+	"time"
 
 	v1 "github.com/garethgeorge/backrest/gen/go/v1"
 )
 
-func (h *Hook) doCommand(cmd *v1.Hook_ActionCommand, vars HookVars, output io.Writer) error {
+func (h *Hook) doCommand(ctx context.Context, cmd *v1.Hook_ActionCommand, vars HookVars, output io.Writer) error {
 	command, err := h.renderTemplate(cmd.ActionCommand.Command, vars)
 	if err != nil {
 		return fmt.Errorf("template rendering: %w", err)
@@ -30,11 +34,34 @@ func (h *Hook) doCommand(cmd *v1.Hook_ActionCommand, vars HookVars, output io.Wr
 	output.Write([]byte("------- output -------\n"))
 
 	// Run the command in the specified shell
-	execCmd := exec.Command(shell)
+	execCmd := exec.CommandContext(ctx, shell)
 	execCmd.Stdin = strings.NewReader(command)
+	execCmd.Dir = cmd.ActionCommand.GetWorkingDir()
+	if allowlist := cmd.ActionCommand.GetEnvAllowlist(); len(allowlist) > 0 {
+		execCmd.Env = allowedEnv(os.Environ(), allowlist)
+	}
+	if user := cmd.ActionCommand.GetUser(); user != "" {
+		if err := runAsUser(execCmd, user); err != nil {
+			return fmt.Errorf("run as user %q: %w", user, err)
+		}
+	}
+	// background processes the script started may hold its output open, don't wait on them once it is killed.
+	execCmd.WaitDelay = 5 * time.Second
 
 	execCmd.Stderr = output
 	execCmd.Stdout = output
 
 	return execCmd.Run()
 }
+
+// allowedEnv returns the variables of env named in the allowlist.
+func allowedEnv(env []string, allowlist []string) []string {
+	allowed := []string{}
+	for _, kv := range env {
+		name, _, _ := strings.Cut(kv, "=")
+		if slices.Contains(allowlist, name) {
+			allowed = append(allowed, kv)
+		}
+	}
+	return allowed
+}
@@ -2,6 +2,7 @@ package hook
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -9,18 +10,22 @@ import (
 	v1 "github.com/garethgeorge/backrest/gen/go/v1"
 )
 
-func (h *Hook) doDiscord(cmd *v1.Hook_ActionDiscord, vars HookVars, output io.Writer) error {
-	payload, err := h.renderTemplateOrDefault(cmd.ActionDiscord.GetTemplate(), defaultTemplate, vars)
+func (h *Hook) doDiscord(ctx context.Context, cmd *v1.Hook_ActionDiscord, vars HookVars, output io.Writer) error {
+	payload, err := h.renderTemplateOrDefault(cmd.ActionDiscord.GetTemplate(), MsgPayload, vars)
 	if err != nil {
 		return fmt.Errorf("template rendering: %w", err)
 	}
+	header, err := vars.messages().Render(MsgChatHeader, vars)
+	if err != nil {
+		return fmt.Errorf("header rendering: %w", err)
+	}
 
 	type Message struct {
 		Content string `json:"content"`
 	}
 
 	request := Message{
-		Content: "Backrest Notification\n" + payload, // leading newline looks better in discord.
+		Content: header + "\n" + payload, // leading newline looks better in discord.
 	}
 
 	requestBytes, _ := json.Marshal(request)
@@ -28,6 +33,6 @@ func (h *Hook) doDiscord(cmd *v1.Hook_ActionDiscord, vars HookVars, output io.Wr
 	fmt.Fprintf(output, "Sending Discord message to %s\n---- payload ----\n", cmd.ActionDiscord.GetWebhookUrl())
 	output.Write(requestBytes)
 
-	_, err = post(cmd.ActionDiscord.GetWebhookUrl(), "application/json", bytes.NewReader(requestBytes))
+	_, err = post(ctx, cmd.ActionDiscord.GetWebhookUrl(), "application/json", bytes.NewReader(requestBytes))
 	return err
 }
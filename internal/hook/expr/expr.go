@@ -0,0 +1,244 @@
+// Package expr implements the small expression language hook conditions are written in e.g.
+// `error contains "timeout" && plan == "media"` or `snapshot.data_added > 50GB`.
+//
+// Expressions compare strings, numbers and booleans with ==, !=, <, <=, > and >=, test substrings with contains and
+// regular expressions with matches, and combine conditions with &&, || and !. Numbers may carry a size suffix, KB, MB,
+// GB and TB are powers of 1000 and KiB, MiB, GiB and TiB powers of 1024. Identifiers name variables, dots select
+// fields of nested variables. Variables that aren't set are null, null is only equal to null and comparing it is false.
+package expr
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Expr is a parsed expression.
+type Expr struct {
+	src  string
+	root node
+}
+
+// Parse parses the expression.
+func Parse(src string) (*Expr, error) {
+	tokens, err := lex(src)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != tokEOF {
+		return nil, fmt.Errorf("unexpected %s at offset %d", t, t.pos)
+	}
+	return &Expr{src: src, root: root}, nil
+}
+
+func (e *Expr) String() string {
+	return e.src
+}
+
+// Eval evaluates the expression with the variables, it is an error if the result isn't a boolean. Values of vars
+// are strings, numbers, booleans or nested map[string]any.
+func (e *Expr) Eval(vars map[string]any) (bool, error) {
+	v, err := e.root.eval(vars)
+	if err != nil {
+		return false, err
+	}
+	b, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("expression %q evaluates to %s, not a boolean", e.src, describe(v))
+	}
+	return b, nil
+}
+
+type node interface {
+	eval(vars map[string]any) (any, error)
+}
+
+type literal struct{ value any }
+
+func (n literal) eval(map[string]any) (any, error) { return n.value, nil }
+
+type ident struct{ path []string }
+
+func (n ident) eval(vars map[string]any) (any, error) {
+	var cur any = vars
+	for _, name := range n.path {
+		m, ok := cur.(map[string]any)
+		if !ok {
+			return nil, nil
+		}
+		cur = m[name]
+	}
+	return normalize(cur), nil
+}
+
+type not struct{ operand node }
+
+func (n not) eval(vars map[string]any) (any, error) {
+	v, err := n.operand.eval(vars)
+	if err != nil {
+		return nil, err
+	}
+	b, ok := v.(bool)
+	if !ok {
+		return nil, fmt.Errorf("operand of ! is %s, not a boolean", describe(v))
+	}
+	return !b, nil
+}
+
+// logical is && or ||, the right operand is only evaluated if the left doesn't decide the result.
+type logical struct {
+	op          string
+	left, right node
+}
+
+func (n logical) eval(vars map[string]any) (any, error) {
+	l, err := n.operand(n.left, vars)
+	if err != nil {
+		return nil, err
+	}
+	if l == (n.op == "||") {
+		return l, nil
+	}
+	return n.operand(n.right, vars)
+}
+
+func (n logical) operand(operand node, vars map[string]any) (bool, error) {
+	v, err := operand.eval(vars)
+	if err != nil {
+		return false, err
+	}
+	b, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("operand of %s is %s, not a boolean", n.op, describe(v))
+	}
+	return b, nil
+}
+
+type comparison struct {
+	op          string
+	left, right node
+}
+
+func (n comparison) eval(vars map[string]any) (any, error) {
+	l, err := n.left.eval(vars)
+	if err != nil {
+		return nil, err
+	}
+	r, err := n.right.eval(vars)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := l.(map[string]any); ok {
+		return nil, fmt.Errorf("can't compare an object with %s", n.op)
+	}
+	if _, ok := r.(map[string]any); ok {
+		return nil, fmt.Errorf("can't compare an object with %s", n.op)
+	}
+
+	switch n.op {
+	case "==":
+		return l == r, nil
+	case "!=":
+		return l != r, nil
+	case "contains", "matches":
+		if l == nil || r == nil {
+			return false, nil
+		}
+		ls, lok := l.(string)
+		rs, rok := r.(string)
+		if !lok || !rok {
+			return nil, fmt.Errorf("operands of %s must be strings, got %s and %s", n.op, describe(l), describe(r))
+		}
+		if n.op == "contains" {
+			return strings.Contains(ls, rs), nil
+		}
+		re, err := regexp.Compile(rs)
+		if err != nil {
+			return nil, fmt.Errorf("invalid regular expression %q: %w", rs, err)
+		}
+		return re.MatchString(ls), nil
+	}
+
+	if l == nil || r == nil {
+		return false, nil
+	}
+	var c int
+	switch l := l.(type) {
+	case float64:
+		r, ok := r.(float64)
+		if !ok {
+			return nil, fmt.Errorf("can't compare %s with %s", describe(l), describe(r))
+		}
+		c = compare(l, r)
+	case string:
+		r, ok := r.(string)
+		if !ok {
+			return nil, fmt.Errorf("can't compare %s with %s", describe(l), describe(r))
+		}
+		c = strings.Compare(l, r)
+	default:
+		return nil, fmt.Errorf("can't order %s", describe(l))
+	}
+	switch n.op {
+	case "<":
+		return c < 0, nil
+	case "<=":
+		return c <= 0, nil
+	case ">":
+		return c > 0, nil
+	default:
+		return c >= 0, nil
+	}
+}
+
+func compare(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// normalize converts the numeric types of variables to float64 so they compare with number literals.
+func normalize(v any) any {
+	switch v := v.(type) {
+	case int:
+		return float64(v)
+	case int32:
+		return float64(v)
+	case int64:
+		return float64(v)
+	case uint64:
+		return float64(v)
+	case float32:
+		return float64(v)
+	case string, bool, float64, map[string]any:
+		return v
+	default:
+		return nil
+	}
+}
+
+func describe(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return "null"
+	case string:
+		return fmt.Sprintf("string %q", v)
+	case float64:
+		return fmt.Sprintf("number %v", v)
+	case bool:
+		return fmt.Sprintf("boolean %v", v)
+	default:
+		return "an object"
+	}
+}
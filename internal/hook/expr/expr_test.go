@@ -0,0 +1,92 @@
+package expr
+
+import (
+	"testing"
+)
+
+func TestEval(t *testing.T) {
+	vars := map[string]any{
+		"plan":  "media",
+		"error": "task timed out after 1h",
+		"snapshot": map[string]any{
+			"data_added":  int64(60e9),
+			"files_new":   3,
+			"snapshot_id": "abc",
+		},
+	}
+
+	tests := []struct {
+		expr string
+		want bool
+	}{
+		{expr: `error contains "timeout" && plan == "media"`, want: false},
+		{expr: `error contains "timed out" && plan == "media"`, want: true},
+		{expr: `snapshot.data_added > 50GB`, want: true},
+		{expr: `snapshot.data_added > 60GB`, want: false},
+		{expr: `snapshot.data_added >= 60GB`, want: true},
+		{expr: `snapshot.data_added < 1TiB`, want: true},
+		{expr: `snapshot.files_new == 3 || plan == "other"`, want: true},
+		{expr: `!(plan == "media")`, want: false},
+		{expr: `plan != 'media' || !false`, want: true},
+		{expr: `error matches "after [0-9]+h$"`, want: true},
+		{expr: `missing == null`, want: true},
+		{expr: `missing > 10`, want: false},
+		{expr: `missing contains "x"`, want: false},
+		{expr: `snapshot.missing.field != 1`, want: true},
+		{expr: `plan < "zzz" && plan >= "media"`, want: true},
+		{expr: `true || missing > "a" && 1 > 2`, want: true},
+	}
+	for _, tc := range tests {
+		e, err := Parse(tc.expr)
+		if err != nil {
+			t.Errorf("Parse(%q) error: %v", tc.expr, err)
+			continue
+		}
+		got, err := e.Eval(vars)
+		if err != nil {
+			t.Errorf("Eval(%q) error: %v", tc.expr, err)
+			continue
+		}
+		if got != tc.want {
+			t.Errorf("Eval(%q) = %v, want %v", tc.expr, got, tc.want)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, src := range []string{
+		``,
+		`plan ==`,
+		`plan == "media`,
+		`(plan == "media"`,
+		`plan == "media")`,
+		`size > 10XB`,
+		`plan = "media"`,
+		`plan..id == 1`,
+	} {
+		if _, err := Parse(src); err == nil {
+			t.Errorf("Parse(%q) want error", src)
+		}
+	}
+}
+
+func TestEvalErrors(t *testing.T) {
+	vars := map[string]any{"plan": "media", "snapshot": map[string]any{}}
+	for _, src := range []string{
+		`plan`,
+		`plan > 1`,
+		`plan && true`,
+		`!plan`,
+		`snapshot == 1`,
+		`plan matches "("`,
+	} {
+		e, err := Parse(src)
+		if err != nil {
+			t.Errorf("Parse(%q) error: %v", src, err)
+			continue
+		}
+		if _, err := e.Eval(vars); err == nil {
+			t.Errorf("Eval(%q) want error", src)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package hook
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -11,13 +12,13 @@ import (
 	v1 "github.com/garethgeorge/backrest/gen/go/v1"
 )
 
-func (h *Hook) doGotify(cmd *v1.Hook_ActionGotify, vars HookVars, output io.Writer) error {
-	payload, err := h.renderTemplateOrDefault(cmd.ActionGotify.GetTemplate(), defaultTemplate, vars)
+func (h *Hook) doGotify(ctx context.Context, cmd *v1.Hook_ActionGotify, vars HookVars, output io.Writer) error {
+	payload, err := h.renderTemplateOrDefault(cmd.ActionGotify.GetTemplate(), MsgPayload, vars)
 	if err != nil {
 		return fmt.Errorf("template rendering: %w", err)
 	}
 
-	title, err := h.renderTemplateOrDefault(cmd.ActionGotify.GetTitleTemplate(), "Backrest Event", vars)
+	title, err := h.renderTemplateOrDefault(cmd.ActionGotify.GetTitleTemplate(), MsgGotifyTitle, vars)
 	if err != nil {
 		return fmt.Errorf("title template rendering: %w", err)
 	}
@@ -48,7 +49,7 @@ func (h *Hook) doGotify(cmd *v1.Hook_ActionGotify, vars HookVars, output io.Writ
 	fmt.Fprintf(output, "---- payload ----\n")
 	output.Write(b)
 
-	body, err := post(postUrl, "application/json", bytes.NewReader(b))
+	body, err := post(ctx, postUrl, "application/json", bytes.NewReader(b))
 
 	if err != nil {
 		return fmt.Errorf("send gotify message: %w", err)
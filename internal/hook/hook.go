@@ -3,27 +3,28 @@ package hook
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"slices"
 	"strings"
+	"sync/atomic"
 	"text/template"
 	"time"
 
 	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/hook/expr"
 	"github.com/garethgeorge/backrest/internal/oplog"
 	"github.com/garethgeorge/backrest/internal/rotatinglog"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/proto"
 )
 
-var (
-	defaultTemplate = `{{ .Summary }}`
-)
-
 type HookExecutor struct {
 	oplog    *oplog.OpLog
 	logStore *rotatinglog.RotatingLog
+	catalog  atomic.Pointer[Catalog]
+	plugins  atomic.Pointer[Plugins]
 }
 
 func NewHookExecutor(oplog *oplog.OpLog, bigOutputStore *rotatinglog.RotatingLog) *HookExecutor {
@@ -33,6 +34,16 @@ func NewHookExecutor(oplog *oplog.OpLog, bigOutputStore *rotatinglog.RotatingLog
 	}
 }
 
+// SetCatalog sets the messages notifications are rendered with, nil restores the default catalog.
+func (e *HookExecutor) SetCatalog(c *Catalog) {
+	e.catalog.Store(c)
+}
+
+// SetPlugins sets the external plugins available to hooks.
+func (e *HookExecutor) SetPlugins(p *Plugins) {
+	e.plugins.Store(p)
+}
+
 // ExecuteHooks schedules tasks for the hooks subscribed to the given event. The vars map is used to substitute variables
 // Hooks are pulled both from the provided plan and from the repo config.
 func (e *HookExecutor) ExecuteHooks(repo *v1.Repo, plan *v1.Plan, snapshotId string, events []v1.Hook_Condition, vars HookVars) {
@@ -41,17 +52,20 @@ func (e *HookExecutor) ExecuteHooks(repo *v1.Repo, plan *v1.Plan, snapshotId str
 		PlanId:     plan.GetId(),
 		RepoId:     repo.GetId(),
 		SnapshotId: snapshotId,
+		RunId:      vars.RunId,
 	}
 
 	vars.SnapshotId = snapshotId
 	vars.Repo = repo
 	vars.Plan = plan
 	vars.CurTime = time.Now()
+	vars.catalog = e.catalog.Load()
+	vars.plugins = e.plugins.Load()
 
 	for idx, hook := range repo.GetHooks() {
 		h := (*Hook)(hook)
 		event := firstMatchingCondition(h, events)
-		if event == v1.Hook_CONDITION_UNKNOWN {
+		if event == v1.Hook_CONDITION_UNKNOWN || !h.conditionMet(event, vars) {
 			continue
 		}
 
@@ -70,7 +84,7 @@ func (e *HookExecutor) ExecuteHooks(repo *v1.Repo, plan *v1.Plan, snapshotId str
 	for idx, hook := range plan.GetHooks() {
 		h := (*Hook)(hook)
 		event := firstMatchingCondition(h, events)
-		if event == v1.Hook_CONDITION_UNKNOWN {
+		if event == v1.Hook_CONDITION_UNKNOWN || !h.conditionMet(event, vars) {
 			continue
 		}
 
@@ -96,6 +110,24 @@ func firstMatchingCondition(hook *Hook, events []v1.Hook_Condition) v1.Hook_Cond
 	return v1.Hook_CONDITION_UNKNOWN
 }
 
+// conditionMet evaluates the hook's condition expression for the event. Hooks whose expression fails to evaluate
+// run, a mistake in the expression shouldn't silence the hook.
+func (h *Hook) conditionMet(event v1.Hook_Condition, vars HookVars) bool {
+	if h.ConditionExpr == "" {
+		return true
+	}
+	vars.Event = event
+	e, err := expr.Parse(h.ConditionExpr)
+	if err == nil {
+		var met bool
+		if met, err = e.Eval(vars.exprVars()); err == nil {
+			return met
+		}
+	}
+	zap.L().Warn("failed to evaluate hook condition, running the hook", zap.String("condition", h.ConditionExpr), zap.Error(err))
+	return true
+}
+
 func (e *HookExecutor) executeHook(op *v1.Operation, hook *Hook, event v1.Hook_Condition, vars HookVars) {
 	if err := e.oplog.Add(op); err != nil {
 		zap.S().Errorf("execute hook: add operation: %v", err)
@@ -113,7 +145,12 @@ func (e *HookExecutor) executeHook(op *v1.Operation, hook *Hook, event v1.Hook_C
 	}()
 	defer pw.Close()
 
-	if err := hook.Do(event, vars, io.MultiWriter(output, pw)); err != nil {
+	ctx, cancel := context.WithTimeout(context.Background(), hook.timeout())
+	defer cancel()
+	if err := hook.Do(ctx, event, vars, io.MultiWriter(output, pw)); err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			err = fmt.Errorf("hook timed out after %v: %w", hook.timeout(), err)
+		}
 		output.Write([]byte(fmt.Sprintf("Error: %v", err)))
 		op.DisplayMessage = err.Error()
 		op.Status = v1.OperationStatus_STATUS_ERROR
@@ -141,25 +178,28 @@ func curTimeMs() int64 {
 
 type Hook v1.Hook
 
-func (h *Hook) Do(event v1.Hook_Condition, vars HookVars, output io.Writer) error {
+// defaultTimeout bounds hooks without a timeout, a hung hook would otherwise block the task that ran it.
+const defaultTimeout = 10 * time.Minute
+
+func (h *Hook) timeout() time.Duration {
+	if h.TimeoutSeconds > 0 {
+		return time.Duration(h.TimeoutSeconds) * time.Second
+	}
+	return defaultTimeout
+}
+
+func (h *Hook) Do(ctx context.Context, event v1.Hook_Condition, vars HookVars, output io.Writer) error {
 	if !slices.Contains(h.Conditions, event) {
 		return nil
 	}
 
 	vars.Event = event
 
-	switch action := h.Action.(type) {
-	case *v1.Hook_ActionCommand:
-		return h.doCommand(action, vars, output)
-	case *v1.Hook_ActionDiscord:
-		return h.doDiscord(action, vars, output)
-	case *v1.Hook_ActionGotify:
-		return h.doGotify(action, vars, output)
-	case *v1.Hook_ActionSlack:
-		return h.doSlack(action, vars, output)
-	default:
-		return fmt.Errorf("unknown hook action: %v", action)
+	plugin, err := h.plugin(vars.plugins)
+	if err != nil {
+		return err
 	}
+	return plugin.Run(ctx, h, vars, output)
 }
 
 func (h *Hook) renderTemplate(text string, vars HookVars) (string, error) {
@@ -176,9 +216,10 @@ func (h *Hook) renderTemplate(text string, vars HookVars) (string, error) {
 	return buf.String(), nil
 }
 
-func (h *Hook) renderTemplateOrDefault(template string, defaultTmpl string, vars HookVars) (string, error) {
+// renderTemplateOrDefault renders the template, or the named catalog message if the template is empty.
+func (h *Hook) renderTemplateOrDefault(template string, defaultMsg string, vars HookVars) (string, error) {
 	if strings.Trim(template, " ") == "" {
-		return h.renderTemplate(defaultTmpl, vars)
+		return vars.messages().Render(defaultMsg, vars)
 	}
 	return h.renderTemplate(template, vars)
 }
@@ -2,8 +2,12 @@ package hook
 
 import (
 	"bytes"
+	"context"
+	"fmt"
 	"os/exec"
+	"strings"
 	"testing"
+	"time"
 
 	v1 "github.com/garethgeorge/backrest/gen/go/v1"
 )
@@ -18,7 +22,7 @@ func TestHookCommandInDefaultShell(t *testing.T) {
 		},
 	})
 
-	err := hook.Do(v1.Hook_CONDITION_SNAPSHOT_START, HookVars{}, &bytes.Buffer{})
+	err := hook.Do(context.Background(), v1.Hook_CONDITION_SNAPSHOT_START, HookVars{}, &bytes.Buffer{})
 	if err == nil {
 		t.Fatal("expected error")
 	}
@@ -43,7 +47,7 @@ exit $counter`,
 		},
 	})
 
-	err := hook.Do(v1.Hook_CONDITION_SNAPSHOT_START, HookVars{}, &bytes.Buffer{})
+	err := hook.Do(context.Background(), v1.Hook_CONDITION_SNAPSHOT_START, HookVars{}, &bytes.Buffer{})
 	if err == nil {
 		t.Fatal("expected error")
 	}
@@ -51,3 +55,50 @@ exit $counter`,
 		t.Fatalf("expected exit code 3, got %v", err.(*exec.ExitError).ExitCode())
 	}
 }
+
+func TestHookCommandTimeout(t *testing.T) {
+	hook := Hook(v1.Hook{
+		Conditions: []v1.Hook_Condition{v1.Hook_CONDITION_SNAPSHOT_START},
+		Action: &v1.Hook_ActionCommand{
+			ActionCommand: &v1.Hook_Command{
+				Command: "sleep 30",
+			},
+		},
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	if err := hook.Do(ctx, v1.Hook_CONDITION_SNAPSHOT_START, HookVars{}, &bytes.Buffer{}); err == nil {
+		t.Fatal("expected error")
+	}
+	if d := time.Since(start); d > 10*time.Second {
+		t.Errorf("want the hook stopped at its timeout, ran for %v", d)
+	}
+}
+
+func TestHookCommandSandbox(t *testing.T) {
+	t.Setenv("BACKREST_HOOK_ALLOWED", "allowed")
+	t.Setenv("BACKREST_HOOK_SECRET", "secret")
+	dir := t.TempDir()
+
+	hook := Hook(v1.Hook{
+		Conditions: []v1.Hook_Condition{v1.Hook_CONDITION_SNAPSHOT_START},
+		Action: &v1.Hook_ActionCommand{
+			ActionCommand: &v1.Hook_Command{
+				Command:      `echo "dir=$(pwd) allowed=$BACKREST_HOOK_ALLOWED secret=$BACKREST_HOOK_SECRET"`,
+				WorkingDir:   dir,
+				EnvAllowlist: []string{"PATH", "BACKREST_HOOK_ALLOWED"},
+			},
+		},
+	})
+
+	var output bytes.Buffer
+	if err := hook.Do(context.Background(), v1.Hook_CONDITION_SNAPSHOT_START, HookVars{}, &output); err != nil {
+		t.Fatalf("unexpected error: %v, output: %s", err, output.String())
+	}
+	want := fmt.Sprintf("dir=%s allowed=allowed secret=\n", dir)
+	if !strings.HasSuffix(output.String(), want) {
+		t.Errorf("want output ending in %q, got %q", want, output.String())
+	}
+}
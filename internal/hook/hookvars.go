@@ -1,10 +1,9 @@
 package hook
 
 import (
-	"bytes"
 	"encoding/json"
 	"fmt"
-	"text/template"
+	"strings"
 	"time"
 
 	"github.com/alessio/shellescape"
@@ -24,20 +23,40 @@ type HookVars struct {
 	SnapshotStats *restic.BackupProgressEntry // the summary of the backup operation.
 	CurTime       time.Time                   // the current time as time.Time
 	Error         string                      // the error that caused the hook to run as a string.
+	RunId         int64                       // the run the hook's operation is part of, 0 to start a run of its own.
+
+	catalog *Catalog // messages used by Summary and EventName, the default catalog if nil.
+	depth   int      // nesting of catalog messages being rendered.
+	plugins *Plugins // external plugins hooks may run, none if nil.
+}
+
+func (v HookVars) messages() *Catalog {
+	if v.catalog == nil {
+		return defaultCatalog
+	}
+	return v.catalog
 }
 
-func (v HookVars) EventName(cond v1.Hook_Condition) string {
+func (v HookVars) EventName(cond v1.Hook_Condition) (string, error) {
 	switch cond {
 	case v1.Hook_CONDITION_SNAPSHOT_START:
-		return "snapshot start"
+		return v.messages().Render(MsgEventSnapshotStart, v)
 	case v1.Hook_CONDITION_SNAPSHOT_END:
-		return "snapshot end"
+		return v.messages().Render(MsgEventSnapshotEnd, v)
 	case v1.Hook_CONDITION_ANY_ERROR:
-		return "error"
+		return v.messages().Render(MsgEventAnyError, v)
 	case v1.Hook_CONDITION_SNAPSHOT_ERROR:
-		return "snapshot error"
+		return v.messages().Render(MsgEventSnapshotError, v)
+	case v1.Hook_CONDITION_TIMEOUT:
+		return v.messages().Render(MsgEventTimeout, v)
+	case v1.Hook_CONDITION_RESTORE_DRILL_ERROR:
+		return v.messages().Render(MsgEventRestoreDrillError, v)
+	case v1.Hook_CONDITION_LOW_DISK_SPACE:
+		return v.messages().Render(MsgEventLowDiskSpace, v)
+	case v1.Hook_CONDITION_BACKUP_ANOMALY:
+		return v.messages().Render(MsgEventBackupAnomaly, v)
 	default:
-		return "unknown"
+		return v.messages().Render(MsgEventUnknown, v)
 	}
 }
 
@@ -72,7 +91,39 @@ func (v HookVars) FormatSizeBytes(val any) string {
 }
 
 func (v HookVars) IsError(cond v1.Hook_Condition) bool {
-	return cond == v1.Hook_CONDITION_ANY_ERROR || cond == v1.Hook_CONDITION_SNAPSHOT_ERROR
+	return cond == v1.Hook_CONDITION_ANY_ERROR || cond == v1.Hook_CONDITION_SNAPSHOT_ERROR || cond == v1.Hook_CONDITION_TIMEOUT || cond == v1.Hook_CONDITION_RESTORE_DRILL_ERROR
+}
+
+// ErrorClass returns the kind of failure described by .Error e.g. "network" or "auth", "" if it isn't classified.
+func (v HookVars) ErrorClass() string {
+	class := restic.ClassifyOutput(v.Error)
+	if v.Event == v1.Hook_CONDITION_TIMEOUT {
+		class = v1.ErrorClass_ERROR_CLASS_TIMEOUT
+	}
+	if v.Error == "" || class == v1.ErrorClass_ERROR_CLASS_UNKNOWN {
+		return ""
+	}
+	return strings.ToLower(strings.TrimPrefix(class.String(), "ERROR_CLASS_"))
+}
+
+// exprVars are the variables of hook condition expressions, the snapshot's fields are named as in restic's JSON output.
+func (v HookVars) exprVars() map[string]any {
+	vars := map[string]any{
+		"task":        v.Task,
+		"event":       strings.ToLower(strings.TrimPrefix(v.Event.String(), "CONDITION_")),
+		"error":       v.Error,
+		"error_class": v.ErrorClass(),
+		"repo":        v.Repo.GetId(),
+		"plan":        v.Plan.GetId(),
+		"snapshot_id": v.SnapshotId,
+	}
+	if v.SnapshotStats != nil {
+		var snapshot map[string]any
+		if b, err := json.Marshal(v.SnapshotStats); err == nil && json.Unmarshal(b, &snapshot) == nil {
+			vars["snapshot"] = snapshot
+		}
+	}
+	return vars
 }
 
 func (v HookVars) ShellEscape(s string) string {
@@ -90,32 +141,19 @@ func (v HookVars) JsonMarshal(s any) string {
 func (v HookVars) Summary() (string, error) {
 	switch v.Event {
 	case v1.Hook_CONDITION_SNAPSHOT_START:
-		return v.renderTemplate(templateForSnapshotStart)
+		return v.messages().Render(MsgSummarySnapshotStart, v)
 	case v1.Hook_CONDITION_SNAPSHOT_END:
-		return v.renderTemplate(templateForSnapshotEnd)
-	case v1.Hook_CONDITION_ANY_ERROR:
-		return v.renderTemplate(templateForError)
-	case v1.Hook_CONDITION_SNAPSHOT_ERROR:
-		return v.renderTemplate(templateForError)
+		return v.messages().Render(MsgSummarySnapshotEnd, v)
+	case v1.Hook_CONDITION_ANY_ERROR, v1.Hook_CONDITION_SNAPSHOT_ERROR, v1.Hook_CONDITION_TIMEOUT, v1.Hook_CONDITION_RESTORE_DRILL_ERROR:
+		return v.messages().Render(MsgSummaryError, v)
+	case v1.Hook_CONDITION_LOW_DISK_SPACE, v1.Hook_CONDITION_BACKUP_ANOMALY:
+		return v.messages().Render(MsgSummaryWarning, v)
 	default:
-		return "unknown event", nil
-	}
-}
-
-func (v HookVars) renderTemplate(templ string) (string, error) {
-	t, err := template.New("t").Parse(templ)
-	if err != nil {
-		return "", err
+		return v.messages().Render(MsgEventUnknown, v)
 	}
-
-	var buf bytes.Buffer
-	err = t.Execute(&buf, v)
-	if err != nil {
-		return "", err
-	}
-	return buf.String(), nil
 }
 
+// templates of the "en" locale.
 var templateForSnapshotEnd = `Task: "{{ .Task }}" at {{ .FormatTime .CurTime }}
 Event: {{ .EventName .Event }}
 Repo: {{ .Repo.Id }} 
@@ -149,6 +187,11 @@ var templateForError = `Task: "{{ .Task }}" at {{ .FormatTime .CurTime }}
 Error: {{ .Error }}
 {{ end }}`
 
+var templateForWarning = `Task: "{{ .Task }}" at {{ .FormatTime .CurTime }}
+{{ if .Error -}}
+Warning: {{ .Error }}
+{{ end }}`
+
 var templateForSnapshotStart = `Task: "{{ .Task }}" at {{ .FormatTime .CurTime }}
 Event: {{ .EventName .Event }}
 Repo: {{ .Repo.Id }} 
@@ -0,0 +1,52 @@
+package hook
+
+import (
+	"testing"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/pkg/restic"
+)
+
+func TestErrorClass(t *testing.T) {
+	tests := []struct {
+		vars HookVars
+		want string
+	}{
+		{vars: HookVars{Event: v1.Hook_CONDITION_ANY_ERROR, Error: "Fatal: wrong password or no key found"}, want: "auth"},
+		{vars: HookVars{Event: v1.Hook_CONDITION_SNAPSHOT_ERROR, Error: "dial tcp 10.0.0.1:443: connection refused"}, want: "network"},
+		{vars: HookVars{Event: v1.Hook_CONDITION_TIMEOUT, Error: "task timed out"}, want: "timeout"},
+		{vars: HookVars{Event: v1.Hook_CONDITION_ANY_ERROR, Error: "something unexpected"}, want: ""},
+		{vars: HookVars{Event: v1.Hook_CONDITION_SNAPSHOT_END}, want: ""},
+	}
+	for _, tc := range tests {
+		if got := tc.vars.ErrorClass(); got != tc.want {
+			t.Errorf("ErrorClass() of %q = %q, want %q", tc.vars.Error, got, tc.want)
+		}
+	}
+}
+
+func TestConditionMet(t *testing.T) {
+	vars := HookVars{
+		Plan:          &v1.Plan{Id: "media"},
+		Error:         "dial tcp 10.0.0.1:443: i/o timeout",
+		SnapshotStats: &restic.BackupProgressEntry{DataAdded: 60e9},
+	}
+	tests := []struct {
+		expr  string
+		event v1.Hook_Condition
+		want  bool
+	}{
+		{expr: "", event: v1.Hook_CONDITION_ANY_ERROR, want: true},
+		{expr: `error contains "timeout" && plan == "media"`, event: v1.Hook_CONDITION_ANY_ERROR, want: true},
+		{expr: `error_class == "network"`, event: v1.Hook_CONDITION_ANY_ERROR, want: true},
+		{expr: `event == "snapshot_end"`, event: v1.Hook_CONDITION_ANY_ERROR, want: false},
+		{expr: `snapshot.data_added > 50GB`, event: v1.Hook_CONDITION_SNAPSHOT_END, want: true},
+		{expr: `plan > 1`, event: v1.Hook_CONDITION_ANY_ERROR, want: true}, // fails to evaluate so the hook runs.
+	}
+	for _, tc := range tests {
+		h := &Hook{ConditionExpr: tc.expr}
+		if got := h.conditionMet(tc.event, vars); got != tc.want {
+			t.Errorf("conditionMet(%q) = %v, want %v", tc.expr, got, tc.want)
+		}
+	}
+}
@@ -1,13 +1,19 @@
 package hook
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"net/http"
 )
 
-func post(url string, contentType string, body io.Reader) (string, error) {
-	r, err := http.Post(url, contentType, body)
+func post(ctx context.Context, url string, contentType string, body io.Reader) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, body)
+	if err != nil {
+		return "", fmt.Errorf("create request %v: %w", url, err)
+	}
+	req.Header.Set("Content-Type", contentType)
+	r, err := http.DefaultClient.Do(req)
 	if err != nil {
 		return "", fmt.Errorf("send request %v: %w", url, err)
 	}
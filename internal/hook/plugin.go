@@ -0,0 +1,170 @@
+package hook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/pkg/restic"
+)
+
+var ErrUnknownPlugin = errors.New("unknown plugin")
+
+// Plugin runs the action of a hook, the built in actions and external plugins implement it.
+type Plugin interface {
+	Run(ctx context.Context, h *Hook, vars HookVars, output io.Writer) error
+}
+
+type pluginFunc func(ctx context.Context, h *Hook, vars HookVars, output io.Writer) error
+
+func (f pluginFunc) Run(ctx context.Context, h *Hook, vars HookVars, output io.Writer) error {
+	return f(ctx, h, vars, output)
+}
+
+// Plugins are the external plugins available to hooks by name.
+type Plugins struct {
+	plugins map[string]Plugin
+}
+
+// DiscoverPlugins returns an exec plugin for every executable file in dir, named after the file without its
+// extension. A missing dir has no plugins.
+func DiscoverPlugins(dir string) (*Plugins, error) {
+	p := &Plugins{plugins: make(map[string]Plugin)}
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return p, nil
+	} else if err != nil {
+		return p, fmt.Errorf("read plugins dir: %w", err)
+	}
+	for _, entry := range entries {
+		info, err := entry.Info()
+		if err != nil || !info.Mode().IsRegular() || !isExecutable(entry.Name(), info.Mode()) {
+			continue
+		}
+		name := strings.TrimSuffix(entry.Name(), filepath.Ext(entry.Name()))
+		p.plugins[name] = &ExecPlugin{Path: filepath.Join(dir, entry.Name())}
+	}
+	return p, nil
+}
+
+func isExecutable(name string, mode os.FileMode) bool {
+	if runtime.GOOS == "windows" {
+		return strings.EqualFold(filepath.Ext(name), ".exe")
+	}
+	return mode&0111 != 0
+}
+
+// Names returns the names of the plugins in sorted order.
+func (p *Plugins) Names() []string {
+	var names []string
+	if p != nil {
+		for name := range p.plugins {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (p *Plugins) get(name string) (Plugin, error) {
+	if p != nil {
+		if plugin, ok := p.plugins[name]; ok {
+			return plugin, nil
+		}
+	}
+	return nil, fmt.Errorf("%w %q, plugins are discovered in the plugins directory", ErrUnknownPlugin, name)
+}
+
+// PluginRequest is written to an exec plugin's stdin as JSON. NOTE: new fields may be added, plugins should ignore
+// fields they don't know.
+type PluginRequest struct {
+	Event         string                      `json:"event"` // e.g. "snapshot_error", see Hook.Condition.
+	Summary       string                      `json:"summary"`
+	Task          string                      `json:"task"`
+	Repo          string                      `json:"repo"`
+	Plan          string                      `json:"plan,omitempty"`
+	SnapshotId    string                      `json:"snapshot_id,omitempty"`
+	SnapshotStats *restic.BackupProgressEntry `json:"snapshot_stats,omitempty"`
+	Error         string                      `json:"error,omitempty"`
+	ErrorClass    string                      `json:"error_class,omitempty"`
+	Time          time.Time                   `json:"time"`
+	Settings      map[string]string           `json:"settings,omitempty"` // the hook's plugin settings.
+}
+
+// ExecPlugin runs an executable for each hook, the executable reads a PluginRequest from stdin and fails the hook
+// by exiting with a non-zero status. Its output is kept as the hook's output.
+type ExecPlugin struct {
+	Path string
+}
+
+var _ Plugin = &ExecPlugin{}
+
+func (p *ExecPlugin) Run(ctx context.Context, h *Hook, vars HookVars, output io.Writer) error {
+	summary, err := vars.Summary()
+	if err != nil {
+		return fmt.Errorf("render summary: %w", err)
+	}
+	req, err := json.Marshal(&PluginRequest{
+		Event:         strings.ToLower(strings.TrimPrefix(vars.Event.String(), "CONDITION_")),
+		Summary:       summary,
+		Task:          vars.Task,
+		Repo:          vars.Repo.GetId(),
+		Plan:          vars.Plan.GetId(),
+		SnapshotId:    vars.SnapshotId,
+		SnapshotStats: vars.SnapshotStats,
+		Error:         vars.Error,
+		ErrorClass:    vars.ErrorClass(),
+		Time:          vars.CurTime,
+		Settings:      (*v1.Hook)(h).GetActionPlugin().GetSettings(),
+	})
+	if err != nil {
+		return fmt.Errorf("json marshal: %w", err)
+	}
+
+	fmt.Fprintf(output, "Running plugin %s\n", p.Path)
+	cmd := exec.CommandContext(ctx, p.Path)
+	cmd.Stdin = strings.NewReader(string(req))
+	cmd.Stdout = output
+	cmd.Stderr = output
+	cmd.WaitDelay = 5 * time.Second
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("plugin %s: %w", filepath.Base(p.Path), err)
+	}
+	return nil
+}
+
+// plugin returns the plugin that runs the hook's action.
+func (h *Hook) plugin(plugins *Plugins) (Plugin, error) {
+	switch action := h.Action.(type) {
+	case *v1.Hook_ActionCommand:
+		return pluginFunc(func(ctx context.Context, h *Hook, vars HookVars, output io.Writer) error {
+			return h.doCommand(ctx, action, vars, output)
+		}), nil
+	case *v1.Hook_ActionDiscord:
+		return pluginFunc(func(ctx context.Context, h *Hook, vars HookVars, output io.Writer) error {
+			return h.doDiscord(ctx, action, vars, output)
+		}), nil
+	case *v1.Hook_ActionGotify:
+		return pluginFunc(func(ctx context.Context, h *Hook, vars HookVars, output io.Writer) error {
+			return h.doGotify(ctx, action, vars, output)
+		}), nil
+	case *v1.Hook_ActionSlack:
+		return pluginFunc(func(ctx context.Context, h *Hook, vars HookVars, output io.Writer) error {
+			return h.doSlack(ctx, action, vars, output)
+		}), nil
+	case *v1.Hook_ActionPlugin:
+		return plugins.get(action.ActionPlugin.GetName())
+	default:
+		return nil, fmt.Errorf("unknown hook action: %v", action)
+	}
+}
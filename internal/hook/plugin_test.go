@@ -0,0 +1,75 @@
+package hook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+)
+
+func TestExecPlugin(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("plugin scripts need a unix shell")
+	}
+
+	dir := t.TempDir()
+	script := "#!/bin/sh\ncat > \"$(dirname \"$0\")/request.json\"\necho delivered\ngrep -q '\"fail\":\"yes\"' \"$(dirname \"$0\")/request.json\" && exit 3\nexit 0\n"
+	if err := os.WriteFile(filepath.Join(dir, "pager.sh"), []byte(script), 0755); err != nil {
+		t.Fatalf("write plugin: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "README"), []byte("not a plugin"), 0644); err != nil {
+		t.Fatalf("write readme: %v", err)
+	}
+
+	plugins, err := DiscoverPlugins(dir)
+	if err != nil {
+		t.Fatalf("discover plugins: %v", err)
+	}
+	if names := plugins.Names(); len(names) != 1 || names[0] != "pager" {
+		t.Fatalf("want only the executable discovered as a plugin, got %v", names)
+	}
+
+	action := &v1.Hook_Plugin{Name: "pager", Settings: map[string]string{"routing_key": "abc"}}
+	hook := Hook(v1.Hook{
+		Conditions: []v1.Hook_Condition{v1.Hook_CONDITION_SNAPSHOT_ERROR},
+		Action:     &v1.Hook_ActionPlugin{ActionPlugin: action},
+	})
+	vars := HookVars{Task: "backup", Repo: &v1.Repo{Id: "repo"}, Plan: &v1.Plan{Id: "plan"}, Error: "connection refused", plugins: plugins}
+
+	var output bytes.Buffer
+	if err := hook.Do(context.Background(), v1.Hook_CONDITION_SNAPSHOT_ERROR, vars, &output); err != nil {
+		t.Fatalf("unexpected error: %v, output: %s", err, output.String())
+	}
+	if !strings.Contains(output.String(), "delivered") {
+		t.Errorf("want the plugin's output kept, got %q", output.String())
+	}
+	data, err := os.ReadFile(filepath.Join(dir, "request.json"))
+	if err != nil {
+		t.Fatalf("read request: %v", err)
+	}
+	var req PluginRequest
+	if err := json.Unmarshal(data, &req); err != nil {
+		t.Fatalf("unmarshal request %s: %v", data, err)
+	}
+	if req.Event != "snapshot_error" || req.Repo != "repo" || req.Plan != "plan" || req.ErrorClass != "network" ||
+		req.Settings["routing_key"] != "abc" || !strings.Contains(req.Summary, "connection refused") {
+		t.Errorf("want the hook's vars and settings in the request, got %+v", req)
+	}
+
+	action.Settings["fail"] = "yes"
+	if err := hook.Do(context.Background(), v1.Hook_CONDITION_SNAPSHOT_ERROR, vars, &output); err == nil {
+		t.Errorf("want the hook failed when the plugin exits with an error")
+	}
+
+	action.Name = "missing"
+	if err := hook.Do(context.Background(), v1.Hook_CONDITION_SNAPSHOT_ERROR, vars, &output); !errors.Is(err, ErrUnknownPlugin) {
+		t.Errorf("want ErrUnknownPlugin for a plugin that isn't installed, got %v", err)
+	}
+}
@@ -0,0 +1,14 @@
+//go:build !linux && !darwin
+// +build !linux,!darwin
+
+package hook
+
+import (
+	"errors"
+	"os/exec"
+)
+
+// runAsUser is only supported on linux and darwin.
+func runAsUser(cmd *exec.Cmd, name string) error {
+	return errors.New("running hooks as another user is not supported on this platform")
+}
@@ -2,6 +2,7 @@ package hook
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -9,18 +10,22 @@ import (
 	v1 "github.com/garethgeorge/backrest/gen/go/v1"
 )
 
-func (h *Hook) doSlack(cmd *v1.Hook_ActionSlack, vars HookVars, output io.Writer) error {
-	payload, err := h.renderTemplateOrDefault(cmd.ActionSlack.GetTemplate(), defaultTemplate, vars)
+func (h *Hook) doSlack(ctx context.Context, cmd *v1.Hook_ActionSlack, vars HookVars, output io.Writer) error {
+	payload, err := h.renderTemplateOrDefault(cmd.ActionSlack.GetTemplate(), MsgPayload, vars)
 	if err != nil {
 		return fmt.Errorf("template rendering: %w", err)
 	}
+	header, err := vars.messages().Render(MsgChatHeader, vars)
+	if err != nil {
+		return fmt.Errorf("header rendering: %w", err)
+	}
 
 	type Message struct {
 		Text string `json:"text"`
 	}
 
 	request := Message{
-		Text: "Backrest Notification\n" + payload, // leading newline looks better in discord.
+		Text: header + "\n" + payload, // leading newline looks better in discord.
 	}
 
 	requestBytes, _ := json.Marshal(request)
@@ -28,6 +33,6 @@ func (h *Hook) doSlack(cmd *v1.Hook_ActionSlack, vars HookVars, output io.Writer
 	fmt.Fprintf(output, "Sending Slack message to %s\n---- payload ----\n", cmd.ActionSlack.GetWebhookUrl())
 	output.Write(requestBytes)
 
-	_, err = post(cmd.ActionSlack.GetWebhookUrl(), "application/json", bytes.NewReader(requestBytes))
+	_, err = post(ctx, cmd.ActionSlack.GetWebhookUrl(), "application/json", bytes.NewReader(requestBytes))
 	return err
 }
@@ -0,0 +1,236 @@
+// Package metrics keeps a compact, downsampled history of per-plan metrics recorded from finished operations so
+// charts don't have to scan the operation log.
+package metrics
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/oplog"
+	"github.com/garethgeorge/backrest/internal/oplog/indexutil"
+	"github.com/natefinch/atomic"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// maxPoints bounds the points of a series, the older half of a full series is merged pairwise so recent points
+	// keep full resolution.
+	maxPoints = 512
+
+	saveInterval = time.Minute
+)
+
+// seriesKey identifies a series, plans backing up to several repos have a series per repo.
+type seriesKey struct {
+	planId string
+	repoId string
+	metric v1.Metric
+}
+
+// Store holds the metric series in memory and persists them to a file.
+type Store struct {
+	path string
+
+	mu     sync.Mutex
+	series map[seriesKey]*v1.MetricSeries
+	dirty  bool
+}
+
+// NewStore returns a store persisted to path, series saved by a previous run are loaded. loaded is false if there was
+// nothing to load and the store should be backfilled from the oplog.
+func NewStore(path string) (s *Store, loaded bool, err error) {
+	s = &Store{path: path, series: make(map[seriesKey]*v1.MetricSeries)}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, false, nil
+	} else if err != nil {
+		return s, false, fmt.Errorf("read metrics: %w", err)
+	}
+	list := &v1.MetricSeriesList{}
+	if err := proto.Unmarshal(data, list); err != nil {
+		return s, false, fmt.Errorf("unmarshal metrics: %w", err)
+	}
+	for _, series := range list.Series {
+		s.series[seriesKey{series.PlanId, series.RepoId, series.Metric}] = series
+	}
+	return s, true, nil
+}
+
+// Backfill records the finished operations of the plans from the oplog.
+func (s *Store) Backfill(log *oplog.OpLog, planIds []string) error {
+	for _, planId := range planIds {
+		if err := log.ForEachByPlan(planId, indexutil.CollectAll(), func(op *v1.Operation) error {
+			s.Record(op)
+			return nil
+		}); err != nil {
+			return fmt.Errorf("backfill plan %q: %w", planId, err)
+		}
+	}
+	return nil
+}
+
+// Run records operations as they finish and saves the store periodically until the context is cancelled.
+func (s *Store) Run(ctx context.Context, log *oplog.OpLog) {
+	callback := func(old *v1.Operation, new *v1.Operation) {
+		if new == nil || (old != nil && old.Status == new.Status) {
+			return
+		}
+		s.Record(new)
+	}
+	log.Subscribe(&callback)
+	defer log.Unsubscribe(&callback)
+
+	ticker := time.NewTicker(saveInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			if err := s.Save(); err != nil {
+				zap.S().Warnf("metrics: %v", err)
+			}
+			return
+		case <-ticker.C:
+			if err := s.Save(); err != nil {
+				zap.S().Warnf("metrics: %v", err)
+			}
+		}
+	}
+}
+
+// Record adds the metrics of a successful operation to the plan's series, other operations are ignored.
+func (s *Store) Record(op *v1.Operation) {
+	if op.PlanId == "" || (op.Status != v1.OperationStatus_STATUS_SUCCESS && op.Status != v1.OperationStatus_STATUS_WARNING) {
+		return
+	}
+	at := op.UnixTimeEndMs
+	switch o := op.Op.(type) {
+	case *v1.Operation_OperationBackup:
+		summary := o.OperationBackup.GetLastStatus().GetSummary()
+		if summary == nil {
+			return
+		}
+		s.add(op.PlanId, op.RepoId, v1.Metric_METRIC_BACKUP_DURATION_MS, at, float64(op.UnixTimeEndMs-op.UnixTimeStartMs))
+		s.add(op.PlanId, op.RepoId, v1.Metric_METRIC_BACKUP_BYTES_ADDED, at, float64(summary.DataAdded))
+	case *v1.Operation_OperationStats:
+		if stats := o.OperationStats.GetStats(); stats != nil {
+			s.add(op.PlanId, op.RepoId, v1.Metric_METRIC_REPO_SIZE_BYTES, at, float64(stats.TotalSize))
+		}
+	}
+}
+
+func (s *Store) add(planId, repoId string, metric v1.Metric, at int64, value float64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := seriesKey{planId, repoId, metric}
+	series, ok := s.series[key]
+	if !ok {
+		series = &v1.MetricSeries{PlanId: planId, RepoId: repoId, Metric: metric}
+		s.series[key] = series
+	}
+
+	// operations are usually recorded in order, backfilled or reindexed operations may not be.
+	idx := sort.Search(len(series.Points), func(i int) bool { return series.Points[i].UnixTimeMs > at })
+	series.Points = slices.Insert(series.Points, idx, &v1.MetricPoint{UnixTimeMs: at, Value: value, Count: 1})
+	if len(series.Points) > maxPoints {
+		series.Points = downsample(series.Points)
+	}
+	s.dirty = true
+}
+
+// Query returns copies of the plan's series of each of its repos, with the points at or after since. All metrics are
+// returned if metrics is empty.
+func (s *Store) Query(planId string, metrics []v1.Metric, since int64) []*v1.MetricSeries {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var result []*v1.MetricSeries
+	for key, series := range s.series {
+		if key.planId != planId || (len(metrics) > 0 && !slices.Contains(metrics, key.metric)) {
+			continue
+		}
+		series = proto.Clone(series).(*v1.MetricSeries)
+		idx := sort.Search(len(series.Points), func(i int) bool { return series.Points[i].UnixTimeMs >= since })
+		series.Points = series.Points[idx:]
+		result = append(result, series)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Metric != result[j].Metric {
+			return result[i].Metric < result[j].Metric
+		}
+		return result[i].RepoId < result[j].RepoId
+	})
+	return result
+}
+
+// RemovePlan removes the plan's series, e.g. once its history is deleted.
+func (s *Store) RemovePlan(planId string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for key := range s.series {
+		if key.planId == planId {
+			delete(s.series, key)
+			s.dirty = true
+		}
+	}
+}
+
+// Save writes the series to the store's file if they changed since the last save.
+func (s *Store) Save() error {
+	s.mu.Lock()
+	if !s.dirty {
+		s.mu.Unlock()
+		return nil
+	}
+	list := &v1.MetricSeriesList{}
+	for _, series := range s.series {
+		list.Series = append(list.Series, series)
+	}
+	data, err := proto.Marshal(list)
+	s.dirty = false
+	s.mu.Unlock()
+	if err != nil {
+		return fmt.Errorf("marshal metrics: %w", err)
+	}
+
+	if err := atomic.WriteFile(s.path, bytes.NewReader(data)); err != nil {
+		s.mu.Lock()
+		s.dirty = true
+		s.mu.Unlock()
+		return fmt.Errorf("save metrics: %w", err)
+	}
+	return nil
+}
+
+// downsample merges adjacent pairs of the older half of the points.
+func downsample(points []*v1.MetricPoint) []*v1.MetricPoint {
+	half := len(points) / 2
+	merged := make([]*v1.MetricPoint, 0, half/2+1+len(points)-half)
+	for i := 0; i+1 < half; i += 2 {
+		merged = append(merged, mergePoints(points[i], points[i+1]))
+	}
+	if half%2 == 1 {
+		merged = append(merged, points[half-1])
+	}
+	return append(merged, points[half:]...)
+}
+
+// mergePoints averages two points weighted by the number of values each averages.
+func mergePoints(a, b *v1.MetricPoint) *v1.MetricPoint {
+	count := a.Count + b.Count
+	ca, cb := float64(max(a.Count, 1)), float64(max(b.Count, 1))
+	return &v1.MetricPoint{
+		UnixTimeMs: int64((float64(a.UnixTimeMs)*ca + float64(b.UnixTimeMs)*cb) / (ca + cb)),
+		Value:      (a.Value*ca + b.Value*cb) / (ca + cb),
+		Count:      count,
+	}
+}
@@ -0,0 +1,127 @@
+package metrics
+
+import (
+	"testing"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/oplog"
+)
+
+func backupOp(planId string, endMs int64, dataAdded int64, status v1.OperationStatus) *v1.Operation {
+	return &v1.Operation{
+		PlanId:          planId,
+		RepoId:          "repo",
+		UnixTimeStartMs: endMs - 1000,
+		UnixTimeEndMs:   endMs,
+		Status:          status,
+		Op: &v1.Operation_OperationBackup{OperationBackup: &v1.OperationBackup{
+			LastStatus: &v1.BackupProgressEntry{Entry: &v1.BackupProgressEntry_Summary{Summary: &v1.BackupProgressSummary{DataAdded: dataAdded}}},
+		}},
+	}
+}
+
+func TestStoreRecordAndQuery(t *testing.T) {
+	t.Parallel()
+
+	s, _, err := NewStore(t.TempDir() + "/metrics.binpb")
+	if err != nil {
+		t.Fatalf("new store: %v", err)
+	}
+	s.Record(backupOp("plan", 3000, 30, v1.OperationStatus_STATUS_SUCCESS))
+	s.Record(backupOp("plan", 1000, 10, v1.OperationStatus_STATUS_SUCCESS))
+	s.Record(backupOp("plan", 2000, 20, v1.OperationStatus_STATUS_ERROR))
+	s.Record(backupOp("other", 2000, 20, v1.OperationStatus_STATUS_SUCCESS))
+	s.Record(&v1.Operation{PlanId: "plan", RepoId: "repo", UnixTimeEndMs: 4000, Status: v1.OperationStatus_STATUS_SUCCESS,
+		Op: &v1.Operation_OperationStats{OperationStats: &v1.OperationStats{Stats: &v1.RepoStats{TotalSize: 500}}}})
+
+	series := s.Query("plan", []v1.Metric{v1.Metric_METRIC_BACKUP_BYTES_ADDED}, 0)
+	if len(series) != 1 || len(series[0].Points) != 2 {
+		t.Fatalf("want the bytes added of the two successful backups, got %v", series)
+	}
+	if p := series[0].Points; p[0].Value != 10 || p[1].Value != 30 {
+		t.Errorf("want points ordered by time, got %v", p)
+	}
+	if got := s.Query("plan", nil, 0); len(got) != 3 || got[2].Metric != v1.Metric_METRIC_REPO_SIZE_BYTES || got[2].Points[0].Value != 500 {
+		t.Errorf("want duration, bytes added and repo size series, got %v", got)
+	}
+	if got := s.Query("plan", []v1.Metric{v1.Metric_METRIC_BACKUP_DURATION_MS}, 2000); len(got) != 1 || len(got[0].Points) != 1 || got[0].Points[0].Value != 1000 {
+		t.Errorf("want only the points since the time, got %v", got)
+	}
+
+	offsite := backupOp("plan", 5000, 50, v1.OperationStatus_STATUS_SUCCESS)
+	offsite.RepoId = "offsite"
+	s.Record(offsite)
+	if got := s.Query("plan", []v1.Metric{v1.Metric_METRIC_BACKUP_BYTES_ADDED}, 0); len(got) != 2 || got[0].RepoId != "offsite" || len(got[0].Points) != 1 || got[1].RepoId != "repo" {
+		t.Errorf("want a series per repo, got %v", got)
+	}
+}
+
+func TestStoreDownsample(t *testing.T) {
+	t.Parallel()
+
+	s, _, err := NewStore(t.TempDir() + "/metrics.binpb")
+	if err != nil {
+		t.Fatalf("new store: %v", err)
+	}
+	n := 5 * maxPoints
+	for i := 1; i <= n; i++ {
+		s.Record(backupOp("plan", int64(i)*1000, 100, v1.OperationStatus_STATUS_SUCCESS))
+	}
+	series := s.Query("plan", []v1.Metric{v1.Metric_METRIC_BACKUP_BYTES_ADDED}, 0)[0]
+	if len(series.Points) > maxPoints {
+		t.Errorf("want at most %d points, got %d", maxPoints, len(series.Points))
+	}
+	var count int64
+	for _, p := range series.Points {
+		count += p.Count
+		if p.Value != 100 {
+			t.Fatalf("want merged points averaged, got %v", p)
+		}
+	}
+	if count != int64(n) {
+		t.Errorf("want the points to account for all %d values, got %d", n, count)
+	}
+	if last := series.Points[len(series.Points)-1]; last.Count != 1 || last.UnixTimeMs != int64(n)*1000 {
+		t.Errorf("want the most recent point kept at full resolution, got %v", last)
+	}
+}
+
+func TestStoreSaveAndBackfill(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	log, err := oplog.NewOpLog(dir + "/oplog.boltdb")
+	if err != nil {
+		t.Fatalf("error creating oplog: %s", err)
+	}
+	t.Cleanup(func() { log.Close() })
+	for i := int64(1); i <= 3; i++ {
+		if err := log.Add(backupOp("plan", i*1000, i, v1.OperationStatus_STATUS_SUCCESS)); err != nil {
+			t.Fatalf("add operation: %v", err)
+		}
+	}
+
+	s, loaded, err := NewStore(dir + "/metrics.binpb")
+	if err != nil || loaded {
+		t.Fatalf("want a new store, got loaded %v, err %v", loaded, err)
+	}
+	if err := s.Backfill(log, []string{"plan"}); err != nil {
+		t.Fatalf("backfill: %v", err)
+	}
+	if err := s.Save(); err != nil {
+		t.Fatalf("save: %v", err)
+	}
+
+	s, loaded, err = NewStore(dir + "/metrics.binpb")
+	if err != nil || !loaded {
+		t.Fatalf("want the saved store loaded, got loaded %v, err %v", loaded, err)
+	}
+	if got := s.Query("plan", []v1.Metric{v1.Metric_METRIC_BACKUP_BYTES_ADDED}, 0); len(got) != 1 || len(got[0].Points) != 3 {
+		t.Errorf("want the backfilled points loaded, got %v", got)
+	}
+
+	s.RemovePlan("plan")
+	if got := s.Query("plan", nil, 0); len(got) != 0 {
+		t.Errorf("want the plan's series removed, got %v", got)
+	}
+}
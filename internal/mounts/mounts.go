@@ -0,0 +1,147 @@
+// Package mounts reads the mount table to find the file systems mounted beneath backup paths.
+package mounts
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+var ErrUnsupported = errors.New("listing mounts is not supported on " + runtime.GOOS)
+
+// networkFSTypes are file system types backed by a remote server.
+var networkFSTypes = map[string]bool{
+	"9p":          true,
+	"afs":         true,
+	"ceph":        true,
+	"cifs":        true,
+	"davfs":       true,
+	"fuse.rclone": true,
+	"fuse.sshfs":  true,
+	"glusterfs":   true,
+	"lustre":      true,
+	"ncpfs":       true,
+	"nfs":         true,
+	"nfs4":        true,
+	"smb3":        true,
+	"smbfs":       true,
+	"sshfs":       true,
+}
+
+// Mount is an entry of the mount table.
+type Mount struct {
+	Point  string // where the file system is mounted.
+	Root   string // directory of the file system mounted at Point, "/" unless it is a bind mount of a subdirectory.
+	FSType string
+	Source string
+}
+
+// IsNetwork reports whether the file system is backed by a remote server.
+func (m Mount) IsNetwork() bool {
+	return networkFSTypes[m.FSType]
+}
+
+// IsBind reports whether the mount is a bind mount of a directory within another file system.
+func (m Mount) IsBind() bool {
+	return m.Root != "/"
+}
+
+// List returns the mounts of the current process.
+func List() ([]Mount, error) {
+	if runtime.GOOS != "linux" {
+		return nil, ErrUnsupported
+	}
+	f, err := os.Open("/proc/self/mountinfo")
+	if err != nil {
+		return nil, fmt.Errorf("open mount table: %w", err)
+	}
+	defer f.Close()
+	return parseMountInfo(f)
+}
+
+// parseMountInfo parses the format of /proc/<pid>/mountinfo, see proc(5).
+func parseMountInfo(r io.Reader) ([]Mount, error) {
+	var mounts []Mount
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		sep := -1
+		for i := 6; i < len(fields); i++ {
+			if fields[i] == "-" {
+				sep = i
+				break
+			}
+		}
+		if len(fields) < 5 || sep < 0 || sep+2 >= len(fields) {
+			return nil, fmt.Errorf("malformed mountinfo line %q", scanner.Text())
+		}
+		mounts = append(mounts, Mount{
+			Root:   unescape(fields[3]),
+			Point:  unescape(fields[4]),
+			FSType: fields[sep+1],
+			Source: unescape(fields[sep+2]),
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read mount table: %w", err)
+	}
+	return mounts, nil
+}
+
+// unescape decodes the octal escapes (e.g. \040 for a space) the kernel uses in mount table paths.
+func unescape(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+4 <= len(s) {
+			if n, err := strconv.ParseUint(s[i+1:i+4], 8, 8); err == nil {
+				sb.WriteByte(byte(n))
+				i += 3
+				continue
+			}
+		}
+		sb.WriteByte(s[i])
+	}
+	return sb.String()
+}
+
+// Beneath returns the mounts strictly beneath dir.
+func Beneath(mounts []Mount, dir string) []Mount {
+	dir = filepath.Clean(dir)
+	var beneath []Mount
+	for _, m := range mounts {
+		if m.Point != dir && isWithin(dir, m.Point) {
+			beneath = append(beneath, m)
+		}
+	}
+	return beneath
+}
+
+// Containing returns the mount that p is on, the last mounted of the mounts that contain it.
+func Containing(mounts []Mount, p string) (Mount, bool) {
+	p = filepath.Clean(p)
+	var found Mount
+	var ok bool
+	for _, m := range mounts {
+		if isWithin(m.Point, p) && (!ok || len(m.Point) >= len(found.Point)) {
+			found, ok = m, true
+		}
+	}
+	return found, ok
+}
+
+// isWithin reports whether p is dir or beneath it.
+func isWithin(dir, p string) bool {
+	if dir == "/" {
+		return strings.HasPrefix(p, "/")
+	}
+	return p == dir || strings.HasPrefix(p, dir+"/")
+}
@@ -0,0 +1,52 @@
+package mounts
+
+import (
+	"strings"
+	"testing"
+)
+
+const testMountInfo = `22 1 8:1 / / rw,relatime shared:1 - ext4 /dev/sda1 rw
+35 22 0:31 / /mnt/my\040share rw,relatime shared:12 - cifs //server/share rw,vers=3.0
+36 22 8:1 /srv/data /data rw,relatime - ext4 /dev/sda1 rw
+`
+
+func TestParseMountInfo(t *testing.T) {
+	mounts, err := parseMountInfo(strings.NewReader(testMountInfo))
+	if err != nil {
+		t.Fatalf("parseMountInfo() error: %v", err)
+	}
+	if len(mounts) != 3 {
+		t.Fatalf("want 3 mounts, got %v", mounts)
+	}
+	if m := mounts[1]; m.Point != "/mnt/my share" || m.FSType != "cifs" || m.Source != "//server/share" || !m.IsNetwork() || m.IsBind() {
+		t.Errorf("unexpected network mount: %+v", m)
+	}
+	if m := mounts[2]; m.Point != "/data" || m.Root != "/srv/data" || !m.IsBind() || m.IsNetwork() {
+		t.Errorf("unexpected bind mount: %+v", m)
+	}
+
+	if _, err := parseMountInfo(strings.NewReader("22 1 8:1 / /\n")); err == nil {
+		t.Errorf("want an error for a malformed line")
+	}
+}
+
+func TestBeneathAndContaining(t *testing.T) {
+	mounts, _ := parseMountInfo(strings.NewReader(testMountInfo))
+
+	if beneath := Beneath(mounts, "/mnt"); len(beneath) != 1 || beneath[0].Point != "/mnt/my share" {
+		t.Errorf("want the share beneath /mnt, got %v", beneath)
+	}
+	if beneath := Beneath(mounts, "/data"); len(beneath) != 0 {
+		t.Errorf("want no mounts strictly beneath /data, got %v", beneath)
+	}
+	if beneath := Beneath(mounts, "/"); len(beneath) != 2 {
+		t.Errorf("want every other mount beneath /, got %v", beneath)
+	}
+
+	if m, ok := Containing(mounts, "/mnt/my share/docs"); !ok || m.FSType != "cifs" {
+		t.Errorf("want the share to contain its files, got %+v", m)
+	}
+	if m, ok := Containing(mounts, "/database"); !ok || m.Point != "/" {
+		t.Errorf("want /database on the root file system, got %+v", m)
+	}
+}
@@ -0,0 +1,194 @@
+package mqtt
+
+import (
+	"bufio"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"time"
+)
+
+// packet types from the MQTT 3.1.1 specification.
+const (
+	packetConnect    = 1
+	packetConnack    = 2
+	packetPublish    = 3
+	packetPingreq    = 12
+	packetPingresp   = 13
+	packetDisconnect = 14
+)
+
+// KeepAlive is the keep alive interval sent to the broker, the connection must see a packet at least this often.
+const KeepAlive = 60 * time.Second
+
+var ErrConnectionRefused = errors.New("connection refused by broker")
+
+// Client is a minimal MQTT 3.1.1 client supporting QoS 0 publishes, which is all that is needed to report status.
+type Client struct {
+	conn    net.Conn
+	w       *bufio.Writer
+	timeout time.Duration // for each write, a broker that stops reading fails the write instead of blocking it.
+	done    chan struct{}
+}
+
+type ClientOptions struct {
+	ClientId string
+	Username string
+	Password string
+	Timeout  time.Duration
+}
+
+// Dial connects to the broker at the given URI e.g. tcp://localhost:1883 or ssl://localhost:8883 and performs the CONNECT handshake.
+func Dial(broker string, opts ClientOptions) (*Client, error) {
+	u, err := url.Parse(broker)
+	if err != nil {
+		return nil, fmt.Errorf("parse broker uri %q: %w", broker, err)
+	}
+	if opts.Timeout == 0 {
+		opts.Timeout = 10 * time.Second
+	}
+
+	var conn net.Conn
+	dialer := &net.Dialer{Timeout: opts.Timeout}
+	switch u.Scheme {
+	case "tcp", "mqtt":
+		conn, err = dialer.Dial("tcp", hostWithDefaultPort(u, "1883"))
+	case "ssl", "tls", "mqtts":
+		conn, err = tls.DialWithDialer(dialer, "tcp", hostWithDefaultPort(u, "8883"), &tls.Config{ServerName: u.Hostname()})
+	default:
+		return nil, fmt.Errorf("unsupported broker scheme %q", u.Scheme)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("dial broker %v: %w", u.Host, err)
+	}
+
+	c := &Client{conn: conn, w: bufio.NewWriter(conn), timeout: opts.Timeout, done: make(chan struct{})}
+	conn.SetDeadline(time.Now().Add(opts.Timeout))
+	if err := c.connect(opts); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	conn.SetDeadline(time.Time{})
+	// nothing is subscribed to, the broker only sends ping responses which are discarded.
+	go func() {
+		io.Copy(io.Discard, conn)
+		close(c.done)
+	}()
+	return c, nil
+}
+
+// Done is closed once the connection is closed, by either side.
+func (c *Client) Done() <-chan struct{} {
+	return c.done
+}
+
+func hostWithDefaultPort(u *url.URL, port string) string {
+	if u.Port() != "" {
+		return u.Host
+	}
+	return net.JoinHostPort(u.Hostname(), port)
+}
+
+func (c *Client) connect(opts ClientOptions) error {
+	var body []byte
+	body = appendString(body, "MQTT")
+	body = append(body, 4) // protocol level 3.1.1
+
+	flags := byte(0x02) // clean session
+	if opts.Username != "" {
+		flags |= 0x80
+		if opts.Password != "" {
+			flags |= 0x40
+		}
+	}
+	body = append(body, flags)
+	keepAlive := int(KeepAlive / time.Second)
+	body = append(body, byte(keepAlive>>8), byte(keepAlive))
+
+	body = appendString(body, opts.ClientId)
+	if opts.Username != "" {
+		body = appendString(body, opts.Username)
+		if opts.Password != "" {
+			body = appendString(body, opts.Password)
+		}
+	}
+
+	if err := c.writePacket(packetConnect<<4, body); err != nil {
+		return fmt.Errorf("send connect: %w", err)
+	}
+
+	header := make([]byte, 4)
+	if _, err := io.ReadFull(c.conn, header); err != nil {
+		return fmt.Errorf("read connack: %w", err)
+	}
+	if header[0]>>4 != packetConnack || header[1] != 2 {
+		return fmt.Errorf("unexpected response to connect: %x", header)
+	}
+	if header[3] != 0 {
+		return fmt.Errorf("%w: return code %d", ErrConnectionRefused, header[3])
+	}
+	return nil
+}
+
+// Publish sends a QoS 0 message. Retained messages are kept by the broker and delivered to new subscribers.
+func (c *Client) Publish(topic string, payload []byte, retain bool) error {
+	header := byte(packetPublish << 4)
+	if retain {
+		header |= 0x01
+	}
+	body := appendString(nil, topic)
+	body = append(body, payload...)
+	if err := c.writePacket(header, body); err != nil {
+		return fmt.Errorf("publish %v: %w", topic, err)
+	}
+	return nil
+}
+
+// Ping sends a PINGREQ such that the broker keeps an idle connection open.
+func (c *Client) Ping() error {
+	if err := c.writePacket(packetPingreq<<4, nil); err != nil {
+		return fmt.Errorf("ping: %w", err)
+	}
+	return nil
+}
+
+// Close sends a DISCONNECT and closes the underlying connection.
+func (c *Client) Close() error {
+	err := c.writePacket(packetDisconnect<<4, nil)
+	if e := c.conn.Close(); err == nil {
+		err = e
+	}
+	return err
+}
+
+func (c *Client) writePacket(header byte, body []byte) error {
+	c.conn.SetWriteDeadline(time.Now().Add(c.timeout))
+	c.w.WriteByte(header)
+	c.w.Write(encodeLength(len(body)))
+	c.w.Write(body)
+	return c.w.Flush()
+}
+
+func appendString(b []byte, s string) []byte {
+	b = append(b, byte(len(s)>>8), byte(len(s)))
+	return append(b, s...)
+}
+
+// encodeLength encodes the remaining length of a packet using the variable length encoding from the spec.
+func encodeLength(n int) []byte {
+	var b []byte
+	for {
+		digit := byte(n % 128)
+		n /= 128
+		if n > 0 {
+			digit |= 0x80
+		}
+		b = append(b, digit)
+		if n == 0 {
+			return b
+		}
+	}
+}
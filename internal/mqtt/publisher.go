@@ -0,0 +1,459 @@
+package mqtt
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/config"
+	"github.com/garethgeorge/backrest/internal/oplog"
+	"github.com/garethgeorge/backrest/internal/oplog/indexutil"
+	"github.com/garethgeorge/backrest/internal/orchestrator"
+	"go.uber.org/zap"
+)
+
+// retryInterval is how long to wait before publishing again after a failure to reach the broker.
+var retryInterval = 30 * time.Second
+
+var objectIdRegex = regexp.MustCompile(`[^a-zA-Z0-9_-]`)
+
+// Status is the JSON payload published to the state topic of a plan or repo.
+type Status struct {
+	Id                   string `json:"id"`
+	LastBackupTime       string `json:"last_backup_time,omitempty"`
+	LastBackupStatus     string `json:"last_backup_status,omitempty"`
+	LastBackupBytesAdded int64  `json:"last_backup_bytes_added"`
+	LastOperation        string `json:"last_operation,omitempty"`
+	LastOperationTime    string `json:"last_operation_time,omitempty"`
+	LastOperationStatus  string `json:"last_operation_status,omitempty"`
+	Problem              string `json:"problem"` // ON if the last operation failed and wasn't acknowledged, for plans only their last backup counts. Used by the Home Assistant binary sensor.
+
+	problemOperationId int64 // operation the problem state was taken from.
+}
+
+// Publisher tracks the status of plans and repos from the oplog and publishes it to an MQTT broker on every operation completion.
+type Publisher struct {
+	config config.ConfigStore
+	oplog  *oplog.OpLog
+
+	mu         sync.Mutex
+	planStatus map[string]*Status
+	repoStatus map[string]*Status
+	dirty      bool
+	notify     chan struct{}
+
+	// owned by Run, the connection is kept open and only topics whose payload changed are published.
+	client    *Client
+	broker    string // broker and options the client connected with, changing them in the config reconnects.
+	dialed    ClientOptions
+	published map[string][]byte
+}
+
+func NewPublisher(config config.ConfigStore, oplog *oplog.OpLog) *Publisher {
+	return &Publisher{
+		config:     config,
+		oplog:      oplog,
+		planStatus: make(map[string]*Status),
+		repoStatus: make(map[string]*Status),
+		notify:     make(chan struct{}, 1),
+	}
+}
+
+// Run publishes status updates until the context is cancelled. Status is seeded from the recent history in the oplog.
+func (p *Publisher) Run(ctx context.Context) {
+	cfg, err := p.config.Get()
+	if err != nil {
+		zap.S().Errorf("mqtt publisher: get config: %v", err)
+		return
+	}
+	p.seed(cfg)
+
+	callback := func(old *v1.Operation, new *v1.Operation) {
+		if new == nil || !isComplete(new) {
+			return
+		}
+		if old != nil && isComplete(old) {
+			if (old.Acknowledgement == nil) != (new.Acknowledgement == nil) {
+				p.recordAcknowledgement(new)
+			}
+			return
+		}
+		p.recordOperation(new)
+	}
+	p.oplog.Subscribe(&callback)
+	defer p.oplog.Unsubscribe(&callback)
+
+	defer p.disconnect()
+
+	keepAlive := time.NewTicker(KeepAlive / 2)
+	defer keepAlive.Stop()
+
+	p.markDirty()
+	for {
+		var lost <-chan struct{}
+		if p.client != nil {
+			lost = p.client.Done()
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-lost:
+			// messages sent just before the broker closed the connection may be lost, all topics are published again.
+			zap.S().Warnf("mqtt publisher: connection to broker %v lost", p.broker)
+			p.disconnect()
+			p.markDirty()
+			continue
+		case <-keepAlive.C:
+			if p.client != nil {
+				if err := p.client.Ping(); err != nil {
+					zap.S().Warnf("mqtt publisher: %v", err)
+					p.disconnect()
+					p.markDirty()
+				}
+			}
+			continue
+		case <-p.notify:
+		}
+
+		if err := p.publish(); err != nil {
+			zap.S().Warnf("mqtt publisher: %v", err)
+			p.mu.Lock()
+			p.dirty = true
+			p.mu.Unlock()
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(retryInterval):
+			}
+			p.markDirty()
+		}
+	}
+}
+
+// seedOperations is how many recent operations of a plan in each of its repos the status is seeded from.
+const seedOperations = 100
+
+func (p *Publisher) seed(cfg *v1.Config) {
+	for _, plan := range cfg.Plans {
+		ops, err := recentOperations(p.oplog, plan, seedOperations)
+		if err != nil {
+			zap.S().Warnf("mqtt publisher: read history for plan %q: %v", plan.Id, err)
+			continue
+		}
+		for _, op := range ops {
+			if isComplete(op) {
+				p.recordOperation(op)
+			}
+		}
+	}
+}
+
+// recentOperations returns the last n operations of the plan in each repo, oldest first, such that the history of a
+// plan's additional repos isn't pushed out by its backups to the others.
+func recentOperations(log *oplog.OpLog, plan *v1.Plan, n int) ([]*v1.Operation, error) {
+	repos := orchestrator.PlanRepos(plan)
+	counts := make(map[string]int)
+	var ops []*v1.Operation
+	if err := log.ForEachByPlan(plan.Id, indexutil.Reversed(indexutil.CollectAll()), func(op *v1.Operation) error {
+		if counts[op.RepoId] >= n {
+			return nil
+		}
+		counts[op.RepoId]++
+		ops = append(ops, op)
+		if !slices.ContainsFunc(repos, func(repoId string) bool { return counts[repoId] < n }) {
+			return oplog.ErrStopIteration
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	slices.Reverse(ops)
+	return ops, nil
+}
+
+func (p *Publisher) recordOperation(op *v1.Operation) {
+	name := operationName(op)
+	if name == "" {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if op.PlanId != "" {
+		_, isBackup := op.Op.(*v1.Operation_OperationBackup)
+		updateStatus(p.statusFor(p.planStatus, op.PlanId), op, name, isBackup)
+	}
+	if op.RepoId != "" {
+		updateStatus(p.statusFor(p.repoStatus, op.RepoId), op, name, true)
+	}
+	p.dirty = true
+	select {
+	case p.notify <- struct{}{}:
+	default:
+	}
+}
+
+// recordAcknowledgement updates the problem state of the operation's plan and repo if it was taken from the operation.
+func (p *Publisher) recordAcknowledgement(op *v1.Operation) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, s := range []*Status{p.planStatus[op.PlanId], p.repoStatus[op.RepoId]} {
+		if s != nil && s.problemOperationId == op.Id {
+			s.Problem = problemState(op)
+			p.dirty = true
+		}
+	}
+	select {
+	case p.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (p *Publisher) markDirty() {
+	p.mu.Lock()
+	p.dirty = true
+	p.mu.Unlock()
+	select {
+	case p.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (p *Publisher) statusFor(m map[string]*Status, id string) *Status {
+	s, ok := m[id]
+	if !ok {
+		s = &Status{Id: id, Problem: "OFF"}
+		m[id] = s
+	}
+	return s
+}
+
+// updateStatus records the completed operation, its failure is a problem only if problem is set.
+func updateStatus(s *Status, op *v1.Operation, name string, problem bool) {
+	status := statusName(op.Status)
+	endTime := time.UnixMilli(op.UnixTimeEndMs).Format(time.RFC3339)
+	s.LastOperation = name
+	s.LastOperationTime = endTime
+	s.LastOperationStatus = status
+	if problem {
+		s.Problem = problemState(op)
+		s.problemOperationId = op.Id
+	}
+
+	if backup, ok := op.Op.(*v1.Operation_OperationBackup); ok {
+		s.LastBackupTime = endTime
+		s.LastBackupStatus = status
+		s.LastBackupBytesAdded = backup.OperationBackup.GetLastStatus().GetSummary().GetDataAdded()
+	}
+}
+
+// problemState is ON if the operation failed and no user acknowledged the failure.
+func problemState(op *v1.Operation) string {
+	if op.Status == v1.OperationStatus_STATUS_ERROR && op.Acknowledgement == nil {
+		return "ON"
+	}
+	return "OFF"
+}
+
+func (p *Publisher) publish() error {
+	cfg, err := p.config.Get()
+	if err != nil {
+		return fmt.Errorf("get config: %w", err)
+	}
+	mqttCfg := cfg.GetMqtt()
+	if mqttCfg.GetBroker() == "" {
+		p.disconnect()
+		return nil
+	}
+
+	p.mu.Lock()
+	if !p.dirty {
+		p.mu.Unlock()
+		return nil
+	}
+	p.dirty = false
+	messages := make(map[string][]byte)
+	prefix := stringOrDefault(mqttCfg.TopicPrefix, "backrest")
+	for _, plan := range cfg.Plans {
+		s := *p.statusFor(p.planStatus, plan.Id)
+		b, _ := json.Marshal(s)
+		messages[stateTopic(prefix, "plan", plan.Id)] = b
+	}
+	for _, repo := range cfg.Repos {
+		s := *p.statusFor(p.repoStatus, repo.Id)
+		b, _ := json.Marshal(s)
+		messages[stateTopic(prefix, "repo", repo.Id)] = b
+	}
+	p.mu.Unlock()
+
+	host := hostname(cfg)
+	if !mqttCfg.DisableDiscovery {
+		discoveryPrefix := stringOrDefault(mqttCfg.DiscoveryPrefix, "homeassistant")
+		for _, plan := range cfg.Plans {
+			for topic, msg := range discoveryMessages(discoveryPrefix, prefix, host, "plan", plan.Id) {
+				messages[topic] = msg
+			}
+		}
+		for _, repo := range cfg.Repos {
+			for topic, msg := range discoveryMessages(discoveryPrefix, prefix, host, "repo", repo.Id) {
+				messages[topic] = msg
+			}
+		}
+	}
+
+	if err := p.connect(mqttCfg.Broker, ClientOptions{
+		ClientId: stringOrDefault(mqttCfg.ClientId, "backrest-"+host),
+		Username: mqttCfg.Username,
+		Password: mqttCfg.Password,
+	}); err != nil {
+		return fmt.Errorf("connect: %w", err)
+	}
+	for topic, msg := range messages {
+		if bytes.Equal(p.published[topic], msg) {
+			continue
+		}
+		if err := p.client.Publish(topic, msg, true); err != nil {
+			p.disconnect()
+			return err
+		}
+		p.published[topic] = msg
+	}
+	return nil
+}
+
+// connect reuses the open connection unless the broker or credentials changed.
+func (p *Publisher) connect(broker string, opts ClientOptions) error {
+	if p.client != nil && p.broker == broker && p.dialed == opts {
+		return nil
+	}
+	p.disconnect()
+	client, err := Dial(broker, opts)
+	if err != nil {
+		return err
+	}
+	p.client, p.broker, p.dialed = client, broker, opts
+	p.published = make(map[string][]byte)
+	return nil
+}
+
+func (p *Publisher) disconnect() {
+	if p.client == nil {
+		return
+	}
+	p.client.Close()
+	p.client = nil
+	p.published = nil
+}
+
+func stateTopic(prefix, kind, id string) string {
+	return fmt.Sprintf("%v/%v/%v/state", prefix, kind, id)
+}
+
+type discoveryDevice struct {
+	Identifiers  []string `json:"identifiers"`
+	Name         string   `json:"name"`
+	Manufacturer string   `json:"manufacturer"`
+}
+
+type discoveryConfig struct {
+	Name              string          `json:"name"`
+	UniqueId          string          `json:"unique_id"`
+	StateTopic        string          `json:"state_topic"`
+	ValueTemplate     string          `json:"value_template"`
+	DeviceClass       string          `json:"device_class,omitempty"`
+	UnitOfMeasurement string          `json:"unit_of_measurement,omitempty"`
+	Device            discoveryDevice `json:"device"`
+}
+
+// discoveryMessages returns the Home Assistant discovery topics and payloads for the sensors of a plan or repo.
+func discoveryMessages(discoveryPrefix, prefix, host, kind, id string) map[string][]byte {
+	objectId := objectIdRegex.ReplaceAllString(fmt.Sprintf("backrest_%v_%v_%v", host, kind, id), "_")
+	device := discoveryDevice{
+		Identifiers:  []string{objectId},
+		Name:         fmt.Sprintf("Backrest %v %v", kind, id),
+		Manufacturer: "Backrest",
+	}
+
+	sensors := []struct {
+		component string
+		field     string
+		name      string
+		config    discoveryConfig
+	}{
+		{"sensor", "last_backup_time", "Last backup", discoveryConfig{DeviceClass: "timestamp"}},
+		{"sensor", "last_backup_status", "Last backup status", discoveryConfig{}},
+		{"sensor", "last_backup_bytes_added", "Last backup bytes added", discoveryConfig{DeviceClass: "data_size", UnitOfMeasurement: "B"}},
+		{"sensor", "last_operation_status", "Last operation status", discoveryConfig{}},
+		{"binary_sensor", "problem", "Problem", discoveryConfig{DeviceClass: "problem"}},
+	}
+
+	messages := make(map[string][]byte)
+	for _, sensor := range sensors {
+		c := sensor.config
+		c.Name = sensor.name
+		c.UniqueId = objectId + "_" + sensor.field
+		c.StateTopic = stateTopic(prefix, kind, id)
+		c.ValueTemplate = fmt.Sprintf("{{ value_json.%v }}", sensor.field)
+		c.Device = device
+		b, _ := json.Marshal(c)
+		messages[fmt.Sprintf("%v/%v/%v/%v/config", discoveryPrefix, sensor.component, objectId, sensor.field)] = b
+	}
+	return messages
+}
+
+func isComplete(op *v1.Operation) bool {
+	switch op.Status {
+	case v1.OperationStatus_STATUS_SUCCESS, v1.OperationStatus_STATUS_WARNING, v1.OperationStatus_STATUS_ERROR, v1.OperationStatus_STATUS_SYSTEM_CANCELLED, v1.OperationStatus_STATUS_USER_CANCELLED:
+		return true
+	default:
+		return false
+	}
+}
+
+// operationName returns a short name for the operation or "" if the operation should not be reported.
+func operationName(op *v1.Operation) string {
+	switch op.Op.(type) {
+	case *v1.Operation_OperationBackup:
+		return "backup"
+	case *v1.Operation_OperationForget:
+		return "forget"
+	case *v1.Operation_OperationPrune:
+		return "prune"
+	case *v1.Operation_OperationRestore:
+		return "restore"
+	case *v1.Operation_OperationStats:
+		return "stats"
+	default:
+		return ""
+	}
+}
+
+func statusName(status v1.OperationStatus) string {
+	return strings.ToLower(strings.TrimPrefix(status.String(), "STATUS_"))
+}
+
+func hostname(cfg *v1.Config) string {
+	if cfg.Host != "" {
+		return cfg.Host
+	}
+	host, err := os.Hostname()
+	if err != nil {
+		return "unknown"
+	}
+	return host
+}
+
+func stringOrDefault(s, def string) string {
+	if s == "" {
+		return def
+	}
+	return s
+}
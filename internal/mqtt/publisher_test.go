@@ -0,0 +1,335 @@
+package mqtt
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/config"
+	"github.com/garethgeorge/backrest/internal/oplog"
+)
+
+func TestPublishOnOperationComplete(t *testing.T) {
+	broker := newFakeBroker(t)
+
+	log, err := oplog.NewOpLog(t.TempDir() + "/oplog.boltdb")
+	if err != nil {
+		t.Fatalf("failed to create oplog: %v", err)
+	}
+	t.Cleanup(func() { log.Close() })
+
+	store := &config.MemoryStore{
+		Config: &v1.Config{
+			Host: "testhost",
+			Repos: []*v1.Repo{
+				{Id: "repo1"},
+			},
+			Plans: []*v1.Plan{
+				{Id: "plan1", Repo: "repo1"},
+			},
+			Mqtt: &v1.Mqtt{
+				Broker:   "tcp://" + broker.addr,
+				Username: "user",
+				Password: "pass",
+			},
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go NewPublisher(store, log).Run(ctx)
+
+	if err := waitFor(func() bool {
+		_, ok := broker.message("homeassistant/sensor/backrest_testhost_plan_plan1/last_backup_time/config")
+		return ok
+	}); err != nil {
+		t.Fatalf("discovery topic was not published")
+	}
+
+	op := &v1.Operation{
+		RepoId:          "repo1",
+		PlanId:          "plan1",
+		Status:          v1.OperationStatus_STATUS_INPROGRESS,
+		UnixTimeStartMs: 1000,
+		Op: &v1.Operation_OperationBackup{
+			OperationBackup: &v1.OperationBackup{},
+		},
+	}
+	if err := log.Add(op); err != nil {
+		t.Fatalf("failed to add operation: %v", err)
+	}
+	op.Status = v1.OperationStatus_STATUS_SUCCESS
+	op.UnixTimeEndMs = 2000
+	op.GetOperationBackup().LastStatus = &v1.BackupProgressEntry{
+		Entry: &v1.BackupProgressEntry_Summary{
+			Summary: &v1.BackupProgressSummary{DataAdded: 1234},
+		},
+	}
+	if err := log.Update(op); err != nil {
+		t.Fatalf("failed to update operation: %v", err)
+	}
+
+	var status Status
+	if err := waitFor(func() bool {
+		msg, ok := broker.message("backrest/plan/plan1/state")
+		if !ok {
+			return false
+		}
+		if err := json.Unmarshal(msg, &status); err != nil {
+			t.Fatalf("failed to unmarshal status: %v", err)
+		}
+		return status.LastBackupStatus == "success"
+	}); err != nil {
+		t.Fatalf("plan state was not published, last status: %+v", status)
+	}
+	if status.LastBackupBytesAdded != 1234 {
+		t.Errorf("want bytes added 1234, got %v", status.LastBackupBytesAdded)
+	}
+	if status.Problem != "OFF" {
+		t.Errorf("want problem OFF, got %v", status.Problem)
+	}
+	if _, ok := broker.message("backrest/repo/repo1/state"); !ok {
+		t.Errorf("repo state was not published")
+	}
+
+	failed := &v1.Operation{
+		RepoId:          "repo1",
+		PlanId:          "plan1",
+		Status:          v1.OperationStatus_STATUS_ERROR,
+		UnixTimeStartMs: 3000,
+		UnixTimeEndMs:   4000,
+		Op:              &v1.Operation_OperationBackup{OperationBackup: &v1.OperationBackup{}},
+	}
+	if err := log.Add(failed); err != nil {
+		t.Fatalf("failed to add operation: %v", err)
+	}
+	planStatus := func() Status {
+		msg, _ := broker.message("backrest/plan/plan1/state")
+		var status Status
+		json.Unmarshal(msg, &status)
+		return status
+	}
+	problem := func() string { return planStatus().Problem }
+	if err := waitFor(func() bool { return problem() == "ON" }); err != nil {
+		t.Fatalf("want problem ON after a failure, got %v", problem())
+	}
+
+	// only backups decide the problem state of a plan, a forget succeeding doesn't clear it.
+	if err := log.Add(&v1.Operation{
+		RepoId:          "repo1",
+		PlanId:          "plan1",
+		Status:          v1.OperationStatus_STATUS_SUCCESS,
+		UnixTimeStartMs: 5000,
+		UnixTimeEndMs:   6000,
+		Op:              &v1.Operation_OperationForget{OperationForget: &v1.OperationForget{}},
+	}); err != nil {
+		t.Fatalf("failed to add operation: %v", err)
+	}
+	if err := waitFor(func() bool { return planStatus().LastOperation == "forget" }); err != nil {
+		t.Fatalf("forget was not published, last status: %+v", planStatus())
+	}
+	if problem() != "ON" {
+		t.Errorf("want problem to stay ON after a forget, got %v", problem())
+	}
+	failed.Acknowledgement = &v1.OperationAcknowledgement{Note: "disk replaced"}
+	if err := log.Update(failed); err != nil {
+		t.Fatalf("failed to update operation: %v", err)
+	}
+	if err := waitFor(func() bool { return problem() == "OFF" }); err != nil {
+		t.Fatalf("want problem OFF once the failure is acknowledged, got %v", problem())
+	}
+
+	// the connection is reused and unchanged topics aren't published again.
+	if n := broker.connectionCount(); n != 1 {
+		t.Errorf("want one connection to the broker, got %d", n)
+	}
+	if n := broker.publishCount("homeassistant/sensor/backrest_testhost_plan_plan1/last_backup_time/config"); n != 1 {
+		t.Errorf("want the discovery topic published once, got %d", n)
+	}
+
+	// a lost connection is reopened and every topic published again.
+	broker.drop()
+	if err := waitFor(func() bool {
+		return broker.publishCount("homeassistant/sensor/backrest_testhost_plan_plan1/last_backup_time/config") == 2
+	}); err != nil {
+		t.Fatalf("want the topics published again after the connection was lost, got %d connections", broker.connectionCount())
+	}
+}
+
+func TestRecentOperations(t *testing.T) {
+	log, err := oplog.NewOpLog(t.TempDir() + "/oplog.boltdb")
+	if err != nil {
+		t.Fatalf("failed to create oplog: %v", err)
+	}
+	t.Cleanup(func() { log.Close() })
+
+	add := func(repoId string) {
+		if err := log.Add(&v1.Operation{
+			RepoId:          repoId,
+			PlanId:          "plan1",
+			Status:          v1.OperationStatus_STATUS_SUCCESS,
+			UnixTimeStartMs: 1000,
+			Op:              &v1.Operation_OperationBackup{OperationBackup: &v1.OperationBackup{}},
+		}); err != nil {
+			t.Fatalf("failed to add operation: %v", err)
+		}
+	}
+	add("offsite")
+	for i := 0; i < 5; i++ {
+		add("local")
+	}
+
+	ops, err := recentOperations(log, &v1.Plan{Id: "plan1", Repo: "local", AdditionalRepos: []string{"offsite"}}, 2)
+	if err != nil {
+		t.Fatalf("recentOperations() error: %v", err)
+	}
+	var repos []string
+	for _, op := range ops {
+		repos = append(repos, op.RepoId)
+	}
+	if want := []string{"offsite", "local", "local"}; !slices.Equal(repos, want) {
+		t.Errorf("want operations in repos %v, got %v", want, repos)
+	}
+}
+
+func TestEncodeLength(t *testing.T) {
+	tests := []struct {
+		n    int
+		want []byte
+	}{
+		{0, []byte{0}},
+		{127, []byte{0x7f}},
+		{128, []byte{0x80, 0x01}},
+		{16383, []byte{0xff, 0x7f}},
+		{16384, []byte{0x80, 0x80, 0x01}},
+	}
+	for _, tc := range tests {
+		got := encodeLength(tc.n)
+		if string(got) != string(tc.want) {
+			t.Errorf("encodeLength(%d) = %x, want %x", tc.n, got, tc.want)
+		}
+	}
+}
+
+func waitFor(f func() bool) error {
+	for i := 0; i < 100; i++ {
+		if f() {
+			return nil
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+	return context.DeadlineExceeded
+}
+
+// fakeBroker accepts connections and records the last message published to each topic.
+type fakeBroker struct {
+	addr        string
+	mu          sync.Mutex
+	messages    map[string][]byte
+	publishes   map[string]int
+	connections int
+	conns       []net.Conn
+}
+
+func newFakeBroker(t *testing.T) *fakeBroker {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	t.Cleanup(func() { l.Close() })
+
+	b := &fakeBroker{addr: l.Addr().String(), messages: make(map[string][]byte), publishes: make(map[string]int)}
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go b.serve(conn)
+		}
+	}()
+	return b
+}
+
+func (b *fakeBroker) message(topic string) ([]byte, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	msg, ok := b.messages[topic]
+	return msg, ok
+}
+
+func (b *fakeBroker) publishCount(topic string) int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.publishes[topic]
+}
+
+func (b *fakeBroker) connectionCount() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.connections
+}
+
+// drop closes the open connections as a restarting broker would.
+func (b *fakeBroker) drop() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for _, conn := range b.conns {
+		conn.Close()
+	}
+	b.conns = nil
+}
+
+func (b *fakeBroker) serve(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	for {
+		header, err := r.ReadByte()
+		if err != nil {
+			return
+		}
+		length, multiplier := 0, 1
+		for {
+			digit, err := r.ReadByte()
+			if err != nil {
+				return
+			}
+			length += int(digit&0x7f) * multiplier
+			multiplier *= 128
+			if digit&0x80 == 0 {
+				break
+			}
+		}
+		body := make([]byte, length)
+		if _, err := io.ReadFull(r, body); err != nil {
+			return
+		}
+
+		switch header >> 4 {
+		case packetConnect:
+			b.mu.Lock()
+			b.connections++
+			b.conns = append(b.conns, conn)
+			b.mu.Unlock()
+			conn.Write([]byte{packetConnack << 4, 2, 0, 0})
+		case packetPublish:
+			topicLen := int(body[0])<<8 | int(body[1])
+			topic := string(body[2 : 2+topicLen])
+			b.mu.Lock()
+			b.messages[topic] = body[2+topicLen:]
+			b.publishes[topic]++
+			b.mu.Unlock()
+		case packetPingreq:
+			conn.Write([]byte{packetPingresp << 4, 0})
+		case packetDisconnect:
+			return
+		}
+	}
+}
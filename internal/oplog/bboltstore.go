@@ -0,0 +1,490 @@
+package oplog
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"slices"
+	"time"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/oplog/indexutil"
+	"github.com/garethgeorge/backrest/internal/oplog/serializationutil"
+	"github.com/garethgeorge/backrest/internal/protoutil"
+	bolt "go.etcd.io/bbolt"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	SystemBucket        = []byte("oplog.system")       // system stores metadata
+	OpLogBucket         = []byte("oplog.log")          // oplog stores existant operations.
+	RepoIndexBucket     = []byte("oplog.repo_idx")     // repo_index tracks IDs of operations affecting a given repo
+	PlanIndexBucket     = []byte("oplog.plan_idx")     // plan_index tracks IDs of operations affecting a given plan
+	SnapshotIndexBucket = []byte("oplog.snapshot_idx") // snapshot_index tracks IDs of operations affecting a given snapshot
+	QuarantineBucket    = []byte("oplog.quarantine")   // quarantine stores corrupt records removed from the log by an integrity check
+)
+
+// BboltStore is an OpStore persisting operations in a bbolt database with an index bucket per indexed field.
+type BboltStore struct {
+	db *bolt.DB
+}
+
+var _ OpStore = (*BboltStore)(nil)
+
+func NewBboltStore(databasePath string) (*BboltStore, error) {
+	if err := os.MkdirAll(path.Dir(databasePath), 0700); err != nil {
+		return nil, fmt.Errorf("error creating database directory: %s", err)
+	}
+
+	db, err := bolt.Open(databasePath, 0600, &bolt.Options{Timeout: 1 * time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("error opening database: %s", err)
+	}
+
+	if err := db.Update(func(tx *bolt.Tx) error {
+		// Create the buckets if they don't exist
+		for _, bucket := range [][]byte{
+			SystemBucket, OpLogBucket, RepoIndexBucket, PlanIndexBucket, SnapshotIndexBucket, QuarantineBucket,
+		} {
+			if _, err := tx.CreateBucketIfNotExists(bucket); err != nil {
+				return fmt.Errorf("creating bucket %s: %s", string(bucket), err)
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &BboltStore{db: db}, nil
+}
+
+// Reindex adds every operation written since the last checkpoint to the indices.
+func (s *BboltStore) Reindex() error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		sysBucket := tx.Bucket(SystemBucket)
+		opLogBucket := tx.Bucket(OpLogBucket)
+		c := opLogBucket.Cursor()
+		if lastValidated := sysBucket.Get([]byte("last_validated")); lastValidated != nil {
+			c.Seek(lastValidated)
+		}
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			op := &v1.Operation{}
+			if err := proto.Unmarshal(v, op); err != nil {
+				zap.L().Error("error unmarshalling operation, there may be corruption in the oplog", zap.Error(err))
+				continue
+			}
+
+			if err := s.addOperationHelper(tx, op); err != nil {
+				zap.L().Error("error re-adding operation, there may be corruption in the oplog", zap.Error(err))
+				continue
+			}
+		}
+		if lastValidated, _ := c.Last(); lastValidated != nil {
+			if err := sysBucket.Put([]byte("last_validated"), lastValidated); err != nil {
+				return fmt.Errorf("checkpointing last_validated key: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+func (s *BboltStore) CheckIntegrity(repair bool, report *IntegrityReport) error {
+	check := func(tx *bolt.Tx) error {
+		b := tx.Bucket(OpLogBucket)
+
+		var corruptKeys [][]byte
+		c := b.Cursor()
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			if _, err := readOperationRecord(k, v); err != nil {
+				id, _ := serializationutil.Btoi(k)
+				zap.L().Warn("corrupt operation in oplog", zap.Int64("id", id), zap.Error(err))
+				report.CorruptOperations = append(report.CorruptOperations, id)
+				corruptKeys = append(corruptKeys, slices.Clone(k))
+			}
+		}
+
+		for _, idx := range []struct {
+			bucket []byte
+			value  func(op *v1.Operation) string
+		}{
+			{RepoIndexBucket, func(op *v1.Operation) string { return op.RepoId }},
+			{PlanIndexBucket, func(op *v1.Operation) string { return op.PlanId }},
+			{SnapshotIndexBucket, func(op *v1.Operation) string { return op.SnapshotId }},
+		} {
+			var danglingKeys [][]byte
+			ic := tx.Bucket(idx.bucket).Cursor()
+			for k, _ := ic.First(); k != nil; k, _ = ic.Next() {
+				if !indexEntryValid(b, k, idx.value) {
+					danglingKeys = append(danglingKeys, slices.Clone(k))
+				}
+			}
+			report.DanglingIndexEntries += len(danglingKeys)
+			if !repair {
+				continue
+			}
+			for _, k := range danglingKeys {
+				if err := tx.Bucket(idx.bucket).Delete(k); err != nil {
+					return fmt.Errorf("removing dangling index entry: %w", err)
+				}
+			}
+		}
+
+		if !repair {
+			return nil
+		}
+		quarantine := tx.Bucket(QuarantineBucket)
+		for _, k := range corruptKeys {
+			if err := quarantine.Put(k, b.Get(k)); err != nil {
+				return fmt.Errorf("quarantining corrupt operation: %w", err)
+			}
+			if err := b.Delete(k); err != nil {
+				return fmt.Errorf("removing corrupt operation: %w", err)
+			}
+		}
+		return nil
+	}
+
+	if repair {
+		return s.db.Update(check)
+	}
+	return s.db.View(check)
+}
+
+// readOperationRecord unmarshals an operation stored in the log and verifies it is stored under its own ID.
+func readOperationRecord(k, v []byte) (*v1.Operation, error) {
+	op := &v1.Operation{}
+	if err := proto.Unmarshal(v, op); err != nil {
+		return nil, fmt.Errorf("error unmarshalling operation: %w", err)
+	}
+	if err := protoutil.ValidateOperation(op); err != nil {
+		return nil, fmt.Errorf("validating operation: %w", err)
+	}
+	if id, err := serializationutil.Btoi(k); err != nil || id != op.Id {
+		return nil, fmt.Errorf("operation %v stored under key %x", op.Id, k)
+	}
+	return op, nil
+}
+
+// indexEntryValid reports whether an index key references a readable operation with the indexed value. Entries
+// referencing corrupt records are also dangling since the records are removed when they are quarantined.
+func indexEntryValid(b *bolt.Bucket, k []byte, value func(op *v1.Operation) string) bool {
+	indexed, n, err := serializationutil.Btos(k)
+	if err != nil {
+		return false
+	}
+	id, err := serializationutil.Btoi(k[n:])
+	if err != nil {
+		return false
+	}
+	v := b.Get(serializationutil.Itob(id))
+	if v == nil {
+		return false
+	}
+	op, err := readOperationRecord(serializationutil.Itob(id), v)
+	return err == nil && value(op) == indexed
+}
+
+func (s *BboltStore) Close() error {
+	return s.db.Close()
+}
+
+func (s *BboltStore) WriteTo(w io.Writer) (int64, error) {
+	var n int64
+	err := s.db.View(func(tx *bolt.Tx) error {
+		var err error
+		n, err = tx.WriteTo(w)
+		return err
+	})
+	return n, err
+}
+
+func (s *BboltStore) Add(ops ...*v1.Operation) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		for _, op := range ops {
+			if err := s.addOperationHelper(tx, op); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (s *BboltStore) Update(op *v1.Operation) (*v1.Operation, error) {
+	var oldOp *v1.Operation
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		var err error
+		oldOp, err = s.deleteOperationHelper(tx, op.Id)
+		if err != nil {
+			return fmt.Errorf("deleting existing value prior to update: %w", err)
+		}
+		if err := s.addOperationHelper(tx, op); err != nil {
+			return fmt.Errorf("adding updated value: %w", err)
+		}
+		return nil
+	})
+	return oldOp, err
+}
+
+func (s *BboltStore) Delete(ids ...int64) ([]*v1.Operation, error) {
+	removedOps := make([]*v1.Operation, 0, len(ids))
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		for _, id := range ids {
+			removed, err := s.deleteOperationHelper(tx, id)
+			if err != nil {
+				return fmt.Errorf("deleting operation %v: %w", id, err)
+			}
+			removedOps = append(removedOps, removed)
+		}
+		return nil
+	})
+	return removedOps, err
+}
+
+func (s *BboltStore) getOperationHelper(b *bolt.Bucket, id int64) (*v1.Operation, error) {
+	bytes := b.Get(serializationutil.Itob(id))
+	if bytes == nil {
+		return nil, fmt.Errorf("opid %v: %w", id, ErrNotExist)
+	}
+
+	var op v1.Operation
+	if err := proto.Unmarshal(bytes, &op); err != nil {
+		return nil, fmt.Errorf("error unmarshalling operation: %w", err)
+	}
+
+	return &op, nil
+}
+
+func (s *BboltStore) addOperationHelper(tx *bolt.Tx, op *v1.Operation) error {
+	b := tx.Bucket(OpLogBucket)
+	if op.Id == 0 {
+		seq, err := b.NextSequence()
+		if err != nil {
+			return fmt.Errorf("create next operation ID: %w", err)
+		}
+		op.Id = nextOperationId(time.Now().UnixMilli(), seq)
+	}
+
+	if err := protoutil.ValidateOperation(op); err != nil {
+		return fmt.Errorf("validating operation: %w", err)
+	}
+
+	bytes, err := proto.Marshal(op)
+	if err != nil {
+		return fmt.Errorf("error marshalling operation: %w", err)
+	}
+
+	if err := b.Put(serializationutil.Itob(op.Id), bytes); err != nil {
+		return fmt.Errorf("error putting operation into bucket: %w", err)
+	}
+
+	// Update always universal indices
+	if op.RepoId != "" {
+		if err := indexutil.IndexByteValue(tx.Bucket(RepoIndexBucket), []byte(op.RepoId), op.Id); err != nil {
+			return fmt.Errorf("error adding operation to repo index: %w", err)
+		}
+	}
+	if op.PlanId != "" {
+		if err := indexutil.IndexByteValue(tx.Bucket(PlanIndexBucket), []byte(op.PlanId), op.Id); err != nil {
+			return fmt.Errorf("error adding operation to repo index: %w", err)
+		}
+	}
+	if op.SnapshotId != "" {
+		if err := indexutil.IndexByteValue(tx.Bucket(SnapshotIndexBucket), []byte(op.SnapshotId), op.Id); err != nil {
+			return fmt.Errorf("error adding operation to snapshot index: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *BboltStore) deleteOperationHelper(tx *bolt.Tx, id int64) (*v1.Operation, error) {
+	b := tx.Bucket(OpLogBucket)
+
+	prevValue, err := s.getOperationHelper(b, id)
+	if err != nil {
+		return nil, fmt.Errorf("getting operation %v: %w", id, err)
+	}
+
+	if prevValue.PlanId != "" {
+		if err := indexutil.IndexRemoveByteValue(tx.Bucket(PlanIndexBucket), []byte(prevValue.PlanId), id); err != nil {
+			return nil, fmt.Errorf("removing operation %v from plan index: %w", id, err)
+		}
+	}
+
+	if prevValue.RepoId != "" {
+		if err := indexutil.IndexRemoveByteValue(tx.Bucket(RepoIndexBucket), []byte(prevValue.RepoId), id); err != nil {
+			return nil, fmt.Errorf("removing operation %v from repo index: %w", id, err)
+		}
+	}
+
+	if prevValue.SnapshotId != "" {
+		if err := indexutil.IndexRemoveByteValue(tx.Bucket(SnapshotIndexBucket), []byte(prevValue.SnapshotId), id); err != nil {
+			return nil, fmt.Errorf("removing operation %v from snapshot index: %w", id, err)
+		}
+	}
+
+	if err := b.Delete(serializationutil.Itob(id)); err != nil {
+		return nil, fmt.Errorf("deleting operation %v from bucket: %w", id, err)
+	}
+
+	return prevValue, nil
+}
+
+func (s *BboltStore) Get(id int64) (*v1.Operation, error) {
+	var op *v1.Operation
+	if err := s.db.View(func(tx *bolt.Tx) error {
+		var err error
+		op, err = s.getOperationHelper(tx.Bucket(OpLogBucket), id)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return op, nil
+}
+
+func indexBucket(field IndexField) ([]byte, error) {
+	switch field {
+	case IndexRepoId:
+		return RepoIndexBucket, nil
+	case IndexPlanId:
+		return PlanIndexBucket, nil
+	case IndexSnapshotId:
+		return SnapshotIndexBucket, nil
+	default:
+		return nil, fmt.Errorf("unknown index field %q", field)
+	}
+}
+
+func (s *BboltStore) ForEach(field IndexField, value string, collector indexutil.Collector, do func(op *v1.Operation) error) error {
+	bucket, err := indexBucket(field)
+	if err != nil {
+		return err
+	}
+	return s.db.View(func(tx *bolt.Tx) error {
+		ids := collector(indexutil.IndexSearchByteValue(tx.Bucket(bucket), []byte(value)))
+		return s.forOpsByIds(tx, ids, do)
+	})
+}
+
+func (s *BboltStore) forOpsByIds(tx *bolt.Tx, ids []int64, do func(*v1.Operation) error) error {
+	b := tx.Bucket(OpLogBucket)
+	for _, id := range ids {
+		op, err := s.getOperationHelper(b, id)
+		if err != nil {
+			return err
+		}
+		if err := do(op); err != nil {
+			if err == ErrStopIteration {
+				break
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// ForAll visits every operation, records that fail to unmarshal are logged and skipped.
+func (s *BboltStore) ForAll(do func(op *v1.Operation) error) error {
+	return s.db.View(func(tx *bolt.Tx) error {
+		c := tx.Bucket(OpLogBucket).Cursor()
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			op := &v1.Operation{}
+			if err := proto.Unmarshal(v, op); err != nil {
+				zap.L().Error("error unmarshalling operation, there may be corruption in the oplog", zap.Error(err))
+				continue
+			}
+			if err := do(op); err != nil {
+				if err == ErrStopIteration {
+					return nil
+				}
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (s *BboltStore) Query(q Query) ([]*v1.Operation, int64, error) {
+	var ops []*v1.Operation
+	more := false
+	collect := func(op *v1.Operation) error {
+		if !q.afterCursor(op.Id) || !q.Matches(op) {
+			return nil
+		}
+		if q.Limit > 0 && len(ops) == q.Limit {
+			more = true
+			return ErrStopIteration
+		}
+		ops = append(ops, op)
+		return nil
+	}
+
+	if err := s.db.View(func(tx *bolt.Tx) error {
+		var index []byte
+		var key string
+		switch {
+		case q.PlanId != "":
+			index, key = PlanIndexBucket, q.PlanId
+		case q.RepoId != "":
+			index, key = RepoIndexBucket, q.RepoId
+		case q.SnapshotId != "":
+			index, key = SnapshotIndexBucket, q.SnapshotId
+		}
+		if index != nil {
+			collector := indexutil.CollectAll()
+			if q.Descending {
+				collector = indexutil.Reversed(collector)
+			}
+			ids := collector(indexutil.IndexSearchByteValue(tx.Bucket(index), []byte(key)))
+			return s.forOpsByIds(tx, ids, collect)
+		}
+		return scanOps(tx, q, collect)
+	}); err != nil {
+		return nil, 0, err
+	}
+
+	var cursor int64
+	if more {
+		cursor = ops[len(ops)-1].Id
+	}
+	return ops, cursor, nil
+}
+
+// scanOps iterates the whole log in the query's sort order starting at its cursor.
+func scanOps(tx *bolt.Tx, q Query, do func(*v1.Operation) error) error {
+	c := tx.Bucket(OpLogBucket).Cursor()
+	var k, v []byte
+	switch {
+	case q.Descending && q.Cursor != 0:
+		if k, v = c.Seek(serializationutil.Itob(q.Cursor)); k == nil {
+			k, v = c.Last()
+		}
+	case q.Descending:
+		k, v = c.Last()
+	default:
+		k, v = c.Seek(serializationutil.Itob(q.Cursor + 1))
+	}
+	for ; k != nil; k, v = step(c, q.Descending) {
+		op := &v1.Operation{}
+		if err := proto.Unmarshal(v, op); err != nil {
+			return fmt.Errorf("error unmarshalling operation: %w", err)
+		}
+		if err := do(op); err != nil {
+			if err == ErrStopIteration {
+				return nil
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+func step(c *bolt.Cursor, descending bool) ([]byte, []byte) {
+	if descending {
+		return c.Prev()
+	}
+	return c.Next()
+}
@@ -0,0 +1,82 @@
+package oplog
+
+import (
+	"sort"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+)
+
+// durationSamples is how many of a plan's most recent completed operations of a type an estimate averages.
+const durationSamples = 10
+
+// completedStatuses are the statuses of operations that ran to completion, cancelled and failed operations often
+// stopped early so their durations aren't representative.
+var completedStatuses = []v1.OperationStatus{v1.OperationStatus_STATUS_SUCCESS, v1.OperationStatus_STATUS_WARNING}
+
+// EstimateDuration estimates the duration of the plan's next operation of the type, nil without history.
+func (o *OpLog) EstimateDuration(planId, opType string) (*v1.DurationEstimate, error) {
+	ops, _, err := o.Query(Query{
+		PlanId:     planId,
+		Types:      []string{opType},
+		Statuses:   completedStatuses,
+		Descending: true,
+		Limit:      durationSamples,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return estimateDuration(opType, ops), nil
+}
+
+// DurationEstimates returns an estimate for each operation type the plan has completed, ordered by type.
+func (o *OpLog) DurationEstimates(planId string) ([]*v1.DurationEstimate, error) {
+	ops, _, err := o.Query(Query{
+		PlanId:     planId,
+		Statuses:   completedStatuses,
+		Descending: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	byType := make(map[string][]*v1.Operation)
+	for _, op := range ops {
+		opType := OperationType(op)
+		if opType == "index_snapshot" {
+			continue // recorded with the snapshot's time, not run as a task.
+		}
+		if len(byType[opType]) < durationSamples {
+			byType[opType] = append(byType[opType], op)
+		}
+	}
+	var estimates []*v1.DurationEstimate
+	for opType, ops := range byType {
+		if estimate := estimateDuration(opType, ops); estimate != nil {
+			estimates = append(estimates, estimate)
+		}
+	}
+	sort.Slice(estimates, func(i, j int) bool { return estimates[i].OperationType < estimates[j].OperationType })
+	return estimates, nil
+}
+
+// estimateDuration averages the durations of the operations, operations without a valid end time are skipped.
+func estimateDuration(opType string, ops []*v1.Operation) *v1.DurationEstimate {
+	estimate := &v1.DurationEstimate{OperationType: opType}
+	var total int64
+	for _, op := range ops {
+		d := op.UnixTimeEndMs - op.UnixTimeStartMs
+		if op.UnixTimeEndMs == 0 || d < 0 {
+			continue
+		}
+		total += d
+		estimate.Samples++
+		if d > estimate.MaxMs {
+			estimate.MaxMs = d
+		}
+	}
+	if estimate.Samples == 0 {
+		return nil
+	}
+	estimate.AverageMs = total / int64(estimate.Samples)
+	return estimate
+}
@@ -0,0 +1,65 @@
+package oplog
+
+import (
+	"testing"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+)
+
+func TestDurationEstimates(t *testing.T) {
+	for _, backend := range testBackends {
+		t.Run(backend.name, func(t *testing.T) {
+			log := backend.open(t)
+
+			add := func(planId string, status v1.OperationStatus, startMs, endMs int64, op *v1.Operation) {
+				op.PlanId = planId
+				op.RepoId = "repo1"
+				op.Status = status
+				op.UnixTimeStartMs = startMs
+				op.UnixTimeEndMs = endMs
+				if err := log.Add(op); err != nil {
+					t.Fatalf("error adding operation: %s", err)
+				}
+			}
+			backup := func() *v1.Operation {
+				return &v1.Operation{Op: &v1.Operation_OperationBackup{OperationBackup: &v1.OperationBackup{}}}
+			}
+			for i := int64(0); i < 12; i++ {
+				// the two oldest backups are beyond the samples averaged.
+				d := int64(1000)
+				if i < 2 {
+					d = 100000
+				}
+				add("plan1", v1.OperationStatus_STATUS_SUCCESS, i*200000, i*200000+d, backup())
+			}
+			add("plan1", v1.OperationStatus_STATUS_WARNING, 3000000, 3003000, backup())
+			add("plan1", v1.OperationStatus_STATUS_ERROR, 4000000, 4500000, backup())
+			add("plan1", v1.OperationStatus_STATUS_SUCCESS, 5000000, 5002000, &v1.Operation{Op: &v1.Operation_OperationPrune{OperationPrune: &v1.OperationPrune{}}})
+			add("plan1", v1.OperationStatus_STATUS_SUCCESS, 6000000, 6000000, &v1.Operation{Op: &v1.Operation_OperationIndexSnapshot{OperationIndexSnapshot: &v1.OperationIndexSnapshot{}}})
+			add("plan2", v1.OperationStatus_STATUS_SUCCESS, 7000000, 7900000, backup())
+
+			estimate, err := log.EstimateDuration("plan1", "backup")
+			if err != nil {
+				t.Fatalf("EstimateDuration() error: %v", err)
+			}
+			if estimate.Samples != 10 || estimate.AverageMs != 1200 || estimate.MaxMs != 3000 {
+				t.Errorf("want 10 samples averaging 1200ms with a max of 3000ms, got %v", estimate)
+			}
+
+			estimates, err := log.DurationEstimates("plan1")
+			if err != nil {
+				t.Fatalf("DurationEstimates() error: %v", err)
+			}
+			if len(estimates) != 2 || estimates[0].OperationType != "backup" || estimates[1].OperationType != "prune" {
+				t.Fatalf("want backup and prune estimates, got %v", estimates)
+			}
+			if estimates[0].AverageMs != 1200 || estimates[1].AverageMs != 2000 || estimates[1].Samples != 1 {
+				t.Errorf("got estimates %v", estimates)
+			}
+
+			if estimate, err := log.EstimateDuration("plan3", "backup"); err != nil || estimate != nil {
+				t.Errorf("want no estimate without history, got %v, %v", estimate, err)
+			}
+		})
+	}
+}
@@ -59,6 +59,24 @@ func (i *IndexSearchIterator) Next() (int64, bool) {
 	return id, true
 }
 
+// SliceIterator iterates over IDs that have already been read from an index.
+type SliceIterator struct {
+	ids []int64
+}
+
+func NewSliceIterator(ids []int64) *SliceIterator {
+	return &SliceIterator{ids: ids}
+}
+
+func (i *SliceIterator) Next() (int64, bool) {
+	if len(i.ids) == 0 {
+		return 0, false
+	}
+	id := i.ids[0]
+	i.ids = i.ids[1:]
+	return id, true
+}
+
 type JoinIterator struct {
 	iters []IndexIterator
 }
@@ -0,0 +1,63 @@
+package oplog
+
+import (
+	"fmt"
+	"slices"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+)
+
+// IntegrityOptions configures a consistency pass over the oplog.
+type IntegrityOptions struct {
+	// Known repo and plan IDs, operations referencing other IDs are orphaned.
+	Repos []string
+	Plans []string
+	// Repair removes dangling index entries and moves corrupt records into quarantine.
+	Repair bool
+	// RemoveOrphaned deletes orphaned operations.
+	RemoveOrphaned bool
+}
+
+// IntegrityReport describes the problems found by a consistency pass and whether they were fixed.
+type IntegrityReport struct {
+	DanglingIndexEntries int     // index entries pointing at missing operations or at operations with a different value.
+	CorruptOperations    []int64 // records that could not be read or failed validation.
+	OrphanedOperations   []int64 // operations referencing a repo or plan that no longer exists.
+	Repaired             bool    // dangling index entries were removed and corrupt records quarantined.
+	OrphansRemoved       bool
+}
+
+// CheckIntegrity runs a consistency pass over the log and, as requested by opts, repairs what it finds.
+func (o *OpLog) CheckIntegrity(opts IntegrityOptions) (*IntegrityReport, error) {
+	report := &IntegrityReport{}
+	if err := o.store.CheckIntegrity(opts.Repair, report); err != nil {
+		return nil, fmt.Errorf("checking store integrity: %w", err)
+	}
+	report.Repaired = opts.Repair
+
+	if err := o.store.ForAll(func(op *v1.Operation) error {
+		if isOrphaned(op, opts) {
+			report.OrphanedOperations = append(report.OrphanedOperations, op.Id)
+		}
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("finding orphaned operations: %w", err)
+	}
+
+	if opts.RemoveOrphaned && len(report.OrphanedOperations) > 0 {
+		if err := o.Delete(report.OrphanedOperations...); err != nil {
+			return nil, fmt.Errorf("removing orphaned operations: %w", err)
+		}
+		report.OrphansRemoved = true
+	}
+	return report, nil
+}
+
+// isOrphaned reports whether the operation references a repo that no longer exists, or a plan that no longer exists
+// and no snapshot. Operations for a snapshot of a deleted plan still describe a snapshot in the repo.
+func isOrphaned(op *v1.Operation, opts IntegrityOptions) bool {
+	if op.RepoId != "" && !slices.Contains(opts.Repos, op.RepoId) {
+		return true
+	}
+	return op.PlanId != "" && op.SnapshotId == "" && !slices.Contains(opts.Plans, op.PlanId)
+}
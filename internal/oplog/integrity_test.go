@@ -0,0 +1,154 @@
+package oplog
+
+import (
+	"slices"
+	"testing"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/oplog/indexutil"
+	"github.com/garethgeorge/backrest/internal/oplog/serializationutil"
+	bolt "go.etcd.io/bbolt"
+)
+
+func TestCheckIntegrityBbolt(t *testing.T) {
+	t.Parallel()
+	store, err := NewBboltStore(t.TempDir() + "/test.boltdb")
+	if err != nil {
+		t.Fatalf("error creating bbolt store: %s", err)
+	}
+	log := NewOpLogWithStore(store)
+	t.Cleanup(func() { log.Close() })
+
+	op := &v1.Operation{UnixTimeStartMs: 1234, RepoId: "repo1", PlanId: "plan1", Op: &v1.Operation_OperationBackup{}}
+	if err := log.Add(op); err != nil {
+		t.Fatalf("error adding operation: %s", err)
+	}
+
+	// a corrupt record and index entries for an operation that does not exist.
+	if err := store.db.Update(func(tx *bolt.Tx) error {
+		if err := tx.Bucket(OpLogBucket).Put(serializationutil.Itob(op.Id+1), []byte("not a proto")); err != nil {
+			return err
+		}
+		if err := indexutil.IndexByteValue(tx.Bucket(PlanIndexBucket), []byte("plan1"), op.Id+2); err != nil {
+			return err
+		}
+		return indexutil.IndexByteValue(tx.Bucket(RepoIndexBucket), []byte("repo2"), op.Id)
+	}); err != nil {
+		t.Fatalf("error corrupting database: %s", err)
+	}
+
+	testCheckIntegrity(t, log, []int64{op.Id + 1}, 2)
+
+	if err := store.db.View(func(tx *bolt.Tx) error {
+		if tx.Bucket(QuarantineBucket).Get(serializationutil.Itob(op.Id+1)) == nil {
+			t.Errorf("want corrupt record in quarantine")
+		}
+		return nil
+	}); err != nil {
+		t.Fatalf("error reading quarantine: %s", err)
+	}
+}
+
+func TestCheckIntegritySqlite(t *testing.T) {
+	t.Parallel()
+	store, err := NewSqliteStore(t.TempDir() + "/test.sqlite")
+	if err != nil {
+		t.Fatalf("error creating sqlite store: %s", err)
+	}
+	log := NewOpLogWithStore(store)
+	t.Cleanup(func() { log.Close() })
+
+	op := &v1.Operation{UnixTimeStartMs: 1234, RepoId: "repo1", PlanId: "plan1", Op: &v1.Operation_OperationBackup{}}
+	if err := log.Add(op); err != nil {
+		t.Fatalf("error adding operation: %s", err)
+	}
+
+	// a corrupt record and two columns that no longer match the stored operation.
+	if _, err := store.db.Exec(`INSERT INTO operations (id, repo_id, plan_id, snapshot_id, status, type, unix_time_start_ms, display_message, operation)
+		VALUES (?, 'repo1', 'plan1', '', 0, 'backup', 0, '', ?)`, op.Id+1, []byte("not a proto")); err != nil {
+		t.Fatalf("error corrupting database: %s", err)
+	}
+	if _, err := store.db.Exec("UPDATE operations SET plan_id = 'plan2', repo_id = 'repo2' WHERE id = ?", op.Id); err != nil {
+		t.Fatalf("error corrupting database: %s", err)
+	}
+
+	testCheckIntegrity(t, log, []int64{op.Id + 1}, 2)
+	countByPlanHelper(t, log, "plan1", 1)
+
+	var quarantined int
+	if err := store.db.QueryRow("SELECT COUNT(*) FROM quarantine WHERE id = ?", op.Id+1).Scan(&quarantined); err != nil {
+		t.Fatalf("error reading quarantine: %s", err)
+	}
+	if quarantined != 1 {
+		t.Errorf("want corrupt record in quarantine")
+	}
+}
+
+// testCheckIntegrity checks that the problems are reported without changes, then repaired.
+func testCheckIntegrity(t *testing.T, log *OpLog, wantCorrupt []int64, wantDangling int) {
+	t.Helper()
+	opts := IntegrityOptions{Repos: []string{"repo1"}, Plans: []string{"plan1"}}
+	for _, repair := range []bool{false, false, true} {
+		opts.Repair = repair
+		report, err := log.CheckIntegrity(opts)
+		if err != nil {
+			t.Fatalf("CheckIntegrity() error: %s", err)
+		}
+		if !slices.Equal(report.CorruptOperations, wantCorrupt) {
+			t.Errorf("repair=%v: want corrupt operations %v, got %v", repair, wantCorrupt, report.CorruptOperations)
+		}
+		if report.DanglingIndexEntries != wantDangling {
+			t.Errorf("repair=%v: want %d dangling index entries, got %d", repair, wantDangling, report.DanglingIndexEntries)
+		}
+		if report.Repaired != repair {
+			t.Errorf("want repaired %v, got %v", repair, report.Repaired)
+		}
+	}
+
+	report, err := log.CheckIntegrity(opts)
+	if err != nil {
+		t.Fatalf("CheckIntegrity() error: %s", err)
+	}
+	if report.DanglingIndexEntries != 0 || len(report.CorruptOperations) != 0 {
+		t.Errorf("want no problems after repair, got %+v", report)
+	}
+	countByRepoHelper(t, log, "repo1", 1)
+	countByRepoHelper(t, log, "repo2", 0)
+}
+
+func TestCheckIntegrityOrphans(t *testing.T) {
+	for _, backend := range testBackends {
+		t.Run(backend.name, func(t *testing.T) {
+			log := backend.open(t)
+
+			ops := []*v1.Operation{
+				{UnixTimeStartMs: 1000, RepoId: "repo1", PlanId: "plan1", Op: &v1.Operation_OperationBackup{}},
+				{UnixTimeStartMs: 1001, RepoId: "deletedrepo", PlanId: "plan1", Op: &v1.Operation_OperationBackup{}},
+				{UnixTimeStartMs: 1002, RepoId: "repo1", PlanId: "deletedplan", Op: &v1.Operation_OperationForget{}},
+				// snapshots of deleted plans remain in the repo.
+				{UnixTimeStartMs: 1003, RepoId: "repo1", PlanId: "deletedplan", SnapshotId: snapshotId, Op: &v1.Operation_OperationIndexSnapshot{}},
+			}
+			if err := log.BulkAdd(ops); err != nil {
+				t.Fatalf("error adding operations: %s", err)
+			}
+
+			opts := IntegrityOptions{Repos: []string{"repo1"}, Plans: []string{"plan1"}}
+			report, err := log.CheckIntegrity(opts)
+			if err != nil {
+				t.Fatalf("CheckIntegrity() error: %s", err)
+			}
+			want := []int64{ops[1].Id, ops[2].Id}
+			if !slices.Equal(report.OrphanedOperations, want) || report.OrphansRemoved {
+				t.Fatalf("want orphaned operations %v not removed, got %v removed=%v", want, report.OrphanedOperations, report.OrphansRemoved)
+			}
+
+			opts.RemoveOrphaned = true
+			if _, err := log.CheckIntegrity(opts); err != nil {
+				t.Fatalf("CheckIntegrity() error: %s", err)
+			}
+			countByRepoHelper(t, log, "repo1", 2)
+			countByRepoHelper(t, log, "deletedrepo", 0)
+			countByPlanHelper(t, log, "deletedplan", 1)
+		})
+	}
+}
@@ -3,19 +3,12 @@ package oplog
 import (
 	"errors"
 	"fmt"
-	"os"
-	"path"
+	"io"
 	"sync"
-	"time"
 
 	v1 "github.com/garethgeorge/backrest/gen/go/v1"
 	"github.com/garethgeorge/backrest/internal/oplog/indexutil"
-	"github.com/garethgeorge/backrest/internal/oplog/serializationutil"
-	"github.com/garethgeorge/backrest/internal/protoutil"
 	"github.com/garethgeorge/backrest/pkg/restic"
-	bolt "go.etcd.io/bbolt"
-	"go.uber.org/zap"
-	"google.golang.org/protobuf/proto"
 )
 
 type EventType int
@@ -29,95 +22,88 @@ const (
 var ErrNotExist = errors.New("operation does not exist")
 var ErrStopIteration = errors.New("stop iteration")
 
-var (
-	SystemBucket        = []byte("oplog.system")       // system stores metadata
-	OpLogBucket         = []byte("oplog.log")          // oplog stores existant operations.
-	RepoIndexBucket     = []byte("oplog.repo_idx")     // repo_index tracks IDs of operations affecting a given repo
-	PlanIndexBucket     = []byte("oplog.plan_idx")     // plan_index tracks IDs of operations affecting a given plan
-	SnapshotIndexBucket = []byte("oplog.snapshot_idx") // snapshot_index tracks IDs of operations affecting a given snapshot
+// IndexField is an operation field that stores index operations by.
+type IndexField string
+
+const (
+	IndexRepoId     = IndexField("repo_id")
+	IndexPlanId     = IndexField("plan_id")
+	IndexSnapshotId = IndexField("snapshot_id")
 )
 
+// OpStore is a storage backend for the oplog. Implementations must be safe for concurrent use.
+type OpStore interface {
+	// Add assigns IDs to the operations and stores them in a single transaction.
+	Add(ops ...*v1.Operation) error
+	// Update replaces the stored operation with the same ID and returns the previous value.
+	Update(op *v1.Operation) (*v1.Operation, error)
+	// Delete removes the operations with the given IDs and returns the removed values.
+	Delete(ids ...int64) ([]*v1.Operation, error)
+	// Get returns the operation with the given ID or ErrNotExist.
+	Get(id int64) (*v1.Operation, error)
+	// ForEach visits the operations with the given value of an indexed field, the collector selects the IDs visited.
+	ForEach(field IndexField, value string, collector indexutil.Collector, do func(op *v1.Operation) error) error
+	// ForAll visits every operation in ascending ID order.
+	ForAll(do func(op *v1.Operation) error) error
+	// Query returns a page of the operations matching q, see OpLog.Query.
+	Query(q Query) ([]*v1.Operation, int64, error)
+	// CheckIntegrity fills in the dangling index entries and corrupt operations of the report, if repair is set
+	// the index entries are fixed and the corrupt records are moved out of the log into quarantine.
+	CheckIntegrity(repair bool, report *IntegrityReport) error
+	// WriteTo writes a consistent copy of the database to w while the store remains in use.
+	WriteTo(w io.Writer) (int64, error)
+	Close() error
+}
+
+// reindexer is implemented by stores that maintain their own indices and can rebuild them on startup.
+type reindexer interface {
+	Reindex() error
+}
+
 // OpLog represents a log of operations performed.
 // Operations are indexed by repo and plan.
 type OpLog struct {
-	db *bolt.DB
+	store OpStore
 
 	subscribersMu sync.RWMutex
 	subscribers   []*func(*v1.Operation, *v1.Operation)
 }
 
+// NewOpLog opens the bbolt backed oplog at databasePath.
 func NewOpLog(databasePath string) (*OpLog, error) {
-	if err := os.MkdirAll(path.Dir(databasePath), 0700); err != nil {
-		return nil, fmt.Errorf("error creating database directory: %s", err)
-	}
-
-	db, err := bolt.Open(databasePath, 0600, &bolt.Options{Timeout: 1 * time.Second})
+	store, err := NewBboltStore(databasePath)
 	if err != nil {
-		return nil, fmt.Errorf("error opening database: %s", err)
-	}
-
-	o := &OpLog{
-		db: db,
-	}
-
-	if err := db.Update(func(tx *bolt.Tx) error {
-		// Create the buckets if they don't exist
-		for _, bucket := range [][]byte{
-			SystemBucket, OpLogBucket, RepoIndexBucket, PlanIndexBucket, SnapshotIndexBucket,
-		} {
-			if _, err := tx.CreateBucketIfNotExists(bucket); err != nil {
-				return fmt.Errorf("creating bucket %s: %s", string(bucket), err)
-			}
-		}
-
-		return nil
-	}); err != nil {
 		return nil, err
 	}
+	return NewOpLogWithStore(store), nil
+}
 
-	return o, nil
+// NewOpLogWithStore returns an oplog persisting operations to store.
+func NewOpLogWithStore(store OpStore) *OpLog {
+	return &OpLog{
+		store: store,
+	}
 }
 
 // Scan checks the log for incomplete operations. Should only be called at startup.
 func (o *OpLog) Scan(onIncomplete func(op *v1.Operation)) error {
-	removeIds := make([]int64, 0)
-
-	err := o.db.Update(func(tx *bolt.Tx) error {
-		sysBucket := tx.Bucket(SystemBucket)
-		opLogBucket := tx.Bucket(OpLogBucket)
-		c := opLogBucket.Cursor()
-		if lastValidated := sysBucket.Get([]byte("last_validated")); lastValidated != nil {
-			c.Seek(lastValidated)
-		}
-		for k, v := c.First(); k != nil; k, v = c.Next() {
-			op := &v1.Operation{}
-			if err := proto.Unmarshal(v, op); err != nil {
-				zap.L().Error("error unmarshalling operation, there may be corruption in the oplog", zap.Error(err))
-				continue
-			}
-
-			if op.Status == v1.OperationStatus_STATUS_PENDING || op.Status == v1.OperationStatus_STATUS_SYSTEM_CANCELLED || op.Status == v1.OperationStatus_STATUS_USER_CANCELLED {
-				// remove pending or user cancelled operations.
-				removeIds = append(removeIds, op.Id)
-				continue
-			} else if op.Status == v1.OperationStatus_STATUS_INPROGRESS {
-				onIncomplete(op)
-				removeIds = append(removeIds, op.Id)
-			}
-
-			if err := o.addOperationHelper(tx, op); err != nil {
-				zap.L().Error("error re-adding operation, there may be corruption in the oplog", zap.Error(err))
-				continue
-			}
+	if r, ok := o.store.(reindexer); ok {
+		if err := r.Reindex(); err != nil {
+			return fmt.Errorf("scanning log: %v", err)
 		}
-		if lastValidated, _ := c.Last(); lastValidated != nil {
-			if err := sysBucket.Put([]byte("last_validated"), lastValidated); err != nil {
-				return fmt.Errorf("checkpointing last_validated key: %w", err)
-			}
+	}
+
+	removeIds := make([]int64, 0)
+	if err := o.store.ForAll(func(op *v1.Operation) error {
+		if op.Status == v1.OperationStatus_STATUS_PENDING || op.Status == v1.OperationStatus_STATUS_SYSTEM_CANCELLED || op.Status == v1.OperationStatus_STATUS_USER_CANCELLED {
+			// remove pending or user cancelled operations.
+			removeIds = append(removeIds, op.Id)
+		} else if op.Status == v1.OperationStatus_STATUS_INPROGRESS {
+			onIncomplete(op)
+			removeIds = append(removeIds, op.Id)
 		}
 		return nil
-	})
-	if err != nil {
+	}); err != nil {
 		return fmt.Errorf("scanning log: %v", err)
 	}
 
@@ -130,7 +116,12 @@ func (o *OpLog) Scan(onIncomplete func(op *v1.Operation)) error {
 }
 
 func (o *OpLog) Close() error {
-	return o.db.Close()
+	return o.store.Close()
+}
+
+// WriteTo writes a consistent copy of the database to w, it is safe to call while the oplog is in use.
+func (o *OpLog) WriteTo(w io.Writer) (int64, error) {
+	return o.store.WriteTo(w)
 }
 
 // Add adds a generic operation to the operation log.
@@ -139,13 +130,7 @@ func (o *OpLog) Add(op *v1.Operation) error {
 		return errors.New("operation already has an ID, OpLog.Add is expected to set the ID")
 	}
 
-	err := o.db.Update(func(tx *bolt.Tx) error {
-		err := o.addOperationHelper(tx, op)
-		if err != nil {
-			return err
-		}
-		return nil
-	})
+	err := o.store.Add(op)
 	if err == nil {
 		o.notifyHelper(nil, op)
 	}
@@ -153,17 +138,12 @@ func (o *OpLog) Add(op *v1.Operation) error {
 }
 
 func (o *OpLog) BulkAdd(ops []*v1.Operation) error {
-	err := o.db.Update(func(tx *bolt.Tx) error {
-		for _, op := range ops {
-			if op.Id != 0 {
-				return errors.New("operation already has an ID, OpLog.BulkAdd is expected to set the ID")
-			}
-			if err := o.addOperationHelper(tx, op); err != nil {
-				return err
-			}
+	for _, op := range ops {
+		if op.Id != 0 {
+			return errors.New("operation already has an ID, OpLog.BulkAdd is expected to set the ID")
 		}
-		return nil
-	})
+	}
+	err := o.store.Add(ops...)
 	if err == nil {
 		for _, op := range ops {
 			o.notifyHelper(nil, op)
@@ -176,18 +156,7 @@ func (o *OpLog) Update(op *v1.Operation) error {
 	if op.Id == 0 {
 		return errors.New("operation does not have an ID, OpLog.Update expects operation with an ID")
 	}
-	var oldOp *v1.Operation
-	err := o.db.Update(func(tx *bolt.Tx) error {
-		var err error
-		oldOp, err = o.deleteOperationHelper(tx, op.Id)
-		if err != nil {
-			return fmt.Errorf("deleting existing value prior to update: %w", err)
-		}
-		if err := o.addOperationHelper(tx, op); err != nil {
-			return fmt.Errorf("adding updated value: %w", err)
-		}
-		return nil
-	})
+	oldOp, err := o.store.Update(op)
 	if err == nil {
 		o.notifyHelper(oldOp, op)
 	}
@@ -195,17 +164,7 @@ func (o *OpLog) Update(op *v1.Operation) error {
 }
 
 func (o *OpLog) Delete(ids ...int64) error {
-	removedOps := make([]*v1.Operation, 0, len(ids))
-	err := o.db.Update(func(tx *bolt.Tx) error {
-		for _, id := range ids {
-			removed, err := o.deleteOperationHelper(tx, id)
-			if err != nil {
-				return fmt.Errorf("deleting operation %v: %w", id, err)
-			}
-			removedOps = append(removedOps, removed)
-		}
-		return nil
-	})
+	removedOps, err := o.store.Delete(ids...)
 	if err == nil {
 		for _, op := range removedOps {
 			o.notifyHelper(op, nil)
@@ -222,174 +181,34 @@ func (o *OpLog) notifyHelper(old *v1.Operation, new *v1.Operation) {
 	}
 }
 
-func (o *OpLog) getOperationHelper(b *bolt.Bucket, id int64) (*v1.Operation, error) {
-	bytes := b.Get(serializationutil.Itob(id))
-	if bytes == nil {
-		return nil, fmt.Errorf("opid %v: %w", id, ErrNotExist)
-	}
-
-	var op v1.Operation
-	if err := proto.Unmarshal(bytes, &op); err != nil {
-		return nil, fmt.Errorf("error unmarshalling operation: %w", err)
-	}
-
-	return &op, nil
-}
-
-func (o *OpLog) nextOperationId(b *bolt.Bucket, unixTimeMs int64) (int64, error) {
-	seq, err := b.NextSequence()
-	if err != nil {
-		return 0, fmt.Errorf("next sequence: %w", err)
-	}
-	return int64(unixTimeMs<<20) | int64(seq&((1<<20)-1)), nil
-}
-
-func (o *OpLog) addOperationHelper(tx *bolt.Tx, op *v1.Operation) error {
-	b := tx.Bucket(OpLogBucket)
-	if op.Id == 0 {
-		var err error
-		op.Id, err = o.nextOperationId(b, time.Now().UnixMilli())
-		if err != nil {
-			return fmt.Errorf("create next operation ID: %w", err)
-		}
-	}
-
-	if err := protoutil.ValidateOperation(op); err != nil {
-		return fmt.Errorf("validating operation: %w", err)
-	}
-
-	bytes, err := proto.Marshal(op)
-	if err != nil {
-		return fmt.Errorf("error marshalling operation: %w", err)
-	}
-
-	if err := b.Put(serializationutil.Itob(op.Id), bytes); err != nil {
-		return fmt.Errorf("error putting operation into bucket: %w", err)
-	}
-
-	// Update always universal indices
-	if op.RepoId != "" {
-		if err := indexutil.IndexByteValue(tx.Bucket(RepoIndexBucket), []byte(op.RepoId), op.Id); err != nil {
-			return fmt.Errorf("error adding operation to repo index: %w", err)
-		}
-	}
-	if op.PlanId != "" {
-		if err := indexutil.IndexByteValue(tx.Bucket(PlanIndexBucket), []byte(op.PlanId), op.Id); err != nil {
-			return fmt.Errorf("error adding operation to repo index: %w", err)
-		}
-	}
-	if op.SnapshotId != "" {
-		if err := indexutil.IndexByteValue(tx.Bucket(SnapshotIndexBucket), []byte(op.SnapshotId), op.Id); err != nil {
-			return fmt.Errorf("error adding operation to snapshot index: %w", err)
-		}
-	}
-
-	return nil
-}
-
-func (o *OpLog) deleteOperationHelper(tx *bolt.Tx, id int64) (*v1.Operation, error) {
-	b := tx.Bucket(OpLogBucket)
-
-	prevValue, err := o.getOperationHelper(b, id)
-	if err != nil {
-		return nil, fmt.Errorf("getting operation %v: %w", id, err)
-	}
-
-	if prevValue.PlanId != "" {
-		if err := indexutil.IndexRemoveByteValue(tx.Bucket(PlanIndexBucket), []byte(prevValue.PlanId), id); err != nil {
-			return nil, fmt.Errorf("removing operation %v from plan index: %w", id, err)
-		}
-	}
-
-	if prevValue.RepoId != "" {
-		if err := indexutil.IndexRemoveByteValue(tx.Bucket(RepoIndexBucket), []byte(prevValue.RepoId), id); err != nil {
-			return nil, fmt.Errorf("removing operation %v from repo index: %w", id, err)
-		}
-	}
-
-	if prevValue.SnapshotId != "" {
-		if err := indexutil.IndexRemoveByteValue(tx.Bucket(SnapshotIndexBucket), []byte(prevValue.SnapshotId), id); err != nil {
-			return nil, fmt.Errorf("removing operation %v from snapshot index: %w", id, err)
-		}
-	}
-
-	if err := b.Delete(serializationutil.Itob(id)); err != nil {
-		return nil, fmt.Errorf("deleting operation %v from bucket: %w", id, err)
-	}
-
-	return prevValue, nil
-}
-
 func (o *OpLog) Get(id int64) (*v1.Operation, error) {
-	var op *v1.Operation
-	if err := o.db.View(func(tx *bolt.Tx) error {
-		var err error
-		op, err = o.getOperationHelper(tx.Bucket(OpLogBucket), id)
-		return err
-	}); err != nil {
-		return nil, err
-	}
-	return op, nil
+	return o.store.Get(id)
 }
 
 func (o *OpLog) ForEachByRepo(repoId string, collector indexutil.Collector, do func(op *v1.Operation) error) error {
-	return o.db.View(func(tx *bolt.Tx) error {
-		ids := collector(indexutil.IndexSearchByteValue(tx.Bucket(RepoIndexBucket), []byte(repoId)))
-		return o.forOpsByIds(tx, ids, do)
-	})
+	return o.store.ForEach(IndexRepoId, repoId, collector, do)
 }
 
 func (o *OpLog) ForEachByPlan(planId string, collector indexutil.Collector, do func(op *v1.Operation) error) error {
-	return o.db.View(func(tx *bolt.Tx) error {
-		ids := collector(indexutil.IndexSearchByteValue(tx.Bucket(PlanIndexBucket), []byte(planId)))
-		return o.forOpsByIds(tx, ids, do)
-	})
+	return o.store.ForEach(IndexPlanId, planId, collector, do)
 }
 
 func (o *OpLog) ForEachBySnapshotId(snapshotId string, collector indexutil.Collector, do func(op *v1.Operation) error) error {
 	if err := restic.ValidateSnapshotId(snapshotId); err != nil {
 		return nil
 	}
-	return o.db.View(func(tx *bolt.Tx) error {
-		ids := collector(indexutil.IndexSearchByteValue(tx.Bucket(SnapshotIndexBucket), []byte(snapshotId)))
-		return o.forOpsByIds(tx, ids, do)
-	})
+	return o.store.ForEach(IndexSnapshotId, snapshotId, collector, do)
 }
 
-func (o *OpLog) forOpsByIds(tx *bolt.Tx, ids []int64, do func(*v1.Operation) error) error {
-	b := tx.Bucket(OpLogBucket)
-	for _, id := range ids {
-		op, err := o.getOperationHelper(b, id)
-		if err != nil {
-			return err
-		}
-		if err := do(op); err != nil {
-			if err == ErrStopIteration {
-				break
-			}
-			return err
-		}
-	}
-	return nil
+// ForAll visits every operation in ascending ID order, iteration ends early if do returns ErrStopIteration.
+func (o *OpLog) ForAll(do func(op *v1.Operation) error) error {
+	return o.store.ForAll(do)
 }
 
-func (o *OpLog) ForAll(do func(op *v1.Operation) error) error {
-	if err := o.db.View(func(tx *bolt.Tx) error {
-		c := tx.Bucket(OpLogBucket).Cursor()
-		for k, v := c.First(); k != nil; k, v = c.Next() {
-			op := &v1.Operation{}
-			if err := proto.Unmarshal(v, op); err != nil {
-				return fmt.Errorf("error unmarshalling operation: %w", err)
-			}
-			if err := do(op); err != nil {
-				return err
-			}
-		}
-		return nil
-	}); err != nil {
-		return nil
-	}
-	return nil
+// Query returns the operations matching q. If more operations match than the limit allows, the ID of the
+// last operation returned is provided as the cursor for the next page, otherwise the cursor is 0.
+func (o *OpLog) Query(q Query) ([]*v1.Operation, int64, error) {
+	return o.store.Query(q)
 }
 
 func (o *OpLog) Subscribe(callback *func(*v1.Operation, *v1.Operation)) {
@@ -409,3 +228,9 @@ func (o *OpLog) Unsubscribe(callback *func(*v1.Operation, *v1.Operation)) {
 		}
 	}
 }
+
+// nextOperationId derives a unique ID from the operation's creation time and a sequence number so that IDs
+// sort in creation order.
+func nextOperationId(unixTimeMs int64, seq uint64) int64 {
+	return int64(unixTimeMs<<20) | int64(seq&((1<<20)-1))
+}
@@ -0,0 +1,94 @@
+package oplog
+
+import (
+	"slices"
+	"strings"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+)
+
+// Query selects operations from the log. The zero value matches every operation in ascending ID order.
+type Query struct {
+	RepoId      string
+	PlanId      string
+	SnapshotId  string
+	Statuses    []v1.OperationStatus
+	Types       []string // operation types as returned by OperationType.
+	SinceUnixMs int64    // inclusive lower bound on the operation's start time.
+	UntilUnixMs int64    // exclusive upper bound on the operation's start time.
+	Text        string   // case insensitive substring of the operation's display message.
+	Descending  bool     // newest operations first.
+	Cursor      int64    // only operations after this ID in the sort order, 0 to start from the first operation.
+	Limit       int      // maximum number of operations to return, 0 for no limit.
+}
+
+// OperationType returns the name of the operation's type e.g. "backup" for an OperationBackup.
+func OperationType(op *v1.Operation) string {
+	switch op.Op.(type) {
+	case *v1.Operation_OperationBackup:
+		return "backup"
+	case *v1.Operation_OperationIndexSnapshot:
+		return "index_snapshot"
+	case *v1.Operation_OperationForget:
+		return "forget"
+	case *v1.Operation_OperationPrune:
+		return "prune"
+	case *v1.Operation_OperationRestore:
+		return "restore"
+	case *v1.Operation_OperationStats:
+		return "stats"
+	case *v1.Operation_OperationRunHook:
+		return "run_hook"
+	case *v1.Operation_OperationMigrate:
+		return "migrate"
+	case *v1.Operation_OperationRestoreDrill:
+		return "restore_drill"
+	case *v1.Operation_OperationCacheCleanup:
+		return "cache_cleanup"
+	case *v1.Operation_OperationWarmup:
+		return "warmup"
+	case *v1.Operation_OperationBenchmark:
+		return "benchmark"
+	default:
+		return "unknown"
+	}
+}
+
+// Matches reports whether op satisfies the filters of the query, the cursor and limit are not considered.
+func (q *Query) Matches(op *v1.Operation) bool {
+	if q.RepoId != "" && op.RepoId != q.RepoId {
+		return false
+	}
+	if q.PlanId != "" && op.PlanId != q.PlanId {
+		return false
+	}
+	if q.SnapshotId != "" && op.SnapshotId != q.SnapshotId {
+		return false
+	}
+	if len(q.Statuses) > 0 && !slices.Contains(q.Statuses, op.Status) {
+		return false
+	}
+	if len(q.Types) > 0 && !slices.Contains(q.Types, OperationType(op)) {
+		return false
+	}
+	if q.SinceUnixMs != 0 && op.UnixTimeStartMs < q.SinceUnixMs {
+		return false
+	}
+	if q.UntilUnixMs != 0 && op.UnixTimeStartMs >= q.UntilUnixMs {
+		return false
+	}
+	if q.Text != "" && !strings.Contains(strings.ToLower(op.DisplayMessage), strings.ToLower(q.Text)) {
+		return false
+	}
+	return true
+}
+
+func (q *Query) afterCursor(id int64) bool {
+	if q.Cursor == 0 {
+		return true
+	}
+	if q.Descending {
+		return id < q.Cursor
+	}
+	return id > q.Cursor
+}
@@ -0,0 +1,168 @@
+package oplog
+
+import (
+	"slices"
+	"testing"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+)
+
+// testBackends opens an empty oplog with each of the storage backends.
+var testBackends = []struct {
+	name string
+	open func(t *testing.T) *OpLog
+}{
+	{
+		name: "bbolt",
+		open: func(t *testing.T) *OpLog {
+			log, err := NewOpLog(t.TempDir() + "/test.boltdb")
+			if err != nil {
+				t.Fatalf("error creating oplog: %s", err)
+			}
+			t.Cleanup(func() { log.Close() })
+			return log
+		},
+	},
+	{
+		name: "sqlite",
+		open: func(t *testing.T) *OpLog {
+			store, err := NewSqliteStore(t.TempDir() + "/test.sqlite")
+			if err != nil {
+				t.Fatalf("error creating sqlite store: %s", err)
+			}
+			log := NewOpLogWithStore(store)
+			t.Cleanup(func() { log.Close() })
+			return log
+		},
+	},
+}
+
+func TestQuery(t *testing.T) {
+	for _, backend := range testBackends {
+		t.Run(backend.name, func(t *testing.T) {
+			testQuery(t, backend.open(t))
+		})
+	}
+}
+
+func testQuery(t *testing.T, log *OpLog) {
+
+	for i := 0; i < 10; i++ {
+		op := &v1.Operation{
+			UnixTimeStartMs: int64(1000 + i),
+			RepoId:          "repo1",
+			PlanId:          "plan1",
+			Status:          v1.OperationStatus_STATUS_SUCCESS,
+			Op:              &v1.Operation_OperationBackup{},
+		}
+		if i%2 == 1 {
+			op.PlanId = "plan2"
+			op.Status = v1.OperationStatus_STATUS_ERROR
+			op.DisplayMessage = "Fatal: repository is already LOCKED"
+			op.Op = &v1.Operation_OperationPrune{}
+		}
+		if err := log.Add(op); err != nil {
+			t.Fatalf("error adding operation: %s", err)
+		}
+	}
+
+	var tests = []struct {
+		name  string
+		query Query
+		want  []int64 // start times of the expected operations.
+	}{
+		{
+			name:  "all",
+			query: Query{},
+			want:  []int64{1000, 1001, 1002, 1003, 1004, 1005, 1006, 1007, 1008, 1009},
+		},
+		{
+			name:  "by status and type",
+			query: Query{Statuses: []v1.OperationStatus{v1.OperationStatus_STATUS_ERROR}, Types: []string{"prune"}},
+			want:  []int64{1001, 1003, 1005, 1007, 1009},
+		},
+		{
+			name:  "by text descending",
+			query: Query{Text: "locked", Descending: true, Limit: 2},
+			want:  []int64{1009, 1007},
+		},
+		{
+			name:  "by plan and time range",
+			query: Query{PlanId: "plan1", SinceUnixMs: 1002, UntilUnixMs: 1006},
+			want:  []int64{1002, 1004},
+		},
+		{
+			name:  "by repo descending",
+			query: Query{RepoId: "repo1", Descending: true, Limit: 3},
+			want:  []int64{1009, 1008, 1007},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ops, _, err := log.Query(tc.query)
+			if err != nil {
+				t.Fatalf("Query() error: %s", err)
+			}
+			var got []int64
+			for _, op := range ops {
+				got = append(got, op.UnixTimeStartMs)
+			}
+			if !slices.Equal(got, tc.want) {
+				t.Errorf("want operations %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestQueryPagination(t *testing.T) {
+	for _, backend := range testBackends {
+		t.Run(backend.name, func(t *testing.T) {
+			testQueryPagination(t, backend.open(t))
+		})
+	}
+}
+
+func testQueryPagination(t *testing.T, log *OpLog) {
+
+	for i := 0; i < 7; i++ {
+		if err := log.Add(&v1.Operation{
+			UnixTimeStartMs: int64(1000 + i),
+			RepoId:          "repo1",
+			PlanId:          "plan1",
+			Op:              &v1.Operation_OperationBackup{},
+		}); err != nil {
+			t.Fatalf("error adding operation: %s", err)
+		}
+	}
+
+	for _, descending := range []bool{false, true} {
+		for _, planId := range []string{"", "plan1"} {
+			var got []int64
+			var cursor int64
+			pages := 0
+			for {
+				ops, next, err := log.Query(Query{PlanId: planId, Descending: descending, Cursor: cursor, Limit: 3})
+				if err != nil {
+					t.Fatalf("Query() error: %s", err)
+				}
+				pages++
+				for _, op := range ops {
+					got = append(got, op.UnixTimeStartMs)
+				}
+				if next == 0 {
+					break
+				}
+				cursor = next
+			}
+
+			want := []int64{1000, 1001, 1002, 1003, 1004, 1005, 1006}
+			if descending {
+				want = []int64{1006, 1005, 1004, 1003, 1002, 1001, 1000}
+			}
+			if pages != 3 || !slices.Equal(got, want) {
+				t.Errorf("descending=%v plan=%q: want %v in 3 pages, got %v in %d pages", descending, planId, want, got, pages)
+			}
+		}
+	}
+}
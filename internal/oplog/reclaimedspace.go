@@ -0,0 +1,48 @@
+package oplog
+
+import (
+	"sort"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+)
+
+// ReclaimedSpace returns the space freed by the repo's successful prunes and the snapshots removed by its successful
+// forgets over time, ordered by the time the operations ended.
+func (o *OpLog) ReclaimedSpace(repoId string) (*v1.ReclaimedSpace, error) {
+	ops, _, err := o.Query(Query{RepoId: repoId})
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(ops, func(i, j int) bool { return ops[i].UnixTimeEndMs < ops[j].UnixTimeEndMs })
+
+	reclaimed := &v1.ReclaimedSpace{}
+	for _, op := range ops {
+		if op.Status != v1.OperationStatus_STATUS_SUCCESS && op.Status != v1.OperationStatus_STATUS_WARNING {
+			continue
+		}
+		point := &v1.ReclaimedSpacePoint{
+			UnixTimeMs:  op.UnixTimeEndMs,
+			OperationId: op.Id,
+		}
+		switch o := op.Op.(type) {
+		case *v1.Operation_OperationPrune:
+			if o.OperationPrune.GetStats() == nil {
+				continue
+			}
+			point.BytesFreed = o.OperationPrune.Stats.BytesFreed
+		case *v1.Operation_OperationForget:
+			if len(o.OperationForget.GetForget()) == 0 {
+				continue
+			}
+			point.SnapshotsRemoved = int64(len(o.OperationForget.Forget))
+		default:
+			continue
+		}
+		reclaimed.TotalBytesFreed += point.BytesFreed
+		reclaimed.TotalSnapshotsRemoved += point.SnapshotsRemoved
+		point.CumulativeBytesFreed = reclaimed.TotalBytesFreed
+		point.CumulativeSnapshotsRemoved = reclaimed.TotalSnapshotsRemoved
+		reclaimed.Points = append(reclaimed.Points, point)
+	}
+	return reclaimed, nil
+}
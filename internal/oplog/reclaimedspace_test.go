@@ -0,0 +1,57 @@
+package oplog
+
+import (
+	"testing"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+)
+
+func TestReclaimedSpace(t *testing.T) {
+	for _, backend := range testBackends {
+		t.Run(backend.name, func(t *testing.T) {
+			log := backend.open(t)
+
+			add := func(repoId string, endMs int64, status v1.OperationStatus, op *v1.Operation) {
+				op.PlanId = "plan1"
+				op.RepoId = repoId
+				op.Status = status
+				op.UnixTimeStartMs = endMs - 1000
+				op.UnixTimeEndMs = endMs
+				if err := log.Add(op); err != nil {
+					t.Fatalf("error adding operation: %s", err)
+				}
+			}
+			prune := func(bytesFreed int64) *v1.Operation {
+				return &v1.Operation{Op: &v1.Operation_OperationPrune{OperationPrune: &v1.OperationPrune{Stats: &v1.PruneStats{BytesFreed: bytesFreed}}}}
+			}
+			forget := func(n int) *v1.Operation {
+				f := &v1.OperationForget{}
+				for i := 0; i < n; i++ {
+					f.Forget = append(f.Forget, &v1.ResticSnapshot{Id: "snapshot"})
+				}
+				return &v1.Operation{Op: &v1.Operation_OperationForget{OperationForget: f}}
+			}
+			add("repo1", 4000, v1.OperationStatus_STATUS_SUCCESS, prune(100))
+			add("repo1", 2000, v1.OperationStatus_STATUS_SUCCESS, forget(3))
+			add("repo1", 6000, v1.OperationStatus_STATUS_ERROR, prune(1000))
+			add("repo1", 7000, v1.OperationStatus_STATUS_SUCCESS, forget(0))
+			add("repo1", 8000, v1.OperationStatus_STATUS_SUCCESS, prune(50))
+			add("repo2", 9000, v1.OperationStatus_STATUS_SUCCESS, prune(10000))
+
+			reclaimed, err := log.ReclaimedSpace("repo1")
+			if err != nil {
+				t.Fatalf("ReclaimedSpace() error: %v", err)
+			}
+			if reclaimed.TotalBytesFreed != 150 || reclaimed.TotalSnapshotsRemoved != 3 {
+				t.Errorf("want 150 bytes freed and 3 snapshots removed, got %v", reclaimed)
+			}
+			if len(reclaimed.Points) != 3 {
+				t.Fatalf("want a point per successful prune and forget removing snapshots, got %v", reclaimed.Points)
+			}
+			last := reclaimed.Points[2]
+			if reclaimed.Points[0].SnapshotsRemoved != 3 || last.UnixTimeMs != 8000 || last.CumulativeBytesFreed != 150 || last.CumulativeSnapshotsRemoved != 3 {
+				t.Errorf("want the points ordered by time with cumulative totals, got %v", reclaimed.Points)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package oplog
+
+import (
+	"sort"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+)
+
+// ResourceUsage totals the recorded resource usage of the plan's operations by operation type, ordered by type.
+// Failed operations are included, the resources they used were spent all the same.
+func (o *OpLog) ResourceUsage(planId string) ([]*v1.ResourceUsageSummary, error) {
+	ops, _, err := o.Query(Query{PlanId: planId})
+	if err != nil {
+		return nil, err
+	}
+
+	byType := make(map[string]*v1.ResourceUsageSummary)
+	uploadMs := make(map[string]int64) // duration of the operations that uploaded data by type.
+	for _, op := range ops {
+		usage := op.GetResourceUsage()
+		if usage == nil {
+			continue
+		}
+		opType := OperationType(op)
+		summary, ok := byType[opType]
+		if !ok {
+			summary = &v1.ResourceUsageSummary{OperationType: opType, Total: &v1.ResourceUsage{}}
+			byType[opType] = summary
+		}
+
+		var d int64
+		if op.UnixTimeEndMs > op.UnixTimeStartMs {
+			d = op.UnixTimeEndMs - op.UnixTimeStartMs
+		}
+		summary.Operations++
+		summary.DurationMs += d
+		addResourceUsage(summary.Total, usage)
+		if usage.UploadBytesEstimate > 0 {
+			uploadMs[opType] += d
+		}
+	}
+
+	summaries := make([]*v1.ResourceUsageSummary, 0, len(byType))
+	for opType, summary := range byType {
+		if ms := uploadMs[opType]; ms > 0 {
+			summary.UploadBytesPerSecEstimate = summary.Total.UploadBytesEstimate * 1000 / ms
+		}
+		summaries = append(summaries, summary)
+	}
+	sort.Slice(summaries, func(i, j int) bool { return summaries[i].OperationType < summaries[j].OperationType })
+	return summaries, nil
+}
+
+// addResourceUsage adds usage to the total, the peak memory is the largest of any operation.
+func addResourceUsage(total, usage *v1.ResourceUsage) {
+	total.Processes += usage.Processes
+	total.CpuUserMs += usage.CpuUserMs
+	total.CpuSystemMs += usage.CpuSystemMs
+	total.MaxRssBytes = max(total.MaxRssBytes, usage.MaxRssBytes)
+	total.ReadBytes += usage.ReadBytes
+	total.WriteBytes += usage.WriteBytes
+	total.UploadBytesEstimate += usage.UploadBytesEstimate
+}
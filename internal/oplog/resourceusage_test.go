@@ -0,0 +1,59 @@
+package oplog
+
+import (
+	"testing"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+)
+
+func TestResourceUsage(t *testing.T) {
+	for _, backend := range testBackends {
+		t.Run(backend.name, func(t *testing.T) {
+			log := backend.open(t)
+
+			add := func(planId string, startMs, endMs int64, usage *v1.ResourceUsage, op *v1.Operation) {
+				op.PlanId = planId
+				op.RepoId = "repo1"
+				op.Status = v1.OperationStatus_STATUS_SUCCESS
+				op.UnixTimeStartMs = startMs
+				op.UnixTimeEndMs = endMs
+				op.ResourceUsage = usage
+				if err := log.Add(op); err != nil {
+					t.Fatalf("error adding operation: %s", err)
+				}
+			}
+			backup := func() *v1.Operation {
+				return &v1.Operation{Op: &v1.Operation_OperationBackup{OperationBackup: &v1.OperationBackup{}}}
+			}
+			add("plan1", 0, 2000, &v1.ResourceUsage{Processes: 1, CpuUserMs: 100, MaxRssBytes: 50, ReadBytes: 1000, UploadBytesEstimate: 4000}, backup())
+			add("plan1", 10000, 12000, &v1.ResourceUsage{Processes: 2, CpuUserMs: 200, CpuSystemMs: 10, MaxRssBytes: 80, WriteBytes: 10, UploadBytesEstimate: 2000}, backup())
+			add("plan1", 20000, 21000, &v1.ResourceUsage{Processes: 1, CpuUserMs: 50}, backup())
+			add("plan1", 30000, 35000, nil, backup())
+			add("plan1", 40000, 45000, &v1.ResourceUsage{Processes: 1, CpuUserMs: 1000}, &v1.Operation{Op: &v1.Operation_OperationPrune{OperationPrune: &v1.OperationPrune{}}})
+			add("plan2", 50000, 51000, &v1.ResourceUsage{Processes: 1, CpuUserMs: 1}, backup())
+
+			summaries, err := log.ResourceUsage("plan1")
+			if err != nil {
+				t.Fatalf("ResourceUsage() error: %v", err)
+			}
+			if len(summaries) != 2 || summaries[0].OperationType != "backup" || summaries[1].OperationType != "prune" {
+				t.Fatalf("want backup and prune summaries, got %v", summaries)
+			}
+			backups := summaries[0]
+			if backups.Operations != 3 || backups.DurationMs != 5000 {
+				t.Errorf("want 3 backups taking 5000ms, got %v", backups)
+			}
+			total := backups.Total
+			if total.Processes != 4 || total.CpuUserMs != 350 || total.CpuSystemMs != 10 || total.MaxRssBytes != 80 ||
+				total.ReadBytes != 1000 || total.WriteBytes != 10 || total.UploadBytesEstimate != 6000 {
+				t.Errorf("got backup totals %v", total)
+			}
+			if backups.UploadBytesPerSecEstimate != 1500 {
+				t.Errorf("want 1500 bytes/sec uploaded over the backups that uploaded data, got %d", backups.UploadBytesPerSecEstimate)
+			}
+			if summaries[1].Total.CpuUserMs != 1000 || summaries[1].UploadBytesPerSecEstimate != 0 {
+				t.Errorf("got prune summary %v", summaries[1])
+			}
+		})
+	}
+}
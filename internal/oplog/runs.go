@@ -0,0 +1,92 @@
+package oplog
+
+import (
+	"sort"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+)
+
+// RunId returns the ID of the run the operation is part of, operations recorded before runs are a run of their own.
+func RunId(op *v1.Operation) int64 {
+	if op.RunId != 0 {
+		return op.RunId
+	}
+	return op.Id
+}
+
+// statusSeverity orders the statuses of a completed run's operations, the most severe is the run's status.
+var statusSeverity = map[v1.OperationStatus]int{
+	v1.OperationStatus_STATUS_SUCCESS:          1,
+	v1.OperationStatus_STATUS_USER_CANCELLED:   2,
+	v1.OperationStatus_STATUS_SYSTEM_CANCELLED: 3,
+	v1.OperationStatus_STATUS_WARNING:          4,
+	v1.OperationStatus_STATUS_ERROR:            5,
+}
+
+// GroupRuns groups the operations into runs ordered by the ID of their first operation.
+func GroupRuns(ops []*v1.Operation) []*v1.Run {
+	byId := make(map[int64]*v1.Run)
+	var runs []*v1.Run
+	for _, op := range ops {
+		id := RunId(op)
+		run, ok := byId[id]
+		if !ok {
+			run = &v1.Run{Id: id, PlanId: op.PlanId, RepoId: op.RepoId}
+			byId[id] = run
+			runs = append(runs, run)
+		}
+		run.Operations = append(run.Operations, op)
+	}
+
+	sort.Slice(runs, func(i, j int) bool { return runs[i].Id < runs[j].Id })
+	for _, run := range runs {
+		sort.Slice(run.Operations, func(i, j int) bool { return run.Operations[i].Id < run.Operations[j].Id })
+		summarizeRun(run)
+	}
+	return runs
+}
+
+// summarizeRun sets the run's status and times from its operations, a run with some operations still pending is in
+// progress as its earlier operations scheduled them. A done run is partial if some of its backups, e.g. to a plan's
+// different repos, succeeded and others failed.
+func summarizeRun(run *v1.Run) {
+	var pending, inProgress, done, backedUp, backupFailed bool
+	for _, op := range run.Operations {
+		if run.UnixTimeStartMs == 0 || op.UnixTimeStartMs < run.UnixTimeStartMs {
+			run.UnixTimeStartMs = op.UnixTimeStartMs
+		}
+		if op.UnixTimeEndMs > run.UnixTimeEndMs {
+			run.UnixTimeEndMs = op.UnixTimeEndMs
+		}
+		switch op.Status {
+		case v1.OperationStatus_STATUS_PENDING:
+			pending = true
+		case v1.OperationStatus_STATUS_INPROGRESS:
+			inProgress = true
+		default:
+			done = true
+			if statusSeverity[op.Status] > statusSeverity[run.Status] {
+				run.Status = op.Status
+			}
+			if op.GetOperationBackup() != nil {
+				switch op.Status {
+				case v1.OperationStatus_STATUS_SUCCESS, v1.OperationStatus_STATUS_WARNING:
+					backedUp = true
+				case v1.OperationStatus_STATUS_ERROR:
+					backupFailed = true
+				}
+			}
+		}
+	}
+
+	switch {
+	case inProgress || (pending && done):
+		run.Status = v1.OperationStatus_STATUS_INPROGRESS
+		run.UnixTimeEndMs = 0
+	case pending:
+		run.Status = v1.OperationStatus_STATUS_PENDING
+		run.UnixTimeEndMs = 0
+	default:
+		run.Partial = backedUp && backupFailed
+	}
+}
@@ -0,0 +1,90 @@
+package oplog
+
+import (
+	"testing"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+)
+
+func TestGroupRuns(t *testing.T) {
+	ops := []*v1.Operation{
+		{Id: 1, PlanId: "plan1", RepoId: "repo1", RunId: 1, Status: v1.OperationStatus_STATUS_SUCCESS, UnixTimeStartMs: 100, UnixTimeEndMs: 200},
+		{Id: 2, PlanId: "plan1", RepoId: "repo1", RunId: 1, Status: v1.OperationStatus_STATUS_ERROR, UnixTimeStartMs: 200, UnixTimeEndMs: 300},
+		{Id: 4, PlanId: "plan1", RepoId: "repo1", Status: v1.OperationStatus_STATUS_SUCCESS, UnixTimeStartMs: 500, UnixTimeEndMs: 600}, // recorded before runs
+		{Id: 3, PlanId: "plan1", RepoId: "repo1", RunId: 1, Status: v1.OperationStatus_STATUS_WARNING, UnixTimeStartMs: 300, UnixTimeEndMs: 400},
+		{Id: 5, PlanId: "plan1", RepoId: "repo1", RunId: 5, Status: v1.OperationStatus_STATUS_SUCCESS, UnixTimeStartMs: 700, UnixTimeEndMs: 800},
+		{Id: 6, PlanId: "plan1", RepoId: "repo1", RunId: 5, Status: v1.OperationStatus_STATUS_PENDING, UnixTimeStartMs: 800},
+		{Id: 7, PlanId: "plan1", RepoId: "repo1", RunId: 7, Status: v1.OperationStatus_STATUS_PENDING, UnixTimeStartMs: 900},
+	}
+
+	runs := GroupRuns(ops)
+	want := []struct {
+		id     int64
+		ops    []int64
+		status v1.OperationStatus
+		start  int64
+		end    int64
+	}{
+		{id: 1, ops: []int64{1, 2, 3}, status: v1.OperationStatus_STATUS_ERROR, start: 100, end: 400},
+		{id: 4, ops: []int64{4}, status: v1.OperationStatus_STATUS_SUCCESS, start: 500, end: 600},
+		{id: 5, ops: []int64{5, 6}, status: v1.OperationStatus_STATUS_INPROGRESS, start: 700, end: 0},
+		{id: 7, ops: []int64{7}, status: v1.OperationStatus_STATUS_PENDING, start: 900, end: 0},
+	}
+	if len(runs) != len(want) {
+		t.Fatalf("want %d runs, got %d", len(want), len(runs))
+	}
+	for i, w := range want {
+		run := runs[i]
+		if run.Id != w.id || run.Status != w.status || run.UnixTimeStartMs != w.start || run.UnixTimeEndMs != w.end {
+			t.Errorf("run %d: got id %d status %v times %d-%d, want id %d status %v times %d-%d",
+				i, run.Id, run.Status, run.UnixTimeStartMs, run.UnixTimeEndMs, w.id, w.status, w.start, w.end)
+		}
+		var ids []int64
+		for _, op := range run.Operations {
+			ids = append(ids, op.Id)
+		}
+		if len(ids) != len(w.ops) {
+			t.Errorf("run %d: got operations %v, want %v", run.Id, ids, w.ops)
+			continue
+		}
+		for j := range ids {
+			if ids[j] != w.ops[j] {
+				t.Errorf("run %d: got operations %v, want %v", run.Id, ids, w.ops)
+				break
+			}
+		}
+		if run.PlanId != "plan1" || run.RepoId != "repo1" {
+			t.Errorf("run %d: got plan %q repo %q", run.Id, run.PlanId, run.RepoId)
+		}
+	}
+}
+
+func TestGroupRunsPartial(t *testing.T) {
+	backup := func(id int64, repo string, status v1.OperationStatus) *v1.Operation {
+		return &v1.Operation{Id: id, PlanId: "plan1", RepoId: repo, RunId: 1, Status: status, Op: &v1.Operation_OperationBackup{OperationBackup: &v1.OperationBackup{}}}
+	}
+	forget := &v1.Operation{Id: 3, PlanId: "plan1", RepoId: "repo1", RunId: 1, Status: v1.OperationStatus_STATUS_ERROR, Op: &v1.Operation_OperationForget{}}
+
+	tcs := []struct {
+		name    string
+		ops     []*v1.Operation
+		partial bool
+	}{
+		{name: "all repos backed up", ops: []*v1.Operation{backup(1, "repo1", v1.OperationStatus_STATUS_SUCCESS), backup(2, "repo2", v1.OperationStatus_STATUS_WARNING)}},
+		{name: "one repo failed", ops: []*v1.Operation{backup(1, "repo1", v1.OperationStatus_STATUS_SUCCESS), backup(2, "repo2", v1.OperationStatus_STATUS_ERROR)}, partial: true},
+		{name: "all repos failed", ops: []*v1.Operation{backup(1, "repo1", v1.OperationStatus_STATUS_ERROR), backup(2, "repo2", v1.OperationStatus_STATUS_ERROR)}},
+		{name: "only a followup failed", ops: []*v1.Operation{backup(1, "repo1", v1.OperationStatus_STATUS_SUCCESS), forget}},
+		{name: "in progress", ops: []*v1.Operation{backup(1, "repo1", v1.OperationStatus_STATUS_ERROR), backup(2, "repo2", v1.OperationStatus_STATUS_SUCCESS), backup(4, "repo3", v1.OperationStatus_STATUS_PENDING)}},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			runs := GroupRuns(tc.ops)
+			if len(runs) != 1 {
+				t.Fatalf("want 1 run, got %d", len(runs))
+			}
+			if runs[0].Partial != tc.partial {
+				t.Errorf("got partial %v, want %v", runs[0].Partial, tc.partial)
+			}
+		})
+	}
+}
@@ -0,0 +1,529 @@
+package oplog
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/oplog/indexutil"
+	"github.com/garethgeorge/backrest/internal/oplog/serializationutil"
+	"github.com/garethgeorge/backrest/internal/protoutil"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+	_ "modernc.org/sqlite"
+)
+
+// sqliteSchema stores each operation as a serialized proto alongside the columns it is queried by, the columns are
+// kept in sync with the proto so the history can also be inspected with the sqlite3 shell.
+const sqliteSchema = `
+CREATE TABLE IF NOT EXISTS operations (
+	id INTEGER PRIMARY KEY,
+	repo_id TEXT NOT NULL,
+	plan_id TEXT NOT NULL,
+	snapshot_id TEXT NOT NULL,
+	status INTEGER NOT NULL,
+	type TEXT NOT NULL,
+	unix_time_start_ms INTEGER NOT NULL,
+	display_message TEXT NOT NULL,
+	operation BLOB NOT NULL
+);
+CREATE INDEX IF NOT EXISTS operations_repo_id ON operations (repo_id, id);
+CREATE INDEX IF NOT EXISTS operations_plan_id ON operations (plan_id, id);
+CREATE INDEX IF NOT EXISTS operations_snapshot_id ON operations (snapshot_id, id);
+CREATE INDEX IF NOT EXISTS operations_unix_time_start_ms ON operations (unix_time_start_ms);
+CREATE TABLE IF NOT EXISTS quarantine (
+	id INTEGER PRIMARY KEY,
+	operation BLOB NOT NULL
+);
+CREATE TABLE IF NOT EXISTS sequence (
+	name TEXT PRIMARY KEY,
+	value INTEGER NOT NULL
+);
+`
+
+// sqliteScanPageSize is the number of operations read per query when iterating the whole log.
+const sqliteScanPageSize = 1000
+
+// SqliteStore is an OpStore persisting operations in a SQLite database.
+type SqliteStore struct {
+	db *sql.DB
+}
+
+var _ OpStore = (*SqliteStore)(nil)
+
+func NewSqliteStore(databasePath string) (*SqliteStore, error) {
+	if err := os.MkdirAll(path.Dir(databasePath), 0700); err != nil {
+		return nil, fmt.Errorf("error creating database directory: %s", err)
+	}
+
+	dsn := "file:" + databasePath + "?" + url.Values{
+		"_pragma": []string{"busy_timeout(5000)", "journal_mode(WAL)", "synchronous(NORMAL)"},
+	}.Encode()
+	db, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("error opening database: %s", err)
+	}
+	// a single connection serializes writers, sqlite only supports one writer at a time.
+	db.SetMaxOpenConns(1)
+
+	if _, err := db.Exec(sqliteSchema); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("creating schema: %w", err)
+	}
+
+	return &SqliteStore{db: db}, nil
+}
+
+func (s *SqliteStore) CheckIntegrity(repair bool, report *IntegrityReport) error {
+	var problems []string
+	rows, err := s.db.Query("PRAGMA integrity_check")
+	if err != nil {
+		return fmt.Errorf("checking database integrity: %w", err)
+	}
+	for rows.Next() {
+		var problem string
+		if err := rows.Scan(&problem); err != nil {
+			rows.Close()
+			return fmt.Errorf("reading integrity check: %w", err)
+		}
+		if problem != "ok" {
+			problems = append(problems, problem)
+		}
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("reading integrity check: %w", err)
+	}
+	if len(problems) > 0 {
+		zap.L().Warn("sqlite oplog integrity check failed", zap.Strings("problems", problems))
+		report.DanglingIndexEntries += len(problems)
+	}
+
+	// the columns queries filter on are the sqlite store's index entries, they are stale if they differ from the record.
+	var stale []*v1.Operation
+	var after int64
+	for {
+		var n int
+		rows, err := s.db.Query(`SELECT id, repo_id, plan_id, snapshot_id, status, type, unix_time_start_ms, display_message, operation
+			FROM operations WHERE id > ? ORDER BY id LIMIT ?`, after, sqliteScanPageSize)
+		if err != nil {
+			return fmt.Errorf("querying operations: %w", err)
+		}
+		for rows.Next() {
+			var id, startMs int64
+			var status int32
+			var repoId, planId, snapshotId, opType, displayMessage string
+			var bytes []byte
+			if err := rows.Scan(&id, &repoId, &planId, &snapshotId, &status, &opType, &startMs, &displayMessage, &bytes); err != nil {
+				rows.Close()
+				return fmt.Errorf("reading operation: %w", err)
+			}
+			n++
+			after = id
+
+			op, err := readOperationRecord(serializationutil.Itob(id), bytes)
+			if err != nil {
+				zap.L().Warn("corrupt operation in oplog", zap.Int64("id", id), zap.Error(err))
+				report.CorruptOperations = append(report.CorruptOperations, id)
+				continue
+			}
+			staleColumns := 0
+			for _, match := range []bool{
+				repoId == op.RepoId, planId == op.PlanId, snapshotId == op.SnapshotId, status == int32(op.Status),
+				opType == OperationType(op), startMs == op.UnixTimeStartMs, displayMessage == op.DisplayMessage,
+			} {
+				if !match {
+					staleColumns++
+				}
+			}
+			if staleColumns > 0 {
+				report.DanglingIndexEntries += staleColumns
+				stale = append(stale, op)
+			}
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return fmt.Errorf("reading operations: %w", err)
+		}
+		if n < sqliteScanPageSize {
+			break
+		}
+	}
+
+	if !repair {
+		return nil
+	}
+	return s.withTx(func(tx *sql.Tx) error {
+		if len(problems) > 0 {
+			if _, err := tx.Exec("REINDEX"); err != nil {
+				return fmt.Errorf("rebuilding indices: %w", err)
+			}
+		}
+		for _, op := range stale {
+			if _, err := tx.Exec("DELETE FROM operations WHERE id = ?", op.Id); err != nil {
+				return fmt.Errorf("removing stale operation %v: %w", op.Id, err)
+			}
+			if err := insertOperation(tx, op); err != nil {
+				return fmt.Errorf("rewriting stale operation %v: %w", op.Id, err)
+			}
+		}
+		for _, id := range report.CorruptOperations {
+			if _, err := tx.Exec("INSERT OR REPLACE INTO quarantine (id, operation) SELECT id, operation FROM operations WHERE id = ?", id); err != nil {
+				return fmt.Errorf("quarantining corrupt operation %v: %w", id, err)
+			}
+			if _, err := tx.Exec("DELETE FROM operations WHERE id = ?", id); err != nil {
+				return fmt.Errorf("removing corrupt operation %v: %w", id, err)
+			}
+		}
+		return nil
+	})
+}
+
+func (s *SqliteStore) Close() error {
+	return s.db.Close()
+}
+
+func (s *SqliteStore) WriteTo(w io.Writer) (int64, error) {
+	dir, err := os.MkdirTemp("", "backrest-oplog-")
+	if err != nil {
+		return 0, fmt.Errorf("creating temp dir: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	snapshotPath := path.Join(dir, "oplog.sqlite")
+	if _, err := s.db.Exec("VACUUM INTO ?", snapshotPath); err != nil {
+		return 0, fmt.Errorf("copying database: %w", err)
+	}
+
+	f, err := os.Open(snapshotPath)
+	if err != nil {
+		return 0, fmt.Errorf("opening database copy: %w", err)
+	}
+	defer f.Close()
+	return io.Copy(w, f)
+}
+
+func (s *SqliteStore) Add(ops ...*v1.Operation) error {
+	return s.withTx(func(tx *sql.Tx) error {
+		for _, op := range ops {
+			if op.Id == 0 {
+				seq, err := nextSequence(tx)
+				if err != nil {
+					return fmt.Errorf("create next operation ID: %w", err)
+				}
+				op.Id = nextOperationId(time.Now().UnixMilli(), seq)
+			}
+			if err := insertOperation(tx, op); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (s *SqliteStore) Update(op *v1.Operation) (*v1.Operation, error) {
+	var oldOp *v1.Operation
+	err := s.withTx(func(tx *sql.Tx) error {
+		var err error
+		oldOp, err = getOperation(tx, op.Id)
+		if err != nil {
+			return fmt.Errorf("getting existing value prior to update: %w", err)
+		}
+		if _, err := tx.Exec("DELETE FROM operations WHERE id = ?", op.Id); err != nil {
+			return fmt.Errorf("deleting existing value prior to update: %w", err)
+		}
+		if err := insertOperation(tx, op); err != nil {
+			return fmt.Errorf("adding updated value: %w", err)
+		}
+		return nil
+	})
+	return oldOp, err
+}
+
+func (s *SqliteStore) Delete(ids ...int64) ([]*v1.Operation, error) {
+	removedOps := make([]*v1.Operation, 0, len(ids))
+	err := s.withTx(func(tx *sql.Tx) error {
+		for _, id := range ids {
+			removed, err := getOperation(tx, id)
+			if err != nil {
+				return fmt.Errorf("deleting operation %v: %w", id, err)
+			}
+			if _, err := tx.Exec("DELETE FROM operations WHERE id = ?", id); err != nil {
+				return fmt.Errorf("deleting operation %v: %w", id, err)
+			}
+			removedOps = append(removedOps, removed)
+		}
+		return nil
+	})
+	return removedOps, err
+}
+
+func (s *SqliteStore) Get(id int64) (*v1.Operation, error) {
+	return getOperation(s.db, id)
+}
+
+func (s *SqliteStore) ForEach(field IndexField, value string, collector indexutil.Collector, do func(op *v1.Operation) error) error {
+	switch field {
+	case IndexRepoId, IndexPlanId, IndexSnapshotId:
+	default:
+		return fmt.Errorf("unknown index field %q", field)
+	}
+
+	// the IDs are read before visiting any operation so that do may use the store, the pool only has one connection.
+	ids, err := s.queryIds("SELECT id FROM operations WHERE "+string(field)+" = ? ORDER BY id", value)
+	if err != nil {
+		return err
+	}
+	for _, id := range collector(indexutil.NewSliceIterator(ids)) {
+		op, err := s.Get(id)
+		if err != nil {
+			return err
+		}
+		if err := do(op); err != nil {
+			if err == ErrStopIteration {
+				break
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// ForAll visits every operation, records that fail to unmarshal are logged and skipped.
+func (s *SqliteStore) ForAll(do func(op *v1.Operation) error) error {
+	var after int64
+	for {
+		ops, n, last, err := s.scanPage(after)
+		if err != nil {
+			return err
+		}
+		for _, op := range ops {
+			if err := do(op); err != nil {
+				if err == ErrStopIteration {
+					return nil
+				}
+				return err
+			}
+		}
+		if n < sqliteScanPageSize {
+			return nil
+		}
+		after = last
+	}
+}
+
+// scanPage reads the next page of operations after the given ID, returning the number of rows read and the last ID read.
+func (s *SqliteStore) scanPage(after int64) ([]*v1.Operation, int, int64, error) {
+	rows, err := s.db.Query("SELECT id, operation FROM operations WHERE id > ? ORDER BY id LIMIT ?", after, sqliteScanPageSize)
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("querying operations: %w", err)
+	}
+	defer rows.Close()
+
+	var ops []*v1.Operation
+	n := 0
+	for rows.Next() {
+		var bytes []byte
+		if err := rows.Scan(&after, &bytes); err != nil {
+			return nil, 0, 0, fmt.Errorf("reading operation: %w", err)
+		}
+		n++
+		op := &v1.Operation{}
+		if err := proto.Unmarshal(bytes, op); err != nil {
+			zap.L().Error("error unmarshalling operation, there may be corruption in the oplog", zap.Error(err))
+			continue
+		}
+		ops = append(ops, op)
+	}
+	return ops, n, after, rows.Err()
+}
+
+func (s *SqliteStore) Query(q Query) ([]*v1.Operation, int64, error) {
+	var where []string
+	var args []any
+	addFilter := func(clause string, values ...any) {
+		where = append(where, clause)
+		args = append(args, values...)
+	}
+
+	if q.RepoId != "" {
+		addFilter("repo_id = ?", q.RepoId)
+	}
+	if q.PlanId != "" {
+		addFilter("plan_id = ?", q.PlanId)
+	}
+	if q.SnapshotId != "" {
+		addFilter("snapshot_id = ?", q.SnapshotId)
+	}
+	if len(q.Statuses) > 0 {
+		var values []any
+		for _, status := range q.Statuses {
+			values = append(values, int32(status))
+		}
+		addFilter("status IN ("+placeholders(len(values))+")", values...)
+	}
+	if len(q.Types) > 0 {
+		var values []any
+		for _, t := range q.Types {
+			values = append(values, t)
+		}
+		addFilter("type IN ("+placeholders(len(values))+")", values...)
+	}
+	if q.SinceUnixMs != 0 {
+		addFilter("unix_time_start_ms >= ?", q.SinceUnixMs)
+	}
+	if q.UntilUnixMs != 0 {
+		addFilter("unix_time_start_ms < ?", q.UntilUnixMs)
+	}
+	if q.Text != "" {
+		addFilter("instr(lower(display_message), lower(?)) > 0", q.Text)
+	}
+	order := "ASC"
+	if q.Descending {
+		order = "DESC"
+	}
+	if q.Cursor != 0 {
+		if q.Descending {
+			addFilter("id < ?", q.Cursor)
+		} else {
+			addFilter("id > ?", q.Cursor)
+		}
+	}
+
+	stmt := "SELECT operation FROM operations"
+	if len(where) > 0 {
+		stmt += " WHERE " + strings.Join(where, " AND ")
+	}
+	stmt += " ORDER BY id " + order
+	if q.Limit > 0 {
+		// one extra row tells whether there is another page.
+		stmt += " LIMIT ?"
+		args = append(args, q.Limit+1)
+	}
+
+	ops, err := s.queryOps(stmt, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var cursor int64
+	if q.Limit > 0 && len(ops) > q.Limit {
+		ops = ops[:q.Limit]
+		cursor = ops[len(ops)-1].Id
+	}
+	return ops, cursor, nil
+}
+
+func (s *SqliteStore) withTx(f func(tx *sql.Tx) error) error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("beginning transaction: %w", err)
+	}
+	if err := f(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+	return nil
+}
+
+func (s *SqliteStore) queryIds(stmt string, args ...any) ([]int64, error) {
+	rows, err := s.db.Query(stmt, args...)
+	if err != nil {
+		return nil, fmt.Errorf("querying operations: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("reading operation ID: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+func (s *SqliteStore) queryOps(stmt string, args ...any) ([]*v1.Operation, error) {
+	rows, err := s.db.Query(stmt, args...)
+	if err != nil {
+		return nil, fmt.Errorf("querying operations: %w", err)
+	}
+	defer rows.Close()
+
+	var ops []*v1.Operation
+	for rows.Next() {
+		var bytes []byte
+		if err := rows.Scan(&bytes); err != nil {
+			return nil, fmt.Errorf("reading operation: %w", err)
+		}
+		op := &v1.Operation{}
+		if err := proto.Unmarshal(bytes, op); err != nil {
+			return nil, fmt.Errorf("error unmarshalling operation: %w", err)
+		}
+		ops = append(ops, op)
+	}
+	return ops, rows.Err()
+}
+
+// queryRower is implemented by both *sql.DB and *sql.Tx.
+type queryRower interface {
+	QueryRow(query string, args ...any) *sql.Row
+}
+
+func getOperation(db queryRower, id int64) (*v1.Operation, error) {
+	var bytes []byte
+	if err := db.QueryRow("SELECT operation FROM operations WHERE id = ?", id).Scan(&bytes); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("opid %v: %w", id, ErrNotExist)
+		}
+		return nil, fmt.Errorf("getting operation %v: %w", id, err)
+	}
+
+	var op v1.Operation
+	if err := proto.Unmarshal(bytes, &op); err != nil {
+		return nil, fmt.Errorf("error unmarshalling operation: %w", err)
+	}
+	return &op, nil
+}
+
+func insertOperation(tx *sql.Tx, op *v1.Operation) error {
+	if err := protoutil.ValidateOperation(op); err != nil {
+		return fmt.Errorf("validating operation: %w", err)
+	}
+
+	bytes, err := proto.Marshal(op)
+	if err != nil {
+		return fmt.Errorf("error marshalling operation: %w", err)
+	}
+
+	if _, err := tx.Exec(`INSERT INTO operations (id, repo_id, plan_id, snapshot_id, status, type, unix_time_start_ms, display_message, operation)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		op.Id, op.RepoId, op.PlanId, op.SnapshotId, int32(op.Status), OperationType(op), op.UnixTimeStartMs, op.DisplayMessage, bytes); err != nil {
+		return fmt.Errorf("error inserting operation: %w", err)
+	}
+	return nil
+}
+
+func nextSequence(tx *sql.Tx) (uint64, error) {
+	var seq uint64
+	if err := tx.QueryRow(`INSERT INTO sequence (name, value) VALUES ('operations', 1)
+		ON CONFLICT (name) DO UPDATE SET value = value + 1 RETURNING value`).Scan(&seq); err != nil {
+		return 0, fmt.Errorf("next sequence: %w", err)
+	}
+	return seq, nil
+}
+
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+}
@@ -0,0 +1,121 @@
+package oplog
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"testing"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+)
+
+func TestSqliteStore(t *testing.T) {
+	t.Parallel()
+	dbPath := t.TempDir() + "/test.sqlite"
+	store, err := NewSqliteStore(dbPath)
+	if err != nil {
+		t.Fatalf("error creating sqlite store: %s", err)
+	}
+	log := NewOpLogWithStore(store)
+
+	var ops []*v1.Operation
+	for i := 0; i < 5; i++ {
+		op := &v1.Operation{
+			UnixTimeStartMs: int64(1000 + i),
+			PlanId:          "plan1",
+			RepoId:          "repo1",
+			Op:              &v1.Operation_OperationBackup{},
+		}
+		if i == 4 {
+			op.SnapshotId = snapshotId
+		}
+		ops = append(ops, op)
+	}
+	if err := log.BulkAdd(ops); err != nil {
+		t.Fatalf("error adding operations: %s", err)
+	}
+	for i := 1; i < len(ops); i++ {
+		if ops[i].Id <= ops[i-1].Id {
+			t.Errorf("want increasing operation IDs, got %d after %d", ops[i].Id, ops[i-1].Id)
+		}
+	}
+
+	countByPlanHelper(t, log, "plan1", 5)
+	countByRepoHelper(t, log, "repo1", 5)
+	countBySnapshotIdHelper(t, log, snapshotId, 1)
+
+	ops[0].PlanId = "plan2"
+	if err := log.Update(ops[0]); err != nil {
+		t.Fatalf("error updating operation: %s", err)
+	}
+	countByPlanHelper(t, log, "plan1", 4)
+	countByPlanHelper(t, log, "plan2", 1)
+
+	if err := log.Delete(ops[1].Id); err != nil {
+		t.Fatalf("error deleting operation: %s", err)
+	}
+	if _, err := log.Get(ops[1].Id); !errors.Is(err, ErrNotExist) {
+		t.Errorf("want ErrNotExist for deleted operation, got %v", err)
+	}
+	if err := log.Update(ops[1]); !errors.Is(err, ErrNotExist) {
+		t.Errorf("want ErrNotExist updating deleted operation, got %v", err)
+	}
+
+	// the log persists across restarts and IDs continue from the stored sequence.
+	if err := log.Close(); err != nil {
+		t.Fatalf("error closing oplog: %s", err)
+	}
+	store, err = NewSqliteStore(dbPath)
+	if err != nil {
+		t.Fatalf("error reopening sqlite store: %s", err)
+	}
+	log = NewOpLogWithStore(store)
+	t.Cleanup(func() { log.Close() })
+
+	got, err := log.Get(ops[2].Id)
+	if err != nil {
+		t.Fatalf("error getting operation: %s", err)
+	}
+	if got.UnixTimeStartMs != ops[2].UnixTimeStartMs {
+		t.Errorf("want operation start time %d, got %d", ops[2].UnixTimeStartMs, got.UnixTimeStartMs)
+	}
+
+	op := &v1.Operation{UnixTimeStartMs: 2000, PlanId: "plan1", RepoId: "repo1", Op: &v1.Operation_OperationBackup{}}
+	if err := log.Add(op); err != nil {
+		t.Fatalf("error adding operation: %s", err)
+	}
+	if op.Id&((1<<20)-1) != 6 {
+		t.Errorf("want sequence number 6 after reopening, got %d", op.Id&((1<<20)-1))
+	}
+}
+
+func TestSqliteStoreWriteTo(t *testing.T) {
+	t.Parallel()
+	store, err := NewSqliteStore(t.TempDir() + "/test.sqlite")
+	if err != nil {
+		t.Fatalf("error creating sqlite store: %s", err)
+	}
+	log := NewOpLogWithStore(store)
+	t.Cleanup(func() { log.Close() })
+
+	if err := log.Add(&v1.Operation{UnixTimeStartMs: 1234, PlanId: "plan1", RepoId: "repo1", Op: &v1.Operation_OperationBackup{}}); err != nil {
+		t.Fatalf("error adding operation: %s", err)
+	}
+
+	var buf bytes.Buffer
+	if _, err := log.WriteTo(&buf); err != nil {
+		t.Fatalf("WriteTo() error: %s", err)
+	}
+
+	copyPath := t.TempDir() + "/copy.sqlite"
+	if err := os.WriteFile(copyPath, buf.Bytes(), 0600); err != nil {
+		t.Fatalf("error writing copy: %s", err)
+	}
+	copyStore, err := NewSqliteStore(copyPath)
+	if err != nil {
+		t.Fatalf("error opening copy: %s", err)
+	}
+	copyLog := NewOpLogWithStore(copyStore)
+	t.Cleanup(func() { copyLog.Close() })
+	countByPlanHelper(t, copyLog, "plan1", 1)
+}
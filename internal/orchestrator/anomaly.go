@@ -0,0 +1,101 @@
+package orchestrator
+
+import (
+	"fmt"
+	"slices"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/oplog"
+	"github.com/garethgeorge/backrest/internal/oplog/indexutil"
+)
+
+const (
+	defaultAnomalyDataAddedFactor     = 100
+	defaultAnomalyChangedFilesPercent = 90
+	defaultAnomalyBaselineBackups     = 10
+	defaultAnomalyMinDataAddedBytes   = 100 * 1000 * 1000
+	minAnomalyBaselineBackups         = 3 // fewer backups are too few to tell what is normal for the plan.
+)
+
+// anomalyBaseline returns the summaries of up to n of the plan's most recent completed backups to the repo, backups
+// that were anomalous themselves are left out so a run of anomalous backups doesn't become the norm.
+func anomalyBaseline(log *oplog.OpLog, planId, repoId string, n int) ([]*v1.BackupProgressSummary, error) {
+	var baseline []*v1.BackupProgressSummary
+	err := log.ForEachByPlan(planId, indexutil.Reversed(indexutil.CollectAll()), func(op *v1.Operation) error {
+		backup := op.GetOperationBackup()
+		if backup == nil || backup.Anomaly != nil || op.RepoId != repoId ||
+			(op.Status != v1.OperationStatus_STATUS_SUCCESS && op.Status != v1.OperationStatus_STATUS_WARNING) {
+			return nil
+		}
+		if summary := backup.GetLastStatus().GetSummary(); summary != nil {
+			baseline = append(baseline, summary)
+		}
+		if len(baseline) >= n {
+			return oplog.ErrStopIteration
+		}
+		return nil
+	})
+	return baseline, err
+}
+
+// detectAnomaly compares the backup's summary with the baseline of previous backups, it returns nil if the backup
+// isn't anomalous or the baseline is too short.
+func detectAnomaly(cfg *v1.AnomalyDetection, summary *v1.BackupProgressSummary, baseline []*v1.BackupProgressSummary) *v1.BackupAnomaly {
+	if len(baseline) < minAnomalyBaselineBackups || summary == nil {
+		return nil
+	}
+	factor := cfg.GetDataAddedFactor()
+	if factor <= 0 {
+		factor = defaultAnomalyDataAddedFactor
+	}
+	changedThreshold := float64(cfg.GetChangedFilesPercent())
+	if changedThreshold <= 0 {
+		changedThreshold = defaultAnomalyChangedFilesPercent
+	}
+	minBytes := cfg.GetMinDataAddedBytes()
+	if minBytes <= 0 {
+		minBytes = defaultAnomalyMinDataAddedBytes
+	}
+
+	dataAdded := make([]int64, 0, len(baseline))
+	changed := make([]float64, 0, len(baseline))
+	for _, s := range baseline {
+		dataAdded = append(dataAdded, s.DataAdded)
+		changed = append(changed, changedFilesPercent(s))
+	}
+	anomaly := &v1.BackupAnomaly{
+		BaselineDataAdded:   median(dataAdded),
+		ChangedFilesPercent: changedFilesPercent(summary),
+	}
+
+	if summary.DataAdded >= minBytes && float64(summary.DataAdded) >= factor*float64(anomaly.BaselineDataAdded) {
+		anomaly.Reasons = append(anomaly.Reasons, fmt.Sprintf("added %d bytes, %.0fx the median of %d bytes added by the last %d backups",
+			summary.DataAdded, float64(summary.DataAdded)/float64(max(anomaly.BaselineDataAdded, 1)), anomaly.BaselineDataAdded, len(baseline)))
+	}
+	if baselineChanged := median(changed); anomaly.ChangedFilesPercent >= changedThreshold && baselineChanged < changedThreshold {
+		anomaly.Reasons = append(anomaly.Reasons, fmt.Sprintf("changed %.0f%% of the existing files, the last %d backups changed a median of %.0f%%",
+			anomaly.ChangedFilesPercent, len(baseline), baselineChanged))
+	}
+	if len(anomaly.Reasons) == 0 {
+		return nil
+	}
+	return anomaly
+}
+
+// changedFilesPercent returns the percentage of the files that existed before the backup that it found changed.
+func changedFilesPercent(s *v1.BackupProgressSummary) float64 {
+	existing := s.FilesChanged + s.FilesUnmodified
+	if existing == 0 {
+		return 0
+	}
+	return 100 * float64(s.FilesChanged) / float64(existing)
+}
+
+func median[T int64 | float64](values []T) T {
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+	if len(sorted)%2 == 1 {
+		return sorted[len(sorted)/2]
+	}
+	return (sorted[len(sorted)/2-1] + sorted[len(sorted)/2]) / 2
+}
@@ -0,0 +1,87 @@
+package orchestrator
+
+import (
+	"testing"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/oplog"
+)
+
+func TestDetectAnomaly(t *testing.T) {
+	baseline := []*v1.BackupProgressSummary{
+		{DataAdded: 2e6, FilesChanged: 10, FilesUnmodified: 990},
+		{DataAdded: 3e6, FilesChanged: 20, FilesUnmodified: 980},
+		{DataAdded: 1e6, FilesChanged: 5, FilesUnmodified: 995},
+	}
+	cfg := &v1.AnomalyDetection{Enabled: true}
+
+	tests := []struct {
+		name        string
+		summary     *v1.BackupProgressSummary
+		baseline    []*v1.BackupProgressSummary
+		wantReasons int
+	}{
+		{name: "normal", summary: &v1.BackupProgressSummary{DataAdded: 4e6, FilesChanged: 30, FilesUnmodified: 970}, baseline: baseline},
+		{name: "data added", summary: &v1.BackupProgressSummary{DataAdded: 300e6, FilesChanged: 30, FilesUnmodified: 970}, baseline: baseline, wantReasons: 1},
+		{name: "files encrypted", summary: &v1.BackupProgressSummary{DataAdded: 2e9, FilesChanged: 950, FilesUnmodified: 50}, baseline: baseline, wantReasons: 2},
+		{name: "below minimum data added", summary: &v1.BackupProgressSummary{DataAdded: 50e6}, baseline: baseline},
+		{name: "baseline too short", summary: &v1.BackupProgressSummary{DataAdded: 2e9, FilesChanged: 1000}, baseline: baseline[:2]},
+		{
+			name:    "plan always changes most files",
+			summary: &v1.BackupProgressSummary{DataAdded: 1e6, FilesChanged: 10, FilesUnmodified: 0},
+			baseline: []*v1.BackupProgressSummary{
+				{DataAdded: 1e6, FilesChanged: 10}, {DataAdded: 1e6, FilesChanged: 10}, {DataAdded: 1e6, FilesChanged: 9, FilesUnmodified: 1},
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			anomaly := detectAnomaly(cfg, tc.summary, tc.baseline)
+			if got := len(anomaly.GetReasons()); got != tc.wantReasons {
+				t.Errorf("want %d reasons, got %v", tc.wantReasons, anomaly.GetReasons())
+			}
+		})
+	}
+
+	if anomaly := detectAnomaly(cfg, tests[2].summary, baseline); anomaly.BaselineDataAdded != 2e6 || anomaly.ChangedFilesPercent != 95 {
+		t.Errorf("want a 2 MB baseline and 95%% of files changed, got %v", anomaly)
+	}
+}
+
+func TestAnomalyBaseline(t *testing.T) {
+	t.Parallel()
+
+	log, err := oplog.NewOpLog(t.TempDir() + "/oplog.boltdb")
+	if err != nil {
+		t.Fatalf("error creating oplog: %s", err)
+	}
+	t.Cleanup(func() { log.Close() })
+
+	add := func(status v1.OperationStatus, dataAdded int64, anomaly *v1.BackupAnomaly) {
+		if err := log.Add(&v1.Operation{
+			PlanId:          "plan",
+			RepoId:          "repo",
+			UnixTimeStartMs: curTimeMillis(),
+			Status:          status,
+			Op: &v1.Operation_OperationBackup{OperationBackup: &v1.OperationBackup{
+				LastStatus: &v1.BackupProgressEntry{Entry: &v1.BackupProgressEntry_Summary{Summary: &v1.BackupProgressSummary{DataAdded: dataAdded}}},
+				Anomaly:    anomaly,
+			}},
+		}); err != nil {
+			t.Fatalf("add operation: %v", err)
+		}
+	}
+	add(v1.OperationStatus_STATUS_SUCCESS, 1, nil)
+	add(v1.OperationStatus_STATUS_SUCCESS, 2, nil)
+	add(v1.OperationStatus_STATUS_WARNING, 3, nil)
+	add(v1.OperationStatus_STATUS_ERROR, 4, nil)
+	add(v1.OperationStatus_STATUS_WARNING, 5, &v1.BackupAnomaly{Reasons: []string{"added data"}})
+
+	baseline, err := anomalyBaseline(log, "plan", "repo", 2)
+	if err != nil {
+		t.Fatalf("baseline: %v", err)
+	}
+	if len(baseline) != 2 || baseline[0].DataAdded != 3 || baseline[1].DataAdded != 2 {
+		t.Errorf("want the two most recent completed, non anomalous backups, got %v", baseline)
+	}
+}
@@ -0,0 +1,40 @@
+package orchestrator
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrAppendOnly is returned for forgets and prunes of an append-only repo without a retention repo.
+var ErrAppendOnly = errors.New("repo is append-only")
+
+// retentionRepo returns the repo forgets and prunes of the repo's snapshots run against, the repo itself unless it is
+// append-only in which case it is the configured retention repo.
+func (o *Orchestrator) retentionRepo(repoId string, action string) (*RepoOrchestrator, error) {
+	repo, err := o.GetRepo(repoId)
+	if err != nil {
+		return nil, fmt.Errorf("get repo %q: %w", repoId, err)
+	}
+	appendOnly := repo.Config().GetAppendOnly()
+	if !appendOnly.GetEnabled() {
+		return repo, nil
+	}
+	if appendOnly.GetRetentionRepo() == "" {
+		return nil, fmt.Errorf("%w: %s of repo %q needs a retention repo with permission to delete", ErrAppendOnly, action, repoId)
+	}
+	retention, err := o.GetRepo(appendOnly.GetRetentionRepo())
+	if err != nil {
+		return nil, fmt.Errorf("get retention repo %q of append-only repo %q: %w", appendOnly.GetRetentionRepo(), repoId, err)
+	}
+	return retention, nil
+}
+
+// canApplyRetention reports whether forgets and prunes of the repo's snapshots can run.
+func (o *Orchestrator) canApplyRetention(repoId string) bool {
+	repo, err := o.GetRepo(repoId)
+	if err != nil {
+		return false
+	}
+	appendOnly := repo.Config().GetAppendOnly()
+	return !appendOnly.GetEnabled() || appendOnly.GetRetentionRepo() != ""
+}
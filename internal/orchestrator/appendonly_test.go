@@ -0,0 +1,54 @@
+package orchestrator
+
+import (
+	"errors"
+	"testing"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+)
+
+func TestRetentionRepo(t *testing.T) {
+	t.Parallel()
+
+	orch, err := NewOrchestrator("", &v1.Config{
+		Repos: []*v1.Repo{
+			{Id: "plain", Uri: "/tmp/plain"},
+			{Id: "locked", Uri: "rest:https://host/locked", AppendOnly: &v1.AppendOnly{Enabled: true, RetentionRepo: "trusted"}},
+			{Id: "trusted", Uri: "rest:https://host:8001/locked"},
+			{Id: "stuck", Uri: "rest:https://host/stuck", AppendOnly: &v1.AppendOnly{Enabled: true}},
+		},
+	}, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create orchestrator: %v", err)
+	}
+
+	for _, tc := range []struct {
+		repo    string
+		want    string
+		wantErr error
+	}{
+		{repo: "plain", want: "plain"},
+		{repo: "locked", want: "trusted"},
+		{repo: "stuck", wantErr: ErrAppendOnly},
+	} {
+		repo, err := orch.retentionRepo(tc.repo, "forget")
+		if tc.wantErr != nil {
+			if !errors.Is(err, tc.wantErr) {
+				t.Errorf("repo %s: want error %v, got %v", tc.repo, tc.wantErr, err)
+			}
+			if orch.canApplyRetention(tc.repo) {
+				t.Errorf("repo %s: want retention not applicable", tc.repo)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("repo %s: unexpected error %v", tc.repo, err)
+		}
+		if repo.Config().Id != tc.want {
+			t.Errorf("repo %s: want retention run against %s, got %s", tc.repo, tc.want, repo.Config().Id)
+		}
+		if !orch.canApplyRetention(tc.repo) {
+			t.Errorf("repo %s: want retention applicable", tc.repo)
+		}
+	}
+}
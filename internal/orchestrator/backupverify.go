@@ -0,0 +1,76 @@
+package orchestrator
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"time"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/pkg/restic"
+)
+
+// verifyBackupSample compares up to n random files of the snapshot with the source files they were read from. Files
+// that changed since the backup can't be compared and are skipped, any other difference means the source was read
+// incorrectly or is suffering from bit rot.
+func verifyBackupSample(ctx context.Context, repo *RepoOrchestrator, snapshotId string, n int, rng *rand.Rand) (*v1.RestoreVerification, error) {
+	entries, err := repo.ListSnapshotFilesRecursive(ctx, snapshotId, "/")
+	if err != nil {
+		return nil, err
+	}
+	byPath := make(map[string]*restic.LsEntry, len(entries))
+	for _, entry := range entries {
+		byPath[entry.Path] = entry
+	}
+
+	verification := &v1.RestoreVerification{}
+	for _, path := range sampleDrillFiles(entries, n, rng) {
+		entry := byPath[path]
+		sourceHash, ok, err := hashUnchangedSource(entry)
+		if err != nil {
+			verification.Mismatches = append(verification.Mismatches, fmt.Sprintf("%v: %v", path, err))
+			verification.FilesChecked++
+			continue
+		} else if !ok {
+			continue
+		}
+
+		h := sha256.New()
+		if err := repo.Dump(ctx, snapshotId, path, h); err != nil {
+			return verification, err
+		}
+		verification.FilesChecked++
+		if snapshotHash := hex.EncodeToString(h.Sum(nil)); snapshotHash != sourceHash {
+			verification.Mismatches = append(verification.Mismatches, fmt.Sprintf("%v: snapshot sha256 %v, source sha256 %v", path, snapshotHash, sourceHash))
+		}
+	}
+	return verification, nil
+}
+
+// hashUnchangedSource returns the sha256 of the source file of the entry, ok is false if the file was removed or
+// modified since the snapshot was taken.
+func hashUnchangedSource(entry *restic.LsEntry) (hash string, ok bool, err error) {
+	info, err := os.Stat(entry.Path)
+	if err != nil {
+		return "", false, nil
+	}
+	mtime, err := time.Parse(time.RFC3339Nano, entry.Mtime)
+	if err != nil || !info.ModTime().Equal(mtime) || info.Size() != int64(entry.Size) {
+		return "", false, nil
+	}
+
+	f, err := os.Open(entry.Path)
+	if err != nil {
+		return "", false, fmt.Errorf("open source: %w", err)
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", false, fmt.Errorf("read source: %w", err)
+	}
+	return hex.EncodeToString(h.Sum(nil)), true, nil
+}
@@ -0,0 +1,84 @@
+package orchestrator
+
+import (
+	"context"
+	"math/rand"
+	"os"
+	"path"
+	"strings"
+	"testing"
+	"time"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/pkg/restic"
+	"github.com/garethgeorge/backrest/test/helpers"
+)
+
+func TestVerifyBackupSample(t *testing.T) {
+	t.Parallel()
+
+	testData := t.TempDir()
+	for _, name := range []string{"file1", "file2", "file3"} {
+		if err := os.WriteFile(path.Join(testData, name), []byte("test data "+name), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	r := &v1.Repo{
+		Id:       "test",
+		Uri:      t.TempDir(),
+		Password: "test",
+		Flags:    []string{"--no-cache"},
+	}
+	plan := &v1.Plan{
+		Id:    "test",
+		Repo:  "test",
+		Paths: []string{testData},
+	}
+	orchestrator := newRepoOrchestrator(r, restic.NewRepo(helpers.ResticBinary(t), r, restic.WithFlags("--no-cache")))
+
+	summary, err := orchestrator.Backup(context.Background(), plan, nil)
+	if err != nil {
+		t.Fatalf("failed to backup plan %s: %v", plan.Id, err)
+	}
+
+	verification, err := verifyBackupSample(context.Background(), orchestrator, summary.SnapshotId, 10, rand.New(rand.NewSource(1)))
+	if err != nil {
+		t.Fatalf("verify error: %v", err)
+	}
+	if verification.FilesChecked != 3 || len(verification.Mismatches) != 0 {
+		t.Fatalf("want 3 matching files, got %v", verification)
+	}
+
+	// bit rot keeps the size and modification time but changes the content.
+	rotted := path.Join(testData, "file1")
+	info, err := os.Stat(rotted)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(rotted, []byte("test data fileX"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(rotted, info.ModTime(), info.ModTime()); err != nil {
+		t.Fatal(err)
+	}
+	// a modified file can't be compared with the snapshot.
+	modified := path.Join(testData, "file2")
+	if err := os.WriteFile(modified, []byte("changed since the backup"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	later := time.Now().Add(time.Hour)
+	if err := os.Chtimes(modified, later, later); err != nil {
+		t.Fatal(err)
+	}
+
+	verification, err = verifyBackupSample(context.Background(), orchestrator, summary.SnapshotId, 10, rand.New(rand.NewSource(1)))
+	if err != nil {
+		t.Fatalf("verify error: %v", err)
+	}
+	if verification.FilesChecked != 2 {
+		t.Errorf("want the modified file skipped, got %d files checked", verification.FilesChecked)
+	}
+	if len(verification.Mismatches) != 1 || !strings.HasPrefix(verification.Mismatches[0], rotted+":") {
+		t.Errorf("want a mismatch for %v, got %v", rotted, verification.Mismatches)
+	}
+}
@@ -0,0 +1,106 @@
+package orchestrator
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/pkg/restic"
+	"github.com/hashicorp/go-multierror"
+)
+
+const (
+	DefaultBenchmarkBytes   = 64 << 20
+	MaxBenchmarkBytes       = 4 << 30
+	benchmarkFileBytes      = 16 << 20 // test data is split in files of this size so restic uploads them concurrently.
+	benchmarkLatencySamples = 5
+	benchmarkTag            = "backrest:benchmark"
+)
+
+// Benchmark measures the latency and the upload and download throughput of the repo's backend by backing up and
+// reading back sizeBytes of random test data, which is incompressible and never deduplicated. The test data is
+// forgotten and pruned afterwards, pruning only deletes packs left entirely unused so it doesn't repack the repo.
+func (r *RepoOrchestrator) Benchmark(ctx context.Context, sizeBytes int64) (result *v1.OperationBenchmark, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	result = &v1.OperationBenchmark{SizeBytes: sizeBytes}
+	dir, err := os.MkdirTemp("", "backrest-benchmark-")
+	if err != nil {
+		return result, fmt.Errorf("create test data directory: %w", err)
+	}
+	defer os.RemoveAll(dir)
+	if err := writeBenchmarkData(dir, sizeBytes); err != nil {
+		return result, err
+	}
+
+	latencies := make([]int64, 0, benchmarkLatencySamples)
+	for i := 0; i < benchmarkLatencySamples; i++ {
+		start := time.Now()
+		if _, err := r.repo.Config(ctx); err != nil {
+			return result, fmt.Errorf("measure latency: %w", err)
+		}
+		latencies = append(latencies, time.Since(start).Milliseconds())
+	}
+	result.LatencyMs = median(latencies)
+
+	start := time.Now()
+	summary, err := r.repo.Backup(ctx, nil, restic.WithBackupPaths(dir), restic.WithBackupTags(benchmarkTag))
+	if err != nil {
+		return result, fmt.Errorf("upload test data: %w", err)
+	}
+	result.UploadMs, result.UploadBytesPerSecond = throughput(sizeBytes, time.Since(start))
+
+	// the test data is removed even if the benchmark is cancelled.
+	defer func() {
+		cleanupCtx := context.WithoutCancel(ctx)
+		if e := r.repo.ForgetSnapshot(cleanupCtx, summary.SnapshotId); e != nil {
+			err = multierror.Append(err, fmt.Errorf("forget test data snapshot %v: %w", summary.SnapshotId, e))
+			return
+		}
+		if e := r.repo.Prune(cleanupCtx, io.Discard, restic.WithFlags("--max-unused", "unlimited")); e != nil {
+			err = multierror.Append(err, fmt.Errorf("prune test data: %w", e))
+			return
+		}
+		result.Cleaned = true
+	}()
+
+	start = time.Now()
+	if err := r.repo.Dump(ctx, summary.SnapshotId, dir, io.Discard, restic.WithFlags("--archive", "tar")); err != nil {
+		return result, fmt.Errorf("download test data: %w", err)
+	}
+	result.DownloadMs, result.DownloadBytesPerSecond = throughput(sizeBytes, time.Since(start))
+	return result, nil
+}
+
+// writeBenchmarkData writes sizeBytes of random data to files in dir.
+func writeBenchmarkData(dir string, sizeBytes int64) error {
+	for i := 0; sizeBytes > 0; i++ {
+		n := min(sizeBytes, benchmarkFileBytes)
+		f, err := os.Create(filepath.Join(dir, fmt.Sprintf("data%d", i)))
+		if err != nil {
+			return fmt.Errorf("create test data: %w", err)
+		}
+		_, err = io.CopyN(f, rand.Reader, n)
+		if e := f.Close(); err == nil {
+			err = e
+		}
+		if err != nil {
+			return fmt.Errorf("write test data: %w", err)
+		}
+		sizeBytes -= n
+	}
+	return nil
+}
+
+func throughput(bytes int64, d time.Duration) (ms int64, bytesPerSecond float64) {
+	if d <= 0 {
+		return 0, 0
+	}
+	return d.Milliseconds(), float64(bytes) / d.Seconds()
+}
@@ -0,0 +1,44 @@
+package orchestrator
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/pkg/restic"
+	"github.com/garethgeorge/backrest/test/helpers"
+)
+
+func TestBenchmark(t *testing.T) {
+	t.Parallel()
+
+	r := &v1.Repo{
+		Id:       "test",
+		Uri:      t.TempDir(),
+		Password: "test",
+		Flags:    []string{"--no-cache"},
+	}
+	repo := newRepoOrchestrator(r, restic.NewRepo(helpers.ResticBinary(t), r, restic.WithFlags("--no-cache")))
+	if err := repo.repo.Init(context.Background()); err != nil {
+		t.Fatalf("init repo: %v", err)
+	}
+
+	result, err := repo.Benchmark(context.Background(), 3<<20)
+	if err != nil {
+		t.Fatalf("benchmark: %v", err)
+	}
+	if !result.Cleaned || result.SizeBytes != 3<<20 {
+		t.Errorf("want the test data of the requested size cleaned up, got %v", result)
+	}
+	if result.UploadBytesPerSecond <= 0 || result.DownloadBytesPerSecond <= 0 {
+		t.Errorf("want upload and download throughput measured, got %v", result)
+	}
+
+	snapshots, err := repo.Snapshots(context.Background())
+	if err != nil {
+		t.Fatalf("snapshots: %v", err)
+	}
+	if len(snapshots) != 0 {
+		t.Errorf("want no snapshots left by the benchmark, got %v", snapshots)
+	}
+}
@@ -0,0 +1,59 @@
+package orchestrator
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/oplog"
+	"github.com/garethgeorge/backrest/internal/oplog/indexutil"
+)
+
+// defaultWarmupValidHours is how long a warmup allows reading a cold storage repo if the repo doesn't say.
+const defaultWarmupValidHours = 24
+
+// ErrColdStorage is returned for operations reading the pack data of a repo on cold storage that isn't warmed up.
+var ErrColdStorage = errors.New("repo is on cold storage")
+
+// RequireWarm returns an error unless the repo's pack data can be read for the action. Repos that aren't on cold
+// storage always can, cold storage repos only until their latest successful warmup expires.
+func (o *Orchestrator) RequireWarm(repo *v1.Repo, action string) error {
+	cold := repo.GetColdStorage()
+	if !cold.GetEnabled() {
+		return nil
+	}
+	if cold.GetWarmupCommand() == "" {
+		return fmt.Errorf("%w: %s reads archived data and the repo has no warmup command, move the data to a readable storage tier first", ErrColdStorage, action)
+	}
+
+	until, ok := o.warmUntil(repo.Id)
+	switch {
+	case !ok:
+		return fmt.Errorf("%w: %s reads archived data, warm up the repo first", ErrColdStorage, action)
+	case !time.Now().Before(until):
+		return fmt.Errorf("%w: %s reads archived data and the last warmup expired at %v, warm up the repo again", ErrColdStorage, action, until.Format(time.RFC3339))
+	default:
+		return nil
+	}
+}
+
+// warmUntil returns when the repo's latest successful warmup expires, ok is false if it was never warmed up.
+func (o *Orchestrator) warmUntil(repoId string) (until time.Time, ok bool) {
+	o.OpLog.ForEachByRepo(repoId, indexutil.Reversed(indexutil.CollectAll()), func(op *v1.Operation) error {
+		warmup, isWarmup := op.Op.(*v1.Operation_OperationWarmup)
+		if !isWarmup || op.Status != v1.OperationStatus_STATUS_SUCCESS {
+			return nil
+		}
+		until, ok = time.UnixMilli(warmup.OperationWarmup.GetValidUntilUnixMs()), true
+		return oplog.ErrStopIteration
+	})
+	return until, ok
+}
+
+func warmupValidity(cold *v1.ColdStorage) time.Duration {
+	if h := cold.GetWarmupValidHours(); h > 0 {
+		return time.Duration(h) * time.Hour
+	}
+	return defaultWarmupValidHours * time.Hour
+}
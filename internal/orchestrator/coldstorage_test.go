@@ -0,0 +1,145 @@
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/oplog"
+	"github.com/garethgeorge/backrest/internal/oplog/indexutil"
+	"github.com/garethgeorge/backrest/test/helpers"
+)
+
+func TestRequireWarm(t *testing.T) {
+	t.Parallel()
+
+	log, err := oplog.NewOpLog(t.TempDir() + "/oplog.boltdb")
+	if err != nil {
+		t.Fatalf("error creating oplog: %s", err)
+	}
+	t.Cleanup(func() { log.Close() })
+	orch := &Orchestrator{OpLog: log}
+
+	if err := orch.RequireWarm(&v1.Repo{Id: "hot"}, "restore"); err != nil {
+		t.Errorf("repos not on cold storage are always readable, got %v", err)
+	}
+
+	repo := &v1.Repo{Id: "cold", ColdStorage: &v1.ColdStorage{Enabled: true, WarmupCommand: "true"}}
+	if err := orch.RequireWarm(repo, "restore"); !errors.Is(err, ErrColdStorage) || !strings.Contains(err.Error(), "warm up the repo first") {
+		t.Errorf("want a cold storage error before the first warmup, got %v", err)
+	}
+	noCommand := &v1.Repo{Id: "cold", ColdStorage: &v1.ColdStorage{Enabled: true}}
+	if err := orch.RequireWarm(noCommand, "prune"); !errors.Is(err, ErrColdStorage) || !strings.Contains(err.Error(), "no warmup command") {
+		t.Errorf("want a cold storage error without a warmup command, got %v", err)
+	}
+
+	addWarmup := func(status v1.OperationStatus, validUntil time.Time) {
+		if err := log.Add(&v1.Operation{
+			RepoId:          "cold",
+			PlanId:          planForRepoMaintenance,
+			UnixTimeStartMs: curTimeMillis(),
+			Status:          status,
+			Op:              &v1.Operation_OperationWarmup{OperationWarmup: &v1.OperationWarmup{ValidUntilUnixMs: validUntil.UnixMilli()}},
+		}); err != nil {
+			t.Fatalf("add operation: %v", err)
+		}
+	}
+
+	addWarmup(v1.OperationStatus_STATUS_SUCCESS, time.Now().Add(-time.Hour))
+	if err := orch.RequireWarm(repo, "restore"); !errors.Is(err, ErrColdStorage) || !strings.Contains(err.Error(), "expired") {
+		t.Errorf("want a cold storage error once the warmup expired, got %v", err)
+	}
+
+	addWarmup(v1.OperationStatus_STATUS_SUCCESS, time.Now().Add(time.Hour))
+	addWarmup(v1.OperationStatus_STATUS_ERROR, time.Time{})
+	if err := orch.RequireWarm(repo, "restore"); err != nil {
+		t.Errorf("want the repo readable until the last successful warmup expires, got %v", err)
+	}
+}
+
+func TestColdStorageGatesReads(t *testing.T) {
+	t.Parallel()
+
+	log, err := oplog.NewOpLog(t.TempDir() + "/oplog.boltdb")
+	if err != nil {
+		t.Fatalf("error creating oplog: %s", err)
+	}
+	t.Cleanup(func() { log.Close() })
+
+	plan := &v1.Plan{
+		Id:                "test",
+		Repo:              "cold",
+		Paths:             []string{helpers.CreateTestData(t)},
+		Cron:              "0 0 1 1 *",
+		VerifySampleFiles: 3,
+		RestoreDrill:      &v1.RestoreDrill{Cron: "0 0 1 1 *"},
+	}
+	orch, err := NewOrchestrator(helpers.ResticBinary(t), &v1.Config{
+		Repos: []*v1.Repo{{
+			Id:          "cold",
+			Uri:         t.TempDir(),
+			Password:    "test",
+			Flags:       []string{"--no-cache"},
+			ColdStorage: &v1.ColdStorage{Enabled: true, WarmupCommand: "true"},
+		}},
+		Plans: []*v1.Plan{plan},
+	}, log, nil)
+	if err != nil {
+		t.Fatalf("failed to create orchestrator: %v", err)
+	}
+
+	// the backup only writes to the repo, its verification reads pack data and is skipped.
+	backup := NewOneoffBackupTask(orch, plan, time.Now())
+	backup.Next(time.Now())
+	if err := backup.Run(context.Background()); err != nil {
+		t.Fatalf("backup error: %v", err)
+	}
+	var backupOp *v1.Operation
+	log.ForEachByPlan(plan.Id, indexutil.CollectAll(), func(op *v1.Operation) error {
+		if op.GetOperationBackup() != nil {
+			backupOp = op
+		}
+		return nil
+	})
+	if backupOp.GetStatus() != v1.OperationStatus_STATUS_SUCCESS || backupOp.GetOperationBackup().GetVerification() != nil || !strings.Contains(backupOp.GetDisplayMessage(), "Verification skipped") {
+		t.Errorf("want the backup to succeed with its verification skipped, got %v", backupOp)
+	}
+
+	drill, err := NewScheduledRestoreDrillTask(orch, plan)
+	if err != nil {
+		t.Fatalf("failed to create restore drill: %v", err)
+	}
+	drill.Next(time.Now())
+	if err := drill.Run(context.Background()); !errors.Is(err, ErrColdStorage) {
+		t.Errorf("want the restore drill refused before a warmup, got %v", err)
+	}
+}
+
+func TestRunWarmupCommand(t *testing.T) {
+	t.Parallel()
+
+	repo := &v1.Repo{
+		Id:          "cold",
+		Uri:         "s3:s3.amazonaws.com/bucket",
+		Env:         []string{"FOO=bar"},
+		ColdStorage: &v1.ColdStorage{Enabled: true, WarmupCommand: `echo "$BACKREST_REPO_ID $BACKREST_REPO_URI $FOO $AWS_PROFILE"`},
+		BackendOptions: &v1.BackendOptions{Backend: &v1.BackendOptions_S3_{
+			S3: &v1.BackendOptions_S3{Profile: "archive"},
+		}},
+	}
+	output, err := runWarmupCommand(context.Background(), repo)
+	if err != nil {
+		t.Fatalf("run warmup command: %v", err)
+	}
+	if want := "cold s3:s3.amazonaws.com/bucket bar archive\n"; output != want {
+		t.Errorf("want output %q, got %q", want, output)
+	}
+
+	repo.ColdStorage.WarmupCommand = "echo restoring; exit 3"
+	if output, err := runWarmupCommand(context.Background(), repo); err == nil || output != "restoring\n" {
+		t.Errorf("want the failure and output of the command, got %q, %v", output, err)
+	}
+}
@@ -0,0 +1,169 @@
+package orchestrator
+
+import (
+	"path"
+	"slices"
+	"sort"
+	"strings"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/pkg/restic"
+)
+
+const (
+	maxDataAddedEntries = 20 // paths recorded per backup.
+
+	// a directory is not reported if a single child accounts for this share of its data, the child is reported instead.
+	dataAddedDominantChild = 0.9
+)
+
+type dataAddedNode struct {
+	bytes int64
+	files int64
+}
+
+// dataAddedTracker totals the data added by a backup for every directory beneath the backup paths from the
+// verbose progress entries of restic.
+type dataAddedTracker struct {
+	roots map[string]struct{}
+	nodes map[string]*dataAddedNode
+}
+
+func newDataAddedTracker(roots []string) *dataAddedTracker {
+	t := &dataAddedTracker{
+		roots: make(map[string]struct{}, len(roots)),
+		nodes: make(map[string]*dataAddedNode),
+	}
+	for _, root := range roots {
+		t.roots[cleanTrackedPath(root)] = struct{}{}
+	}
+	return t
+}
+
+func cleanTrackedPath(p string) string {
+	return path.Clean(strings.ReplaceAll(p, "\\", "/"))
+}
+
+// Add records a verbose progress entry, only files that added data are counted.
+func (t *dataAddedTracker) Add(entry *restic.BackupProgressEntry) {
+	if entry.MessageType != "verbose_status" || (entry.Action != "new" && entry.Action != "modified") {
+		return
+	}
+	if entry.DataSizeInRepo <= 0 || strings.HasSuffix(entry.Item, "/") {
+		return // directories are reported with a trailing slash, their metadata is not counted.
+	}
+
+	p := cleanTrackedPath(entry.Item)
+	for {
+		if _, ok := t.roots[p]; ok {
+			return
+		}
+		parent := path.Dir(p)
+		if parent == p {
+			return // the filesystem root is never worth suggesting.
+		}
+		n, ok := t.nodes[p]
+		if !ok {
+			n = &dataAddedNode{}
+			t.nodes[p] = n
+		}
+		n.bytes += int64(entry.DataSizeInRepo)
+		n.files++
+		p = parent
+	}
+}
+
+// Top returns up to n non-overlapping paths that added the most data, largest first. Directories whose data
+// comes almost entirely from a single child are left out in favour of the child.
+func (t *dataAddedTracker) Top(n int) []*v1.DataAddedEntry {
+	largestChild := make(map[string]int64)
+	for p, node := range t.nodes {
+		parent := path.Dir(p)
+		if node.bytes > largestChild[parent] {
+			largestChild[parent] = node.bytes
+		}
+	}
+
+	var candidates []*v1.DataAddedEntry
+	for p, node := range t.nodes {
+		if float64(largestChild[p]) >= dataAddedDominantChild*float64(node.bytes) {
+			continue
+		}
+		candidates = append(candidates, &v1.DataAddedEntry{Path: p, Bytes: node.bytes, Files: node.files})
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].Bytes != candidates[j].Bytes {
+			return candidates[i].Bytes > candidates[j].Bytes
+		}
+		return candidates[i].Path < candidates[j].Path
+	})
+
+	var top []*v1.DataAddedEntry
+	for _, c := range candidates {
+		if len(top) >= n {
+			break
+		}
+		overlaps := false
+		for _, selected := range top {
+			if pathContains(selected.Path, c.Path) || pathContains(c.Path, selected.Path) {
+				overlaps = true
+				break
+			}
+		}
+		if !overlaps {
+			top = append(top, c)
+		}
+	}
+	return top
+}
+
+// pathContains reports whether p is dir or beneath it.
+func pathContains(dir, p string) bool {
+	return p == dir || strings.HasPrefix(p, strings.TrimSuffix(dir, "/")+"/")
+}
+
+// SuggestExclusions combines the data added by recent backups into up to n suggested excludes, largest first.
+// Paths beneath a path that is also suggested are merged into it.
+func SuggestExclusions(backups []*v1.OperationBackup, n int) []*v1.ExclusionSuggestion {
+	byPath := make(map[string]*v1.ExclusionSuggestion)
+	for _, backup := range backups {
+		for _, entry := range backup.GetDataAdded() {
+			s, ok := byPath[entry.Path]
+			if !ok {
+				s = &v1.ExclusionSuggestion{Path: entry.Path}
+				byPath[entry.Path] = s
+			}
+			s.BytesAdded += entry.Bytes
+			s.FilesAdded += entry.Files
+			s.Backups++
+		}
+	}
+
+	// merge paths into their outermost suggested ancestor, visiting ancestors first.
+	paths := make([]string, 0, len(byPath))
+	for p := range byPath {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	var merged []*v1.ExclusionSuggestion
+	for _, p := range paths {
+		s := byPath[p]
+		idx := slices.IndexFunc(merged, func(m *v1.ExclusionSuggestion) bool { return pathContains(m.Path, p) })
+		if idx < 0 {
+			merged = append(merged, s)
+			continue
+		}
+		ancestor := merged[idx]
+		ancestor.BytesAdded += s.BytesAdded
+		ancestor.FilesAdded += s.FilesAdded
+		ancestor.Backups = max(ancestor.Backups, s.Backups)
+	}
+
+	sort.SliceStable(merged, func(i, j int) bool {
+		return merged[i].BytesAdded > merged[j].BytesAdded
+	})
+	if len(merged) > n {
+		merged = merged[:n]
+	}
+	return merged
+}
@@ -0,0 +1,64 @@
+package orchestrator
+
+import (
+	"testing"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/pkg/restic"
+)
+
+func TestDataAddedTracker(t *testing.T) {
+	tracker := newDataAddedTracker([]string{"/home/user"})
+	add := func(action, item string, bytes int) {
+		tracker.Add(&restic.BackupProgressEntry{MessageType: "verbose_status", Action: action, Item: item, DataSizeInRepo: bytes})
+	}
+	add("new", "/home/user/.cache/browser/a", 400)
+	add("modified", "/home/user/.cache/browser/b", 500)
+	add("new", "/home/user/.cache/tool/c", 200)
+	add("new", "/home/user/vm/disk.img", 2000)
+	add("new", "/home/user/docs/a.txt", 10)
+	add("new", "/home/user/docs/b.txt", 12)
+	add("unchanged", "/home/user/docs/c.txt", 1000)
+	add("new", "/home/user/docs/", 50)
+
+	top := tracker.Top(3)
+	want := []*v1.DataAddedEntry{
+		{Path: "/home/user/vm/disk.img", Bytes: 2000, Files: 1},
+		{Path: "/home/user/.cache", Bytes: 1100, Files: 3},
+		{Path: "/home/user/docs", Bytes: 22, Files: 2},
+	}
+	if len(top) != len(want) {
+		t.Fatalf("want %d entries, got %v", len(want), top)
+	}
+	for i := range want {
+		if top[i].Path != want[i].Path || top[i].Bytes != want[i].Bytes || top[i].Files != want[i].Files {
+			t.Errorf("entry %d: want %v, got %v", i, want[i], top[i])
+		}
+	}
+
+	// directories whose data comes from a single file are not reported.
+	nested := newDataAddedTracker([]string{"/data"})
+	nested.Add(&restic.BackupProgressEntry{MessageType: "verbose_status", Action: "new", Item: "/data/a/b/c/big", DataSizeInRepo: 100})
+	if top := nested.Top(5); len(top) != 1 || top[0].Path != "/data/a/b/c/big" {
+		t.Errorf("want only the file reported, got %v", top)
+	}
+}
+
+func TestSuggestExclusions(t *testing.T) {
+	backups := []*v1.OperationBackup{
+		{DataAdded: []*v1.DataAddedEntry{{Path: "/home/user/.cache", Bytes: 1000, Files: 3}, {Path: "/home/user/docs", Bytes: 10, Files: 1}}},
+		{DataAdded: []*v1.DataAddedEntry{{Path: "/home/user/.cache/browser", Bytes: 800, Files: 2}, {Path: "/home/user/.cache browser", Bytes: 5, Files: 1}}},
+		{DataAdded: []*v1.DataAddedEntry{{Path: "/home/user/.cache", Bytes: 500, Files: 1}}},
+	}
+
+	suggestions := SuggestExclusions(backups, 2)
+	if len(suggestions) != 2 {
+		t.Fatalf("want 2 suggestions, got %v", suggestions)
+	}
+	if s := suggestions[0]; s.Path != "/home/user/.cache" || s.BytesAdded != 2300 || s.FilesAdded != 6 || s.Backups != 2 {
+		t.Errorf("want nested paths merged into the cache dir, got %v", s)
+	}
+	if s := suggestions[1]; s.Path != "/home/user/docs" || s.BytesAdded != 10 {
+		t.Errorf("want the docs dir second, got %v", s)
+	}
+}
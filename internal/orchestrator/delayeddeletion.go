@@ -0,0 +1,108 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/protoutil"
+	"github.com/garethgeorge/backrest/pkg/restic"
+)
+
+// deleteAfterTagPrefix prefixes the tag of snapshots marked for delayed deletion, it is followed by the RFC 3339 time
+// after which the snapshot is forgotten.
+const deleteAfterTagPrefix = "backrest:delete-after:"
+
+func deleteAfterTag(t time.Time) string {
+	return deleteAfterTagPrefix + t.UTC().Format(time.RFC3339)
+}
+
+// deleteAfter returns when the snapshot with the tags may be forgotten, ok is false if it isn't marked for deletion.
+func deleteAfter(tags []string) (t time.Time, ok bool) {
+	for _, tag := range tags {
+		if value, found := strings.CutPrefix(tag, deleteAfterTagPrefix); found {
+			if t, err := time.Parse(time.RFC3339, value); err == nil {
+				return t, true
+			}
+		}
+	}
+	return time.Time{}, false
+}
+
+// deletionTags returns the delayed deletion tags of the snapshot.
+func deletionTags(tags []string) []string {
+	var marks []string
+	for _, tag := range tags {
+		if strings.HasPrefix(tag, deleteAfterTagPrefix) {
+			marks = append(marks, tag)
+		}
+	}
+	return marks
+}
+
+// deletionCoolOff returns how long the repo keeps snapshots marked for deletion, 0 if it forgets them immediately.
+func (o *Orchestrator) deletionCoolOff(repoId string) time.Duration {
+	repo, err := o.GetRepo(repoId)
+	if err != nil {
+		return 0
+	}
+	return time.Duration(repo.Config().GetDelayedDeletion().GetCoolOffHours()) * time.Hour
+}
+
+// markForDeletion tags the snapshots to be forgotten once the repo's cool off passed and moves their operations to
+// the rewritten snapshots. It returns the marked snapshots.
+func (o *Orchestrator) markForDeletion(ctx context.Context, repo *RepoOrchestrator, ids []string, coolOff time.Duration) ([]*v1.ResticSnapshot, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	rewritten, err := repo.Retag(ctx, ids, []string{deleteAfterTag(time.Now().Add(coolOff))}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("mark snapshots for deletion: %w", err)
+	}
+	var marked []*v1.ResticSnapshot
+	for _, id := range ids {
+		snapshot := rewritten[id]
+		if snapshot.Id != id {
+			if err := relinkSnapshot(o.OpLog, id, snapshot); err != nil {
+				return marked, fmt.Errorf("move operations of snapshot %v to %v: %w", id, snapshot.Id, err)
+			}
+		}
+		marked = append(marked, protoutil.SnapshotToProto(snapshot))
+	}
+	return marked, nil
+}
+
+// RescueSnapshot removes the deletion mark of a snapshot marked for delayed deletion and pins it so retention
+// doesn't mark it again. It returns the snapshot's ID, which changes since restic rewrites the snapshot.
+func (o *Orchestrator) RescueSnapshot(ctx context.Context, repoId, snapshotId string) (string, error) {
+	repo, err := o.retentionRepo(repoId, "rescue")
+	if err != nil {
+		return "", err
+	}
+	snapshots, err := repo.Snapshots(ctx)
+	if err != nil {
+		return "", err
+	}
+	idx := slices.IndexFunc(snapshots, func(s *restic.Snapshot) bool { return s.Id == snapshotId })
+	if idx < 0 {
+		return "", fmt.Errorf("snapshot %q not found in repo %q", snapshotId, repoId)
+	}
+	marks := deletionTags(snapshots[idx].Tags)
+	if len(marks) == 0 {
+		return "", fmt.Errorf("snapshot %q is not marked for deletion", snapshotId)
+	}
+
+	rewritten, err := repo.Retag(ctx, []string{snapshotId}, []string{PinnedTag}, marks)
+	if err != nil {
+		return "", fmt.Errorf("rescue snapshot %v: %w", snapshotId, err)
+	}
+	snapshot := rewritten[snapshotId]
+	if err := relinkSnapshot(o.OpLog, snapshotId, snapshot); err != nil {
+		return "", fmt.Errorf("move operations of snapshot %v to %v: %w", snapshotId, snapshot.Id, err)
+	}
+	return snapshot.Id, nil
+}
@@ -0,0 +1,105 @@
+package orchestrator
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/oplog"
+	"github.com/garethgeorge/backrest/pkg/restic"
+	"github.com/garethgeorge/backrest/test/helpers"
+	test "github.com/garethgeorge/backrest/test/helpers"
+)
+
+func TestExpiredSnapshots(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	snapshots := []*restic.Snapshot{
+		{Id: "expired", Tags: []string{"plan:a", deleteAfterTag(now.Add(-time.Minute))}},
+		{Id: "cooling", Tags: []string{deleteAfterTag(now.Add(time.Hour))}},
+		{Id: "unmarked", Tags: []string{"plan:a"}},
+		{Id: "pinned", Tags: []string{PinnedTag, deleteAfterTag(now.Add(-time.Hour))}},
+		{Id: "malformed", Tags: []string{deleteAfterTagPrefix + "soon"}},
+	}
+
+	expired := expiredSnapshots(snapshots, now)
+	if len(expired) != 1 || expired[0].Id != "expired" {
+		t.Errorf("want only the snapshot whose cool off passed, got %v", expired)
+	}
+	if after, ok := deleteAfter(snapshots[1].Tags); !ok || !after.Equal(now.Add(time.Hour)) {
+		t.Errorf("want the deletion time parsed from the tag, got %v, %v", after, ok)
+	}
+}
+
+func TestMarkForDeletion(t *testing.T) {
+	t.Parallel()
+
+	log, err := oplog.NewOpLog(t.TempDir() + "/oplog.boltdb")
+	if err != nil {
+		t.Fatalf("error creating oplog: %s", err)
+	}
+	t.Cleanup(func() { log.Close() })
+	orch := &Orchestrator{OpLog: log}
+
+	r := &v1.Repo{
+		Id:       "test",
+		Uri:      t.TempDir(),
+		Password: "test",
+		Flags:    []string{"--no-cache"},
+	}
+	plan := &v1.Plan{
+		Id:        "test",
+		Repo:      "test",
+		Paths:     []string{test.CreateTestData(t)},
+		Retention: &v1.RetentionPolicy{KeepLastN: 1},
+	}
+	repo := newRepoOrchestrator(r, restic.NewRepo(helpers.ResticBinary(t), r, restic.WithFlags("--no-cache")))
+
+	var ids []string
+	for i := 0; i < 2; i++ {
+		summary, err := repo.Backup(context.Background(), plan, nil)
+		if err != nil {
+			t.Fatalf("failed to backup plan %s: %v", plan.Id, err)
+		}
+		ids = append(ids, summary.SnapshotId)
+	}
+
+	removals, err := repo.RetentionRemovals(context.Background(), plan)
+	if err != nil {
+		t.Fatalf("retention removals: %v", err)
+	}
+	if len(removals) != 1 || removals[0].Id != ids[0] {
+		t.Fatalf("want the older snapshot %v removed by retention, got %v", ids[0], removals)
+	}
+
+	marked, err := orch.markForDeletion(context.Background(), repo, []string{ids[0]}, time.Hour)
+	if err != nil {
+		t.Fatalf("mark for deletion: %v", err)
+	}
+	if len(marked) != 1 || marked[0].Id == ids[0] {
+		t.Fatalf("want the snapshot rewritten with a deletion mark, got %v", marked)
+	}
+	if after, ok := deleteAfter(marked[0].Tags); !ok || time.Until(after) < 59*time.Minute {
+		t.Errorf("want the snapshot marked for deletion in an hour, got tags %v", marked[0].Tags)
+	}
+
+	snapshots, err := repo.Snapshots(context.Background())
+	if err != nil {
+		t.Fatalf("snapshots: %v", err)
+	}
+	if len(snapshots) != 2 {
+		t.Errorf("want marked snapshots kept until the cool off passed, got %d snapshots", len(snapshots))
+	}
+	if expired := expiredSnapshots(snapshots, time.Now().Add(2*time.Hour)); len(expired) != 1 || expired[0].Id != marked[0].Id {
+		t.Errorf("want the marked snapshot expired after the cool off, got %v", expired)
+	}
+
+	rescued, err := repo.Retag(context.Background(), []string{marked[0].Id}, []string{PinnedTag}, deletionTags(marked[0].Tags))
+	if err != nil {
+		t.Fatalf("rescue: %v", err)
+	}
+	if s := rescued[marked[0].Id]; !slices.Contains(s.Tags, PinnedTag) || len(deletionTags(s.Tags)) != 0 {
+		t.Errorf("want the rescued snapshot pinned and unmarked, got tags %v", s.Tags)
+	}
+}
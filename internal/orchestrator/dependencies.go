@@ -0,0 +1,99 @@
+package orchestrator
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/oplog"
+	"github.com/garethgeorge/backrest/internal/oplog/indexutil"
+	"go.uber.org/zap"
+)
+
+// unmetDependency returns why the plans the plan requires keep it from running, or "" if they don't.
+func unmetDependency(log *oplog.OpLog, plan *v1.Plan) string {
+	for _, dep := range plan.GetDependencies().GetRequires() {
+		status, err := latestBackupStatus(log, dep)
+		if err != nil {
+			zap.L().Warn("failed to check the latest backup of a required plan", zap.String("plan", plan.Id), zap.String("required", dep), zap.Error(err))
+			return fmt.Sprintf("couldn't check the latest backup of plan %q", dep)
+		}
+		switch status {
+		case v1.OperationStatus_STATUS_SUCCESS:
+		case v1.OperationStatus_STATUS_UNKNOWN:
+			return fmt.Sprintf("plan %q has not completed a backup", dep)
+		default:
+			return fmt.Sprintf("the latest backup of plan %q did not succeed", dep)
+		}
+	}
+	return ""
+}
+
+// latestBackupStatus returns the status of the plan's most recent completed backup, STATUS_UNKNOWN if there is none.
+// Cancelled and skipped backups didn't run so they are passed over.
+func latestBackupStatus(log *oplog.OpLog, planId string) (v1.OperationStatus, error) {
+	status := v1.OperationStatus_STATUS_UNKNOWN
+	if err := log.ForEachByPlan(planId, indexutil.Reversed(indexutil.CollectAll()), func(op *v1.Operation) error {
+		if _, ok := op.Op.(*v1.Operation_OperationBackup); !ok {
+			return nil
+		}
+		switch op.Status {
+		case v1.OperationStatus_STATUS_PENDING, v1.OperationStatus_STATUS_INPROGRESS,
+			v1.OperationStatus_STATUS_USER_CANCELLED, v1.OperationStatus_STATUS_SYSTEM_CANCELLED:
+			return nil
+		}
+		status = op.Status
+		return oplog.ErrStopIteration
+	}); err != nil {
+		return status, err
+	}
+	return status, nil
+}
+
+// runBackupsSucceeded reports whether the latest backup of the plan to each of its repos in the run succeeded, retries
+// of a timed out backup replace it.
+func runBackupsSucceeded(log *oplog.OpLog, planId string, runId int64) (bool, error) {
+	seen := make(map[string]bool)
+	succeeded := true
+	if err := log.ForEachByPlan(planId, indexutil.Reversed(indexutil.CollectAll()), func(op *v1.Operation) error {
+		if _, ok := op.Op.(*v1.Operation_OperationBackup); !ok || op.RunId != runId || seen[op.RepoId] {
+			return nil
+		}
+		seen[op.RepoId] = true
+		if op.Status != v1.OperationStatus_STATUS_SUCCESS {
+			succeeded = false
+			return oplog.ErrStopIteration
+		}
+		return nil
+	}); err != nil {
+		return false, err
+	}
+	return succeeded, nil
+}
+
+// scheduleDependentBackups schedules backups of the plans that follow the plan if its backups in the run succeeded,
+// they join the run.
+func scheduleDependentBackups(o *Orchestrator, plan *v1.Plan, runId int64) {
+	if ok, err := runBackupsSucceeded(o.OpLog, plan.Id, runId); err != nil {
+		zap.L().Warn("failed to check the backups of a run", zap.String("plan", plan.Id), zap.Int64("run", runId), zap.Error(err))
+		return
+	} else if !ok {
+		return
+	}
+
+	o.mu.Lock()
+	cfg := o.config
+	o.mu.Unlock()
+
+	for _, dependent := range cfg.GetPlans() {
+		if !slices.Contains(dependent.GetDependencies().GetAfter(), plan.Id) {
+			continue
+		}
+		t := NewOneoffBackupTask(o, dependent, time.Now())
+		t.name = fmt.Sprintf("backup for plan %q after plan %q", dependent.Id, plan.Id)
+		t.checkPreconditions = true
+		t.runId = runId
+		o.ScheduleTask(t, TaskPriorityDefault)
+	}
+}
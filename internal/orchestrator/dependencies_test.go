@@ -0,0 +1,51 @@
+package orchestrator
+
+import (
+	"strings"
+	"testing"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/oplog"
+)
+
+func TestUnmetDependency(t *testing.T) {
+	t.Parallel()
+
+	log, err := oplog.NewOpLog(t.TempDir() + "/oplog.boltdb")
+	if err != nil {
+		t.Fatalf("error creating oplog: %s", err)
+	}
+	t.Cleanup(func() { log.Close() })
+
+	plan := &v1.Plan{Id: "offsite", Dependencies: &v1.PlanDependencies{Requires: []string{"local"}}}
+	addBackup := func(status v1.OperationStatus) {
+		if err := log.Add(&v1.Operation{
+			PlanId: "local",
+			RepoId: "repo1",
+			Status: status,
+			Op:     &v1.Operation_OperationBackup{},
+		}); err != nil {
+			t.Fatalf("error adding operation: %s", err)
+		}
+	}
+
+	if reason := unmetDependency(log, plan); !strings.Contains(reason, "has not completed a backup") {
+		t.Errorf("want a plan without backups to be unmet, got %q", reason)
+	}
+
+	addBackup(v1.OperationStatus_STATUS_SUCCESS)
+	if reason := unmetDependency(log, plan); reason != "" {
+		t.Errorf("want a successful backup to satisfy the dependency, got %q", reason)
+	}
+
+	addBackup(v1.OperationStatus_STATUS_ERROR)
+	addBackup(v1.OperationStatus_STATUS_SYSTEM_CANCELLED)
+	addBackup(v1.OperationStatus_STATUS_PENDING)
+	if reason := unmetDependency(log, plan); !strings.Contains(reason, "did not succeed") {
+		t.Errorf("want the failed backup, not the skipped or pending ones, to decide, got %q", reason)
+	}
+
+	if reason := unmetDependency(log, &v1.Plan{Id: "local"}); reason != "" {
+		t.Errorf("want a plan without dependencies to run, got %q", reason)
+	}
+}
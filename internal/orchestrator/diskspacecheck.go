@@ -0,0 +1,129 @@
+package orchestrator
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/diskspace"
+	"github.com/garethgeorge/backrest/internal/hook"
+	"github.com/garethgeorge/backrest/internal/resticflags"
+	"go.uber.org/zap"
+)
+
+// spaceCheckPath is a path a task writes to and what it is used for.
+type spaceCheckPath struct {
+	path    string
+	purpose string
+}
+
+// checkDiskSpace checks the free space of the paths against the repo's disk space thresholds. Paths below the
+// minimum refuse the task with a *diskspace.InsufficientError, paths below the warning threshold run the low disk
+// space hooks and are returned as warnings.
+func checkDiskSpace(o *Orchestrator, repo *v1.Repo, plan *v1.Plan, vars hook.HookVars, paths ...spaceCheckPath) ([]string, error) {
+	warnings, err := evaluateDiskSpace(repo.GetDiskSpace(), paths, diskspace.Free)
+	for _, warning := range warnings {
+		zap.L().Warn("disk space pre-flight check", zap.String("repo", repo.GetId()), zap.String("warning", warning))
+	}
+	if len(warnings) > 0 {
+		vars.Error = strings.Join(warnings, "\n")
+		o.hookExecutor.ExecuteHooks(repo, plan, "", []v1.Hook_Condition{
+			v1.Hook_CONDITION_LOW_DISK_SPACE,
+		}, vars)
+	}
+	return warnings, err
+}
+
+// evaluateDiskSpace compares the free space of each path with the thresholds. Paths that can't be checked are
+// logged and skipped, a failing check shouldn't keep backups from running.
+func evaluateDiskSpace(limits *v1.DiskSpace, paths []spaceCheckPath, free func(path string) (uint64, error)) ([]string, error) {
+	minBytes := uint64(max(limits.GetMinFreeMb(), 0)) * diskspace.MB
+	warnBytes := uint64(max(limits.GetWarnFreeMb(), 0)) * diskspace.MB
+	if minBytes == 0 && warnBytes == 0 {
+		return nil, nil
+	}
+
+	var warnings []string
+	for _, p := range paths {
+		freeBytes, err := free(p.path)
+		if errors.Is(err, diskspace.ErrUnsupported) {
+			return warnings, nil
+		} else if err != nil {
+			zap.L().Warn("failed to check free disk space", zap.String("path", p.path), zap.Error(err))
+			continue
+		}
+		if freeBytes < minBytes {
+			return warnings, &diskspace.InsufficientError{Path: p.path, Purpose: p.purpose, FreeBytes: freeBytes, MinBytes: minBytes}
+		}
+		if freeBytes < warnBytes {
+			warnings = append(warnings, fmt.Sprintf("low disk space for the %s at %q: %d MB free", p.purpose, p.path, freeBytes/diskspace.MB))
+		}
+	}
+	return warnings, nil
+}
+
+// repoSpacePaths returns the paths restic writes to for any command on the repo, the repo itself if it is on a
+// local file system.
+func repoSpacePaths(repo *v1.Repo, plan *v1.Plan) []spaceCheckPath {
+	var paths []spaceCheckPath
+	if dir := resticCacheDir(repo, plan); dir != "" {
+		paths = append(paths, spaceCheckPath{path: dir, purpose: "restic cache"})
+	}
+	if dir := localRepoPath(repo.GetUri()); dir != "" {
+		paths = append(paths, spaceCheckPath{path: dir, purpose: "repo"})
+	}
+	return paths
+}
+
+// resticCacheDir returns the cache directory restic uses for the repo, "" if caching is disabled or it is unknown.
+func resticCacheDir(repo *v1.Repo, plan *v1.Plan) string {
+	dir := ""
+	for _, env := range append(append([]string{}, repo.GetEnv()...), plan.GetEnv()...) {
+		if v, ok := strings.CutPrefix(env, "RESTIC_CACHE_DIR="); ok {
+			dir = v
+		}
+	}
+
+	var args []string
+	for _, flag := range repo.GetFlags() {
+		args = append(args, strings.Fields(flag)...)
+	}
+	args = append(args, resticflags.Args(repo.GetExtraFlags())...)
+	if repo.GetCacheDir() != "" {
+		args = append(args, "--cache-dir="+repo.GetCacheDir())
+	}
+	args = append(args, resticflags.Args(plan.GetExtraFlags())...)
+	for i, arg := range args {
+		switch {
+		case arg == "--no-cache":
+			return ""
+		case arg == "--cache-dir" && i+1 < len(args):
+			dir = args[i+1]
+		case strings.HasPrefix(arg, "--cache-dir="):
+			dir = strings.TrimPrefix(arg, "--cache-dir=")
+		}
+	}
+
+	if dir != "" {
+		return dir
+	}
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(cacheDir, "restic")
+}
+
+// localRepoPath returns the directory of a repo on a local file system, "" for remote backends.
+func localRepoPath(uri string) string {
+	if dir, ok := strings.CutPrefix(uri, "local:"); ok {
+		return dir
+	}
+	if filepath.IsAbs(uri) {
+		return uri
+	}
+	return ""
+}
@@ -0,0 +1,102 @@
+package orchestrator
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/diskspace"
+)
+
+func TestEvaluateDiskSpace(t *testing.T) {
+	free := map[string]uint64{
+		"/cache":  50 * diskspace.MB,
+		"/repo":   500 * diskspace.MB,
+		"/target": 5 * diskspace.MB,
+	}
+	freeFn := func(path string) (uint64, error) {
+		if f, ok := free[path]; ok {
+			return f, nil
+		}
+		return 0, errors.New("no such path")
+	}
+	cache := spaceCheckPath{path: "/cache", purpose: "restic cache"}
+	repo := spaceCheckPath{path: "/repo", purpose: "repo"}
+	target := spaceCheckPath{path: "/target", purpose: "restore target"}
+	missing := spaceCheckPath{path: "/missing", purpose: "repo"}
+
+	tests := []struct {
+		name         string
+		limits       *v1.DiskSpace
+		paths        []spaceCheckPath
+		wantWarnings int
+		wantErrPath  string
+	}{
+		{name: "no thresholds", limits: nil, paths: []spaceCheckPath{cache, target}},
+		{name: "enough space", limits: &v1.DiskSpace{MinFreeMb: 10, WarnFreeMb: 20}, paths: []spaceCheckPath{cache, repo}},
+		{name: "warning", limits: &v1.DiskSpace{MinFreeMb: 10, WarnFreeMb: 100}, paths: []spaceCheckPath{cache, repo}, wantWarnings: 1},
+		{name: "refused", limits: &v1.DiskSpace{MinFreeMb: 10, WarnFreeMb: 100}, paths: []spaceCheckPath{cache, target}, wantWarnings: 1, wantErrPath: "/target"},
+		{name: "unchecked paths are skipped", limits: &v1.DiskSpace{MinFreeMb: 1000}, paths: []spaceCheckPath{missing}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			warnings, err := evaluateDiskSpace(tc.limits, tc.paths, freeFn)
+			if len(warnings) != tc.wantWarnings {
+				t.Errorf("got warnings %v, want %d", warnings, tc.wantWarnings)
+			}
+			var insufficient *diskspace.InsufficientError
+			if tc.wantErrPath == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+			} else if !errors.As(err, &insufficient) || insufficient.Path != tc.wantErrPath {
+				t.Errorf("got error %v, want insufficient space at %q", err, tc.wantErrPath)
+			}
+		})
+	}
+}
+
+func TestResticCacheDir(t *testing.T) {
+	userCache, err := os.UserCacheDir()
+	if err != nil {
+		t.Skipf("no user cache dir: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		repo *v1.Repo
+		plan *v1.Plan
+		want string
+	}{
+		{name: "default", repo: &v1.Repo{}, want: filepath.Join(userCache, "restic")},
+		{name: "env", repo: &v1.Repo{Env: []string{"RESTIC_CACHE_DIR=/env"}}, want: "/env"},
+		{name: "flag overrides env", repo: &v1.Repo{Env: []string{"RESTIC_CACHE_DIR=/env"}, Flags: []string{"--cache-dir /flag"}}, want: "/flag"},
+		{name: "extra flag", repo: &v1.Repo{ExtraFlags: []*v1.ResticFlag{{Name: "--cache-dir", Value: "/extra"}}}, want: "/extra"},
+		{name: "cache dir overrides repo flags", repo: &v1.Repo{Flags: []string{"--cache-dir=/flag"}, CacheDir: "/configured"}, want: "/configured"},
+		{name: "plan flag", repo: &v1.Repo{}, plan: &v1.Plan{ExtraFlags: []*v1.ResticFlag{{Name: "--cache-dir", Value: "/plan"}}}, want: "/plan"},
+		{name: "no cache", repo: &v1.Repo{Flags: []string{"--no-cache"}}, want: ""},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := resticCacheDir(tc.repo, tc.plan); got != tc.want {
+				t.Errorf("got %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestLocalRepoPath(t *testing.T) {
+	for uri, want := range map[string]string{
+		"/srv/restic":         "/srv/restic",
+		"local:/srv/restic":   "/srv/restic",
+		"s3:s3.amazonaws.com": "",
+		"sftp:host:/srv":      "",
+		"rest:https://host/":  "",
+	} {
+		if got := localRepoPath(uri); got != want {
+			t.Errorf("localRepoPath(%q) = %q, want %q", uri, got, want)
+		}
+	}
+}
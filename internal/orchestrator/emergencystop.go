@@ -0,0 +1,43 @@
+package orchestrator
+
+import (
+	"errors"
+	"fmt"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"go.uber.org/zap"
+)
+
+var ErrEmergencyStop = errors.New("emergency stop in effect, resume schedules to run tasks")
+
+// EmergencyStopped reports whether an emergency stop is in effect.
+func (o *Orchestrator) EmergencyStopped() bool {
+	return o.emergencyStop.Load()
+}
+
+// applyEmergencyStop records the config's emergency stop state, if one is in effect the running task is cancelled
+// which kills its restic process. It returns true if tasks must not be scheduled. o.mu must be held.
+func (o *Orchestrator) applyEmergencyStop(cfg *v1.Config) bool {
+	stop := cfg.GetEmergencyStop()
+	o.emergencyStop.Store(stop != nil)
+	if stop == nil {
+		return false
+	}
+
+	zap.L().Warn("emergency stop in effect, all schedules are paused", zap.String("user", stop.User), zap.String("reason", stop.Reason))
+	if running := o.runningTask.Load(); running != nil {
+		running.cancel()
+	}
+	return true
+}
+
+// refuseTask cancels a task that can't run because of an emergency stop and reports the stop to its callbacks.
+func refuseTask(t Task, callbacks []func(error)) {
+	if err := t.Cancel(v1.OperationStatus_STATUS_SYSTEM_CANCELLED); err != nil {
+		zap.L().Error("failed to cancel task", zap.String("task", t.Name()), zap.Error(err))
+	}
+	zap.L().Info("task not run, emergency stop in effect", zap.String("task", t.Name()))
+	for _, cb := range callbacks {
+		cb(fmt.Errorf("task %q: %w", t.Name(), ErrEmergencyStop))
+	}
+}
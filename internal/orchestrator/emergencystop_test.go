@@ -0,0 +1,90 @@
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/config"
+	"google.golang.org/protobuf/proto"
+)
+
+type blockingTask struct {
+	testTask
+	started chan struct{}
+}
+
+func (t *blockingTask) Run(ctx context.Context) error {
+	close(t.started)
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func TestEmergencyStop(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cfg := config.NewDefaultConfig()
+	orch, err := NewOrchestrator("", cfg, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create orchestrator: %v", err)
+	}
+	go orch.Run(ctx)
+
+	once := func() func(time.Time) *time.Time {
+		scheduled := false
+		return func(t time.Time) *time.Time {
+			if scheduled {
+				return nil
+			}
+			scheduled = true
+			return &t
+		}
+	}
+	running := &blockingTask{testTask: testTask{onNext: once()}, started: make(chan struct{})}
+	done := make(chan error, 1)
+	orch.ScheduleTask(running, TaskPriorityDefault, func(err error) { done <- err })
+	<-running.started
+
+	stopped := proto.Clone(cfg).(*v1.Config)
+	stopped.EmergencyStop = &v1.EmergencyStop{UnixTimeMs: time.Now().UnixMilli(), Reason: "test"}
+	if err := orch.ApplyConfig(stopped); err != nil {
+		t.Fatalf("apply config: %v", err)
+	}
+
+	select {
+	case err := <-done:
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("want the running task cancelled, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("running task not cancelled by the emergency stop")
+	}
+
+	var refused error
+	orch.ScheduleTask(&testTask{onNext: once(), onRun: func() error {
+		t.Errorf("task ran during the emergency stop")
+		return nil
+	}}, TaskPriorityDefault, func(err error) { refused = err })
+	if !errors.Is(refused, ErrEmergencyStop) {
+		t.Errorf("want tasks refused during the emergency stop, got %v", refused)
+	}
+
+	if err := orch.ApplyConfig(cfg); err != nil {
+		t.Fatalf("apply config: %v", err)
+	}
+	ran := make(chan struct{})
+	orch.ScheduleTask(&testTask{onNext: once(), onRun: func() error {
+		close(ran)
+		return nil
+	}}, TaskPriorityDefault)
+	select {
+	case <-ran:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("task not run after schedules were resumed")
+	}
+}
@@ -0,0 +1,88 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/pkg/restic"
+)
+
+// FileHistory returns the versions of the path in each snapshot backing it up, oldest first. If plan is not nil only
+// the plan's snapshots are considered.
+func (r *RepoOrchestrator) FileHistory(ctx context.Context, plan *v1.Plan, filePath string) ([]*v1.FileVersion, error) {
+	if !path.IsAbs(filePath) {
+		return nil, fmt.Errorf("path %q must be absolute", filePath)
+	}
+	filePath = path.Clean(filePath)
+
+	var opts []restic.GenericOption
+	if plan != nil {
+		opts = snapshotFilterForPlan(plan)
+	}
+	snapshots, err := r.repo.Snapshots(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("get snapshots: %w", err)
+	}
+	sortSnapshotsByTime(snapshots)
+
+	// a single find searches every snapshot, the path is escaped so it only matches itself.
+	results, err := r.repo.Find(ctx, escapeFindPattern(filePath), opts...)
+	if err != nil {
+		return nil, fmt.Errorf("find %q: %w", filePath, err)
+	}
+	found := make(map[string]*restic.LsEntry)
+	for _, result := range results {
+		for _, match := range result.Matches {
+			if match.Path == filePath {
+				found[result.Snapshot] = match
+			}
+		}
+	}
+
+	var versions []*v1.FileVersion
+	var prev *restic.LsEntry
+	for _, snapshot := range snapshots {
+		if !backsUp(snapshot, filePath) {
+			continue
+		}
+		version := &v1.FileVersion{
+			SnapshotId:         snapshot.Id,
+			SnapshotUnixTimeMs: snapshot.UnixTimeMs(),
+		}
+		if entry, ok := found[snapshot.Id]; ok {
+			entry.Name = path.Base(entry.Path)
+			version.Present = true
+			version.Entry = entry.ToProto()
+			version.Changed = prev == nil || prev.Size != entry.Size || prev.Mtime != entry.Mtime
+			prev = entry
+		}
+		versions = append(versions, version)
+	}
+	return versions, nil
+}
+
+// backsUp reports whether the path is beneath one of the snapshot's backup paths.
+func backsUp(snapshot *restic.Snapshot, filePath string) bool {
+	for _, p := range snapshot.Paths {
+		p = path.Clean(p)
+		if p == "/" || filePath == p || strings.HasPrefix(filePath, p+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// escapeFindPattern escapes the pattern syntax of restic find so the pattern matches the literal path.
+func escapeFindPattern(p string) string {
+	var sb strings.Builder
+	for _, c := range p {
+		if strings.ContainsRune(`*?[]\`, c) {
+			sb.WriteRune('\\')
+		}
+		sb.WriteRune(c)
+	}
+	return sb.String()
+}
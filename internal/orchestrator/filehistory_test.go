@@ -0,0 +1,79 @@
+package orchestrator
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/pkg/restic"
+	"github.com/garethgeorge/backrest/test/helpers"
+)
+
+func TestFileHistory(t *testing.T) {
+	t.Parallel()
+
+	r := &v1.Repo{
+		Id:       "test",
+		Uri:      t.TempDir(),
+		Password: "test",
+		Flags:    []string{"--no-cache"},
+	}
+	dir := t.TempDir()
+	plan := &v1.Plan{
+		Id:    "test",
+		Repo:  "test",
+		Paths: []string{dir},
+	}
+	repo := newRepoOrchestrator(r, restic.NewRepo(helpers.ResticBinary(t), r, restic.WithFlags("--no-cache")))
+
+	file := filepath.Join(dir, "file[1].txt")
+	backup := func() string {
+		summary, err := repo.Backup(context.Background(), plan, nil)
+		if err != nil {
+			t.Fatalf("failed to backup plan %s: %v", plan.Id, err)
+		}
+		return summary.SnapshotId
+	}
+	write := func(content string) {
+		if err := os.WriteFile(file, []byte(content), 0644); err != nil {
+			t.Fatalf("write file: %v", err)
+		}
+	}
+
+	write("a")
+	first := backup()
+	backup() // unchanged.
+	write("bigger")
+	third := backup()
+	if err := os.Remove(file); err != nil {
+		t.Fatalf("remove file: %v", err)
+	}
+	fourth := backup()
+
+	versions, err := repo.FileHistory(context.Background(), plan, file)
+	if err != nil {
+		t.Fatalf("file history: %v", err)
+	}
+	if len(versions) != 4 {
+		t.Fatalf("want a version per snapshot, got %v", versions)
+	}
+	want := []struct {
+		present, changed bool
+		size             int64
+	}{{true, true, 1}, {true, false, 1}, {true, true, 6}, {false, false, 0}}
+	for i, w := range want {
+		v := versions[i]
+		if v.Present != w.present || v.Changed != w.changed || v.GetEntry().GetSize() != w.size {
+			t.Errorf("version %d: want present %v, changed %v, size %d, got %v", i, w.present, w.changed, w.size, v)
+		}
+	}
+	if versions[0].SnapshotId != first || versions[2].SnapshotId != third || versions[3].SnapshotId != fourth {
+		t.Errorf("want the versions oldest first, got %v", versions)
+	}
+
+	if _, err := repo.FileHistory(context.Background(), plan, "relative/path"); err == nil {
+		t.Errorf("want an error for a relative path")
+	}
+}
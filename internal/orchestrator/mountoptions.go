@@ -0,0 +1,82 @@
+package orchestrator
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/mounts"
+	"github.com/garethgeorge/backrest/pkg/restic"
+)
+
+// needsMountTable reports whether the plan's backup options depend on the mounts beneath its paths.
+func needsMountTable(plan *v1.Plan) bool {
+	return !plan.OneFileSystem && slices.ContainsFunc(plan.PathOptions, func(o *v1.PathOptions) bool { return o.OneFileSystem })
+}
+
+// mountBackupOpts returns the restic options implementing the plan's file system boundaries. restic backs up paths
+// given explicitly even with --one-file-system so included mounts are passed as paths, per path boundaries are
+// implemented by excluding the mountpoints beneath the path.
+func mountBackupOpts(plan *v1.Plan, mountTable []mounts.Mount) []restic.BackupOption {
+	var opts []restic.BackupOption
+	if plan.OneFileSystem {
+		opts = append(opts, restic.WithBackupOptions(restic.WithFlags("--one-file-system")))
+		for _, o := range plan.PathOptions {
+			opts = append(opts, restic.WithBackupPaths(o.IncludeMounts...))
+		}
+		return opts
+	}
+
+	for _, m := range excludedMounts(plan, mountTable) {
+		opts = append(opts, restic.WithBackupExcludes(escapePattern(m)))
+	}
+	return opts
+}
+
+// excludedMounts returns the mountpoints beneath paths with a per path file system boundary that aren't included.
+func excludedMounts(plan *v1.Plan, mountTable []mounts.Mount) []string {
+	var excluded []string
+	for _, o := range plan.PathOptions {
+		if !o.OneFileSystem {
+			continue
+		}
+		for _, m := range mounts.Beneath(mountTable, o.Path) {
+			if slices.Contains(excluded, m.Point) || slices.Contains(o.IncludeMounts, m.Point) {
+				continue
+			}
+			excluded = append(excluded, m.Point)
+		}
+	}
+	return excluded
+}
+
+// mountWarnings returns pre-flight warnings for the plan's paths that are on, or would be backed up across, network
+// file systems or bind mounts.
+func mountWarnings(plan *v1.Plan, mountTable []mounts.Mount) []string {
+	var warnings []string
+	for _, p := range plan.Paths {
+		if m, ok := mounts.Containing(mountTable, p); ok && m.IsNetwork() {
+			warnings = append(warnings, fmt.Sprintf("path %q is on a %s network file system mounted from %s", p, m.FSType, m.Source))
+		}
+
+		var opts *v1.PathOptions
+		if idx := slices.IndexFunc(plan.PathOptions, func(o *v1.PathOptions) bool { return o.Path == p }); idx >= 0 {
+			opts = plan.PathOptions[idx]
+		}
+		for _, m := range mounts.Beneath(mountTable, p) {
+			crossed := !plan.OneFileSystem && !opts.GetOneFileSystem()
+			if !crossed && !slices.Contains(opts.GetIncludeMounts(), m.Point) {
+				continue
+			}
+			switch {
+			case m.IsNetwork():
+				warnings = append(warnings, fmt.Sprintf("path %q spans the %s network file system mounted at %q", p, m.FSType, m.Point))
+			case m.IsBind():
+				warnings = append(warnings, fmt.Sprintf("path %q spans a bind mount of %q at %q, its files may be backed up twice", p, m.Root, m.Point))
+			}
+		}
+	}
+	sort.Strings(warnings)
+	return slices.Compact(warnings)
+}
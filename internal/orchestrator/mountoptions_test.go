@@ -0,0 +1,59 @@
+package orchestrator
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/mounts"
+)
+
+var testMountTable = []mounts.Mount{
+	{Point: "/", Root: "/", FSType: "ext4", Source: "/dev/sda1"},
+	{Point: "/home", Root: "/", FSType: "ext4", Source: "/dev/sda2"},
+	{Point: "/home/user/nas", Root: "/", FSType: "nfs4", Source: "nas:/export"},
+	{Point: "/home/user/photos", Root: "/mnt/photos", FSType: "ext4", Source: "/dev/sdb1"},
+	{Point: "/home/user/usb", Root: "/", FSType: "vfat", Source: "/dev/sdc1"},
+	{Point: "/srv/share", Root: "/", FSType: "cifs", Source: "//server/share"},
+}
+
+func TestExcludedMounts(t *testing.T) {
+	plan := &v1.Plan{
+		Paths: []string{"/home/user", "/etc"},
+		PathOptions: []*v1.PathOptions{
+			{Path: "/home/user", OneFileSystem: true, IncludeMounts: []string{"/home/user/usb"}},
+		},
+	}
+	want := []string{"/home/user/nas", "/home/user/photos"}
+	if got := excludedMounts(plan, testMountTable); !slices.Equal(got, want) {
+		t.Errorf("excludedMounts() = %v, want %v", got, want)
+	}
+	if !needsMountTable(plan) {
+		t.Errorf("want the mount table needed for per path boundaries")
+	}
+
+	plan.OneFileSystem = true
+	if needsMountTable(plan) {
+		t.Errorf("want restic itself to enforce the boundary when the whole plan doesn't cross file systems")
+	}
+}
+
+func TestMountWarnings(t *testing.T) {
+	plan := &v1.Plan{Paths: []string{"/home/user", "/srv/share/docs"}}
+	warnings := mountWarnings(plan, testMountTable)
+	if len(warnings) != 3 {
+		t.Fatalf("want 3 warnings, got %v", warnings)
+	}
+	for _, want := range []string{`"/home/user/nas"`, `bind mount of "/mnt/photos"`, `path "/srv/share/docs" is on a cifs network file system`} {
+		if !slices.ContainsFunc(warnings, func(w string) bool { return strings.Contains(w, want) }) {
+			t.Errorf("want a warning containing %s, got %v", want, warnings)
+		}
+	}
+
+	plan.PathOptions = []*v1.PathOptions{{Path: "/home/user", OneFileSystem: true, IncludeMounts: []string{"/home/user/photos"}}}
+	warnings = mountWarnings(plan, testMountTable)
+	if len(warnings) != 2 || !strings.Contains(strings.Join(warnings, "\n"), "/mnt/photos") {
+		t.Errorf("want only the included bind mount and the network path warned about, got %v", warnings)
+	}
+}
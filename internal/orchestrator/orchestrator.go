@@ -10,10 +10,14 @@ import (
 	"time"
 
 	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/backendopts"
 	"github.com/garethgeorge/backrest/internal/config"
 	"github.com/garethgeorge/backrest/internal/hook"
 	"github.com/garethgeorge/backrest/internal/oplog"
+	"github.com/garethgeorge/backrest/internal/precondition"
+	"github.com/garethgeorge/backrest/internal/resticflags"
 	"github.com/garethgeorge/backrest/internal/rotatinglog"
+	"github.com/garethgeorge/backrest/internal/selfbackup"
 	"github.com/garethgeorge/backrest/pkg/restic"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/proto"
@@ -35,17 +39,19 @@ const (
 
 // Orchestrator is responsible for managing repos and backups.
 type Orchestrator struct {
-	mu           sync.Mutex
-	config       *v1.Config
-	OpLog        *oplog.OpLog
-	repoPool     *resticRepoPool
-	taskQueue    taskQueue
-	hookExecutor *hook.HookExecutor
+	mu            sync.Mutex
+	config        *v1.Config
+	OpLog         *oplog.OpLog
+	repoPool      *resticRepoPool
+	taskQueue     taskQueue
+	hookExecutor  *hook.HookExecutor
+	preconditions *precondition.Checker
 
 	// now for the purpose of testing; used by Run() to get the current time.
 	now func() time.Time
 
-	runningTask atomic.Pointer[taskExecutionInfo]
+	runningTask   atomic.Pointer[taskExecutionInfo]
+	emergencyStop atomic.Bool // set while the config's emergency stop is in effect.
 }
 
 func NewOrchestrator(resticBin string, cfg *v1.Config, oplog *oplog.OpLog, logStore *rotatinglog.RotatingLog) (*Orchestrator, error) {
@@ -61,7 +67,8 @@ func NewOrchestrator(resticBin string, cfg *v1.Config, oplog *oplog.OpLog, logSt
 		taskQueue: newTaskQueue(func() time.Time {
 			return o.curTime()
 		}),
-		hookExecutor: hook.NewHookExecutor(oplog, logStore),
+		hookExecutor:  hook.NewHookExecutor(oplog, logStore),
+		preconditions: precondition.NewChecker(),
 	}
 
 	// verify the operation log and mark any incomplete operations as failed.
@@ -78,6 +85,17 @@ func NewOrchestrator(resticBin string, cfg *v1.Config, oplog *oplog.OpLog, logSt
 			return nil, fmt.Errorf("scan oplog: %w", err)
 		}
 
+		report, err := o.CheckOplogIntegrity(true, false)
+		if err != nil {
+			return nil, fmt.Errorf("check oplog integrity: %w", err)
+		}
+		if report.DanglingIndexEntries > 0 || len(report.CorruptOperations) > 0 || len(report.OrphanedOperations) > 0 {
+			zap.L().Warn("found problems in the operation log, index entries were repaired and corrupt records quarantined",
+				zap.Int("dangling_index_entries", report.DanglingIndexEntries),
+				zap.Int64s("quarantined_operations", report.CorruptOperations),
+				zap.Int("orphaned_operations", len(report.OrphanedOperations)))
+		}
+
 		for _, repoId := range incompleteOpRepos {
 			repo, err := o.GetRepo(repoId)
 			if err != nil {
@@ -113,6 +131,19 @@ func (o *Orchestrator) ApplyConfig(cfg *v1.Config) error {
 	defer o.mu.Unlock()
 	o.config = cfg
 
+	catalog, err := hook.NewCatalog(cfg.GetNotifications())
+	if err != nil {
+		zap.L().Error("invalid notification templates, using the defaults", zap.Error(err))
+	}
+	o.hookExecutor.SetCatalog(catalog)
+
+	// plugins are rediscovered with every config change so new plugins are picked up without a restart.
+	plugins, err := hook.DiscoverPlugins(config.PluginsDir())
+	if err != nil {
+		zap.L().Error("failed to discover hook plugins", zap.Error(err))
+	}
+	o.hookExecutor.SetPlugins(plugins)
+
 	// Update the config provided to the repo pool.
 	if err := o.repoPool.configProvider.Update(cfg); err != nil {
 		return fmt.Errorf("failed to update repo pool config: %w", err)
@@ -128,6 +159,9 @@ func (o *Orchestrator) ApplyConfig(cfg *v1.Config) error {
 			zap.L().Debug("queued task cancelled due to config change", zap.String("task", t.task.Name()))
 		}
 	}
+	if o.applyEmergencyStop(cfg) {
+		return nil
+	}
 	zap.L().Info("Applied config to orchestrator, task queue reset. Rescheduling planned tasks now.")
 
 	// Requeue tasks that are affected by the config change.
@@ -135,9 +169,44 @@ func (o *Orchestrator) ApplyConfig(cfg *v1.Config) error {
 		orchestrator: o,
 	}, TaskPriorityDefault)
 	for _, plan := range cfg.Plans {
-		t, err := NewScheduledBackupTask(o, plan)
+		if plan.Cron != "" || len(plan.GetDependencies().GetAfter()) == 0 {
+			t, err := NewScheduledBackupTask(o, plan)
+			if err != nil {
+				return fmt.Errorf("schedule backup task for plan %q: %w", plan.Id, err)
+			}
+			o.ScheduleTask(t, TaskPriorityDefault)
+		}
+
+		if plan.GetRestoreDrill().GetCron() != "" {
+			drill, err := NewScheduledRestoreDrillTask(o, plan)
+			if err != nil {
+				return fmt.Errorf("schedule restore drill task for plan %q: %w", plan.Id, err)
+			}
+			o.ScheduleTask(drill, TaskPriorityDefault)
+		}
+	}
+
+	for _, repo := range cfg.Repos {
+		if repo.GetCachePolicy().GetCron() != "" {
+			t, err := NewScheduledCacheCleanupTask(o, repo)
+			if err != nil {
+				return fmt.Errorf("schedule cache cleanup task for repo %q: %w", repo.Id, err)
+			}
+			o.ScheduleTask(t, TaskPriorityDefault)
+		}
+		if repo.GetDelayedDeletion().GetCoolOffHours() > 0 {
+			t, err := NewScheduledDelayedDeletionTask(o, repo)
+			if err != nil {
+				return fmt.Errorf("schedule delayed deletion task for repo %q: %w", repo.Id, err)
+			}
+			o.ScheduleTask(t, TaskPriorityForget)
+		}
+	}
+
+	if cfg.GetSelfBackup().GetRepo() != "" {
+		t, err := NewScheduledSelfBackupTask(o, cfg.SelfBackup)
 		if err != nil {
-			return fmt.Errorf("schedule backup task for plan %q: %w", plan.Id, err)
+			return fmt.Errorf("schedule self backup task: %w", err)
 		}
 		o.ScheduleTask(t, TaskPriorityDefault)
 	}
@@ -145,6 +214,13 @@ func (o *Orchestrator) ApplyConfig(cfg *v1.Config) error {
 	return nil
 }
 
+// SetResticBinary switches the restic binary used for repos, e.g. after restic was updated.
+func (o *Orchestrator) SetResticBinary(resticPath string) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.repoPool.setResticPath(resticPath)
+}
+
 func (o *Orchestrator) GetRepo(repoId string) (repo *RepoOrchestrator, err error) {
 	o.mu.Lock()
 	defer o.mu.Unlock()
@@ -173,6 +249,25 @@ func (o *Orchestrator) GetPlan(planId string) (*v1.Plan, error) {
 	return nil, ErrPlanNotFound
 }
 
+// CheckOplogIntegrity runs a consistency pass over the operation log against the current config, see oplog.CheckIntegrity.
+func (o *Orchestrator) CheckOplogIntegrity(repair, removeOrphaned bool) (*oplog.IntegrityReport, error) {
+	o.mu.Lock()
+	opts := oplog.IntegrityOptions{
+		Plans:          []string{planForUntrackedSnapshots, planForRepoMaintenance, planForAdhocBackups, selfbackup.PlanId},
+		Repair:         repair,
+		RemoveOrphaned: removeOrphaned,
+	}
+	for _, repo := range o.config.Repos {
+		opts.Repos = append(opts.Repos, repo.Id)
+	}
+	for _, plan := range o.config.Plans {
+		opts.Plans = append(opts.Plans, plan.Id)
+	}
+	o.mu.Unlock()
+
+	return o.OpLog.CheckIntegrity(opts)
+}
+
 func (o *Orchestrator) CancelOperation(operationId int64, status v1.OperationStatus) error {
 	o.mu.Lock()
 	defer o.mu.Unlock()
@@ -223,6 +318,12 @@ func (o *Orchestrator) Run(mainCtx context.Context) {
 			continue
 		}
 
+		// tasks dequeued while an emergency stop was applied are dropped, not rescheduled.
+		if o.EmergencyStopped() {
+			refuseTask(t.task, t.callbacks)
+			continue
+		}
+
 		zap.L().Info("running task", zap.String("task", t.task.Name()))
 
 		taskCtx, cancel := context.WithCancel(mainCtx)
@@ -247,6 +348,9 @@ func (o *Orchestrator) Run(mainCtx context.Context) {
 			cb(err)
 		}
 
+		if o.EmergencyStopped() {
+			continue // ApplyConfig reschedules the task once schedules are resumed.
+		}
 		if nextTime := t.task.Next(o.curTime()); nextTime != nil {
 			o.taskQueue.Push(scheduledTask{
 				task:  t.task,
@@ -257,6 +361,10 @@ func (o *Orchestrator) Run(mainCtx context.Context) {
 }
 
 func (o *Orchestrator) ScheduleTask(t Task, priority int, callbacks ...func(error)) {
+	if o.EmergencyStopped() {
+		refuseTask(t, callbacks)
+		return
+	}
 	nextRun := t.Next(o.curTime())
 	if nextRun == nil {
 		return
@@ -286,6 +394,14 @@ func newResticRepoPool(resticPath string, configProvider config.ConfigStore) *re
 	}
 }
 
+// setResticPath switches the restic binary of repos created from now on, tasks already running keep their binary.
+func (rp *resticRepoPool) setResticPath(resticPath string) {
+	rp.mu.Lock()
+	defer rp.mu.Unlock()
+	rp.resticPath = resticPath
+	rp.repos = make(map[string]*RepoOrchestrator)
+}
+
 func (rp *resticRepoPool) GetRepo(repoId string) (repo *RepoOrchestrator, err error) {
 	cfg, err := rp.configProvider.Get()
 	if err != nil {
@@ -325,6 +441,15 @@ func (rp *resticRepoPool) GetRepo(repoId string) (repo *RepoOrchestrator, err er
 	if len(repoProto.GetFlags()) > 0 {
 		opts = append(opts, restic.WithFlags(repoProto.GetFlags()...))
 	}
+	if len(repoProto.GetExtraFlags()) > 0 {
+		opts = append(opts, restic.WithFlags(resticflags.Args(repoProto.GetExtraFlags())...))
+	}
+	if repoProto.GetCacheDir() != "" {
+		opts = append(opts, restic.WithFlags("--cache-dir="+repoProto.GetCacheDir()))
+	}
+	if repoProto.GetBackendOptions() != nil {
+		opts = append(opts, restic.WithEnv(backendopts.Env(repoProto)...), restic.WithFlags(backendopts.Args(repoProto)...))
+	}
 
 	// Otherwise create a new repo.
 	repo = newRepoOrchestrator(repoProto, restic.NewRepo(rp.resticPath, repoProto, opts...))
@@ -0,0 +1,31 @@
+package orchestrator
+
+import (
+	"slices"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// PlanRepos returns the IDs of the repos the plan backs up to, its repo first.
+func PlanRepos(plan *v1.Plan) []string {
+	repos := []string{plan.Repo}
+	for _, repo := range plan.AdditionalRepos {
+		if !slices.Contains(repos, repo) {
+			repos = append(repos, repo)
+		}
+	}
+	return repos
+}
+
+// PlanForRepo returns a copy of the plan backing up to only the repo, tasks for the plan's additional repos run with
+// it so they act on that repo.
+func PlanForRepo(plan *v1.Plan, repoId string) *v1.Plan {
+	if repoId == plan.Repo && len(plan.AdditionalRepos) == 0 {
+		return plan
+	}
+	plan = proto.Clone(plan).(*v1.Plan)
+	plan.Repo = repoId
+	plan.AdditionalRepos = nil
+	return plan
+}
@@ -2,15 +2,24 @@ package orchestrator
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
+	"os"
+	"path/filepath"
+	"slices"
 	"sort"
+	"strings"
 	"sync"
 	"time"
 
 	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/fsutil"
+	"github.com/garethgeorge/backrest/internal/mounts"
 	"github.com/garethgeorge/backrest/internal/protoutil"
+	"github.com/garethgeorge/backrest/internal/resticflags"
 	"github.com/garethgeorge/backrest/pkg/restic"
+	lru "github.com/hashicorp/golang-lru/v2"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/proto"
 )
@@ -23,11 +32,15 @@ type RepoOrchestrator struct {
 	repoConfig  *v1.Repo
 	repo        *restic.Repo
 	initialized bool
+	resticId    string                             // the ID restic assigned the repo, looked up on first use.
+	sizes       *lru.Cache[string, *snapshotSizes] // sizes of the contents of recently browsed snapshots by snapshot ID.
 }
 
 // newRepoOrchestrator accepts a config and a repo that is configured with the properties of that config object.
 func newRepoOrchestrator(repoConfig *v1.Repo, repo *restic.Repo) *RepoOrchestrator {
+	sizes, _ := lru.New[string, *snapshotSizes](snapshotSizesCacheSize) // only fails for a non-positive size.
 	return &RepoOrchestrator{
+		sizes:      sizes,
 		repoConfig: repoConfig,
 		repo:       repo,
 		l:          zap.L().With(zap.String("repo", repoConfig.Id)),
@@ -44,7 +57,7 @@ func (r *RepoOrchestrator) Snapshots(ctx context.Context) ([]*restic.Snapshot, e
 }
 
 func (r *RepoOrchestrator) SnapshotsForPlan(ctx context.Context, plan *v1.Plan) ([]*restic.Snapshot, error) {
-	snapshots, err := r.repo.Snapshots(ctx, restic.WithFlags("--tag", tagForPlan(plan)))
+	snapshots, err := r.repo.Snapshots(ctx, snapshotFilterForPlan(plan)...)
 	if err != nil {
 		return nil, fmt.Errorf("get snapshots for plan %q: %w", plan.Id, err)
 	}
@@ -52,7 +65,8 @@ func (r *RepoOrchestrator) SnapshotsForPlan(ctx context.Context, plan *v1.Plan)
 	return snapshots, nil
 }
 
-func (r *RepoOrchestrator) Backup(ctx context.Context, plan *v1.Plan, progressCallback func(event *restic.BackupProgressEntry)) (*restic.BackupProgressEntry, error) {
+// Backup backs up the plan's paths, extraOpts are applied after the options derived from the plan.
+func (r *RepoOrchestrator) Backup(ctx context.Context, plan *v1.Plan, progressCallback func(event *restic.BackupProgressEntry), extraOpts ...restic.BackupOption) (*restic.BackupProgressEntry, error) {
 	zap.L().Debug("repo orchestrator starting backup", zap.String("repo", r.repoConfig.Id))
 
 	r.mu.Lock()
@@ -79,11 +93,27 @@ func (r *RepoOrchestrator) Backup(ctx context.Context, plan *v1.Plan, progressCa
 	opts = append(opts, restic.WithBackupExcludes(plan.Excludes...))
 	opts = append(opts, restic.WithBackupIExcludes(plan.Iexcludes...))
 	opts = append(opts, restic.WithBackupTags(tagForPlan(plan)))
+	opts = append(opts, restic.WithBackupVerbose())
+
+	var mountTable []mounts.Mount
+	if needsMountTable(plan) {
+		if mountTable, err = mounts.List(); err != nil {
+			return nil, fmt.Errorf("list mounts for the plan's path options: %w", err)
+		}
+	}
+	opts = append(opts, mountBackupOpts(plan, mountTable)...)
+	if plan.Host != "" {
+		opts = append(opts, restic.WithBackupHost(plan.Host))
+	}
 
 	if len(snapshots) > 0 {
 		// TODO: design a test strategy to verify that the backup parent is used correctly.
 		opts = append(opts, restic.WithBackupParent(snapshots[len(snapshots)-1].Id))
 	}
+	opts = append(opts, restic.WithBackupOptions(resourceLimitOpts(plan.ResourceLimits)...))
+	opts = append(opts, restic.WithBackupOptions(planEnvOpts(plan)...))
+	opts = append(opts, restic.WithBackupOptions(restic.WithFlags(resticflags.Args(plan.ExtraFlags)...)))
+	opts = append(opts, extraOpts...)
 
 	summary, err := r.repo.Backup(ctx, progressCallback, opts...)
 	if err != nil {
@@ -108,6 +138,37 @@ func (r *RepoOrchestrator) ListSnapshotFiles(ctx context.Context, snapshotId str
 	return lsEnts, nil
 }
 
+// ListSnapshotFilesRecursive lists every entry beneath path in the snapshot.
+func (r *RepoOrchestrator) ListSnapshotFilesRecursive(ctx context.Context, snapshotId string, path string) ([]*restic.LsEntry, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	_, entries, err := r.repo.ListDirectory(ctx, snapshotId, path, restic.WithFlags("--recursive"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list snapshot files: %w", err)
+	}
+	return entries, nil
+}
+
+// Dump writes the contents of the file at path in the snapshot to w.
+func (r *RepoOrchestrator) Dump(ctx context.Context, snapshotId string, path string, w io.Writer) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := r.repo.Dump(ctx, snapshotId, path, w); err != nil {
+		return fmt.Errorf("dump %q from snapshot %v: %w", path, snapshotId, err)
+	}
+	return nil
+}
+
+// DumpArchive writes the directory at path in the snapshot to w as a tar archive. Unlike the other operations it
+// doesn't hold the repo's lock, streaming a whole snapshot can take hours and restic's shared lock already keeps
+// the data from being pruned meanwhile.
+func (r *RepoOrchestrator) DumpArchive(ctx context.Context, snapshotId string, path string, w io.Writer) error {
+	if err := r.repo.Dump(ctx, snapshotId, path, w, restic.WithFlags("--archive", "tar")); err != nil {
+		return fmt.Errorf("dump %q from snapshot %v as tar: %w", path, snapshotId, err)
+	}
+	return nil
+}
+
 func (r *RepoOrchestrator) Forget(ctx context.Context, plan *v1.Plan) ([]*v1.ResticSnapshot, error) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
@@ -117,9 +178,11 @@ func (r *RepoOrchestrator) Forget(ctx context.Context, plan *v1.Plan) ([]*v1.Res
 		return nil, fmt.Errorf("plan %q has no retention policy", plan.Id)
 	}
 
-	result, err := r.repo.Forget(
-		ctx, protoutil.RetentionPolicyFromProto(plan.Retention),
-		restic.WithFlags("--tag", tagForPlan(plan)), restic.WithFlags("--group-by", "tag"))
+	opts := snapshotFilterForPlan(plan)
+	opts = append(opts, restic.WithFlags("--group-by", groupByForPlan(plan)), restic.WithFlags("--keep-tag", PinnedTag))
+	opts = append(opts, resourceLimitOpts(plan.ResourceLimits)...)
+	opts = append(opts, planEnvOpts(plan)...)
+	result, err := r.repo.Forget(ctx, protoutil.RetentionPolicyFromProto(plan.Retention), opts...)
 	if err != nil {
 		return nil, fmt.Errorf("get snapshots for repo %v: %w", r.repoConfig.Id, err)
 	}
@@ -138,6 +201,125 @@ func (r *RepoOrchestrator) Forget(ctx context.Context, plan *v1.Plan) ([]*v1.Res
 	return forgotten, nil
 }
 
+// SetPinned adds or removes the snapshot's pinned tag and returns the snapshot, restic rewrites the snapshot with a
+// new ID if its tags change.
+func (r *RepoOrchestrator) SetPinned(ctx context.Context, snapshotId string, pinned bool) (*restic.Snapshot, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	snapshots, err := r.repo.Snapshots(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get snapshots for repo %v: %w", r.repoConfig.Id, err)
+	}
+	idx := slices.IndexFunc(snapshots, func(s *restic.Snapshot) bool { return s.Id == snapshotId })
+	if idx < 0 {
+		return nil, fmt.Errorf("snapshot %q not found in repo %v", snapshotId, r.repoConfig.Id)
+	}
+	old := snapshots[idx]
+	if slices.Contains(old.Tags, PinnedTag) == pinned {
+		return old, nil
+	}
+
+	var add, remove []string
+	if pinned {
+		add = []string{PinnedTag}
+	} else {
+		remove = []string{PinnedTag}
+	}
+	r.l.Debug("Set snapshot pinned", zap.String("snapshot", snapshotId), zap.Bool("pinned", pinned))
+	if err := r.repo.Tag(ctx, snapshotId, add, remove); err != nil {
+		return nil, fmt.Errorf("tag snapshot %v: %w", snapshotId, err)
+	}
+
+	snapshots, err = r.repo.Snapshots(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get snapshots for repo %v: %w", r.repoConfig.Id, err)
+	}
+	if s := findRewritten(snapshots, old, func(s *restic.Snapshot) bool { return slices.Contains(s.Tags, PinnedTag) == pinned }); s != nil {
+		return s, nil
+	}
+	return nil, fmt.Errorf("rewritten snapshot of %v not found in repo %v", snapshotId, r.repoConfig.Id)
+}
+
+// RetentionRemovals returns the snapshots the plan's retention policy forgets without forgetting them.
+func (r *RepoOrchestrator) RetentionRemovals(ctx context.Context, plan *v1.Plan) ([]*restic.Snapshot, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if plan.Retention == nil {
+		return nil, fmt.Errorf("plan %q has no retention policy", plan.Id)
+	}
+
+	opts := snapshotFilterForPlan(plan)
+	opts = append(opts, restic.WithFlags("--group-by", groupByForPlan(plan)), restic.WithFlags("--keep-tag", PinnedTag), restic.WithFlags("--dry-run"))
+	opts = append(opts, resourceLimitOpts(plan.ResourceLimits)...)
+	opts = append(opts, planEnvOpts(plan)...)
+	result, err := r.repo.Forget(ctx, protoutil.RetentionPolicyFromProto(plan.Retention), opts...)
+	if err != nil {
+		return nil, fmt.Errorf("get snapshots forgotten by the retention policy of plan %v: %w", plan.Id, err)
+	}
+	removals := make([]*restic.Snapshot, 0, len(result.Remove))
+	for i := range result.Remove {
+		removals = append(removals, &result.Remove[i])
+	}
+	return removals, nil
+}
+
+// Retag adds and removes tags of the snapshots and returns the snapshots restic rewrote them as by their old IDs.
+func (r *RepoOrchestrator) Retag(ctx context.Context, snapshotIds []string, add []string, remove []string) (map[string]*restic.Snapshot, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	snapshots, err := r.repo.Snapshots(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get snapshots for repo %v: %w", r.repoConfig.Id, err)
+	}
+	var olds []*restic.Snapshot
+	for _, id := range snapshotIds {
+		idx := slices.IndexFunc(snapshots, func(s *restic.Snapshot) bool { return s.Id == id })
+		if idx < 0 {
+			return nil, fmt.Errorf("snapshot %q not found in repo %v", id, r.repoConfig.Id)
+		}
+		olds = append(olds, snapshots[idx])
+		if err := r.repo.Tag(ctx, id, add, remove); err != nil {
+			return nil, fmt.Errorf("tag snapshot %v: %w", id, err)
+		}
+	}
+
+	snapshots, err = r.repo.Snapshots(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get snapshots for repo %v: %w", r.repoConfig.Id, err)
+	}
+	tagged := func(s *restic.Snapshot) bool {
+		return !slices.ContainsFunc(add, func(tag string) bool { return !slices.Contains(s.Tags, tag) }) &&
+			!slices.ContainsFunc(remove, func(tag string) bool { return slices.Contains(s.Tags, tag) })
+	}
+	rewritten := make(map[string]*restic.Snapshot, len(olds))
+	for _, old := range olds {
+		s := findRewritten(snapshots, old, tagged)
+		if s == nil {
+			// restic leaves snapshots that already had the tags as they are.
+			s = old
+		}
+		rewritten[old.Id] = s
+	}
+	return rewritten, nil
+}
+
+// findRewritten returns the snapshot matching the predicate restic rewrote old as, nil if there is none.
+func findRewritten(snapshots []*restic.Snapshot, old *restic.Snapshot, match func(s *restic.Snapshot) bool) *restic.Snapshot {
+	original := old.Original
+	if original == "" {
+		original = old.Id
+	}
+	for _, s := range snapshots {
+		if s.Id != old.Id && s.Original == original && s.Tree == old.Tree && s.Time == old.Time && match(s) {
+			return s
+		}
+	}
+	return nil
+}
+
 func (r *RepoOrchestrator) ForgetSnapshot(ctx context.Context, snapshotId string) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
@@ -146,7 +328,8 @@ func (r *RepoOrchestrator) ForgetSnapshot(ctx context.Context, snapshotId string
 	return r.repo.ForgetSnapshot(ctx, snapshotId)
 }
 
-func (r *RepoOrchestrator) Prune(ctx context.Context, output io.Writer) error {
+// Prune prunes the repo on behalf of the plan, the plan's resource limits apply to the prune.
+func (r *RepoOrchestrator) Prune(ctx context.Context, plan *v1.Plan, output io.Writer) error {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
@@ -164,6 +347,9 @@ func (r *RepoOrchestrator) Prune(ctx context.Context, output io.Writer) error {
 		opts = append(opts, restic.WithFlags("--max-unused", fmt.Sprintf("%v%%", policy.MaxUnusedPercent)))
 	}
 
+	opts = append(opts, resourceLimitOpts(plan.ResourceLimits)...)
+	opts = append(opts, planEnvOpts(plan)...)
+
 	r.l.Debug("Prune snapshots")
 	err := r.repo.Prune(ctx, output, opts...)
 	if err != nil {
@@ -172,16 +358,59 @@ func (r *RepoOrchestrator) Prune(ctx context.Context, output io.Writer) error {
 	return nil
 }
 
-func (r *RepoOrchestrator) Restore(ctx context.Context, snapshotId string, path string, target string, progressCallback func(event *v1.RestoreProgressEntry)) (*v1.RestoreProgressEntry, error) {
+// planEnvOpts sets the plan's extra environment variables on its restic processes.
+func planEnvOpts(plan *v1.Plan) []restic.GenericOption {
+	if len(plan.Env) == 0 {
+		return nil
+	}
+	return []restic.GenericOption{restic.WithEnv(plan.Env...)}
+}
+
+// Restore restores path from the snapshot to target. restic always replaces existing files and does not
+// allow combining include and exclude patterns, so when files must be left out the snapshot is listed and
+// every file to be restored is passed as an include pattern instead. The verification result is nil unless
+// requested in the restore options.
+func (r *RepoOrchestrator) Restore(ctx context.Context, snapshotId string, path string, target string, restoreOpts *v1.RestoreOptions, progressCallback func(event *v1.RestoreProgressEntry)) (*v1.RestoreProgressEntry, *v1.RestoreVerification, error) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
 	r.l.Debug("Restore snapshot", zap.String("snapshot", snapshotId), zap.String("target", target))
 
+	filter := newRestoreFilter(path, restoreOpts)
+
 	var opts []restic.GenericOption
+	var verification *v1.RestoreVerification
 	opts = append(opts, restic.WithFlags("--target", target))
-	if path != "" {
-		opts = append(opts, restic.WithFlags("--include", path))
+	if restoreOpts.GetVerify() {
+		opts = append(opts, restic.WithFlags("--verify"))
+		verification = &v1.RestoreVerification{}
+	}
+
+	var restored []*restic.LsEntry
+	if len(filter.excludes) == 0 && restoreOpts.GetOverwrite() == v1.RestoreOptions_OVERWRITE_ALWAYS && !restoreOpts.GetSkipOwnership() && !restoreOpts.GetSkipPermissions() && !restoreOpts.GetVerify() {
+		opts = append(opts, filter.flags()...)
+	} else {
+		listPath := path
+		if listPath == "" {
+			listPath = "/"
+		}
+		_, entries, err := r.repo.ListDirectory(ctx, snapshotId, listPath, restic.WithFlags("--recursive"))
+		if err != nil {
+			return nil, nil, fmt.Errorf("list files to restore from snapshot %q: %w", snapshotId, err)
+		}
+		var skipped bool
+		restored, skipped = selectRestoredEntries(entries, filter, restoreOpts.GetOverwrite(), target)
+		if len(filter.excludes) == 0 && !skipped {
+			opts = append(opts, filter.flags()...)
+		} else {
+			includes := includeFlags(entries, restored)
+			if len(includes) == 0 {
+				// without any include patterns restic would restore the entire snapshot.
+				r.l.Debug("Restore snapshot: nothing to restore", zap.String("snapshot", snapshotId))
+				return &v1.RestoreProgressEntry{MessageType: "summary", PercentDone: 1}, verification, nil
+			}
+			opts = append(opts, includes...)
+		}
 	}
 
 	summary, err := r.repo.Restore(ctx, snapshotId, func(event *restic.RestoreProgressEntry) {
@@ -190,10 +419,18 @@ func (r *RepoOrchestrator) Restore(ctx context.Context, snapshotId string, path
 		}
 	}, opts...)
 	if err != nil {
-		return nil, fmt.Errorf("restore snapshot %q for repo %v: %w", snapshotId, r.repoConfig.Id, err)
+		return nil, nil, fmt.Errorf("restore snapshot %q for repo %v: %w", snapshotId, r.repoConfig.Id, err)
+	}
+
+	if err := resetRestoredMetadata(target, restored, restoreOpts); err != nil {
+		return nil, nil, fmt.Errorf("reset metadata of restored files: %w", err)
+	}
+
+	if verification != nil {
+		verification = verifyRestoredEntries(target, restored)
 	}
 
-	return protoutil.RestoreProgressEntryToProto(summary), nil
+	return protoutil.RestoreProgressEntryToProto(summary), verification, nil
 }
 
 // UnlockIfAutoEnabled unlocks the repo if the auto unlock feature is enabled.
@@ -220,6 +457,8 @@ func (r *RepoOrchestrator) Unlock(ctx context.Context) error {
 	return nil
 }
 
+// Stats returns the repo's stats in raw-data mode, i.e. the deduplicated and compressed size of the data
+// referenced by snapshots, along with the logical size of the snapshots from restore-size mode.
 func (r *RepoOrchestrator) Stats(ctx context.Context) (*v1.RepoStats, error) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
@@ -229,8 +468,181 @@ func (r *RepoOrchestrator) Stats(ctx context.Context) (*v1.RepoStats, error) {
 	if err != nil {
 		return nil, fmt.Errorf("stats for repo %v: %w", r.repoConfig.Id, err)
 	}
+	restoreStats, err := r.repo.StatsForMode(ctx, restic.StatsModeRestoreSize)
+	if err != nil {
+		return nil, fmt.Errorf("restore-size stats for repo %v: %w", r.repoConfig.Id, err)
+	}
+
+	statsProto := protoutil.RepoStatsToProto(stats)
+	statsProto.RestoreSize = restoreStats.TotalSize
+	statsProto.RestoreFileCount = restoreStats.TotalFileCount
+	return statsProto, nil
+}
+
+// SnapshotStats returns the stats of a single snapshot, this is much cheaper than stats for the whole repo.
+func (r *RepoOrchestrator) SnapshotStats(ctx context.Context, snapshotId string) (*v1.RepoStats, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.l.Debug("Get snapshot stats", zap.String("snapshot", snapshotId))
+	stats, err := r.repo.Stats(ctx, restic.WithFlags(snapshotId))
+	if err != nil {
+		return nil, fmt.Errorf("stats for snapshot %v in repo %v: %w", snapshotId, r.repoConfig.Id, err)
+	}
+	restoreStats, err := r.repo.StatsForMode(ctx, restic.StatsModeRestoreSize, restic.WithFlags(snapshotId))
+	if err != nil {
+		return nil, fmt.Errorf("restore-size stats for snapshot %v in repo %v: %w", snapshotId, r.repoConfig.Id, err)
+	}
+
+	statsProto := protoutil.RepoStatsToProto(stats)
+	statsProto.RestoreSize = restoreStats.TotalSize
+	statsProto.RestoreFileCount = restoreStats.TotalFileCount
+	return statsProto, nil
+}
+
+// Check runs restic check on the repo, the output is returned even if the check fails.
+func (r *RepoOrchestrator) Check(ctx context.Context, opts ...restic.GenericOption) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	output, err := r.repo.Check(ctx, opts...)
+	if err != nil {
+		return output, fmt.Errorf("check repo %v: %w", r.repoConfig.Id, err)
+	}
+	return output, nil
+}
+
+// Locks returns the locks held in the repo. It does not wait for running operations since those are
+// typically the holders of the locks.
+func (r *RepoOrchestrator) Locks(ctx context.Context) ([]*restic.Lock, error) {
+	locks, err := r.repo.Locks(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list locks for repo %v: %w", r.repoConfig.Id, err)
+	}
+	return locks, nil
+}
+
+// PruneEstimate returns the amount of unreferenced data a prune would remove from the repo.
+func (r *RepoOrchestrator) PruneEstimate(ctx context.Context) (*restic.PruneEstimate, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	estimate, err := r.repo.PruneEstimate(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("estimate prune for repo %v: %w", r.repoConfig.Id, err)
+	}
+	return estimate, nil
+}
+
+// Migrations returns the restic migrations that can be applied to the repo.
+func (r *RepoOrchestrator) Migrations(ctx context.Context) ([]restic.Migration, error) {
+	migrations, err := r.repo.Migrations(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list migrations for repo %v: %w", r.repoConfig.Id, err)
+	}
+	return migrations, nil
+}
+
+// Migrate applies a restic migration to the repo, holding the repo for the duration.
+func (r *RepoOrchestrator) Migrate(ctx context.Context, migration string, output io.Writer) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.repo.Migrate(ctx, migration, output); err != nil {
+		return fmt.Errorf("migrate repo %v: %w", r.repoConfig.Id, err)
+	}
+	return nil
+}
+
+// CacheStats returns the location and size of the repo's restic cache.
+func (r *RepoOrchestrator) CacheStats(ctx context.Context) (*v1.RepoCacheStats, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stats := &v1.RepoCacheStats{RepoId: r.repoConfig.Id}
+	cacheDir, err := r.cacheDir(ctx)
+	if err != nil || cacheDir == "" {
+		return stats, err
+	}
+	stats.Path = cacheDir
+	if stats.SizeBytes, err = fsutil.DirSize(cacheDir); err != nil {
+		return nil, fmt.Errorf("size of cache %q: %w", cacheDir, err)
+	}
+	if stats.TotalSizeBytes, err = fsutil.DirSize(filepath.Dir(cacheDir)); err != nil {
+		return nil, fmt.Errorf("size of cache %q: %w", filepath.Dir(cacheDir), err)
+	}
+	return stats, nil
+}
+
+// CacheCleanup removes caches unused for maxAgeDays from the repo's cache directory and clears the repo's own cache
+// if it is larger than maxSizeBytes, 0 for no limit. restic rebuilds a cleared cache as needed.
+func (r *RepoOrchestrator) CacheCleanup(ctx context.Context, maxAgeDays int, maxSizeBytes int64, output io.Writer) (*v1.OperationCacheCleanup, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	result := &v1.OperationCacheCleanup{}
+	cacheDir, err := r.cacheDir(ctx)
+	if err != nil {
+		return result, err
+	}
+	if cacheDir == "" {
+		return result, errors.New("the repo doesn't use a cache")
+	}
+	if result.SizeBeforeBytes, err = fsutil.DirSize(cacheDir); err != nil {
+		return result, fmt.Errorf("size of cache %q: %w", cacheDir, err)
+	}
+
+	if err := r.repo.CacheCleanup(ctx, maxAgeDays, output); err != nil {
+		return result, fmt.Errorf("cache cleanup for repo %v: %w", r.repoConfig.Id, err)
+	}
+
+	if maxSizeBytes > 0 && result.SizeBeforeBytes > maxSizeBytes {
+		r.l.Info("clearing cache larger than the maximum size", zap.String("cache", cacheDir), zap.Int64("size", result.SizeBeforeBytes))
+		if err := os.RemoveAll(cacheDir); err != nil {
+			return result, fmt.Errorf("clear cache %q: %w", cacheDir, err)
+		}
+		result.Cleared = true
+	}
+	if result.SizeAfterBytes, err = fsutil.DirSize(cacheDir); err != nil {
+		return result, fmt.Errorf("size of cache %q: %w", cacheDir, err)
+	}
+	return result, nil
+}
+
+// RemoveCache deletes the repo's restic cache and returns the number of bytes it held.
+func (r *RepoOrchestrator) RemoveCache(ctx context.Context) (int64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	cacheDir, err := r.cacheDir(ctx)
+	if err != nil || cacheDir == "" {
+		return 0, err
+	}
+	size, err := fsutil.DirSize(cacheDir)
+	if err != nil {
+		return 0, fmt.Errorf("size of cache %q: %w", cacheDir, err)
+	}
+	if err := os.RemoveAll(cacheDir); err != nil {
+		return 0, fmt.Errorf("remove cache %q: %w", cacheDir, err)
+	}
+	return size, nil
+}
 
-	return protoutil.RepoStatsToProto(stats), nil
+// cacheDir returns the directory of the repo's cache, named by restic after the repo's ID. It is "" if the repo
+// doesn't use a cache.
+func (r *RepoOrchestrator) cacheDir(ctx context.Context) (string, error) {
+	base := resticCacheDir(r.repoConfig, nil)
+	if base == "" {
+		return "", nil
+	}
+	if r.resticId == "" {
+		id, err := r.repo.RepoId(ctx)
+		if err != nil {
+			return "", fmt.Errorf("get id of repo %v: %w", r.repoConfig.Id, err)
+		}
+		r.resticId = id
+	}
+	return filepath.Join(base, r.resticId), nil
 }
 
 func (r *RepoOrchestrator) Config() *v1.Repo {
@@ -240,10 +652,30 @@ func (r *RepoOrchestrator) Config() *v1.Repo {
 	return proto.Clone(r.repoConfig).(*v1.Repo)
 }
 
+// PinnedTag marks snapshots that are kept regardless of the plan's retention policy.
+const PinnedTag = "backrest:pinned"
+
 func tagForPlan(plan *v1.Plan) string {
 	return fmt.Sprintf("plan:%s", plan.Id)
 }
 
+// snapshotFilterForPlan returns the flags selecting the snapshots that belong to the plan.
+func snapshotFilterForPlan(plan *v1.Plan) []restic.GenericOption {
+	opts := []restic.GenericOption{restic.WithFlags("--tag", tagForPlan(plan))}
+	if plan.Host != "" {
+		opts = append(opts, restic.WithFlags("--host", plan.Host))
+	}
+	return opts
+}
+
+// groupByForPlan returns the restic --group-by value for the plan's retention policy.
+func groupByForPlan(plan *v1.Plan) string {
+	if len(plan.GroupBy) == 0 {
+		return "tag"
+	}
+	return strings.Join(plan.GroupBy, ",")
+}
+
 func sortSnapshotsByTime(snapshots []*restic.Snapshot) {
 	sort.SliceStable(snapshots, func(i, j int) bool {
 		return snapshots[i].UnixTimeMs() < snapshots[j].UnixTimeMs()
@@ -2,8 +2,12 @@ package orchestrator
 
 import (
 	"context"
+	"errors"
+	"os"
+	"path"
 	"slices"
 	"testing"
+	"time"
 
 	v1 "github.com/garethgeorge/backrest/gen/go/v1"
 	"github.com/garethgeorge/backrest/pkg/restic"
@@ -131,3 +135,400 @@ func TestSnapshotParenting(t *testing.T) {
 		t.Errorf("expected 8 snapshots, got %d", len(snapshots))
 	}
 }
+
+func TestForgetScopedToHost(t *testing.T) {
+	t.Parallel()
+
+	repo := t.TempDir()
+	testData := test.CreateTestData(t)
+
+	r := &v1.Repo{
+		Id:       "test",
+		Uri:      repo,
+		Password: "test",
+		Flags:    []string{"--no-cache"},
+	}
+
+	// the same plan configured on two machines sharing a repo.
+	plans := []*v1.Plan{
+		{
+			Id:    "shared",
+			Repo:  "test",
+			Paths: []string{testData},
+			Host:  "machine-a",
+			Retention: &v1.RetentionPolicy{
+				KeepLastN: 1,
+			},
+		},
+		{
+			Id:    "shared",
+			Repo:  "test",
+			Paths: []string{testData},
+			Host:  "machine-b",
+			Retention: &v1.RetentionPolicy{
+				KeepLastN: 1,
+			},
+		},
+	}
+
+	orchestrator := newRepoOrchestrator(r, restic.NewRepo(helpers.ResticBinary(t), r, restic.WithFlags("--no-cache")))
+
+	for i := 0; i < 3; i++ {
+		for _, plan := range plans {
+			if _, err := orchestrator.Backup(context.Background(), plan, nil); err != nil {
+				t.Fatalf("failed to backup plan %s: %v", plan.Id, err)
+			}
+		}
+	}
+
+	forgotten, err := orchestrator.Forget(context.Background(), plans[0])
+	if err != nil {
+		t.Fatalf("forget error: %v", err)
+	}
+	if len(forgotten) != 2 {
+		t.Errorf("expected 2 forgotten snapshots, got %d", len(forgotten))
+	}
+	for _, snapshot := range forgotten {
+		if snapshot.Hostname != "machine-a" {
+			t.Errorf("forgot snapshot %v from host %q", snapshot.Id, snapshot.Hostname)
+		}
+	}
+
+	snapshots, err := orchestrator.SnapshotsForPlan(context.Background(), plans[1])
+	if err != nil {
+		t.Fatalf("failed to get snapshots: %v", err)
+	}
+	if len(snapshots) != 3 {
+		t.Errorf("expected 3 snapshots for machine-b, got %d", len(snapshots))
+	}
+}
+
+func TestForgetKeepsPinned(t *testing.T) {
+	t.Parallel()
+
+	repo := t.TempDir()
+	testData := test.CreateTestData(t)
+
+	r := &v1.Repo{
+		Id:       "test",
+		Uri:      repo,
+		Password: "test",
+		Flags:    []string{"--no-cache"},
+	}
+	plan := &v1.Plan{
+		Id:    "test",
+		Repo:  "test",
+		Paths: []string{testData},
+		Retention: &v1.RetentionPolicy{
+			KeepLastN: 1,
+		},
+	}
+
+	orchestrator := newRepoOrchestrator(r, restic.NewRepo(helpers.ResticBinary(t), r, restic.WithFlags("--no-cache")))
+
+	var ids []string
+	for i := 0; i < 3; i++ {
+		summary, err := orchestrator.Backup(context.Background(), plan, nil)
+		if err != nil {
+			t.Fatalf("failed to backup plan %s: %v", plan.Id, err)
+		}
+		ids = append(ids, summary.SnapshotId)
+	}
+
+	pinned, err := orchestrator.SetPinned(context.Background(), ids[0], true)
+	if err != nil {
+		t.Fatalf("pin error: %v", err)
+	}
+	if pinned.Id == ids[0] || !slices.Contains(pinned.Tags, PinnedTag) {
+		t.Fatalf("want the snapshot rewritten with the pinned tag, got %v with tags %v", pinned.Id, pinned.Tags)
+	}
+	if again, err := orchestrator.SetPinned(context.Background(), pinned.Id, true); err != nil || again.Id != pinned.Id {
+		t.Errorf("want pinning a pinned snapshot to leave it as is, got %v, %v", again, err)
+	}
+
+	forgotten, err := orchestrator.Forget(context.Background(), plan)
+	if err != nil {
+		t.Fatalf("forget error: %v", err)
+	}
+	if len(forgotten) != 1 || forgotten[0].Id != ids[1] {
+		t.Errorf("want only the unpinned older snapshot %v forgotten, got %v", ids[1], forgotten)
+	}
+
+	unpinned, err := orchestrator.SetPinned(context.Background(), pinned.Id, false)
+	if err != nil {
+		t.Fatalf("unpin error: %v", err)
+	}
+	if unpinned.Id == pinned.Id || slices.Contains(unpinned.Tags, PinnedTag) {
+		t.Errorf("want the snapshot rewritten without the pinned tag, got %v with tags %v", unpinned.Id, unpinned.Tags)
+	}
+}
+
+func TestRestoreWithOptions(t *testing.T) {
+	t.Parallel()
+
+	repo := t.TempDir()
+	testData := test.CreateTestData(t)
+	if err := os.Chmod(path.Join(testData, "file 0"), 0600); err != nil {
+		t.Fatalf("failed to chmod test data: %v", err)
+	}
+
+	r := &v1.Repo{
+		Id:       "test",
+		Uri:      repo,
+		Password: "test",
+		Flags:    []string{"--no-cache"},
+	}
+	plan := &v1.Plan{
+		Id:    "test",
+		Repo:  "test",
+		Paths: []string{testData},
+	}
+
+	orchestrator := newRepoOrchestrator(r, restic.NewRepo(helpers.ResticBinary(t), r, restic.WithFlags("--no-cache")))
+
+	summary, err := orchestrator.Backup(context.Background(), plan, nil)
+	if err != nil {
+		t.Fatalf("backup error: %v", err)
+	}
+
+	target := t.TempDir()
+	restoredFile := func(name string) string {
+		return restoreDestination(target, path.Join(testData, name))
+	}
+	if _, _, err := orchestrator.Restore(context.Background(), summary.SnapshotId, testData, target, nil, nil); err != nil {
+		t.Fatalf("restore error: %v", err)
+	}
+
+	old := time.Now().Add(-24 * time.Hour)
+	future := time.Now().Add(24 * time.Hour)
+	for name, mtime := range map[string]time.Time{"file 1": future, "file 2": old, "file 3": old} {
+		if err := os.WriteFile(restoredFile(name), []byte("modified"), 0644); err != nil {
+			t.Fatalf("failed to modify restored file: %v", err)
+		}
+		if err := os.Chtimes(restoredFile(name), mtime, mtime); err != nil {
+			t.Fatalf("failed to set mtime: %v", err)
+		}
+	}
+
+	assertContent := func(name, want string) {
+		t.Helper()
+		data, err := os.ReadFile(restoredFile(name))
+		if err != nil {
+			t.Fatalf("failed to read restored file: %v", err)
+		}
+		if string(data) != want {
+			t.Errorf("%v: want content %q, got %q", name, want, data)
+		}
+	}
+
+	if _, _, err := orchestrator.Restore(context.Background(), summary.SnapshotId, testData, target, &v1.RestoreOptions{
+		Overwrite: v1.RestoreOptions_OVERWRITE_IF_NEWER,
+		Excludes:  []string{"file 3"},
+	}, nil); err != nil {
+		t.Fatalf("restore error: %v", err)
+	}
+	assertContent("file 1", "modified")
+	assertContent("file 2", "test data 2")
+	assertContent("file 3", "modified")
+
+	if _, _, err := orchestrator.Restore(context.Background(), summary.SnapshotId, testData, target, &v1.RestoreOptions{
+		Overwrite: v1.RestoreOptions_OVERWRITE_NEVER,
+	}, nil); err != nil {
+		t.Fatalf("restore error: %v", err)
+	}
+	assertContent("file 3", "modified")
+
+	target = t.TempDir()
+	if _, _, err := orchestrator.Restore(context.Background(), summary.SnapshotId, testData, target, &v1.RestoreOptions{
+		Includes:        []string{"file 0", "file 1"},
+		SkipPermissions: true,
+	}, nil); err != nil {
+		t.Fatalf("restore error: %v", err)
+	}
+	ents, err := os.ReadDir(restoreDestination(target, testData))
+	if err != nil {
+		t.Fatalf("failed to read restored dir: %v", err)
+	}
+	if len(ents) != 2 {
+		t.Errorf("want 2 included files restored, got %d", len(ents))
+	}
+	info, err := os.Stat(restoredFile("file 0"))
+	if err != nil {
+		t.Fatalf("failed to stat restored file: %v", err)
+	}
+	if info.Mode().Perm() != 0644 {
+		t.Errorf("want default permissions 0644, got %v", info.Mode().Perm())
+	}
+
+	_, verification, err := orchestrator.Restore(context.Background(), summary.SnapshotId, testData, t.TempDir(), &v1.RestoreOptions{
+		Verify: true,
+	}, nil)
+	if err != nil {
+		t.Fatalf("restore error: %v", err)
+	}
+	if verification.GetFilesChecked() != 101 || len(verification.GetMismatches()) != 0 {
+		t.Errorf("want 101 files verified without mismatches, got %v", verification)
+	}
+}
+
+func TestStatsBothModes(t *testing.T) {
+	t.Parallel()
+
+	repo := t.TempDir()
+	testData := test.CreateTestData(t)
+
+	r := &v1.Repo{
+		Id:       "test",
+		Uri:      repo,
+		Password: "test",
+		Flags:    []string{"--no-cache"},
+	}
+	plan := &v1.Plan{
+		Id:    "test",
+		Repo:  "test",
+		Paths: []string{testData},
+	}
+
+	orchestrator := newRepoOrchestrator(r, restic.NewRepo(helpers.ResticBinary(t), r, restic.WithFlags("--no-cache")))
+	if _, err := orchestrator.Backup(context.Background(), plan, nil); err != nil {
+		t.Fatalf("backup error: %v", err)
+	}
+
+	stats, err := orchestrator.Stats(context.Background())
+	if err != nil {
+		t.Fatalf("stats error: %v", err)
+	}
+	if stats.TotalSize == 0 || stats.TotalBlobCount == 0 {
+		t.Errorf("expected raw-data stats, got %v", stats)
+	}
+	if stats.RestoreSize == 0 || stats.RestoreFileCount < 100 {
+		t.Errorf("expected restore-size stats covering 100 files, got %v", stats)
+	}
+
+	summary, err := orchestrator.Backup(context.Background(), plan, nil)
+	if err != nil {
+		t.Fatalf("backup error: %v", err)
+	}
+	snapshotStats, err := orchestrator.SnapshotStats(context.Background(), summary.SnapshotId)
+	if err != nil {
+		t.Fatalf("snapshot stats error: %v", err)
+	}
+	if snapshotStats.SnapshotCount != 1 {
+		t.Errorf("expected stats scoped to 1 snapshot, got %d", snapshotStats.SnapshotCount)
+	}
+	if snapshotStats.RestoreSize != stats.RestoreSize {
+		t.Errorf("expected snapshot restore size %d, got %d", stats.RestoreSize, snapshotStats.RestoreSize)
+	}
+}
+
+func TestPruneWorthwhileAfterForget(t *testing.T) {
+	t.Parallel()
+
+	testData := test.CreateTestData(t)
+	r := &v1.Repo{
+		Id:       "test",
+		Uri:      t.TempDir(),
+		Password: "test",
+		Flags:    []string{"--no-cache"},
+		PrunePolicy: &v1.PrunePolicy{
+			MinReclaimableBytes: 1 << 40,
+		},
+	}
+	plan := &v1.Plan{
+		Id:        "test",
+		Repo:      "test",
+		Paths:     []string{testData},
+		Retention: &v1.RetentionPolicy{KeepLastN: 1},
+	}
+
+	orchestrator := newRepoOrchestrator(r, restic.NewRepo(helpers.ResticBinary(t), r, restic.WithFlags("--no-cache")))
+	for i := 0; i < 2; i++ {
+		if _, err := orchestrator.Backup(context.Background(), plan, nil); err != nil {
+			t.Fatalf("failed to backup plan: %v", err)
+		}
+	}
+	if _, err := orchestrator.Forget(context.Background(), plan); err != nil {
+		t.Fatalf("forget error: %v", err)
+	}
+
+	task := &ForgetTask{plan: plan}
+	forgetOp := &v1.OperationForget{}
+	if task.pruneWorthwhile(context.Background(), orchestrator, forgetOp) {
+		t.Errorf("want prune skipped when the estimate is below the threshold")
+	}
+	if !forgetOp.PruneSkipped || forgetOp.ReclaimableBytesEstimate >= r.PrunePolicy.MinReclaimableBytes {
+		t.Errorf("want skipped prune recorded with estimate below threshold, got %v", forgetOp)
+	}
+
+	r.PrunePolicy.MinReclaimableBytes = 0
+	forgetOp = &v1.OperationForget{}
+	if !task.pruneWorthwhile(context.Background(), orchestrator, forgetOp) || forgetOp.PruneSkipped {
+		t.Errorf("want prune scheduled without a threshold, got %v", forgetOp)
+	}
+}
+
+func TestMigrationPrerequisites(t *testing.T) {
+	t.Parallel()
+
+	r := &v1.Repo{
+		Id:       "test",
+		Uri:      t.TempDir(),
+		Password: "test",
+		Flags:    []string{"--no-cache"},
+	}
+	orchestrator := newRepoOrchestrator(r, restic.NewRepo(helpers.ResticBinary(t), r, restic.WithFlags("--no-cache")))
+	if err := orchestrator.repo.Init(context.Background()); err != nil {
+		t.Fatalf("failed to init repo: %v", err)
+	}
+
+	// repos are created at version 2 so there is nothing to upgrade.
+	if err := checkMigrationPrerequisites(context.Background(), orchestrator, "upgrade_repo_v2"); !errors.Is(err, ErrMigrationUnavailable) {
+		t.Errorf("want ErrMigrationUnavailable, got %v", err)
+	}
+}
+
+func TestCacheCleanup(t *testing.T) {
+	t.Parallel()
+
+	cacheDir := t.TempDir()
+	r := &v1.Repo{
+		Id:       "test",
+		Uri:      t.TempDir(),
+		Password: "test",
+		CacheDir: cacheDir,
+	}
+	plan := &v1.Plan{
+		Id:    "test",
+		Repo:  "test",
+		Paths: []string{test.CreateTestData(t)},
+	}
+
+	orchestrator := newRepoOrchestrator(r, restic.NewRepo(helpers.ResticBinary(t), r, restic.WithFlags("--cache-dir="+cacheDir)))
+	if _, err := orchestrator.Backup(context.Background(), plan, nil); err != nil {
+		t.Fatalf("failed to backup plan %s: %v", plan.Id, err)
+	}
+
+	stats, err := orchestrator.CacheStats(context.Background())
+	if err != nil {
+		t.Fatalf("cache stats error: %v", err)
+	}
+	if path.Dir(stats.Path) != cacheDir || stats.SizeBytes == 0 || stats.TotalSizeBytes < stats.SizeBytes {
+		t.Fatalf("want a non-empty cache in %q, got %v", cacheDir, stats)
+	}
+
+	result, err := orchestrator.CacheCleanup(context.Background(), 30, 0, nil)
+	if err != nil {
+		t.Fatalf("cache cleanup error: %v", err)
+	}
+	if result.Cleared || result.SizeAfterBytes != stats.SizeBytes {
+		t.Errorf("want the cache kept without a size limit, got %v", result)
+	}
+
+	result, err = orchestrator.CacheCleanup(context.Background(), 30, 1, nil)
+	if err != nil {
+		t.Fatalf("cache cleanup error: %v", err)
+	}
+	if !result.Cleared || result.SizeBeforeBytes != stats.SizeBytes || result.SizeAfterBytes != 0 {
+		t.Errorf("want the cache cleared once larger than the maximum size, got %v", result)
+	}
+}
@@ -0,0 +1,60 @@
+package orchestrator
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/pkg/restic"
+)
+
+// resourceLimitOpts returns the restic options applying a plan's resource limits to the restic processes it spawns.
+func resourceLimitOpts(limits *v1.ResourceLimits) []restic.GenericOption {
+	if limits == nil {
+		return nil
+	}
+
+	var opts []restic.GenericOption
+	if limits.Gomaxprocs > 0 {
+		opts = append(opts, restic.WithEnv(fmt.Sprintf("GOMAXPROCS=%d", limits.Gomaxprocs)))
+	}
+	if prefix := resourceLimitPrefix(limits, runtime.GOOS, os.Geteuid()); len(prefix) > 0 {
+		opts = append(opts, restic.WithCommandPrefix(prefix...))
+	}
+	return opts
+}
+
+// resourceLimitPrefix builds the command restic is run through to apply the limits, limits that are not supported
+// on goos are ignored. cgroup limits are applied by running restic in a transient systemd scope, in the user's
+// service manager when not running as root which requires the memory and io controllers to be delegated to it.
+func resourceLimitPrefix(limits *v1.ResourceLimits, goos string, euid int) []string {
+	var prefix []string
+
+	if goos == "linux" && (limits.MemoryMax != "" || limits.IoWeight != 0) {
+		prefix = append(prefix, "systemd-run", "--scope", "--quiet", "--collect")
+		if euid != 0 {
+			prefix = append(prefix, "--user")
+		}
+		if limits.MemoryMax != "" {
+			prefix = append(prefix, "-p", "MemoryMax="+limits.MemoryMax)
+		}
+		if limits.IoWeight != 0 {
+			prefix = append(prefix, "-p", fmt.Sprintf("IOWeight=%d", limits.IoWeight))
+		}
+		prefix = append(prefix, "--")
+	}
+
+	if goos != "windows" && limits.Nice != 0 {
+		prefix = append(prefix, "nice", "-n", fmt.Sprintf("%d", limits.Nice))
+	}
+
+	if goos == "linux" && limits.IoClass != v1.ResourceLimits_IO_CLASS_UNCHANGED {
+		prefix = append(prefix, "ionice", "-c", fmt.Sprintf("%d", limits.IoClass))
+		if limits.IoClass != v1.ResourceLimits_IO_CLASS_IDLE {
+			prefix = append(prefix, "-n", fmt.Sprintf("%d", limits.IoPriority))
+		}
+	}
+
+	return prefix
+}
@@ -0,0 +1,70 @@
+package orchestrator
+
+import (
+	"slices"
+	"testing"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+)
+
+func TestResourceLimitPrefix(t *testing.T) {
+	tests := []struct {
+		name   string
+		limits *v1.ResourceLimits
+		goos   string
+		euid   int
+		want   []string
+	}{
+		{
+			name:   "no limits",
+			limits: &v1.ResourceLimits{},
+			goos:   "linux",
+		},
+		{
+			name:   "nice and ionice",
+			limits: &v1.ResourceLimits{Nice: 10, IoClass: v1.ResourceLimits_IO_CLASS_BEST_EFFORT, IoPriority: 7},
+			goos:   "linux",
+			want:   []string{"nice", "-n", "10", "ionice", "-c", "2", "-n", "7"},
+		},
+		{
+			name:   "idle io class has no priority",
+			limits: &v1.ResourceLimits{IoClass: v1.ResourceLimits_IO_CLASS_IDLE, IoPriority: 3},
+			goos:   "linux",
+			want:   []string{"ionice", "-c", "3"},
+		},
+		{
+			name:   "cgroup limits as user",
+			limits: &v1.ResourceLimits{MemoryMax: "2G", IoWeight: 50, Nice: 5},
+			goos:   "linux",
+			euid:   1000,
+			want:   []string{"systemd-run", "--scope", "--quiet", "--collect", "--user", "-p", "MemoryMax=2G", "-p", "IOWeight=50", "--", "nice", "-n", "5"},
+		},
+		{
+			name:   "cgroup limits as root",
+			limits: &v1.ResourceLimits{MemoryMax: "512M"},
+			goos:   "linux",
+			want:   []string{"systemd-run", "--scope", "--quiet", "--collect", "-p", "MemoryMax=512M", "--"},
+		},
+		{
+			name:   "darwin only supports nice",
+			limits: &v1.ResourceLimits{Nice: 5, IoClass: v1.ResourceLimits_IO_CLASS_IDLE, MemoryMax: "2G"},
+			goos:   "darwin",
+			want:   []string{"nice", "-n", "5"},
+		},
+		{
+			name:   "windows ignores limits",
+			limits: &v1.ResourceLimits{Nice: 5, IoClass: v1.ResourceLimits_IO_CLASS_IDLE, MemoryMax: "2G"},
+			goos:   "windows",
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			got := resourceLimitPrefix(tc.limits, tc.goos, tc.euid)
+			if !slices.Equal(got, tc.want) {
+				t.Errorf("want prefix %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
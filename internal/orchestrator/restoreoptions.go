@@ -0,0 +1,246 @@
+package orchestrator
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/pkg/restic"
+	"github.com/hashicorp/go-multierror"
+)
+
+// restoreFilter selects the entries of a snapshot that a restore with the given options will write.
+type restoreFilter struct {
+	includes []string
+	excludes []string
+}
+
+func newRestoreFilter(snapshotPath string, opts *v1.RestoreOptions) *restoreFilter {
+	f := &restoreFilter{excludes: opts.GetExcludes()}
+	for _, include := range opts.GetIncludes() {
+		if !strings.HasPrefix(include, "/") {
+			// relative patterns match anywhere beneath the path being restored.
+			include = path.Join(snapshotPath, "**", include)
+		}
+		f.includes = append(f.includes, include)
+	}
+	if len(f.includes) == 0 && snapshotPath != "" {
+		f.includes = []string{snapshotPath}
+	}
+	return f
+}
+
+// flags returns the restic restore flags implementing a filter without excludes.
+func (f *restoreFilter) flags() []restic.GenericOption {
+	var opts []restic.GenericOption
+	for _, include := range f.includes {
+		opts = append(opts, restic.WithFlags("--include", include))
+	}
+	return opts
+}
+
+func (f *restoreFilter) matches(p string) bool {
+	for _, exclude := range f.excludes {
+		if matchPattern(exclude, p) {
+			return false
+		}
+	}
+	if len(f.includes) == 0 {
+		return true
+	}
+	for _, include := range f.includes {
+		if matchPattern(include, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchPattern reports whether a restic filter pattern matches p or one of its parent directories.
+// Patterns that are not absolute may match at any depth, "**" matches any number of path components.
+func matchPattern(pattern, p string) bool {
+	pat := splitPath(pattern)
+	if !strings.HasPrefix(pattern, "/") {
+		pat = append([]string{"**"}, pat...)
+	}
+	return matchComponents(pat, splitPath(p))
+}
+
+func matchComponents(pat, strs []string) bool {
+	if len(pat) == 0 {
+		return true // the remaining components are beneath a match.
+	}
+	if pat[0] == "**" {
+		for i := 0; i <= len(strs); i++ {
+			if matchComponents(pat[1:], strs[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+	if len(strs) == 0 {
+		return false
+	}
+	if ok, _ := path.Match(pat[0], strs[0]); !ok {
+		return false
+	}
+	return matchComponents(pat[1:], strs[1:])
+}
+
+func splitPath(p string) []string {
+	var components []string
+	for _, c := range strings.Split(p, "/") {
+		if c != "" {
+			components = append(components, c)
+		}
+	}
+	return components
+}
+
+// escapePattern escapes the characters restic treats as wildcards so that p only matches itself.
+func escapePattern(p string) string {
+	if runtime.GOOS == "windows" {
+		return p // filepath.Match does not support escaping on windows.
+	}
+	var sb strings.Builder
+	for _, c := range p {
+		switch c {
+		case '\\', '*', '?', '[':
+			sb.WriteRune('\\')
+		}
+		sb.WriteRune(c)
+	}
+	return sb.String()
+}
+
+// selectRestoredEntries returns the entries matched by the filter that the overwrite policy allows writing to target,
+// skipped is true if any matched entry is left untouched because it already exists.
+func selectRestoredEntries(entries []*restic.LsEntry, filter *restoreFilter, policy v1.RestoreOptions_OverwritePolicy, target string) (selected []*restic.LsEntry, skipped bool) {
+	for _, entry := range entries {
+		if !filter.matches(entry.Path) {
+			continue
+		}
+		if skipExisting(policy, entry, restoreDestination(target, entry.Path)) {
+			skipped = true
+			continue
+		}
+		selected = append(selected, entry)
+	}
+	return selected, skipped
+}
+
+// includeFlags returns include patterns matching exactly the selected entries. Directories are only included
+// if they are empty since including a directory restores everything beneath it, restic creates the parents
+// of included files itself.
+func includeFlags(entries []*restic.LsEntry, selected []*restic.LsEntry) []restic.GenericOption {
+	parents := make(map[string]struct{})
+	for _, entry := range entries {
+		parents[path.Dir(entry.Path)] = struct{}{}
+	}
+
+	var opts []restic.GenericOption
+	for _, entry := range selected {
+		if entry.Type == "dir" {
+			if _, ok := parents[entry.Path]; ok {
+				continue
+			}
+		}
+		opts = append(opts, restic.WithFlags("--include", escapePattern(entry.Path)))
+	}
+	return opts
+}
+
+// restoreDestination returns the location restic writes a snapshot path to when restoring to target.
+func restoreDestination(target, snapshotPath string) string {
+	return filepath.Join(target, filepath.FromSlash(snapshotPath))
+}
+
+// skipExisting reports whether the overwrite policy requires leaving the file at dst untouched.
+func skipExisting(policy v1.RestoreOptions_OverwritePolicy, entry *restic.LsEntry, dst string) bool {
+	if policy == v1.RestoreOptions_OVERWRITE_ALWAYS || entry.Type == "dir" {
+		return false
+	}
+	info, err := os.Lstat(dst)
+	if err != nil {
+		return false
+	}
+	if policy == v1.RestoreOptions_OVERWRITE_NEVER {
+		return true
+	}
+	mtime, err := time.Parse(time.RFC3339Nano, entry.Mtime)
+	if err != nil {
+		return false
+	}
+	return !mtime.After(info.ModTime())
+}
+
+// resetRestoredMetadata replaces the ownership and permissions restic restored from the snapshot with
+// those of a newly created file, as requested by the restore options.
+func resetRestoredMetadata(target string, entries []*restic.LsEntry, opts *v1.RestoreOptions) error {
+	var err error
+	for _, entry := range entries {
+		if entry.Type == "symlink" {
+			continue
+		}
+		dst := restoreDestination(target, entry.Path)
+		if opts.GetSkipOwnership() && runtime.GOOS != "windows" {
+			if e := os.Lchown(dst, os.Getuid(), os.Getgid()); e != nil && !errors.Is(e, os.ErrNotExist) {
+				err = multierror.Append(err, fmt.Errorf("chown %v: %w", dst, e))
+			}
+		}
+		if opts.GetSkipPermissions() {
+			mode := os.FileMode(0644)
+			if entry.Type == "dir" {
+				mode = 0755
+			}
+			if e := os.Chmod(dst, mode); e != nil && !errors.Is(e, os.ErrNotExist) {
+				err = multierror.Append(err, fmt.Errorf("chmod %v: %w", dst, e))
+			}
+		}
+	}
+	return err
+}
+
+// verifyRestoredEntries checks that each restored entry exists at target with the type and size recorded in the snapshot.
+// File contents are verified by restic itself when the restore is run with --verify.
+func verifyRestoredEntries(target string, entries []*restic.LsEntry) *v1.RestoreVerification {
+	verification := &v1.RestoreVerification{}
+	for _, entry := range entries {
+		dst := restoreDestination(target, entry.Path)
+		verification.FilesChecked++
+
+		info, err := os.Lstat(dst)
+		if err != nil {
+			verification.Mismatches = append(verification.Mismatches, fmt.Sprintf("%v: %v", entry.Path, err))
+			continue
+		}
+
+		var gotType string
+		switch {
+		case info.IsDir():
+			gotType = "dir"
+		case info.Mode()&os.ModeSymlink != 0:
+			gotType = "symlink"
+		case info.Mode().IsRegular():
+			gotType = "file"
+		default:
+			gotType = "other"
+		}
+		if entry.Type == "dir" || entry.Type == "symlink" || entry.Type == "file" {
+			if gotType != entry.Type {
+				verification.Mismatches = append(verification.Mismatches, fmt.Sprintf("%v: restored as %v, want %v", entry.Path, gotType, entry.Type))
+				continue
+			}
+		}
+		if entry.Type == "file" && info.Size() != int64(entry.Size) {
+			verification.Mismatches = append(verification.Mismatches, fmt.Sprintf("%v: restored size %d, want %d", entry.Path, info.Size(), entry.Size))
+		}
+	}
+	return verification
+}
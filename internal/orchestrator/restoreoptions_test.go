@@ -0,0 +1,78 @@
+package orchestrator
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/pkg/restic"
+)
+
+func TestMatchPattern(t *testing.T) {
+	tcs := []struct {
+		pattern string
+		path    string
+		want    bool
+	}{
+		{"/home/user", "/home/user", true},
+		{"/home/user", "/home/user/docs/a.txt", true},
+		{"/home/user", "/home/other", false},
+		{"*.txt", "/home/user/a.txt", true},
+		{"*.txt", "/home/user/a.jpg", false},
+		{"docs", "/home/user/docs/a.txt", true},
+		{"/home/**/a.txt", "/home/user/docs/a.txt", true},
+		{"/home/*/a.txt", "/home/user/docs/a.txt", false},
+		{"/", "/anything", true},
+	}
+
+	for _, tc := range tcs {
+		if got := matchPattern(tc.pattern, tc.path); got != tc.want {
+			t.Errorf("matchPattern(%q, %q) = %v, want %v", tc.pattern, tc.path, got, tc.want)
+		}
+	}
+}
+
+func TestRestoreFilter(t *testing.T) {
+	f := newRestoreFilter("/home/user", nil)
+	if !f.matches("/home/user/a.txt") || f.matches("/etc/passwd") {
+		t.Errorf("default filter should select the restored path only")
+	}
+
+	f = newRestoreFilter("/home/user", &v1.RestoreOptions{
+		Includes: []string{"*.txt"},
+		Excludes: []string{"secret.txt"},
+	})
+	if !f.matches("/home/user/docs/a.txt") {
+		t.Errorf("want relative include to match beneath the restored path")
+	}
+	if f.matches("/etc/a.txt") {
+		t.Errorf("want relative include not to match outside the restored path")
+	}
+	if f.matches("/home/user/secret.txt") {
+		t.Errorf("want excluded file not to match")
+	}
+}
+
+func TestVerifyRestoredEntries(t *testing.T) {
+	target := t.TempDir()
+	if err := os.MkdirAll(path.Join(target, "data"), 0755); err != nil {
+		t.Fatalf("failed to create dir: %v", err)
+	}
+	if err := os.WriteFile(path.Join(target, "data", "a"), []byte("abc"), 0644); err != nil {
+		t.Fatalf("failed to write file: %v", err)
+	}
+
+	verification := verifyRestoredEntries(target, []*restic.LsEntry{
+		{Path: "/data", Type: "dir"},
+		{Path: "/data/a", Type: "file", Size: 3},
+		{Path: "/data/b", Type: "file", Size: 1},
+		{Path: "/data/a", Type: "file", Size: 4},
+	})
+	if verification.FilesChecked != 4 {
+		t.Errorf("want 4 files checked, got %d", verification.FilesChecked)
+	}
+	if len(verification.Mismatches) != 2 {
+		t.Errorf("want missing and truncated files reported, got %v", verification.Mismatches)
+	}
+}
@@ -0,0 +1,91 @@
+package orchestrator
+
+import (
+	"hash/fnv"
+	"time"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/gitploy-io/cronexpr"
+)
+
+// jitterOffset returns how long after its cron times the plan's scheduled tasks start. The offset is derived from
+// the plan's ID so it is stable across restarts and plans sharing a schedule don't all start at once.
+func jitterOffset(plan *v1.Plan) time.Duration {
+	window := time.Duration(plan.GetScheduleJitterMinutes()) * time.Minute
+	if window <= 0 {
+		return 0
+	}
+	h := fnv.New64a()
+	h.Write([]byte(plan.Id))
+	return time.Duration(h.Sum64()%uint64(window/time.Second)) * time.Second
+}
+
+// jitteredScheduler returns the next run of the cron schedule delayed by the plan's jitter offset.
+func jitteredScheduler(sched *cronexpr.Schedule, plan *v1.Plan) func(curTime time.Time) *time.Time {
+	offset := jitterOffset(plan)
+	return func(curTime time.Time) *time.Time {
+		// shifting the current time back keeps a cron time whose delayed start is still ahead.
+		next := nextCronTime(sched, curTime.Add(-offset)).Add(offset)
+		return &next
+	}
+}
+
+// planLocation returns the time zone the plan's schedules are evaluated in.
+func planLocation(plan *v1.Plan) string {
+	if plan.GetTimezone() != "" {
+		return plan.Timezone
+	}
+	return time.Now().Location().String()
+}
+
+// nextCronTime returns the next cron time after curTime with daylight saving changes handled deterministically:
+// cron times skipped when the clocks move forward run at the end of the gap and cron times repeated when the clocks
+// move back only run at their first occurrence.
+func nextCronTime(sched *cronexpr.Schedule, curTime time.Time) time.Time {
+	next := sched.Next(curTime)
+	if next.IsZero() || sched.Location == nil {
+		return next
+	}
+	loc := sched.Location
+	_, curOffset := curTime.In(loc).Zone()
+	_, nextOffset := next.In(loc).Zone()
+
+	if nextOffset > curOffset {
+		// the clocks moved forward, a cron time in the skipped wall clock times is found evaluating the schedule
+		// with the offset from before the change.
+		transition := zoneTransition(loc, curTime, next)
+		before := *sched
+		before.Location = time.FixedZone("", curOffset)
+		if skipped := before.Next(curTime); !skipped.Before(transition) && skipped.Before(transition.Add(time.Duration(nextOffset-curOffset)*time.Second)) {
+			return transition
+		}
+	}
+
+	// a cron time in wall clock times repeated after the clocks moved back already ran at its first occurrence.
+	localNext := next.In(loc)
+	for _, d := range []time.Duration{30 * time.Minute, time.Hour, 2 * time.Hour} {
+		earlier := next.Add(-d).In(loc)
+		if _, offset := earlier.Zone(); offset > nextOffset && sameWallClock(earlier, localNext) {
+			return nextCronTime(sched, next)
+		}
+	}
+	return next
+}
+
+// zoneTransition returns the first instant in (from, to] with the time zone offset of to, to the second.
+func zoneTransition(loc *time.Location, from, to time.Time) time.Time {
+	_, target := to.In(loc).Zone()
+	for to.Sub(from) > time.Second {
+		mid := from.Add(to.Sub(from) / 2)
+		if _, offset := mid.In(loc).Zone(); offset == target {
+			to = mid
+		} else {
+			from = mid
+		}
+	}
+	return to.Truncate(time.Second)
+}
+
+func sameWallClock(a, b time.Time) bool {
+	return a.Year() == b.Year() && a.YearDay() == b.YearDay() && a.Hour() == b.Hour() && a.Minute() == b.Minute()
+}
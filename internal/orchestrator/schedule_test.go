@@ -0,0 +1,124 @@
+package orchestrator
+
+import (
+	"testing"
+	"time"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/gitploy-io/cronexpr"
+)
+
+func TestJitteredScheduler(t *testing.T) {
+	t.Parallel()
+
+	sched, err := cronexpr.ParseInLocation("0 2 * * *", "UTC")
+	if err != nil {
+		t.Fatalf("parse cron: %v", err)
+	}
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	if next := jitteredScheduler(sched, &v1.Plan{Id: "plan"})(base); !next.Equal(base.Add(2 * time.Hour)) {
+		t.Errorf("want no delay without jitter, got %v", next)
+	}
+
+	offsets := make(map[time.Duration]bool)
+	for _, id := range []string{"plan1", "plan2", "plan3", "plan4", "plan5"} {
+		plan := &v1.Plan{Id: id, ScheduleJitterMinutes: 30}
+		offset := jitterOffset(plan)
+		if offset < 0 || offset >= 30*time.Minute {
+			t.Fatalf("plan %s: offset %v outside of the jitter window", id, offset)
+		}
+		if offset != jitterOffset(plan) {
+			t.Fatalf("plan %s: want a stable offset", id)
+		}
+		offsets[offset] = true
+
+		scheduler := jitteredScheduler(sched, plan)
+		first := base.Add(2*time.Hour + offset)
+		if next := scheduler(base); !next.Equal(first) {
+			t.Errorf("plan %s: want the first run at %v, got %v", id, first, next)
+		}
+		// between the cron time and the delayed start the pending run is kept.
+		if next := scheduler(base.Add(2 * time.Hour)); offset > 0 && !next.Equal(first) {
+			t.Errorf("plan %s: want the delayed run at %v kept, got %v", id, first, next)
+		}
+		if next := scheduler(first); !next.Equal(first.Add(24 * time.Hour)) {
+			t.Errorf("plan %s: want the next run a day later, got %v", id, next)
+		}
+	}
+	if len(offsets) < 2 {
+		t.Errorf("want plans spread over the jitter window, got offsets %v", offsets)
+	}
+}
+
+func TestNextCronTimeDaylightSaving(t *testing.T) {
+	t.Parallel()
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("load location: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		cron string
+		from time.Time
+		want []time.Time
+	}{
+		{
+			name: "skipped time runs at the end of the gap",
+			cron: "30 2 * * *",
+			// the clocks move from 02:00 to 03:00 on 2024-03-31.
+			from: time.Date(2024, 3, 30, 12, 0, 0, 0, berlin),
+			want: []time.Time{
+				time.Date(2024, 3, 31, 3, 0, 0, 0, berlin),
+				time.Date(2024, 4, 1, 2, 30, 0, 0, berlin),
+			},
+		},
+		{
+			name: "repeated time runs once",
+			cron: "30 2 * * *",
+			// the clocks move from 03:00 back to 02:00 on 2024-10-27.
+			from: time.Date(2024, 10, 26, 12, 0, 0, 0, berlin),
+			want: []time.Time{
+				time.Date(2024, 10, 27, 0, 30, 0, 0, time.UTC), // 02:30 CEST
+				time.Date(2024, 10, 28, 2, 30, 0, 0, berlin),
+			},
+		},
+		{
+			name: "hourly schedule across the repeated hour",
+			cron: "0 * * * *",
+			from: time.Date(2024, 10, 27, 0, 30, 0, 0, time.UTC), // 02:30 CEST
+			want: []time.Time{
+				time.Date(2024, 10, 27, 3, 0, 0, 0, berlin), // 02:00 CET already ran as 02:00 CEST.
+				time.Date(2024, 10, 27, 4, 0, 0, 0, berlin),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			sched, err := cronexpr.ParseInLocation(tc.cron, "Europe/Berlin")
+			if err != nil {
+				t.Fatalf("parse cron: %v", err)
+			}
+			cur := tc.from
+			for _, want := range tc.want {
+				next := nextCronTime(sched, cur)
+				if !next.Equal(want) {
+					t.Fatalf("after %v: want %v, got %v", cur.In(berlin), want.In(berlin), next.In(berlin))
+				}
+				cur = next
+			}
+		})
+	}
+
+	plan := &v1.Plan{Id: "plan", Timezone: "America/New_York"}
+	sched, err := cronexpr.ParseInLocation("0 9 * * *", planLocation(plan))
+	if err != nil {
+		t.Fatalf("parse cron: %v", err)
+	}
+	if next := jitteredScheduler(sched, plan)(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)); !next.Equal(time.Date(2024, 1, 1, 14, 0, 0, 0, time.UTC)) {
+		t.Errorf("want the schedule evaluated in the plan's time zone, got %v", next)
+	}
+}
@@ -0,0 +1,108 @@
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/oplog"
+	"github.com/garethgeorge/backrest/internal/oplog/indexutil"
+	"github.com/garethgeorge/backrest/internal/protoutil"
+	"github.com/garethgeorge/backrest/pkg/restic"
+)
+
+// ErrSnapshotPinned is returned when forgetting a pinned snapshot, it has to be unpinned first.
+var ErrSnapshotPinned = errors.New("snapshot is pinned")
+
+// isPinned reports whether the snapshot has the pinned tag.
+func isPinned(snapshot *v1.ResticSnapshot) bool {
+	return slices.Contains(snapshot.GetTags(), PinnedTag)
+}
+
+// PinSnapshot pins or unpins the snapshot and returns its ID, which changes if restic rewrote the snapshot. The
+// snapshot's operations are moved to the new ID so its history and note are kept.
+func (o *Orchestrator) PinSnapshot(ctx context.Context, repoId, snapshotId string, pinned bool) (string, error) {
+	repo, err := o.GetRepo(repoId)
+	if err != nil {
+		return "", fmt.Errorf("get repo %q: %w", repoId, err)
+	}
+	snapshot, err := repo.SetPinned(ctx, snapshotId, pinned)
+	if err != nil {
+		return "", err
+	}
+	if snapshot.Id == snapshotId {
+		return snapshotId, nil
+	}
+	if err := relinkSnapshot(o.OpLog, snapshotId, snapshot); err != nil {
+		return "", fmt.Errorf("move operations of snapshot %v to %v: %w", snapshotId, snapshot.Id, err)
+	}
+	return snapshot.Id, nil
+}
+
+// AnnotateSnapshot sets the note of the snapshot's index operation, an empty note removes it.
+func (o *Orchestrator) AnnotateSnapshot(repoId, snapshotId, note string) error {
+	var indexOp *v1.Operation
+	if err := o.OpLog.ForEachBySnapshotId(snapshotId, indexutil.CollectAll(), func(op *v1.Operation) error {
+		if index := op.GetOperationIndexSnapshot(); op.RepoId == repoId && index != nil && !index.Forgot {
+			indexOp = op
+			return oplog.ErrStopIteration
+		}
+		return nil
+	}); err != nil {
+		return fmt.Errorf("get operations of snapshot %v: %w", snapshotId, err)
+	}
+	if indexOp == nil {
+		return fmt.Errorf("snapshot %q of repo %q is not indexed", snapshotId, repoId)
+	}
+
+	indexOp.GetOperationIndexSnapshot().Note = note
+	if err := o.OpLog.Update(indexOp); err != nil {
+		return fmt.Errorf("update index operation %v: %w", indexOp.Id, err)
+	}
+	return nil
+}
+
+// relinkSnapshot moves the operations of a snapshot to the snapshot restic rewrote it as. If the rewritten snapshot
+// was already indexed its note is carried over and the old index operation is left to be marked forgotten.
+func relinkSnapshot(log *oplog.OpLog, oldId string, snapshot *restic.Snapshot) error {
+	var ops []*v1.Operation
+	if err := log.ForEachBySnapshotId(oldId, indexutil.CollectAll(), func(op *v1.Operation) error {
+		ops = append(ops, op)
+		return nil
+	}); err != nil {
+		return err
+	}
+	var indexed *v1.Operation
+	if err := log.ForEachBySnapshotId(snapshot.Id, indexutil.CollectAll(), func(op *v1.Operation) error {
+		if op.GetOperationIndexSnapshot() != nil {
+			indexed = op
+			return oplog.ErrStopIteration
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	for _, op := range ops {
+		if index := op.GetOperationIndexSnapshot(); index != nil {
+			if indexed != nil {
+				if note := index.Note; note != "" && indexed.GetOperationIndexSnapshot().Note == "" {
+					indexed.GetOperationIndexSnapshot().Note = note
+					if err := log.Update(indexed); err != nil {
+						return fmt.Errorf("update operation %v: %w", indexed.Id, err)
+					}
+				}
+				continue
+			}
+			index.Snapshot = protoutil.SnapshotToProto(snapshot)
+			index.Forgot = false
+		}
+		op.SnapshotId = snapshot.Id
+		if err := log.Update(op); err != nil {
+			return fmt.Errorf("update operation %v: %w", op.Id, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package orchestrator
+
+import (
+	"testing"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/oplog"
+	"github.com/garethgeorge/backrest/pkg/restic"
+)
+
+func TestRelinkSnapshot(t *testing.T) {
+	t.Parallel()
+
+	log, err := oplog.NewOpLog(t.TempDir() + "/oplog.boltdb")
+	if err != nil {
+		t.Fatalf("error creating oplog: %s", err)
+	}
+	t.Cleanup(func() { log.Close() })
+
+	oldId := "1111111111111111111111111111111111111111111111111111111111111111"
+	newId := "2222222222222222222222222222222222222222222222222222222222222222"
+	index := &v1.Operation{
+		RepoId:     "repo1",
+		PlanId:     "plan1",
+		SnapshotId: oldId,
+		Status:     v1.OperationStatus_STATUS_SUCCESS,
+		Op: &v1.Operation_OperationIndexSnapshot{
+			OperationIndexSnapshot: &v1.OperationIndexSnapshot{
+				Snapshot: &v1.ResticSnapshot{Id: oldId, Tags: []string{"plan:plan1"}},
+				Note:     "before the upgrade",
+			},
+		},
+	}
+	backup := &v1.Operation{
+		RepoId:     "repo1",
+		PlanId:     "plan1",
+		SnapshotId: oldId,
+		Status:     v1.OperationStatus_STATUS_SUCCESS,
+		Op:         &v1.Operation_OperationBackup{OperationBackup: &v1.OperationBackup{}},
+	}
+	for _, op := range []*v1.Operation{index, backup} {
+		if err := log.Add(op); err != nil {
+			t.Fatalf("error adding operation: %s", err)
+		}
+	}
+
+	rewritten := &restic.Snapshot{Id: newId, Time: "2024-01-01T00:00:00Z", Tags: []string{"plan:plan1", PinnedTag}, Original: oldId}
+	if err := relinkSnapshot(log, oldId, rewritten); err != nil {
+		t.Fatalf("relinkSnapshot() error: %v", err)
+	}
+
+	for _, id := range []int64{index.Id, backup.Id} {
+		op, err := log.Get(id)
+		if err != nil {
+			t.Fatalf("Get() error: %v", err)
+		}
+		if op.SnapshotId != newId {
+			t.Errorf("want operation %d moved to snapshot %v, got %v", id, newId, op.SnapshotId)
+		}
+	}
+	op, _ := log.Get(index.Id)
+	if got := op.GetOperationIndexSnapshot(); got.Snapshot.Id != newId || !isPinned(got.Snapshot) || got.Note != "before the upgrade" {
+		t.Errorf("want the index operation to describe the pinned snapshot and keep its note, got %v", got)
+	}
+}
@@ -0,0 +1,121 @@
+package orchestrator
+
+import (
+	"context"
+	"path"
+	"slices"
+	"strings"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/pkg/restic"
+)
+
+const (
+	// maxLargestEntries is how many of a snapshot's largest files and directories are kept.
+	maxLargestEntries = 1000
+	// snapshotSizesCacheSize is how many snapshots per repo the sizes are cached for, snapshots are immutable so the
+	// sizes never need to be recomputed.
+	snapshotSizesCacheSize = 16
+)
+
+// snapshotSizes holds the cumulative sizes of a snapshot's directories and its largest files and directories.
+type snapshotSizes struct {
+	dirs         map[string]int64 // cumulative size of the files beneath each directory by path.
+	largestFiles []*v1.LsEntry    // sorted by size, largest first.
+	largestDirs  []*v1.LsEntry    // sorted by size, largest first.
+}
+
+func computeSnapshotSizes(entries []*restic.LsEntry) *snapshotSizes {
+	sizes := &snapshotSizes{dirs: make(map[string]int64)}
+	holdsFiles := make(map[string]bool)
+	var files []*v1.LsEntry
+	for _, e := range entries {
+		if e.Type != "file" {
+			continue
+		}
+		holdsFiles[path.Dir(e.Path)] = true
+		for dir := path.Dir(e.Path); ; dir = path.Dir(dir) {
+			sizes.dirs[dir] += int64(e.Size)
+			if dir == "/" || dir == "." {
+				break
+			}
+		}
+		files = append(files, e.ToProto())
+	}
+	sortBySizeDesc(files)
+	sizes.largestFiles = files[:min(len(files), maxLargestEntries)]
+
+	// directories holding nothing but one subdirectory are as large as it is, they are left out of the largest.
+	subdirs := make(map[string]int)
+	for dir := range sizes.dirs {
+		if parent := path.Dir(dir); parent != dir {
+			subdirs[parent]++
+		}
+	}
+	var dirs []*v1.LsEntry
+	for dir, size := range sizes.dirs {
+		if subdirs[dir] == 1 && !holdsFiles[dir] {
+			continue
+		}
+		dirs = append(dirs, &v1.LsEntry{Name: path.Base(dir), Type: "dir", Path: dir, Size: size})
+	}
+	sortBySizeDesc(dirs)
+	sizes.largestDirs = dirs[:min(len(dirs), maxLargestEntries)]
+	return sizes
+}
+
+func sortBySizeDesc(entries []*v1.LsEntry) {
+	slices.SortStableFunc(entries, func(a, b *v1.LsEntry) int {
+		if a.Size != b.Size {
+			if a.Size > b.Size {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(a.Path, b.Path)
+	})
+}
+
+// snapshotSizes returns the sizes of the snapshot's contents, computed with a recursive listing of the snapshot the
+// first time they are requested.
+func (r *RepoOrchestrator) snapshotSizes(ctx context.Context, snapshotId string) (*snapshotSizes, error) {
+	if sizes, ok := r.sizes.Get(snapshotId); ok {
+		return sizes, nil
+	}
+	entries, err := r.ListSnapshotFilesRecursive(ctx, snapshotId, "/")
+	if err != nil {
+		return nil, err
+	}
+	sizes := computeSnapshotSizes(entries)
+	r.sizes.Add(snapshotId, sizes)
+	return sizes, nil
+}
+
+// ListSnapshotFilesWithSizes lists the entries in the directory of the snapshot, the size of directories is the
+// cumulative size of the files beneath them.
+func (r *RepoOrchestrator) ListSnapshotFilesWithSizes(ctx context.Context, snapshotId string, dir string) ([]*v1.LsEntry, error) {
+	entries, err := r.ListSnapshotFiles(ctx, snapshotId, dir)
+	if err != nil {
+		return nil, err
+	}
+	sizes, err := r.snapshotSizes(ctx, snapshotId)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range entries {
+		if e.Type == "dir" {
+			e.Size = sizes.dirs[e.Path]
+		}
+	}
+	return entries, nil
+}
+
+// LargestEntries returns the n largest files and directories of the snapshot.
+func (r *RepoOrchestrator) LargestEntries(ctx context.Context, snapshotId string, n int) (files []*v1.LsEntry, dirs []*v1.LsEntry, err error) {
+	sizes, err := r.snapshotSizes(ctx, snapshotId)
+	if err != nil {
+		return nil, nil, err
+	}
+	n = min(n, maxLargestEntries)
+	return sizes.largestFiles[:min(len(sizes.largestFiles), n)], sizes.largestDirs[:min(len(sizes.largestDirs), n)], nil
+}
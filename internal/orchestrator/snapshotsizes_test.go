@@ -0,0 +1,44 @@
+package orchestrator
+
+import (
+	"testing"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/pkg/restic"
+)
+
+func TestComputeSnapshotSizes(t *testing.T) {
+	sizes := computeSnapshotSizes([]*restic.LsEntry{
+		{Type: "dir", Path: "/home"},
+		{Type: "dir", Path: "/home/alice"},
+		{Type: "dir", Path: "/home/alice/videos"},
+		{Type: "file", Path: "/home/alice/videos/a.mp4", Size: 1000},
+		{Type: "file", Path: "/home/alice/videos/b.mp4", Size: 3000},
+		{Type: "dir", Path: "/home/alice/docs"},
+		{Type: "file", Path: "/home/alice/docs/cv.pdf", Size: 50},
+		{Type: "symlink", Path: "/home/alice/link", Size: 999},
+		{Type: "file", Path: "/home/alice/notes.txt", Size: 5},
+	})
+
+	for dir, want := range map[string]int64{"/": 4055, "/home": 4055, "/home/alice": 4055, "/home/alice/videos": 4000, "/home/alice/docs": 50} {
+		if got := sizes.dirs[dir]; got != want {
+			t.Errorf("dir %s: want size %d, got %d", dir, want, got)
+		}
+	}
+
+	if got := entryPaths(sizes.largestFiles); len(got) != 4 || got[0] != "/home/alice/videos/b.mp4" || got[3] != "/home/alice/notes.txt" {
+		t.Errorf("want the files largest first, got %v", got)
+	}
+	// "/" and "/home" hold nothing but one subdirectory.
+	if got := entryPaths(sizes.largestDirs); len(got) != 3 || got[0] != "/home/alice" || got[1] != "/home/alice/videos" || got[2] != "/home/alice/docs" {
+		t.Errorf("want the directories largest first, got %v", got)
+	}
+}
+
+func entryPaths(entries []*v1.LsEntry) []string {
+	var p []string
+	for _, e := range entries {
+		p = append(p, e.Path)
+	}
+	return p
+}
@@ -4,14 +4,22 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"sync/atomic"
 	"time"
 
 	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/diskspace"
+	"github.com/garethgeorge/backrest/internal/hook"
 	"github.com/garethgeorge/backrest/internal/oplog"
+	"github.com/garethgeorge/backrest/pkg/restic"
 	"github.com/hashicorp/go-multierror"
+	"go.uber.org/zap"
 )
 
+// ErrTaskTimeout is returned by tasks interrupted because they exceeded their plan's timeout.
+var ErrTaskTimeout = errors.New("task timed out")
+
 type Task interface {
 	Name() string                               // huamn readable name for this task.
 	Next(now time.Time) *time.Time              // when this task would like to be run.
@@ -24,6 +32,7 @@ type TaskWithOperation struct {
 	orch    *Orchestrator
 	op      *v1.Operation
 	running atomic.Bool
+	runId   int64 // run the task's operations join, 0 for tasks that start a run of their own.
 }
 
 func (t *TaskWithOperation) OperationId() int64 {
@@ -37,6 +46,9 @@ func (t *TaskWithOperation) setOperation(op *v1.Operation) error {
 	if t.op != nil {
 		return errors.New("task already has an operation")
 	}
+	if t.runId != 0 {
+		op.RunId = t.runId
+	}
 	if err := t.orch.OpLog.Add(op); err != nil {
 		return fmt.Errorf("task failed to add operation to oplog: %v", err)
 	}
@@ -59,7 +71,12 @@ func (t *TaskWithOperation) runWithOpAndContext(ctx context.Context, do func(ctx
 	}()
 
 	return WithOperation(t.orch.OpLog, t.op, func() error {
-		return do(ctx, t.op)
+		usage := &restic.ResourceUsage{}
+		err := do(restic.ContextWithResourceUsage(ctx, usage), t.op)
+		if u := usage.Proto(); u.Processes > 0 {
+			t.op.ResourceUsage = u
+		}
+		return err
 	})
 }
 
@@ -80,37 +97,110 @@ func (t *TaskWithOperation) Cancel(withStatus v1.OperationStatus) error {
 	return nil
 }
 
+// overrunFactor is how many times its expected duration an operation runs before it is reported as overrunning.
+const overrunFactor = 2
+
 // WithOperation is a utility that creates an operation to track the function's execution.
 // timestamps are automatically added and the status is automatically updated if an error occurs.
-func WithOperation(oplog *oplog.OpLog, op *v1.Operation, do func() error) error {
+func WithOperation(log *oplog.OpLog, op *v1.Operation, do func() error) error {
 	op.UnixTimeStartMs = curTimeMillis() // update the start time from the planned time to the actual time.
 	if op.Status == v1.OperationStatus_STATUS_PENDING || op.Status == v1.OperationStatus_STATUS_UNKNOWN {
 		op.Status = v1.OperationStatus_STATUS_INPROGRESS
 	}
+	if op.PlanId != "" {
+		if estimate, err := log.EstimateDuration(op.PlanId, oplog.OperationType(op)); err != nil {
+			zap.L().Warn("failed to estimate operation duration", zap.String("plan", op.PlanId), zap.Error(err))
+		} else {
+			op.ExpectedDurationMs = estimate.GetAverageMs()
+		}
+	}
 	if op.Id != 0 {
-		if err := oplog.Update(op); err != nil {
+		if err := log.Update(op); err != nil {
 			return fmt.Errorf("failed to add operation to oplog: %w", err)
 		}
 	} else {
-		if err := oplog.Add(op); err != nil {
+		if err := log.Add(op); err != nil {
 			return fmt.Errorf("failed to add operation to oplog: %w", err)
 		}
 	}
+	if op.RunId == 0 {
+		op.RunId = op.Id // the operation starts a run, saved by the updates below.
+	}
 	err := do()
 	if err != nil {
 		op.Status = v1.OperationStatus_STATUS_ERROR
 		op.DisplayMessage = err.Error()
+		op.ErrorClass = classifyError(err)
 	}
 	op.UnixTimeEndMs = curTimeMillis()
 	if op.Status == v1.OperationStatus_STATUS_INPROGRESS {
 		op.Status = v1.OperationStatus_STATUS_SUCCESS
 	}
-	if e := oplog.Update(op); e != nil {
+	if elapsed := op.UnixTimeEndMs - op.UnixTimeStartMs; op.ExpectedDurationMs > 0 && elapsed > overrunFactor*op.ExpectedDurationMs {
+		zap.L().Warn("operation took much longer than expected", zap.Int64("id", op.Id), zap.String("plan", op.PlanId), zap.String("type", oplog.OperationType(op)),
+			zap.Duration("elapsed", time.Duration(elapsed)*time.Millisecond), zap.Duration("expected", time.Duration(op.ExpectedDurationMs)*time.Millisecond))
+	}
+	if e := log.Update(op); e != nil {
 		return multierror.Append(err, fmt.Errorf("failed to update operation in oplog: %w", e))
 	}
 	return err
 }
 
+// withTimeout runs do with a context that is cancelled once the timeout expires, a timeout of 0 is no limit.
+// Errors returned after the timeout expired are reported as ErrTaskTimeout.
+func withTimeout(ctx context.Context, timeout time.Duration, do func(ctx context.Context) error) error {
+	if timeout <= 0 {
+		return do(ctx)
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	err := do(ctx)
+	if err != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return fmt.Errorf("%w after %v: %v", ErrTaskTimeout, timeout, err)
+	}
+	return err
+}
+
+// handleTimeout fires the plan's timeout hooks if err is a timeout and, if err is a timeout or of a class the plan
+// retries and the plan allows another attempt, schedules the retry after the configured delay.
+func handleTimeout(orch *Orchestrator, t Task, plan *v1.Plan, attempt int, err error, retry func(at time.Time, attempt int) Task, priority int) {
+	if err == nil {
+		return
+	}
+	class := classifyError(err)
+	if class == v1.ErrorClass_ERROR_CLASS_TIMEOUT {
+		repo, _ := orch.GetRepo(plan.Repo)
+		orch.hookExecutor.ExecuteHooks(repo.Config(), plan, "", []v1.Hook_Condition{
+			v1.Hook_CONDITION_TIMEOUT,
+		}, hook.HookVars{
+			Task:  t.Name(),
+			Error: err.Error(),
+		})
+	} else if !slices.Contains(plan.GetTimeouts().GetRetryOn(), class) {
+		return
+	}
+
+	if attempt >= int(plan.GetTimeouts().GetRetries()) {
+		return
+	}
+	at := time.Now().Add(time.Duration(plan.GetTimeouts().GetRetryDelayMinutes()) * time.Minute)
+	zap.L().Info("retrying failed task", zap.String("task", t.Name()), zap.Stringer("class", class), zap.Int("attempt", attempt+1), zap.Time("at", at))
+	orch.ScheduleTask(retry(at, attempt+1), priority)
+}
+
+// classifyError returns the class of a task's error, restic failures are classified by their output.
+func classifyError(err error) v1.ErrorClass {
+	var insufficient *diskspace.InsufficientError
+	switch {
+	case errors.Is(err, ErrTaskTimeout):
+		return v1.ErrorClass_ERROR_CLASS_TIMEOUT
+	case errors.As(err, &insufficient):
+		return v1.ErrorClass_ERROR_CLASS_NO_SPACE
+	default:
+		return restic.ClassifyError(err)
+	}
+}
+
 func timeToUnixMillis(t time.Time) int64 {
 	return t.Unix()*1000 + int64(t.Nanosecond()/1000000)
 }
@@ -0,0 +1,98 @@
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/diskspace"
+	"github.com/garethgeorge/backrest/internal/oplog"
+)
+
+func TestWithTimeout(t *testing.T) {
+	t.Parallel()
+
+	errFailed := errors.New("failed")
+	waitForCtx := func(ctx context.Context) error {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(5 * time.Second):
+			return errFailed
+		}
+	}
+
+	if err := withTimeout(context.Background(), 10*time.Millisecond, waitForCtx); !errors.Is(err, ErrTaskTimeout) {
+		t.Errorf("want ErrTaskTimeout, got %v", err)
+	}
+	if err := withTimeout(context.Background(), time.Minute, func(ctx context.Context) error { return errFailed }); !errors.Is(err, errFailed) || errors.Is(err, ErrTaskTimeout) {
+		t.Errorf("want the task's error when it fails before the timeout, got %v", err)
+	}
+	if err := withTimeout(context.Background(), 0, func(ctx context.Context) error {
+		if _, ok := ctx.Deadline(); ok {
+			t.Errorf("want no deadline without a timeout")
+		}
+		return nil
+	}); err != nil {
+		t.Errorf("want no error, got %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := withTimeout(ctx, time.Minute, waitForCtx); errors.Is(err, ErrTaskTimeout) {
+		t.Errorf("want cancellation not reported as a timeout, got %v", err)
+	}
+}
+
+func TestWithOperationRunId(t *testing.T) {
+	t.Parallel()
+
+	log, err := oplog.NewOpLog(t.TempDir() + "/oplog.boltdb")
+	if err != nil {
+		t.Fatalf("error creating oplog: %s", err)
+	}
+	t.Cleanup(func() { log.Close() })
+
+	first := &v1.Operation{PlanId: "plan1", RepoId: "repo1", Op: &v1.Operation_OperationBackup{}}
+	if err := WithOperation(log, first, func() error { return nil }); err != nil {
+		t.Fatalf("WithOperation() error: %v", err)
+	}
+	if first.RunId != first.Id {
+		t.Errorf("want an operation without a run to start one, got run %d for operation %d", first.RunId, first.Id)
+	}
+
+	second := &v1.Operation{PlanId: "plan1", RepoId: "repo1", RunId: first.Id, Op: &v1.Operation_OperationForget{}}
+	if err := WithOperation(log, second, func() error { return nil }); err != nil {
+		t.Fatalf("WithOperation() error: %v", err)
+	}
+	saved, err := log.Get(second.Id)
+	if err != nil {
+		t.Fatalf("Get() error: %v", err)
+	}
+	if saved.RunId != first.Id {
+		t.Errorf("want the operation to join run %d, got %d", first.Id, saved.RunId)
+	}
+}
+
+func TestClassifyError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		err  error
+		want v1.ErrorClass
+	}{
+		{name: "timeout", err: fmt.Errorf("backup: %w", ErrTaskTimeout), want: v1.ErrorClass_ERROR_CLASS_TIMEOUT},
+		{name: "disk space", err: fmt.Errorf("pre-flight check: %w", &diskspace.InsufficientError{Path: "/", Purpose: "repo"}), want: v1.ErrorClass_ERROR_CLASS_NO_SPACE},
+		{name: "restic output", err: errors.New("command failed: Fatal: unable to create lock in backend: repository is already locked"), want: v1.ErrorClass_ERROR_CLASS_LOCKED},
+		{name: "unknown", err: errors.New("failed"), want: v1.ErrorClass_ERROR_CLASS_UNKNOWN},
+	}
+	for _, tc := range tests {
+		if got := classifyError(tc.err); got != tc.want {
+			t.Errorf("%s: classifyError() = %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
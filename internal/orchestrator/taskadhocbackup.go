@@ -0,0 +1,75 @@
+package orchestrator
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/pkg/restic"
+	"github.com/hashicorp/go-multierror"
+)
+
+// planForAdhocBackups is the plan ad-hoc backups and their snapshots are recorded under.
+const planForAdhocBackups = "_adhoc_"
+
+// AdhocBackupOpts describes a one-off backup of paths outside of any plan.
+type AdhocBackupOpts struct {
+	RepoId   string
+	Paths    []string
+	Tags     []string
+	Excludes []string
+}
+
+// Validate checks that the paths are absolute and the tags can't be confused with the tags backrest manages.
+func (o AdhocBackupOpts) Validate() error {
+	var err error
+	if o.RepoId == "" {
+		err = multierror.Append(err, errors.New("repo is required"))
+	}
+	if len(o.Paths) == 0 {
+		err = multierror.Append(err, errors.New("at least one path is required"))
+	}
+	for _, p := range o.Paths {
+		if !filepath.IsAbs(p) {
+			err = multierror.Append(err, fmt.Errorf("path %q must be absolute", p))
+		}
+	}
+	for _, tag := range o.Tags {
+		switch {
+		case tag == "":
+			err = multierror.Append(err, errors.New("tags must not be empty"))
+		case strings.Contains(tag, ","):
+			err = multierror.Append(err, fmt.Errorf("tag %q must not contain a comma", tag))
+		case strings.HasPrefix(tag, "plan:") || tag == PinnedTag:
+			err = multierror.Append(err, fmt.Errorf("tag %q is reserved", tag))
+		}
+	}
+	return err
+}
+
+// NewOneoffAdhocBackupTask returns a backup of the paths, tracked as a backup of the ad-hoc plan. The plan has no
+// retention policy so its snapshots are kept until forgotten.
+func NewOneoffAdhocBackupTask(orchestrator *Orchestrator, opts AdhocBackupOpts, at time.Time) (*BackupTask, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	if _, err := orchestrator.GetRepo(opts.RepoId); err != nil {
+		return nil, fmt.Errorf("get repo %q: %w", opts.RepoId, err)
+	}
+
+	plan := &v1.Plan{
+		Id:       planForAdhocBackups,
+		Repo:     opts.RepoId,
+		Paths:    opts.Paths,
+		Excludes: opts.Excludes,
+	}
+	t := NewOneoffBackupTask(orchestrator, plan, at)
+	t.name = fmt.Sprintf("ad-hoc backup of %s", strings.Join(opts.Paths, ", "))
+	if len(opts.Tags) > 0 {
+		t.backupOpts = append(t.backupOpts, restic.WithBackupTags(opts.Tags...))
+	}
+	return t, nil
+}
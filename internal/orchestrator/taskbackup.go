@@ -4,11 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math/rand"
 	"slices"
+	"strings"
 	"time"
 
 	v1 "github.com/garethgeorge/backrest/gen/go/v1"
 	"github.com/garethgeorge/backrest/internal/hook"
+	"github.com/garethgeorge/backrest/internal/mounts"
 	"github.com/garethgeorge/backrest/internal/protoutil"
 	"github.com/garethgeorge/backrest/pkg/restic"
 	"github.com/gitploy-io/cronexpr"
@@ -24,12 +27,23 @@ type BackupTask struct {
 	TaskWithOperation
 	plan      *v1.Plan
 	scheduler func(curTime time.Time) *time.Time
+	attempt   int // number of previous attempts that timed out.
+
+	checkPreconditions bool      // scheduled and chained backups and their deferrals only run once the plan's dependencies and preconditions hold.
+	deferDeadline      time.Time // deferrals stop once the next scheduled backup would run first.
+	additionalRepo     bool      // backs up to one of the plan's additional repos.
+	nextRepos          []string  // additional repos backed up one after another once this backup finished, in the same run.
+
+	backupOpts []restic.BackupOption // extra restic options, e.g. the tags of an ad-hoc backup.
 }
 
+// defaultDeferInterval is how long a backup with unmet preconditions waits before checking them again.
+const defaultDeferInterval = 15 * time.Minute
+
 var _ Task = &BackupTask{}
 
 func NewScheduledBackupTask(orchestrator *Orchestrator, plan *v1.Plan) (*BackupTask, error) {
-	sched, err := cronexpr.ParseInLocation(plan.Cron, time.Now().Location().String())
+	sched, err := cronexpr.ParseInLocation(plan.Cron, planLocation(plan))
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse schedule %q: %w", plan.Cron, err)
 	}
@@ -39,11 +53,9 @@ func NewScheduledBackupTask(orchestrator *Orchestrator, plan *v1.Plan) (*BackupT
 		TaskWithOperation: TaskWithOperation{
 			orch: orchestrator,
 		},
-		plan: plan,
-		scheduler: func(curTime time.Time) *time.Time {
-			next := sched.Next(curTime)
-			return &next
-		},
+		plan:               plan,
+		scheduler:          jitteredScheduler(sched, plan),
+		checkPreconditions: true,
 	}, nil
 }
 
@@ -89,13 +101,108 @@ func (t *BackupTask) Next(now time.Time) *time.Time {
 }
 
 func (t *BackupTask) Run(ctx context.Context) error {
-	return t.runWithOpAndContext(ctx, func(ctx context.Context, op *v1.Operation) error {
-		return backupHelper(ctx, t, t.orch, t.plan, op)
+	if t.checkPreconditions {
+		if reason := unmetDependency(t.orch.OpLog, t.plan); reason != "" {
+			return t.skipBackup(reason)
+		}
+		if reason := t.orch.preconditions.Unmet(ctx, t.plan.Preconditions); reason != "" {
+			return t.deferBackup(reason)
+		}
+	}
+
+	timeout := time.Duration(t.plan.GetTimeouts().GetBackupMinutes()) * time.Minute
+	var runId int64
+	err := t.runWithOpAndContext(ctx, func(ctx context.Context, op *v1.Operation) error {
+		runId = op.RunId
+		return withTimeout(ctx, timeout, func(ctx context.Context) error {
+			return backupHelper(ctx, t, t.orch, t.plan, op, t.backupOpts...)
+		})
 	})
+	handleTimeout(t.orch, t, t.plan, t.attempt, err, func(at time.Time, attempt int) Task {
+		retry := NewOneoffBackupTask(t.orch, t.plan, at)
+		retry.name = t.name
+		retry.attempt = attempt
+		retry.additionalRepo = t.additionalRepo
+		retry.runId = t.runId
+		return retry
+	}, TaskPriorityDefault)
+
+	// the additional repos are backed up one after another whether or not the backup to the plan's repo succeeded,
+	// each is a copy of its own. Retries of a timed out backup don't back them up again.
+	nextRepos := t.nextRepos
+	if !t.additionalRepo && t.attempt == 0 {
+		nextRepos = PlanRepos(t.plan)[1:]
+	}
+	if len(nextRepos) > 0 {
+		t.scheduleAdditionalRepo(runId, nextRepos)
+	} else if runId != 0 {
+		// the plan's dependents follow once its backups to all of its repos finished.
+		scheduleDependentBackups(t.orch, t.plan, runId)
+	}
+	return err
+}
+
+// scheduleAdditionalRepo schedules the backup to the first of the repos, it joins the run of this backup and backs up
+// to the rest of the repos after it.
+func (t *BackupTask) scheduleAdditionalRepo(runId int64, repos []string) {
+	backup := NewOneoffBackupTask(t.orch, PlanForRepo(t.plan, repos[0]), time.Now())
+	backup.name = fmt.Sprintf("backup for plan %q to repo %q", t.plan.Id, repos[0])
+	backup.additionalRepo = true
+	backup.nextRepos = repos[1:]
+	backup.runId = runId
+	backup.backupOpts = t.backupOpts
+	t.orch.ScheduleTask(backup, TaskPriorityDefault)
+}
+
+// skipBackup records the backup as skipped because a plan it requires didn't succeed, it isn't retried.
+func (t *BackupTask) skipBackup(reason string) error {
+	zap.L().Info("skipping backup, dependency unmet", zap.String("plan", t.plan.Id), zap.String("reason", reason))
+	if t.op != nil {
+		t.op.DisplayMessage = "Skipped, " + reason
+	}
+	if err := t.Cancel(v1.OperationStatus_STATUS_SYSTEM_CANCELLED); err != nil {
+		return fmt.Errorf("record skipped backup: %w", err)
+	}
+	return nil
+}
+
+// deferBackup records the backup as skipped and, unless the next scheduled backup would run first, schedules
+// another attempt once the defer interval has passed.
+func (t *BackupTask) deferBackup(reason string) error {
+	zap.L().Info("skipping backup, precondition unmet", zap.String("plan", t.plan.Id), zap.String("reason", reason))
+	if t.op != nil {
+		t.op.DisplayMessage = "Skipped, " + reason
+	}
+	if err := t.Cancel(v1.OperationStatus_STATUS_SYSTEM_CANCELLED); err != nil {
+		return fmt.Errorf("record skipped backup: %w", err)
+	}
+
+	now := time.Now()
+	interval := defaultDeferInterval
+	if mins := t.plan.Preconditions.GetDeferMinutes(); mins > 0 {
+		interval = time.Duration(mins) * time.Minute
+	}
+	deadline := t.deferDeadline
+	if deadline.IsZero() {
+		if next := t.scheduler(now); next != nil {
+			deadline = *next
+		}
+	}
+	at := now.Add(interval)
+	if !at.Before(deadline) {
+		return nil
+	}
+
+	retry := NewOneoffBackupTask(t.orch, t.plan, at)
+	retry.name = fmt.Sprintf("deferred backup for plan %q", t.plan.Id)
+	retry.checkPreconditions = true
+	retry.deferDeadline = deadline
+	t.orch.ScheduleTask(retry, TaskPriorityDefault)
+	return nil
 }
 
 // backupHelper does a backup.
-func backupHelper(ctx context.Context, t Task, orchestrator *Orchestrator, plan *v1.Plan, op *v1.Operation) error {
+func backupHelper(ctx context.Context, t Task, orchestrator *Orchestrator, plan *v1.Plan, op *v1.Operation, opts ...restic.BackupOption) error {
 	startTime := time.Now()
 	backupOp := &v1.Operation_OperationBackup{
 		OperationBackup: &v1.OperationBackup{},
@@ -108,14 +215,41 @@ func backupHelper(ctx context.Context, t Task, orchestrator *Orchestrator, plan
 		return fmt.Errorf("couldn't get repo %q: %w", plan.Repo, err)
 	}
 
+	if mountTable, err := mounts.List(); err == nil {
+		backupOp.OperationBackup.Warnings = mountWarnings(plan, mountTable)
+		for _, warning := range backupOp.OperationBackup.Warnings {
+			zap.L().Warn("backup pre-flight check", zap.String("plan", plan.Id), zap.String("warning", warning))
+		}
+	} else if !errors.Is(err, mounts.ErrUnsupported) {
+		zap.L().Warn("failed to list mounts for backup pre-flight check", zap.String("plan", plan.Id), zap.Error(err))
+	}
+
+	spaceWarnings, err := checkDiskSpace(orchestrator, repo.Config(), plan, hook.HookVars{
+		Task:  t.Name(),
+		RunId: op.RunId,
+	}, repoSpacePaths(repo.Config(), plan)...)
+	if err != nil {
+		orchestrator.hookExecutor.ExecuteHooks(repo.Config(), plan, "", []v1.Hook_Condition{
+			v1.Hook_CONDITION_SNAPSHOT_ERROR, v1.Hook_CONDITION_ANY_ERROR,
+		}, hook.HookVars{
+			Task:  t.Name(),
+			Error: err.Error(),
+			RunId: op.RunId,
+		})
+		return fmt.Errorf("backup pre-flight check: %w", err)
+	}
+	backupOp.OperationBackup.Warnings = append(backupOp.OperationBackup.Warnings, spaceWarnings...)
+
 	orchestrator.hookExecutor.ExecuteHooks(repo.Config(), plan, "", []v1.Hook_Condition{
 		v1.Hook_CONDITION_SNAPSHOT_START,
 	}, hook.HookVars{
-		Task: t.Name(),
+		Task:  t.Name(),
+		RunId: op.RunId,
 	})
 
 	lastSent := time.Now() // debounce progress updates, these can endup being very frequent.
 	var lastFiles []string
+	dataAdded := newDataAddedTracker(plan.Paths)
 	summary, err := repo.Backup(ctx, plan, func(entry *restic.BackupProgressEntry) {
 
 		if entry.MessageType == "status" {
@@ -141,6 +275,9 @@ func backupHelper(ctx context.Context, t Task, orchestrator *Orchestrator, plan
 				return
 			}
 			backupOp.OperationBackup.Errors = append(backupOp.OperationBackup.Errors, backupError)
+		} else if entry.MessageType == "verbose_status" {
+			dataAdded.Add(entry)
+			return
 		} else if entry.MessageType != "summary" {
 			zap.S().Warnf("unexpected message type %q in backup progress entry", entry.MessageType)
 		}
@@ -153,11 +290,14 @@ func backupHelper(ctx context.Context, t Task, orchestrator *Orchestrator, plan
 		if err := orchestrator.OpLog.Update(op); err != nil {
 			zap.S().Errorf("failed to update oplog with progress for backup: %v", err)
 		}
-	})
+	}, opts...)
+
+	backupOp.OperationBackup.DataAdded = dataAdded.Top(maxDataAddedEntries)
 
 	vars := hook.HookVars{
 		Task:          t.Name(),
 		SnapshotStats: summary,
+		RunId:         op.RunId,
 	}
 	if err != nil {
 		vars.Error = err.Error()
@@ -177,6 +317,13 @@ func backupHelper(ctx context.Context, t Task, orchestrator *Orchestrator, plan
 	}, vars)
 
 	op.SnapshotId = summary.SnapshotId
+	if usage := restic.UsageFromContext(ctx); usage != nil && localRepoPath(repo.Config().GetUri()) == "" {
+		uploaded := summary.DataAddedPacked
+		if uploaded == 0 {
+			uploaded = summary.DataAdded // restic before 0.17 doesn't report the compressed size.
+		}
+		usage.AddUploadBytesEstimate(int64(uploaded))
+	}
 	backupOp.OperationBackup.LastStatus = protoutil.BackupProgressEntryToProto(summary)
 	if backupOp.OperationBackup.LastStatus == nil {
 		return fmt.Errorf("expected a final backup progress entry, got nil")
@@ -184,13 +331,71 @@ func backupHelper(ctx context.Context, t Task, orchestrator *Orchestrator, plan
 
 	zap.L().Info("Backup complete", zap.String("plan", plan.Id), zap.Duration("duration", time.Since(startTime)), zap.Any("summary", backupOp.OperationBackup.LastStatus))
 
-	// schedule followup tasks
+	if cfg := plan.GetAnomalyDetection(); cfg.GetEnabled() {
+		n := int(cfg.GetBaselineBackups())
+		if n <= 0 {
+			n = defaultAnomalyBaselineBackups
+		}
+		baseline, err := anomalyBaseline(orchestrator.OpLog, plan.Id, plan.Repo, n)
+		if err != nil {
+			zap.L().Error("failed to get the baseline of previous backups", zap.String("plan", plan.Id), zap.Error(err))
+		} else if anomaly := detectAnomaly(cfg, backupOp.OperationBackup.LastStatus.GetSummary(), baseline); anomaly != nil {
+			backupOp.OperationBackup.Anomaly = anomaly
+			problem := "backup is anomalous: " + strings.Join(anomaly.Reasons, ", ")
+			zap.L().Warn("backup anomaly", zap.String("plan", plan.Id), zap.String("snapshot", op.SnapshotId), zap.Strings("reasons", anomaly.Reasons))
+			op.Status = v1.OperationStatus_STATUS_WARNING
+			op.DisplayMessage = strings.TrimSpace(op.DisplayMessage + " " + problem + ".")
+			orchestrator.hookExecutor.ExecuteHooks(repo.Config(), plan, op.SnapshotId, []v1.Hook_Condition{
+				v1.Hook_CONDITION_BACKUP_ANOMALY,
+			}, hook.HookVars{
+				Task:          t.Name(),
+				Error:         problem,
+				SnapshotStats: summary,
+				RunId:         op.RunId,
+			})
+		}
+	}
+
+	if n := int(plan.GetVerifySampleFiles()); n > 0 && op.SnapshotId != "" {
+		if err := orchestrator.RequireWarm(repo.Config(), "backup verification"); err != nil {
+			// the backup only wrote to cold storage, reading the sample back has to wait for a warmup.
+			zap.L().Info("skipping backup verification", zap.String("plan", plan.Id), zap.String("snapshot", op.SnapshotId), zap.Error(err))
+			op.DisplayMessage = strings.TrimSpace(op.DisplayMessage + " Verification skipped, " + err.Error() + ".")
+		} else {
+			verification, err := verifyBackupSample(ctx, repo, op.SnapshotId, n, rand.New(rand.NewSource(time.Now().UnixNano())))
+			backupOp.OperationBackup.Verification = verification
+			var problem string
+			if err != nil {
+				problem = fmt.Sprintf("couldn't verify the backup: %v", err)
+			} else if mismatches := len(verification.GetMismatches()); mismatches > 0 {
+				problem = fmt.Sprintf("backup verification found %d files that don't match the source", mismatches)
+			}
+			if problem != "" {
+				zap.L().Warn("backup verification", zap.String("plan", plan.Id), zap.String("snapshot", op.SnapshotId), zap.String("problem", problem))
+				op.Status = v1.OperationStatus_STATUS_WARNING
+				op.DisplayMessage = strings.TrimSpace(op.DisplayMessage + " " + problem + ".")
+				orchestrator.hookExecutor.ExecuteHooks(repo.Config(), plan, op.SnapshotId, []v1.Hook_Condition{
+					v1.Hook_CONDITION_ANY_ERROR,
+				}, hook.HookVars{
+					Task:  t.Name(),
+					Error: problem,
+					RunId: op.RunId,
+				})
+			}
+		}
+	}
+
+	// schedule followup tasks, they join the backup's run.
 	at := time.Now()
-	if plan.Retention != nil && !proto.Equal(plan.Retention, &v1.RetentionPolicy{}) {
-		orchestrator.ScheduleTask(NewOneoffForgetTask(orchestrator, plan, op.SnapshotId, at), TaskPriorityForget)
+	if plan.Retention != nil && !proto.Equal(plan.Retention, &v1.RetentionPolicy{}) && orchestrator.canApplyRetention(plan.Repo) {
+		forget := NewOneoffForgetTask(orchestrator, plan, op.SnapshotId, at)
+		forget.runId = op.RunId
+		orchestrator.ScheduleTask(forget, TaskPriorityForget)
 	}
 	orchestrator.ScheduleTask(NewOneoffIndexSnapshotsTask(orchestrator, plan.Repo, at), TaskPriorityIndexSnapshots)
-	orchestrator.ScheduleTask(NewOneoffStatsTask(orchestrator, plan, op.SnapshotId, at), TaskPriorityStats)
+	stats := NewOneoffStatsTask(orchestrator, plan, op.SnapshotId, at)
+	stats.runId = op.RunId
+	orchestrator.ScheduleTask(stats, TaskPriorityStats)
 
 	return nil
 }
@@ -0,0 +1,85 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/hook"
+	"go.uber.org/zap"
+)
+
+// BenchmarkTask measures the throughput of a repo's backend with temporary test data.
+type BenchmarkTask struct {
+	TaskWithOperation
+	repoId    string
+	sizeBytes int64
+	at        *time.Time
+}
+
+var _ Task = &BenchmarkTask{}
+
+func NewOneoffBenchmarkTask(orchestrator *Orchestrator, repoId string, sizeBytes int64, at time.Time) *BenchmarkTask {
+	return &BenchmarkTask{
+		TaskWithOperation: TaskWithOperation{
+			orch: orchestrator,
+		},
+		repoId:    repoId,
+		sizeBytes: sizeBytes,
+		at:        &at,
+	}
+}
+
+func (t *BenchmarkTask) Name() string {
+	return fmt.Sprintf("benchmark for repo %q", t.repoId)
+}
+
+func (t *BenchmarkTask) Next(now time.Time) *time.Time {
+	ret := t.at
+	if ret != nil {
+		t.at = nil
+		if err := t.setOperation(&v1.Operation{
+			PlanId:          planForRepoMaintenance,
+			RepoId:          t.repoId,
+			UnixTimeStartMs: timeToUnixMillis(*ret),
+			Status:          v1.OperationStatus_STATUS_PENDING,
+			Op:              &v1.Operation_OperationBenchmark{},
+		}); err != nil {
+			zap.S().Errorf("task %v failed to add operation to oplog: %v", t.Name(), err)
+			return nil
+		}
+	}
+	return ret
+}
+
+func (t *BenchmarkTask) Run(ctx context.Context) error {
+	repo, err := t.orch.GetRepo(t.repoId)
+	if err != nil {
+		return fmt.Errorf("get repo %q: %w", t.repoId, err)
+	}
+
+	err = t.runWithOpAndContext(ctx, func(ctx context.Context, op *v1.Operation) error {
+		op.Op = &v1.Operation_OperationBenchmark{OperationBenchmark: &v1.OperationBenchmark{SizeBytes: t.sizeBytes}}
+		if repo.Config().GetAppendOnly().GetEnabled() {
+			return fmt.Errorf("%w: the benchmark's test data can't be removed from the repo", ErrAppendOnly)
+		}
+		if err := t.orch.RequireWarm(repo.Config(), "benchmark"); err != nil {
+			return err
+		}
+
+		result, err := repo.Benchmark(ctx, t.sizeBytes)
+		op.Op = &v1.Operation_OperationBenchmark{OperationBenchmark: result}
+		return err
+	})
+	if err != nil {
+		t.orch.hookExecutor.ExecuteHooks(repo.Config(), nil, "", []v1.Hook_Condition{
+			v1.Hook_CONDITION_ANY_ERROR,
+		}, hook.HookVars{
+			Task:  t.Name(),
+			Error: err.Error(),
+		})
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package orchestrator
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/hook"
+	"github.com/gitploy-io/cronexpr"
+	"go.uber.org/zap"
+)
+
+// defaultCacheMaxAgeDays is how long a cache directory may go unused before a cleanup removes it, restic's default.
+const defaultCacheMaxAgeDays = 30
+
+// CacheCleanupTask periodically removes old restic cache directories and clears the repo's cache once it outgrows
+// the repo's cache policy.
+type CacheCleanupTask struct {
+	TaskWithOperation
+	repoId    string
+	policy    *v1.CachePolicy
+	scheduler func(curTime time.Time) *time.Time
+}
+
+var _ Task = &CacheCleanupTask{}
+
+func NewScheduledCacheCleanupTask(orchestrator *Orchestrator, repo *v1.Repo) (*CacheCleanupTask, error) {
+	cron := repo.GetCachePolicy().GetCron()
+	sched, err := cronexpr.ParseInLocation(cron, time.Now().Location().String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse cache cleanup schedule %q: %w", cron, err)
+	}
+
+	return &CacheCleanupTask{
+		TaskWithOperation: TaskWithOperation{
+			orch: orchestrator,
+		},
+		repoId: repo.Id,
+		policy: repo.CachePolicy,
+		scheduler: func(curTime time.Time) *time.Time {
+			next := sched.Next(curTime)
+			return &next
+		},
+	}, nil
+}
+
+func (t *CacheCleanupTask) Name() string {
+	return fmt.Sprintf("cache cleanup for repo %q", t.repoId)
+}
+
+func (t *CacheCleanupTask) Next(now time.Time) *time.Time {
+	next := t.scheduler(now)
+	if next == nil {
+		return nil
+	}
+
+	if err := t.setOperation(&v1.Operation{
+		PlanId:          planForRepoMaintenance,
+		RepoId:          t.repoId,
+		UnixTimeStartMs: timeToUnixMillis(*next),
+		Status:          v1.OperationStatus_STATUS_PENDING,
+		Op:              &v1.Operation_OperationCacheCleanup{},
+	}); err != nil {
+		zap.S().Errorf("task %v failed to add operation to oplog: %v", t.Name(), err)
+	}
+
+	return next
+}
+
+func (t *CacheCleanupTask) Run(ctx context.Context) error {
+	repo, err := t.orch.GetRepo(t.repoId)
+	if err != nil {
+		return fmt.Errorf("get repo %q: %w", t.repoId, err)
+	}
+
+	maxAgeDays := int(t.policy.GetMaxAgeDays())
+	if maxAgeDays == 0 {
+		maxAgeDays = defaultCacheMaxAgeDays
+	}
+
+	err = t.runWithOpAndContext(ctx, func(ctx context.Context, op *v1.Operation) error {
+		var buf bytes.Buffer
+		result, err := repo.CacheCleanup(ctx, maxAgeDays, t.policy.GetMaxSizeMb()*1024*1024, &buf)
+		result.Output = buf.String()
+		op.Op = &v1.Operation_OperationCacheCleanup{OperationCacheCleanup: result}
+		return err
+	})
+	if err != nil {
+		t.orch.hookExecutor.ExecuteHooks(repo.Config(), nil, "", []v1.Hook_Condition{
+			v1.Hook_CONDITION_ANY_ERROR,
+		}, hook.HookVars{
+			Task:  t.Name(),
+			Error: err.Error(),
+		})
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/hook"
+	"github.com/garethgeorge/backrest/internal/protoutil"
+	"github.com/garethgeorge/backrest/pkg/restic"
+	"github.com/gitploy-io/cronexpr"
+	"go.uber.org/zap"
+)
+
+// defaultDelayedDeletionCron checks for snapshots whose cool off passed every hour.
+const defaultDelayedDeletionCron = "0 * * * *"
+
+// DelayedDeletionTask periodically forgets the snapshots of a repo marked for deletion once their cool off passed.
+type DelayedDeletionTask struct {
+	TaskWithOperation
+	repoId    string
+	scheduler func(curTime time.Time) *time.Time
+}
+
+var _ Task = &DelayedDeletionTask{}
+
+func NewScheduledDelayedDeletionTask(orchestrator *Orchestrator, repo *v1.Repo) (*DelayedDeletionTask, error) {
+	cron := repo.GetDelayedDeletion().GetCron()
+	if cron == "" {
+		cron = defaultDelayedDeletionCron
+	}
+	sched, err := cronexpr.ParseInLocation(cron, time.Now().Location().String())
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse delayed deletion schedule %q: %w", cron, err)
+	}
+
+	return &DelayedDeletionTask{
+		TaskWithOperation: TaskWithOperation{
+			orch: orchestrator,
+		},
+		repoId: repo.Id,
+		scheduler: func(curTime time.Time) *time.Time {
+			next := sched.Next(curTime)
+			return &next
+		},
+	}, nil
+}
+
+func (t *DelayedDeletionTask) Name() string {
+	return fmt.Sprintf("delayed deletion for repo %q", t.repoId)
+}
+
+func (t *DelayedDeletionTask) Next(now time.Time) *time.Time {
+	next := t.scheduler(now)
+	if next == nil {
+		return nil
+	}
+
+	if err := t.setOperation(&v1.Operation{
+		PlanId:          planForRepoMaintenance,
+		RepoId:          t.repoId,
+		UnixTimeStartMs: timeToUnixMillis(*next),
+		Status:          v1.OperationStatus_STATUS_PENDING,
+		Op:              &v1.Operation_OperationForget{},
+	}); err != nil {
+		zap.S().Errorf("task %v failed to add operation to oplog: %v", t.Name(), err)
+	}
+
+	return next
+}
+
+func (t *DelayedDeletionTask) Run(ctx context.Context) error {
+	id := t.op.Id
+	var forgot []*v1.ResticSnapshot
+	err := t.runWithOpAndContext(ctx, func(ctx context.Context, op *v1.Operation) error {
+		forgetOp := &v1.OperationForget{}
+		op.Op = &v1.Operation_OperationForget{OperationForget: forgetOp}
+
+		repo, err := t.orch.retentionRepo(t.repoId, "delayed deletion")
+		if err != nil {
+			return err
+		}
+		snapshots, err := repo.Snapshots(ctx)
+		if err != nil {
+			return err
+		}
+		for _, expired := range expiredSnapshots(snapshots, time.Now()) {
+			if err := repo.ForgetSnapshot(ctx, expired.Id); err != nil {
+				return fmt.Errorf("forget %q: %w", expired.Id, err)
+			}
+			forgot = append(forgot, protoutil.SnapshotToProto(expired))
+			forgetOp.Forget = forgot
+			if err := markForgotten(t.orch.OpLog, expired.Id); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		repo, _ := t.orch.GetRepo(t.repoId)
+		t.orch.hookExecutor.ExecuteHooks(repo.Config(), nil, "", []v1.Hook_Condition{
+			v1.Hook_CONDITION_ANY_ERROR,
+		}, hook.HookVars{
+			Task:  t.Name(),
+			Error: err.Error(),
+		})
+		return err
+	}
+
+	// most runs find nothing to forget, they aren't worth keeping in the history.
+	if len(forgot) == 0 {
+		return t.orch.OpLog.Delete(id)
+	}
+	if plan := t.planOf(forgot); plan != nil {
+		t.orch.ScheduleTask(NewOneoffPruneTask(t.orch, plan, time.Now(), false), TaskPriorityPrune)
+	}
+	return nil
+}
+
+// planOf returns a configured plan the forgotten snapshots were taken by, prunes follow forgets of a plan's snapshots
+// and are repo wide so one prune reclaims the space of every plan.
+func (t *DelayedDeletionTask) planOf(forgot []*v1.ResticSnapshot) *v1.Plan {
+	for _, snapshot := range forgot {
+		for _, tag := range snapshot.Tags {
+			if planId, ok := strings.CutPrefix(tag, "plan:"); ok {
+				if plan, err := t.orch.GetPlan(planId); err == nil {
+					return plan
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// expiredSnapshots returns the snapshots marked for deletion whose cool off passed, pinned snapshots are never
+// forgotten.
+func expiredSnapshots(snapshots []*restic.Snapshot, now time.Time) []*restic.Snapshot {
+	var expired []*restic.Snapshot
+	for _, s := range snapshots {
+		if after, marked := deleteAfter(s.Tags); marked && !now.Before(after) && !slices.Contains(s.Tags, PinnedTag) {
+			expired = append(expired, s)
+		}
+	}
+	return expired
+}
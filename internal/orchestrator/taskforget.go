@@ -57,22 +57,64 @@ func (t *ForgetTask) Next(now time.Time) *time.Time {
 	return ret
 }
 
+// pruneWorthwhile reports whether a prune should follow the forget. If the repo's prune policy sets a minimum the
+// space a prune would reclaim is estimated with a dry run and recorded in the forget operation. Prune is still
+// scheduled if the estimate fails.
+func (t *ForgetTask) pruneWorthwhile(ctx context.Context, repo *RepoOrchestrator, forgetOp *v1.OperationForget) bool {
+	minBytes := repo.Config().GetPrunePolicy().GetMinReclaimableBytes()
+	if minBytes <= 0 {
+		return true
+	}
+
+	estimate, err := repo.PruneEstimate(ctx)
+	if err != nil {
+		zap.L().Warn("failed to estimate space reclaimed by prune, scheduling prune anyway", zap.String("repo", t.plan.Repo), zap.Error(err))
+		return true
+	}
+	forgetOp.ReclaimableBytesEstimate = estimate.Bytes
+	if estimate.Bytes < minBytes {
+		zap.L().Info("skipping prune after forget, estimated reclaimable space is below the threshold",
+			zap.String("repo", t.plan.Repo), zap.Int64("estimate_bytes", estimate.Bytes), zap.Int64("min_reclaimable_bytes", minBytes))
+		forgetOp.PruneSkipped = true
+		return false
+	}
+	return true
+}
+
 func (t *ForgetTask) Run(ctx context.Context) error {
+	var runId int64
 	if err := t.runWithOpAndContext(ctx, func(ctx context.Context, op *v1.Operation) error {
+		runId = op.RunId
 		forgetOp := &v1.Operation_OperationForget{
 			OperationForget: &v1.OperationForget{},
 		}
 		op.Op = forgetOp
 
-		var err error
-		repo, err := t.orch.GetRepo(t.plan.Repo)
+		repo, err := t.orch.retentionRepo(t.plan.Repo, "forget")
 		if err != nil {
-			return fmt.Errorf("get repo %q: %w", t.plan.Repo, err)
+			return err
 		}
 
 		err = repo.UnlockIfAutoEnabled(ctx)
 		if err != nil {
-			return fmt.Errorf("auto unlock repo %q: %w", t.plan.Repo, err)
+			return fmt.Errorf("auto unlock repo %q: %w", repo.Config().Id, err)
+		}
+
+		forgetOp.OperationForget.Policy = t.plan.Retention
+		if delay := t.orch.deletionCoolOff(t.plan.Repo); delay > 0 {
+			removals, err := repo.RetentionRemovals(ctx, t.plan)
+			if err != nil {
+				return fmt.Errorf("forget: %w", err)
+			}
+			// snapshots marked by earlier forgets keep their deletion time.
+			var ids []string
+			for _, s := range removals {
+				if _, marked := deleteAfter(s.Tags); !marked {
+					ids = append(ids, s.Id)
+				}
+			}
+			forgetOp.OperationForget.Marked, err = t.orch.markForDeletion(ctx, repo, ids, delay)
+			return err
 		}
 
 		forgot, err := repo.Forget(ctx, t.plan)
@@ -81,7 +123,6 @@ func (t *ForgetTask) Run(ctx context.Context) error {
 		}
 
 		forgetOp.OperationForget.Forget = append(forgetOp.OperationForget.Forget, forgot...)
-		forgetOp.OperationForget.Policy = t.plan.Retention
 
 		var ops []*v1.Operation
 		for _, forgot := range forgot {
@@ -102,8 +143,10 @@ func (t *ForgetTask) Run(ctx context.Context) error {
 			}
 		}
 
-		if len(forgot) > 0 {
-			t.orch.ScheduleTask(NewOneoffPruneTask(t.orch, t.plan, time.Now(), false), TaskPriorityPrune)
+		if len(forgot) > 0 && t.pruneWorthwhile(ctx, repo, forgetOp.OperationForget) {
+			prune := NewOneoffPruneTask(t.orch, t.plan, time.Now(), false)
+			prune.runId = op.RunId
+			t.orch.ScheduleTask(prune, TaskPriorityPrune)
 		}
 
 		return err
@@ -114,6 +157,7 @@ func (t *ForgetTask) Run(ctx context.Context) error {
 		}, hook.HookVars{
 			Task:  t.Name(),
 			Error: err.Error(),
+			RunId: runId,
 		})
 	}
 	return nil
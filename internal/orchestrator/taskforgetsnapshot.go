@@ -59,9 +59,9 @@ func (t *ForgetSnapshotTask) Next(now time.Time) *time.Time {
 func (t *ForgetSnapshotTask) Run(ctx context.Context) error {
 	id := t.op.Id
 	if err := t.runWithOpAndContext(ctx, func(ctx context.Context, op *v1.Operation) error {
-		repo, err := t.orch.GetRepo(t.repoId)
+		repo, err := t.orch.retentionRepo(t.repoId, "forget")
 		if err != nil {
-			return fmt.Errorf("get repo %q: %w", t.repoId, err)
+			return err
 		}
 
 		// Find snapshot to forget
@@ -73,6 +73,16 @@ func (t *ForgetSnapshotTask) Run(ctx context.Context) error {
 
 		for _, op := range ops {
 			if indexOp, ok := op.Op.(*v1.Operation_OperationIndexSnapshot); ok {
+				if isPinned(indexOp.OperationIndexSnapshot.Snapshot) {
+					return fmt.Errorf("forget %q: %w, unpin it first", op.SnapshotId, ErrSnapshotPinned)
+				}
+				if delay := t.orch.deletionCoolOff(t.repoId); delay > 0 {
+					if _, marked := deleteAfter(indexOp.OperationIndexSnapshot.Snapshot.GetTags()); marked {
+						return nil
+					}
+					_, err := t.orch.markForDeletion(ctx, repo, []string{op.SnapshotId}, delay)
+					return err
+				}
 				err := repo.ForgetSnapshot(ctx, op.SnapshotId)
 				if err != nil {
 					return fmt.Errorf("forget %q: %w", op.SnapshotId, err)
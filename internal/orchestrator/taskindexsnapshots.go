@@ -3,6 +3,7 @@ package orchestrator
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -15,6 +16,7 @@ import (
 )
 
 const planForUntrackedSnapshots = "_unassociated_"
+const planPrefixForImportedSnapshots = "_imported_"
 
 // IndexSnapshotsTask tracks a forget operation.
 type IndexSnapshotsTask struct {
@@ -101,7 +103,7 @@ func indexSnapshotsHelper(ctx context.Context, orchestrator *Orchestrator, repoI
 		}
 
 		snapshotProto := protoutil.SnapshotToProto(snapshot)
-		planId := planForSnapshot(snapshotProto)
+		planId := planForSnapshot(snapshotProto, repo.Config().GetGroupUntrackedSnapshots())
 		indexOps = append(indexOps, &v1.Operation{
 			RepoId:          repoId,
 			PlanId:          planId,
@@ -180,11 +182,24 @@ func indexCurrentSnapshotIdsForRepo(log *oplog.OpLog, repoId string) (map[string
 	return knownIds, nil
 }
 
-func planForSnapshot(snapshot *v1.ResticSnapshot) string {
+// planForSnapshot returns the plan ID a snapshot is indexed under. Snapshots not created by backrest are either
+// grouped together as unassociated or, if groupUntracked is set, grouped by hostname and tags.
+func planForSnapshot(snapshot *v1.ResticSnapshot, groupUntracked bool) string {
 	for _, tag := range snapshot.Tags {
 		if strings.HasPrefix(tag, "plan:") {
 			return tag[5:]
 		}
 	}
-	return planForUntrackedSnapshots
+	if !groupUntracked {
+		return planForUntrackedSnapshots
+	}
+
+	group := planPrefixForImportedSnapshots + ":" + snapshot.Hostname
+	// pinning a snapshot doesn't move it to another group.
+	tags := slices.DeleteFunc(slices.Clone(snapshot.Tags), func(tag string) bool { return tag == PinnedTag })
+	if len(tags) > 0 {
+		slices.Sort(tags)
+		group += ":" + strings.Join(tags, ",")
+	}
+	return group
 }
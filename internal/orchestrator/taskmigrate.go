@@ -0,0 +1,120 @@
+package orchestrator
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/hook"
+	"github.com/garethgeorge/backrest/pkg/restic"
+	"go.uber.org/zap"
+)
+
+// planForRepoMaintenance is the plan ID recorded on operations that act on a repo as a whole rather than for a plan.
+const planForRepoMaintenance = "_repo_maintenance_"
+
+var ErrMigrationUnavailable = errors.New("migration is not available for this repo")
+
+// MigrateTask applies a restic migration to a repo. Tasks run one at a time so no other task runs on the repo while
+// the migration is applied, the repo is additionally held against direct access for the duration.
+type MigrateTask struct {
+	TaskWithOperation
+	repoId    string
+	migration string
+	at        *time.Time
+}
+
+var _ Task = &MigrateTask{}
+
+func NewOneoffMigrateTask(orchestrator *Orchestrator, repoId string, migration string, at time.Time) *MigrateTask {
+	return &MigrateTask{
+		TaskWithOperation: TaskWithOperation{
+			orch: orchestrator,
+		},
+		repoId:    repoId,
+		migration: migration,
+		at:        &at,
+	}
+}
+
+func (t *MigrateTask) Name() string {
+	return fmt.Sprintf("migrate %v for repo %q", t.migration, t.repoId)
+}
+
+func (t *MigrateTask) Next(now time.Time) *time.Time {
+	ret := t.at
+	if ret != nil {
+		t.at = nil
+		if err := t.setOperation(&v1.Operation{
+			PlanId:          planForRepoMaintenance,
+			RepoId:          t.repoId,
+			UnixTimeStartMs: timeToUnixMillis(*ret),
+			Status:          v1.OperationStatus_STATUS_PENDING,
+			Op: &v1.Operation_OperationMigrate{
+				OperationMigrate: &v1.OperationMigrate{Migration: t.migration},
+			},
+		}); err != nil {
+			zap.S().Errorf("task %v failed to add operation to oplog: %v", t.Name(), err)
+			return nil
+		}
+	}
+	return ret
+}
+
+func (t *MigrateTask) Run(ctx context.Context) error {
+	repo, err := t.orch.GetRepo(t.repoId)
+	if err != nil {
+		return fmt.Errorf("get repo %q: %w", t.repoId, err)
+	}
+
+	err = t.runWithOpAndContext(ctx, func(ctx context.Context, op *v1.Operation) error {
+		if err := checkMigrationPrerequisites(ctx, repo, t.migration); err != nil {
+			return err
+		}
+
+		var buf bytes.Buffer
+		err := repo.Migrate(ctx, t.migration, &buf)
+		op.Op = &v1.Operation_OperationMigrate{
+			OperationMigrate: &v1.OperationMigrate{
+				Migration: t.migration,
+				Output:    buf.String(),
+			},
+		}
+		return err
+	})
+	if err != nil {
+		t.orch.hookExecutor.ExecuteHooks(repo.Config(), nil, "", []v1.Hook_Condition{
+			v1.Hook_CONDITION_ANY_ERROR,
+		}, hook.HookVars{
+			Task:  t.Name(),
+			Error: err.Error(),
+		})
+		return err
+	}
+	return nil
+}
+
+// checkMigrationPrerequisites verifies that restic offers the migration for the repo and that no other process
+// currently holds a lock on it.
+func checkMigrationPrerequisites(ctx context.Context, repo *RepoOrchestrator, migration string) error {
+	migrations, err := repo.Migrations(ctx)
+	if err != nil {
+		return err
+	}
+	if !slices.ContainsFunc(migrations, func(m restic.Migration) bool { return m.Name == migration }) {
+		return fmt.Errorf("%w: %q", ErrMigrationUnavailable, migration)
+	}
+
+	locks, err := repo.Locks(ctx)
+	if err != nil {
+		return err
+	}
+	if len(locks) > 0 {
+		return fmt.Errorf("repo is locked by %d other process(es), wait for them to finish or unlock the repo", len(locks))
+	}
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -11,15 +12,17 @@ import (
 	"github.com/garethgeorge/backrest/internal/hook"
 	"github.com/garethgeorge/backrest/internal/oplog"
 	"github.com/garethgeorge/backrest/internal/oplog/indexutil"
+	"github.com/garethgeorge/backrest/pkg/restic"
 	"go.uber.org/zap"
 )
 
 // PruneTask tracks a forget operation.
 type PruneTask struct {
 	TaskWithOperation
-	plan  *v1.Plan
-	at    *time.Time
-	force bool
+	plan    *v1.Plan
+	at      *time.Time
+	force   bool
+	attempt int // number of previous attempts that timed out.
 }
 
 var _ Task = &PruneTask{}
@@ -75,6 +78,12 @@ func (t *PruneTask) shouldRun(now time.Time) (bool, error) {
 		return false, fmt.Errorf("get repo %v: %w", t.plan.Repo, err)
 	}
 
+	// prunes repack archived data, they must be run by hand once the repo is warmed up. Append-only repos without a
+	// retention repo can't be pruned at all.
+	if repo.Config().GetColdStorage().GetEnabled() || !t.orch.canApplyRetention(t.plan.Repo) {
+		return false, nil
+	}
+
 	nextPruneTime, err := t.getNextPruneTime(repo, repo.repoConfig.PrunePolicy)
 	if err != nil {
 		return false, fmt.Errorf("get next prune time: %w", err)
@@ -101,81 +110,109 @@ func (t *PruneTask) getNextPruneTime(repo *RepoOrchestrator, policy *v1.PrunePol
 }
 
 func (t *PruneTask) Run(ctx context.Context) error {
+	timeout := time.Duration(t.plan.GetTimeouts().GetPruneMinutes()) * time.Minute
+	var runId int64
 	if err := t.runWithOpAndContext(ctx, func(ctx context.Context, op *v1.Operation) error {
-		repo, err := t.orch.GetRepo(t.plan.Repo)
-		if err != nil {
-			return fmt.Errorf("get repo %v: %w", t.plan.Repo, err)
-		}
+		runId = op.RunId
+		return withTimeout(ctx, timeout, func(ctx context.Context) error {
+			return t.prune(ctx, op)
+		})
+	}); err != nil {
+		repo, _ := t.orch.GetRepo(t.plan.Repo)
+		t.orch.hookExecutor.ExecuteHooks(repo.Config(), t.plan, "", []v1.Hook_Condition{
+			v1.Hook_CONDITION_ANY_ERROR,
+		}, hook.HookVars{
+			Task:  t.Name(),
+			Error: err.Error(),
+			RunId: runId,
+		})
+		// retries are forced since the timed out prune counts towards the prune policy's frequency.
+		handleTimeout(t.orch, t, t.plan, t.attempt, err, func(at time.Time, attempt int) Task {
+			retry := NewOneoffPruneTask(t.orch, t.plan, at, true)
+			retry.attempt = attempt
+			return retry
+		}, TaskPriorityPrune)
+		return err
+	}
+	return nil
+}
 
-		err = repo.UnlockIfAutoEnabled(ctx)
-		if err != nil {
-			return fmt.Errorf("auto unlock repo %q: %w", t.plan.Repo, err)
-		}
+func (t *PruneTask) prune(ctx context.Context, op *v1.Operation) error {
+	repo, err := t.orch.retentionRepo(t.plan.Repo, "prune")
+	if err != nil {
+		return err
+	}
 
-		opPrune := &v1.Operation_OperationPrune{
-			OperationPrune: &v1.OperationPrune{},
-		}
-		op.Op = opPrune
-
-		ctx, cancel := context.WithCancel(ctx)
-		interval := time.NewTicker(1 * time.Second)
-		defer interval.Stop()
-		var buf synchronizedBuffer
-		var wg sync.WaitGroup
-		wg.Add(1)
-		go func() {
-
-			defer wg.Done()
-			for {
-				select {
-				case <-interval.C:
-					output := buf.String()
-					if len(output) > 8*1024 { // only provide live status upto the first 8K of output.
-						output = output[:len(output)-8*1024]
-					}
+	if err := t.orch.RequireWarm(repo.Config(), "prune"); err != nil {
+		return err
+	}
 
-					if opPrune.OperationPrune.Output != output {
-						opPrune.OperationPrune.Output = buf.String()
+	if _, err := checkDiskSpace(t.orch, repo.Config(), t.plan, hook.HookVars{
+		Task:  t.Name(),
+		RunId: op.RunId,
+	}, repoSpacePaths(repo.Config(), t.plan)...); err != nil {
+		return fmt.Errorf("prune pre-flight check: %w", err)
+	}
 
-						if err := t.orch.OpLog.Update(op); err != nil {
-							zap.L().Error("update prune operation with status output", zap.Error(err))
-						}
+	err = repo.UnlockIfAutoEnabled(ctx)
+	if err != nil {
+		return fmt.Errorf("auto unlock repo %q: %w", repo.Config().Id, err)
+	}
+
+	opPrune := &v1.Operation_OperationPrune{
+		OperationPrune: &v1.OperationPrune{},
+	}
+	op.Op = opPrune
+
+	ctx, cancel := context.WithCancel(ctx)
+	interval := time.NewTicker(1 * time.Second)
+	defer interval.Stop()
+	var buf synchronizedBuffer
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+
+		defer wg.Done()
+		for {
+			select {
+			case <-interval.C:
+				output := buf.String()
+				if len(output) > 8*1024 { // only provide live status upto the first 8K of output.
+					output = output[:len(output)-8*1024]
+				}
+
+				if opPrune.OperationPrune.Output != output {
+					opPrune.OperationPrune.Output = buf.String()
+
+					if err := t.orch.OpLog.Update(op); err != nil {
+						zap.L().Error("update prune operation with status output", zap.Error(err))
 					}
-				case <-ctx.Done():
-					return
 				}
+			case <-ctx.Done():
+				return
 			}
-		}()
-
-		if err := repo.Prune(ctx, &buf); err != nil {
-			cancel()
-			return fmt.Errorf("prune: %w", err)
 		}
-		cancel()
-		wg.Wait()
+	}()
 
-		// TODO: it would be best to store the output in separate storage for large status data.
-		output := buf.String()
-		if len(output) > 8*1024 { // only save the first 4K of output.
-			output = output[:len(output)-8*1024]
-		}
-		op.Op = &v1.Operation_OperationPrune{
-			OperationPrune: &v1.OperationPrune{
-				Output: output,
-			},
-		}
+	if err := repo.Prune(ctx, t.plan, &buf); err != nil {
+		cancel()
+		return fmt.Errorf("prune: %w", err)
+	}
+	cancel()
+	wg.Wait()
 
-		return nil
-	}); err != nil {
-		repo, _ := t.orch.GetRepo(t.plan.Repo)
-		t.orch.hookExecutor.ExecuteHooks(repo.Config(), t.plan, "", []v1.Hook_Condition{
-			v1.Hook_CONDITION_ANY_ERROR,
-		}, hook.HookVars{
-			Task:  t.Name(),
-			Error: err.Error(),
-		})
-		return err
+	// TODO: it would be best to store the output in separate storage for large status data.
+	output := buf.String()
+	if len(output) > 8*1024 { // only save the first 4K of output.
+		output = output[:len(output)-8*1024]
+	}
+	op.Op = &v1.Operation_OperationPrune{
+		OperationPrune: &v1.OperationPrune{
+			Output: output,
+			Stats:  pruneStatsToProto(buf.String()),
+		},
 	}
+
 	return nil
 }
 
@@ -198,3 +235,20 @@ func (w *synchronizedBuffer) String() string {
 
 	return w.buf.String()
 }
+
+// pruneStatsToProto parses the summary of the prune's output, it returns nil if the output has none.
+func pruneStatsToProto(output string) *v1.PruneStats {
+	stats, err := restic.ReadPruneStats(strings.NewReader(output))
+	if err != nil {
+		zap.L().Warn("failed to parse prune output", zap.Error(err))
+		return nil
+	}
+	return &v1.PruneStats{
+		BytesFreed:           stats.TotalBytes,
+		BlobsRemoved:         stats.TotalBlobs,
+		BytesRepacked:        stats.RepackBytes,
+		BytesRemaining:       stats.RemainingBytes,
+		BytesUnusedRemaining: stats.UnusedRemainingBytes,
+		PacksDeleted:         stats.PacksDeleted,
+	}
+}
@@ -17,6 +17,7 @@ type RestoreTaskOpts struct {
 	SnapshotId string // required
 	Path       string // required
 	Target     string // required
+	Options    *v1.RestoreOptions
 }
 
 // RestoreTask tracks a forget operation.
@@ -69,8 +70,9 @@ func (t *RestoreTask) Run(ctx context.Context) error {
 	if err := t.runWithOpAndContext(ctx, func(ctx context.Context, op *v1.Operation) error {
 		forgetOp := &v1.Operation_OperationRestore{
 			OperationRestore: &v1.OperationRestore{
-				Path:   t.restoreOpts.Path,
-				Target: t.restoreOpts.Target,
+				Path:    t.restoreOpts.Path,
+				Target:  t.restoreOpts.Target,
+				Options: t.restoreOpts.Options,
 			},
 		}
 		op.Op = forgetOp
@@ -80,9 +82,24 @@ func (t *RestoreTask) Run(ctx context.Context) error {
 		if err != nil {
 			return fmt.Errorf("couldn't get repo %q: %w", t.restoreOpts.RepoId, err)
 		}
+		if err := t.orch.RequireWarm(repo.Config(), "restore"); err != nil {
+			return err
+		}
+
+		// restores only read from the repo, only the cache and the target are written to.
+		spacePaths := []spaceCheckPath{{path: t.restoreOpts.Target, purpose: "restore target"}}
+		if dir := resticCacheDir(repo.Config(), nil); dir != "" {
+			spacePaths = append(spacePaths, spaceCheckPath{path: dir, purpose: "restic cache"})
+		}
+		if _, err := checkDiskSpace(t.orch, repo.Config(), nil, hook.HookVars{
+			Task:  t.Name(),
+			RunId: op.RunId,
+		}, spacePaths...); err != nil {
+			return fmt.Errorf("restore pre-flight check: %w", err)
+		}
 
 		lastSent := time.Now() // debounce progress updates, these can endup being very frequent.
-		summary, err := repo.Restore(ctx, t.restoreOpts.SnapshotId, t.restoreOpts.Path, t.restoreOpts.Target, func(entry *v1.RestoreProgressEntry) {
+		summary, verification, err := repo.Restore(ctx, t.restoreOpts.SnapshotId, t.restoreOpts.Path, t.restoreOpts.Target, t.restoreOpts.Options, func(entry *v1.RestoreProgressEntry) {
 			if time.Since(lastSent) < 250*time.Millisecond {
 				return
 			}
@@ -98,6 +115,11 @@ func (t *RestoreTask) Run(ctx context.Context) error {
 			return fmt.Errorf("restore failed: %w", err)
 		}
 		forgetOp.OperationRestore.Status = summary
+		forgetOp.OperationRestore.Verification = verification
+
+		if n := len(verification.GetMismatches()); n > 0 {
+			return fmt.Errorf("restore verification found %d mismatched files", n)
+		}
 
 		return nil
 	}); err != nil {
@@ -0,0 +1,184 @@
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"os"
+	"sort"
+	"time"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/hook"
+	"github.com/garethgeorge/backrest/pkg/restic"
+	"github.com/gitploy-io/cronexpr"
+	"go.uber.org/zap"
+)
+
+// defaultDrillSampleFiles is the number of files a restore drill restores when the plan doesn't configure it.
+const defaultDrillSampleFiles = 10
+
+// RestoreDrillTask periodically restores part of a plan's latest snapshot to a temporary directory and verifies the
+// restored files, proving that the backups can actually be restored.
+type RestoreDrillTask struct {
+	TaskWithOperation
+	plan      *v1.Plan
+	scheduler func(curTime time.Time) *time.Time
+}
+
+var _ Task = &RestoreDrillTask{}
+
+func NewScheduledRestoreDrillTask(orchestrator *Orchestrator, plan *v1.Plan) (*RestoreDrillTask, error) {
+	cron := plan.GetRestoreDrill().GetCron()
+	sched, err := cronexpr.ParseInLocation(cron, planLocation(plan))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse restore drill schedule %q: %w", cron, err)
+	}
+
+	return &RestoreDrillTask{
+		TaskWithOperation: TaskWithOperation{
+			orch: orchestrator,
+		},
+		plan:      plan,
+		scheduler: jitteredScheduler(sched, plan),
+	}, nil
+}
+
+func (t *RestoreDrillTask) Name() string {
+	return fmt.Sprintf("restore drill for plan %q", t.plan.Id)
+}
+
+func (t *RestoreDrillTask) Next(now time.Time) *time.Time {
+	next := t.scheduler(now)
+	if next == nil {
+		return nil
+	}
+
+	if err := t.setOperation(&v1.Operation{
+		PlanId:          t.plan.Id,
+		RepoId:          t.plan.Repo,
+		UnixTimeStartMs: timeToUnixMillis(*next),
+		Status:          v1.OperationStatus_STATUS_PENDING,
+		Op:              &v1.Operation_OperationRestoreDrill{},
+	}); err != nil {
+		zap.S().Errorf("task %v failed to add operation to oplog: %v", t.Name(), err)
+	}
+
+	return next
+}
+
+func (t *RestoreDrillTask) Run(ctx context.Context) error {
+	if reason := unmetDependency(t.orch.OpLog, t.plan); reason != "" {
+		zap.L().Info("skipping restore drill, dependency unmet", zap.String("plan", t.plan.Id), zap.String("reason", reason))
+		if t.op != nil {
+			t.op.DisplayMessage = "Skipped, " + reason
+		}
+		if err := t.Cancel(v1.OperationStatus_STATUS_SYSTEM_CANCELLED); err != nil {
+			return fmt.Errorf("record skipped restore drill: %w", err)
+		}
+		return nil
+	}
+
+	var snapshotId string
+	if err := t.runWithOpAndContext(ctx, func(ctx context.Context, op *v1.Operation) error {
+		drillOp := &v1.OperationRestoreDrill{}
+		op.Op = &v1.Operation_OperationRestoreDrill{OperationRestoreDrill: drillOp}
+
+		repo, err := t.orch.GetRepo(t.plan.Repo)
+		if err != nil {
+			return fmt.Errorf("couldn't get repo %q: %w", t.plan.Repo, err)
+		}
+		if err := t.orch.RequireWarm(repo.Config(), "restore drill"); err != nil {
+			return err
+		}
+
+		snapshots, err := repo.SnapshotsForPlan(ctx, t.plan)
+		if err != nil {
+			return err
+		}
+		if len(snapshots) == 0 {
+			op.Status = v1.OperationStatus_STATUS_WARNING
+			op.DisplayMessage = "no snapshots to restore"
+			return nil
+		}
+		snapshotId = snapshots[len(snapshots)-1].Id
+		op.SnapshotId = snapshotId
+
+		target, err := os.MkdirTemp("", "backrest-restore-drill-")
+		if err != nil {
+			return fmt.Errorf("create restore directory: %w", err)
+		}
+		defer func() {
+			if err := os.RemoveAll(target); err != nil {
+				zap.S().Errorf("task %v failed to remove restore directory %q: %v", t.Name(), target, err)
+			}
+		}()
+
+		path := t.plan.GetRestoreDrill().GetPath()
+		opts := &v1.RestoreOptions{Verify: true}
+		if path != "" {
+			drillOp.Paths = []string{path}
+		} else {
+			entries, err := repo.ListSnapshotFilesRecursive(ctx, snapshotId, "/")
+			if err != nil {
+				return err
+			}
+			n := int(t.plan.GetRestoreDrill().GetSampleFiles())
+			if n == 0 {
+				n = defaultDrillSampleFiles
+			}
+			drillOp.Paths = sampleDrillFiles(entries, n, rand.New(rand.NewSource(time.Now().UnixNano())))
+			if len(drillOp.Paths) == 0 {
+				op.Status = v1.OperationStatus_STATUS_WARNING
+				op.DisplayMessage = "snapshot contains no files to restore"
+				return nil
+			}
+			for _, p := range drillOp.Paths {
+				opts.Includes = append(opts.Includes, escapePattern(p))
+			}
+		}
+
+		summary, verification, err := repo.Restore(ctx, snapshotId, path, target, opts, nil)
+		if err != nil {
+			return fmt.Errorf("restore failed: %w", err)
+		}
+		drillOp.Status = summary
+		drillOp.Verification = verification
+
+		if verification.GetFilesChecked() == 0 {
+			return errors.New("restore drill restored no files")
+		}
+		if n := len(verification.GetMismatches()); n > 0 {
+			return fmt.Errorf("restore drill found %d mismatched files", n)
+		}
+		return nil
+	}); err != nil {
+		repo, _ := t.orch.GetRepo(t.plan.Repo)
+		t.orch.hookExecutor.ExecuteHooks(repo.Config(), t.plan, snapshotId, []v1.Hook_Condition{
+			v1.Hook_CONDITION_ANY_ERROR,
+			v1.Hook_CONDITION_RESTORE_DRILL_ERROR,
+		}, hook.HookVars{
+			Task:  t.Name(),
+			Error: err.Error(),
+		})
+		return err
+	}
+	return nil
+}
+
+// sampleDrillFiles returns the paths of up to n regular files chosen at random from the entries, sorted by path.
+func sampleDrillFiles(entries []*restic.LsEntry, n int, rng *rand.Rand) []string {
+	var files []string
+	for _, entry := range entries {
+		if entry.Type == "file" {
+			files = append(files, entry.Path)
+		}
+	}
+	rng.Shuffle(len(files), func(i, j int) { files[i], files[j] = files[j], files[i] })
+	if len(files) > n {
+		files = files[:n]
+	}
+	sort.Strings(files)
+	return files
+}
@@ -0,0 +1,89 @@
+package orchestrator
+
+import (
+	"context"
+	"math/rand"
+	"slices"
+	"testing"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/pkg/restic"
+	"github.com/garethgeorge/backrest/test/helpers"
+)
+
+func TestSampleDrillFiles(t *testing.T) {
+	entries := []*restic.LsEntry{
+		{Path: "/data", Type: "dir"},
+		{Path: "/data/a", Type: "file"},
+		{Path: "/data/b", Type: "file"},
+		{Path: "/data/link", Type: "symlink"},
+		{Path: "/data/sub", Type: "dir"},
+		{Path: "/data/sub/c", Type: "file"},
+	}
+
+	all := sampleDrillFiles(entries, 10, rand.New(rand.NewSource(1)))
+	if want := []string{"/data/a", "/data/b", "/data/sub/c"}; !slices.Equal(all, want) {
+		t.Errorf("want every file when the sample is larger than the snapshot, got %v", all)
+	}
+
+	seen := make(map[string]bool)
+	for seed := int64(0); seed < 20; seed++ {
+		sample := sampleDrillFiles(entries, 2, rand.New(rand.NewSource(seed)))
+		if len(sample) != 2 || !slices.IsSorted(sample) {
+			t.Fatalf("want 2 sorted files, got %v", sample)
+		}
+		for _, p := range sample {
+			if !slices.Contains(all, p) {
+				t.Fatalf("sampled %q which is not a file", p)
+			}
+			seen[p] = true
+		}
+	}
+	if len(seen) != len(all) {
+		t.Errorf("want every file sampled across seeds, got %v", seen)
+	}
+
+	if sample := sampleDrillFiles(entries[:1], 5, rand.New(rand.NewSource(1))); len(sample) != 0 {
+		t.Errorf("want no files sampled from a snapshot without files, got %v", sample)
+	}
+}
+
+func TestRestoreDrillSample(t *testing.T) {
+	t.Parallel()
+
+	testData := helpers.CreateTestData(t)
+	r := &v1.Repo{
+		Id:       "test",
+		Uri:      t.TempDir(),
+		Password: "test",
+		Flags:    []string{"--no-cache"},
+	}
+	plan := &v1.Plan{
+		Id:    "test",
+		Repo:  "test",
+		Paths: []string{testData},
+	}
+	orchestrator := newRepoOrchestrator(r, restic.NewRepo(helpers.ResticBinary(t), r, restic.WithFlags("--no-cache")))
+
+	summary, err := orchestrator.Backup(context.Background(), plan, nil)
+	if err != nil {
+		t.Fatalf("backup error: %v", err)
+	}
+
+	entries, err := orchestrator.ListSnapshotFilesRecursive(context.Background(), summary.SnapshotId, "/")
+	if err != nil {
+		t.Fatalf("list error: %v", err)
+	}
+	opts := &v1.RestoreOptions{Verify: true}
+	for _, p := range sampleDrillFiles(entries, 3, rand.New(rand.NewSource(1))) {
+		opts.Includes = append(opts.Includes, escapePattern(p))
+	}
+
+	_, verification, err := orchestrator.Restore(context.Background(), summary.SnapshotId, "", t.TempDir(), opts, nil)
+	if err != nil {
+		t.Fatalf("restore error: %v", err)
+	}
+	if verification.GetFilesChecked() != 3 || len(verification.GetMismatches()) != 0 {
+		t.Errorf("want the 3 sampled files restored without mismatches, got %v", verification)
+	}
+}
@@ -0,0 +1,47 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/config"
+	"github.com/garethgeorge/backrest/internal/selfbackup"
+)
+
+// SelfBackupTask backs up backrest's own config and operation log using the internal self backup plan.
+type SelfBackupTask struct {
+	*BackupTask
+	stageDir string
+}
+
+var _ Task = &SelfBackupTask{}
+
+func NewScheduledSelfBackupTask(orchestrator *Orchestrator, cfg *v1.SelfBackup) (*SelfBackupTask, error) {
+	stageDir := path.Join(config.DataDir(), "self-backup")
+	plan := selfbackup.Plan(cfg, stageDir)
+	if plan == nil {
+		return nil, fmt.Errorf("self backup is not configured")
+	}
+
+	t, err := NewScheduledBackupTask(orchestrator, plan)
+	if err != nil {
+		return nil, err
+	}
+	t.name = "backup of backrest state"
+	return &SelfBackupTask{BackupTask: t, stageDir: stageDir}, nil
+}
+
+func (t *SelfBackupTask) Run(ctx context.Context) error {
+	return t.runWithOpAndContext(ctx, func(ctx context.Context, op *v1.Operation) error {
+		t.orch.mu.Lock()
+		cfg := t.orch.config
+		t.orch.mu.Unlock()
+
+		if err := selfbackup.Stage(t.stageDir, cfg, t.orch.OpLog); err != nil {
+			return fmt.Errorf("stage backrest state: %w", err)
+		}
+		return backupHelper(ctx, t, t.orch, t.plan, op)
+	})
+}
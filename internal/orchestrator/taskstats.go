@@ -22,6 +22,7 @@ type StatsTask struct {
 	plan         *v1.Plan
 	linkSnapshot string // snapshot to link the task to (if any)
 	at           *time.Time
+	scoped       bool // stats are computed for linkSnapshot only.
 }
 
 var _ Task = &StatsTask{}
@@ -49,7 +50,7 @@ func (t *StatsTask) shouldRun() (bool, error) {
 			return nil
 		}
 		howFarBack++
-		if _, ok := op.Op.(*v1.Operation_OperationStats); ok {
+		if stats, ok := op.Op.(*v1.Operation_OperationStats); ok && !stats.OperationStats.GetSnapshotScoped() {
 			if bytesSinceLastStat == -1 {
 				bytesSinceLastStat = 0
 			}
@@ -86,7 +87,11 @@ func (t *StatsTask) Next(now time.Time) *time.Time {
 			zap.S().Errorf("task %v failed to check if it should run: %v", t.Name(), err)
 		}
 		if !shouldRun {
-			return nil
+			repo, err := t.orch.GetRepo(t.plan.Repo)
+			if err != nil || !repo.Config().GetIncrementalStats() || t.linkSnapshot == "" {
+				return nil
+			}
+			t.scoped = true
 		}
 
 		if err := t.setOperation(&v1.Operation{
@@ -95,7 +100,9 @@ func (t *StatsTask) Next(now time.Time) *time.Time {
 			SnapshotId:      t.linkSnapshot,
 			UnixTimeStartMs: timeToUnixMillis(*ret),
 			Status:          v1.OperationStatus_STATUS_PENDING,
-			Op:              &v1.Operation_OperationStats{},
+			Op: &v1.Operation_OperationStats{
+				OperationStats: &v1.OperationStats{SnapshotScoped: t.scoped},
+			},
 		}); err != nil {
 			zap.S().Errorf("task %v failed to add operation to oplog: %v", t.Name(), err)
 			return nil
@@ -109,20 +116,28 @@ func (t *StatsTask) Run(ctx context.Context) error {
 		return errors.New("plan does not have a retention policy")
 	}
 
+	var runId int64
 	if err := t.runWithOpAndContext(ctx, func(ctx context.Context, op *v1.Operation) error {
+		runId = op.RunId
 		repo, err := t.orch.GetRepo(t.plan.Repo)
 		if err != nil {
 			return fmt.Errorf("get repo %q: %w", t.plan.Repo, err)
 		}
 
-		stats, err := repo.Stats(ctx)
+		var stats *v1.RepoStats
+		if t.scoped {
+			stats, err = repo.SnapshotStats(ctx, t.linkSnapshot)
+		} else {
+			stats, err = repo.Stats(ctx)
+		}
 		if err != nil {
 			return fmt.Errorf("get stats: %w", err)
 		}
 
 		op.Op = &v1.Operation_OperationStats{
 			OperationStats: &v1.OperationStats{
-				Stats: stats,
+				Stats:          stats,
+				SnapshotScoped: t.scoped,
 			},
 		}
 
@@ -134,6 +149,7 @@ func (t *StatsTask) Run(ctx context.Context) error {
 		}, hook.HookVars{
 			Task:  t.Name(),
 			Error: err.Error(),
+			RunId: runId,
 		})
 		return err
 	}
@@ -0,0 +1,103 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"time"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/backendopts"
+	"github.com/garethgeorge/backrest/internal/hook"
+	"go.uber.org/zap"
+)
+
+// WarmupTask runs the warmup command of a repo on cold storage, operations reading pack data are allowed once it
+// succeeds until the warmup expires.
+type WarmupTask struct {
+	TaskWithOperation
+	repoId string
+	at     *time.Time
+}
+
+var _ Task = &WarmupTask{}
+
+func NewOneoffWarmupTask(orchestrator *Orchestrator, repoId string, at time.Time) *WarmupTask {
+	return &WarmupTask{
+		TaskWithOperation: TaskWithOperation{
+			orch: orchestrator,
+		},
+		repoId: repoId,
+		at:     &at,
+	}
+}
+
+func (t *WarmupTask) Name() string {
+	return fmt.Sprintf("warmup for repo %q", t.repoId)
+}
+
+func (t *WarmupTask) Next(now time.Time) *time.Time {
+	ret := t.at
+	if ret != nil {
+		t.at = nil
+		if err := t.setOperation(&v1.Operation{
+			PlanId:          planForRepoMaintenance,
+			RepoId:          t.repoId,
+			UnixTimeStartMs: timeToUnixMillis(*ret),
+			Status:          v1.OperationStatus_STATUS_PENDING,
+			Op:              &v1.Operation_OperationWarmup{},
+		}); err != nil {
+			zap.S().Errorf("task %v failed to add operation to oplog: %v", t.Name(), err)
+			return nil
+		}
+	}
+	return ret
+}
+
+func (t *WarmupTask) Run(ctx context.Context) error {
+	repo, err := t.orch.GetRepo(t.repoId)
+	if err != nil {
+		return fmt.Errorf("get repo %q: %w", t.repoId, err)
+	}
+
+	err = t.runWithOpAndContext(ctx, func(ctx context.Context, op *v1.Operation) error {
+		warmup := &v1.OperationWarmup{}
+		op.Op = &v1.Operation_OperationWarmup{OperationWarmup: warmup}
+
+		cold := repo.Config().GetColdStorage()
+		if !cold.GetEnabled() || cold.GetWarmupCommand() == "" {
+			return fmt.Errorf("repo %q has no cold storage warmup command", t.repoId)
+		}
+
+		output, err := runWarmupCommand(ctx, repo.Config())
+		warmup.Output = output
+		if err != nil {
+			return fmt.Errorf("warmup command: %w", err)
+		}
+		// the restored objects expire relative to their restore, not to when the command was started.
+		warmup.ValidUntilUnixMs = time.Now().Add(warmupValidity(cold)).UnixMilli()
+		return nil
+	})
+	if err != nil {
+		t.orch.hookExecutor.ExecuteHooks(repo.Config(), nil, "", []v1.Hook_Condition{
+			v1.Hook_CONDITION_ANY_ERROR,
+		}, hook.HookVars{
+			Task:  t.Name(),
+			Error: err.Error(),
+		})
+		return err
+	}
+	return nil
+}
+
+// runWarmupCommand runs the repo's warmup command in a shell with the repo's env and backend credentials.
+func runWarmupCommand(ctx context.Context, repo *v1.Repo) (string, error) {
+	cmd := exec.CommandContext(ctx, "sh", "-c", repo.GetColdStorage().GetWarmupCommand())
+	cmd.Env = append(os.Environ(), repo.GetEnv()...)
+	cmd.Env = append(cmd.Env, backendopts.Env(repo)...)
+	cmd.Env = append(cmd.Env, "BACKREST_REPO_ID="+repo.GetId(), "BACKREST_REPO_URI="+repo.GetUri())
+	cmd.WaitDelay = 5 * time.Second
+	output, err := cmd.CombinedOutput()
+	return string(output), err
+}
@@ -0,0 +1,75 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/oplog"
+	"github.com/garethgeorge/backrest/internal/oplog/indexutil"
+)
+
+// ForgetPlanSnapshots forgets all snapshots of a plan that is being deleted, pinned snapshots are kept and repos
+// with delayed deletion only mark the snapshots. It returns the number of forgotten or marked snapshots and the number
+// of pinned snapshots kept. The space is reclaimed by the repo's next prune.
+func (o *Orchestrator) ForgetPlanSnapshots(ctx context.Context, plan *v1.Plan) (forgotten int, pinned int, err error) {
+	repo, err := o.retentionRepo(plan.Repo, "forgetting the plan's snapshots")
+	if err != nil {
+		return 0, 0, err
+	}
+	snapshots, err := repo.SnapshotsForPlan(ctx, plan)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	coolOff := o.deletionCoolOff(plan.Repo)
+	var ids []string
+	for _, s := range snapshots {
+		if slices.Contains(s.Tags, PinnedTag) {
+			pinned++
+			continue
+		}
+		if _, marked := deleteAfter(s.Tags); marked && coolOff > 0 {
+			forgotten++ // already marked, the delayed deletion task forgets it.
+			continue
+		}
+		ids = append(ids, s.Id)
+	}
+
+	if coolOff > 0 {
+		marked, err := o.markForDeletion(ctx, repo, ids, coolOff)
+		return forgotten + len(marked), pinned, err
+	}
+
+	for _, id := range ids {
+		if err := repo.ForgetSnapshot(ctx, id); err != nil {
+			return forgotten, pinned, fmt.Errorf("forget %q: %w", id, err)
+		}
+		forgotten++
+		if err := markForgotten(o.OpLog, id); err != nil {
+			return forgotten, pinned, err
+		}
+	}
+	return forgotten, pinned, nil
+}
+
+// markForgotten marks the index operations of the snapshot as forgotten.
+func markForgotten(log *oplog.OpLog, snapshotId string) error {
+	var ops []*v1.Operation
+	if err := log.ForEachBySnapshotId(snapshotId, indexutil.CollectAll(), func(op *v1.Operation) error {
+		if op.GetOperationIndexSnapshot() != nil {
+			ops = append(ops, op)
+		}
+		return nil
+	}); err != nil {
+		return fmt.Errorf("get operations of snapshot %v: %w", snapshotId, err)
+	}
+	for _, op := range ops {
+		op.GetOperationIndexSnapshot().Forgot = true
+		if err := log.Update(op); err != nil {
+			return fmt.Errorf("mark index snapshot %v as forgotten: %w", op.Id, err)
+		}
+	}
+	return nil
+}
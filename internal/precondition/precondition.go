@@ -0,0 +1,232 @@
+package precondition
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
+	"time"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+)
+
+var ErrUnsupported = errors.New("not supported on " + runtime.GOOS)
+
+// dialTimeout bounds how long a reachability check waits for a host to accept a connection.
+var dialTimeout = 5 * time.Second
+
+// Checker evaluates task preconditions against the state of the host. The probes may be replaced for testing.
+type Checker struct {
+	OnACPower func(ctx context.Context) (bool, error)
+	Metered   func(ctx context.Context) (bool, error)
+	WifiSSID  func(ctx context.Context) (string, error)
+	DialHost  func(ctx context.Context, addr string) error
+}
+
+func NewChecker() *Checker {
+	return &Checker{
+		OnACPower: onACPower,
+		Metered:   metered,
+		WifiSSID:  wifiSSID,
+		DialHost:  dialHost,
+	}
+}
+
+// Unmet returns a human readable reason describing the first unmet precondition, or "" if all are met.
+// A precondition that cannot be evaluated on this host is unmet.
+func (c *Checker) Unmet(ctx context.Context, p *v1.Preconditions) string {
+	if p == nil {
+		return ""
+	}
+
+	if p.OnAcPower {
+		onAC, err := c.OnACPower(ctx)
+		if err != nil {
+			return fmt.Sprintf("could not determine power source: %v", err)
+		}
+		if !onAC {
+			return "running on battery power"
+		}
+	}
+
+	if p.UnmeteredNetwork {
+		isMetered, err := c.Metered(ctx)
+		if err != nil {
+			return fmt.Sprintf("could not determine whether the network is metered: %v", err)
+		}
+		if isMetered {
+			return "network connection is metered"
+		}
+	}
+
+	if len(p.WifiSsids) > 0 {
+		ssid, err := c.WifiSSID(ctx)
+		if err != nil {
+			return fmt.Sprintf("could not determine Wi-Fi network: %v", err)
+		}
+		if !slices.Contains(p.WifiSsids, ssid) {
+			if ssid == "" {
+				return "not connected to an allowed Wi-Fi network"
+			}
+			return fmt.Sprintf("connected to Wi-Fi network %q which is not allowed", ssid)
+		}
+	}
+
+	for _, host := range p.ReachableHosts {
+		if err := c.DialHost(ctx, hostAddr(host)); err != nil {
+			return fmt.Sprintf("host %v is not reachable: %v", host, err)
+		}
+	}
+
+	return ""
+}
+
+// hostAddr adds the default port to a host without one.
+func hostAddr(host string) string {
+	if _, _, err := net.SplitHostPort(host); err == nil {
+		return host
+	}
+	return net.JoinHostPort(strings.Trim(host, "[]"), "443")
+}
+
+func dialHost(ctx context.Context, addr string) error {
+	ctx, cancel := context.WithTimeout(ctx, dialTimeout)
+	defer cancel()
+	conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+func onACPower(ctx context.Context) (bool, error) {
+	switch runtime.GOOS {
+	case "linux":
+		return linuxOnACPower("/sys/class/power_supply")
+	case "darwin":
+		out, err := exec.CommandContext(ctx, "pmset", "-g", "ps").Output()
+		if err != nil {
+			return false, fmt.Errorf("pmset: %w", err)
+		}
+		return bytes.Contains(out, []byte("'AC Power'")), nil
+	default:
+		return false, ErrUnsupported
+	}
+}
+
+// linuxOnACPower reads the power supplies in sysfs. Hosts with no mains adapter or battery e.g. desktops and
+// servers are considered to be on AC power.
+func linuxOnACPower(sysfs string) (bool, error) {
+	supplies, err := os.ReadDir(sysfs)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return true, nil
+		}
+		return false, err
+	}
+
+	sawMains := false
+	discharging := false
+	for _, supply := range supplies {
+		dir := filepath.Join(sysfs, supply.Name())
+		switch readSysfs(dir, "type") {
+		case "Mains":
+			sawMains = true
+			if readSysfs(dir, "online") == "1" {
+				return true, nil
+			}
+		case "Battery":
+			if readSysfs(dir, "status") == "Discharging" {
+				discharging = true
+			}
+		}
+	}
+	return !sawMains && !discharging, nil
+}
+
+func readSysfs(dir, name string) string {
+	data, err := os.ReadFile(filepath.Join(dir, name))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(data))
+}
+
+func metered(ctx context.Context) (bool, error) {
+	if runtime.GOOS != "linux" {
+		return false, ErrUnsupported
+	}
+	out, err := exec.CommandContext(ctx, "nmcli", "-t", "-f", "GENERAL.STATE,GENERAL.METERED", "device", "show").Output()
+	if err != nil {
+		return false, fmt.Errorf("nmcli: %w", err)
+	}
+	return parseNmcliMetered(out), nil
+}
+
+// parseNmcliMetered reports whether any connected device in nmcli's terse device output is metered.
+func parseNmcliMetered(out []byte) bool {
+	connected := false
+	scanner := bufio.NewScanner(bytes.NewReader(out))
+	for scanner.Scan() {
+		key, value, _ := strings.Cut(scanner.Text(), ":")
+		switch key {
+		case "GENERAL.STATE":
+			connected = strings.HasPrefix(value, "100")
+		case "GENERAL.METERED":
+			if connected && strings.HasPrefix(value, "yes") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func wifiSSID(ctx context.Context) (string, error) {
+	switch runtime.GOOS {
+	case "linux":
+		out, err := exec.CommandContext(ctx, "nmcli", "-t", "-f", "active,ssid", "device", "wifi").Output()
+		if err != nil {
+			return "", fmt.Errorf("nmcli: %w", err)
+		}
+		return parseSSID(out, "yes:"), nil
+	case "darwin":
+		out, err := exec.CommandContext(ctx, "networksetup", "-getairportnetwork", "en0").Output()
+		if err != nil {
+			return "", fmt.Errorf("networksetup: %w", err)
+		}
+		return parseSSID(out, "Current Wi-Fi Network: "), nil
+	case "windows":
+		out, err := exec.CommandContext(ctx, "netsh", "wlan", "show", "interfaces").Output()
+		if err != nil {
+			return "", fmt.Errorf("netsh: %w", err)
+		}
+		for _, line := range strings.Split(string(out), "\n") {
+			key, value, ok := strings.Cut(line, ":")
+			if ok && strings.TrimSpace(key) == "SSID" {
+				return strings.TrimSpace(value), nil
+			}
+		}
+		return "", nil
+	default:
+		return "", ErrUnsupported
+	}
+}
+
+// parseSSID returns the remainder of the first line of out starting with prefix.
+func parseSSID(out []byte, prefix string) string {
+	for _, line := range strings.Split(string(out), "\n") {
+		line = strings.TrimRight(line, "\r")
+		if strings.HasPrefix(line, prefix) {
+			return strings.TrimPrefix(line, prefix)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,160 @@
+package precondition
+
+import (
+	"context"
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+)
+
+func fakeChecker() *Checker {
+	return &Checker{
+		OnACPower: func(ctx context.Context) (bool, error) { return true, nil },
+		Metered:   func(ctx context.Context) (bool, error) { return false, nil },
+		WifiSSID:  func(ctx context.Context) (string, error) { return "home", nil },
+		DialHost:  func(ctx context.Context, addr string) error { return nil },
+	}
+}
+
+func TestUnmet(t *testing.T) {
+	var tests = []struct {
+		name    string
+		p       *v1.Preconditions
+		modify  func(c *Checker)
+		wantMet bool
+		want    string // substring of the reason.
+	}{
+		{
+			name:    "no preconditions",
+			p:       nil,
+			wantMet: true,
+		},
+		{
+			name:    "all met",
+			p:       &v1.Preconditions{OnAcPower: true, UnmeteredNetwork: true, WifiSsids: []string{"work", "home"}, ReachableHosts: []string{"nas:22"}},
+			wantMet: true,
+		},
+		{
+			name:   "on battery",
+			p:      &v1.Preconditions{OnAcPower: true},
+			modify: func(c *Checker) { c.OnACPower = func(ctx context.Context) (bool, error) { return false, nil } },
+			want:   "battery",
+		},
+		{
+			name: "power source unknown",
+			p:    &v1.Preconditions{OnAcPower: true},
+			modify: func(c *Checker) {
+				c.OnACPower = func(ctx context.Context) (bool, error) { return false, ErrUnsupported }
+			},
+			want: "could not determine power source",
+		},
+		{
+			name:   "metered",
+			p:      &v1.Preconditions{UnmeteredNetwork: true},
+			modify: func(c *Checker) { c.Metered = func(ctx context.Context) (bool, error) { return true, nil } },
+			want:   "metered",
+		},
+		{
+			name: "wrong wifi",
+			p:    &v1.Preconditions{WifiSsids: []string{"work"}},
+			want: `"home" which is not allowed`,
+		},
+		{
+			name: "host unreachable",
+			p:    &v1.Preconditions{ReachableHosts: []string{"nas"}},
+			modify: func(c *Checker) {
+				c.DialHost = func(ctx context.Context, addr string) error {
+					if addr != "nas:443" {
+						t.Errorf("want default port, got %q", addr)
+					}
+					return errors.New("connection refused")
+				}
+			},
+			want: "host nas is not reachable",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			c := fakeChecker()
+			if tc.modify != nil {
+				tc.modify(c)
+			}
+			reason := c.Unmet(context.Background(), tc.p)
+			if tc.wantMet && reason != "" {
+				t.Errorf("want preconditions met, got %q", reason)
+			}
+			if !tc.wantMet && !strings.Contains(reason, tc.want) {
+				t.Errorf("want reason containing %q, got %q", tc.want, reason)
+			}
+		})
+	}
+}
+
+func TestLinuxOnACPower(t *testing.T) {
+	writeSupply := func(t *testing.T, dir, name string, files map[string]string) {
+		t.Helper()
+		if err := os.MkdirAll(filepath.Join(dir, name), 0755); err != nil {
+			t.Fatal(err)
+		}
+		for f, v := range files {
+			if err := os.WriteFile(filepath.Join(dir, name, f), []byte(v+"\n"), 0644); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	desktop := t.TempDir()
+	laptopOnAC := t.TempDir()
+	writeSupply(t, laptopOnAC, "AC", map[string]string{"type": "Mains", "online": "1"})
+	writeSupply(t, laptopOnAC, "BAT0", map[string]string{"type": "Battery", "status": "Charging"})
+	laptopOnBattery := t.TempDir()
+	writeSupply(t, laptopOnBattery, "AC", map[string]string{"type": "Mains", "online": "0"})
+	writeSupply(t, laptopOnBattery, "BAT0", map[string]string{"type": "Battery", "status": "Discharging"})
+
+	for dir, want := range map[string]bool{
+		desktop:                           true,
+		laptopOnAC:                        true,
+		laptopOnBattery:                   false,
+		filepath.Join(desktop, "missing"): true,
+	} {
+		got, err := linuxOnACPower(dir)
+		if err != nil {
+			t.Fatalf("linuxOnACPower(%q) error: %v", dir, err)
+		}
+		if got != want {
+			t.Errorf("linuxOnACPower(%q) = %v, want %v", dir, got, want)
+		}
+	}
+}
+
+func TestParseNmcliMetered(t *testing.T) {
+	out := "GENERAL.STATE:100 (connected)\nGENERAL.METERED:no\nGENERAL.STATE:30 (disconnected)\nGENERAL.METERED:yes\n"
+	if parseNmcliMetered([]byte(out)) {
+		t.Errorf("want unmetered when only a disconnected device is metered")
+	}
+	out += "GENERAL.STATE:100 (connected)\nGENERAL.METERED:yes (guessed)\n"
+	if !parseNmcliMetered([]byte(out)) {
+		t.Errorf("want metered when a connected device is metered")
+	}
+}
+
+func TestDialHost(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := l.Addr().String()
+	if err := dialHost(context.Background(), addr); err != nil {
+		t.Errorf("want listening host reachable, got %v", err)
+	}
+	l.Close()
+	if err := dialHost(context.Background(), addr); err == nil {
+		t.Errorf("want closed host unreachable")
+	}
+}
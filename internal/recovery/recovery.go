@@ -0,0 +1,184 @@
+package recovery
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/alessio/shellescape"
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/resticflags"
+	"golang.org/x/crypto/openpgp"
+	"golang.org/x/crypto/openpgp/armor"
+)
+
+var ErrInvalidPublicKey = errors.New("invalid public key")
+
+// Options configure how a recovery bundle is generated.
+type Options struct {
+	// PublicKey is an optional ASCII armored OpenPGP public key, the repo password is encrypted to it if set.
+	PublicKey string
+	// ResticVersion is the version of restic the repo was last used with.
+	ResticVersion string
+	Now           time.Time
+}
+
+// Credential is a reference to a secret set in the repo's environment. The value is not included, only a
+// fingerprint that identifies which value was configured.
+type Credential struct {
+	Name        string
+	Fingerprint string
+}
+
+type bundleVars struct {
+	Repo              *v1.Repo
+	Generated         string
+	ResticVersion     string
+	Password          string
+	PasswordEncrypted bool
+	Credentials       []Credential
+	Flags             string
+	URI               string
+}
+
+var bundleTemplate = template.Must(template.New("bundle").Parse(`BACKREST RECOVERY BUNDLE
+========================
+
+Repo:      {{ .Repo.Id }}
+URI:       {{ .Repo.Uri }}
+Generated: {{ .Generated }}
+{{- if .ResticVersion }}
+Restic:    {{ .ResticVersion }}
+{{- end }}
+
+This bundle contains what is needed to restore from this repo with restic alone, without a running backrest
+instance. Store it somewhere safe and separate from the data it backs up{{ if not .PasswordEncrypted }}, it contains the repo password in plain text{{ end }}.
+
+REPO PASSWORD
+-------------
+{{ if .PasswordEncrypted -}}
+The password is encrypted to the provided public key, decrypt it with e.g. gpg --decrypt.
+
+{{ .Password }}
+{{- else if .Password -}}
+{{ .Password }}
+{{- else -}}
+No password is set in the repo config, it is provided through the environment (e.g. RESTIC_PASSWORD_FILE or RESTIC_PASSWORD_COMMAND).
+{{- end }}
+
+CREDENTIALS
+-----------
+{{ if .Credentials -}}
+The repo's environment sets the following variables. Their values are not included, retrieve them from where
+they are stored. The fingerprint is the start of the SHA-256 of the value configured when this bundle was generated.
+{{ range .Credentials }}
+  {{ .Name }} (sha256:{{ .Fingerprint }})
+{{- end }}
+{{- else -}}
+The repo's environment sets no variables.
+{{- end }}
+{{ if .Flags }}
+The repo is configured with the extra restic flags: {{ .Flags }}
+{{ end }}
+RESTORE INSTRUCTIONS
+--------------------
+1. Install restic{{ if .ResticVersion }} {{ .ResticVersion }} or newer{{ end }} from https://restic.net.
+2. Set the repo location, password and any credentials listed above:
+
+     export RESTIC_REPOSITORY={{ .URI }}
+     export RESTIC_PASSWORD_FILE=/path/to/file/containing/password
+
+3. List the snapshots in the repo:
+
+     restic snapshots
+
+   Snapshots created by backrest are tagged plan:<plan id>, restrict the listing with e.g. --tag plan:<plan id>.
+
+4. Restore a snapshot, "latest" restores the most recent snapshot:
+
+     restic restore <snapshot id> --target /path/to/restore/to
+
+   Add --include <path> to restore only part of the snapshot.
+`))
+
+// Bundle generates the recovery bundle for the repo.
+func Bundle(repo *v1.Repo, opts Options) ([]byte, error) {
+	vars := bundleVars{
+		Repo:          repo,
+		Generated:     opts.Now.UTC().Format(time.RFC3339),
+		ResticVersion: opts.ResticVersion,
+		Password:      repo.GetPassword(),
+		Credentials:   Credentials(repo),
+		URI:           shellescape.Quote(repo.GetUri()),
+	}
+	if flags := append(slices.Clone(repo.GetFlags()), resticflags.Args(repo.GetExtraFlags())...); len(flags) > 0 {
+		vars.Flags = strings.Join(flags, " ")
+	}
+
+	if opts.PublicKey != "" && vars.Password != "" {
+		encrypted, err := encrypt([]byte(vars.Password), opts.PublicKey)
+		if err != nil {
+			return nil, err
+		}
+		vars.Password = strings.TrimSpace(string(encrypted))
+		vars.PasswordEncrypted = true
+	}
+
+	var buf bytes.Buffer
+	if err := bundleTemplate.Execute(&buf, vars); err != nil {
+		return nil, fmt.Errorf("render recovery bundle: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// Credentials returns references to the variables set in the repo's environment.
+func Credentials(repo *v1.Repo) []Credential {
+	var creds []Credential
+	for _, env := range repo.GetEnv() {
+		name, value, ok := strings.Cut(env, "=")
+		if !ok {
+			continue
+		}
+		sum := sha256.Sum256([]byte(value))
+		creds = append(creds, Credential{Name: name, Fingerprint: hex.EncodeToString(sum[:4])})
+	}
+	return creds
+}
+
+// encrypt encrypts data to the armored OpenPGP public key, returning an armored message.
+func encrypt(data []byte, armoredKey string) ([]byte, error) {
+	keyring, err := openpgp.ReadArmoredKeyRing(strings.NewReader(armoredKey))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPublicKey, err)
+	}
+	if len(keyring) == 0 {
+		return nil, fmt.Errorf("%w: no keys found", ErrInvalidPublicKey)
+	}
+
+	var buf bytes.Buffer
+	armored, err := armor.Encode(&buf, "PGP MESSAGE", nil)
+	if err != nil {
+		return nil, fmt.Errorf("armor message: %w", err)
+	}
+	plaintext, err := openpgp.Encrypt(armored, keyring, nil, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPublicKey, err)
+	}
+	if _, err := io.Copy(plaintext, bytes.NewReader(data)); err != nil {
+		return nil, fmt.Errorf("encrypt: %w", err)
+	}
+	if err := plaintext.Close(); err != nil {
+		return nil, fmt.Errorf("encrypt: %w", err)
+	}
+	if err := armored.Close(); err != nil {
+		return nil, fmt.Errorf("armor message: %w", err)
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,95 @@
+package recovery
+
+import (
+	"bytes"
+	"io"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"golang.org/x/crypto/openpgp"
+	"golang.org/x/crypto/openpgp/armor"
+)
+
+var testRepo = &v1.Repo{
+	Id:       "repo1",
+	Uri:      "s3:s3.amazonaws.com/bucket",
+	Password: "hunter2",
+	Env:      []string{"AWS_ACCESS_KEY_ID=AKIAEXAMPLE", "AWS_SECRET_ACCESS_KEY=secretvalue"},
+}
+
+func TestBundle(t *testing.T) {
+	bundle, err := Bundle(testRepo, Options{ResticVersion: "0.16.4", Now: time.Unix(0, 0)})
+	if err != nil {
+		t.Fatalf("Bundle() error: %v", err)
+	}
+	text := string(bundle)
+
+	for _, want := range []string{"s3:s3.amazonaws.com/bucket", "hunter2", "AWS_ACCESS_KEY_ID (sha256:", "restic 0.16.4 or newer", "1970-01-01T00:00:00Z"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("want bundle to contain %q, got:\n%s", want, text)
+		}
+	}
+	for _, secret := range []string{"AKIAEXAMPLE", "secretvalue"} {
+		if strings.Contains(text, secret) {
+			t.Errorf("want credential value %q omitted from bundle", secret)
+		}
+	}
+}
+
+func TestBundleEncryptedPassword(t *testing.T) {
+	entity, err := openpgp.NewEntity("test", "", "test@example.com", nil)
+	if err != nil {
+		t.Fatalf("failed to create key: %v", err)
+	}
+	// keys generated by gpg state their preferred hashes, generated keys need them added.
+	for _, id := range entity.Identities {
+		id.SelfSignature.PreferredHash = []uint8{8} // SHA256
+		if err := id.SelfSignature.SignUserId(id.UserId.Id, entity.PrimaryKey, entity.PrivateKey, nil); err != nil {
+			t.Fatalf("failed to sign identity: %v", err)
+		}
+	}
+	var pub bytes.Buffer
+	w, err := armor.Encode(&pub, openpgp.PublicKeyType, nil)
+	if err != nil {
+		t.Fatalf("failed to armor key: %v", err)
+	}
+	if err := entity.Serialize(w); err != nil {
+		t.Fatalf("failed to serialize key: %v", err)
+	}
+	w.Close()
+
+	bundle, err := Bundle(testRepo, Options{PublicKey: pub.String(), Now: time.Now()})
+	if err != nil {
+		t.Fatalf("Bundle() error: %v", err)
+	}
+	if bytes.Contains(bundle, []byte("hunter2")) {
+		t.Fatalf("want password encrypted, found in plain text")
+	}
+
+	message := regexp.MustCompile(`(?s)-----BEGIN PGP MESSAGE-----.*-----END PGP MESSAGE-----`).Find(bundle)
+	if message == nil {
+		t.Fatalf("want PGP message in bundle, got:\n%s", bundle)
+	}
+	block, err := armor.Decode(bytes.NewReader(message))
+	if err != nil {
+		t.Fatalf("failed to decode message: %v", err)
+	}
+	md, err := openpgp.ReadMessage(block.Body, openpgp.EntityList{entity}, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to decrypt message: %v", err)
+	}
+	password, err := io.ReadAll(md.UnverifiedBody)
+	if err != nil {
+		t.Fatalf("failed to read message: %v", err)
+	}
+	if string(password) != "hunter2" {
+		t.Errorf("want decrypted password hunter2, got %q", password)
+	}
+
+	if _, err := Bundle(testRepo, Options{PublicKey: "not a key"}); err == nil {
+		t.Errorf("want error for invalid public key")
+	}
+}
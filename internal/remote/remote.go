@@ -0,0 +1,152 @@
+// Package remote reads the status and operations of other backrest instances such that several instances can be
+// monitored from one UI.
+package remote
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/gen/go/v1/v1connect"
+	"github.com/garethgeorge/backrest/internal/config"
+	"github.com/garethgeorge/backrest/internal/orchestrator"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	LocalInstanceId = "local"
+
+	requestTimeout  = 15 * time.Second
+	plansOperations = 20 // recent operations fetched per plan and repo to find its last backup.
+)
+
+var ErrInstanceNotFound = errors.New("remote instance not found")
+
+// Source is the subset of the Backrest service the status of an instance is read from. It is implemented both by
+// the local API handler and by clients of remote instances.
+type Source interface {
+	GetConfig(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[v1.Config], error)
+	GetOperations(context.Context, *connect.Request[v1.GetOperationsRequest]) (*connect.Response[v1.OperationList], error)
+}
+
+// Instances provides clients for the remote instances in the config.
+type Instances struct {
+	config     config.ConfigStore
+	httpClient *http.Client
+}
+
+func NewInstances(config config.ConfigStore) *Instances {
+	return &Instances{
+		config:     config,
+		httpClient: &http.Client{Timeout: requestTimeout},
+	}
+}
+
+// Client returns a client for the remote instance with the id.
+func (r *Instances) Client(id string) (v1connect.BackrestClient, error) {
+	cfg, err := r.config.Get()
+	if err != nil {
+		return nil, fmt.Errorf("get config: %w", err)
+	}
+	for _, instance := range cfg.RemoteInstances {
+		if instance.Id == id {
+			return r.newClient(instance), nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %q", ErrInstanceNotFound, id)
+}
+
+func (r *Instances) newClient(instance *v1.RemoteInstance) v1connect.BackrestClient {
+	token := instance.Token
+	auth := connect.UnaryInterceptorFunc(func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			req.Header().Set("Authorization", "Bearer "+token)
+			return next(ctx, req)
+		}
+	})
+	return v1connect.NewBackrestClient(r.httpClient, instance.Url, connect.WithInterceptors(auth))
+}
+
+// StatusAll returns the status of the local instance followed by the status of each remote instance. Remote
+// instances are queried concurrently, unreachable instances are reported with their error.
+func (r *Instances) StatusAll(ctx context.Context, local Source) ([]*v1.RemoteInstanceStatus, error) {
+	cfg, err := r.config.Get()
+	if err != nil {
+		return nil, fmt.Errorf("get config: %w", err)
+	}
+
+	statuses := make([]*v1.RemoteInstanceStatus, len(cfg.RemoteInstances)+1)
+	statuses[0] = Status(ctx, LocalInstanceId, "", local)
+
+	var wg sync.WaitGroup
+	for i, instance := range cfg.RemoteInstances {
+		i, instance := i, instance
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+			defer cancel()
+			statuses[i+1] = Status(ctx, instance.Id, instance.Url, r.newClient(instance))
+		}()
+	}
+	wg.Wait()
+	return statuses, nil
+}
+
+// Status reads the plan status of an instance from the source.
+func Status(ctx context.Context, id, url string, src Source) *v1.RemoteInstanceStatus {
+	status := &v1.RemoteInstanceStatus{Id: id, Url: url}
+
+	cfgResp, err := src.GetConfig(ctx, connect.NewRequest(&emptypb.Empty{}))
+	if err != nil {
+		status.Error = fmt.Sprintf("get config: %v", err)
+		return status
+	}
+	cfg := cfgResp.Msg
+	status.Host = cfg.Host
+
+	for _, plan := range cfg.Plans {
+		repos := orchestrator.PlanRepos(plan)
+		opsResp, err := src.GetOperations(ctx, connect.NewRequest(&v1.GetOperationsRequest{PlanId: plan.Id, LastN: int64(plansOperations * len(repos))}))
+		if err != nil {
+			status.Error = fmt.Sprintf("get operations for plan %q: %v", plan.Id, err)
+			return status
+		}
+		for _, repoId := range repos {
+			status.Plans = append(status.Plans, planStatus(plan.Id, repoId, opsResp.Msg.Operations))
+		}
+	}
+	status.Reachable = true
+	return status
+}
+
+// planStatus summarizes the plan's recent operations in the repo, operations are ordered oldest first. A plan backing
+// up to additional repos has a status for each of its repos.
+func planStatus(planId, repoId string, ops []*v1.Operation) *v1.RemotePlanStatus {
+	status := &v1.RemotePlanStatus{PlanId: planId, RepoId: repoId}
+	for i := len(ops) - 1; i >= 0; i-- {
+		op := ops[i]
+		if op.RepoId != repoId {
+			continue
+		}
+		if status.LastOperation == nil {
+			status.LastOperation = op
+		}
+		if _, ok := op.Op.(*v1.Operation_OperationBackup); ok && status.LastBackup == nil && isComplete(op) {
+			status.LastBackup = op
+		}
+		if status.LastBackup != nil {
+			break
+		}
+	}
+	return status
+}
+
+func isComplete(op *v1.Operation) bool {
+	return op.Status != v1.OperationStatus_STATUS_PENDING && op.Status != v1.OperationStatus_STATUS_INPROGRESS
+}
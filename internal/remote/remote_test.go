@@ -0,0 +1,126 @@
+package remote
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/gen/go/v1/v1connect"
+	"github.com/garethgeorge/backrest/internal/config"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// fakeInstance serves a fixed config and operations, requiring the token if set.
+type fakeInstance struct {
+	v1connect.UnimplementedBackrestHandler
+	token string
+	cfg   *v1.Config
+	ops   map[string][]*v1.Operation // operations by plan, oldest first.
+}
+
+func (f *fakeInstance) checkToken(header interface{ Get(string) string }) error {
+	if f.token != "" && header.Get("Authorization") != "Bearer "+f.token {
+		return connect.NewError(connect.CodeUnauthenticated, errors.New("bad token"))
+	}
+	return nil
+}
+
+func (f *fakeInstance) GetConfig(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[v1.Config], error) {
+	if err := f.checkToken(req.Header()); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(f.cfg), nil
+}
+
+func (f *fakeInstance) GetOperations(ctx context.Context, req *connect.Request[v1.GetOperationsRequest]) (*connect.Response[v1.OperationList], error) {
+	if err := f.checkToken(req.Header()); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&v1.OperationList{Operations: f.ops[req.Msg.PlanId]}), nil
+}
+
+func backupOp(id int64, repoId string, status v1.OperationStatus) *v1.Operation {
+	return &v1.Operation{Id: id, RepoId: repoId, Status: status, Op: &v1.Operation_OperationBackup{OperationBackup: &v1.OperationBackup{}}}
+}
+
+func TestStatusAll(t *testing.T) {
+	remoteInstance := &fakeInstance{
+		token: "secret",
+		cfg: &v1.Config{
+			Host:  "nas",
+			Plans: []*v1.Plan{{Id: "photos", Repo: "b2", AdditionalRepos: []string{"usb"}}},
+		},
+		ops: map[string][]*v1.Operation{
+			"photos": {
+				backupOp(1, "b2", v1.OperationStatus_STATUS_SUCCESS),
+				backupOp(2, "b2", v1.OperationStatus_STATUS_ERROR),
+				{Id: 3, RepoId: "b2", Status: v1.OperationStatus_STATUS_SUCCESS, Op: &v1.Operation_OperationForget{OperationForget: &v1.OperationForget{}}},
+				backupOp(4, "b2", v1.OperationStatus_STATUS_INPROGRESS),
+				backupOp(5, "usb", v1.OperationStatus_STATUS_SUCCESS),
+			},
+		},
+	}
+	_, handler := v1connect.NewBackrestHandler(remoteInstance)
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	instances := NewInstances(&config.MemoryStore{Config: &v1.Config{
+		RemoteInstances: []*v1.RemoteInstance{
+			{Id: "nas", Url: server.URL, Token: "secret"},
+			{Id: "wrong-token", Url: server.URL, Token: "other"},
+			{Id: "down", Url: "http://127.0.0.1:1", Token: "secret"},
+		},
+	}})
+	local := &fakeInstance{cfg: &v1.Config{Host: "desktop"}}
+
+	statuses, err := instances.StatusAll(context.Background(), local)
+	if err != nil {
+		t.Fatalf("StatusAll() error: %v", err)
+	}
+	if len(statuses) != 4 {
+		t.Fatalf("want 4 statuses, got %d", len(statuses))
+	}
+
+	if s := statuses[0]; s.Id != LocalInstanceId || !s.Reachable || s.Host != "desktop" {
+		t.Errorf("unexpected local status: %v", s)
+	}
+
+	nas := statuses[1]
+	if !nas.Reachable || nas.Host != "nas" || len(nas.Plans) != 2 {
+		t.Fatalf("unexpected nas status: %v", nas)
+	}
+	plan := nas.Plans[0]
+	if plan.PlanId != "photos" || plan.RepoId != "b2" {
+		t.Errorf("unexpected plan status: %v", plan)
+	}
+	if plan.LastOperation.GetId() != 4 {
+		t.Errorf("want last operation 4, got %v", plan.LastOperation)
+	}
+	if plan.LastBackup.GetId() != 2 {
+		t.Errorf("want last completed backup 2, got %v", plan.LastBackup)
+	}
+	if usb := nas.Plans[1]; usb.PlanId != "photos" || usb.RepoId != "usb" || usb.LastBackup.GetId() != 5 {
+		t.Errorf("want the additional repo's last completed backup 5, got %v", usb)
+	}
+
+	for _, s := range statuses[2:] {
+		if s.Reachable || s.Error == "" {
+			t.Errorf("want instance %q unreachable with an error, got %v", s.Id, s)
+		}
+	}
+
+	client, err := instances.Client("nas")
+	if err != nil {
+		t.Fatalf("Client() error: %v", err)
+	}
+	resp, err := client.GetOperations(context.Background(), connect.NewRequest(&v1.GetOperationsRequest{PlanId: "photos"}))
+	if err != nil || len(resp.Msg.Operations) != 5 {
+		t.Errorf("want proxied operations, got %v (error: %v)", resp, err)
+	}
+	if _, err := instances.Client("missing"); !errors.Is(err, ErrInstanceNotFound) {
+		t.Errorf("want ErrInstanceNotFound, got %v", err)
+	}
+}
@@ -0,0 +1,107 @@
+// Package resticflags validates the extra restic flags configured on repos and plans against an allowlist and
+// converts them to command line arguments.
+package resticflags
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/hashicorp/go-multierror"
+)
+
+// Scope is the set of restic commands a flag is passed to.
+type Scope int
+
+const (
+	ScopeGlobal Scope = iota // every command, only global flags are allowed.
+	ScopeBackup              // the backup command, global and backup flags are allowed.
+)
+
+type flagSpec struct {
+	scope    Scope
+	hasValue bool
+}
+
+// allowed lists the flags that can be configured. Flags that backrest sets itself, that change the output format
+// it parses or that select a different repo are deliberately left out.
+var allowed = map[string]flagSpec{
+	// global flags
+	"--cacert":          {scope: ScopeGlobal, hasValue: true},
+	"--cache-dir":       {scope: ScopeGlobal, hasValue: true},
+	"--cleanup-cache":   {scope: ScopeGlobal},
+	"--compression":     {scope: ScopeGlobal, hasValue: true},
+	"--insecure-tls":    {scope: ScopeGlobal},
+	"--limit-download":  {scope: ScopeGlobal, hasValue: true},
+	"--limit-upload":    {scope: ScopeGlobal, hasValue: true},
+	"--no-cache":        {scope: ScopeGlobal},
+	"--option":          {scope: ScopeGlobal, hasValue: true},
+	"--pack-size":       {scope: ScopeGlobal, hasValue: true},
+	"--retry-lock":      {scope: ScopeGlobal, hasValue: true},
+	"--tls-client-cert": {scope: ScopeGlobal, hasValue: true},
+
+	// backup flags
+	"--exclude-file":        {scope: ScopeBackup, hasValue: true},
+	"--exclude-if-present":  {scope: ScopeBackup, hasValue: true},
+	"--exclude-larger-than": {scope: ScopeBackup, hasValue: true},
+	"--files-from":          {scope: ScopeBackup, hasValue: true},
+	"--files-from-raw":      {scope: ScopeBackup, hasValue: true},
+	"--files-from-verbatim": {scope: ScopeBackup, hasValue: true},
+	"--force":               {scope: ScopeBackup},
+	"--iexclude-file":       {scope: ScopeBackup, hasValue: true},
+	"--ignore-ctime":        {scope: ScopeBackup},
+	"--ignore-inode":        {scope: ScopeBackup},
+	"--no-scan":             {scope: ScopeBackup},
+	"--one-file-system":     {scope: ScopeBackup},
+	"--read-concurrency":    {scope: ScopeBackup, hasValue: true},
+	"--skip-if-unchanged":   {scope: ScopeBackup},
+	"--use-fs-snapshot":     {scope: ScopeBackup},
+	"--with-atime":          {scope: ScopeBackup},
+}
+
+var ErrNotAllowed = errors.New("flag is not allowed")
+
+// Allowed returns the names of the flags allowed in the scope.
+func Allowed(scope Scope) []string {
+	var names []string
+	for name, spec := range allowed {
+		if spec.scope <= scope {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Validate checks that every flag is allowed in the scope and has a value only if it takes one.
+func Validate(flags []*v1.ResticFlag, scope Scope) error {
+	var err error
+	for idx, flag := range flags {
+		spec, ok := allowed[flag.GetName()]
+		if !ok || spec.scope > scope {
+			err = multierror.Append(err, fmt.Errorf("extra_flags[%d]: %w: %q", idx, ErrNotAllowed, flag.GetName()))
+			continue
+		}
+		if spec.hasValue && flag.GetValue() == "" {
+			err = multierror.Append(err, fmt.Errorf("extra_flags[%d]: %s requires a value", idx, flag.GetName()))
+		} else if !spec.hasValue && flag.GetValue() != "" {
+			err = multierror.Append(err, fmt.Errorf("extra_flags[%d]: %s does not take a value", idx, flag.GetName()))
+		}
+	}
+	return err
+}
+
+// Args returns the command line arguments for the flags, values are joined to the flag name so they can never be
+// parsed as a flag themselves.
+func Args(flags []*v1.ResticFlag) []string {
+	args := make([]string, 0, len(flags))
+	for _, flag := range flags {
+		if flag.GetValue() == "" {
+			args = append(args, flag.GetName())
+		} else {
+			args = append(args, flag.GetName()+"="+flag.GetValue())
+		}
+	}
+	return args
+}
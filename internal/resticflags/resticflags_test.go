@@ -0,0 +1,91 @@
+package resticflags
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		flags   []*v1.ResticFlag
+		scope   Scope
+		wantErr string
+	}{
+		{
+			name:  "global flags on a repo",
+			flags: []*v1.ResticFlag{{Name: "--limit-upload", Value: "1024"}, {Name: "--insecure-tls"}},
+			scope: ScopeGlobal,
+		},
+		{
+			name:  "backup flags on a plan",
+			flags: []*v1.ResticFlag{{Name: "--one-file-system"}, {Name: "--ignore-inode"}, {Name: "--limit-upload", Value: "1024"}},
+			scope: ScopeBackup,
+		},
+		{
+			name:    "backup flag on a repo",
+			flags:   []*v1.ResticFlag{{Name: "--one-file-system"}},
+			scope:   ScopeGlobal,
+			wantErr: "not allowed",
+		},
+		{
+			name:    "unknown flag",
+			flags:   []*v1.ResticFlag{{Name: "--repo", Value: "/elsewhere"}},
+			scope:   ScopeBackup,
+			wantErr: "not allowed",
+		},
+		{
+			name:    "missing value",
+			flags:   []*v1.ResticFlag{{Name: "--exclude-larger-than"}},
+			scope:   ScopeBackup,
+			wantErr: "requires a value",
+		},
+		{
+			name:    "unexpected value",
+			flags:   []*v1.ResticFlag{{Name: "--ignore-ctime", Value: "yes"}},
+			scope:   ScopeBackup,
+			wantErr: "does not take a value",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := Validate(tc.flags, tc.scope)
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Validate() error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("want error containing %q, got %v", tc.wantErr, err)
+			}
+		})
+	}
+
+	if err := Validate([]*v1.ResticFlag{{Name: "--json"}}, ScopeBackup); !errors.Is(err, ErrNotAllowed) {
+		t.Errorf("want ErrNotAllowed, got %v", err)
+	}
+}
+
+func TestArgs(t *testing.T) {
+	got := Args([]*v1.ResticFlag{{Name: "--one-file-system"}, {Name: "--exclude-if-present", Value: "-nobackup"}})
+	if want := []string{"--one-file-system", "--exclude-if-present=-nobackup"}; !slices.Equal(got, want) {
+		t.Errorf("Args() = %v, want %v", got, want)
+	}
+}
+
+func TestAllowed(t *testing.T) {
+	global, backup := Allowed(ScopeGlobal), Allowed(ScopeBackup)
+	if slices.Contains(global, "--one-file-system") || !slices.Contains(backup, "--one-file-system") {
+		t.Errorf("want backup flags only allowed on backups")
+	}
+	for _, name := range global {
+		if !slices.Contains(backup, name) {
+			t.Errorf("want global flag %q allowed on backups", name)
+		}
+	}
+}
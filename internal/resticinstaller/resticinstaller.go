@@ -30,21 +30,24 @@ var (
 
 	findResticMu  sync.Mutex
 	didTryInstall bool
+	activeVersion = RequiredResticVersion // version FindOrInstallResticBinary returns, newer if restic was updated.
 )
 
+var releaseDownloadURL = "https://github.com/restic/restic/releases/download"
+
 func resticDownloadURL(version string) string {
 	if runtime.GOOS == "windows" {
-		return fmt.Sprintf("https://github.com/restic/restic/releases/download/v%v/restic_%v_windows_%v.zip", version, version, runtime.GOARCH)
+		return fmt.Sprintf("%v/v%v/restic_%v_windows_%v.zip", releaseDownloadURL, version, version, runtime.GOARCH)
 	}
-	return fmt.Sprintf("https://github.com/restic/restic/releases/download/v%v/restic_%v_%v_%v.bz2", version, version, runtime.GOOS, runtime.GOARCH)
+	return fmt.Sprintf("%v/v%v/restic_%v_%v_%v.bz2", releaseDownloadURL, version, version, runtime.GOOS, runtime.GOARCH)
 }
 
 func hashDownloadURL(version string) string {
-	return fmt.Sprintf("https://github.com/restic/restic/releases/download/v%v/SHA256SUMS", version)
+	return fmt.Sprintf("%v/v%v/SHA256SUMS", releaseDownloadURL, version)
 }
 
 func sigDownloadURL(version string) string {
-	return fmt.Sprintf("https://github.com/restic/restic/releases/download/v%v/SHA256SUMS.asc", version)
+	return fmt.Sprintf("%v/v%v/SHA256SUMS.asc", releaseDownloadURL, version)
 }
 
 // getURL downloads the given url and returns the response body as a string.
@@ -63,13 +66,15 @@ func getURL(url string) ([]byte, error) {
 	return body.Bytes(), nil
 }
 
-func verify(sha256 string) error {
-	sha256sums, err := getURL(hashDownloadURL(RequiredResticVersion))
+// verify checks that sha256 is the published hash of the release archive of the version, the published hashes are
+// verified against the restic release signing key.
+func verify(version string, archive string, sha256 string) error {
+	sha256sums, err := getURL(hashDownloadURL(version))
 	if err != nil {
 		return fmt.Errorf("get sha256sums: %w", err)
 	}
 
-	signature, err := getURL(sigDownloadURL(RequiredResticVersion))
+	signature, err := getURL(sigDownloadURL(version))
 	if err != nil {
 		return fmt.Errorf("get signature: %w", err)
 	}
@@ -78,14 +83,39 @@ func verify(sha256 string) error {
 		return fmt.Errorf("gpg verification failed: ok=%v err=%v", ok, err)
 	}
 
-	if !strings.Contains(string(sha256sums), sha256) {
+	if published, ok := publishedHash(sha256sums, archive); !ok || published != sha256 {
 		fmt.Fprintf(os.Stderr, "sha256sums:\n%v\n", string(sha256sums))
-		return fmt.Errorf("sha256sums do not contain %v", sha256)
+		return fmt.Errorf("sha256sums do not list %v for %v", sha256, archive)
 	}
 
 	return nil
 }
 
+// publishedHash returns the hash SHA256SUMS lists for the file.
+func publishedHash(sha256sums []byte, file string) (string, bool) {
+	for _, line := range strings.Split(string(sha256sums), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 2 && strings.TrimPrefix(fields[1], "*") == file {
+			return strings.ToLower(fields[0]), true
+		}
+	}
+	return "", false
+}
+
+// fileSHA256 returns the hex SHA-256 of the file's contents.
+func fileSHA256(name string) (string, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", fmt.Errorf("hash %v: %w", name, err)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
 // downloadFile downloads a file from the given url and saves it to the given path. The sha256 checksum of the file is returned on success.
 func downloadFile(url string, downloadPath string) (string, error) {
 	// Download ur as a file and save it to path
@@ -154,41 +184,60 @@ func downloadFile(url string, downloadPath string) (string, error) {
 	return hex.EncodeToString(hash[:]), nil
 }
 
-func installResticIfNotExists(resticInstallPath string) error {
+// installResticIfNotExists downloads and verifies the version of restic to resticInstallPath unless it is installed.
+func installResticIfNotExists(resticInstallPath string, version string) error {
 	// withFlock is used to ensure tests pass; when running on CI multiple tests may try to install restic at the same time.
 	return withFlock(path.Join(config.DataDir(), "install.lock"), func() error {
 		if _, err := os.Stat(resticInstallPath); err == nil {
 			// file is now installed, probably by another process. We can return.
 			return nil
 		}
+		return downloadRestic(resticInstallPath, version)
+	})
+}
 
-		if err := os.MkdirAll(path.Dir(resticInstallPath), 0755); err != nil {
-			return fmt.Errorf("create restic install directory %v: %w", path.Dir(resticInstallPath), err)
-		}
+// installRestic downloads and verifies the version of restic to resticInstallPath, replacing any binary already there
+// since it can't be checked against the signed SHA256SUMS which list the hashes of the release archives. It returns
+// the hex SHA-256 of the verified binary.
+func installRestic(resticInstallPath string, version string) (string, error) {
+	if err := withFlock(path.Join(config.DataDir(), "install.lock"), func() error {
+		return downloadRestic(resticInstallPath, version)
+	}); err != nil {
+		return "", err
+	}
+	return fileSHA256(resticInstallPath)
+}
 
-		hash, err := downloadFile(resticDownloadURL(RequiredResticVersion), resticInstallPath+".tmp")
-		if err != nil {
-			return err
-		}
+// downloadRestic downloads the version of restic and moves it to resticInstallPath once it is verified.
+func downloadRestic(resticInstallPath string, version string) error {
+	if err := os.MkdirAll(path.Dir(resticInstallPath), 0755); err != nil {
+		return fmt.Errorf("create restic install directory %v: %w", path.Dir(resticInstallPath), err)
+	}
 
-		if err := verify(hash); err != nil {
-			os.Remove(resticInstallPath) // try to remove the bad binary.
-			return fmt.Errorf("failed to verify the authenticity of the downloaded restic binary: %v", err)
-		}
+	url := resticDownloadURL(version)
+	hash, err := downloadFile(url, resticInstallPath+".tmp")
+	if err != nil {
+		return err
+	}
 
-		if err := os.Chmod(resticInstallPath+".tmp", 0755); err != nil {
-			return fmt.Errorf("chmod executable %v: %w", resticInstallPath, err)
-		}
+	if err := verify(version, path.Base(url), hash); err != nil {
+		os.Remove(resticInstallPath + ".tmp") // try to remove the bad binary.
+		return fmt.Errorf("failed to verify the authenticity of the downloaded restic binary: %v", err)
+	}
 
-		if err := os.Rename(resticInstallPath+".tmp", resticInstallPath); err != nil {
-			return fmt.Errorf("rename %v.tmp to %v: %w", resticInstallPath, resticInstallPath, err)
-		}
+	if err := os.Chmod(resticInstallPath+".tmp", 0755); err != nil {
+		return fmt.Errorf("chmod executable %v: %w", resticInstallPath, err)
+	}
 
-		return nil
-	})
+	if err := os.Rename(resticInstallPath+".tmp", resticInstallPath); err != nil {
+		return fmt.Errorf("rename %v.tmp to %v: %w", resticInstallPath, resticInstallPath, err)
+	}
+
+	return nil
 }
 
-func removeOldVersions(installDir string) {
+// removeOldVersions removes the installed restic binaries other than the version.
+func removeOldVersions(installDir string, version string) {
 	files, err := os.ReadDir(installDir)
 	if err != nil {
 		zap.S().Errorf("remove old restic versions: read dir %v: %v", installDir, err)
@@ -196,7 +245,7 @@ func removeOldVersions(installDir string) {
 	}
 
 	for _, file := range files {
-		if !strings.HasPrefix(file.Name(), "restic-") || strings.Contains(file.Name(), RequiredResticVersion) {
+		if !strings.HasPrefix(file.Name(), "restic-") || strings.Contains(file.Name(), version) {
 			continue
 		}
 
@@ -224,14 +273,7 @@ func FindOrInstallResticBinary() (string, error) {
 	}
 
 	// Check for restic installation in data directory.
-	resticInstallPath := path.Join(config.DataDir(), fmt.Sprintf("restic-%v", RequiredResticVersion))
-	if runtime.GOOS == "windows" {
-		programFiles := os.Getenv("programfiles(x86)")
-		if programFiles == "" {
-			programFiles = os.Getenv("programfiles")
-		}
-		resticInstallPath = path.Join(programFiles, "restic", fmt.Sprintf("restic-%v.exe", RequiredResticVersion))
-	}
+	resticInstallPath := installPath(activeVersion)
 	if _, err := os.Stat(resticInstallPath); err != nil {
 		if !errors.Is(err, os.ErrNotExist) {
 			return "", fmt.Errorf("could not stat restic binary at %v: %w", resticBin, err)
@@ -242,13 +284,25 @@ func FindOrInstallResticBinary() (string, error) {
 		}
 		didTryInstall = true
 
-		zap.S().Infof("Installing restic %v to %v...", resticInstallPath, RequiredResticVersion)
-		if err := installResticIfNotExists(resticInstallPath); err != nil {
+		zap.S().Infof("Installing restic %v to %v...", resticInstallPath, activeVersion)
+		if err := installResticIfNotExists(resticInstallPath, activeVersion); err != nil {
 			return "", fmt.Errorf("install restic: %w", err)
 		}
-		zap.S().Infof("Installed restic %v", RequiredResticVersion)
-		removeOldVersions(path.Dir(resticInstallPath))
+		zap.S().Infof("Installed restic %v", activeVersion)
+		removeOldVersions(path.Dir(resticInstallPath), activeVersion)
 	}
 
 	return resticInstallPath, nil
 }
+
+// installPath returns where the version of restic is installed.
+func installPath(version string) string {
+	if runtime.GOOS == "windows" {
+		programFiles := os.Getenv("programfiles(x86)")
+		if programFiles == "" {
+			programFiles = os.Getenv("programfiles")
+		}
+		return path.Join(programFiles, "restic", fmt.Sprintf("restic-%v.exe", version))
+	}
+	return path.Join(config.DataDir(), fmt.Sprintf("restic-%v", version))
+}
@@ -0,0 +1,193 @@
+package resticinstaller
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/config"
+	"go.uber.org/zap"
+)
+
+var ErrNotManaged = errors.New("restic is provided by the user, not installed by backrest")
+
+var latestReleaseURL = "https://api.github.com/repos/restic/restic/releases/latest"
+
+// IsManaged returns whether backrest installs restic, false if the binary is provided with BACKREST_RESTIC_COMMAND.
+func IsManaged() bool {
+	return config.ResticBinPath() == ""
+}
+
+// ActiveVersion returns the version of the restic binary backrest installed and uses.
+func ActiveVersion() string {
+	findResticMu.Lock()
+	defer findResticMu.Unlock()
+	return activeVersion
+}
+
+// FindOrInstallPinned finds or installs the pinned version of restic, or RequiredResticVersion if the pin is older.
+// An installed binary is only used if it matches the pinned hash, otherwise it was modified since it was verified or
+// was never pinned and the version is downloaded and verified again. The returned pin records the binary, it should be
+// stored in the config. Binaries provided by the user aren't pinned, the pin is returned unchanged.
+func FindOrInstallPinned(pin *v1.ResticBinary) (string, *v1.ResticBinary, error) {
+	if !IsManaged() {
+		bin, err := FindOrInstallResticBinary()
+		return bin, pin, err
+	}
+
+	version := RequiredResticVersion
+	if pin.GetVersion() != "" && CompareVersions(pin.GetVersion(), version) > 0 {
+		version = pin.GetVersion()
+	}
+
+	findResticMu.Lock()
+	defer findResticMu.Unlock()
+
+	bin := installPath(version)
+	if pin.GetVersion() == version && pin.GetSha256() != "" {
+		hash, err := fileSHA256(bin)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return "", pin, fmt.Errorf("verify restic binary: %w", err)
+		}
+		if err == nil && hash == pin.GetSha256() {
+			activeVersion = version
+			removeOldVersions(path.Dir(bin), version)
+			return bin, pin, nil
+		}
+		if err == nil {
+			zap.S().Warnf("restic binary %v doesn't match the hash pinned when it was installed, installing it again", bin)
+		}
+	}
+
+	zap.S().Infof("Installing restic %v to %v...", version, bin)
+	hash, err := installRestic(bin, version)
+	if err != nil {
+		return "", pin, fmt.Errorf("install restic %v: %w", version, err)
+	}
+	activeVersion = version
+	removeOldVersions(path.Dir(bin), version)
+	return bin, &v1.ResticBinary{Version: version, Sha256: hash}, nil
+}
+
+// LatestVersion returns the version of restic's latest release.
+func LatestVersion(ctx context.Context) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, latestReleaseURL, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("http GET %v: %w", latestReleaseURL, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("http GET %v: %v", latestReleaseURL, resp.Status)
+	}
+
+	var release struct {
+		TagName string `json:"tag_name"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&release); err != nil {
+		return "", fmt.Errorf("decode release: %w", err)
+	}
+	version := strings.TrimPrefix(release.TagName, "v")
+	if parseVersion(version) == nil {
+		return "", fmt.Errorf("unexpected release tag %q", release.TagName)
+	}
+	return version, nil
+}
+
+// Install downloads and verifies the version of restic and checks that it runs, the binary is removed again if it
+// doesn't. A binary already at the version's install path is replaced. The installed binary isn't used until Activate
+// is called.
+func Install(ctx context.Context, version string) (string, *v1.ResticBinary, error) {
+	if !IsManaged() {
+		return "", nil, ErrNotManaged
+	}
+
+	bin := installPath(version)
+	hash, err := installRestic(bin, version)
+	if err != nil {
+		return "", nil, fmt.Errorf("install restic %v: %w", version, err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+	output, err := exec.CommandContext(ctx, bin, "version").CombinedOutput()
+	if err == nil && !strings.Contains(string(output), version) {
+		err = fmt.Errorf("reports version %q", strings.TrimSpace(string(output)))
+	}
+	if err != nil {
+		if rmErr := os.Remove(bin); rmErr != nil {
+			zap.S().Warnf("remove restic %v after it failed to run: %v", version, rmErr)
+		}
+		return "", nil, fmt.Errorf("restic %v failed to run, keeping restic %v: %w", version, ActiveVersion(), err)
+	}
+	return bin, &v1.ResticBinary{Version: version, Sha256: hash}, nil
+}
+
+// Activate makes FindOrInstallResticBinary return the installed version of restic.
+func Activate(version string) {
+	findResticMu.Lock()
+	defer findResticMu.Unlock()
+	activeVersion = version
+}
+
+// Uninstall removes an installed version of restic, e.g. to roll back an update that couldn't be recorded.
+func Uninstall(version string) error {
+	findResticMu.Lock()
+	defer findResticMu.Unlock()
+	if version == activeVersion {
+		return fmt.Errorf("restic %v is in use", version)
+	}
+	return os.Remove(installPath(version))
+}
+
+// CompareVersions compares two restic versions like "0.16.4", it returns -1, 0 or 1. Versions that don't parse
+// are older than any version that does.
+func CompareVersions(a, b string) int {
+	va, vb := parseVersion(a), parseVersion(b)
+	switch {
+	case va == nil && vb == nil:
+		return 0
+	case va == nil:
+		return -1
+	case vb == nil:
+		return 1
+	}
+	for i := range va {
+		if va[i] != vb[i] {
+			if va[i] < vb[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+func parseVersion(v string) []int {
+	parts := strings.Split(v, ".")
+	if len(parts) != 3 {
+		return nil
+	}
+	nums := make([]int, 3)
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return nil
+		}
+		nums[i] = n
+	}
+	return nums
+}
@@ -0,0 +1,112 @@
+package resticinstaller
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"runtime"
+	"testing"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestCompareVersions(t *testing.T) {
+	tcs := []struct {
+		a, b string
+		want int
+	}{
+		{"0.16.4", "0.16.4", 0},
+		{"0.16.4", "0.17.0", -1},
+		{"0.17.0", "0.16.4", 1},
+		{"0.16.10", "0.16.9", 1},
+		{"1.0.0", "0.99.99", 1},
+		{"garbage", "0.16.4", -1},
+		{"0.16.4", "0.16", 1},
+	}
+	for _, tc := range tcs {
+		if got := CompareVersions(tc.a, tc.b); got != tc.want {
+			t.Errorf("CompareVersions(%q, %q) = %d, want %d", tc.a, tc.b, got, tc.want)
+		}
+	}
+}
+
+func TestPublishedHash(t *testing.T) {
+	sums := []byte("ABCDEF  restic_0.16.4_linux_amd64.bz2\n123456 *restic_0.16.4_windows_amd64.zip\n999999  restic_0.16.4_linux_amd64.bz2.bak\n")
+
+	if hash, ok := publishedHash(sums, "restic_0.16.4_linux_amd64.bz2"); !ok || hash != "abcdef" {
+		t.Errorf("linux hash = %q, %v, want abcdef", hash, ok)
+	}
+	if hash, ok := publishedHash(sums, "restic_0.16.4_windows_amd64.zip"); !ok || hash != "123456" {
+		t.Errorf("windows hash = %q, %v, want 123456", hash, ok)
+	}
+	if _, ok := publishedHash(sums, "restic_0.16.4_darwin_arm64.bz2"); ok {
+		t.Errorf("expected no hash for a file that isn't listed")
+	}
+}
+
+func TestLatestVersion(t *testing.T) {
+	tag := "v0.17.1"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"tag_name": "` + tag + `"}`))
+	}))
+	defer server.Close()
+
+	oldURL := latestReleaseURL
+	latestReleaseURL = server.URL
+	defer func() { latestReleaseURL = oldURL }()
+
+	version, err := LatestVersion(context.Background())
+	if err != nil {
+		t.Fatalf("LatestVersion() error: %v", err)
+	}
+	if version != "0.17.1" {
+		t.Errorf("LatestVersion() = %q, want 0.17.1", version)
+	}
+
+	tag = "nightly"
+	if _, err := LatestVersion(context.Background()); err == nil {
+		t.Errorf("expected an error for an unexpected tag")
+	}
+}
+
+func TestFindOrInstallPinned(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("restic is installed to program files on windows")
+	}
+	t.Setenv("BACKREST_RESTIC_COMMAND", "")
+	t.Setenv("BACKREST_DATA", t.TempDir())
+
+	// downloads fail so a binary is only used if it is trusted without reinstalling it.
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+	oldURL := releaseDownloadURL
+	releaseDownloadURL = server.URL
+	defer func() { releaseDownloadURL = oldURL }()
+
+	bin := installPath(RequiredResticVersion)
+	if err := os.WriteFile(bin, []byte("#!/bin/sh\n"), 0755); err != nil {
+		t.Fatalf("write binary: %v", err)
+	}
+	hash, err := fileSHA256(bin)
+	if err != nil {
+		t.Fatalf("hash binary: %v", err)
+	}
+
+	pin := &v1.ResticBinary{Version: RequiredResticVersion, Sha256: hash}
+	got, gotPin, err := FindOrInstallPinned(pin)
+	if err != nil {
+		t.Fatalf("FindOrInstallPinned() error: %v", err)
+	}
+	if got != bin || !proto.Equal(gotPin, pin) {
+		t.Errorf("want the pinned binary %v, got %v with pin %v", bin, got, gotPin)
+	}
+
+	if _, _, err := FindOrInstallPinned(nil); err == nil {
+		t.Errorf("want an unpinned binary to be downloaded and verified again, got no error")
+	}
+	if _, _, err := FindOrInstallPinned(&v1.ResticBinary{Version: RequiredResticVersion, Sha256: "0000"}); err == nil {
+		t.Errorf("want a binary not matching its pin to be downloaded and verified again, got no error")
+	}
+}
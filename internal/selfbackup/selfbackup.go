@@ -0,0 +1,167 @@
+package selfbackup
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"time"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/pkg/restic"
+	"github.com/natefinch/atomic"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// PlanId is the ID of the internal plan used to back up backrest's state. Snapshots are tagged plan:<PlanId>.
+const PlanId = "_backrest_state"
+
+const (
+	configFileName = "config.json"
+	oplogFileName  = "oplog.boltdb"
+	defaultCron    = "0 3 * * *" // daily at 3am.
+)
+
+var ErrNoStateSnapshot = errors.New("no backrest state snapshot found in repo")
+
+// Database is implemented by the oplog, it must write a consistent copy of the database to w.
+type Database interface {
+	WriteTo(w io.Writer) (int64, error)
+}
+
+// Plan returns the internal plan that backs up the staging directory, nil if self backup is disabled.
+func Plan(cfg *v1.SelfBackup, stageDir string) *v1.Plan {
+	if cfg.GetRepo() == "" {
+		return nil
+	}
+	plan := &v1.Plan{
+		Id:        PlanId,
+		Repo:      cfg.Repo,
+		Paths:     []string{stageDir},
+		Cron:      cfg.Cron,
+		Retention: cfg.Retention,
+	}
+	if plan.Cron == "" {
+		plan.Cron = defaultCron
+	}
+	if plan.Retention == nil {
+		plan.Retention = &v1.RetentionPolicy{
+			KeepLastN: 30,
+		}
+	}
+	return plan
+}
+
+// Stage writes the config and a consistent copy of the oplog database to dir so they can be backed up.
+func Stage(dir string, cfg *v1.Config, db Database) error {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return fmt.Errorf("create staging dir: %w", err)
+	}
+
+	data, err := protojson.MarshalOptions{Indent: "  ", Multiline: true}.Marshal(cfg)
+	if err != nil {
+		return fmt.Errorf("marshal config: %w", err)
+	}
+	if err := writeFile(path.Join(dir, configFileName), bytes.NewReader(data)); err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	if _, err := db.WriteTo(&buf); err != nil {
+		return fmt.Errorf("snapshot oplog: %w", err)
+	}
+	return writeFile(path.Join(dir, oplogFileName), &buf)
+}
+
+// RestoreState restores the config and oplog database from the latest state snapshot in the repo.
+// Existing files at configPath and oplogPath are preserved with a .bak suffix.
+func RestoreState(ctx context.Context, repo *restic.Repo, configPath, oplogPath string) error {
+	snapshots, err := repo.Snapshots(ctx, restic.WithFlags("--tag", "plan:"+PlanId))
+	if err != nil {
+		return fmt.Errorf("list state snapshots: %w", err)
+	}
+	if len(snapshots) == 0 {
+		return ErrNoStateSnapshot
+	}
+	sort.SliceStable(snapshots, func(i, j int) bool {
+		return snapshots[i].UnixTimeMs() < snapshots[j].UnixTimeMs()
+	})
+	latest := snapshots[len(snapshots)-1]
+	if len(latest.Paths) != 1 {
+		return fmt.Errorf("state snapshot %v has unexpected paths %v", latest.Id, latest.Paths)
+	}
+
+	tmpDir, err := os.MkdirTemp("", "backrest-restore-state-")
+	if err != nil {
+		return fmt.Errorf("create temp dir: %w", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	zap.S().Infof("restoring backrest state from snapshot %v taken at %v", latest.Id, latest.Time)
+	if _, err := repo.Restore(ctx, latest.Id, nil, restic.WithFlags("--target", tmpDir)); err != nil {
+		return fmt.Errorf("restore state snapshot %v: %w", latest.Id, err)
+	}
+
+	restoredDir := filepath.Join(tmpDir, filepath.FromSlash(latest.Paths[0]))
+	if filepath.VolumeName(latest.Paths[0]) != "" {
+		// windows paths are restored with the volume name as a directory e.g. C/...
+		restoredDir = filepath.Join(tmpDir, latest.Paths[0][:1], latest.Paths[0][2:])
+	}
+
+	// validate the config before replacing anything.
+	data, err := os.ReadFile(filepath.Join(restoredDir, configFileName))
+	if err != nil {
+		return fmt.Errorf("read restored config: %w", err)
+	}
+	var cfg v1.Config
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(data, &cfg); err != nil {
+		return fmt.Errorf("restored config is invalid: %w", err)
+	}
+
+	for src, dst := range map[string]string{
+		filepath.Join(restoredDir, configFileName): configPath,
+		filepath.Join(restoredDir, oplogFileName):  oplogPath,
+	} {
+		if err := replaceFile(src, dst); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func replaceFile(src, dst string) error {
+	f, err := os.Open(src)
+	if err != nil {
+		return fmt.Errorf("open restored file: %w", err)
+	}
+	defer f.Close()
+
+	if _, err := os.Stat(dst); err == nil {
+		bak := fmt.Sprintf("%v.bak-%v", dst, time.Now().Format("2006-01-02T15-04-05"))
+		if err := os.Rename(dst, bak); err != nil {
+			return fmt.Errorf("move existing %v aside: %w", dst, err)
+		}
+		zap.S().Infof("moved existing %v to %v", dst, bak)
+	}
+	if err := os.MkdirAll(filepath.Dir(dst), 0700); err != nil {
+		return fmt.Errorf("create dir for %v: %w", dst, err)
+	}
+	return writeFile(dst, f)
+}
+
+func writeFile(p string, r io.Reader) error {
+	if err := atomic.WriteFile(p, r); err != nil {
+		return fmt.Errorf("write %v: %w", p, err)
+	}
+	// the config contains repo passwords, only the user running backrest should be able to read it.
+	if err := os.Chmod(p, 0600); err != nil {
+		return fmt.Errorf("chmod(0600) %v: %w", p, err)
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+package selfbackup
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path"
+	"testing"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/internal/oplog"
+	"github.com/garethgeorge/backrest/pkg/restic"
+	"github.com/garethgeorge/backrest/test/helpers"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func TestPlan(t *testing.T) {
+	if Plan(&v1.SelfBackup{}, "/stage") != nil {
+		t.Errorf("want nil plan when no repo is configured")
+	}
+
+	plan := Plan(&v1.SelfBackup{Repo: "repo1"}, "/stage")
+	if plan.Id != PlanId || plan.Repo != "repo1" || plan.Cron != defaultCron {
+		t.Errorf("unexpected plan: %v", plan)
+	}
+	if len(plan.Paths) != 1 || plan.Paths[0] != "/stage" {
+		t.Errorf("want paths [/stage], got %v", plan.Paths)
+	}
+	if plan.Retention.GetKeepLastN() != 30 {
+		t.Errorf("want default retention of 30 snapshots, got %v", plan.Retention)
+	}
+}
+
+func TestStageAndRestoreState(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+
+	log, err := oplog.NewOpLog(path.Join(dir, "oplog.boltdb"))
+	if err != nil {
+		t.Fatalf("failed to create oplog: %v", err)
+	}
+	defer log.Close()
+	if err := log.Add(&v1.Operation{
+		RepoId:          "repo1",
+		PlanId:          "plan1",
+		UnixTimeStartMs: 1234,
+		Status:          v1.OperationStatus_STATUS_SUCCESS,
+		Op:              &v1.Operation_OperationBackup{},
+	}); err != nil {
+		t.Fatalf("failed to add operation: %v", err)
+	}
+
+	cfg := &v1.Config{Host: "original-host"}
+	stageDir := path.Join(dir, "stage")
+	if err := Stage(stageDir, cfg, log); err != nil {
+		t.Fatalf("Stage() error: %v", err)
+	}
+
+	repo := restic.NewRepo(helpers.ResticBinary(t), &v1.Repo{
+		Id:       "test",
+		Uri:      path.Join(dir, "repo"),
+		Password: "test",
+	}, restic.WithFlags("--no-cache"))
+	if err := repo.Init(context.Background()); err != nil {
+		t.Fatalf("failed to init repo: %v", err)
+	}
+
+	if err := RestoreState(context.Background(), repo, path.Join(dir, "out", "config.json"), path.Join(dir, "out", "oplog.boltdb")); !errors.Is(err, ErrNoStateSnapshot) {
+		t.Fatalf("want ErrNoStateSnapshot before any backup, got %v", err)
+	}
+
+	if _, err := repo.Backup(context.Background(), nil, restic.WithBackupPaths(stageDir), restic.WithBackupTags("plan:"+PlanId)); err != nil {
+		t.Fatalf("failed to backup: %v", err)
+	}
+
+	configPath := path.Join(dir, "out", "config.json")
+	oplogPath := path.Join(dir, "out", "oplog.boltdb")
+	if err := os.MkdirAll(path.Dir(configPath), 0700); err != nil {
+		t.Fatalf("failed to create dir: %v", err)
+	}
+	if err := os.WriteFile(configPath, []byte("{}"), 0600); err != nil {
+		t.Fatalf("failed to write existing config: %v", err)
+	}
+
+	if err := RestoreState(context.Background(), repo, configPath, oplogPath); err != nil {
+		t.Fatalf("RestoreState() error: %v", err)
+	}
+
+	data, err := os.ReadFile(configPath)
+	if err != nil {
+		t.Fatalf("failed to read restored config: %v", err)
+	}
+	var restored v1.Config
+	if err := protojson.Unmarshal(data, &restored); err != nil {
+		t.Fatalf("failed to unmarshal restored config: %v", err)
+	}
+	if restored.Host != "original-host" {
+		t.Errorf("want restored host %q, got %q", "original-host", restored.Host)
+	}
+
+	restoredLog, err := oplog.NewOpLog(oplogPath)
+	if err != nil {
+		t.Fatalf("failed to open restored oplog: %v", err)
+	}
+	defer restoredLog.Close()
+	var count int
+	if err := restoredLog.ForAll(func(op *v1.Operation) error {
+		count++
+		return nil
+	}); err != nil {
+		t.Fatalf("failed to read restored oplog: %v", err)
+	}
+	if count != 1 {
+		t.Errorf("want 1 operation in restored oplog, got %d", count)
+	}
+
+	ents, err := os.ReadDir(path.Dir(configPath))
+	if err != nil {
+		t.Fatalf("failed to read dir: %v", err)
+	}
+	if len(ents) != 3 {
+		t.Errorf("want config, oplog, and config backup in output dir, got %d entries", len(ents))
+	}
+}
@@ -0,0 +1,121 @@
+// Package servertls builds the TLS configuration of the API and UI listener from the config.
+package servertls
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"golang.org/x/crypto/acme"
+	"golang.org/x/crypto/acme/autocert"
+)
+
+var ErrNoCertificate = errors.New("tls requires cert_file and key_file or acme domains")
+
+// Server is the TLS configuration of the listener. ChallengeHandler is non-nil if ACME HTTP-01 challenges should be
+// served on ChallengeAddress, it redirects all other requests to HTTPS.
+type Server struct {
+	TLSConfig        *tls.Config
+	ChallengeAddress string
+	ChallengeHandler http.Handler
+}
+
+// New returns the TLS configuration for the config, or nil if TLS is not configured. ACME account keys and issued
+// certificates are cached in cacheDir.
+func New(cfg *v1.Tls, cacheDir string) (*Server, error) {
+	if cfg == nil {
+		return nil, nil
+	}
+
+	server := &Server{}
+	switch {
+	case len(cfg.GetAcme().GetDomains()) > 0:
+		m := &autocert.Manager{
+			Prompt:     autocert.AcceptTOS,
+			HostPolicy: autocert.HostWhitelist(cfg.Acme.Domains...),
+			Cache:      autocert.DirCache(cacheDir),
+			Email:      cfg.Acme.Email,
+		}
+		if cfg.Acme.DirectoryUrl != "" {
+			m.Client = &acme.Client{DirectoryURL: cfg.Acme.DirectoryUrl}
+		}
+		server.TLSConfig = m.TLSConfig()
+		if cfg.Acme.HttpChallengeAddress != "" {
+			server.ChallengeAddress = cfg.Acme.HttpChallengeAddress
+			server.ChallengeHandler = m.HTTPHandler(nil)
+		}
+	case cfg.CertFile != "" && cfg.KeyFile != "":
+		loader := &certLoader{certFile: cfg.CertFile, keyFile: cfg.KeyFile}
+		if _, err := loader.GetCertificate(nil); err != nil {
+			return nil, err
+		}
+		server.TLSConfig = &tls.Config{GetCertificate: loader.GetCertificate}
+	default:
+		return nil, ErrNoCertificate
+	}
+	server.TLSConfig.MinVersion = tls.VersionTLS12
+
+	if cfg.ClientCaFile != "" {
+		data, err := os.ReadFile(cfg.ClientCaFile)
+		if err != nil {
+			return nil, fmt.Errorf("read client CA file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(data) {
+			return nil, fmt.Errorf("client CA file %q contains no certificates", cfg.ClientCaFile)
+		}
+		server.TLSConfig.ClientCAs = pool
+		server.TLSConfig.ClientAuth = tls.VerifyClientCertIfGiven
+		if cfg.RequireClientCert {
+			server.TLSConfig.ClientAuth = tls.RequireAndVerifyClientCert
+		}
+	}
+	return server, nil
+}
+
+// certLoader serves a certificate from files, reloading it when either file is modified such that renewed
+// certificates are picked up without a restart.
+type certLoader struct {
+	certFile, keyFile string
+
+	mu       sync.Mutex
+	cert     *tls.Certificate
+	modTimes [2]time.Time
+}
+
+func (l *certLoader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var modTimes [2]time.Time
+	for i, f := range []string{l.certFile, l.keyFile} {
+		info, err := os.Stat(f)
+		if err != nil {
+			if l.cert != nil {
+				return l.cert, nil // keep serving the last good certificate while a renewal replaces the files.
+			}
+			return nil, fmt.Errorf("stat %q: %w", f, err)
+		}
+		modTimes[i] = info.ModTime()
+	}
+	if l.cert != nil && modTimes == l.modTimes {
+		return l.cert, nil
+	}
+
+	cert, err := tls.LoadX509KeyPair(l.certFile, l.keyFile)
+	if err != nil {
+		if l.cert != nil {
+			return l.cert, nil
+		}
+		return nil, fmt.Errorf("load certificate: %w", err)
+	}
+	l.cert = &cert
+	l.modTimes = modTimes
+	return l.cert, nil
+}
@@ -0,0 +1,140 @@
+package servertls
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+)
+
+// writeCert writes a self signed certificate for the common name and returns the certificate and key paths.
+func writeCert(t *testing.T, dir, cn string) (string, string) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: cn},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("create certificate: %v", err)
+	}
+	keyDer, _ := x509.MarshalECPrivateKey(key)
+
+	certFile, keyFile := filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")
+	if err := os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return certFile, keyFile
+}
+
+func commonName(t *testing.T, cert *tls.Certificate) string {
+	t.Helper()
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		t.Fatalf("parse certificate: %v", err)
+	}
+	return leaf.Subject.CommonName
+}
+
+func TestNew(t *testing.T) {
+	if server, err := New(nil, t.TempDir()); server != nil || err != nil {
+		t.Errorf("want nil without TLS config, got %v, %v", server, err)
+	}
+	if _, err := New(&v1.Tls{}, t.TempDir()); !errors.Is(err, ErrNoCertificate) {
+		t.Errorf("want ErrNoCertificate, got %v", err)
+	}
+
+	server, err := New(&v1.Tls{Acme: &v1.Acme{Domains: []string{"backrest.example.com"}, HttpChallengeAddress: ":80"}}, t.TempDir())
+	if err != nil {
+		t.Fatalf("New() with acme error: %v", err)
+	}
+	if server.TLSConfig.GetCertificate == nil || server.ChallengeHandler == nil || server.ChallengeAddress != ":80" {
+		t.Errorf("want acme certificates and challenge handler, got %+v", server)
+	}
+}
+
+func TestCertFileReload(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := writeCert(t, dir, "first")
+	server, err := New(&v1.Tls{CertFile: certFile, KeyFile: keyFile}, dir)
+	if err != nil {
+		t.Fatalf("New() error: %v", err)
+	}
+	if server.ChallengeHandler != nil {
+		t.Errorf("want no challenge handler for certificate files")
+	}
+
+	cert, err := server.TLSConfig.GetCertificate(nil)
+	if err != nil {
+		t.Fatalf("GetCertificate() error: %v", err)
+	}
+	if cn := commonName(t, cert); cn != "first" {
+		t.Errorf("want certificate first, got %q", cn)
+	}
+
+	// a renewed certificate is picked up once the files change.
+	writeCert(t, dir, "second")
+	future := time.Now().Add(time.Minute)
+	os.Chtimes(certFile, future, future)
+	cert, err = server.TLSConfig.GetCertificate(nil)
+	if err != nil {
+		t.Fatalf("GetCertificate() error: %v", err)
+	}
+	if cn := commonName(t, cert); cn != "second" {
+		t.Errorf("want certificate second after reload, got %q", cn)
+	}
+
+	// the last good certificate is kept while the files are invalid.
+	os.WriteFile(certFile, []byte("garbage"), 0600)
+	os.Chtimes(certFile, future.Add(time.Minute), future.Add(time.Minute))
+	if cert, err = server.TLSConfig.GetCertificate(nil); err != nil || commonName(t, cert) != "second" {
+		t.Errorf("want last good certificate, got error %v", err)
+	}
+}
+
+func TestClientCA(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := writeCert(t, dir, "server")
+
+	server, err := New(&v1.Tls{CertFile: certFile, KeyFile: keyFile, ClientCaFile: certFile}, dir)
+	if err != nil {
+		t.Fatalf("New() error: %v", err)
+	}
+	if server.TLSConfig.ClientAuth != tls.VerifyClientCertIfGiven || server.TLSConfig.ClientCAs == nil {
+		t.Errorf("want optional client certificates, got %v", server.TLSConfig.ClientAuth)
+	}
+
+	server, err = New(&v1.Tls{CertFile: certFile, KeyFile: keyFile, ClientCaFile: certFile, RequireClientCert: true}, dir)
+	if err != nil {
+		t.Fatalf("New() error: %v", err)
+	}
+	if server.TLSConfig.ClientAuth != tls.RequireAndVerifyClientCert {
+		t.Errorf("want required client certificates, got %v", server.TLSConfig.ClientAuth)
+	}
+
+	if _, err := New(&v1.Tls{CertFile: certFile, KeyFile: keyFile, ClientCaFile: keyFile}, dir); err == nil {
+		t.Errorf("want error for client CA file without certificates")
+	}
+}
@@ -0,0 +1,49 @@
+package unixsocket
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+func TestListen(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("unix socket permissions aren't supported on windows")
+	}
+	path := filepath.Join(t.TempDir(), "backrest.sock")
+
+	l, err := Listen(path, 0600)
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	if info, err := os.Stat(path); err != nil || info.Mode().Perm() != 0600 {
+		t.Errorf("want the socket created with mode 0600, got %v, %v", info.Mode(), err)
+	}
+	conn, err := net.Dial("unix", path)
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	conn.Close()
+
+	// a socket left behind, e.g. by a crash, is replaced.
+	l.(*net.UnixListener).SetUnlinkOnClose(false)
+	l.Close()
+	l, err = Listen(path, 0660)
+	if err != nil {
+		t.Fatalf("listen on a stale socket: %v", err)
+	}
+	l.Close()
+
+	file := filepath.Join(t.TempDir(), "data")
+	if err := os.WriteFile(file, []byte("data"), 0644); err != nil {
+		t.Fatalf("write file: %v", err)
+	}
+	if _, err := Listen(file, 0660); err == nil {
+		t.Errorf("want an error listening on a path that isn't a socket")
+	}
+	if data, err := os.ReadFile(file); err != nil || string(data) != "data" {
+		t.Errorf("want the file kept, got %q, %v", data, err)
+	}
+}
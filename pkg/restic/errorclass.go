@@ -0,0 +1,100 @@
+package restic
+
+import (
+	"errors"
+	"os/exec"
+	"strings"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+)
+
+// exit codes restic 0.17 and newer use for some failures, older versions exit with 1 and are classified by output.
+const (
+	exitCodeRepoNotFound  = 10
+	exitCodeLocked        = 11
+	exitCodeWrongPassword = 12
+)
+
+// errorPatterns are substrings of restic and backend error messages for each class, checked in order so classes
+// with more specific messages come first e.g. a lock failure mentioning a network error is still a lock failure.
+var errorPatterns = []struct {
+	class    v1.ErrorClass
+	patterns []string
+}{
+	{v1.ErrorClass_ERROR_CLASS_LOCKED, []string{
+		"repository is already locked",
+		"unable to create lock",
+		"locked exclusively",
+	}},
+	{v1.ErrorClass_ERROR_CLASS_AUTH, []string{
+		"wrong password or no key found",
+		"invalidaccesskeyid",
+		"signaturedoesnotmatch",
+		"the aws access key id you provided does not exist",
+		"authentication failed",
+		"401 unauthorized",
+		"unable to authenticate",
+	}},
+	{v1.ErrorClass_ERROR_CLASS_NO_SPACE, []string{
+		"no space left on device",
+		"disk quota exceeded",
+		"quotaexceeded",
+		"insufficient disk space",
+	}},
+	{v1.ErrorClass_ERROR_CLASS_CORRUPTED, []string{
+		"ciphertext verification failed",
+		"invalid data returned",
+		"hash does not match",
+		"does not match id",
+		"pack file cannot be listed",
+		"is damaged",
+	}},
+	{v1.ErrorClass_ERROR_CLASS_PERMISSION, []string{
+		"permission denied",
+		"operation not permitted",
+		"accessdenied",
+		"403 forbidden",
+	}},
+	{v1.ErrorClass_ERROR_CLASS_NETWORK, []string{
+		"connection refused",
+		"connection reset by peer",
+		"no such host",
+		"network is unreachable",
+		"i/o timeout",
+		"tls handshake timeout",
+		"broken pipe",
+		"server misbehaving",
+		"dial tcp",
+	}},
+}
+
+// ClassifyError returns the class of a failed restic command from its exit code and output, ERROR_CLASS_UNKNOWN if
+// it matches none.
+func ClassifyError(err error) v1.ErrorClass {
+	if err == nil {
+		return v1.ErrorClass_ERROR_CLASS_UNKNOWN
+	}
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		switch exitErr.ExitCode() {
+		case exitCodeLocked:
+			return v1.ErrorClass_ERROR_CLASS_LOCKED
+		case exitCodeWrongPassword:
+			return v1.ErrorClass_ERROR_CLASS_AUTH
+		}
+	}
+	return ClassifyOutput(err.Error())
+}
+
+// ClassifyOutput returns the class of the error message or command output, ERROR_CLASS_UNKNOWN if it matches none.
+func ClassifyOutput(output string) v1.ErrorClass {
+	output = strings.ToLower(output)
+	for _, p := range errorPatterns {
+		for _, pattern := range p.patterns {
+			if strings.Contains(output, pattern) {
+				return p.class
+			}
+		}
+	}
+	return v1.ErrorClass_ERROR_CLASS_UNKNOWN
+}
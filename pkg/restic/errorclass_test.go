@@ -0,0 +1,51 @@
+package restic
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"testing"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+)
+
+func TestClassifyError(t *testing.T) {
+	tests := []struct {
+		name   string
+		output string
+		want   v1.ErrorClass
+	}{
+		{name: "wrong password", output: "Fatal: wrong password or no key found", want: v1.ErrorClass_ERROR_CLASS_AUTH},
+		{name: "s3 credentials", output: "Fatal: unable to open config file: Stat: The AWS Access Key Id you provided does not exist in our records.", want: v1.ErrorClass_ERROR_CLASS_AUTH},
+		{name: "locked", output: "unable to create lock in backend: repository is already locked by PID 1234 on host", want: v1.ErrorClass_ERROR_CLASS_LOCKED},
+		{name: "network", output: `Fatal: unable to open config file: Get "https://s3.example.com/": dial tcp: lookup s3.example.com: no such host`, want: v1.ErrorClass_ERROR_CLASS_NETWORK},
+		{name: "timeout", output: "net/http: TLS handshake timeout", want: v1.ErrorClass_ERROR_CLASS_NETWORK},
+		{name: "no space", output: "Save(<data/1234>) returned error: write /repo/data/12/1234: no space left on device", want: v1.ErrorClass_ERROR_CLASS_NO_SPACE},
+		{name: "permission", output: "open /etc/shadow: permission denied", want: v1.ErrorClass_ERROR_CLASS_PERMISSION},
+		{name: "corrupted", output: "Load(<data/5678>, 0, 0) returned error, retrying after 1s: ciphertext verification failed", want: v1.ErrorClass_ERROR_CLASS_CORRUPTED},
+		{name: "unknown", output: "Fatal: something else went wrong", want: v1.ErrorClass_ERROR_CLASS_UNKNOWN},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := &CmdError{Command: "restic backup", Err: errors.New("exit status 1"), Output: tc.output}
+			if got := ClassifyError(fmt.Errorf("backup: %w", err)); got != tc.want {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+
+	if got := ClassifyError(nil); got != v1.ErrorClass_ERROR_CLASS_UNKNOWN {
+		t.Errorf("ClassifyError(nil) = %v, want unknown", got)
+	}
+}
+
+func TestClassifyExitCode(t *testing.T) {
+	err := exec.Command("sh", "-c", "exit 11").Run()
+	if got := ClassifyError(&CmdError{Command: "restic backup", Err: err}); got != v1.ErrorClass_ERROR_CLASS_LOCKED {
+		t.Errorf("exit code 11: got %v, want locked", got)
+	}
+	err = exec.Command("sh", "-c", "exit 12").Run()
+	if got := ClassifyError(&CmdError{Command: "restic backup", Err: err}); got != v1.ErrorClass_ERROR_CLASS_AUTH {
+		t.Errorf("exit code 12: got %v, want auth", got)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"io"
 	"os/exec"
 	"slices"
+	"strings"
 	"time"
 
 	v1 "github.com/garethgeorge/backrest/gen/go/v1"
@@ -22,6 +23,7 @@ type Snapshot struct {
 	Username   string   `json:"username"`
 	Tags       []string `json:"tags"`
 	Parent     string   `json:"parent"`
+	Original   string   `json:"original"` // ID of the snapshot this one was rewritten from (e.g. by changing its tags).
 	unixTimeMs int64    `json:"-"`
 }
 
@@ -49,7 +51,7 @@ func (s *Snapshot) Validate() error {
 
 type BackupProgressEntry struct {
 	// Common fields
-	MessageType string `json:"message_type"` // "summary" or "status" or "error"
+	MessageType string `json:"message_type"` // "summary", "status", "verbose_status" or "error"
 
 	// Error fields
 	Error  any    `json:"error"`
@@ -66,6 +68,7 @@ type BackupProgressEntry struct {
 	DataBlobs           int     `json:"data_blobs"`
 	TreeBlobs           int     `json:"tree_blobs"`
 	DataAdded           int     `json:"data_added"`
+	DataAddedPacked     int     `json:"data_added_packed"` // reported by restic 0.17 and later.
 	TotalFilesProcessed int     `json:"total_files_processed"`
 	TotalBytesProcessed int     `json:"total_bytes_processed"`
 	TotalDuration       float64 `json:"total_duration"`
@@ -78,6 +81,11 @@ type BackupProgressEntry struct {
 	TotalBytes   int      `json:"total_bytes"`
 	BytesDone    int      `json:"bytes_done"`
 	CurrentFiles []string `json:"current_files"`
+
+	// Verbose status fields, reported per file and directory when the backup runs with --verbose.
+	Action         string `json:"action"` // "new", "modified", "unchanged" or "scan_finished"
+	DataSize       int    `json:"data_size"`
+	DataSizeInRepo int    `json:"data_size_in_repo"`
 }
 
 func (b *BackupProgressEntry) Validate() error {
@@ -182,6 +190,12 @@ func (e *LsEntry) ToProto() *v1.LsEntry {
 	}
 }
 
+// FindResult is the entries of a snapshot matched by restic find.
+type FindResult struct {
+	Snapshot string     `json:"snapshot"`
+	Matches  []*LsEntry `json:"matches"`
+}
+
 func readLs(output io.Reader) (*Snapshot, []*LsEntry, error) {
 	scanner := bufio.NewScanner(output)
 	scanner.Split(bufio.ScanLines)
@@ -317,5 +331,115 @@ type RepoStats struct {
 	CompressionProgress    int64   `json:"compression_progress"`
 	CompressionSpaceSaving float64 `json:"compression_space_saving"`
 	TotalBlobCount         int64   `json:"total_blob_count"`
+	TotalFileCount         int64   `json:"total_file_count"`
 	SnapshotsCount         int64   `json:"snapshots_count"`
 }
+
+type Lock struct {
+	Id        string    `json:"-"`
+	Time      time.Time `json:"time"`
+	Exclusive bool      `json:"exclusive"`
+	Hostname  string    `json:"hostname"`
+	Username  string    `json:"username"`
+	PID       int       `json:"pid"`
+}
+
+// PruneEstimate is the amount of data a prune would remove, as reported by prune --dry-run.
+type PruneEstimate struct {
+	Blobs int64
+	Bytes int64
+}
+
+// readPruneEstimate parses the "total prune" line of prune --dry-run output e.g. "total prune: 6 blobs / 1.112 KiB".
+func readPruneEstimate(output io.Reader) (*PruneEstimate, error) {
+	scanner := bufio.NewScanner(output)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if !strings.HasPrefix(line, "total prune:") {
+			continue
+		}
+		blobs, bytes, err := parseBlobsLine(line)
+		if err != nil {
+			return nil, err
+		}
+		return &PruneEstimate{Blobs: blobs, Bytes: bytes}, nil
+	}
+	return nil, errors.New("prune output did not include an estimate")
+}
+
+// PruneStats are the amounts of data removed by a prune, as reported in its output.
+type PruneStats struct {
+	RepackBytes          int64 // "to repack", used blobs rewritten from partly unused packs.
+	TotalBlobs           int64 // "total prune", unused blobs removed.
+	TotalBytes           int64
+	RemainingBytes       int64 // "remaining"
+	UnusedRemainingBytes int64 // "unused size after prune"
+	PacksDeleted         int64 // "removing N old packs", 0 if no packs were deleted.
+}
+
+// ReadPruneStats parses the summary of a prune's output e.g. "total prune: 10 blobs / 294.820 KiB".
+func ReadPruneStats(output io.Reader) (*PruneStats, error) {
+	var stats PruneStats
+	found := false
+	scanner := bufio.NewScanner(output)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		var err error
+		switch {
+		case strings.HasPrefix(line, "to repack:"):
+			_, stats.RepackBytes, err = parseBlobsLine(line)
+		case strings.HasPrefix(line, "total prune:"):
+			stats.TotalBlobs, stats.TotalBytes, err = parseBlobsLine(line)
+			found = true
+		case strings.HasPrefix(line, "remaining:"):
+			_, stats.RemainingBytes, err = parseBlobsLine(line)
+		case strings.HasPrefix(line, "unused size after prune:"):
+			size, _, _ := strings.Cut(strings.TrimSpace(strings.TrimPrefix(line, "unused size after prune:")), "(")
+			stats.UnusedRemainingBytes, err = parseByteSize(strings.TrimSpace(size))
+		case strings.HasPrefix(line, "removing ") && strings.HasSuffix(line, " old packs"):
+			_, err = fmt.Sscanf(line, "removing %d old packs", &stats.PacksDeleted)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if !found {
+		return nil, errors.New("prune output did not include a summary")
+	}
+	return &stats, nil
+}
+
+// parseBlobsLine parses a line of a prune's summary e.g. "to delete: 9 blobs / 294.577 KiB".
+func parseBlobsLine(line string) (blobs int64, bytes int64, err error) {
+	_, rest, _ := strings.Cut(line, ":")
+	count, size, ok := strings.Cut(rest, "/")
+	if !ok {
+		return 0, 0, fmt.Errorf("unexpected prune summary %q", line)
+	}
+	if _, err := fmt.Sscanf(strings.TrimSpace(count), "%d blobs", &blobs); err != nil {
+		return 0, 0, fmt.Errorf("parse blob count %q: %w", count, err)
+	}
+	bytes, err = parseByteSize(strings.TrimSpace(size))
+	return blobs, bytes, err
+}
+
+// parseByteSize parses sizes formatted by restic e.g. "1.112 KiB".
+func parseByteSize(s string) (int64, error) {
+	var value float64
+	var unit string
+	if _, err := fmt.Sscanf(s, "%f %s", &value, &unit); err != nil {
+		return 0, fmt.Errorf("parse size %q: %w", s, err)
+	}
+	multipliers := map[string]float64{
+		"B":   1,
+		"KiB": 1 << 10,
+		"MiB": 1 << 20,
+		"GiB": 1 << 30,
+		"TiB": 1 << 40,
+	}
+	m, ok := multipliers[unit]
+	if !ok {
+		return 0, fmt.Errorf("parse size %q: unknown unit %q", s, unit)
+	}
+	return int64(value * m), nil
+}
@@ -46,3 +46,58 @@ func TestReadLs(t *testing.T) {
 		t.Errorf("wanted 3 entries, got: %d", len(entries))
 	}
 }
+
+func TestReadPruneEstimate(t *testing.T) {
+	output := `collecting packs for deletion and repacking
+
+Would have made the following changes:
+to repack:             0 blobs / 0 B
+this removes:          0 blobs / 0 B
+to delete:             6 blobs / 1.112 KiB
+total prune:           6 blobs / 1.500 KiB
+remaining:             0 blobs / 0 B
+`
+	estimate, err := readPruneEstimate(bytes.NewBufferString(output))
+	if err != nil {
+		t.Fatalf("failed to read prune estimate: %v", err)
+	}
+	if estimate.Blobs != 6 || estimate.Bytes != 1536 {
+		t.Errorf("wanted 6 blobs / 1536 bytes, got: %+v", estimate)
+	}
+
+	if _, err := readPruneEstimate(bytes.NewBufferString("Fatal: unable to open repo")); err == nil {
+		t.Errorf("wanted error for output without an estimate")
+	}
+}
+
+func TestReadPruneStats(t *testing.T) {
+	output := `loading indexes...
+collecting packs for deletion and repacking
+[0:00] 100.00%  8 / 8 packs processed
+
+to repack:             2 blobs / 421 B
+this removes:          1 blobs / 249 B
+to delete:             9 blobs / 294.577 KiB
+total prune:          10 blobs / 2.000 KiB
+remaining:             2 blobs / 303 B
+unused size after prune: 1.000 KiB (0.00% of remaining size)
+
+repacking packs
+removing 7 old packs
+[0:00] 100.00%  7 / 7 files deleted
+
+done
+`
+	stats, err := ReadPruneStats(bytes.NewBufferString(output))
+	if err != nil {
+		t.Fatalf("failed to read prune stats: %v", err)
+	}
+	want := PruneStats{RepackBytes: 421, TotalBlobs: 10, TotalBytes: 2048, RemainingBytes: 303, UnusedRemainingBytes: 1024, PacksDeleted: 7}
+	if *stats != want {
+		t.Errorf("wanted %+v, got: %+v", want, *stats)
+	}
+
+	if _, err := ReadPruneStats(bytes.NewBufferString("Fatal: unable to open repo")); err == nil {
+		t.Errorf("wanted error for output without a summary")
+	}
+}
@@ -19,6 +19,8 @@ import (
 var errAlreadyInitialized = errors.New("repo already initialized")
 var ErrPartialBackup = errors.New("incomplete backup")
 var ErrBackupFailed = errors.New("backup failed")
+var ErrMigrationNotApplicable = errors.New("migration cannot be applied")
+var ErrRepoNotFound = errors.New("no repo at the uri")
 
 type Repo struct {
 	cmd         string
@@ -61,6 +63,26 @@ func (r *Repo) buildEnv() []string {
 	return env
 }
 
+// commandContext builds a restic command, run through the prefix command if one is given e.g. to lower its priority.
+func (r *Repo) commandContext(ctx context.Context, prefix []string, args ...string) *exec.Cmd {
+	if len(prefix) == 0 {
+		return exec.CommandContext(ctx, r.cmd, args...)
+	}
+	return exec.CommandContext(ctx, prefix[0], append(append(slices.Clone(prefix[1:]), r.cmd), args...)...)
+}
+
+// storageArgs are the flags controlling how data is written to the repo, passed to the commands that write packs.
+func (r *Repo) storageArgs() []string {
+	var args []string
+	if r.repo.GetPackSizeMb() != 0 {
+		args = append(args, "--pack-size", fmt.Sprintf("%d", r.repo.GetPackSizeMb()))
+	}
+	if r.repo.GetCompression() != "" {
+		args = append(args, "--compression", r.repo.GetCompression())
+	}
+	return args
+}
+
 // init initializes the repo, the command will be cancelled with the context.
 func (r *Repo) init(ctx context.Context, opts ...GenericOption) error {
 	if r.initialized {
@@ -71,9 +93,11 @@ func (r *Repo) init(ctx context.Context, opts ...GenericOption) error {
 
 	var args = []string{"init", "--json"}
 	args = append(args, r.extraArgs...)
+	args = append(args, r.storageArgs()...)
 	args = append(args, opt.extraArgs...)
 
-	cmd := exec.CommandContext(ctx, r.cmd, args...)
+	cmd := r.commandContext(ctx, opt.cmdPrefix, args...)
+	defer recordUsage(ctx, cmd)
 	cmd.Env = append(cmd.Env, r.buildEnv()...)
 	cmd.Env = append(cmd.Env, opt.extraEnv...)
 
@@ -88,7 +112,14 @@ func (r *Repo) init(ctx context.Context, opts ...GenericOption) error {
 	return nil
 }
 
+// Init initializes the repo if it does not already exist. The restic version is checked first if the repo config
+// uses flags that older versions do not support.
 func (r *Repo) Init(ctx context.Context, opts ...GenericOption) error {
+	if len(r.storageArgs()) > 0 {
+		if err := r.CheckVersion(ctx); err != nil {
+			return err
+		}
+	}
 	if err := r.init(ctx, opts...); err != nil && !errors.Is(err, errAlreadyInitialized) {
 		return fmt.Errorf("init failed: %w", err)
 	}
@@ -109,6 +140,7 @@ func (r *Repo) Backup(ctx context.Context, progressCallback func(*BackupProgress
 
 	args := []string{"backup", "--json", "--exclude-caches"}
 	args = append(args, r.extraArgs...)
+	args = append(args, r.storageArgs()...)
 	args = append(args, opt.paths...)
 	args = append(args, opt.extraArgs...)
 
@@ -116,8 +148,10 @@ func (r *Repo) Backup(ctx context.Context, progressCallback func(*BackupProgress
 	reader, writer := io.Pipe()
 	capture := io.MultiWriter(output, writer)
 
-	cmd := exec.CommandContext(ctx, r.cmd, args...)
+	cmd := r.commandContext(ctx, opt.cmdPrefix, args...)
+	defer recordUsage(ctx, cmd)
 	cmd.Env = append(cmd.Env, r.buildEnv()...)
+	cmd.Env = append(cmd.Env, opt.extraEnv...)
 	cmd.StdoutPipe()
 	cmd.Stderr = capture
 	cmd.Stdout = capture
@@ -175,7 +209,8 @@ func (r *Repo) Snapshots(ctx context.Context, opts ...GenericOption) ([]*Snapsho
 	args = append(args, r.extraArgs...)
 	args = append(args, opt.extraArgs...)
 
-	cmd := exec.CommandContext(ctx, r.cmd, args...)
+	cmd := r.commandContext(ctx, opt.cmdPrefix, args...)
+	defer recordUsage(ctx, cmd)
 	cmd.Env = append(cmd.Env, r.buildEnv()...)
 	cmd.Env = append(cmd.Env, opt.extraEnv...)
 
@@ -205,7 +240,8 @@ func (r *Repo) Forget(ctx context.Context, policy *RetentionPolicy, opts ...Gene
 	args = append(args, opt.extraArgs...)
 	args = append(args, policy.toForgetFlags()...)
 
-	cmd := exec.CommandContext(ctx, r.cmd, args...)
+	cmd := r.commandContext(ctx, opt.cmdPrefix, args...)
+	defer recordUsage(ctx, cmd)
 	cmd.Env = append(cmd.Env, r.buildEnv()...)
 	cmd.Env = append(cmd.Env, opt.extraEnv...)
 
@@ -214,18 +250,21 @@ func (r *Repo) Forget(ctx context.Context, policy *RetentionPolicy, opts ...Gene
 		return nil, newCmdError(cmd, string(output), err)
 	}
 
-	var result []ForgetResult
-	if err := json.Unmarshal(output, &result); err != nil {
+	// restic reports a result per snapshot group, e.g. snapshots with different tags, these are merged.
+	var groups []ForgetResult
+	if err := json.Unmarshal(output, &groups); err != nil {
 		return nil, newCmdError(cmd, string(output), fmt.Errorf("command output is not valid JSON: %w", err))
 	}
-	if len(result) != 1 {
-		return nil, fmt.Errorf("expected 1 output from forget, got %v", len(result))
+	result := &ForgetResult{}
+	for _, group := range groups {
+		result.Keep = append(result.Keep, group.Keep...)
+		result.Remove = append(result.Remove, group.Remove...)
 	}
-	if err := result[0].Validate(); err != nil {
+	if err := result.Validate(); err != nil {
 		return nil, newCmdError(cmd, string(output), fmt.Errorf("invalid forget result: %w", err))
 	}
 
-	return &result[0], nil
+	return result, nil
 }
 
 func (r *Repo) ForgetSnapshot(ctx context.Context, snapshotId string, opts ...GenericOption) error {
@@ -236,7 +275,8 @@ func (r *Repo) ForgetSnapshot(ctx context.Context, snapshotId string, opts ...Ge
 	args = append(args, opt.extraArgs...)
 	args = append(args, snapshotId)
 
-	cmd := exec.CommandContext(ctx, r.cmd, args...)
+	cmd := r.commandContext(ctx, opt.cmdPrefix, args...)
+	defer recordUsage(ctx, cmd)
 	cmd.Env = append(cmd.Env, r.buildEnv()...)
 	cmd.Env = append(cmd.Env, opt.extraEnv...)
 
@@ -253,9 +293,11 @@ func (r *Repo) Prune(ctx context.Context, pruneOutput io.Writer, opts ...Generic
 
 	args := []string{"prune"}
 	args = append(args, r.extraArgs...)
+	args = append(args, r.storageArgs()...)
 	args = append(args, opt.extraArgs...)
 
-	cmd := exec.CommandContext(ctx, r.cmd, args...)
+	cmd := r.commandContext(ctx, opt.cmdPrefix, args...)
+	defer recordUsage(ctx, cmd)
 	cmd.Env = append(cmd.Env, r.buildEnv()...)
 	cmd.Env = append(cmd.Env, opt.extraEnv...)
 
@@ -287,7 +329,8 @@ func (r *Repo) Restore(ctx context.Context, snapshot string, callback func(*Rest
 	reader, writer := io.Pipe()
 	capture := io.MultiWriter(output, writer)
 
-	cmd := exec.CommandContext(ctx, r.cmd, args...)
+	cmd := r.commandContext(ctx, opt.cmdPrefix, args...)
+	defer recordUsage(ctx, cmd)
 	cmd.Env = append(cmd.Env, r.buildEnv()...)
 	cmd.Env = append(cmd.Env, opt.extraEnv...)
 	cmd.Stderr = capture
@@ -342,7 +385,8 @@ func (r *Repo) ListDirectory(ctx context.Context, snapshot string, path string,
 	args = append(args, r.extraArgs...)
 	args = append(args, opt.extraArgs...)
 
-	cmd := exec.CommandContext(ctx, r.cmd, args...)
+	cmd := r.commandContext(ctx, opt.cmdPrefix, args...)
+	defer recordUsage(ctx, cmd)
 	cmd.Env = append(cmd.Env, r.buildEnv()...)
 	cmd.Env = append(cmd.Env, opt.extraEnv...)
 
@@ -359,6 +403,35 @@ func (r *Repo) ListDirectory(ctx context.Context, snapshot string, path string,
 	return snapshots, entries, nil
 }
 
+// Tag adds and removes tags of a snapshot. restic rewrites a snapshot whose tags change, the rewritten snapshot has
+// a new ID and records the ID it was rewritten from as its original.
+func (r *Repo) Tag(ctx context.Context, snapshotId string, add []string, remove []string, opts ...GenericOption) error {
+	opt := resolveOpts(opts)
+
+	args := []string{"tag"}
+	args = append(args, r.extraArgs...)
+	args = append(args, opt.extraArgs...)
+	for _, tag := range add {
+		args = append(args, "--add", tag)
+	}
+	for _, tag := range remove {
+		args = append(args, "--remove", tag)
+	}
+	args = append(args, snapshotId)
+
+	cmd := r.commandContext(ctx, opt.cmdPrefix, args...)
+	defer recordUsage(ctx, cmd)
+	cmd.Env = append(cmd.Env, r.buildEnv()...)
+	cmd.Env = append(cmd.Env, opt.extraEnv...)
+
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return newCmdError(cmd, string(output), err)
+	}
+
+	return nil
+}
+
 func (r *Repo) Unlock(ctx context.Context, opts ...GenericOption) error {
 	opt := resolveOpts(opts)
 
@@ -366,7 +439,8 @@ func (r *Repo) Unlock(ctx context.Context, opts ...GenericOption) error {
 	args = append(args, r.extraArgs...)
 	args = append(args, opt.extraArgs...)
 
-	cmd := exec.CommandContext(ctx, r.cmd, args...)
+	cmd := r.commandContext(ctx, opt.cmdPrefix, args...)
+	defer recordUsage(ctx, cmd)
 	cmd.Env = append(cmd.Env, r.buildEnv()...)
 	cmd.Env = append(cmd.Env, opt.extraEnv...)
 
@@ -378,14 +452,26 @@ func (r *Repo) Unlock(ctx context.Context, opts ...GenericOption) error {
 	return nil
 }
 
+const (
+	StatsModeRawData     = "raw-data"
+	StatsModeRestoreSize = "restore-size"
+)
+
+// Stats returns the raw-data stats of the repo.
 func (r *Repo) Stats(ctx context.Context, opts ...GenericOption) (*RepoStats, error) {
+	return r.StatsForMode(ctx, StatsModeRawData, opts...)
+}
+
+// StatsForMode returns the stats of the repo computed in the given restic stats mode.
+func (r *Repo) StatsForMode(ctx context.Context, mode string, opts ...GenericOption) (*RepoStats, error) {
 	opt := resolveOpts(opts)
 
-	args := []string{"stats", "--json", "--mode=raw-data"}
+	args := []string{"stats", "--json", "--mode=" + mode}
 	args = append(args, r.extraArgs...)
 	args = append(args, opt.extraArgs...)
 
-	cmd := exec.CommandContext(ctx, r.cmd, args...)
+	cmd := r.commandContext(ctx, opt.cmdPrefix, args...)
+	defer recordUsage(ctx, cmd)
 	cmd.Env = append(cmd.Env, r.buildEnv()...)
 	cmd.Env = append(cmd.Env, opt.extraEnv...)
 
@@ -402,6 +488,96 @@ func (r *Repo) Stats(ctx context.Context, opts ...GenericOption) (*RepoStats, er
 	return &stats, nil
 }
 
+// Check runs restic check on the repo and returns its output, the error is non-nil if the check found problems.
+func (r *Repo) Check(ctx context.Context, opts ...GenericOption) (string, error) {
+	opt := resolveOpts(opts)
+
+	args := []string{"check"}
+	args = append(args, r.extraArgs...)
+	args = append(args, opt.extraArgs...)
+
+	cmd := r.commandContext(ctx, opt.cmdPrefix, args...)
+	defer recordUsage(ctx, cmd)
+	cmd.Env = append(cmd.Env, r.buildEnv()...)
+	cmd.Env = append(cmd.Env, opt.extraEnv...)
+
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return string(output), newCmdError(cmd, string(output), err)
+	}
+	return string(output), nil
+}
+
+// Locks returns the locks currently held in the repo. Listing locks does not itself lock the repo.
+func (r *Repo) Locks(ctx context.Context, opts ...GenericOption) ([]*Lock, error) {
+	opt := resolveOpts(opts)
+
+	args := []string{"list", "locks", "--no-lock"}
+	args = append(args, r.extraArgs...)
+	args = append(args, opt.extraArgs...)
+
+	cmd := r.commandContext(ctx, opt.cmdPrefix, args...)
+	defer recordUsage(ctx, cmd)
+	cmd.Env = append(cmd.Env, r.buildEnv()...)
+	cmd.Env = append(cmd.Env, opt.extraEnv...)
+
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, newCmdError(cmd, string(output), err)
+	}
+
+	var locks []*Lock
+	for _, id := range strings.Fields(string(output)) {
+		args := []string{"cat", "lock", id, "--no-lock"}
+		args = append(args, r.extraArgs...)
+		args = append(args, opt.extraArgs...)
+
+		cmd := r.commandContext(ctx, opt.cmdPrefix, args...)
+		defer recordUsage(ctx, cmd)
+		cmd.Env = append(cmd.Env, r.buildEnv()...)
+		cmd.Env = append(cmd.Env, opt.extraEnv...)
+
+		output, err := cmd.Output()
+		if err != nil {
+			if ctx.Err() == nil {
+				continue // the lock was released since it was listed.
+			}
+			return nil, newCmdError(cmd, string(output), err)
+		}
+		lock := &Lock{Id: id}
+		if err := json.Unmarshal(output, lock); err != nil {
+			return nil, newCmdError(cmd, string(output), fmt.Errorf("command output is not valid JSON: %w", err))
+		}
+		locks = append(locks, lock)
+	}
+	return locks, nil
+}
+
+// PruneEstimate runs a prune dry run and returns how much data a prune would remove.
+func (r *Repo) PruneEstimate(ctx context.Context, opts ...GenericOption) (*PruneEstimate, error) {
+	opt := resolveOpts(opts)
+
+	args := []string{"prune", "--dry-run"}
+	args = append(args, r.extraArgs...)
+	args = append(args, opt.extraArgs...)
+
+	cmd := r.commandContext(ctx, opt.cmdPrefix, args...)
+	defer recordUsage(ctx, cmd)
+	cmd.Env = append(cmd.Env, r.buildEnv()...)
+	cmd.Env = append(cmd.Env, opt.extraEnv...)
+
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return nil, newCmdError(cmd, string(output), err)
+	}
+
+	estimate, err := readPruneEstimate(bytes.NewReader(output))
+	if err != nil {
+		return nil, newCmdError(cmd, string(output), err)
+	}
+	return estimate, nil
+}
+
 type RetentionPolicy struct {
 	KeepLastN          int    // keep the last n snapshots.
 	KeepHourly         int    // keep the last n hourly snapshots.
@@ -438,9 +614,217 @@ func (r *RetentionPolicy) toForgetFlags() []string {
 	return flags
 }
 
+// Migration is a restic migration that can be applied to the repo.
+type Migration struct {
+	Name        string
+	Description string
+}
+
+// Migrations lists the migrations restic reports as applicable to the repo.
+func (r *Repo) Migrations(ctx context.Context, opts ...GenericOption) ([]Migration, error) {
+	opt := resolveOpts(opts)
+
+	args := []string{"migrate"}
+	args = append(args, r.extraArgs...)
+	args = append(args, opt.extraArgs...)
+
+	cmd := r.commandContext(ctx, opt.cmdPrefix, args...)
+	defer recordUsage(ctx, cmd)
+	cmd.Env = append(cmd.Env, r.buildEnv()...)
+	cmd.Env = append(cmd.Env, opt.extraEnv...)
+
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return nil, newCmdError(cmd, string(output), err)
+	}
+	return parseMigrations(string(output)), nil
+}
+
+// parseMigrations parses the listing printed by restic migrate when no migration is given, e.g.
+//
+//	available migrations:
+//	  upgrade_repo_v2	upgrade a repository to version 2
+func parseMigrations(output string) []Migration {
+	var migrations []Migration
+	listing := false
+	for _, line := range strings.Split(output, "\n") {
+		if strings.HasPrefix(line, "available migrations:") {
+			listing = true
+			continue
+		}
+		if !listing || !strings.HasPrefix(line, "  ") {
+			continue
+		}
+		name, description, _ := strings.Cut(strings.TrimSpace(line), "\t")
+		migrations = append(migrations, Migration{Name: name, Description: strings.TrimSpace(description)})
+	}
+	return migrations
+}
+
+// Migrate applies the named migration, writing restic's output to migrateOutput if it is not nil.
+func (r *Repo) Migrate(ctx context.Context, migration string, migrateOutput io.Writer, opts ...GenericOption) error {
+	opt := resolveOpts(opts)
+
+	args := []string{"migrate"}
+	args = append(args, r.extraArgs...)
+	args = append(args, opt.extraArgs...)
+	args = append(args, migration)
+
+	cmd := r.commandContext(ctx, opt.cmdPrefix, args...)
+	defer recordUsage(ctx, cmd)
+	cmd.Env = append(cmd.Env, r.buildEnv()...)
+	cmd.Env = append(cmd.Env, opt.extraEnv...)
+
+	var output = newOutputCapturer(outputBufferLimit)
+	var writer io.Writer = output
+	if migrateOutput != nil {
+		writer = io.MultiWriter(migrateOutput, output)
+	}
+	cmd.Stdout = writer
+	cmd.Stderr = writer
+
+	if err := cmd.Run(); err != nil {
+		return newCmdErrorPreformatted(cmd, output.String(), err)
+	}
+	// restic exits successfully when a migration does not apply.
+	if strings.Contains(output.String(), "cannot be applied") {
+		return newCmdErrorPreformatted(cmd, output.String(), ErrMigrationNotApplicable)
+	}
+	return nil
+}
+
+// Dump writes the contents of the file at path in the snapshot to w.
+func (r *Repo) Dump(ctx context.Context, snapshotId string, path string, w io.Writer, opts ...GenericOption) error {
+	opt := resolveOpts(opts)
+
+	args := []string{"dump"}
+	args = append(args, r.extraArgs...)
+	args = append(args, opt.extraArgs...)
+	args = append(args, snapshotId, path)
+
+	cmd := r.commandContext(ctx, opt.cmdPrefix, args...)
+	defer recordUsage(ctx, cmd)
+	cmd.Env = append(cmd.Env, r.buildEnv()...)
+	cmd.Env = append(cmd.Env, opt.extraEnv...)
+
+	var stderr = newOutputCapturer(outputBufferLimit)
+	cmd.Stdout = w
+	cmd.Stderr = stderr
+
+	if err := cmd.Run(); err != nil {
+		return newCmdErrorPreformatted(cmd, stderr.String(), err)
+	}
+	return nil
+}
+
+// Find returns the entries matching the pattern in each snapshot with a match, patterns starting with a / match
+// the full path of entries.
+func (r *Repo) Find(ctx context.Context, pattern string, opts ...GenericOption) ([]*FindResult, error) {
+	opt := resolveOpts(opts)
+
+	args := []string{"find", "--json"}
+	args = append(args, r.extraArgs...)
+	args = append(args, opt.extraArgs...)
+	args = append(args, "--", pattern)
+
+	cmd := r.commandContext(ctx, opt.cmdPrefix, args...)
+	defer recordUsage(ctx, cmd)
+	cmd.Env = append(cmd.Env, r.buildEnv()...)
+	cmd.Env = append(cmd.Env, opt.extraEnv...)
+
+	var stderr = newOutputCapturer(outputBufferLimit)
+	cmd.Stderr = stderr
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, newCmdErrorPreformatted(cmd, stderr.String(), err)
+	}
+
+	var results []*FindResult
+	if err := json.Unmarshal(output, &results); err != nil {
+		return nil, newCmdError(cmd, string(output), fmt.Errorf("command output is not valid JSON: %w", err))
+	}
+	return results, nil
+}
+
+// RepoConfig is the config restic stores in a repo when it is initialized.
+type RepoConfig struct {
+	Version int    `json:"version"`
+	Id      string `json:"id"`
+}
+
+// Config returns the repo's config, ErrRepoNotFound if there is no repo at its URI.
+func (r *Repo) Config(ctx context.Context, opts ...GenericOption) (*RepoConfig, error) {
+	opt := resolveOpts(opts)
+
+	args := []string{"cat", "config"}
+	args = append(args, r.extraArgs...)
+	args = append(args, opt.extraArgs...)
+
+	cmd := r.commandContext(ctx, opt.cmdPrefix, args...)
+	defer recordUsage(ctx, cmd)
+	cmd.Env = append(cmd.Env, r.buildEnv()...)
+	cmd.Env = append(cmd.Env, opt.extraEnv...)
+
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if strings.Contains(string(output), "Is there a repository at the following location?") ||
+			(errors.As(err, &exitErr) && exitErr.ExitCode() == exitCodeRepoNotFound) {
+			err = ErrRepoNotFound
+		}
+		return nil, newCmdError(cmd, string(output), err)
+	}
+
+	config := &RepoConfig{}
+	if err := json.Unmarshal(output, config); err != nil {
+		return nil, newCmdError(cmd, string(output), fmt.Errorf("command output is not valid JSON: %w", err))
+	}
+	if config.Id == "" {
+		return nil, newCmdError(cmd, string(output), errors.New("repo config has no id"))
+	}
+	return config, nil
+}
+
+// RepoId returns the ID restic assigned the repo when it was initialized, the name of the repo's cache directory.
+func (r *Repo) RepoId(ctx context.Context, opts ...GenericOption) (string, error) {
+	config, err := r.Config(ctx, opts...)
+	if err != nil {
+		return "", err
+	}
+	return config.Id, nil
+}
+
+// CacheCleanup removes the cache directories of repos that haven't been used for maxAgeDays, writing restic's output
+// to cleanupOutput if it is not nil.
+func (r *Repo) CacheCleanup(ctx context.Context, maxAgeDays int, cleanupOutput io.Writer, opts ...GenericOption) error {
+	opt := resolveOpts(opts)
+
+	args := []string{"cache", "--cleanup", "--no-size", fmt.Sprintf("--max-age=%d", maxAgeDays)}
+	args = append(args, r.extraArgs...)
+	args = append(args, opt.extraArgs...)
+
+	cmd := r.commandContext(ctx, opt.cmdPrefix, args...)
+	defer recordUsage(ctx, cmd)
+	cmd.Env = append(cmd.Env, r.buildEnv()...)
+	cmd.Env = append(cmd.Env, opt.extraEnv...)
+
+	var output = newOutputCapturer(outputBufferLimit)
+	var writer io.Writer = output
+	if cleanupOutput != nil {
+		writer = io.MultiWriter(cleanupOutput, output)
+	}
+	cmd.Stdout = writer
+	cmd.Stderr = writer
+
+	if err := cmd.Run(); err != nil {
+		return newCmdErrorPreformatted(cmd, output.String(), err)
+	}
+	return nil
+}
+
 type BackupOpts struct {
-	paths     []string
-	extraArgs []string
+	GenericOpts
+	paths []string
 }
 
 type BackupOption func(opts *BackupOpts)
@@ -475,15 +859,38 @@ func WithBackupTags(tags ...string) BackupOption {
 	}
 }
 
+func WithBackupHost(host string) BackupOption {
+	return func(opts *BackupOpts) {
+		opts.extraArgs = append(opts.extraArgs, "--host", host)
+	}
+}
+
 func WithBackupParent(parent string) BackupOption {
 	return func(opts *BackupOpts) {
 		opts.extraArgs = append(opts.extraArgs, "--parent", parent)
 	}
 }
 
+// WithBackupVerbose reports the data added by each file and directory as "verbose_status" progress entries.
+func WithBackupVerbose() BackupOption {
+	return func(opts *BackupOpts) {
+		opts.extraArgs = append(opts.extraArgs, "--verbose")
+	}
+}
+
+// WithBackupOptions applies generic options e.g. environment variables or a command prefix to the backup.
+func WithBackupOptions(opts ...GenericOption) BackupOption {
+	return func(o *BackupOpts) {
+		for _, opt := range opts {
+			opt(&o.GenericOpts)
+		}
+	}
+}
+
 type GenericOpts struct {
 	extraArgs []string
 	extraEnv  []string
+	cmdPrefix []string
 }
 
 func resolveOpts(opts []GenericOption) *GenericOpts {
@@ -510,6 +917,13 @@ func WithTags(tags ...string) GenericOption {
 	}
 }
 
+// WithCommandPrefix runs restic as an argument of the given command e.g. nice -n 10.
+func WithCommandPrefix(prefix ...string) GenericOption {
+	return func(opts *GenericOpts) {
+		opts.cmdPrefix = append(opts.cmdPrefix, prefix...)
+	}
+}
+
 func WithEnv(env ...string) GenericOption {
 	return func(opts *GenericOpts) {
 		opts.extraEnv = append(opts.extraEnv, env...)
@@ -5,9 +5,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"os"
+	"path/filepath"
 	"reflect"
 	"slices"
+	"strings"
 	"testing"
+	"time"
 
 	v1 "github.com/garethgeorge/backrest/gen/go/v1"
 	"github.com/garethgeorge/backrest/test/helpers"
@@ -73,6 +78,11 @@ func TestResticBackup(t *testing.T) {
 			opts:  []BackupOption{WithBackupPaths(testData), WithBackupExcludes("file*")},
 			files: 0,
 		},
+		{
+			name:  "with command prefix",
+			opts:  []BackupOption{WithBackupPaths(testData), WithBackupOptions(WithCommandPrefix("nice", "-n", "5"), WithEnv("GOMAXPROCS=1"))},
+			files: 100,
+		},
 		{
 			name:    "with nothing to backup",
 			opts:    []BackupOption{},
@@ -373,6 +383,44 @@ func TestForgetSnapshotId(t *testing.T) {
 	}
 }
 
+func TestResticTag(t *testing.T) {
+	t.Parallel()
+
+	repo := t.TempDir()
+	r := NewRepo(helpers.ResticBinary(t), &v1.Repo{
+		Id:       "test",
+		Uri:      repo,
+		Password: "test",
+	}, WithFlags("--no-cache"))
+	if err := r.Init(context.Background()); err != nil {
+		t.Fatalf("failed to init repo: %v", err)
+	}
+
+	testData := helpers.CreateTestData(t)
+	output, err := r.Backup(context.Background(), nil, WithBackupPaths(testData), WithBackupTags("tag1"))
+	if err != nil {
+		t.Fatalf("failed to backup and create new snapshot: %v", err)
+	}
+
+	if err := r.Tag(context.Background(), output.SnapshotId, []string{"tag2"}, []string{"tag1"}); err != nil {
+		t.Fatalf("failed to tag snapshot: %v", err)
+	}
+
+	snapshots, err := r.Snapshots(context.Background())
+	if err != nil {
+		t.Fatalf("failed to list snapshots: %v", err)
+	}
+	if len(snapshots) != 1 {
+		t.Fatalf("wanted 1 snapshot, got: %d", len(snapshots))
+	}
+	if !slices.Equal(snapshots[0].Tags, []string{"tag2"}) {
+		t.Errorf("wanted tags [tag2], got: %v", snapshots[0].Tags)
+	}
+	if snapshots[0].Original != output.SnapshotId {
+		t.Errorf("wanted the rewritten snapshot's original to be %v, got: %v", output.SnapshotId, snapshots[0].Original)
+	}
+}
+
 func TestResticPrune(t *testing.T) {
 	t.Parallel()
 
@@ -487,4 +535,230 @@ func TestResticStats(t *testing.T) {
 	if stats.TotalBlobCount == 0 {
 		t.Errorf("wanted non-zero total blob count, got: %d", stats.TotalBlobCount)
 	}
+
+	restoreSize, err := r.StatsForMode(context.Background(), StatsModeRestoreSize)
+	if err != nil {
+		t.Fatalf("failed to get restore-size stats: %v", err)
+	}
+	if restoreSize.TotalFileCount < 100 {
+		t.Errorf("wanted at least 100 files and dirs, got: %d", restoreSize.TotalFileCount)
+	}
+}
+
+func TestResticHealth(t *testing.T) {
+	t.Parallel()
+
+	repo := t.TempDir()
+	r := NewRepo(helpers.ResticBinary(t), &v1.Repo{
+		Id:       "test",
+		Uri:      repo,
+		Password: "test",
+	}, WithFlags("--no-cache"))
+	if err := r.Init(context.Background()); err != nil {
+		t.Fatalf("failed to init repo: %v", err)
+	}
+
+	testData := helpers.CreateTestData(t)
+	summary, err := r.Backup(context.Background(), nil, WithBackupPaths(testData))
+	if err != nil {
+		t.Fatalf("failed to backup and create new snapshot: %v", err)
+	}
+
+	if _, err := r.Check(context.Background()); err != nil {
+		t.Errorf("check failed: %v", err)
+	}
+
+	locks, err := r.Locks(context.Background())
+	if err != nil {
+		t.Fatalf("failed to list locks: %v", err)
+	}
+	if len(locks) != 0 {
+		t.Errorf("wanted no locks, got: %v", locks)
+	}
+
+	if err := r.ForgetSnapshot(context.Background(), summary.SnapshotId); err != nil {
+		t.Fatalf("failed to forget snapshot: %v", err)
+	}
+	estimate, err := r.PruneEstimate(context.Background())
+	if err != nil {
+		t.Fatalf("failed to estimate prune: %v", err)
+	}
+	if estimate.Blobs == 0 || estimate.Bytes == 0 {
+		t.Errorf("wanted forgotten data in estimate, got: %+v", estimate)
+	}
+}
+
+func TestResticMigrate(t *testing.T) {
+	t.Parallel()
+
+	r := NewRepo(helpers.ResticBinary(t), &v1.Repo{
+		Id:       "test",
+		Uri:      t.TempDir(),
+		Password: "test",
+	}, WithFlags("--no-cache"))
+	if err := r.Init(context.Background(), WithFlags("--repository-version", "1")); err != nil {
+		t.Fatalf("failed to init repo: %v", err)
+	}
+
+	migrations, err := r.Migrations(context.Background())
+	if err != nil {
+		t.Fatalf("failed to list migrations: %v", err)
+	}
+	if !slices.ContainsFunc(migrations, func(m Migration) bool { return m.Name == "upgrade_repo_v2" }) {
+		t.Fatalf("want upgrade_repo_v2 available for a v1 repo, got %v", migrations)
+	}
+
+	output := bytes.NewBuffer(nil)
+	if err := r.Migrate(context.Background(), "upgrade_repo_v2", output); err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+	if !bytes.Contains(output.Bytes(), []byte("success")) {
+		t.Errorf("want migration success in output, got %q", output.String())
+	}
+
+	// the repo is already upgraded.
+	if err := r.Migrate(context.Background(), "upgrade_repo_v2", nil); !errors.Is(err, ErrMigrationNotApplicable) {
+		t.Errorf("want ErrMigrationNotApplicable migrating twice, got %v", err)
+	}
+	if migrations, err := r.Migrations(context.Background()); err != nil || len(migrations) != 0 {
+		t.Errorf("want no migrations after upgrade, got %v (error: %v)", migrations, err)
+	}
+}
+
+func TestResticDump(t *testing.T) {
+	t.Parallel()
+
+	r := NewRepo(helpers.ResticBinary(t), &v1.Repo{
+		Id:       "test",
+		Uri:      t.TempDir(),
+		Password: "test",
+	}, WithFlags("--no-cache"))
+	if err := r.Init(context.Background()); err != nil {
+		t.Fatalf("failed to init repo: %v", err)
+	}
+
+	testData := helpers.CreateTestData(t)
+	summary, err := r.Backup(context.Background(), nil, WithBackupPaths(testData))
+	if err != nil {
+		t.Fatalf("failed to backup: %v", err)
+	}
+
+	file := filepath.Join(testData, "file10")
+	want, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatalf("failed to read test file: %v", err)
+	}
+	var buf bytes.Buffer
+	if err := r.Dump(context.Background(), summary.SnapshotId, file, &buf); err != nil {
+		t.Fatalf("failed to dump %q: %v", file, err)
+	}
+	if !bytes.Equal(buf.Bytes(), want) {
+		t.Errorf("dumped %q, want %q", buf.String(), string(want))
+	}
+
+	if err := r.Dump(context.Background(), summary.SnapshotId, filepath.Join(testData, "missing"), io.Discard); err == nil {
+		t.Errorf("want an error dumping a path not in the snapshot")
+	}
+}
+
+func TestResticCache(t *testing.T) {
+	t.Parallel()
+
+	cacheDir := t.TempDir()
+	r := NewRepo(helpers.ResticBinary(t), &v1.Repo{
+		Id:       "test",
+		Uri:      t.TempDir(),
+		Password: "test",
+	}, WithFlags("--cache-dir", cacheDir))
+	if err := r.Init(context.Background()); err != nil {
+		t.Fatalf("failed to init repo: %v", err)
+	}
+
+	id, err := r.RepoId(context.Background())
+	if err != nil {
+		t.Fatalf("failed to get repo id: %v", err)
+	}
+	if _, err := r.Backup(context.Background(), nil, WithBackupPaths(helpers.CreateTestData(t))); err != nil {
+		t.Fatalf("failed to backup: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(cacheDir, id)); err != nil {
+		t.Fatalf("want the repo's cache named after its id %q: %v", id, err)
+	}
+
+	// a cache that was never used is old and removed by the cleanup.
+	stale := filepath.Join(cacheDir, strings.Repeat("0", 64))
+	if err := os.MkdirAll(filepath.Join(stale, "snapshots"), 0755); err != nil {
+		t.Fatalf("failed to create stale cache: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(stale, "version"), []byte("1"), 0644); err != nil {
+		t.Fatalf("failed to create stale cache: %v", err)
+	}
+	old := time.Now().Add(-60 * 24 * time.Hour)
+	if err := os.Chtimes(stale, old, old); err != nil {
+		t.Fatalf("failed to age stale cache: %v", err)
+	}
+
+	if err := r.CacheCleanup(context.Background(), 30, nil); err != nil {
+		t.Fatalf("failed to clean up cache: %v", err)
+	}
+	if _, err := os.Stat(stale); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("want stale cache removed, got: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(cacheDir, id)); err != nil {
+		t.Errorf("want the repo's cache kept: %v", err)
+	}
+}
+
+func TestResticResourceUsage(t *testing.T) {
+	t.Parallel()
+
+	r := NewRepo(helpers.ResticBinary(t), &v1.Repo{
+		Id:       "test",
+		Uri:      t.TempDir(),
+		Password: "test",
+	}, WithFlags("--no-cache"))
+
+	usage := &ResourceUsage{}
+	ctx := ContextWithResourceUsage(context.Background(), usage)
+	if err := r.Init(ctx); err != nil {
+		t.Fatalf("failed to init repo: %v", err)
+	}
+	if _, err := r.Snapshots(ctx); err != nil {
+		t.Fatalf("failed to list snapshots: %v", err)
+	}
+	if _, err := r.Snapshots(context.Background()); err != nil {
+		t.Fatalf("failed to list snapshots: %v", err)
+	}
+
+	got := usage.Proto()
+	if got.Processes != 2 {
+		t.Errorf("want 2 processes recorded, got %d", got.Processes)
+	}
+	if got.CpuUserMs+got.CpuSystemMs == 0 && got.MaxRssBytes == 0 {
+		t.Errorf("want CPU time or memory recorded, got %v", got)
+	}
+}
+
+func TestResticConfig(t *testing.T) {
+	t.Parallel()
+
+	r := NewRepo(helpers.ResticBinary(t), &v1.Repo{
+		Id:       "test",
+		Uri:      filepath.Join(t.TempDir(), "repo"),
+		Password: "test",
+	}, WithFlags("--no-cache"))
+	if _, err := r.Config(context.Background()); !errors.Is(err, ErrRepoNotFound) {
+		t.Fatalf("want ErrRepoNotFound before the repo is initialized, got %v", err)
+	}
+
+	if err := r.Init(context.Background(), WithFlags("--repository-version", "1")); err != nil {
+		t.Fatalf("failed to init repo: %v", err)
+	}
+	config, err := r.Config(context.Background())
+	if err != nil {
+		t.Fatalf("failed to get repo config: %v", err)
+	}
+	if config.Version != 1 || len(config.Id) != 64 {
+		t.Errorf("want a version 1 repo with an id, got %+v", config)
+	}
 }
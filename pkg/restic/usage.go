@@ -0,0 +1,65 @@
+package restic
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"sync"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+type usageKey struct{}
+
+// ResourceUsage accumulates the resources used by restic processes, it is safe for concurrent use.
+type ResourceUsage struct {
+	mu    sync.Mutex
+	usage v1.ResourceUsage
+}
+
+// ContextWithResourceUsage returns a context the restic commands run with add the resources they use to usage.
+func ContextWithResourceUsage(ctx context.Context, usage *ResourceUsage) context.Context {
+	return context.WithValue(ctx, usageKey{}, usage)
+}
+
+// UsageFromContext returns the usage the context's restic commands add to, nil if it has none.
+func UsageFromContext(ctx context.Context) *ResourceUsage {
+	usage, _ := ctx.Value(usageKey{}).(*ResourceUsage)
+	return usage
+}
+
+// Proto returns a copy of the accumulated usage.
+func (u *ResourceUsage) Proto() *v1.ResourceUsage {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	return proto.Clone(&u.usage).(*v1.ResourceUsage)
+}
+
+// AddUploadBytesEstimate records an estimate of the data uploaded, restic doesn't report network usage.
+func (u *ResourceUsage) AddUploadBytesEstimate(n int64) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.usage.UploadBytesEstimate += n
+}
+
+func (u *ResourceUsage) add(state *os.ProcessState) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.usage.Processes++
+	u.usage.CpuUserMs += state.UserTime().Milliseconds()
+	u.usage.CpuSystemMs += state.SystemTime().Milliseconds()
+	maxRss, readBytes, writeBytes := sysUsage(state)
+	u.usage.MaxRssBytes = max(u.usage.MaxRssBytes, maxRss)
+	u.usage.ReadBytes += readBytes
+	u.usage.WriteBytes += writeBytes
+}
+
+// recordUsage adds the resources used by the finished command to the context's usage, if it has one.
+func recordUsage(ctx context.Context, cmd *exec.Cmd) {
+	usage := UsageFromContext(ctx)
+	if usage == nil || cmd.ProcessState == nil {
+		return
+	}
+	usage.add(cmd.ProcessState)
+}
@@ -0,0 +1,11 @@
+//go:build !linux && !darwin
+// +build !linux,!darwin
+
+package restic
+
+import "os"
+
+// sysUsage is only supported on linux and darwin, elsewhere only CPU time is recorded.
+func sysUsage(state *os.ProcessState) (maxRss, readBytes, writeBytes int64) {
+	return 0, 0, 0
+}
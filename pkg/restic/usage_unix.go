@@ -0,0 +1,26 @@
+//go:build linux || darwin
+// +build linux darwin
+
+package restic
+
+import (
+	"os"
+	"runtime"
+	"syscall"
+)
+
+// blockSize is the unit of the block input and output counts of getrusage.
+const blockSize = 512
+
+// sysUsage returns the peak resident memory and the bytes read from and written to block devices by the process.
+func sysUsage(state *os.ProcessState) (maxRss, readBytes, writeBytes int64) {
+	rusage, ok := state.SysUsage().(*syscall.Rusage)
+	if !ok {
+		return 0, 0, 0
+	}
+	maxRss = int64(rusage.Maxrss)
+	if runtime.GOOS == "linux" {
+		maxRss *= 1024 // reported in kilobytes on linux, bytes on darwin.
+	}
+	return maxRss, int64(rusage.Inblock) * blockSize, int64(rusage.Oublock) * blockSize
+}
@@ -0,0 +1,101 @@
+package restic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var ErrUnsupportedVersion = errors.New("unsupported restic version")
+
+// minVersionStorageArgs is the first restic release supporting --pack-size and --compression.
+const minVersionStorageArgs = "0.14.0"
+
+// Version returns the version of the restic binary e.g. "0.16.4".
+func (r *Repo) Version(ctx context.Context) (string, error) {
+	cmd := r.commandContext(ctx, nil, "version")
+	output, err := cmd.Output()
+	if err != nil {
+		return "", newCmdError(cmd, string(output), err)
+	}
+	version, err := parseVersion(string(output))
+	if err != nil {
+		return "", fmt.Errorf("parse restic version: %w", err)
+	}
+	return version, nil
+}
+
+// CheckVersion returns ErrUnsupportedVersion if the restic binary is too old for the flags set in the repo config.
+func (r *Repo) CheckVersion(ctx context.Context) error {
+	if len(r.storageArgs()) == 0 {
+		return nil
+	}
+	version, err := r.Version(ctx)
+	if err != nil {
+		return err
+	}
+	return checkStorageArgsSupported(version)
+}
+
+func checkStorageArgsSupported(version string) error {
+	if compareVersions(version, minVersionStorageArgs) < 0 {
+		return fmt.Errorf("%w: restic %v does not support pack_size_mb or compression, requires %v or newer", ErrUnsupportedVersion, version, minVersionStorageArgs)
+	}
+	return nil
+}
+
+// parseVersion extracts the version from the output of restic version e.g.
+// "restic 0.16.4 compiled with go1.21.6 on linux/amd64".
+func parseVersion(output string) (string, error) {
+	fields := strings.Fields(output)
+	if len(fields) < 2 || fields[0] != "restic" {
+		return "", fmt.Errorf("unexpected output %q", output)
+	}
+	version := fields[1]
+	if _, err := versionParts(version); err != nil {
+		return "", err
+	}
+	return version, nil
+}
+
+// versionParts returns the numeric components of a version, ignoring any suffix such as "-dev".
+func versionParts(version string) ([]int, error) {
+	version, _, _ = strings.Cut(version, "-")
+	var parts []int
+	for _, p := range strings.Split(version, ".") {
+		n, err := strconv.Atoi(p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid version %q", version)
+		}
+		parts = append(parts, n)
+	}
+	return parts, nil
+}
+
+// compareVersions returns -1, 0 or 1 if a is older than, the same as, or newer than b. Unparseable versions are
+// treated as newer, development builds are not held back.
+func compareVersions(a, b string) int {
+	pa, errA := versionParts(a)
+	pb, errB := versionParts(b)
+	if errA != nil || errB != nil {
+		return 1
+	}
+	for i := 0; i < len(pa) || i < len(pb); i++ {
+		var x, y int
+		if i < len(pa) {
+			x = pa[i]
+		}
+		if i < len(pb) {
+			y = pb[i]
+		}
+		if x != y {
+			if x < y {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
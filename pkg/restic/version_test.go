@@ -0,0 +1,65 @@
+package restic
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	v1 "github.com/garethgeorge/backrest/gen/go/v1"
+	"github.com/garethgeorge/backrest/test/helpers"
+)
+
+func TestParseVersion(t *testing.T) {
+	got, err := parseVersion("restic 0.16.4 compiled with go1.21.6 on linux/amd64\n")
+	if err != nil {
+		t.Fatalf("parseVersion() error: %v", err)
+	}
+	if got != "0.16.4" {
+		t.Errorf("want version 0.16.4, got %q", got)
+	}
+
+	if _, err := parseVersion("not restic"); err == nil {
+		t.Errorf("want error parsing unexpected output")
+	}
+}
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"0.16.4", "0.14.0", 1},
+		{"0.13.1", "0.14.0", -1},
+		{"0.14.0", "0.14", 0},
+		{"0.14.0-dev", "0.14.0", 0},
+		{"1.0.0", "0.99.9", 1},
+	}
+	for _, tc := range tests {
+		if got := compareVersions(tc.a, tc.b); got != tc.want {
+			t.Errorf("compareVersions(%q, %q) = %d, want %d", tc.a, tc.b, got, tc.want)
+		}
+	}
+
+	if err := checkStorageArgsSupported("0.13.1"); !errors.Is(err, ErrUnsupportedVersion) {
+		t.Errorf("want ErrUnsupportedVersion for restic 0.13.1, got %v", err)
+	}
+}
+
+func TestResticInitWithStorageArgs(t *testing.T) {
+	t.Parallel()
+
+	r := NewRepo(helpers.ResticBinary(t), &v1.Repo{
+		Id:          "test",
+		Uri:         t.TempDir(),
+		Password:    "test",
+		PackSizeMb:  8,
+		Compression: "max",
+	}, WithFlags("--no-cache"))
+
+	if err := r.Init(context.Background()); err != nil {
+		t.Fatalf("failed to init repo: %v", err)
+	}
+	if _, err := r.Backup(context.Background(), nil, WithBackupPaths(helpers.CreateTestData(t))); err != nil {
+		t.Fatalf("failed to backup: %v", err)
+	}
+}
@@ -0,0 +1,126 @@
+// @generated by protoc-gen-es v1.7.2 with parameter "target=ts"
+// @generated from file v1/audit.proto (package v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
+
+/**
+ * AuditEntry records a configuration change or user initiated action.
+ *
+ * @generated from message v1.AuditEntry
+ */
+export class AuditEntry extends Message<AuditEntry> {
+  /**
+   * time the action was taken.
+   *
+   * @generated from field: int64 unix_time_ms = 1;
+   */
+  unixTimeMs = protoInt64.zero;
+
+  /**
+   * name of the user that took the action, empty if unauthenticated.
+   *
+   * @generated from field: string user = 2;
+   */
+  user = "";
+
+  /**
+   * the action taken e.g. "set_config", "backup", "restore", "forget".
+   *
+   * @generated from field: string action = 3;
+   */
+  action = "";
+
+  /**
+   * optional, repo the action applies to.
+   *
+   * @generated from field: string repo_id = 4;
+   */
+  repoId = "";
+
+  /**
+   * optional, plan the action applies to.
+   *
+   * @generated from field: string plan_id = 5;
+   */
+  planId = "";
+
+  /**
+   * human readable description of the action, for config changes this is a diff.
+   *
+   * @generated from field: string details = 6;
+   */
+  details = "";
+
+  constructor(data?: PartialMessage<AuditEntry>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.AuditEntry";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "unix_time_ms", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "user", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "action", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "repo_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "plan_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "details", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AuditEntry {
+    return new AuditEntry().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AuditEntry {
+    return new AuditEntry().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AuditEntry {
+    return new AuditEntry().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AuditEntry | PlainMessage<AuditEntry> | undefined, b: AuditEntry | PlainMessage<AuditEntry> | undefined): boolean {
+    return proto3.util.equals(AuditEntry, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.AuditEntryList
+ */
+export class AuditEntryList extends Message<AuditEntryList> {
+  /**
+   * @generated from field: repeated v1.AuditEntry entries = 1;
+   */
+  entries: AuditEntry[] = [];
+
+  constructor(data?: PartialMessage<AuditEntryList>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.AuditEntryList";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "entries", kind: "message", T: AuditEntry, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AuditEntryList {
+    return new AuditEntryList().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AuditEntryList {
+    return new AuditEntryList().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AuditEntryList {
+    return new AuditEntryList().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AuditEntryList | PlainMessage<AuditEntryList> | undefined, b: AuditEntryList | PlainMessage<AuditEntryList> | undefined): boolean {
+    return proto3.util.equals(AuditEntryList, a, b);
+  }
+}
+
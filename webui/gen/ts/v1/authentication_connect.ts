@@ -3,9 +3,9 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { LoginRequest, LoginResponse } from "./authentication_pb.js";
-import { MethodKind } from "@bufbuild/protobuf";
-import { StringValue } from "../types/value_pb.js";
+import { ConfirmTotpEnrollmentRequest, FinishWebAuthnRegistrationRequest, LoginLockoutList, LoginRequest, LoginResponse, RecoveryCodes, RemoveSecondFactorsRequest, TotpEnrollment, WebAuthnChallenge } from "./authentication_pb.js";
+import { Empty, MethodKind } from "@bufbuild/protobuf";
+import { Int64Value, StringValue } from "../types/value_pb.js";
 
 /**
  * @generated from service v1.Authentication
@@ -31,6 +31,107 @@ export const Authentication = {
       O: StringValue,
       kind: MethodKind.Unary,
     },
+    /**
+     * BeginTotpEnrollment generates a TOTP secret, it is enrolled once confirmed with a code.
+     *
+     * @generated from rpc v1.Authentication.BeginTotpEnrollment
+     */
+    beginTotpEnrollment: {
+      name: "BeginTotpEnrollment",
+      I: Empty,
+      O: TotpEnrollment,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ConfirmTotpEnrollment enrolls the TOTP secret, recovery codes are returned if the user had none.
+     *
+     * @generated from rpc v1.Authentication.ConfirmTotpEnrollment
+     */
+    confirmTotpEnrollment: {
+      name: "ConfirmTotpEnrollment",
+      I: ConfirmTotpEnrollmentRequest,
+      O: RecoveryCodes,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * BeginWebAuthnRegistration returns the options for navigator.credentials.create().
+     *
+     * @generated from rpc v1.Authentication.BeginWebAuthnRegistration
+     */
+    beginWebAuthnRegistration: {
+      name: "BeginWebAuthnRegistration",
+      I: Empty,
+      O: WebAuthnChallenge,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * FinishWebAuthnRegistration enrolls the created credential, recovery codes are returned if the user had none.
+     *
+     * @generated from rpc v1.Authentication.FinishWebAuthnRegistration
+     */
+    finishWebAuthnRegistration: {
+      name: "FinishWebAuthnRegistration",
+      I: FinishWebAuthnRegistrationRequest,
+      O: RecoveryCodes,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * RegenerateRecoveryCodes replaces the user's recovery codes.
+     *
+     * @generated from rpc v1.Authentication.RegenerateRecoveryCodes
+     */
+    regenerateRecoveryCodes: {
+      name: "RegenerateRecoveryCodes",
+      I: Empty,
+      O: RecoveryCodes,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * RemoveSecondFactors removes all second factors of the authenticated user once they prove they still hold one, a
+     * user who lost theirs proves it with a recovery code.
+     *
+     * @generated from rpc v1.Authentication.RemoveSecondFactors
+     */
+    removeSecondFactors: {
+      name: "RemoveSecondFactors",
+      I: RemoveSecondFactorsRequest,
+      O: Empty,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * CreateApiToken returns a token for the user valid for the requested number of days (default 365, at most 3650)
+     * e.g. for another instance to read this instance's status.
+     *
+     * @generated from rpc v1.Authentication.CreateApiToken
+     */
+    createApiToken: {
+      name: "CreateApiToken",
+      I: Int64Value,
+      O: StringValue,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ListLoginLockouts returns the clients and users with recent failed logins and whether they are locked out.
+     *
+     * @generated from rpc v1.Authentication.ListLoginLockouts
+     */
+    listLoginLockouts: {
+      name: "ListLoginLockouts",
+      I: Empty,
+      O: LoginLockoutList,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ClearLoginLockouts clears the failed logins of the key e.g. "user:alice", or of all keys if empty.
+     *
+     * @generated from rpc v1.Authentication.ClearLoginLockouts
+     */
+    clearLoginLockouts: {
+      name: "ClearLoginLockouts",
+      I: StringValue,
+      O: Empty,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
 
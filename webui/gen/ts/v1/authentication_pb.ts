@@ -4,7 +4,7 @@
 // @ts-nocheck
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
-import { Message, proto3 } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
 
 /**
  * @generated from message v1.LoginRequest
@@ -20,6 +20,32 @@ export class LoginRequest extends Message<LoginRequest> {
    */
   password = "";
 
+  /**
+   * second factor, required if the user has one enrolled. Only one needs to be provided.
+   *
+   * @generated from field: string totp_code = 3;
+   */
+  totpCode = "";
+
+  /**
+   * @generated from field: string recovery_code = 4;
+   */
+  recoveryCode = "";
+
+  /**
+   * challenge_token from the LoginResponse requesting a second factor.
+   *
+   * @generated from field: string webauthn_challenge_token = 5;
+   */
+  webauthnChallengeToken = "";
+
+  /**
+   * JSON serialization of the PublicKeyCredential returned by navigator.credentials.get().
+   *
+   * @generated from field: string webauthn_assertion_json = 6;
+   */
+  webauthnAssertionJson = "";
+
   constructor(data?: PartialMessage<LoginRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -30,6 +56,10 @@ export class LoginRequest extends Message<LoginRequest> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "username", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "password", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "totp_code", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "recovery_code", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "webauthn_challenge_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "webauthn_assertion_json", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LoginRequest {
@@ -54,12 +84,31 @@ export class LoginRequest extends Message<LoginRequest> {
  */
 export class LoginResponse extends Message<LoginResponse> {
   /**
-   * JWT token
+   * JWT token, empty if a second factor is required.
    *
    * @generated from field: string token = 1;
    */
   token = "";
 
+  /**
+   * the password is correct, the login must be repeated with a second factor.
+   *
+   * @generated from field: bool second_factor_required = 2;
+   */
+  secondFactorRequired = false;
+
+  /**
+   * @generated from field: bool totp_enrolled = 3;
+   */
+  totpEnrolled = false;
+
+  /**
+   * set if the user has WebAuthn credentials.
+   *
+   * @generated from field: v1.WebAuthnChallenge webauthn = 4;
+   */
+  webauthn?: WebAuthnChallenge;
+
   constructor(data?: PartialMessage<LoginResponse>) {
     super();
     proto3.util.initPartial(data, this);
@@ -69,6 +118,9 @@ export class LoginResponse extends Message<LoginResponse> {
   static readonly typeName = "v1.LoginResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "second_factor_required", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "totp_enrolled", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "webauthn", kind: "message", T: WebAuthnChallenge },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LoginResponse {
@@ -88,3 +140,375 @@ export class LoginResponse extends Message<LoginResponse> {
   }
 }
 
+/**
+ * @generated from message v1.WebAuthnChallenge
+ */
+export class WebAuthnChallenge extends Message<WebAuthnChallenge> {
+  /**
+   * opaque token to return with the response, valid for 5 minutes.
+   *
+   * @generated from field: string challenge_token = 1;
+   */
+  challengeToken = "";
+
+  /**
+   * JSON serialization of the PublicKeyCredentialCreationOptions or PublicKeyCredentialRequestOptions.
+   *
+   * @generated from field: string options_json = 2;
+   */
+  optionsJson = "";
+
+  constructor(data?: PartialMessage<WebAuthnChallenge>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.WebAuthnChallenge";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "challenge_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "options_json", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WebAuthnChallenge {
+    return new WebAuthnChallenge().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WebAuthnChallenge {
+    return new WebAuthnChallenge().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WebAuthnChallenge {
+    return new WebAuthnChallenge().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: WebAuthnChallenge | PlainMessage<WebAuthnChallenge> | undefined, b: WebAuthnChallenge | PlainMessage<WebAuthnChallenge> | undefined): boolean {
+    return proto3.util.equals(WebAuthnChallenge, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.TotpEnrollment
+ */
+export class TotpEnrollment extends Message<TotpEnrollment> {
+  /**
+   * base32 encoded secret.
+   *
+   * @generated from field: string secret = 1;
+   */
+  secret = "";
+
+  /**
+   * otpauth:// URL for authenticator apps, typically shown as a QR code.
+   *
+   * @generated from field: string otpauth_url = 2;
+   */
+  otpauthUrl = "";
+
+  constructor(data?: PartialMessage<TotpEnrollment>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.TotpEnrollment";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "secret", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "otpauth_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TotpEnrollment {
+    return new TotpEnrollment().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TotpEnrollment {
+    return new TotpEnrollment().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TotpEnrollment {
+    return new TotpEnrollment().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TotpEnrollment | PlainMessage<TotpEnrollment> | undefined, b: TotpEnrollment | PlainMessage<TotpEnrollment> | undefined): boolean {
+    return proto3.util.equals(TotpEnrollment, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.ConfirmTotpEnrollmentRequest
+ */
+export class ConfirmTotpEnrollmentRequest extends Message<ConfirmTotpEnrollmentRequest> {
+  /**
+   * @generated from field: string secret = 1;
+   */
+  secret = "";
+
+  /**
+   * current code generated from the secret.
+   *
+   * @generated from field: string code = 2;
+   */
+  code = "";
+
+  constructor(data?: PartialMessage<ConfirmTotpEnrollmentRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.ConfirmTotpEnrollmentRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "secret", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "code", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ConfirmTotpEnrollmentRequest {
+    return new ConfirmTotpEnrollmentRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ConfirmTotpEnrollmentRequest {
+    return new ConfirmTotpEnrollmentRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ConfirmTotpEnrollmentRequest {
+    return new ConfirmTotpEnrollmentRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ConfirmTotpEnrollmentRequest | PlainMessage<ConfirmTotpEnrollmentRequest> | undefined, b: ConfirmTotpEnrollmentRequest | PlainMessage<ConfirmTotpEnrollmentRequest> | undefined): boolean {
+    return proto3.util.equals(ConfirmTotpEnrollmentRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.RemoveSecondFactorsRequest
+ */
+export class RemoveSecondFactorsRequest extends Message<RemoveSecondFactorsRequest> {
+  /**
+   * @generated from field: string totp_code = 1;
+   */
+  totpCode = "";
+
+  /**
+   * @generated from field: string recovery_code = 2;
+   */
+  recoveryCode = "";
+
+  constructor(data?: PartialMessage<RemoveSecondFactorsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.RemoveSecondFactorsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "totp_code", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "recovery_code", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveSecondFactorsRequest {
+    return new RemoveSecondFactorsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveSecondFactorsRequest {
+    return new RemoveSecondFactorsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveSecondFactorsRequest {
+    return new RemoveSecondFactorsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RemoveSecondFactorsRequest | PlainMessage<RemoveSecondFactorsRequest> | undefined, b: RemoveSecondFactorsRequest | PlainMessage<RemoveSecondFactorsRequest> | undefined): boolean {
+    return proto3.util.equals(RemoveSecondFactorsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.FinishWebAuthnRegistrationRequest
+ */
+export class FinishWebAuthnRegistrationRequest extends Message<FinishWebAuthnRegistrationRequest> {
+  /**
+   * @generated from field: string challenge_token = 1;
+   */
+  challengeToken = "";
+
+  /**
+   * JSON serialization of the PublicKeyCredential returned by navigator.credentials.create().
+   *
+   * @generated from field: string credential_json = 2;
+   */
+  credentialJson = "";
+
+  /**
+   * name for the authenticator.
+   *
+   * @generated from field: string name = 3;
+   */
+  name = "";
+
+  constructor(data?: PartialMessage<FinishWebAuthnRegistrationRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.FinishWebAuthnRegistrationRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "challenge_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "credential_json", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FinishWebAuthnRegistrationRequest {
+    return new FinishWebAuthnRegistrationRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FinishWebAuthnRegistrationRequest {
+    return new FinishWebAuthnRegistrationRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FinishWebAuthnRegistrationRequest {
+    return new FinishWebAuthnRegistrationRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: FinishWebAuthnRegistrationRequest | PlainMessage<FinishWebAuthnRegistrationRequest> | undefined, b: FinishWebAuthnRegistrationRequest | PlainMessage<FinishWebAuthnRegistrationRequest> | undefined): boolean {
+    return proto3.util.equals(FinishWebAuthnRegistrationRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.RecoveryCodes
+ */
+export class RecoveryCodes extends Message<RecoveryCodes> {
+  /**
+   * one time recovery codes, only shown once. Empty if the existing codes were kept.
+   *
+   * @generated from field: repeated string codes = 1;
+   */
+  codes: string[] = [];
+
+  constructor(data?: PartialMessage<RecoveryCodes>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.RecoveryCodes";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "codes", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RecoveryCodes {
+    return new RecoveryCodes().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RecoveryCodes {
+    return new RecoveryCodes().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RecoveryCodes {
+    return new RecoveryCodes().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RecoveryCodes | PlainMessage<RecoveryCodes> | undefined, b: RecoveryCodes | PlainMessage<RecoveryCodes> | undefined): boolean {
+    return proto3.util.equals(RecoveryCodes, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.LoginLockout
+ */
+export class LoginLockout extends Message<LoginLockout> {
+  /**
+   * "ip:<address>" for a client or "user:<name>" for a user.
+   *
+   * @generated from field: string key = 1;
+   */
+  key = "";
+
+  /**
+   * failed logins since the last success, forgotten after a period without failures.
+   *
+   * @generated from field: int32 failures = 2;
+   */
+  failures = 0;
+
+  /**
+   * @generated from field: int64 last_failure_ms = 3;
+   */
+  lastFailureMs = protoInt64.zero;
+
+  /**
+   * logins are refused until this time, 0 if not locked out.
+   *
+   * @generated from field: int64 locked_until_ms = 4;
+   */
+  lockedUntilMs = protoInt64.zero;
+
+  constructor(data?: PartialMessage<LoginLockout>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.LoginLockout";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "failures", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "last_failure_ms", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "locked_until_ms", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LoginLockout {
+    return new LoginLockout().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LoginLockout {
+    return new LoginLockout().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LoginLockout {
+    return new LoginLockout().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: LoginLockout | PlainMessage<LoginLockout> | undefined, b: LoginLockout | PlainMessage<LoginLockout> | undefined): boolean {
+    return proto3.util.equals(LoginLockout, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.LoginLockoutList
+ */
+export class LoginLockoutList extends Message<LoginLockoutList> {
+  /**
+   * @generated from field: repeated v1.LoginLockout lockouts = 1;
+   */
+  lockouts: LoginLockout[] = [];
+
+  constructor(data?: PartialMessage<LoginLockoutList>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.LoginLockoutList";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "lockouts", kind: "message", T: LoginLockout, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LoginLockoutList {
+    return new LoginLockoutList().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LoginLockoutList {
+    return new LoginLockoutList().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LoginLockoutList {
+    return new LoginLockoutList().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: LoginLockoutList | PlainMessage<LoginLockoutList> | undefined, b: LoginLockoutList | PlainMessage<LoginLockoutList> | undefined): boolean {
+    return proto3.util.equals(LoginLockoutList, a, b);
+  }
+}
+
@@ -4,7 +4,8 @@
 // @ts-nocheck
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
-import { Message, proto3 } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
+import { ErrorClass } from "./restic_pb.js";
 
 /**
  * Config is the top level config object for restic UI.
@@ -48,6 +49,62 @@ export class Config extends Message<Config> {
    */
   auth?: Auth;
 
+  /**
+   * optional MQTT broker to publish plan and repo status to.
+   *
+   * @generated from field: v1.Mqtt mqtt = 7;
+   */
+  mqtt?: Mqtt;
+
+  /**
+   * optional backup of backrest's own config and operation log.
+   *
+   * @generated from field: v1.SelfBackup self_backup = 8;
+   */
+  selfBackup?: SelfBackup;
+
+  /**
+   * optional TLS for the API and UI listener, changes take effect on restart.
+   *
+   * @generated from field: v1.Tls tls = 9;
+   */
+  tls?: Tls;
+
+  /**
+   * other backrest instances whose status is shown read-only.
+   *
+   * @generated from field: repeated v1.RemoteInstance remote_instances = 10;
+   */
+  remoteInstances: RemoteInstance[] = [];
+
+  /**
+   * optional, language and wording of hook notifications.
+   *
+   * @generated from field: v1.Notifications notifications = 11;
+   */
+  notifications?: Notifications;
+
+  /**
+   * set while an emergency stop is in effect, see the EmergencyStop RPC.
+   *
+   * @generated from field: v1.EmergencyStop emergency_stop = 12;
+   */
+  emergencyStop?: EmergencyStop;
+
+  /**
+   * templates plans are created from with InstantiatePlanTemplate.
+   *
+   * @generated from field: repeated v1.PlanTemplate plan_templates = 13;
+   */
+  planTemplates: PlanTemplate[] = [];
+
+  /**
+   * set by backrest, the restic binary it installed and verified.
+   *
+   * @generated from field: v1.ResticBinary restic_binary = 14;
+   */
+  resticBinary?: ResticBinary;
+
   constructor(data?: PartialMessage<Config>) {
     super();
     proto3.util.initPartial(data, this);
@@ -62,6 +119,14 @@ export class Config extends Message<Config> {
     { no: 3, name: "repos", kind: "message", T: Repo, repeated: true },
     { no: 4, name: "plans", kind: "message", T: Plan, repeated: true },
     { no: 5, name: "auth", kind: "message", T: Auth },
+    { no: 7, name: "mqtt", kind: "message", T: Mqtt },
+    { no: 8, name: "self_backup", kind: "message", T: SelfBackup },
+    { no: 9, name: "tls", kind: "message", T: Tls },
+    { no: 10, name: "remote_instances", kind: "message", T: RemoteInstance, repeated: true },
+    { no: 11, name: "notifications", kind: "message", T: Notifications },
+    { no: 12, name: "emergency_stop", kind: "message", T: EmergencyStop },
+    { no: 13, name: "plan_templates", kind: "message", T: PlanTemplate, repeated: true },
+    { no: 14, name: "restic_binary", kind: "message", T: ResticBinary },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Config {
@@ -82,818 +147,3188 @@ export class Config extends Message<Config> {
 }
 
 /**
- * @generated from message v1.Repo
+ * PlanTemplate is a plan whose string fields contain ${name} variables, e.g. a plan with the id "home-${hostname}"
+ * backing up "${path}". Instantiating the template substitutes the variables, unknown variables are an error. Hooks
+ * are copied as is since their commands often use shell variables.
+ *
+ * @generated from message v1.PlanTemplate
  */
-export class Repo extends Message<Repo> {
+export class PlanTemplate extends Message<PlanTemplate> {
   /**
-   * unique but human readable ID for this repo.
+   * unique but human readable ID for this template.
    *
    * @generated from field: string id = 1;
    */
   id = "";
 
   /**
-   * restic repo URI
+   * optional, what plans created from the template back up.
    *
-   * @generated from field: string uri = 2;
+   * @generated from field: string description = 2;
    */
-  uri = "";
+  description = "";
 
   /**
-   * plaintext password
+   * @generated from field: v1.Plan plan = 3;
+   */
+  plan?: Plan;
+
+  constructor(data?: PartialMessage<PlanTemplate>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.PlanTemplate";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "description", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "plan", kind: "message", T: Plan },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PlanTemplate {
+    return new PlanTemplate().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PlanTemplate {
+    return new PlanTemplate().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PlanTemplate {
+    return new PlanTemplate().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PlanTemplate | PlainMessage<PlanTemplate> | undefined, b: PlanTemplate | PlainMessage<PlanTemplate> | undefined): boolean {
+    return proto3.util.equals(PlanTemplate, a, b);
+  }
+}
+
+/**
+ * ResticBinary pins the restic binary backrest downloaded, the binary is checked against the hash at startup and
+ * reinstalled if it was modified.
+ *
+ * @generated from message v1.ResticBinary
+ */
+export class ResticBinary extends Message<ResticBinary> {
+  /**
+   * @generated from field: string version = 1;
+   */
+  version = "";
+
+  /**
+   * hex SHA-256 of the installed binary.
    *
-   * @generated from field: string password = 3;
+   * @generated from field: string sha256 = 2;
    */
-  password = "";
+  sha256 = "";
+
+  constructor(data?: PartialMessage<ResticBinary>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.ResticBinary";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "version", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "sha256", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ResticBinary {
+    return new ResticBinary().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ResticBinary {
+    return new ResticBinary().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ResticBinary {
+    return new ResticBinary().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ResticBinary | PlainMessage<ResticBinary> | undefined, b: ResticBinary | PlainMessage<ResticBinary> | undefined): boolean {
+    return proto3.util.equals(ResticBinary, a, b);
+  }
+}
 
+/**
+ * EmergencyStop records an emergency stop, while set no tasks are run or scheduled until schedules are explicitly
+ * resumed.
+ *
+ * @generated from message v1.EmergencyStop
+ */
+export class EmergencyStop extends Message<EmergencyStop> {
   /**
-   * extra environment variables to set for restic.
+   * time the emergency stop was triggered.
    *
-   * @generated from field: repeated string env = 4;
+   * @generated from field: int64 unix_time_ms = 1;
    */
-  env: string[] = [];
+  unixTimeMs = protoInt64.zero;
 
   /**
-   * extra flags set on the restic command.
+   * user that triggered the emergency stop, empty if auth is disabled.
    *
-   * @generated from field: repeated string flags = 5;
+   * @generated from field: string user = 2;
    */
-  flags: string[] = [];
+  user = "";
 
   /**
-   * policy for when to run prune.
+   * optional, why the emergency stop was triggered.
    *
-   * @generated from field: v1.PrunePolicy prune_policy = 6;
+   * @generated from field: string reason = 3;
    */
-  prunePolicy?: PrunePolicy;
+  reason = "";
+
+  constructor(data?: PartialMessage<EmergencyStop>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.EmergencyStop";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "unix_time_ms", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "user", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EmergencyStop {
+    return new EmergencyStop().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EmergencyStop {
+    return new EmergencyStop().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EmergencyStop {
+    return new EmergencyStop().fromJsonString(jsonString, options);
+  }
 
+  static equals(a: EmergencyStop | PlainMessage<EmergencyStop> | undefined, b: EmergencyStop | PlainMessage<EmergencyStop> | undefined): boolean {
+    return proto3.util.equals(EmergencyStop, a, b);
+  }
+}
+
+/**
+ * Notifications selects the built-in notification messages and overrides individual ones.
+ *
+ * @generated from message v1.Notifications
+ */
+export class Notifications extends Message<Notifications> {
   /**
-   * hooks to run on events for this repo.
+   * language of the built-in messages, "en" (default) or "de".
    *
-   * @generated from field: repeated v1.Hook hooks = 7;
+   * @generated from field: string locale = 1;
    */
-  hooks: Hook[] = [];
+  locale = "";
 
   /**
-   * automatically unlock the repo when needed.
+   * overrides of messages by name e.g. "summary_snapshot_end", see GetNotificationTemplates for the names and defaults.
    *
-   * @generated from field: bool auto_unlock = 8;
+   * @generated from field: map<string, string> templates = 2;
    */
-  autoUnlock = false;
+  templates: { [key: string]: string } = {};
 
-  constructor(data?: PartialMessage<Repo>) {
+  constructor(data?: PartialMessage<Notifications>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "v1.Repo";
+  static readonly typeName = "v1.Notifications";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "uri", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "password", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 4, name: "env", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
-    { no: 5, name: "flags", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
-    { no: 6, name: "prune_policy", kind: "message", T: PrunePolicy },
-    { no: 7, name: "hooks", kind: "message", T: Hook, repeated: true },
-    { no: 8, name: "auto_unlock", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 1, name: "locale", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "templates", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Repo {
-    return new Repo().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Notifications {
+    return new Notifications().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Repo {
-    return new Repo().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Notifications {
+    return new Notifications().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Repo {
-    return new Repo().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Notifications {
+    return new Notifications().fromJsonString(jsonString, options);
   }
 
-  static equals(a: Repo | PlainMessage<Repo> | undefined, b: Repo | PlainMessage<Repo> | undefined): boolean {
-    return proto3.util.equals(Repo, a, b);
+  static equals(a: Notifications | PlainMessage<Notifications> | undefined, b: Notifications | PlainMessage<Notifications> | undefined): boolean {
+    return proto3.util.equals(Notifications, a, b);
   }
 }
 
 /**
- * @generated from message v1.Plan
+ * RemoteInstance is another backrest instance whose status and operations are proxied into this instance's UI.
+ *
+ * @generated from message v1.RemoteInstance
  */
-export class Plan extends Message<Plan> {
+export class RemoteInstance extends Message<RemoteInstance> {
   /**
-   * unique but human readable ID for this plan.
+   * unique but human readable ID for the instance.
    *
    * @generated from field: string id = 1;
    */
   id = "";
 
   /**
-   * ID of the repo to use.
+   * base URL of the instance e.g. https://nas.local:9898.
    *
-   * @generated from field: string repo = 2;
+   * @generated from field: string url = 2;
    */
-  repo = "";
+  url = "";
 
   /**
-   * paths to include in the backup.
+   * API token of a user on the remote instance, see CreateApiToken.
    *
-   * @generated from field: repeated string paths = 4;
+   * @generated from field: string token = 3;
    */
-  paths: string[] = [];
+  token = "";
+
+  constructor(data?: PartialMessage<RemoteInstance>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.RemoteInstance";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoteInstance {
+    return new RemoteInstance().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoteInstance {
+    return new RemoteInstance().fromJson(jsonValue, options);
+  }
 
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoteInstance {
+    return new RemoteInstance().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RemoteInstance | PlainMessage<RemoteInstance> | undefined, b: RemoteInstance | PlainMessage<RemoteInstance> | undefined): boolean {
+    return proto3.util.equals(RemoteInstance, a, b);
+  }
+}
+
+/**
+ * ConfigRevision is a snapshot of the config as it was written at a point in time.
+ *
+ * @generated from message v1.ConfigRevision
+ */
+export class ConfigRevision extends Message<ConfigRevision> {
   /**
-   * glob patterns to exclude.
+   * sequential revision number, incremented on every write.
    *
-   * @generated from field: repeated string excludes = 5;
+   * @generated from field: int64 revision = 1;
    */
-  excludes: string[] = [];
+  revision = protoInt64.zero;
 
   /**
-   * case insensitive glob patterns to exclude.
+   * time the revision was written.
    *
-   * @generated from field: repeated string iexcludes = 9;
+   * @generated from field: int64 unix_time_ms = 2;
    */
-  iexcludes: string[] = [];
+  unixTimeMs = protoInt64.zero;
 
   /**
-   * cron expression describing the backup schedule.
+   * the config, omitted when listing revisions.
    *
-   * @generated from field: string cron = 6;
+   * @generated from field: v1.Config config = 3;
    */
-  cron = "";
+  config?: Config;
 
   /**
-   * retention policy for snapshots.
+   * diff from the previous revision with secrets redacted, populated when listing revisions.
    *
-   * @generated from field: v1.RetentionPolicy retention = 7;
+   * @generated from field: string diff = 4;
    */
-  retention?: RetentionPolicy;
+  diff = "";
+
+  constructor(data?: PartialMessage<ConfigRevision>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
 
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.ConfigRevision";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "revision", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "unix_time_ms", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "config", kind: "message", T: Config },
+    { no: 4, name: "diff", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ConfigRevision {
+    return new ConfigRevision().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ConfigRevision {
+    return new ConfigRevision().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ConfigRevision {
+    return new ConfigRevision().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ConfigRevision | PlainMessage<ConfigRevision> | undefined, b: ConfigRevision | PlainMessage<ConfigRevision> | undefined): boolean {
+    return proto3.util.equals(ConfigRevision, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.ConfigRevisionList
+ */
+export class ConfigRevisionList extends Message<ConfigRevisionList> {
   /**
-   * hooks to run on events for this plan.
-   *
-   * @generated from field: repeated v1.Hook hooks = 8;
+   * @generated from field: repeated v1.ConfigRevision revisions = 1;
    */
-  hooks: Hook[] = [];
+  revisions: ConfigRevision[] = [];
 
-  constructor(data?: PartialMessage<Plan>) {
+  constructor(data?: PartialMessage<ConfigRevisionList>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "v1.Plan";
+  static readonly typeName = "v1.ConfigRevisionList";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "repo", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 4, name: "paths", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
-    { no: 5, name: "excludes", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
-    { no: 9, name: "iexcludes", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
-    { no: 6, name: "cron", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 7, name: "retention", kind: "message", T: RetentionPolicy },
-    { no: 8, name: "hooks", kind: "message", T: Hook, repeated: true },
+    { no: 1, name: "revisions", kind: "message", T: ConfigRevision, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Plan {
-    return new Plan().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ConfigRevisionList {
+    return new ConfigRevisionList().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Plan {
-    return new Plan().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ConfigRevisionList {
+    return new ConfigRevisionList().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Plan {
-    return new Plan().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ConfigRevisionList {
+    return new ConfigRevisionList().fromJsonString(jsonString, options);
   }
 
-  static equals(a: Plan | PlainMessage<Plan> | undefined, b: Plan | PlainMessage<Plan> | undefined): boolean {
-    return proto3.util.equals(Plan, a, b);
+  static equals(a: ConfigRevisionList | PlainMessage<ConfigRevisionList> | undefined, b: ConfigRevisionList | PlainMessage<ConfigRevisionList> | undefined): boolean {
+    return proto3.util.equals(ConfigRevisionList, a, b);
   }
 }
 
 /**
- * @generated from message v1.RetentionPolicy
+ * @generated from message v1.Repo
  */
-export class RetentionPolicy extends Message<RetentionPolicy> {
+export class Repo extends Message<Repo> {
   /**
-   * @generated from field: string max_unused_limit = 1 [deprecated = true];
-   * @deprecated
+   * unique but human readable ID for this repo.
+   *
+   * @generated from field: string id = 1;
    */
-  maxUnusedLimit = "";
+  id = "";
 
   /**
-   * @generated from field: int32 keep_last_n = 2 [deprecated = true];
-   * @deprecated
+   * restic repo URI
+   *
+   * @generated from field: string uri = 2;
    */
-  keepLastN = 0;
+  uri = "";
 
   /**
-   * @generated from field: int32 keep_hourly = 3 [deprecated = true];
-   * @deprecated
+   * plaintext password
+   *
+   * @generated from field: string password = 3;
    */
-  keepHourly = 0;
+  password = "";
 
   /**
-   * @generated from field: int32 keep_daily = 4 [deprecated = true];
-   * @deprecated
+   * extra environment variables to set for restic.
+   *
+   * @generated from field: repeated string env = 4;
    */
-  keepDaily = 0;
+  env: string[] = [];
 
   /**
-   * @generated from field: int32 keep_weekly = 5 [deprecated = true];
-   * @deprecated
+   * extra flags set on the restic command.
+   *
+   * @generated from field: repeated string flags = 5;
    */
-  keepWeekly = 0;
+  flags: string[] = [];
 
   /**
-   * @generated from field: int32 keep_monthly = 6 [deprecated = true];
-   * @deprecated
+   * policy for when to run prune.
+   *
+   * @generated from field: v1.PrunePolicy prune_policy = 6;
    */
-  keepMonthly = 0;
+  prunePolicy?: PrunePolicy;
 
   /**
-   * @generated from field: int32 keep_yearly = 7 [deprecated = true];
-   * @deprecated
+   * hooks to run on events for this repo.
+   *
+   * @generated from field: repeated v1.Hook hooks = 7;
    */
-  keepYearly = 0;
+  hooks: Hook[] = [];
 
   /**
-   * keep snapshots within a duration e.g. 1y2m3d4h5m6s
+   * automatically unlock the repo when needed.
    *
-   * @generated from field: string keep_within_duration = 8 [deprecated = true];
-   * @deprecated
+   * @generated from field: bool auto_unlock = 8;
    */
-  keepWithinDuration = "";
+  autoUnlock = false;
 
   /**
-   * @generated from oneof v1.RetentionPolicy.policy
+   * group snapshots not created by backrest by hostname and tags instead of as a single unassociated group.
+   *
+   * @generated from field: bool group_untracked_snapshots = 9;
    */
-  policy: {
+  groupUntrackedSnapshots = false;
+
+  /**
+   * compute stats for just the new snapshot after each backup, full repo stats only run every 100 operations or 10 GB added.
+   *
+   * @generated from field: bool incremental_stats = 10;
+   */
+  incrementalStats = false;
+
+  /**
+   * optional, target pack size in MiB from 4 to 128 (restic --pack-size), requires restic 0.14.0 or newer.
+   *
+   * @generated from field: int32 pack_size_mb = 11;
+   */
+  packSizeMb = 0;
+
+  /**
+   * optional, one of "auto", "max" or "off" (restic --compression), requires restic 0.14.0 or newer.
+   *
+   * @generated from field: string compression = 12;
+   */
+  compression = "";
+
+  /**
+   * optional, allowlisted global restic flags set on every command for the repo.
+   *
+   * @generated from field: repeated v1.ResticFlag extra_flags = 13;
+   */
+  extraFlags: ResticFlag[] = [];
+
+  /**
+   * optional, free space required on the restic cache, a local repo and restore targets.
+   *
+   * @generated from field: v1.DiskSpace disk_space = 14;
+   */
+  diskSpace?: DiskSpace;
+
+  /**
+   * optional, absolute path of the restic cache directory (restic --cache-dir), defaults to the user's cache directory.
+   *
+   * @generated from field: string cache_dir = 15;
+   */
+  cacheDir = "";
+
+  /**
+   * optional, scheduled cleanup of the restic cache.
+   *
+   * @generated from field: v1.CachePolicy cache_policy = 16;
+   */
+  cachePolicy?: CachePolicy;
+
+  /**
+   * optional, settings of the storage backend named by the uri.
+   *
+   * @generated from field: v1.BackendOptions backend_options = 17;
+   */
+  backendOptions?: BackendOptions;
+
+  /**
+   * optional, the repo's data is on archive storage e.g. S3 Glacier.
+   *
+   * @generated from field: v1.ColdStorage cold_storage = 18;
+   */
+  coldStorage?: ColdStorage;
+
+  /**
+   * optional, the repo can't be deleted from.
+   *
+   * @generated from field: v1.AppendOnly append_only = 19;
+   */
+  appendOnly?: AppendOnly;
+
+  /**
+   * optional, snapshots are forgotten a cool off after retention marks them.
+   *
+   * @generated from field: v1.DelayedDeletion delayed_deletion = 20;
+   */
+  delayedDeletion?: DelayedDeletion;
+
+  constructor(data?: PartialMessage<Repo>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.Repo";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "uri", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "password", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "env", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 5, name: "flags", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 6, name: "prune_policy", kind: "message", T: PrunePolicy },
+    { no: 7, name: "hooks", kind: "message", T: Hook, repeated: true },
+    { no: 8, name: "auto_unlock", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 9, name: "group_untracked_snapshots", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 10, name: "incremental_stats", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 11, name: "pack_size_mb", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 12, name: "compression", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 13, name: "extra_flags", kind: "message", T: ResticFlag, repeated: true },
+    { no: 14, name: "disk_space", kind: "message", T: DiskSpace },
+    { no: 15, name: "cache_dir", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 16, name: "cache_policy", kind: "message", T: CachePolicy },
+    { no: 17, name: "backend_options", kind: "message", T: BackendOptions },
+    { no: 18, name: "cold_storage", kind: "message", T: ColdStorage },
+    { no: 19, name: "append_only", kind: "message", T: AppendOnly },
+    { no: 20, name: "delayed_deletion", kind: "message", T: DelayedDeletion },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Repo {
+    return new Repo().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Repo {
+    return new Repo().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Repo {
+    return new Repo().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Repo | PlainMessage<Repo> | undefined, b: Repo | PlainMessage<Repo> | undefined): boolean {
+    return proto3.util.equals(Repo, a, b);
+  }
+}
+
+/**
+ * DelayedDeletion protects a repo's snapshots against deletion by a compromised instance, e.g. by ransomware. Forgets
+ * tag snapshots as marked for deletion instead of forgetting them, a scheduled task forgets marked snapshots once
+ * the cool off passed. Until then marked snapshots can be rescued.
+ *
+ * @generated from message v1.DelayedDeletion
+ */
+export class DelayedDeletion extends Message<DelayedDeletion> {
+  /**
+   * how long marked snapshots are kept, delayed deletion is enabled if positive.
+   *
+   * @generated from field: int32 cool_off_hours = 1;
+   */
+  coolOffHours = 0;
+
+  /**
+   * optional, schedule of the task forgetting marked snapshots, defaults to hourly.
+   *
+   * @generated from field: string cron = 2;
+   */
+  cron = "";
+
+  constructor(data?: PartialMessage<DelayedDeletion>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.DelayedDeletion";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "cool_off_hours", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "cron", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DelayedDeletion {
+    return new DelayedDeletion().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DelayedDeletion {
+    return new DelayedDeletion().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DelayedDeletion {
+    return new DelayedDeletion().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DelayedDeletion | PlainMessage<DelayedDeletion> | undefined, b: DelayedDeletion | PlainMessage<DelayedDeletion> | undefined): boolean {
+    return proto3.util.equals(DelayedDeletion, a, b);
+  }
+}
+
+/**
+ * AppendOnly marks a repo whose data can't be deleted, e.g. served by rest-server --append-only or on an object-lock
+ * bucket. Forget and prune aren't run against it, the retention of its plans is applied through the retention repo.
+ *
+ * @generated from message v1.AppendOnly
+ */
+export class AppendOnly extends Message<AppendOnly> {
+  /**
+   * @generated from field: bool enabled = 1;
+   */
+  enabled = false;
+
+  /**
+   * optional, ID of a trusted repo accessing the same restic repo with permission to delete, e.g. a rest-server
+   * without --append-only, forget and prune of the append-only repo's plans run against it instead.
+   *
+   * @generated from field: string retention_repo = 2;
+   */
+  retentionRepo = "";
+
+  constructor(data?: PartialMessage<AppendOnly>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.AppendOnly";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "enabled", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "retention_repo", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AppendOnly {
+    return new AppendOnly().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AppendOnly {
+    return new AppendOnly().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AppendOnly {
+    return new AppendOnly().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AppendOnly | PlainMessage<AppendOnly> | undefined, b: AppendOnly | PlainMessage<AppendOnly> | undefined): boolean {
+    return proto3.util.equals(AppendOnly, a, b);
+  }
+}
+
+/**
+ * ColdStorage marks a repo whose pack data is on archive storage that must be restored, e.g. from S3 Glacier, before
+ * it can be read. Operations reading pack data (prune, restores, restore drills, check --read-data) are refused
+ * unless the repo was warmed up, backups and other operations only reading metadata are unaffected.
+ *
+ * @generated from message v1.ColdStorage
+ */
+export class ColdStorage extends Message<ColdStorage> {
+  /**
+   * @generated from field: bool enabled = 1;
+   */
+  enabled = false;
+
+  /**
+   * optional, shell command warming up the repo, it should request the restore of the repo's archived objects and
+   * exit once they are readable. It runs with the repo's env, BACKREST_REPO_ID and BACKREST_REPO_URI set.
+   * Repos without a warmup command can't run operations reading pack data.
+   *
+   * @generated from field: string warmup_command = 2;
+   */
+  warmupCommand = "";
+
+  /**
+   * how long restored objects stay readable after a warmup, defaults to 24.
+   *
+   * @generated from field: int32 warmup_valid_hours = 3;
+   */
+  warmupValidHours = 0;
+
+  constructor(data?: PartialMessage<ColdStorage>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.ColdStorage";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "enabled", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "warmup_command", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "warmup_valid_hours", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ColdStorage {
+    return new ColdStorage().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ColdStorage {
+    return new ColdStorage().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ColdStorage {
+    return new ColdStorage().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ColdStorage | PlainMessage<ColdStorage> | undefined, b: ColdStorage | PlainMessage<ColdStorage> | undefined): boolean {
+    return proto3.util.equals(ColdStorage, a, b);
+  }
+}
+
+/**
+ * BackendOptions configures a repo's storage backend, translated to the environment variables and extended options
+ * (restic -o) restic reads. The backend must match the scheme of the repo's uri.
+ *
+ * @generated from message v1.BackendOptions
+ */
+export class BackendOptions extends Message<BackendOptions> {
+  /**
+   * @generated from oneof v1.BackendOptions.backend
+   */
+  backend: {
     /**
-     * @generated from field: int32 policy_keep_last_n = 10;
+     * @generated from field: v1.BackendOptions.S3 s3 = 1;
      */
-    value: number;
-    case: "policyKeepLastN";
+    value: BackendOptions_S3;
+    case: "s3";
   } | {
     /**
-     * @generated from field: v1.RetentionPolicy.TimeBucketedCounts policy_time_bucketed = 11;
+     * @generated from field: v1.BackendOptions.B2 b2 = 2;
      */
-    value: RetentionPolicy_TimeBucketedCounts;
-    case: "policyTimeBucketed";
+    value: BackendOptions_B2;
+    case: "b2";
   } | {
     /**
-     * @generated from field: bool policy_keep_all = 12;
+     * @generated from field: v1.BackendOptions.Azure azure = 3;
      */
-    value: boolean;
-    case: "policyKeepAll";
+    value: BackendOptions_Azure;
+    case: "azure";
   } | { case: undefined; value?: undefined } = { case: undefined };
 
-  constructor(data?: PartialMessage<RetentionPolicy>) {
+  /**
+   * optional, limit of concurrent connections to the backend, restic's default if 0.
+   *
+   * @generated from field: int32 connections = 4;
+   */
+  connections = 0;
+
+  constructor(data?: PartialMessage<BackendOptions>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "v1.RetentionPolicy";
+  static readonly typeName = "v1.BackendOptions";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "max_unused_limit", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "keep_last_n", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
-    { no: 3, name: "keep_hourly", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
-    { no: 4, name: "keep_daily", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
-    { no: 5, name: "keep_weekly", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
-    { no: 6, name: "keep_monthly", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
-    { no: 7, name: "keep_yearly", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
-    { no: 8, name: "keep_within_duration", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 10, name: "policy_keep_last_n", kind: "scalar", T: 5 /* ScalarType.INT32 */, oneof: "policy" },
-    { no: 11, name: "policy_time_bucketed", kind: "message", T: RetentionPolicy_TimeBucketedCounts, oneof: "policy" },
-    { no: 12, name: "policy_keep_all", kind: "scalar", T: 8 /* ScalarType.BOOL */, oneof: "policy" },
+    { no: 1, name: "s3", kind: "message", T: BackendOptions_S3, oneof: "backend" },
+    { no: 2, name: "b2", kind: "message", T: BackendOptions_B2, oneof: "backend" },
+    { no: 3, name: "azure", kind: "message", T: BackendOptions_Azure, oneof: "backend" },
+    { no: 4, name: "connections", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): BackendOptions {
+    return new BackendOptions().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): BackendOptions {
+    return new BackendOptions().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): BackendOptions {
+    return new BackendOptions().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: BackendOptions | PlainMessage<BackendOptions> | undefined, b: BackendOptions | PlainMessage<BackendOptions> | undefined): boolean {
+    return proto3.util.equals(BackendOptions, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.BackendOptions.S3
+ */
+export class BackendOptions_S3 extends Message<BackendOptions_S3> {
+  /**
+   * AWS_ACCESS_KEY_ID
+   *
+   * @generated from field: string access_key_id = 1;
+   */
+  accessKeyId = "";
+
+  /**
+   * AWS_SECRET_ACCESS_KEY
+   *
+   * @generated from field: string secret_access_key = 2;
+   */
+  secretAccessKey = "";
+
+  /**
+   * optional, AWS_SESSION_TOKEN of temporary credentials.
+   *
+   * @generated from field: string session_token = 3;
+   */
+  sessionToken = "";
+
+  /**
+   * optional, AWS_PROFILE of the shared credentials file, mutually exclusive with access keys.
+   *
+   * @generated from field: string profile = 4;
+   */
+  profile = "";
+
+  /**
+   * optional, bucket region (s3.region).
+   *
+   * @generated from field: string region = 5;
+   */
+  region = "";
+
+  /**
+   * optional, storage class of new objects (s3.storage-class) e.g. STANDARD_IA.
+   *
+   * @generated from field: string storage_class = 6;
+   */
+  storageClass = "";
+
+  /**
+   * optional, one of "auto", "dns" or "path" (s3.bucket-lookup), "path" for most self hosted endpoints.
+   *
+   * @generated from field: string bucket_lookup = 7;
+   */
+  bucketLookup = "";
+
+  constructor(data?: PartialMessage<BackendOptions_S3>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.BackendOptions.S3";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "access_key_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "secret_access_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "profile", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "region", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "storage_class", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "bucket_lookup", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): BackendOptions_S3 {
+    return new BackendOptions_S3().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): BackendOptions_S3 {
+    return new BackendOptions_S3().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): BackendOptions_S3 {
+    return new BackendOptions_S3().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: BackendOptions_S3 | PlainMessage<BackendOptions_S3> | undefined, b: BackendOptions_S3 | PlainMessage<BackendOptions_S3> | undefined): boolean {
+    return proto3.util.equals(BackendOptions_S3, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.BackendOptions.B2
+ */
+export class BackendOptions_B2 extends Message<BackendOptions_B2> {
+  /**
+   * B2_ACCOUNT_ID, the application key ID.
+   *
+   * @generated from field: string account_id = 1;
+   */
+  accountId = "";
+
+  /**
+   * B2_ACCOUNT_KEY, the application key.
+   *
+   * @generated from field: string account_key = 2;
+   */
+  accountKey = "";
+
+  /**
+   * hide deleted files, keeping their versions for the bucket's lifecycle rules, instead of deleting every version.
+   * restic's b2 backend always deletes every version so this requires an rclone: uri of a b2 remote.
+   *
+   * @generated from field: bool hide_deleted = 3;
+   */
+  hideDeleted = false;
+
+  constructor(data?: PartialMessage<BackendOptions_B2>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.BackendOptions.B2";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "account_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "account_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "hide_deleted", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): BackendOptions_B2 {
+    return new BackendOptions_B2().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): BackendOptions_B2 {
+    return new BackendOptions_B2().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): BackendOptions_B2 {
+    return new BackendOptions_B2().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: BackendOptions_B2 | PlainMessage<BackendOptions_B2> | undefined, b: BackendOptions_B2 | PlainMessage<BackendOptions_B2> | undefined): boolean {
+    return proto3.util.equals(BackendOptions_B2, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.BackendOptions.Azure
+ */
+export class BackendOptions_Azure extends Message<BackendOptions_Azure> {
+  /**
+   * AZURE_ACCOUNT_NAME
+   *
+   * @generated from field: string account_name = 1;
+   */
+  accountName = "";
+
+  /**
+   * AZURE_ACCOUNT_KEY, mutually exclusive with account_sas.
+   *
+   * @generated from field: string account_key = 2;
+   */
+  accountKey = "";
+
+  /**
+   * AZURE_ACCOUNT_SAS, a shared access signature token.
+   *
+   * @generated from field: string account_sas = 3;
+   */
+  accountSas = "";
+
+  /**
+   * optional, AZURE_ENDPOINT_SUFFIX of non public clouds e.g. core.chinacloudapi.cn, requires restic 0.16.0 or newer.
+   *
+   * @generated from field: string endpoint_suffix = 4;
+   */
+  endpointSuffix = "";
+
+  constructor(data?: PartialMessage<BackendOptions_Azure>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.BackendOptions.Azure";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "account_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "account_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "account_sas", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "endpoint_suffix", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): BackendOptions_Azure {
+    return new BackendOptions_Azure().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): BackendOptions_Azure {
+    return new BackendOptions_Azure().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): BackendOptions_Azure {
+    return new BackendOptions_Azure().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: BackendOptions_Azure | PlainMessage<BackendOptions_Azure> | undefined, b: BackendOptions_Azure | PlainMessage<BackendOptions_Azure> | undefined): boolean {
+    return proto3.util.equals(BackendOptions_Azure, a, b);
+  }
+}
+
+/**
+ * CachePolicy schedules cleanups of the restic cache used by a repo.
+ *
+ * @generated from message v1.CachePolicy
+ */
+export class CachePolicy extends Message<CachePolicy> {
+  /**
+   * cron expression describing the cleanup schedule.
+   *
+   * @generated from field: string cron = 1;
+   */
+  cron = "";
+
+  /**
+   * cache directories of repos unused for longer are removed (restic cache --cleanup --max-age), defaults to 30.
+   *
+   * @generated from field: int32 max_age_days = 2;
+   */
+  maxAgeDays = 0;
+
+  /**
+   * optional, the repo's cache is cleared when it grows larger, restic rebuilds it as needed.
+   *
+   * @generated from field: int64 max_size_mb = 3;
+   */
+  maxSizeMb = protoInt64.zero;
+
+  constructor(data?: PartialMessage<CachePolicy>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.CachePolicy";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "cron", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "max_age_days", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "max_size_mb", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CachePolicy {
+    return new CachePolicy().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CachePolicy {
+    return new CachePolicy().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CachePolicy {
+    return new CachePolicy().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CachePolicy | PlainMessage<CachePolicy> | undefined, b: CachePolicy | PlainMessage<CachePolicy> | undefined): boolean {
+    return proto3.util.equals(CachePolicy, a, b);
+  }
+}
+
+/**
+ * DiskSpace sets the free space checked for before backups, prunes and restores.
+ *
+ * @generated from message v1.DiskSpace
+ */
+export class DiskSpace extends Message<DiskSpace> {
+  /**
+   * tasks are refused when a path they write to has less free space, 0 to not check.
+   *
+   * @generated from field: int64 min_free_mb = 1;
+   */
+  minFreeMb = protoInt64.zero;
+
+  /**
+   * tasks record a warning and run the CONDITION_LOW_DISK_SPACE hooks below this, 0 to not warn.
+   *
+   * @generated from field: int64 warn_free_mb = 2;
+   */
+  warnFreeMb = protoInt64.zero;
+
+  constructor(data?: PartialMessage<DiskSpace>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.DiskSpace";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "min_free_mb", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "warn_free_mb", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DiskSpace {
+    return new DiskSpace().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DiskSpace {
+    return new DiskSpace().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DiskSpace {
+    return new DiskSpace().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DiskSpace | PlainMessage<DiskSpace> | undefined, b: DiskSpace | PlainMessage<DiskSpace> | undefined): boolean {
+    return proto3.util.equals(DiskSpace, a, b);
+  }
+}
+
+/**
+ * ResticFlag is a restic command line flag, only flags on backrest's allowlist are accepted.
+ *
+ * @generated from message v1.ResticFlag
+ */
+export class ResticFlag extends Message<ResticFlag> {
+  /**
+   * e.g. "--ignore-inode".
+   *
+   * @generated from field: string name = 1;
+   */
+  name = "";
+
+  /**
+   * the flag's value, empty for flags that don't take one.
+   *
+   * @generated from field: string value = 2;
+   */
+  value = "";
+
+  constructor(data?: PartialMessage<ResticFlag>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.ResticFlag";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "value", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ResticFlag {
+    return new ResticFlag().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ResticFlag {
+    return new ResticFlag().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ResticFlag {
+    return new ResticFlag().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ResticFlag | PlainMessage<ResticFlag> | undefined, b: ResticFlag | PlainMessage<ResticFlag> | undefined): boolean {
+    return proto3.util.equals(ResticFlag, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.Plan
+ */
+export class Plan extends Message<Plan> {
+  /**
+   * unique but human readable ID for this plan.
+   *
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * ID of the repo to use.
+   *
+   * @generated from field: string repo = 2;
+   */
+  repo = "";
+
+  /**
+   * paths to include in the backup.
+   *
+   * @generated from field: repeated string paths = 4;
+   */
+  paths: string[] = [];
+
+  /**
+   * glob patterns to exclude.
+   *
+   * @generated from field: repeated string excludes = 5;
+   */
+  excludes: string[] = [];
+
+  /**
+   * case insensitive glob patterns to exclude.
+   *
+   * @generated from field: repeated string iexcludes = 9;
+   */
+  iexcludes: string[] = [];
+
+  /**
+   * cron expression describing the backup schedule.
+   *
+   * @generated from field: string cron = 6;
+   */
+  cron = "";
+
+  /**
+   * retention policy for snapshots.
+   *
+   * @generated from field: v1.RetentionPolicy retention = 7;
+   */
+  retention?: RetentionPolicy;
+
+  /**
+   * hooks to run on events for this plan.
+   *
+   * @generated from field: repeated v1.Hook hooks = 8;
+   */
+  hooks: Hook[] = [];
+
+  /**
+   * optional, hostname recorded on snapshots (restic --host). When set snapshot listing and retention only consider snapshots from this host.
+   *
+   * @generated from field: string host = 10;
+   */
+  host = "";
+
+  /**
+   * optional, how snapshots are grouped when applying retention, any of "host", "paths", "tags". Defaults to "tags".
+   *
+   * @generated from field: repeated string group_by = 11;
+   */
+  groupBy: string[] = [];
+
+  /**
+   * optional, limits on how long the plan's tasks may run.
+   *
+   * @generated from field: v1.TaskTimeouts timeouts = 12;
+   */
+  timeouts?: TaskTimeouts;
+
+  /**
+   * optional, conditions that must hold for scheduled backups to run.
+   *
+   * @generated from field: v1.Preconditions preconditions = 13;
+   */
+  preconditions?: Preconditions;
+
+  /**
+   * optional, CPU, IO and memory limits applied to the plan's restic processes.
+   *
+   * @generated from field: v1.ResourceLimits resource_limits = 14;
+   */
+  resourceLimits?: ResourceLimits;
+
+  /**
+   * optional, scheduled test restores of the plan's latest snapshot.
+   *
+   * @generated from field: v1.RestoreDrill restore_drill = 15;
+   */
+  restoreDrill?: RestoreDrill;
+
+  /**
+   * optional, allowlisted global or backup restic flags set on the plan's backups.
+   *
+   * @generated from field: repeated v1.ResticFlag extra_flags = 16;
+   */
+  extraFlags: ResticFlag[] = [];
+
+  /**
+   * optional, extra environment variables KEY=VALUE set for the plan's restic processes.
+   *
+   * @generated from field: repeated string env = 17;
+   */
+  env: string[] = [];
+
+  /**
+   * don't cross file system boundaries beneath any of the paths (restic --one-file-system).
+   *
+   * @generated from field: bool one_file_system = 18;
+   */
+  oneFileSystem = false;
+
+  /**
+   * optional, mountpoint handling for individual paths.
+   *
+   * @generated from field: repeated v1.PathOptions path_options = 19;
+   */
+  pathOptions: PathOptions[] = [];
+
+  /**
+   * optional, other plans this plan's backups follow or require.
+   *
+   * @generated from field: v1.PlanDependencies dependencies = 20;
+   */
+  dependencies?: PlanDependencies;
+
+  /**
+   * optional, scheduled backups and restore drills start up to this many minutes after their cron time. The delay is fixed per plan so plans sharing a schedule are spread out.
+   *
+   * @generated from field: int32 schedule_jitter_minutes = 21;
+   */
+  scheduleJitterMinutes = 0;
+
+  /**
+   * optional, after each backup this many random files of the new snapshot are compared with the source files to catch read errors and bit rot, 0 to disable.
+   *
+   * @generated from field: int32 verify_sample_files = 22;
+   */
+  verifySampleFiles = 0;
+
+  /**
+   * optional, flag backups deviating from the plan's previous backups.
+   *
+   * @generated from field: v1.AnomalyDetection anomaly_detection = 23;
+   */
+  anomalyDetection?: AnomalyDetection;
+
+  /**
+   * optional, IANA time zone e.g. "Europe/Berlin" the plan's cron and restore drill schedules are evaluated in,
+   * defaults to the server's local time zone. Times skipped by a daylight saving change run at the end of the gap,
+   * times repeated by one only run at their first occurrence.
+   *
+   * @generated from field: string timezone = 24;
+   */
+  timezone = "";
+
+  /**
+   * optional, lets external systems trigger the plan's backups.
+   *
+   * @generated from field: v1.WebhookTrigger webhook_trigger = 25;
+   */
+  webhookTrigger?: WebhookTrigger;
+
+  /**
+   * optional, further repos each backup is also made to e.g. an offsite repo for a 3-2-1 setup. Their backups run one
+   * after another following the backup to repo and join its run, retention is applied in each repo. Restore drills
+   * only read from repo.
+   *
+   * @generated from field: repeated string additional_repos = 26;
+   */
+  additionalRepos: string[] = [];
+
+  constructor(data?: PartialMessage<Plan>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.Plan";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "repo", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "paths", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 5, name: "excludes", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 9, name: "iexcludes", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 6, name: "cron", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "retention", kind: "message", T: RetentionPolicy },
+    { no: 8, name: "hooks", kind: "message", T: Hook, repeated: true },
+    { no: 10, name: "host", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 11, name: "group_by", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 12, name: "timeouts", kind: "message", T: TaskTimeouts },
+    { no: 13, name: "preconditions", kind: "message", T: Preconditions },
+    { no: 14, name: "resource_limits", kind: "message", T: ResourceLimits },
+    { no: 15, name: "restore_drill", kind: "message", T: RestoreDrill },
+    { no: 16, name: "extra_flags", kind: "message", T: ResticFlag, repeated: true },
+    { no: 17, name: "env", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 18, name: "one_file_system", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 19, name: "path_options", kind: "message", T: PathOptions, repeated: true },
+    { no: 20, name: "dependencies", kind: "message", T: PlanDependencies },
+    { no: 21, name: "schedule_jitter_minutes", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 22, name: "verify_sample_files", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 23, name: "anomaly_detection", kind: "message", T: AnomalyDetection },
+    { no: 24, name: "timezone", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 25, name: "webhook_trigger", kind: "message", T: WebhookTrigger },
+    { no: 26, name: "additional_repos", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Plan {
+    return new Plan().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Plan {
+    return new Plan().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Plan {
+    return new Plan().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Plan | PlainMessage<Plan> | undefined, b: Plan | PlainMessage<Plan> | undefined): boolean {
+    return proto3.util.equals(Plan, a, b);
+  }
+}
+
+/**
+ * WebhookTrigger lets external systems e.g. CI pipelines or home automation trigger a plan's backup by POSTing to
+ * /api/trigger/<plan id> with the token as a bearer token or the token query parameter.
+ *
+ * @generated from message v1.WebhookTrigger
+ */
+export class WebhookTrigger extends Message<WebhookTrigger> {
+  /**
+   * at least 16 characters, requests without it are rejected.
+   *
+   * @generated from field: string token = 1;
+   */
+  token = "";
+
+  constructor(data?: PartialMessage<WebhookTrigger>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.WebhookTrigger";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WebhookTrigger {
+    return new WebhookTrigger().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WebhookTrigger {
+    return new WebhookTrigger().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WebhookTrigger {
+    return new WebhookTrigger().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: WebhookTrigger | PlainMessage<WebhookTrigger> | undefined, b: WebhookTrigger | PlainMessage<WebhookTrigger> | undefined): boolean {
+    return proto3.util.equals(WebhookTrigger, a, b);
+  }
+}
+
+/**
+ * AnomalyDetection compares each backup with the baseline of the plan's previous backups, backups adding far more
+ * data or changing most existing files, as ransomware encrypting files does, are flagged as anomalous and run the
+ * CONDITION_BACKUP_ANOMALY hooks.
+ *
+ * @generated from message v1.AnomalyDetection
+ */
+export class AnomalyDetection extends Message<AnomalyDetection> {
+  /**
+   * @generated from field: bool enabled = 1;
+   */
+  enabled = false;
+
+  /**
+   * backups adding this many times the baseline's data are anomalous, defaults to 100.
+   *
+   * @generated from field: double data_added_factor = 2;
+   */
+  dataAddedFactor = 0;
+
+  /**
+   * backups changing this percentage of existing files are anomalous unless the baseline does too, defaults to 90.
+   *
+   * @generated from field: int32 changed_files_percent = 3;
+   */
+  changedFilesPercent = 0;
+
+  /**
+   * number of previous backups the baseline is the median of, defaults to 10. At least 3 are required.
+   *
+   * @generated from field: int32 baseline_backups = 4;
+   */
+  baselineBackups = 0;
+
+  /**
+   * backups adding less data are never anomalous for the data they add, defaults to 100 MB.
+   *
+   * @generated from field: int64 min_data_added_bytes = 5;
+   */
+  minDataAddedBytes = protoInt64.zero;
+
+  constructor(data?: PartialMessage<AnomalyDetection>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.AnomalyDetection";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "enabled", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "data_added_factor", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 3, name: "changed_files_percent", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "baseline_backups", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "min_data_added_bytes", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AnomalyDetection {
+    return new AnomalyDetection().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AnomalyDetection {
+    return new AnomalyDetection().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AnomalyDetection {
+    return new AnomalyDetection().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AnomalyDetection | PlainMessage<AnomalyDetection> | undefined, b: AnomalyDetection | PlainMessage<AnomalyDetection> | undefined): boolean {
+    return proto3.util.equals(AnomalyDetection, a, b);
+  }
+}
+
+/**
+ * PlanDependencies chain a plan's backups to other plans, e.g. copying to an offsite repo after a local backup.
+ *
+ * @generated from message v1.PlanDependencies
+ */
+export class PlanDependencies extends Message<PlanDependencies> {
+  /**
+   * plans whose successful backups are each followed by a backup of this plan, the plan's cron may be left empty to only back up after them.
+   *
+   * @generated from field: repeated string after = 1;
+   */
+  after: string[] = [];
+
+  /**
+   * plans whose latest backup must have succeeded for this plan's scheduled backups and restore drills to run.
+   *
+   * @generated from field: repeated string requires = 2;
+   */
+  requires: string[] = [];
+
+  constructor(data?: PartialMessage<PlanDependencies>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.PlanDependencies";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "after", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 2, name: "requires", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PlanDependencies {
+    return new PlanDependencies().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PlanDependencies {
+    return new PlanDependencies().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PlanDependencies {
+    return new PlanDependencies().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PlanDependencies | PlainMessage<PlanDependencies> | undefined, b: PlanDependencies | PlainMessage<PlanDependencies> | undefined): boolean {
+    return proto3.util.equals(PlanDependencies, a, b);
+  }
+}
+
+/**
+ * PathOptions controls how file systems mounted beneath one of a plan's paths are backed up (linux only).
+ *
+ * @generated from message v1.PathOptions
+ */
+export class PathOptions extends Message<PathOptions> {
+  /**
+   * one of the plan's paths.
+   *
+   * @generated from field: string path = 1;
+   */
+  path = "";
+
+  /**
+   * exclude the file systems mounted beneath the path.
+   *
+   * @generated from field: bool one_file_system = 2;
+   */
+  oneFileSystem = false;
+
+  /**
+   * mountpoints beneath the path that are backed up even when not crossing file systems.
+   *
+   * @generated from field: repeated string include_mounts = 3;
+   */
+  includeMounts: string[] = [];
+
+  constructor(data?: PartialMessage<PathOptions>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.PathOptions";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "one_file_system", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "include_mounts", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PathOptions {
+    return new PathOptions().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PathOptions {
+    return new PathOptions().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PathOptions {
+    return new PathOptions().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PathOptions | PlainMessage<PathOptions> | undefined, b: PathOptions | PlainMessage<PathOptions> | undefined): boolean {
+    return proto3.util.equals(PathOptions, a, b);
+  }
+}
+
+/**
+ * RestoreDrill periodically restores part of a plan's latest snapshot to a temporary directory and verifies it.
+ *
+ * @generated from message v1.RestoreDrill
+ */
+export class RestoreDrill extends Message<RestoreDrill> {
+  /**
+   * cron expression describing the drill schedule.
+   *
+   * @generated from field: string cron = 1;
+   */
+  cron = "";
+
+  /**
+   * number of randomly chosen files to restore, defaults to 10.
+   *
+   * @generated from field: int32 sample_files = 2;
+   */
+  sampleFiles = 0;
+
+  /**
+   * optional, restore this path of the snapshot instead of a random sample.
+   *
+   * @generated from field: string path = 3;
+   */
+  path = "";
+
+  constructor(data?: PartialMessage<RestoreDrill>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.RestoreDrill";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "cron", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "sample_files", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RestoreDrill {
+    return new RestoreDrill().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RestoreDrill {
+    return new RestoreDrill().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RestoreDrill {
+    return new RestoreDrill().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RestoreDrill | PlainMessage<RestoreDrill> | undefined, b: RestoreDrill | PlainMessage<RestoreDrill> | undefined): boolean {
+    return proto3.util.equals(RestoreDrill, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.ResourceLimits
+ */
+export class ResourceLimits extends Message<ResourceLimits> {
+  /**
+   * CPU scheduling priority from -20 (highest) to 19 (lowest), 0 leaves it unchanged. Negative values require root.
+   *
+   * @generated from field: int32 nice = 1;
+   */
+  nice = 0;
+
+  /**
+   * IO scheduling class (linux ionice).
+   *
+   * @generated from field: v1.ResourceLimits.IoClass io_class = 2;
+   */
+  ioClass = ResourceLimits_IoClass.UNCHANGED;
+
+  /**
+   * IO priority within the best effort or realtime class from 0 (highest) to 7 (lowest).
+   *
+   * @generated from field: int32 io_priority = 3;
+   */
+  ioPriority = 0;
+
+  /**
+   * max number of CPUs restic uses at once, 0 for no limit.
+   *
+   * @generated from field: int32 gomaxprocs = 4;
+   */
+  gomaxprocs = 0;
+
+  /**
+   * cgroup memory limit e.g. "2G" (linux with systemd).
+   *
+   * @generated from field: string memory_max = 5;
+   */
+  memoryMax = "";
+
+  /**
+   * cgroup IO weight from 1 to 10000, the default weight is 100 (linux with systemd).
+   *
+   * @generated from field: int32 io_weight = 6;
+   */
+  ioWeight = 0;
+
+  constructor(data?: PartialMessage<ResourceLimits>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.ResourceLimits";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "nice", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "io_class", kind: "enum", T: proto3.getEnumType(ResourceLimits_IoClass) },
+    { no: 3, name: "io_priority", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "gomaxprocs", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "memory_max", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "io_weight", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ResourceLimits {
+    return new ResourceLimits().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ResourceLimits {
+    return new ResourceLimits().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ResourceLimits {
+    return new ResourceLimits().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ResourceLimits | PlainMessage<ResourceLimits> | undefined, b: ResourceLimits | PlainMessage<ResourceLimits> | undefined): boolean {
+    return proto3.util.equals(ResourceLimits, a, b);
+  }
+}
+
+/**
+ * @generated from enum v1.ResourceLimits.IoClass
+ */
+export enum ResourceLimits_IoClass {
+  /**
+   * @generated from enum value: IO_CLASS_UNCHANGED = 0;
+   */
+  UNCHANGED = 0,
+
+  /**
+   * @generated from enum value: IO_CLASS_REALTIME = 1;
+   */
+  REALTIME = 1,
+
+  /**
+   * @generated from enum value: IO_CLASS_BEST_EFFORT = 2;
+   */
+  BEST_EFFORT = 2,
+
+  /**
+   * @generated from enum value: IO_CLASS_IDLE = 3;
+   */
+  IDLE = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(ResourceLimits_IoClass)
+proto3.util.setEnumType(ResourceLimits_IoClass, "v1.ResourceLimits.IoClass", [
+  { no: 0, name: "IO_CLASS_UNCHANGED" },
+  { no: 1, name: "IO_CLASS_REALTIME" },
+  { no: 2, name: "IO_CLASS_BEST_EFFORT" },
+  { no: 3, name: "IO_CLASS_IDLE" },
+]);
+
+/**
+ * @generated from message v1.Preconditions
+ */
+export class Preconditions extends Message<Preconditions> {
+  /**
+   * only run while the host is not running on battery.
+   *
+   * @generated from field: bool on_ac_power = 1;
+   */
+  onAcPower = false;
+
+  /**
+   * only run while no active connection is metered (linux with NetworkManager).
+   *
+   * @generated from field: bool unmetered_network = 2;
+   */
+  unmeteredNetwork = false;
+
+  /**
+   * only run while connected to one of these Wi-Fi networks.
+   *
+   * @generated from field: repeated string wifi_ssids = 3;
+   */
+  wifiSsids: string[] = [];
+
+  /**
+   * only run while each of these host:port addresses accepts TCP connections, the port defaults to 443.
+   *
+   * @generated from field: repeated string reachable_hosts = 4;
+   */
+  reachableHosts: string[] = [];
+
+  /**
+   * how long to wait before checking again when a precondition is unmet, defaults to 15.
+   *
+   * @generated from field: int32 defer_minutes = 5;
+   */
+  deferMinutes = 0;
+
+  constructor(data?: PartialMessage<Preconditions>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.Preconditions";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "on_ac_power", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "unmetered_network", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "wifi_ssids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 4, name: "reachable_hosts", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 5, name: "defer_minutes", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Preconditions {
+    return new Preconditions().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Preconditions {
+    return new Preconditions().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Preconditions {
+    return new Preconditions().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Preconditions | PlainMessage<Preconditions> | undefined, b: Preconditions | PlainMessage<Preconditions> | undefined): boolean {
+    return proto3.util.equals(Preconditions, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.TaskTimeouts
+ */
+export class TaskTimeouts extends Message<TaskTimeouts> {
+  /**
+   * backups running longer are interrupted and marked as timed out, 0 for no limit.
+   *
+   * @generated from field: int32 backup_minutes = 1;
+   */
+  backupMinutes = 0;
+
+  /**
+   * prunes running longer are interrupted and marked as timed out, 0 for no limit.
+   *
+   * @generated from field: int32 prune_minutes = 2;
+   */
+  pruneMinutes = 0;
+
+  /**
+   * number of times a timed out task is retried.
+   *
+   * @generated from field: int32 retries = 3;
+   */
+  retries = 0;
+
+  /**
+   * delay before retrying a timed out task.
+   *
+   * @generated from field: int32 retry_delay_minutes = 4;
+   */
+  retryDelayMinutes = 0;
+
+  /**
+   * optional, failures of these classes are retried like timeouts e.g. ERROR_CLASS_NETWORK.
+   *
+   * @generated from field: repeated v1.ErrorClass retry_on = 5;
+   */
+  retryOn: ErrorClass[] = [];
+
+  constructor(data?: PartialMessage<TaskTimeouts>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.TaskTimeouts";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "backup_minutes", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "prune_minutes", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "retries", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "retry_delay_minutes", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "retry_on", kind: "enum", T: proto3.getEnumType(ErrorClass), repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TaskTimeouts {
+    return new TaskTimeouts().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TaskTimeouts {
+    return new TaskTimeouts().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TaskTimeouts {
+    return new TaskTimeouts().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TaskTimeouts | PlainMessage<TaskTimeouts> | undefined, b: TaskTimeouts | PlainMessage<TaskTimeouts> | undefined): boolean {
+    return proto3.util.equals(TaskTimeouts, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.RetentionPolicy
+ */
+export class RetentionPolicy extends Message<RetentionPolicy> {
+  /**
+   * @generated from field: string max_unused_limit = 1 [deprecated = true];
+   * @deprecated
+   */
+  maxUnusedLimit = "";
+
+  /**
+   * @generated from field: int32 keep_last_n = 2 [deprecated = true];
+   * @deprecated
+   */
+  keepLastN = 0;
+
+  /**
+   * @generated from field: int32 keep_hourly = 3 [deprecated = true];
+   * @deprecated
+   */
+  keepHourly = 0;
+
+  /**
+   * @generated from field: int32 keep_daily = 4 [deprecated = true];
+   * @deprecated
+   */
+  keepDaily = 0;
+
+  /**
+   * @generated from field: int32 keep_weekly = 5 [deprecated = true];
+   * @deprecated
+   */
+  keepWeekly = 0;
+
+  /**
+   * @generated from field: int32 keep_monthly = 6 [deprecated = true];
+   * @deprecated
+   */
+  keepMonthly = 0;
+
+  /**
+   * @generated from field: int32 keep_yearly = 7 [deprecated = true];
+   * @deprecated
+   */
+  keepYearly = 0;
+
+  /**
+   * keep snapshots within a duration e.g. 1y2m3d4h5m6s
+   *
+   * @generated from field: string keep_within_duration = 8 [deprecated = true];
+   * @deprecated
+   */
+  keepWithinDuration = "";
+
+  /**
+   * @generated from oneof v1.RetentionPolicy.policy
+   */
+  policy: {
+    /**
+     * @generated from field: int32 policy_keep_last_n = 10;
+     */
+    value: number;
+    case: "policyKeepLastN";
+  } | {
+    /**
+     * @generated from field: v1.RetentionPolicy.TimeBucketedCounts policy_time_bucketed = 11;
+     */
+    value: RetentionPolicy_TimeBucketedCounts;
+    case: "policyTimeBucketed";
+  } | {
+    /**
+     * @generated from field: bool policy_keep_all = 12;
+     */
+    value: boolean;
+    case: "policyKeepAll";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  constructor(data?: PartialMessage<RetentionPolicy>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.RetentionPolicy";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "max_unused_limit", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "keep_last_n", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "keep_hourly", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "keep_daily", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "keep_weekly", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "keep_monthly", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 7, name: "keep_yearly", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 8, name: "keep_within_duration", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 10, name: "policy_keep_last_n", kind: "scalar", T: 5 /* ScalarType.INT32 */, oneof: "policy" },
+    { no: 11, name: "policy_time_bucketed", kind: "message", T: RetentionPolicy_TimeBucketedCounts, oneof: "policy" },
+    { no: 12, name: "policy_keep_all", kind: "scalar", T: 8 /* ScalarType.BOOL */, oneof: "policy" },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RetentionPolicy {
+    return new RetentionPolicy().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RetentionPolicy {
+    return new RetentionPolicy().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RetentionPolicy {
+    return new RetentionPolicy().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RetentionPolicy | PlainMessage<RetentionPolicy> | undefined, b: RetentionPolicy | PlainMessage<RetentionPolicy> | undefined): boolean {
+    return proto3.util.equals(RetentionPolicy, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.RetentionPolicy.TimeBucketedCounts
+ */
+export class RetentionPolicy_TimeBucketedCounts extends Message<RetentionPolicy_TimeBucketedCounts> {
+  /**
+   * keep the last n hourly snapshots.
+   *
+   * @generated from field: int32 hourly = 1;
+   */
+  hourly = 0;
+
+  /**
+   * keep the last n daily snapshots.
+   *
+   * @generated from field: int32 daily = 2;
+   */
+  daily = 0;
+
+  /**
+   * keep the last n weekly snapshots.
+   *
+   * @generated from field: int32 weekly = 3;
+   */
+  weekly = 0;
+
+  /**
+   * keep the last n monthly snapshots.
+   *
+   * @generated from field: int32 monthly = 4;
+   */
+  monthly = 0;
+
+  /**
+   * keep the last n yearly snapshots.
+   *
+   * @generated from field: int32 yearly = 5;
+   */
+  yearly = 0;
+
+  constructor(data?: PartialMessage<RetentionPolicy_TimeBucketedCounts>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.RetentionPolicy.TimeBucketedCounts";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "hourly", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "daily", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "weekly", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "monthly", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "yearly", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RetentionPolicy_TimeBucketedCounts {
+    return new RetentionPolicy_TimeBucketedCounts().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RetentionPolicy_TimeBucketedCounts {
+    return new RetentionPolicy_TimeBucketedCounts().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RetentionPolicy_TimeBucketedCounts {
+    return new RetentionPolicy_TimeBucketedCounts().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RetentionPolicy_TimeBucketedCounts | PlainMessage<RetentionPolicy_TimeBucketedCounts> | undefined, b: RetentionPolicy_TimeBucketedCounts | PlainMessage<RetentionPolicy_TimeBucketedCounts> | undefined): boolean {
+    return proto3.util.equals(RetentionPolicy_TimeBucketedCounts, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.PrunePolicy
+ */
+export class PrunePolicy extends Message<PrunePolicy> {
+  /**
+   * max frequency of prune runs in days. If 0, prune will be run on every backup.
+   *
+   * @generated from field: int32 max_frequency_days = 1;
+   */
+  maxFrequencyDays = 0;
+
+  /**
+   * max percentage of repo size that can be unused before prune is run.
+   *
+   * @generated from field: int32 max_unused_percent = 100;
+   */
+  maxUnusedPercent = 0;
+
+  /**
+   * max number of bytes that can be unused before prune is run.
+   *
+   * @generated from field: int32 max_unused_bytes = 101;
+   */
+  maxUnusedBytes = 0;
+
+  /**
+   * if set, the prune following a forget only runs if a dry run estimates it will reclaim at least this many bytes.
+   *
+   * @generated from field: int64 min_reclaimable_bytes = 102;
+   */
+  minReclaimableBytes = protoInt64.zero;
+
+  constructor(data?: PartialMessage<PrunePolicy>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.PrunePolicy";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "max_frequency_days", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 100, name: "max_unused_percent", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 101, name: "max_unused_bytes", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 102, name: "min_reclaimable_bytes", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PrunePolicy {
+    return new PrunePolicy().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PrunePolicy {
+    return new PrunePolicy().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PrunePolicy {
+    return new PrunePolicy().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PrunePolicy | PlainMessage<PrunePolicy> | undefined, b: PrunePolicy | PlainMessage<PrunePolicy> | undefined): boolean {
+    return proto3.util.equals(PrunePolicy, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.Hook
+ */
+export class Hook extends Message<Hook> {
+  /**
+   * @generated from field: repeated v1.Hook.Condition conditions = 1;
+   */
+  conditions: Hook_Condition[] = [];
+
+  /**
+   * the hook is stopped and fails after this long, defaults to 10 minutes.
+   *
+   * @generated from field: int32 timeout_seconds = 2;
+   */
+  timeoutSeconds = 0;
+
+  /**
+   * optional expression over the hook's variables, the hook only runs if it is true e.g. `error contains "timeout" && plan == "media"`.
+   *
+   * @generated from field: string condition_expr = 3;
+   */
+  conditionExpr = "";
+
+  /**
+   * @generated from oneof v1.Hook.action
+   */
+  action: {
+    /**
+     * @generated from field: v1.Hook.Command action_command = 100;
+     */
+    value: Hook_Command;
+    case: "actionCommand";
+  } | {
+    /**
+     * @generated from field: v1.Hook.Webhook action_webhook = 101;
+     */
+    value: Hook_Webhook;
+    case: "actionWebhook";
+  } | {
+    /**
+     * @generated from field: v1.Hook.Discord action_discord = 102;
+     */
+    value: Hook_Discord;
+    case: "actionDiscord";
+  } | {
+    /**
+     * @generated from field: v1.Hook.Gotify action_gotify = 103;
+     */
+    value: Hook_Gotify;
+    case: "actionGotify";
+  } | {
+    /**
+     * @generated from field: v1.Hook.Slack action_slack = 104;
+     */
+    value: Hook_Slack;
+    case: "actionSlack";
+  } | {
+    /**
+     * @generated from field: v1.Hook.Plugin action_plugin = 105;
+     */
+    value: Hook_Plugin;
+    case: "actionPlugin";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  constructor(data?: PartialMessage<Hook>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.Hook";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "conditions", kind: "enum", T: proto3.getEnumType(Hook_Condition), repeated: true },
+    { no: 2, name: "timeout_seconds", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "condition_expr", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 100, name: "action_command", kind: "message", T: Hook_Command, oneof: "action" },
+    { no: 101, name: "action_webhook", kind: "message", T: Hook_Webhook, oneof: "action" },
+    { no: 102, name: "action_discord", kind: "message", T: Hook_Discord, oneof: "action" },
+    { no: 103, name: "action_gotify", kind: "message", T: Hook_Gotify, oneof: "action" },
+    { no: 104, name: "action_slack", kind: "message", T: Hook_Slack, oneof: "action" },
+    { no: 105, name: "action_plugin", kind: "message", T: Hook_Plugin, oneof: "action" },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Hook {
+    return new Hook().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Hook {
+    return new Hook().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Hook {
+    return new Hook().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Hook | PlainMessage<Hook> | undefined, b: Hook | PlainMessage<Hook> | undefined): boolean {
+    return proto3.util.equals(Hook, a, b);
+  }
+}
+
+/**
+ * @generated from enum v1.Hook.Condition
+ */
+export enum Hook_Condition {
+  /**
+   * @generated from enum value: CONDITION_UNKNOWN = 0;
+   */
+  UNKNOWN = 0,
+
+  /**
+   * error running any operation.
+   *
+   * @generated from enum value: CONDITION_ANY_ERROR = 1;
+   */
+  ANY_ERROR = 1,
+
+  /**
+   * backup started.
+   *
+   * @generated from enum value: CONDITION_SNAPSHOT_START = 2;
+   */
+  SNAPSHOT_START = 2,
+
+  /**
+   * backup completed (success or fail).
+   *
+   * @generated from enum value: CONDITION_SNAPSHOT_END = 3;
+   */
+  SNAPSHOT_END = 3,
+
+  /**
+   * snapshot failed.
+   *
+   * @generated from enum value: CONDITION_SNAPSHOT_ERROR = 4;
+   */
+  SNAPSHOT_ERROR = 4,
+
+  /**
+   * a task exceeded the plan's timeout.
+   *
+   * @generated from enum value: CONDITION_TIMEOUT = 5;
+   */
+  TIMEOUT = 5,
+
+  /**
+   * a restore drill failed or found mismatched files.
+   *
+   * @generated from enum value: CONDITION_RESTORE_DRILL_ERROR = 6;
+   */
+  RESTORE_DRILL_ERROR = 6,
+
+  /**
+   * a path a task writes to is below the repo's disk space warning threshold.
+   *
+   * @generated from enum value: CONDITION_LOW_DISK_SPACE = 7;
+   */
+  LOW_DISK_SPACE = 7,
+
+  /**
+   * a backup deviated wildly from the plan's baseline, see AnomalyDetection.
+   *
+   * @generated from enum value: CONDITION_BACKUP_ANOMALY = 8;
+   */
+  BACKUP_ANOMALY = 8,
+}
+// Retrieve enum metadata with: proto3.getEnumType(Hook_Condition)
+proto3.util.setEnumType(Hook_Condition, "v1.Hook.Condition", [
+  { no: 0, name: "CONDITION_UNKNOWN" },
+  { no: 1, name: "CONDITION_ANY_ERROR" },
+  { no: 2, name: "CONDITION_SNAPSHOT_START" },
+  { no: 3, name: "CONDITION_SNAPSHOT_END" },
+  { no: 4, name: "CONDITION_SNAPSHOT_ERROR" },
+  { no: 5, name: "CONDITION_TIMEOUT" },
+  { no: 6, name: "CONDITION_RESTORE_DRILL_ERROR" },
+  { no: 7, name: "CONDITION_LOW_DISK_SPACE" },
+  { no: 8, name: "CONDITION_BACKUP_ANOMALY" },
+]);
+
+/**
+ * @generated from message v1.Hook.Command
+ */
+export class Hook_Command extends Message<Hook_Command> {
+  /**
+   * @generated from field: string command = 1;
+   */
+  command = "";
+
+  /**
+   * directory the command runs in, defaults to backrest's working directory.
+   *
+   * @generated from field: string working_dir = 2;
+   */
+  workingDir = "";
+
+  /**
+   * names of the environment variables passed to the command from backrest's environment, all are passed if empty.
+   *
+   * @generated from field: repeated string env_allowlist = 3;
+   */
+  envAllowlist: string[] = [];
+
+  /**
+   * optional, name or uid of the user to run the command as, unix only and requires running as root.
+   *
+   * @generated from field: string user = 4;
+   */
+  user = "";
+
+  constructor(data?: PartialMessage<Hook_Command>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.Hook.Command";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "command", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "working_dir", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "env_allowlist", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 4, name: "user", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Hook_Command {
+    return new Hook_Command().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Hook_Command {
+    return new Hook_Command().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Hook_Command {
+    return new Hook_Command().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Hook_Command | PlainMessage<Hook_Command> | undefined, b: Hook_Command | PlainMessage<Hook_Command> | undefined): boolean {
+    return proto3.util.equals(Hook_Command, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.Hook.Webhook
+ */
+export class Hook_Webhook extends Message<Hook_Webhook> {
+  /**
+   * @generated from field: string webhook_url = 1;
+   */
+  webhookUrl = "";
+
+  constructor(data?: PartialMessage<Hook_Webhook>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.Hook.Webhook";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "webhook_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Hook_Webhook {
+    return new Hook_Webhook().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Hook_Webhook {
+    return new Hook_Webhook().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Hook_Webhook {
+    return new Hook_Webhook().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Hook_Webhook | PlainMessage<Hook_Webhook> | undefined, b: Hook_Webhook | PlainMessage<Hook_Webhook> | undefined): boolean {
+    return proto3.util.equals(Hook_Webhook, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.Hook.Discord
+ */
+export class Hook_Discord extends Message<Hook_Discord> {
+  /**
+   * @generated from field: string webhook_url = 1;
+   */
+  webhookUrl = "";
+
+  /**
+   * template for the webhook payload.
+   *
+   * @generated from field: string template = 2;
+   */
+  template = "";
+
+  constructor(data?: PartialMessage<Hook_Discord>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.Hook.Discord";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "webhook_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "template", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Hook_Discord {
+    return new Hook_Discord().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Hook_Discord {
+    return new Hook_Discord().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Hook_Discord {
+    return new Hook_Discord().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Hook_Discord | PlainMessage<Hook_Discord> | undefined, b: Hook_Discord | PlainMessage<Hook_Discord> | undefined): boolean {
+    return proto3.util.equals(Hook_Discord, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.Hook.Gotify
+ */
+export class Hook_Gotify extends Message<Hook_Gotify> {
+  /**
+   * @generated from field: string base_url = 1;
+   */
+  baseUrl = "";
+
+  /**
+   * @generated from field: string token = 3;
+   */
+  token = "";
+
+  /**
+   * template for the webhook payload.
+   *
+   * @generated from field: string template = 100;
+   */
+  template = "";
+
+  /**
+   * template for the webhook title.
+   *
+   * @generated from field: string title_template = 101;
+   */
+  titleTemplate = "";
+
+  constructor(data?: PartialMessage<Hook_Gotify>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.Hook.Gotify";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "base_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 100, name: "template", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 101, name: "title_template", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RetentionPolicy {
-    return new RetentionPolicy().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Hook_Gotify {
+    return new Hook_Gotify().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RetentionPolicy {
-    return new RetentionPolicy().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Hook_Gotify {
+    return new Hook_Gotify().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RetentionPolicy {
-    return new RetentionPolicy().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Hook_Gotify {
+    return new Hook_Gotify().fromJsonString(jsonString, options);
   }
 
-  static equals(a: RetentionPolicy | PlainMessage<RetentionPolicy> | undefined, b: RetentionPolicy | PlainMessage<RetentionPolicy> | undefined): boolean {
-    return proto3.util.equals(RetentionPolicy, a, b);
+  static equals(a: Hook_Gotify | PlainMessage<Hook_Gotify> | undefined, b: Hook_Gotify | PlainMessage<Hook_Gotify> | undefined): boolean {
+    return proto3.util.equals(Hook_Gotify, a, b);
   }
 }
 
 /**
- * @generated from message v1.RetentionPolicy.TimeBucketedCounts
+ * @generated from message v1.Hook.Slack
  */
-export class RetentionPolicy_TimeBucketedCounts extends Message<RetentionPolicy_TimeBucketedCounts> {
+export class Hook_Slack extends Message<Hook_Slack> {
   /**
-   * keep the last n hourly snapshots.
-   *
-   * @generated from field: int32 hourly = 1;
+   * @generated from field: string webhook_url = 1;
    */
-  hourly = 0;
+  webhookUrl = "";
 
   /**
-   * keep the last n daily snapshots.
+   * template for the webhook payload.
    *
-   * @generated from field: int32 daily = 2;
+   * @generated from field: string template = 2;
    */
-  daily = 0;
+  template = "";
 
-  /**
-   * keep the last n weekly snapshots.
-   *
-   * @generated from field: int32 weekly = 3;
-   */
-  weekly = 0;
+  constructor(data?: PartialMessage<Hook_Slack>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.Hook.Slack";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "webhook_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "template", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Hook_Slack {
+    return new Hook_Slack().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Hook_Slack {
+    return new Hook_Slack().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Hook_Slack {
+    return new Hook_Slack().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Hook_Slack | PlainMessage<Hook_Slack> | undefined, b: Hook_Slack | PlainMessage<Hook_Slack> | undefined): boolean {
+    return proto3.util.equals(Hook_Slack, a, b);
+  }
+}
 
+/**
+ * Plugin runs an external plugin found in backrest's plugins directory, see BACKREST_PLUGINS_DIR.
+ *
+ * @generated from message v1.Hook.Plugin
+ */
+export class Hook_Plugin extends Message<Hook_Plugin> {
   /**
-   * keep the last n monthly snapshots.
+   * name of the plugin's executable, without extension.
    *
-   * @generated from field: int32 monthly = 4;
+   * @generated from field: string name = 1;
    */
-  monthly = 0;
+  name = "";
 
   /**
-   * keep the last n yearly snapshots.
+   * passed to the plugin e.g. an API key or routing key.
    *
-   * @generated from field: int32 yearly = 5;
+   * @generated from field: map<string, string> settings = 2;
    */
-  yearly = 0;
+  settings: { [key: string]: string } = {};
 
-  constructor(data?: PartialMessage<RetentionPolicy_TimeBucketedCounts>) {
+  constructor(data?: PartialMessage<Hook_Plugin>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "v1.RetentionPolicy.TimeBucketedCounts";
+  static readonly typeName = "v1.Hook.Plugin";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "hourly", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
-    { no: 2, name: "daily", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
-    { no: 3, name: "weekly", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
-    { no: 4, name: "monthly", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
-    { no: 5, name: "yearly", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "settings", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RetentionPolicy_TimeBucketedCounts {
-    return new RetentionPolicy_TimeBucketedCounts().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Hook_Plugin {
+    return new Hook_Plugin().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RetentionPolicy_TimeBucketedCounts {
-    return new RetentionPolicy_TimeBucketedCounts().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Hook_Plugin {
+    return new Hook_Plugin().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RetentionPolicy_TimeBucketedCounts {
-    return new RetentionPolicy_TimeBucketedCounts().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Hook_Plugin {
+    return new Hook_Plugin().fromJsonString(jsonString, options);
   }
 
-  static equals(a: RetentionPolicy_TimeBucketedCounts | PlainMessage<RetentionPolicy_TimeBucketedCounts> | undefined, b: RetentionPolicy_TimeBucketedCounts | PlainMessage<RetentionPolicy_TimeBucketedCounts> | undefined): boolean {
-    return proto3.util.equals(RetentionPolicy_TimeBucketedCounts, a, b);
+  static equals(a: Hook_Plugin | PlainMessage<Hook_Plugin> | undefined, b: Hook_Plugin | PlainMessage<Hook_Plugin> | undefined): boolean {
+    return proto3.util.equals(Hook_Plugin, a, b);
   }
 }
 
 /**
- * @generated from message v1.PrunePolicy
+ * SelfBackup configures periodic backups of backrest's config and operation log to one of the configured repos.
+ *
+ * @generated from message v1.SelfBackup
  */
-export class PrunePolicy extends Message<PrunePolicy> {
+export class SelfBackup extends Message<SelfBackup> {
   /**
-   * max frequency of prune runs in days. If 0, prune will be run on every backup.
+   * ID of the repo to store backrest's state in. Self backup is disabled if empty.
    *
-   * @generated from field: int32 max_frequency_days = 1;
+   * @generated from field: string repo = 1;
    */
-  maxFrequencyDays = 0;
+  repo = "";
 
   /**
-   * max percentage of repo size that can be unused before prune is run.
+   * cron expression describing the backup schedule, defaults to daily.
    *
-   * @generated from field: int32 max_unused_percent = 100;
+   * @generated from field: string cron = 2;
    */
-  maxUnusedPercent = 0;
+  cron = "";
 
   /**
-   * max number of bytes that can be unused before prune is run.
+   * retention policy for state snapshots, defaults to keeping the last 30.
    *
-   * @generated from field: int32 max_unused_bytes = 101;
+   * @generated from field: v1.RetentionPolicy retention = 3;
    */
-  maxUnusedBytes = 0;
+  retention?: RetentionPolicy;
 
-  constructor(data?: PartialMessage<PrunePolicy>) {
+  constructor(data?: PartialMessage<SelfBackup>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "v1.PrunePolicy";
+  static readonly typeName = "v1.SelfBackup";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "max_frequency_days", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
-    { no: 100, name: "max_unused_percent", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
-    { no: 101, name: "max_unused_bytes", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 1, name: "repo", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "cron", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "retention", kind: "message", T: RetentionPolicy },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PrunePolicy {
-    return new PrunePolicy().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SelfBackup {
+    return new SelfBackup().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PrunePolicy {
-    return new PrunePolicy().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SelfBackup {
+    return new SelfBackup().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PrunePolicy {
-    return new PrunePolicy().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SelfBackup {
+    return new SelfBackup().fromJsonString(jsonString, options);
   }
 
-  static equals(a: PrunePolicy | PlainMessage<PrunePolicy> | undefined, b: PrunePolicy | PlainMessage<PrunePolicy> | undefined): boolean {
-    return proto3.util.equals(PrunePolicy, a, b);
+  static equals(a: SelfBackup | PlainMessage<SelfBackup> | undefined, b: SelfBackup | PlainMessage<SelfBackup> | undefined): boolean {
+    return proto3.util.equals(SelfBackup, a, b);
   }
 }
 
 /**
- * @generated from message v1.Hook
+ * Tls configures TLS for the API and UI listener using either certificate files or ACME issued certificates.
+ *
+ * @generated from message v1.Tls
  */
-export class Hook extends Message<Hook> {
+export class Tls extends Message<Tls> {
   /**
-   * @generated from field: repeated v1.Hook.Condition conditions = 1;
+   * PEM encoded certificate chain, reloaded when the file changes.
+   *
+   * @generated from field: string cert_file = 1;
    */
-  conditions: Hook_Condition[] = [];
+  certFile = "";
 
   /**
-   * @generated from oneof v1.Hook.action
+   * PEM encoded private key for cert_file.
+   *
+   * @generated from field: string key_file = 2;
    */
-  action: {
-    /**
-     * @generated from field: v1.Hook.Command action_command = 100;
-     */
-    value: Hook_Command;
-    case: "actionCommand";
-  } | {
-    /**
-     * @generated from field: v1.Hook.Webhook action_webhook = 101;
-     */
-    value: Hook_Webhook;
-    case: "actionWebhook";
-  } | {
-    /**
-     * @generated from field: v1.Hook.Discord action_discord = 102;
-     */
-    value: Hook_Discord;
-    case: "actionDiscord";
-  } | {
-    /**
-     * @generated from field: v1.Hook.Gotify action_gotify = 103;
-     */
-    value: Hook_Gotify;
-    case: "actionGotify";
-  } | {
-    /**
-     * @generated from field: v1.Hook.Slack action_slack = 104;
-     */
-    value: Hook_Slack;
-    case: "actionSlack";
-  } | { case: undefined; value?: undefined } = { case: undefined };
+  keyFile = "";
 
-  constructor(data?: PartialMessage<Hook>) {
+  /**
+   * obtain certificates automatically, mutually exclusive with cert_file.
+   *
+   * @generated from field: v1.Acme acme = 3;
+   */
+  acme?: Acme;
+
+  /**
+   * optional PEM encoded CAs to verify client certificates against, verified certificates authenticate as the user named by their common name.
+   *
+   * @generated from field: string client_ca_file = 4;
+   */
+  clientCaFile = "";
+
+  /**
+   * reject connections without a certificate signed by client_ca_file.
+   *
+   * @generated from field: bool require_client_cert = 5;
+   */
+  requireClientCert = false;
+
+  constructor(data?: PartialMessage<Tls>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "v1.Hook";
+  static readonly typeName = "v1.Tls";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "conditions", kind: "enum", T: proto3.getEnumType(Hook_Condition), repeated: true },
-    { no: 100, name: "action_command", kind: "message", T: Hook_Command, oneof: "action" },
-    { no: 101, name: "action_webhook", kind: "message", T: Hook_Webhook, oneof: "action" },
-    { no: 102, name: "action_discord", kind: "message", T: Hook_Discord, oneof: "action" },
-    { no: 103, name: "action_gotify", kind: "message", T: Hook_Gotify, oneof: "action" },
-    { no: 104, name: "action_slack", kind: "message", T: Hook_Slack, oneof: "action" },
+    { no: 1, name: "cert_file", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "key_file", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "acme", kind: "message", T: Acme },
+    { no: 4, name: "client_ca_file", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "require_client_cert", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Hook {
-    return new Hook().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Tls {
+    return new Tls().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Hook {
-    return new Hook().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Tls {
+    return new Tls().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Hook {
-    return new Hook().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Tls {
+    return new Tls().fromJsonString(jsonString, options);
   }
 
-  static equals(a: Hook | PlainMessage<Hook> | undefined, b: Hook | PlainMessage<Hook> | undefined): boolean {
-    return proto3.util.equals(Hook, a, b);
+  static equals(a: Tls | PlainMessage<Tls> | undefined, b: Tls | PlainMessage<Tls> | undefined): boolean {
+    return proto3.util.equals(Tls, a, b);
   }
 }
 
 /**
- * @generated from enum v1.Hook.Condition
+ * @generated from message v1.Acme
  */
-export enum Hook_Condition {
-  /**
-   * @generated from enum value: CONDITION_UNKNOWN = 0;
-   */
-  UNKNOWN = 0,
-
+export class Acme extends Message<Acme> {
   /**
-   * error running any operation.
+   * domains to request certificates for, required.
    *
-   * @generated from enum value: CONDITION_ANY_ERROR = 1;
+   * @generated from field: repeated string domains = 1;
    */
-  ANY_ERROR = 1,
+  domains: string[] = [];
 
   /**
-   * backup started.
+   * optional contact email for the ACME account.
    *
-   * @generated from enum value: CONDITION_SNAPSHOT_START = 2;
+   * @generated from field: string email = 2;
    */
-  SNAPSHOT_START = 2,
+  email = "";
 
   /**
-   * backup completed (success or fail).
+   * ACME directory, defaults to Let's Encrypt.
    *
-   * @generated from enum value: CONDITION_SNAPSHOT_END = 3;
+   * @generated from field: string directory_url = 3;
    */
-  SNAPSHOT_END = 3,
+  directoryUrl = "";
 
   /**
-   * snapshot failed.
+   * optional address e.g. ":80" to answer HTTP-01 challenges on, TLS-ALPN-01 challenges are always answered on the TLS listener.
    *
-   * @generated from enum value: CONDITION_SNAPSHOT_ERROR = 4;
-   */
-  SNAPSHOT_ERROR = 4,
-}
-// Retrieve enum metadata with: proto3.getEnumType(Hook_Condition)
-proto3.util.setEnumType(Hook_Condition, "v1.Hook.Condition", [
-  { no: 0, name: "CONDITION_UNKNOWN" },
-  { no: 1, name: "CONDITION_ANY_ERROR" },
-  { no: 2, name: "CONDITION_SNAPSHOT_START" },
-  { no: 3, name: "CONDITION_SNAPSHOT_END" },
-  { no: 4, name: "CONDITION_SNAPSHOT_ERROR" },
-]);
-
-/**
- * @generated from message v1.Hook.Command
- */
-export class Hook_Command extends Message<Hook_Command> {
-  /**
-   * @generated from field: string command = 1;
+   * @generated from field: string http_challenge_address = 4;
    */
-  command = "";
+  httpChallengeAddress = "";
 
-  constructor(data?: PartialMessage<Hook_Command>) {
+  constructor(data?: PartialMessage<Acme>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "v1.Hook.Command";
+  static readonly typeName = "v1.Acme";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "command", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "domains", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 2, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "directory_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "http_challenge_address", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Hook_Command {
-    return new Hook_Command().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Acme {
+    return new Acme().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Hook_Command {
-    return new Hook_Command().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Acme {
+    return new Acme().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Acme {
+    return new Acme().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Acme | PlainMessage<Acme> | undefined, b: Acme | PlainMessage<Acme> | undefined): boolean {
+    return proto3.util.equals(Acme, a, b);
   }
+}
+
+/**
+ * @generated from message v1.Mqtt
+ */
+export class Mqtt extends Message<Mqtt> {
+  /**
+   * address of the broker e.g. tcp://localhost:1883. Publishing is disabled if empty.
+   *
+   * @generated from field: string broker = 1;
+   */
+  broker = "";
+
+  /**
+   * @generated from field: string username = 2;
+   */
+  username = "";
+
+  /**
+   * @generated from field: string password = 3;
+   */
+  password = "";
+
+  /**
+   * client id to connect with, defaults to backrest-<host>.
+   *
+   * @generated from field: string client_id = 4;
+   */
+  clientId = "";
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Hook_Command {
-    return new Hook_Command().fromJsonString(jsonString, options);
-  }
+  /**
+   * prefix for status topics, defaults to "backrest".
+   *
+   * @generated from field: string topic_prefix = 5;
+   */
+  topicPrefix = "";
 
-  static equals(a: Hook_Command | PlainMessage<Hook_Command> | undefined, b: Hook_Command | PlainMessage<Hook_Command> | undefined): boolean {
-    return proto3.util.equals(Hook_Command, a, b);
-  }
-}
+  /**
+   * Home Assistant discovery prefix, defaults to "homeassistant".
+   *
+   * @generated from field: string discovery_prefix = 6;
+   */
+  discoveryPrefix = "";
 
-/**
- * @generated from message v1.Hook.Webhook
- */
-export class Hook_Webhook extends Message<Hook_Webhook> {
   /**
-   * @generated from field: string webhook_url = 1;
+   * do not publish Home Assistant discovery topics.
+   *
+   * @generated from field: bool disable_discovery = 7;
    */
-  webhookUrl = "";
+  disableDiscovery = false;
 
-  constructor(data?: PartialMessage<Hook_Webhook>) {
+  constructor(data?: PartialMessage<Mqtt>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "v1.Hook.Webhook";
+  static readonly typeName = "v1.Mqtt";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "webhook_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "broker", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "username", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "password", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "client_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "topic_prefix", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "discovery_prefix", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "disable_discovery", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Hook_Webhook {
-    return new Hook_Webhook().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Mqtt {
+    return new Mqtt().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Hook_Webhook {
-    return new Hook_Webhook().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Mqtt {
+    return new Mqtt().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Hook_Webhook {
-    return new Hook_Webhook().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Mqtt {
+    return new Mqtt().fromJsonString(jsonString, options);
   }
 
-  static equals(a: Hook_Webhook | PlainMessage<Hook_Webhook> | undefined, b: Hook_Webhook | PlainMessage<Hook_Webhook> | undefined): boolean {
-    return proto3.util.equals(Hook_Webhook, a, b);
+  static equals(a: Mqtt | PlainMessage<Mqtt> | undefined, b: Mqtt | PlainMessage<Mqtt> | undefined): boolean {
+    return proto3.util.equals(Mqtt, a, b);
   }
 }
 
 /**
- * @generated from message v1.Hook.Discord
+ * @generated from message v1.Auth
  */
-export class Hook_Discord extends Message<Hook_Discord> {
+export class Auth extends Message<Auth> {
   /**
-   * @generated from field: string webhook_url = 1;
+   * users to allow access to the UI.
+   *
+   * @generated from field: repeated v1.User users = 2;
    */
-  webhookUrl = "";
+  users: User[] = [];
 
   /**
-   * template for the webhook payload.
+   * optional, trust identities asserted by a reverse proxy.
    *
-   * @generated from field: string template = 2;
+   * @generated from field: v1.ProxyHeaderAuth proxy_header = 3;
    */
-  template = "";
+  proxyHeader?: ProxyHeaderAuth;
 
-  constructor(data?: PartialMessage<Hook_Discord>) {
+  /**
+   * optional, allow login with an OpenID Connect provider.
+   *
+   * @generated from field: v1.OidcAuth oidc = 4;
+   */
+  oidc?: OidcAuth;
+
+  /**
+   * maps external identities to users, identities that match no mapping log in as the user of the same name.
+   *
+   * @generated from field: repeated v1.IdentityMapping identity_mappings = 5;
+   */
+  identityMappings: IdentityMapping[] = [];
+
+  constructor(data?: PartialMessage<Auth>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "v1.Hook.Discord";
+  static readonly typeName = "v1.Auth";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "webhook_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "template", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "users", kind: "message", T: User, repeated: true },
+    { no: 3, name: "proxy_header", kind: "message", T: ProxyHeaderAuth },
+    { no: 4, name: "oidc", kind: "message", T: OidcAuth },
+    { no: 5, name: "identity_mappings", kind: "message", T: IdentityMapping, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Hook_Discord {
-    return new Hook_Discord().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Auth {
+    return new Auth().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Hook_Discord {
-    return new Hook_Discord().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Auth {
+    return new Auth().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Hook_Discord {
-    return new Hook_Discord().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Auth {
+    return new Auth().fromJsonString(jsonString, options);
   }
 
-  static equals(a: Hook_Discord | PlainMessage<Hook_Discord> | undefined, b: Hook_Discord | PlainMessage<Hook_Discord> | undefined): boolean {
-    return proto3.util.equals(Hook_Discord, a, b);
+  static equals(a: Auth | PlainMessage<Auth> | undefined, b: Auth | PlainMessage<Auth> | undefined): boolean {
+    return proto3.util.equals(Auth, a, b);
   }
 }
 
 /**
- * @generated from message v1.Hook.Gotify
+ * ProxyHeaderAuth authenticates requests by headers set by an authenticating reverse proxy e.g. Authelia or authentik.
+ *
+ * @generated from message v1.ProxyHeaderAuth
  */
-export class Hook_Gotify extends Message<Hook_Gotify> {
+export class ProxyHeaderAuth extends Message<ProxyHeaderAuth> {
   /**
-   * @generated from field: string base_url = 1;
+   * @generated from field: bool enabled = 1;
    */
-  baseUrl = "";
+  enabled = false;
 
   /**
-   * @generated from field: string token = 3;
+   * header holding the external username, defaults to Remote-User.
+   *
+   * @generated from field: string user_header = 2;
    */
-  token = "";
+  userHeader = "";
 
   /**
-   * template for the webhook payload.
+   * header holding comma separated groups, defaults to Remote-Groups.
    *
-   * @generated from field: string template = 100;
+   * @generated from field: string groups_header = 3;
    */
-  template = "";
+  groupsHeader = "";
 
   /**
-   * template for the webhook title.
+   * IPs or CIDRs of proxies allowed to set the headers, required.
    *
-   * @generated from field: string title_template = 101;
+   * @generated from field: repeated string trusted_proxies = 4;
    */
-  titleTemplate = "";
+  trustedProxies: string[] = [];
 
-  constructor(data?: PartialMessage<Hook_Gotify>) {
+  constructor(data?: PartialMessage<ProxyHeaderAuth>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "v1.Hook.Gotify";
+  static readonly typeName = "v1.ProxyHeaderAuth";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "base_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 100, name: "template", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 101, name: "title_template", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "enabled", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "user_header", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "groups_header", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "trusted_proxies", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Hook_Gotify {
-    return new Hook_Gotify().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ProxyHeaderAuth {
+    return new ProxyHeaderAuth().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Hook_Gotify {
-    return new Hook_Gotify().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ProxyHeaderAuth {
+    return new ProxyHeaderAuth().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Hook_Gotify {
-    return new Hook_Gotify().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ProxyHeaderAuth {
+    return new ProxyHeaderAuth().fromJsonString(jsonString, options);
   }
 
-  static equals(a: Hook_Gotify | PlainMessage<Hook_Gotify> | undefined, b: Hook_Gotify | PlainMessage<Hook_Gotify> | undefined): boolean {
-    return proto3.util.equals(Hook_Gotify, a, b);
+  static equals(a: ProxyHeaderAuth | PlainMessage<ProxyHeaderAuth> | undefined, b: ProxyHeaderAuth | PlainMessage<ProxyHeaderAuth> | undefined): boolean {
+    return proto3.util.equals(ProxyHeaderAuth, a, b);
   }
 }
 
 /**
- * @generated from message v1.Hook.Slack
+ * OidcAuth configures login with an OpenID Connect provider using the authorization code flow.
+ *
+ * @generated from message v1.OidcAuth
  */
-export class Hook_Slack extends Message<Hook_Slack> {
+export class OidcAuth extends Message<OidcAuth> {
   /**
-   * @generated from field: string webhook_url = 1;
+   * issuer URL, discovery is used to find the provider's endpoints. OIDC is disabled if empty.
+   *
+   * @generated from field: string issuer = 1;
    */
-  webhookUrl = "";
+  issuer = "";
 
   /**
-   * template for the webhook payload.
+   * @generated from field: string client_id = 2;
+   */
+  clientId = "";
+
+  /**
+   * @generated from field: string client_secret = 3;
+   */
+  clientSecret = "";
+
+  /**
+   * must point at /auth/oidc/callback on this instance e.g. https://backrest.example.com/auth/oidc/callback.
    *
-   * @generated from field: string template = 2;
+   * @generated from field: string redirect_url = 4;
    */
-  template = "";
+  redirectUrl = "";
 
-  constructor(data?: PartialMessage<Hook_Slack>) {
+  /**
+   * defaults to openid, profile, email and groups.
+   *
+   * @generated from field: repeated string scopes = 5;
+   */
+  scopes: string[] = [];
+
+  /**
+   * ID token claim holding the external username, defaults to preferred_username.
+   *
+   * @generated from field: string username_claim = 6;
+   */
+  usernameClaim = "";
+
+  /**
+   * ID token claim holding the groups, defaults to groups.
+   *
+   * @generated from field: string groups_claim = 7;
+   */
+  groupsClaim = "";
+
+  constructor(data?: PartialMessage<OidcAuth>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "v1.Hook.Slack";
+  static readonly typeName = "v1.OidcAuth";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "webhook_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "template", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "issuer", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "client_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "client_secret", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "redirect_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "scopes", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 6, name: "username_claim", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "groups_claim", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Hook_Slack {
-    return new Hook_Slack().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): OidcAuth {
+    return new OidcAuth().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Hook_Slack {
-    return new Hook_Slack().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): OidcAuth {
+    return new OidcAuth().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Hook_Slack {
-    return new Hook_Slack().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): OidcAuth {
+    return new OidcAuth().fromJsonString(jsonString, options);
   }
 
-  static equals(a: Hook_Slack | PlainMessage<Hook_Slack> | undefined, b: Hook_Slack | PlainMessage<Hook_Slack> | undefined): boolean {
-    return proto3.util.equals(Hook_Slack, a, b);
+  static equals(a: OidcAuth | PlainMessage<OidcAuth> | undefined, b: OidcAuth | PlainMessage<OidcAuth> | undefined): boolean {
+    return proto3.util.equals(OidcAuth, a, b);
   }
 }
 
 /**
- * @generated from message v1.Auth
+ * IdentityMapping maps an external user or group to a backrest user. The first matching mapping wins.
+ *
+ * @generated from message v1.IdentityMapping
  */
-export class Auth extends Message<Auth> {
+export class IdentityMapping extends Message<IdentityMapping> {
   /**
-   * users to allow access to the UI.
+   * @generated from oneof v1.IdentityMapping.match
+   */
+  match: {
+    /**
+     * @generated from field: string external_user = 1;
+     */
+    value: string;
+    case: "externalUser";
+  } | {
+    /**
+     * @generated from field: string group = 2;
+     */
+    value: string;
+    case: "group";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  /**
+   * name of the user to log in as.
    *
-   * @generated from field: repeated v1.User users = 2;
+   * @generated from field: string user = 3;
    */
-  users: User[] = [];
+  user = "";
 
-  constructor(data?: PartialMessage<Auth>) {
+  constructor(data?: PartialMessage<IdentityMapping>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "v1.Auth";
+  static readonly typeName = "v1.IdentityMapping";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 2, name: "users", kind: "message", T: User, repeated: true },
+    { no: 1, name: "external_user", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "match" },
+    { no: 2, name: "group", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "match" },
+    { no: 3, name: "user", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Auth {
-    return new Auth().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): IdentityMapping {
+    return new IdentityMapping().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Auth {
-    return new Auth().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): IdentityMapping {
+    return new IdentityMapping().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Auth {
-    return new Auth().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): IdentityMapping {
+    return new IdentityMapping().fromJsonString(jsonString, options);
   }
 
-  static equals(a: Auth | PlainMessage<Auth> | undefined, b: Auth | PlainMessage<Auth> | undefined): boolean {
-    return proto3.util.equals(Auth, a, b);
+  static equals(a: IdentityMapping | PlainMessage<IdentityMapping> | undefined, b: IdentityMapping | PlainMessage<IdentityMapping> | undefined): boolean {
+    return proto3.util.equals(IdentityMapping, a, b);
   }
 }
 
@@ -917,6 +3352,13 @@ export class User extends Message<User> {
     case: "passwordBcrypt";
   } | { case: undefined; value?: undefined } = { case: undefined };
 
+  /**
+   * optional, second factors enrolled for interactive login.
+   *
+   * @generated from field: v1.SecondFactor second_factor = 3;
+   */
+  secondFactor?: SecondFactor;
+
   constructor(data?: PartialMessage<User>) {
     super();
     proto3.util.initPartial(data, this);
@@ -927,6 +3369,7 @@ export class User extends Message<User> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "password_bcrypt", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "password" },
+    { no: 3, name: "second_factor", kind: "message", T: SecondFactor },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): User {
@@ -946,3 +3389,137 @@ export class User extends Message<User> {
   }
 }
 
+/**
+ * SecondFactor holds the second factors a user has enrolled. Once any factor is enrolled it is required at login.
+ *
+ * @generated from message v1.SecondFactor
+ */
+export class SecondFactor extends Message<SecondFactor> {
+  /**
+   * refuse logins without a second factor, can only be set once a factor is enrolled.
+   *
+   * @generated from field: bool required = 1;
+   */
+  required = false;
+
+  /**
+   * base32 encoded TOTP secret, empty if TOTP is not enrolled.
+   *
+   * @generated from field: string totp_secret = 2;
+   */
+  totpSecret = "";
+
+  /**
+   * @generated from field: repeated v1.WebAuthnCredential webauthn_credentials = 3;
+   */
+  webauthnCredentials: WebAuthnCredential[] = [];
+
+  /**
+   * unused recovery codes, each can be used once in place of a second factor.
+   *
+   * @generated from field: repeated string recovery_codes_bcrypt = 4;
+   */
+  recoveryCodesBcrypt: string[] = [];
+
+  constructor(data?: PartialMessage<SecondFactor>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.SecondFactor";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "required", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "totp_secret", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "webauthn_credentials", kind: "message", T: WebAuthnCredential, repeated: true },
+    { no: 4, name: "recovery_codes_bcrypt", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SecondFactor {
+    return new SecondFactor().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SecondFactor {
+    return new SecondFactor().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SecondFactor {
+    return new SecondFactor().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SecondFactor | PlainMessage<SecondFactor> | undefined, b: SecondFactor | PlainMessage<SecondFactor> | undefined): boolean {
+    return proto3.util.equals(SecondFactor, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.WebAuthnCredential
+ */
+export class WebAuthnCredential extends Message<WebAuthnCredential> {
+  /**
+   * base64url encoded credential ID.
+   *
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * PKIX encoded public key.
+   *
+   * @generated from field: bytes public_key = 2;
+   */
+  publicKey = new Uint8Array(0);
+
+  /**
+   * relying party ID (host name) the credential is scoped to.
+   *
+   * @generated from field: string rp_id = 3;
+   */
+  rpId = "";
+
+  /**
+   * signature counter reported at registration, later counters are only kept in memory.
+   *
+   * @generated from field: uint32 sign_count = 4;
+   */
+  signCount = 0;
+
+  /**
+   * user provided name for the authenticator.
+   *
+   * @generated from field: string name = 5;
+   */
+  name = "";
+
+  constructor(data?: PartialMessage<WebAuthnCredential>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.WebAuthnCredential";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "public_key", kind: "scalar", T: 12 /* ScalarType.BYTES */ },
+    { no: 3, name: "rp_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "sign_count", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 5, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WebAuthnCredential {
+    return new WebAuthnCredential().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WebAuthnCredential {
+    return new WebAuthnCredential().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WebAuthnCredential {
+    return new WebAuthnCredential().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: WebAuthnCredential | PlainMessage<WebAuthnCredential> | undefined, b: WebAuthnCredential | PlainMessage<WebAuthnCredential> | undefined): boolean {
+    return proto3.util.equals(WebAuthnCredential, a, b);
+  }
+}
+
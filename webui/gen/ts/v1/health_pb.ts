@@ -0,0 +1,313 @@
+// @generated by protoc-gen-es v1.7.2 with parameter "target=ts"
+// @generated from file v1/health.proto (package v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
+import { Operation } from "./operations_pb.js";
+
+/**
+ * RepoHealth aggregates the state of a repo relevant to its long term health.
+ *
+ * @generated from message v1.RepoHealth
+ */
+export class RepoHealth extends Message<RepoHealth> {
+  /**
+   * @generated from field: string repo_id = 1;
+   */
+  repoId = "";
+
+  /**
+   * most recent completed backup operation, if any.
+   *
+   * @generated from field: v1.Operation last_backup = 2;
+   */
+  lastBackup?: Operation;
+
+  /**
+   * most recent completed prune operation, if any.
+   *
+   * @generated from field: v1.Operation last_prune = 3;
+   */
+  lastPrune?: Operation;
+
+  /**
+   * most recent completed stats operation, if any.
+   *
+   * @generated from field: v1.Operation last_stats = 4;
+   */
+  lastStats?: Operation;
+
+  /**
+   * set if a check was requested.
+   *
+   * @generated from field: v1.RepoCheckResult check = 5;
+   */
+  check?: RepoCheckResult;
+
+  /**
+   * locks currently held in the repo.
+   *
+   * @generated from field: repeated v1.RepoLock locks = 6;
+   */
+  locks: RepoLock[] = [];
+
+  /**
+   * number of locks older than restic's stale lock timeout.
+   *
+   * @generated from field: int64 stale_lock_count = 7;
+   */
+  staleLockCount = protoInt64.zero;
+
+  /**
+   * bytes a prune would remove, only set if an estimate was requested.
+   *
+   * @generated from field: int64 unused_bytes_estimate = 8;
+   */
+  unusedBytesEstimate = protoInt64.zero;
+
+  /**
+   * size of the deduplicated data referenced by snapshots.
+   *
+   * @generated from field: int64 raw_data_size = 9;
+   */
+  rawDataSize = protoInt64.zero;
+
+  /**
+   * size of all snapshots if they were restored.
+   *
+   * @generated from field: int64 restore_size = 10;
+   */
+  restoreSize = protoInt64.zero;
+
+  /**
+   * restore_size / raw_data_size.
+   *
+   * @generated from field: double dedup_ratio = 11;
+   */
+  dedupRatio = 0;
+
+  constructor(data?: PartialMessage<RepoHealth>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.RepoHealth";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "repo_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "last_backup", kind: "message", T: Operation },
+    { no: 3, name: "last_prune", kind: "message", T: Operation },
+    { no: 4, name: "last_stats", kind: "message", T: Operation },
+    { no: 5, name: "check", kind: "message", T: RepoCheckResult },
+    { no: 6, name: "locks", kind: "message", T: RepoLock, repeated: true },
+    { no: 7, name: "stale_lock_count", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 8, name: "unused_bytes_estimate", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 9, name: "raw_data_size", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 10, name: "restore_size", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 11, name: "dedup_ratio", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RepoHealth {
+    return new RepoHealth().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RepoHealth {
+    return new RepoHealth().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RepoHealth {
+    return new RepoHealth().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RepoHealth | PlainMessage<RepoHealth> | undefined, b: RepoHealth | PlainMessage<RepoHealth> | undefined): boolean {
+    return proto3.util.equals(RepoHealth, a, b);
+  }
+}
+
+/**
+ * RepoCacheStats describes the restic cache of a repo.
+ *
+ * @generated from message v1.RepoCacheStats
+ */
+export class RepoCacheStats extends Message<RepoCacheStats> {
+  /**
+   * @generated from field: string repo_id = 1;
+   */
+  repoId = "";
+
+  /**
+   * directory of the repo's cache, empty if the repo doesn't use one.
+   *
+   * @generated from field: string path = 2;
+   */
+  path = "";
+
+  /**
+   * size of the repo's cache directory.
+   *
+   * @generated from field: int64 size_bytes = 3;
+   */
+  sizeBytes = protoInt64.zero;
+
+  /**
+   * size of the cache directory shared by all repos using it.
+   *
+   * @generated from field: int64 total_size_bytes = 4;
+   */
+  totalSizeBytes = protoInt64.zero;
+
+  constructor(data?: PartialMessage<RepoCacheStats>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.RepoCacheStats";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "repo_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "size_bytes", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "total_size_bytes", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RepoCacheStats {
+    return new RepoCacheStats().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RepoCacheStats {
+    return new RepoCacheStats().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RepoCacheStats {
+    return new RepoCacheStats().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RepoCacheStats | PlainMessage<RepoCacheStats> | undefined, b: RepoCacheStats | PlainMessage<RepoCacheStats> | undefined): boolean {
+    return proto3.util.equals(RepoCacheStats, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.RepoCheckResult
+ */
+export class RepoCheckResult extends Message<RepoCheckResult> {
+  /**
+   * @generated from field: bool ok = 1;
+   */
+  ok = false;
+
+  /**
+   * @generated from field: string output = 2;
+   */
+  output = "";
+
+  /**
+   * @generated from field: int64 unix_time_ms = 3;
+   */
+  unixTimeMs = protoInt64.zero;
+
+  constructor(data?: PartialMessage<RepoCheckResult>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.RepoCheckResult";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "ok", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "output", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "unix_time_ms", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RepoCheckResult {
+    return new RepoCheckResult().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RepoCheckResult {
+    return new RepoCheckResult().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RepoCheckResult {
+    return new RepoCheckResult().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RepoCheckResult | PlainMessage<RepoCheckResult> | undefined, b: RepoCheckResult | PlainMessage<RepoCheckResult> | undefined): boolean {
+    return proto3.util.equals(RepoCheckResult, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.RepoLock
+ */
+export class RepoLock extends Message<RepoLock> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: int64 unix_time_ms = 2;
+   */
+  unixTimeMs = protoInt64.zero;
+
+  /**
+   * @generated from field: bool exclusive = 3;
+   */
+  exclusive = false;
+
+  /**
+   * @generated from field: string hostname = 4;
+   */
+  hostname = "";
+
+  /**
+   * @generated from field: string username = 5;
+   */
+  username = "";
+
+  /**
+   * @generated from field: int64 pid = 6;
+   */
+  pid = protoInt64.zero;
+
+  /**
+   * @generated from field: bool stale = 7;
+   */
+  stale = false;
+
+  constructor(data?: PartialMessage<RepoLock>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.RepoLock";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "unix_time_ms", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "exclusive", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "hostname", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "username", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "pid", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 7, name: "stale", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RepoLock {
+    return new RepoLock().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RepoLock {
+    return new RepoLock().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RepoLock {
+    return new RepoLock().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RepoLock | PlainMessage<RepoLock> | undefined, b: RepoLock | PlainMessage<RepoLock> | undefined): boolean {
+    return proto3.util.equals(RepoLock, a, b);
+  }
+}
+
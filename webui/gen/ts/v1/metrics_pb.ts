@@ -0,0 +1,248 @@
+// @generated by protoc-gen-es v1.7.2 with parameter "target=ts"
+// @generated from file v1/metrics.proto (package v1, syntax proto3)
+/* eslint-disable */
+// @ts-nocheck
+
+import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
+
+/**
+ * Metric is a per-plan value recorded from finished operations.
+ *
+ * @generated from enum v1.Metric
+ */
+export enum Metric {
+  /**
+   * @generated from enum value: METRIC_UNKNOWN = 0;
+   */
+  UNKNOWN = 0,
+
+  /**
+   * duration of successful backups.
+   *
+   * @generated from enum value: METRIC_BACKUP_DURATION_MS = 1;
+   */
+  BACKUP_DURATION_MS = 1,
+
+  /**
+   * data added to the repo by successful backups.
+   *
+   * @generated from enum value: METRIC_BACKUP_BYTES_ADDED = 2;
+   */
+  BACKUP_BYTES_ADDED = 2,
+
+  /**
+   * total size of the plan's repo from stats operations.
+   *
+   * @generated from enum value: METRIC_REPO_SIZE_BYTES = 3;
+   */
+  REPO_SIZE_BYTES = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(Metric)
+proto3.util.setEnumType(Metric, "v1.Metric", [
+  { no: 0, name: "METRIC_UNKNOWN" },
+  { no: 1, name: "METRIC_BACKUP_DURATION_MS" },
+  { no: 2, name: "METRIC_BACKUP_BYTES_ADDED" },
+  { no: 3, name: "METRIC_REPO_SIZE_BYTES" },
+]);
+
+/**
+ * @generated from message v1.MetricPoint
+ */
+export class MetricPoint extends Message<MetricPoint> {
+  /**
+   * @generated from field: int64 unix_time_ms = 1;
+   */
+  unixTimeMs = protoInt64.zero;
+
+  /**
+   * @generated from field: double value = 2;
+   */
+  value = 0;
+
+  /**
+   * number of recorded values the point averages, older points are merged as series are downsampled.
+   *
+   * @generated from field: int64 count = 3;
+   */
+  count = protoInt64.zero;
+
+  constructor(data?: PartialMessage<MetricPoint>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.MetricPoint";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "unix_time_ms", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "value", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 3, name: "count", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetricPoint {
+    return new MetricPoint().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MetricPoint {
+    return new MetricPoint().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MetricPoint {
+    return new MetricPoint().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MetricPoint | PlainMessage<MetricPoint> | undefined, b: MetricPoint | PlainMessage<MetricPoint> | undefined): boolean {
+    return proto3.util.equals(MetricPoint, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.MetricSeries
+ */
+export class MetricSeries extends Message<MetricSeries> {
+  /**
+   * @generated from field: string plan_id = 1;
+   */
+  planId = "";
+
+  /**
+   * plans backing up to several repos have a series per repo.
+   *
+   * @generated from field: string repo_id = 2;
+   */
+  repoId = "";
+
+  /**
+   * @generated from field: v1.Metric metric = 3;
+   */
+  metric = Metric.UNKNOWN;
+
+  /**
+   * oldest first.
+   *
+   * @generated from field: repeated v1.MetricPoint points = 4;
+   */
+  points: MetricPoint[] = [];
+
+  constructor(data?: PartialMessage<MetricSeries>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.MetricSeries";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "plan_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "repo_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "metric", kind: "enum", T: proto3.getEnumType(Metric) },
+    { no: 4, name: "points", kind: "message", T: MetricPoint, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetricSeries {
+    return new MetricSeries().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MetricSeries {
+    return new MetricSeries().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MetricSeries {
+    return new MetricSeries().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MetricSeries | PlainMessage<MetricSeries> | undefined, b: MetricSeries | PlainMessage<MetricSeries> | undefined): boolean {
+    return proto3.util.equals(MetricSeries, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.MetricSeriesList
+ */
+export class MetricSeriesList extends Message<MetricSeriesList> {
+  /**
+   * @generated from field: repeated v1.MetricSeries series = 1;
+   */
+  series: MetricSeries[] = [];
+
+  constructor(data?: PartialMessage<MetricSeriesList>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.MetricSeriesList";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "series", kind: "message", T: MetricSeries, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MetricSeriesList {
+    return new MetricSeriesList().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MetricSeriesList {
+    return new MetricSeriesList().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MetricSeriesList {
+    return new MetricSeriesList().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MetricSeriesList | PlainMessage<MetricSeriesList> | undefined, b: MetricSeriesList | PlainMessage<MetricSeriesList> | undefined): boolean {
+    return proto3.util.equals(MetricSeriesList, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.GetMetricSeriesRequest
+ */
+export class GetMetricSeriesRequest extends Message<GetMetricSeriesRequest> {
+  /**
+   * @generated from field: string plan_id = 1;
+   */
+  planId = "";
+
+  /**
+   * optional, all metrics if empty.
+   *
+   * @generated from field: repeated v1.Metric metrics = 2;
+   */
+  metrics: Metric[] = [];
+
+  /**
+   * optional, only points at or after this time.
+   *
+   * @generated from field: int64 since_unix_time_ms = 3;
+   */
+  sinceUnixTimeMs = protoInt64.zero;
+
+  constructor(data?: PartialMessage<GetMetricSeriesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.GetMetricSeriesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "plan_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "metrics", kind: "enum", T: proto3.getEnumType(Metric), repeated: true },
+    { no: 3, name: "since_unix_time_ms", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetMetricSeriesRequest {
+    return new GetMetricSeriesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetMetricSeriesRequest {
+    return new GetMetricSeriesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetMetricSeriesRequest {
+    return new GetMetricSeriesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetMetricSeriesRequest | PlainMessage<GetMetricSeriesRequest> | undefined, b: GetMetricSeriesRequest | PlainMessage<GetMetricSeriesRequest> | undefined): boolean {
+    return proto3.util.equals(GetMetricSeriesRequest, a, b);
+  }
+}
+
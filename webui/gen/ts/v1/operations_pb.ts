@@ -5,12 +5,10 @@
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
-import { BackupProgressEntry, BackupProgressError, RepoStats, ResticSnapshot, RestoreProgressEntry } from "./restic_pb.js";
+import { BackupProgressEntry, BackupProgressError, ErrorClass, RepoStats, ResticSnapshot, RestoreOptions, RestoreProgressEntry, RestoreVerification } from "./restic_pb.js";
 import { RetentionPolicy } from "./config_pb.js";
 
 /**
- * OperationEventType indicates whether the operation was created or updated
- *
  * @generated from enum v1.OperationEventType
  */
 export enum OperationEventType {
@@ -151,6 +149,315 @@ export class OperationList extends Message<OperationList> {
   }
 }
 
+/**
+ * DurationEstimate summarizes how long a plan's recent operations of one type took.
+ *
+ * @generated from message v1.DurationEstimate
+ */
+export class DurationEstimate extends Message<DurationEstimate> {
+  /**
+   * e.g. "backup", as in QueryOperationsRequest.types.
+   *
+   * @generated from field: string operation_type = 1;
+   */
+  operationType = "";
+
+  /**
+   * @generated from field: int64 average_ms = 2;
+   */
+  averageMs = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 max_ms = 3;
+   */
+  maxMs = protoInt64.zero;
+
+  /**
+   * number of completed operations the estimate is based on.
+   *
+   * @generated from field: int32 samples = 4;
+   */
+  samples = 0;
+
+  constructor(data?: PartialMessage<DurationEstimate>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.DurationEstimate";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "operation_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "average_ms", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "max_ms", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "samples", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DurationEstimate {
+    return new DurationEstimate().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DurationEstimate {
+    return new DurationEstimate().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DurationEstimate {
+    return new DurationEstimate().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DurationEstimate | PlainMessage<DurationEstimate> | undefined, b: DurationEstimate | PlainMessage<DurationEstimate> | undefined): boolean {
+    return proto3.util.equals(DurationEstimate, a, b);
+  }
+}
+
+/**
+ * ResourceUsage is the resources used by the restic processes of an operation, or the total of several operations.
+ *
+ * @generated from message v1.ResourceUsage
+ */
+export class ResourceUsage extends Message<ResourceUsage> {
+  /**
+   * number of restic processes run.
+   *
+   * @generated from field: int32 processes = 1;
+   */
+  processes = 0;
+
+  /**
+   * @generated from field: int64 cpu_user_ms = 2;
+   */
+  cpuUserMs = protoInt64.zero;
+
+  /**
+   * @generated from field: int64 cpu_system_ms = 3;
+   */
+  cpuSystemMs = protoInt64.zero;
+
+  /**
+   * peak resident memory of the largest process.
+   *
+   * @generated from field: int64 max_rss_bytes = 4;
+   */
+  maxRssBytes = protoInt64.zero;
+
+  /**
+   * read from block devices, reads served from the page cache are not counted.
+   *
+   * @generated from field: int64 read_bytes = 5;
+   */
+  readBytes = protoInt64.zero;
+
+  /**
+   * written to block devices.
+   *
+   * @generated from field: int64 write_bytes = 6;
+   */
+  writeBytes = protoInt64.zero;
+
+  /**
+   * estimated upload of a backup to a remote repo, the packed size of the data it added if restic reports it
+   * (0.17 and later) or else the uncompressed size. Not measured, metadata and protocol overhead aren't counted.
+   *
+   * @generated from field: int64 upload_bytes_estimate = 7;
+   */
+  uploadBytesEstimate = protoInt64.zero;
+
+  constructor(data?: PartialMessage<ResourceUsage>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.ResourceUsage";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "processes", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "cpu_user_ms", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "cpu_system_ms", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "max_rss_bytes", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 5, name: "read_bytes", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 6, name: "write_bytes", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 7, name: "upload_bytes_estimate", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ResourceUsage {
+    return new ResourceUsage().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ResourceUsage {
+    return new ResourceUsage().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ResourceUsage {
+    return new ResourceUsage().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ResourceUsage | PlainMessage<ResourceUsage> | undefined, b: ResourceUsage | PlainMessage<ResourceUsage> | undefined): boolean {
+    return proto3.util.equals(ResourceUsage, a, b);
+  }
+}
+
+/**
+ * ResourceUsageSummary totals the resource usage of a plan's operations of one type.
+ *
+ * @generated from message v1.ResourceUsageSummary
+ */
+export class ResourceUsageSummary extends Message<ResourceUsageSummary> {
+  /**
+   * e.g. "backup", as in QueryOperationsRequest.types.
+   *
+   * @generated from field: string operation_type = 1;
+   */
+  operationType = "";
+
+  /**
+   * number of operations with recorded usage.
+   *
+   * @generated from field: int32 operations = 2;
+   */
+  operations = 0;
+
+  /**
+   * @generated from field: v1.ResourceUsage total = 3;
+   */
+  total?: ResourceUsage;
+
+  /**
+   * total duration of the operations.
+   *
+   * @generated from field: int64 duration_ms = 4;
+   */
+  durationMs = protoInt64.zero;
+
+  /**
+   * average upload rate of the operations with an upload estimate, over their durations.
+   *
+   * @generated from field: int64 upload_bytes_per_sec_estimate = 5;
+   */
+  uploadBytesPerSecEstimate = protoInt64.zero;
+
+  constructor(data?: PartialMessage<ResourceUsageSummary>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.ResourceUsageSummary";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "operation_type", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "operations", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "total", kind: "message", T: ResourceUsage },
+    { no: 4, name: "duration_ms", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 5, name: "upload_bytes_per_sec_estimate", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ResourceUsageSummary {
+    return new ResourceUsageSummary().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ResourceUsageSummary {
+    return new ResourceUsageSummary().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ResourceUsageSummary {
+    return new ResourceUsageSummary().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ResourceUsageSummary | PlainMessage<ResourceUsageSummary> | undefined, b: ResourceUsageSummary | PlainMessage<ResourceUsageSummary> | undefined): boolean {
+    return proto3.util.equals(ResourceUsageSummary, a, b);
+  }
+}
+
+/**
+ * Run groups the operations of a single trigger e.g. a scheduled backup and the forget, prune, stats and hooks it caused.
+ *
+ * @generated from message v1.Run
+ */
+export class Run extends Message<Run> {
+  /**
+   * ID of the run's first operation.
+   *
+   * @generated from field: int64 id = 1;
+   */
+  id = protoInt64.zero;
+
+  /**
+   * @generated from field: string plan_id = 2;
+   */
+  planId = "";
+
+  /**
+   * @generated from field: string repo_id = 3;
+   */
+  repoId = "";
+
+  /**
+   * in progress while any operation is, otherwise the worst status of its operations.
+   *
+   * @generated from field: v1.OperationStatus status = 4;
+   */
+  status = OperationStatus.STATUS_UNKNOWN;
+
+  /**
+   * @generated from field: int64 unix_time_start_ms = 5;
+   */
+  unixTimeStartMs = protoInt64.zero;
+
+  /**
+   * 0 while the run is in progress.
+   *
+   * @generated from field: int64 unix_time_end_ms = 6;
+   */
+  unixTimeEndMs = protoInt64.zero;
+
+  /**
+   * oldest first.
+   *
+   * @generated from field: repeated v1.Operation operations = 7;
+   */
+  operations: Operation[] = [];
+
+  /**
+   * set once the run is done if some of its backups failed while others succeeded, e.g. a plan only reached some of its repos.
+   *
+   * @generated from field: bool partial = 8;
+   */
+  partial = false;
+
+  constructor(data?: PartialMessage<Run>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.Run";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "plan_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "repo_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "status", kind: "enum", T: proto3.getEnumType(OperationStatus) },
+    { no: 5, name: "unix_time_start_ms", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 6, name: "unix_time_end_ms", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 7, name: "operations", kind: "message", T: Operation, repeated: true },
+    { no: 8, name: "partial", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Run {
+    return new Run().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Run {
+    return new Run().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Run {
+    return new Run().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Run | PlainMessage<Run> | undefined, b: Run | PlainMessage<Run> | undefined): boolean {
+    return proto3.util.equals(Run, a, b);
+  }
+}
+
 /**
  * @generated from message v1.Operation
  */
@@ -209,6 +516,44 @@ export class Operation extends Message<Operation> {
    */
   displayMessage = "";
 
+  /**
+   * optional, ID of the operation that started the run this operation is part of e.g. the backup that scheduled a
+   * forget. Equal to the operation's own ID for the first operation of a run, 0 for operations recorded before runs.
+   *
+   * @generated from field: int64 run_id = 9;
+   */
+  runId = protoInt64.zero;
+
+  /**
+   * optional, average duration in milliseconds of the plan's recent operations of the same type when this operation
+   * started, 0 without history. Compared with the elapsed time for ETAs and overrun detection.
+   *
+   * @generated from field: int64 expected_duration_ms = 10;
+   */
+  expectedDurationMs = protoInt64.zero;
+
+  /**
+   * optional, the kind of failure of an operation with STATUS_ERROR.
+   *
+   * @generated from field: v1.ErrorClass error_class = 11;
+   */
+  errorClass = ErrorClass.UNKNOWN;
+
+  /**
+   * optional, resources used by the restic processes the operation ran.
+   *
+   * @generated from field: v1.ResourceUsage resource_usage = 12;
+   */
+  resourceUsage?: ResourceUsage;
+
+  /**
+   * optional, set once a user triaged the failed operation. Acknowledged failures no longer mark their plan or repo
+   * as having a problem.
+   *
+   * @generated from field: v1.OperationAcknowledgement acknowledgement = 13;
+   */
+  acknowledgement?: OperationAcknowledgement;
+
   /**
    * @generated from oneof v1.Operation.op
    */
@@ -254,6 +599,36 @@ export class Operation extends Message<Operation> {
      */
     value: OperationRunHook;
     case: "operationRunHook";
+  } | {
+    /**
+     * @generated from field: v1.OperationMigrate operation_migrate = 107;
+     */
+    value: OperationMigrate;
+    case: "operationMigrate";
+  } | {
+    /**
+     * @generated from field: v1.OperationRestoreDrill operation_restore_drill = 108;
+     */
+    value: OperationRestoreDrill;
+    case: "operationRestoreDrill";
+  } | {
+    /**
+     * @generated from field: v1.OperationCacheCleanup operation_cache_cleanup = 109;
+     */
+    value: OperationCacheCleanup;
+    case: "operationCacheCleanup";
+  } | {
+    /**
+     * @generated from field: v1.OperationWarmup operation_warmup = 110;
+     */
+    value: OperationWarmup;
+    case: "operationWarmup";
+  } | {
+    /**
+     * @generated from field: v1.OperationBenchmark operation_benchmark = 111;
+     */
+    value: OperationBenchmark;
+    case: "operationBenchmark";
   } | { case: undefined; value?: undefined } = { case: undefined };
 
   constructor(data?: PartialMessage<Operation>) {
@@ -272,6 +647,11 @@ export class Operation extends Message<Operation> {
     { no: 5, name: "unix_time_start_ms", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
     { no: 6, name: "unix_time_end_ms", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
     { no: 7, name: "display_message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "run_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 10, name: "expected_duration_ms", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 11, name: "error_class", kind: "enum", T: proto3.getEnumType(ErrorClass) },
+    { no: 12, name: "resource_usage", kind: "message", T: ResourceUsage },
+    { no: 13, name: "acknowledgement", kind: "message", T: OperationAcknowledgement },
     { no: 100, name: "operation_backup", kind: "message", T: OperationBackup, oneof: "op" },
     { no: 101, name: "operation_index_snapshot", kind: "message", T: OperationIndexSnapshot, oneof: "op" },
     { no: 102, name: "operation_forget", kind: "message", T: OperationForget, oneof: "op" },
@@ -279,6 +659,11 @@ export class Operation extends Message<Operation> {
     { no: 104, name: "operation_restore", kind: "message", T: OperationRestore, oneof: "op" },
     { no: 105, name: "operation_stats", kind: "message", T: OperationStats, oneof: "op" },
     { no: 106, name: "operation_run_hook", kind: "message", T: OperationRunHook, oneof: "op" },
+    { no: 107, name: "operation_migrate", kind: "message", T: OperationMigrate, oneof: "op" },
+    { no: 108, name: "operation_restore_drill", kind: "message", T: OperationRestoreDrill, oneof: "op" },
+    { no: 109, name: "operation_cache_cleanup", kind: "message", T: OperationCacheCleanup, oneof: "op" },
+    { no: 110, name: "operation_warmup", kind: "message", T: OperationWarmup, oneof: "op" },
+    { no: 111, name: "operation_benchmark", kind: "message", T: OperationBenchmark, oneof: "op" },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Operation {
@@ -344,60 +729,259 @@ export class OperationEvent extends Message<OperationEvent> {
 }
 
 /**
- * @generated from message v1.OperationBackup
+ * OperationEventType indicates whether the operation was created or updated
+ *
+ * @generated from message v1.OperationAcknowledgement
  */
-export class OperationBackup extends Message<OperationBackup> {
+export class OperationAcknowledgement extends Message<OperationAcknowledgement> {
   /**
-   * @generated from field: v1.BackupProgressEntry last_status = 3;
+   * user that acknowledged the failure, empty if authentication is disabled.
+   *
+   * @generated from field: string user = 1;
    */
-  lastStatus?: BackupProgressEntry;
+  user = "";
 
   /**
-   * @generated from field: repeated v1.BackupProgressError errors = 4;
+   * @generated from field: int64 unix_time_ms = 2;
    */
-  errors: BackupProgressError[] = [];
+  unixTimeMs = protoInt64.zero;
 
-  constructor(data?: PartialMessage<OperationBackup>) {
+  /**
+   * optional, e.g. the cause or the follow up.
+   *
+   * @generated from field: string note = 3;
+   */
+  note = "";
+
+  constructor(data?: PartialMessage<OperationAcknowledgement>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "v1.OperationBackup";
+  static readonly typeName = "v1.OperationAcknowledgement";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 3, name: "last_status", kind: "message", T: BackupProgressEntry },
-    { no: 4, name: "errors", kind: "message", T: BackupProgressError, repeated: true },
+    { no: 1, name: "user", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "unix_time_ms", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "note", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): OperationBackup {
-    return new OperationBackup().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): OperationAcknowledgement {
+    return new OperationAcknowledgement().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): OperationBackup {
-    return new OperationBackup().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): OperationAcknowledgement {
+    return new OperationAcknowledgement().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): OperationBackup {
-    return new OperationBackup().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): OperationAcknowledgement {
+    return new OperationAcknowledgement().fromJsonString(jsonString, options);
   }
 
-  static equals(a: OperationBackup | PlainMessage<OperationBackup> | undefined, b: OperationBackup | PlainMessage<OperationBackup> | undefined): boolean {
-    return proto3.util.equals(OperationBackup, a, b);
+  static equals(a: OperationAcknowledgement | PlainMessage<OperationAcknowledgement> | undefined, b: OperationAcknowledgement | PlainMessage<OperationAcknowledgement> | undefined): boolean {
+    return proto3.util.equals(OperationAcknowledgement, a, b);
   }
 }
 
 /**
- * OperationIndexSnapshot tracks that a snapshot was detected by backrest. 
- *
- * @generated from message v1.OperationIndexSnapshot
+ * @generated from message v1.OperationBackup
  */
-export class OperationIndexSnapshot extends Message<OperationIndexSnapshot> {
+export class OperationBackup extends Message<OperationBackup> {
   /**
-   * the snapshot that was indexed.
-   *
-   * @generated from field: v1.ResticSnapshot snapshot = 2;
+   * @generated from field: v1.BackupProgressEntry last_status = 3;
    */
-  snapshot?: ResticSnapshot;
+  lastStatus?: BackupProgressEntry;
+
+  /**
+   * @generated from field: repeated v1.BackupProgressError errors = 4;
+   */
+  errors: BackupProgressError[] = [];
+
+  /**
+   * paths that added the most data to the repo, largest first.
+   *
+   * @generated from field: repeated v1.DataAddedEntry data_added = 5;
+   */
+  dataAdded: DataAddedEntry[] = [];
+
+  /**
+   * pre-flight warnings e.g. paths spanning network file systems.
+   *
+   * @generated from field: repeated string warnings = 6;
+   */
+  warnings: string[] = [];
+
+  /**
+   * sampled files of the snapshot compared with the source files, set if the plan verifies backups.
+   *
+   * @generated from field: v1.RestoreVerification verification = 7;
+   */
+  verification?: RestoreVerification;
+
+  /**
+   * set if the backup deviated from the plan's baseline.
+   *
+   * @generated from field: v1.BackupAnomaly anomaly = 8;
+   */
+  anomaly?: BackupAnomaly;
+
+  constructor(data?: PartialMessage<OperationBackup>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.OperationBackup";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 3, name: "last_status", kind: "message", T: BackupProgressEntry },
+    { no: 4, name: "errors", kind: "message", T: BackupProgressError, repeated: true },
+    { no: 5, name: "data_added", kind: "message", T: DataAddedEntry, repeated: true },
+    { no: 6, name: "warnings", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 7, name: "verification", kind: "message", T: RestoreVerification },
+    { no: 8, name: "anomaly", kind: "message", T: BackupAnomaly },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): OperationBackup {
+    return new OperationBackup().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): OperationBackup {
+    return new OperationBackup().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): OperationBackup {
+    return new OperationBackup().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: OperationBackup | PlainMessage<OperationBackup> | undefined, b: OperationBackup | PlainMessage<OperationBackup> | undefined): boolean {
+    return proto3.util.equals(OperationBackup, a, b);
+  }
+}
+
+/**
+ * BackupAnomaly describes how a backup deviated from the baseline of the plan's previous backups.
+ *
+ * @generated from message v1.BackupAnomaly
+ */
+export class BackupAnomaly extends Message<BackupAnomaly> {
+  /**
+   * human readable descriptions of each deviation.
+   *
+   * @generated from field: repeated string reasons = 1;
+   */
+  reasons: string[] = [];
+
+  /**
+   * median data added by the baseline's backups.
+   *
+   * @generated from field: int64 baseline_data_added = 2;
+   */
+  baselineDataAdded = protoInt64.zero;
+
+  /**
+   * percentage of the previously existing files the backup found changed.
+   *
+   * @generated from field: double changed_files_percent = 3;
+   */
+  changedFilesPercent = 0;
+
+  constructor(data?: PartialMessage<BackupAnomaly>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.BackupAnomaly";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "reasons", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 2, name: "baseline_data_added", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "changed_files_percent", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): BackupAnomaly {
+    return new BackupAnomaly().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): BackupAnomaly {
+    return new BackupAnomaly().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): BackupAnomaly {
+    return new BackupAnomaly().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: BackupAnomaly | PlainMessage<BackupAnomaly> | undefined, b: BackupAnomaly | PlainMessage<BackupAnomaly> | undefined): boolean {
+    return proto3.util.equals(BackupAnomaly, a, b);
+  }
+}
+
+/**
+ * DataAddedEntry is a file or directory that contributed to the data added by a backup.
+ *
+ * @generated from message v1.DataAddedEntry
+ */
+export class DataAddedEntry extends Message<DataAddedEntry> {
+  /**
+   * @generated from field: string path = 1;
+   */
+  path = "";
+
+  /**
+   * data added to the repo after deduplication and compression.
+   *
+   * @generated from field: int64 bytes = 2;
+   */
+  bytes = protoInt64.zero;
+
+  /**
+   * new or modified files beneath the path.
+   *
+   * @generated from field: int64 files = 3;
+   */
+  files = protoInt64.zero;
+
+  constructor(data?: PartialMessage<DataAddedEntry>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.DataAddedEntry";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "bytes", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "files", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DataAddedEntry {
+    return new DataAddedEntry().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DataAddedEntry {
+    return new DataAddedEntry().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DataAddedEntry {
+    return new DataAddedEntry().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DataAddedEntry | PlainMessage<DataAddedEntry> | undefined, b: DataAddedEntry | PlainMessage<DataAddedEntry> | undefined): boolean {
+    return proto3.util.equals(DataAddedEntry, a, b);
+  }
+}
+
+/**
+ * OperationIndexSnapshot tracks that a snapshot was detected by backrest. 
+ *
+ * @generated from message v1.OperationIndexSnapshot
+ */
+export class OperationIndexSnapshot extends Message<OperationIndexSnapshot> {
+  /**
+   * the snapshot that was indexed.
+   *
+   * @generated from field: v1.ResticSnapshot snapshot = 2;
+   */
+  snapshot?: ResticSnapshot;
 
   /**
    * tracks whether this snapshot is forgotten yet.
@@ -413,6 +997,13 @@ export class OperationIndexSnapshot extends Message<OperationIndexSnapshot> {
    */
   forgotByOp = protoInt64.zero;
 
+  /**
+   * note documenting the snapshot, set with AnnotateSnapshot.
+   *
+   * @generated from field: string note = 5;
+   */
+  note = "";
+
   constructor(data?: PartialMessage<OperationIndexSnapshot>) {
     super();
     proto3.util.initPartial(data, this);
@@ -424,6 +1015,7 @@ export class OperationIndexSnapshot extends Message<OperationIndexSnapshot> {
     { no: 2, name: "snapshot", kind: "message", T: ResticSnapshot },
     { no: 3, name: "forgot", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
     { no: 4, name: "forgot_by_op", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 5, name: "note", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): OperationIndexSnapshot {
@@ -459,6 +1051,27 @@ export class OperationForget extends Message<OperationForget> {
    */
   policy?: RetentionPolicy;
 
+  /**
+   * estimated bytes a prune would reclaim, only set if the repo's prune policy has a min_reclaimable_bytes threshold.
+   *
+   * @generated from field: int64 reclaimable_bytes_estimate = 3;
+   */
+  reclaimableBytesEstimate = protoInt64.zero;
+
+  /**
+   * the estimate was below the threshold so no prune was scheduled.
+   *
+   * @generated from field: bool prune_skipped = 4;
+   */
+  pruneSkipped = false;
+
+  /**
+   * snapshots marked for deletion instead of forgotten since the repo delays deletion.
+   *
+   * @generated from field: repeated v1.ResticSnapshot marked = 5;
+   */
+  marked: ResticSnapshot[] = [];
+
   constructor(data?: PartialMessage<OperationForget>) {
     super();
     proto3.util.initPartial(data, this);
@@ -469,6 +1082,9 @@ export class OperationForget extends Message<OperationForget> {
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "forget", kind: "message", T: ResticSnapshot, repeated: true },
     { no: 2, name: "policy", kind: "message", T: RetentionPolicy },
+    { no: 3, name: "reclaimable_bytes_estimate", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "prune_skipped", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "marked", kind: "message", T: ResticSnapshot, repeated: true },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): OperationForget {
@@ -501,6 +1117,13 @@ export class OperationPrune extends Message<OperationPrune> {
    */
   output = "";
 
+  /**
+   * amounts of data the prune removed, parsed from the output. Unset if the output couldn't be parsed.
+   *
+   * @generated from field: v1.PruneStats stats = 2;
+   */
+  stats?: PruneStats;
+
   constructor(data?: PartialMessage<OperationPrune>) {
     super();
     proto3.util.initPartial(data, this);
@@ -510,6 +1133,7 @@ export class OperationPrune extends Message<OperationPrune> {
   static readonly typeName = "v1.OperationPrune";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "output", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "stats", kind: "message", T: PruneStats },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): OperationPrune {
@@ -529,6 +1153,87 @@ export class OperationPrune extends Message<OperationPrune> {
   }
 }
 
+/**
+ * PruneStats are the amounts of data removed by a prune.
+ *
+ * @generated from message v1.PruneStats
+ */
+export class PruneStats extends Message<PruneStats> {
+  /**
+   * size of the unused blobs removed from the repo.
+   *
+   * @generated from field: int64 bytes_freed = 1;
+   */
+  bytesFreed = protoInt64.zero;
+
+  /**
+   * number of unused blobs removed from the repo.
+   *
+   * @generated from field: int64 blobs_removed = 2;
+   */
+  blobsRemoved = protoInt64.zero;
+
+  /**
+   * size of the still used blobs rewritten from partly unused packs.
+   *
+   * @generated from field: int64 bytes_repacked = 3;
+   */
+  bytesRepacked = protoInt64.zero;
+
+  /**
+   * size of the blobs in the repo after the prune.
+   *
+   * @generated from field: int64 bytes_remaining = 4;
+   */
+  bytesRemaining = protoInt64.zero;
+
+  /**
+   * size of the unused blobs left in the repo, see the prune policy's max unused.
+   *
+   * @generated from field: int64 bytes_unused_remaining = 5;
+   */
+  bytesUnusedRemaining = protoInt64.zero;
+
+  /**
+   * number of pack files deleted.
+   *
+   * @generated from field: int64 packs_deleted = 6;
+   */
+  packsDeleted = protoInt64.zero;
+
+  constructor(data?: PartialMessage<PruneStats>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.PruneStats";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "bytes_freed", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "blobs_removed", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "bytes_repacked", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "bytes_remaining", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 5, name: "bytes_unused_remaining", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 6, name: "packs_deleted", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PruneStats {
+    return new PruneStats().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PruneStats {
+    return new PruneStats().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PruneStats {
+    return new PruneStats().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PruneStats | PlainMessage<PruneStats> | undefined, b: PruneStats | PlainMessage<PruneStats> | undefined): boolean {
+    return proto3.util.equals(PruneStats, a, b);
+  }
+}
+
 /**
  * @generated from message v1.OperationRestore
  */
@@ -554,6 +1259,20 @@ export class OperationRestore extends Message<OperationRestore> {
    */
   status?: RestoreProgressEntry;
 
+  /**
+   * options the restore was run with.
+   *
+   * @generated from field: v1.RestoreOptions options = 4;
+   */
+  options?: RestoreOptions;
+
+  /**
+   * set if verification was requested.
+   *
+   * @generated from field: v1.RestoreVerification verification = 5;
+   */
+  verification?: RestoreVerification;
+
   constructor(data?: PartialMessage<OperationRestore>) {
     super();
     proto3.util.initPartial(data, this);
@@ -565,6 +1284,8 @@ export class OperationRestore extends Message<OperationRestore> {
     { no: 1, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "target", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "status", kind: "message", T: RestoreProgressEntry },
+    { no: 4, name: "options", kind: "message", T: RestoreOptions },
+    { no: 5, name: "verification", kind: "message", T: RestoreVerification },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): OperationRestore {
@@ -593,6 +1314,13 @@ export class OperationStats extends Message<OperationStats> {
    */
   stats?: RepoStats;
 
+  /**
+   * stats cover only the operation's snapshot rather than the whole repo.
+   *
+   * @generated from field: bool snapshot_scoped = 2;
+   */
+  snapshotScoped = false;
+
   constructor(data?: PartialMessage<OperationStats>) {
     super();
     proto3.util.initPartial(data, this);
@@ -602,6 +1330,7 @@ export class OperationStats extends Message<OperationStats> {
   static readonly typeName = "v1.OperationStats";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "stats", kind: "message", T: RepoStats },
+    { no: 2, name: "snapshot_scoped", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): OperationStats {
@@ -668,3 +1397,309 @@ export class OperationRunHook extends Message<OperationRunHook> {
   }
 }
 
+/**
+ * OperationMigrate tracks a restic migrate operation applied to a repo.
+ *
+ * @generated from message v1.OperationMigrate
+ */
+export class OperationMigrate extends Message<OperationMigrate> {
+  /**
+   * name of the migration e.g. upgrade_repo_v2.
+   *
+   * @generated from field: string migration = 1;
+   */
+  migration = "";
+
+  /**
+   * output of the migration.
+   *
+   * @generated from field: string output = 2;
+   */
+  output = "";
+
+  constructor(data?: PartialMessage<OperationMigrate>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.OperationMigrate";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "migration", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "output", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): OperationMigrate {
+    return new OperationMigrate().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): OperationMigrate {
+    return new OperationMigrate().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): OperationMigrate {
+    return new OperationMigrate().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: OperationMigrate | PlainMessage<OperationMigrate> | undefined, b: OperationMigrate | PlainMessage<OperationMigrate> | undefined): boolean {
+    return proto3.util.equals(OperationMigrate, a, b);
+  }
+}
+
+/**
+ * OperationCacheCleanup tracks a cleanup of the restic cache used by a repo.
+ *
+ * @generated from message v1.OperationCacheCleanup
+ */
+export class OperationCacheCleanup extends Message<OperationCacheCleanup> {
+  /**
+   * output of restic cache --cleanup.
+   *
+   * @generated from field: string output = 1;
+   */
+  output = "";
+
+  /**
+   * size of the repo's cache before the cleanup.
+   *
+   * @generated from field: int64 size_before_bytes = 2;
+   */
+  sizeBeforeBytes = protoInt64.zero;
+
+  /**
+   * size of the repo's cache after the cleanup.
+   *
+   * @generated from field: int64 size_after_bytes = 3;
+   */
+  sizeAfterBytes = protoInt64.zero;
+
+  /**
+   * the repo's cache exceeded the maximum size and was cleared.
+   *
+   * @generated from field: bool cleared = 4;
+   */
+  cleared = false;
+
+  constructor(data?: PartialMessage<OperationCacheCleanup>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.OperationCacheCleanup";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "output", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "size_before_bytes", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "size_after_bytes", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "cleared", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): OperationCacheCleanup {
+    return new OperationCacheCleanup().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): OperationCacheCleanup {
+    return new OperationCacheCleanup().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): OperationCacheCleanup {
+    return new OperationCacheCleanup().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: OperationCacheCleanup | PlainMessage<OperationCacheCleanup> | undefined, b: OperationCacheCleanup | PlainMessage<OperationCacheCleanup> | undefined): boolean {
+    return proto3.util.equals(OperationCacheCleanup, a, b);
+  }
+}
+
+/**
+ * OperationWarmup tracks a run of the warmup command of a repo on cold storage.
+ *
+ * @generated from message v1.OperationWarmup
+ */
+export class OperationWarmup extends Message<OperationWarmup> {
+  /**
+   * output of the warmup command.
+   *
+   * @generated from field: string output = 1;
+   */
+  output = "";
+
+  /**
+   * operations reading pack data are allowed until this time once the warmup succeeded.
+   *
+   * @generated from field: int64 valid_until_unix_ms = 2;
+   */
+  validUntilUnixMs = protoInt64.zero;
+
+  constructor(data?: PartialMessage<OperationWarmup>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.OperationWarmup";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "output", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "valid_until_unix_ms", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): OperationWarmup {
+    return new OperationWarmup().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): OperationWarmup {
+    return new OperationWarmup().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): OperationWarmup {
+    return new OperationWarmup().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: OperationWarmup | PlainMessage<OperationWarmup> | undefined, b: OperationWarmup | PlainMessage<OperationWarmup> | undefined): boolean {
+    return proto3.util.equals(OperationWarmup, a, b);
+  }
+}
+
+/**
+ * OperationBenchmark tracks a measurement of a repo backend's throughput using temporary random test data, the data
+ * is forgotten and pruned afterwards.
+ *
+ * @generated from message v1.OperationBenchmark
+ */
+export class OperationBenchmark extends Message<OperationBenchmark> {
+  /**
+   * size of the test data.
+   *
+   * @generated from field: int64 size_bytes = 1;
+   */
+  sizeBytes = protoInt64.zero;
+
+  /**
+   * median duration of a restic command making a single small request, includes restic's startup and key derivation.
+   *
+   * @generated from field: int64 latency_ms = 2;
+   */
+  latencyMs = protoInt64.zero;
+
+  /**
+   * duration of the backup of the test data.
+   *
+   * @generated from field: int64 upload_ms = 3;
+   */
+  uploadMs = protoInt64.zero;
+
+  /**
+   * duration of reading the test data back.
+   *
+   * @generated from field: int64 download_ms = 4;
+   */
+  downloadMs = protoInt64.zero;
+
+  /**
+   * @generated from field: double upload_bytes_per_second = 5;
+   */
+  uploadBytesPerSecond = 0;
+
+  /**
+   * @generated from field: double download_bytes_per_second = 6;
+   */
+  downloadBytesPerSecond = 0;
+
+  /**
+   * the test data was removed from the repo.
+   *
+   * @generated from field: bool cleaned = 7;
+   */
+  cleaned = false;
+
+  constructor(data?: PartialMessage<OperationBenchmark>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.OperationBenchmark";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "size_bytes", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "latency_ms", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "upload_ms", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "download_ms", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 5, name: "upload_bytes_per_second", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 6, name: "download_bytes_per_second", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 7, name: "cleaned", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): OperationBenchmark {
+    return new OperationBenchmark().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): OperationBenchmark {
+    return new OperationBenchmark().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): OperationBenchmark {
+    return new OperationBenchmark().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: OperationBenchmark | PlainMessage<OperationBenchmark> | undefined, b: OperationBenchmark | PlainMessage<OperationBenchmark> | undefined): boolean {
+    return proto3.util.equals(OperationBenchmark, a, b);
+  }
+}
+
+/**
+ * OperationRestoreDrill tracks a test restore of part of a snapshot to a temporary directory.
+ *
+ * @generated from message v1.OperationRestoreDrill
+ */
+export class OperationRestoreDrill extends Message<OperationRestoreDrill> {
+  /**
+   * paths in the snapshot that were restored.
+   *
+   * @generated from field: repeated string paths = 1;
+   */
+  paths: string[] = [];
+
+  /**
+   * status of the restore.
+   *
+   * @generated from field: v1.RestoreProgressEntry status = 2;
+   */
+  status?: RestoreProgressEntry;
+
+  /**
+   * result of verifying the restored files.
+   *
+   * @generated from field: v1.RestoreVerification verification = 3;
+   */
+  verification?: RestoreVerification;
+
+  constructor(data?: PartialMessage<OperationRestoreDrill>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.OperationRestoreDrill";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "paths", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 2, name: "status", kind: "message", T: RestoreProgressEntry },
+    { no: 3, name: "verification", kind: "message", T: RestoreVerification },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): OperationRestoreDrill {
+    return new OperationRestoreDrill().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): OperationRestoreDrill {
+    return new OperationRestoreDrill().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): OperationRestoreDrill {
+    return new OperationRestoreDrill().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: OperationRestoreDrill | PlainMessage<OperationRestoreDrill> | undefined, b: OperationRestoreDrill | PlainMessage<OperationRestoreDrill> | undefined): boolean {
+    return proto3.util.equals(OperationRestoreDrill, a, b);
+  }
+}
+
@@ -6,6 +6,80 @@
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
 
+/**
+ * ErrorClass is the kind of failure a task ran into, derived from restic's output and exit code.
+ *
+ * @generated from enum v1.ErrorClass
+ */
+export enum ErrorClass {
+  /**
+   * unclassified or no error.
+   *
+   * @generated from enum value: ERROR_CLASS_UNKNOWN = 0;
+   */
+  UNKNOWN = 0,
+
+  /**
+   * wrong repo password or rejected backend credentials.
+   *
+   * @generated from enum value: ERROR_CLASS_AUTH = 1;
+   */
+  AUTH = 1,
+
+  /**
+   * the backend could not be reached or the connection timed out.
+   *
+   * @generated from enum value: ERROR_CLASS_NETWORK = 2;
+   */
+  NETWORK = 2,
+
+  /**
+   * the repo is locked by another process.
+   *
+   * @generated from enum value: ERROR_CLASS_LOCKED = 3;
+   */
+  LOCKED = 3,
+
+  /**
+   * a file system or the backend ran out of space.
+   *
+   * @generated from enum value: ERROR_CLASS_NO_SPACE = 4;
+   */
+  NO_SPACE = 4,
+
+  /**
+   * access to a file or the repo was denied.
+   *
+   * @generated from enum value: ERROR_CLASS_PERMISSION = 5;
+   */
+  PERMISSION = 5,
+
+  /**
+   * the repo contains damaged packs, blobs or indexes.
+   *
+   * @generated from enum value: ERROR_CLASS_CORRUPTED = 6;
+   */
+  CORRUPTED = 6,
+
+  /**
+   * the task exceeded its timeout.
+   *
+   * @generated from enum value: ERROR_CLASS_TIMEOUT = 7;
+   */
+  TIMEOUT = 7,
+}
+// Retrieve enum metadata with: proto3.getEnumType(ErrorClass)
+proto3.util.setEnumType(ErrorClass, "v1.ErrorClass", [
+  { no: 0, name: "ERROR_CLASS_UNKNOWN" },
+  { no: 1, name: "ERROR_CLASS_AUTH" },
+  { no: 2, name: "ERROR_CLASS_NETWORK" },
+  { no: 3, name: "ERROR_CLASS_LOCKED" },
+  { no: 4, name: "ERROR_CLASS_NO_SPACE" },
+  { no: 5, name: "ERROR_CLASS_PERMISSION" },
+  { no: 6, name: "ERROR_CLASS_CORRUPTED" },
+  { no: 7, name: "ERROR_CLASS_TIMEOUT" },
+]);
+
 /**
  * ResticSnapshot represents a restic snapshot.
  *
@@ -498,6 +572,172 @@ export class RestoreProgressEntry extends Message<RestoreProgressEntry> {
   }
 }
 
+/**
+ * RestoreOptions controls how a snapshot is restored.
+ *
+ * @generated from message v1.RestoreOptions
+ */
+export class RestoreOptions extends Message<RestoreOptions> {
+  /**
+   * restore files to their original location, target is ignored.
+   *
+   * @generated from field: bool restore_to_original = 1;
+   */
+  restoreToOriginal = false;
+
+  /**
+   * @generated from field: v1.RestoreOptions.OverwritePolicy overwrite = 2;
+   */
+  overwrite = RestoreOptions_OverwritePolicy.OVERWRITE_ALWAYS;
+
+  /**
+   * restic include patterns, relative patterns are matched beneath the restored path.
+   *
+   * @generated from field: repeated string includes = 3;
+   */
+  includes: string[] = [];
+
+  /**
+   * restic exclude patterns.
+   *
+   * @generated from field: repeated string excludes = 4;
+   */
+  excludes: string[] = [];
+
+  /**
+   * restored files are owned by the user running backrest rather than the snapshot's owner.
+   *
+   * @generated from field: bool skip_ownership = 5;
+   */
+  skipOwnership = false;
+
+  /**
+   * restored files get default permissions rather than the snapshot's mode.
+   *
+   * @generated from field: bool skip_permissions = 6;
+   */
+  skipPermissions = false;
+
+  /**
+   * verify the restored files against the snapshot once the restore completes.
+   *
+   * @generated from field: bool verify = 7;
+   */
+  verify = false;
+
+  constructor(data?: PartialMessage<RestoreOptions>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.RestoreOptions";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "restore_to_original", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "overwrite", kind: "enum", T: proto3.getEnumType(RestoreOptions_OverwritePolicy) },
+    { no: 3, name: "includes", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 4, name: "excludes", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 5, name: "skip_ownership", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 6, name: "skip_permissions", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 7, name: "verify", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RestoreOptions {
+    return new RestoreOptions().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RestoreOptions {
+    return new RestoreOptions().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RestoreOptions {
+    return new RestoreOptions().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RestoreOptions | PlainMessage<RestoreOptions> | undefined, b: RestoreOptions | PlainMessage<RestoreOptions> | undefined): boolean {
+    return proto3.util.equals(RestoreOptions, a, b);
+  }
+}
+
+/**
+ * @generated from enum v1.RestoreOptions.OverwritePolicy
+ */
+export enum RestoreOptions_OverwritePolicy {
+  /**
+   * replace files that already exist at the target.
+   *
+   * @generated from enum value: OVERWRITE_ALWAYS = 0;
+   */
+  OVERWRITE_ALWAYS = 0,
+
+  /**
+   * skip files that already exist at the target.
+   *
+   * @generated from enum value: OVERWRITE_NEVER = 1;
+   */
+  OVERWRITE_NEVER = 1,
+
+  /**
+   * replace existing files only if the snapshot's copy has a newer mtime.
+   *
+   * @generated from enum value: OVERWRITE_IF_NEWER = 2;
+   */
+  OVERWRITE_IF_NEWER = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(RestoreOptions_OverwritePolicy)
+proto3.util.setEnumType(RestoreOptions_OverwritePolicy, "v1.RestoreOptions.OverwritePolicy", [
+  { no: 0, name: "OVERWRITE_ALWAYS" },
+  { no: 1, name: "OVERWRITE_NEVER" },
+  { no: 2, name: "OVERWRITE_IF_NEWER" },
+]);
+
+/**
+ * RestoreVerification is the result of checking restored files against the snapshot.
+ *
+ * @generated from message v1.RestoreVerification
+ */
+export class RestoreVerification extends Message<RestoreVerification> {
+  /**
+   * @generated from field: int64 files_checked = 1;
+   */
+  filesChecked = protoInt64.zero;
+
+  /**
+   * one entry per restored path that does not match the snapshot.
+   *
+   * @generated from field: repeated string mismatches = 2;
+   */
+  mismatches: string[] = [];
+
+  constructor(data?: PartialMessage<RestoreVerification>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.RestoreVerification";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "files_checked", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "mismatches", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RestoreVerification {
+    return new RestoreVerification().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RestoreVerification {
+    return new RestoreVerification().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RestoreVerification {
+    return new RestoreVerification().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RestoreVerification | PlainMessage<RestoreVerification> | undefined, b: RestoreVerification | PlainMessage<RestoreVerification> | undefined): boolean {
+    return proto3.util.equals(RestoreVerification, a, b);
+  }
+}
+
 /**
  * @generated from message v1.RepoStats
  */
@@ -527,6 +767,20 @@ export class RepoStats extends Message<RepoStats> {
    */
   snapshotCount = protoInt64.zero;
 
+  /**
+   * total size of the repo's snapshots if restored, from restic stats --mode restore-size.
+   *
+   * @generated from field: int64 restore_size = 7;
+   */
+  restoreSize = protoInt64.zero;
+
+  /**
+   * number of files and directories in the repo's snapshots if restored.
+   *
+   * @generated from field: int64 restore_file_count = 8;
+   */
+  restoreFileCount = protoInt64.zero;
+
   constructor(data?: PartialMessage<RepoStats>) {
     super();
     proto3.util.initPartial(data, this);
@@ -540,6 +794,8 @@ export class RepoStats extends Message<RepoStats> {
     { no: 3, name: "compression_ratio", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
     { no: 5, name: "total_blob_count", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
     { no: 6, name: "snapshot_count", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 7, name: "restore_size", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 8, name: "restore_file_count", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RepoStats {
@@ -4,11 +4,14 @@
 // @ts-nocheck
 
 import { Empty, MethodKind } from "@bufbuild/protobuf";
-import { Config, Repo } from "./config_pb.js";
-import { OperationEvent, OperationList } from "./operations_pb.js";
-import { ClearHistoryRequest, ForgetRequest, GetOperationsRequest, ListSnapshotFilesRequest, ListSnapshotFilesResponse, ListSnapshotsRequest, LogDataRequest, RestoreSnapshotRequest } from "./service_pb.js";
-import { ResticSnapshotList } from "./restic_pb.js";
+import { Config, ConfigRevisionList, Notifications, Repo } from "./config_pb.js";
+import { AcknowledgeOperationRequest, AdhocBackupRequest, AnnotateSnapshotRequest, BenchmarkRepoRequest, CheckOplogIntegrityRequest, ClearHistoryRequest, DeletePlanRequest, DeleteRepoRequest, DurationEstimateList, ExclusionSuggestionList, FileHistory, ForgetRequest, GetAuditLogRequest, GetFileHistoryRequest, GetLargestFilesRequest, GetLargestFilesResponse, GetOperationsRequest, GetRecoveryBundleRequest, GetRemoteOperationsRequest, GetRepoHealthRequest, GetRunsRequest, InitRepoRequest, InstantiatePlanTemplateRequest, ListSnapshotFilesRequest, ListSnapshotFilesResponse, ListSnapshotsRequest, LogDataRequest, MigrateRepoRequest, OplogIntegrityReport, PinSnapshotRequest, QueryOperationsRequest, QueryOperationsResponse, ReclaimedSpace, RemoteStatusList, RepoProbe, RescueSnapshotRequest, ResourceUsageSummaryList, ResticUpdateStatus, RestoreSnapshotRequest, RunList, SubscribeOperationsRequest, TeardownResult, UpdateResticRequest } from "./service_pb.js";
 import { BytesValue, Int64Value, StringList, StringValue } from "../types/value_pb.js";
+import { Operation, OperationEvent, OperationList } from "./operations_pb.js";
+import { ResticSnapshotList } from "./restic_pb.js";
+import { RepoCacheStats, RepoHealth } from "./health_pb.js";
+import { AuditEntryList } from "./audit_pb.js";
+import { GetMetricSeriesRequest, MetricSeriesList } from "./metrics_pb.js";
 
 /**
  * @generated from service v1.Backrest
@@ -43,6 +46,73 @@ export const Backrest = {
       O: Config,
       kind: MethodKind.Unary,
     },
+    /**
+     * ImportRepo adds a pre-existing restic repository without initializing it and indexes its snapshots grouped by hostname and tags.
+     *
+     * @generated from rpc v1.Backrest.ImportRepo
+     */
+    importRepo: {
+      name: "ImportRepo",
+      I: Repo,
+      O: Config,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ProbeRepo checks whether a restic repo exists at the repo's URI and whether the repo's password opens it.
+     *
+     * @generated from rpc v1.Backrest.ProbeRepo
+     */
+    probeRepo: {
+      name: "ProbeRepo",
+      I: Repo,
+      O: RepoProbe,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * GenerateRepoPassword returns a random password for a new repo.
+     *
+     * @generated from rpc v1.Backrest.GenerateRepoPassword
+     */
+    generateRepoPassword: {
+      name: "GenerateRepoPassword",
+      I: Empty,
+      O: StringValue,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * InitRepo initializes a new restic repo, verifies that it opens and adds it to the config. A local repo it created
+     * is removed again if a step fails. Unlike AddRepo it fails if a repo already exists at the URI.
+     *
+     * @generated from rpc v1.Backrest.InitRepo
+     */
+    initRepo: {
+      name: "InitRepo",
+      I: InitRepoRequest,
+      O: Config,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * GetConfigHistory returns the revisions of the config that have been written, oldest first.
+     *
+     * @generated from rpc v1.Backrest.GetConfigHistory
+     */
+    getConfigHistory: {
+      name: "GetConfigHistory",
+      I: Empty,
+      O: ConfigRevisionList,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * RollbackConfig restores the config to the given revision. The rollback is itself recorded as a new revision.
+     *
+     * @generated from rpc v1.Backrest.RollbackConfig
+     */
+    rollbackConfig: {
+      name: "RollbackConfig",
+      I: Int64Value,
+      O: Config,
+      kind: MethodKind.Unary,
+    },
     /**
      * @generated from rpc v1.Backrest.GetOperationEvents
      */
@@ -52,6 +122,17 @@ export const Backrest = {
       O: OperationEvent,
       kind: MethodKind.ServerStreaming,
     },
+    /**
+     * SubscribeOperations streams the oplog events matching the request's filters as they happen.
+     *
+     * @generated from rpc v1.Backrest.SubscribeOperations
+     */
+    subscribeOperations: {
+      name: "SubscribeOperations",
+      I: SubscribeOperationsRequest,
+      O: OperationEvent,
+      kind: MethodKind.ServerStreaming,
+    },
     /**
      * @generated from rpc v1.Backrest.GetOperations
      */
@@ -61,6 +142,17 @@ export const Backrest = {
       O: OperationList,
       kind: MethodKind.Unary,
     },
+    /**
+     * QueryOperations returns a page of the operations matching the request's filters.
+     *
+     * @generated from rpc v1.Backrest.QueryOperations
+     */
+    queryOperations: {
+      name: "QueryOperations",
+      I: QueryOperationsRequest,
+      O: QueryOperationsResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * @generated from rpc v1.Backrest.ListSnapshots
      */
@@ -79,6 +171,40 @@ export const Backrest = {
       O: ListSnapshotFilesResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * GetLargestFiles returns the largest files and directories of a snapshot, computed once per snapshot and cached.
+     *
+     * @generated from rpc v1.Backrest.GetLargestFiles
+     */
+    getLargestFiles: {
+      name: "GetLargestFiles",
+      I: GetLargestFilesRequest,
+      O: GetLargestFilesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * GetFileHistory returns the versions of a path across the snapshots of a repo, oldest first.
+     *
+     * @generated from rpc v1.Backrest.GetFileHistory
+     */
+    getFileHistory: {
+      name: "GetFileHistory",
+      I: GetFileHistoryRequest,
+      O: FileHistory,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * GetReclaimedSpace returns the space freed by the prunes and the snapshots removed by the forgets of a repo over
+     * time, the value is the repo ID.
+     *
+     * @generated from rpc v1.Backrest.GetReclaimedSpace
+     */
+    getReclaimedSpace: {
+      name: "GetReclaimedSpace",
+      I: StringValue,
+      O: ReclaimedSpace,
+      kind: MethodKind.Unary,
+    },
     /**
      * IndexSnapshots triggers indexin. It accepts a repo id and returns empty if the task is enqueued.
      *
@@ -101,6 +227,17 @@ export const Backrest = {
       O: Empty,
       kind: MethodKind.Unary,
     },
+    /**
+     * AdhocBackup runs a one-off backup of paths that need not belong to any plan, returns once the backup completes.
+     *
+     * @generated from rpc v1.Backrest.AdhocBackup
+     */
+    adhocBackup: {
+      name: "AdhocBackup",
+      I: AdhocBackupRequest,
+      O: Empty,
+      kind: MethodKind.Unary,
+    },
     /**
      * Prune schedules a prune operation. It accepts a plan id and returns empty if the task is enqueued.
      *
@@ -200,6 +337,326 @@ export const Backrest = {
       O: StringList,
       kind: MethodKind.Unary,
     },
+    /**
+     * GetRepoHealth returns the health of a repo, expensive checks are only run if requested.
+     *
+     * @generated from rpc v1.Backrest.GetRepoHealth
+     */
+    getRepoHealth: {
+      name: "GetRepoHealth",
+      I: GetRepoHealthRequest,
+      O: RepoHealth,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * CheckOplogIntegrity runs a consistency pass over the operation log, optionally repairing the problems found.
+     *
+     * @generated from rpc v1.Backrest.CheckOplogIntegrity
+     */
+    checkOplogIntegrity: {
+      name: "CheckOplogIntegrity",
+      I: CheckOplogIntegrityRequest,
+      O: OplogIntegrityReport,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * GetAuditLog returns the audit log entries matching the request, oldest first.
+     *
+     * @generated from rpc v1.Backrest.GetAuditLog
+     */
+    getAuditLog: {
+      name: "GetAuditLog",
+      I: GetAuditLogRequest,
+      O: AuditEntryList,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ExportAuditLog returns the audit log entries matching the request as newline delimited JSON.
+     *
+     * @generated from rpc v1.Backrest.ExportAuditLog
+     */
+    exportAuditLog: {
+      name: "ExportAuditLog",
+      I: GetAuditLogRequest,
+      O: BytesValue,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ListRepoMigrations returns the names of the restic migrations that can be applied to the repo.
+     *
+     * @generated from rpc v1.Backrest.ListRepoMigrations
+     */
+    listRepoMigrations: {
+      name: "ListRepoMigrations",
+      I: StringValue,
+      O: StringList,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * GetRepoCacheStats returns the location and size of the repo's restic cache.
+     *
+     * @generated from rpc v1.Backrest.GetRepoCacheStats
+     */
+    getRepoCacheStats: {
+      name: "GetRepoCacheStats",
+      I: StringValue,
+      O: RepoCacheStats,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * MigrateRepo applies a restic migration (e.g. upgrade_repo_v2) to the repo, no other tasks run while it is applied.
+     *
+     * @generated from rpc v1.Backrest.MigrateRepo
+     */
+    migrateRepo: {
+      name: "MigrateRepo",
+      I: MigrateRepoRequest,
+      O: Empty,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * WarmupRepo runs the warmup command of a repo on cold storage, operations reading pack data are allowed once it
+     * succeeds until the warmup expires.
+     *
+     * @generated from rpc v1.Backrest.WarmupRepo
+     */
+    warmupRepo: {
+      name: "WarmupRepo",
+      I: StringValue,
+      O: Empty,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * GetRecoveryBundle returns a text document with what is needed to restore from the repo using restic alone.
+     *
+     * @generated from rpc v1.Backrest.GetRecoveryBundle
+     */
+    getRecoveryBundle: {
+      name: "GetRecoveryBundle",
+      I: GetRecoveryBundleRequest,
+      O: BytesValue,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * GetNotificationTemplates returns every notification message of the locale, or of the configured locale if empty,
+     * with the configured overrides applied.
+     *
+     * @generated from rpc v1.Backrest.GetNotificationTemplates
+     */
+    getNotificationTemplates: {
+      name: "GetNotificationTemplates",
+      I: StringValue,
+      O: Notifications,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * GetRemoteStatus returns the plan status of this instance and of every configured remote instance.
+     *
+     * @generated from rpc v1.Backrest.GetRemoteStatus
+     */
+    getRemoteStatus: {
+      name: "GetRemoteStatus",
+      I: Empty,
+      O: RemoteStatusList,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * GetRemoteOperations returns operations of a remote instance, proxied read-only.
+     *
+     * @generated from rpc v1.Backrest.GetRemoteOperations
+     */
+    getRemoteOperations: {
+      name: "GetRemoteOperations",
+      I: GetRemoteOperationsRequest,
+      O: OperationList,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * GetRuns returns the operations of a plan or repo grouped into runs, oldest first.
+     *
+     * @generated from rpc v1.Backrest.GetRuns
+     */
+    getRuns: {
+      name: "GetRuns",
+      I: GetRunsRequest,
+      O: RunList,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * GetDurationEstimates returns the average durations of a plan's recent operations by operation type.
+     *
+     * @generated from rpc v1.Backrest.GetDurationEstimates
+     */
+    getDurationEstimates: {
+      name: "GetDurationEstimates",
+      I: StringValue,
+      O: DurationEstimateList,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * GetResourceUsage returns the total resources used by a plan's recorded operations by operation type.
+     *
+     * @generated from rpc v1.Backrest.GetResourceUsage
+     */
+    getResourceUsage: {
+      name: "GetResourceUsage",
+      I: StringValue,
+      O: ResourceUsageSummaryList,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * UpdateRestic checks for a newer restic release and installs it if apply is set. The release is verified against
+     * its published hashes and the restic signing key, the current binary is kept if the new one fails to run.
+     *
+     * @generated from rpc v1.Backrest.UpdateRestic
+     */
+    updateRestic: {
+      name: "UpdateRestic",
+      I: UpdateResticRequest,
+      O: ResticUpdateStatus,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * GetMetricSeries returns the downsampled history of a plan's metrics for charting.
+     *
+     * @generated from rpc v1.Backrest.GetMetricSeries
+     */
+    getMetricSeries: {
+      name: "GetMetricSeries",
+      I: GetMetricSeriesRequest,
+      O: MetricSeriesList,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * GetExclusionSuggestions returns the paths that added the most data across the plan's recent backups, candidates for excludes.
+     *
+     * @generated from rpc v1.Backrest.GetExclusionSuggestions
+     */
+    getExclusionSuggestions: {
+      name: "GetExclusionSuggestions",
+      I: StringValue,
+      O: ExclusionSuggestionList,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * AnnotateSnapshot sets the note documenting a snapshot, an empty note removes it.
+     *
+     * @generated from rpc v1.Backrest.AnnotateSnapshot
+     */
+    annotateSnapshot: {
+      name: "AnnotateSnapshot",
+      I: AnnotateSnapshotRequest,
+      O: Empty,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * PinSnapshot pins or unpins a snapshot, pinned snapshots are kept by retention policies. restic rewrites the
+     * snapshot so the returned ID replaces the snapshot's previous ID.
+     *
+     * @generated from rpc v1.Backrest.PinSnapshot
+     */
+    pinSnapshot: {
+      name: "PinSnapshot",
+      I: PinSnapshotRequest,
+      O: StringValue,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * RescueSnapshot unmarks a snapshot marked for delayed deletion and pins it so retention doesn't mark it again.
+     * restic rewrites the snapshot so the returned ID replaces the snapshot's previous ID.
+     *
+     * @generated from rpc v1.Backrest.RescueSnapshot
+     */
+    rescueSnapshot: {
+      name: "RescueSnapshot",
+      I: RescueSnapshotRequest,
+      O: StringValue,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * EmergencyStop cancels the running task and all queued tasks and pauses all schedules, e.g. when a source machine
+     * is suspected compromised. The stop persists across restarts until ResumeSchedules is called. The value is the
+     * optional reason for the stop.
+     *
+     * @generated from rpc v1.Backrest.EmergencyStop
+     */
+    emergencyStop: {
+      name: "EmergencyStop",
+      I: StringValue,
+      O: Config,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * ResumeSchedules lifts an emergency stop and reschedules all plans and repo maintenance.
+     *
+     * @generated from rpc v1.Backrest.ResumeSchedules
+     */
+    resumeSchedules: {
+      name: "ResumeSchedules",
+      I: Empty,
+      O: Config,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * InstantiatePlanTemplate adds a plan created from the template for each of the instances, all plans are added or
+     * none if any is invalid.
+     *
+     * @generated from rpc v1.Backrest.InstantiatePlanTemplate
+     */
+    instantiatePlanTemplate: {
+      name: "InstantiatePlanTemplate",
+      I: InstantiatePlanTemplateRequest,
+      O: Config,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * DeletePlan removes a plan from the config and tears down what it leaves behind, it fails if other plans still
+     * depend on the plan.
+     *
+     * @generated from rpc v1.Backrest.DeletePlan
+     */
+    deletePlan: {
+      name: "DeletePlan",
+      I: DeletePlanRequest,
+      O: TeardownResult,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * DeleteRepo removes a repo from the config and tears down what it leaves behind, it fails while plans or the self
+     * backup still use the repo. The repo's data is never deleted.
+     *
+     * @generated from rpc v1.Backrest.DeleteRepo
+     */
+    deleteRepo: {
+      name: "DeleteRepo",
+      I: DeleteRepoRequest,
+      O: TeardownResult,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * AcknowledgeOperation records that a user triaged a failed operation, or removes the acknowledgement. It returns
+     * the updated operation.
+     *
+     * @generated from rpc v1.Backrest.AcknowledgeOperation
+     */
+    acknowledgeOperation: {
+      name: "AcknowledgeOperation",
+      I: AcknowledgeOperationRequest,
+      O: Operation,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * BenchmarkRepo schedules a measurement of the repo backend's latency and upload and download throughput, the
+     * results are recorded in a benchmark operation of the repo.
+     *
+     * @generated from rpc v1.Backrest.BenchmarkRepo
+     */
+    benchmarkRepo: {
+      name: "BenchmarkRepo",
+      I: BenchmarkRepoRequest,
+      O: Empty,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
 
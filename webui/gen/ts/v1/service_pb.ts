@@ -5,324 +5,2631 @@
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
 import { Message, proto3, protoInt64 } from "@bufbuild/protobuf";
+import { Config, Repo } from "./config_pb.js";
+import { DurationEstimate, Operation, OperationEventType, OperationStatus, ResourceUsageSummary, Run } from "./operations_pb.js";
+import { RestoreOptions } from "./restic_pb.js";
+
+/**
+ * HistoryDisposition is what happens to the operation history of a deleted plan or repo.
+ *
+ * @generated from enum v1.HistoryDisposition
+ */
+export enum HistoryDisposition {
+  /**
+   * the operations stay in the history.
+   *
+   * @generated from enum value: HISTORY_KEEP = 0;
+   */
+  HISTORY_KEEP = 0,
+
+  /**
+   * the operations are written to a file in the data directory and removed from the history.
+   *
+   * @generated from enum value: HISTORY_ARCHIVE = 1;
+   */
+  HISTORY_ARCHIVE = 1,
+
+  /**
+   * the operations are removed from the history.
+   *
+   * @generated from enum value: HISTORY_DELETE = 2;
+   */
+  HISTORY_DELETE = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(HistoryDisposition)
+proto3.util.setEnumType(HistoryDisposition, "v1.HistoryDisposition", [
+  { no: 0, name: "HISTORY_KEEP" },
+  { no: 1, name: "HISTORY_ARCHIVE" },
+  { no: 2, name: "HISTORY_DELETE" },
+]);
+
+/**
+ * @generated from message v1.BenchmarkRepoRequest
+ */
+export class BenchmarkRepoRequest extends Message<BenchmarkRepoRequest> {
+  /**
+   * @generated from field: string repo_id = 1;
+   */
+  repoId = "";
+
+  /**
+   * optional, size of the test data, defaults to 64 MiB and at most 4 GiB.
+   *
+   * @generated from field: int64 size_bytes = 2;
+   */
+  sizeBytes = protoInt64.zero;
+
+  constructor(data?: PartialMessage<BenchmarkRepoRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.BenchmarkRepoRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "repo_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "size_bytes", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): BenchmarkRepoRequest {
+    return new BenchmarkRepoRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): BenchmarkRepoRequest {
+    return new BenchmarkRepoRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): BenchmarkRepoRequest {
+    return new BenchmarkRepoRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: BenchmarkRepoRequest | PlainMessage<BenchmarkRepoRequest> | undefined, b: BenchmarkRepoRequest | PlainMessage<BenchmarkRepoRequest> | undefined): boolean {
+    return proto3.util.equals(BenchmarkRepoRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.AcknowledgeOperationRequest
+ */
+export class AcknowledgeOperationRequest extends Message<AcknowledgeOperationRequest> {
+  /**
+   * @generated from field: int64 operation_id = 1;
+   */
+  operationId = protoInt64.zero;
+
+  /**
+   * @generated from field: string note = 2;
+   */
+  note = "";
+
+  /**
+   * remove the acknowledgement instead.
+   *
+   * @generated from field: bool unacknowledge = 3;
+   */
+  unacknowledge = false;
+
+  constructor(data?: PartialMessage<AcknowledgeOperationRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.AcknowledgeOperationRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "operation_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "note", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "unacknowledge", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AcknowledgeOperationRequest {
+    return new AcknowledgeOperationRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AcknowledgeOperationRequest {
+    return new AcknowledgeOperationRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AcknowledgeOperationRequest {
+    return new AcknowledgeOperationRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AcknowledgeOperationRequest | PlainMessage<AcknowledgeOperationRequest> | undefined, b: AcknowledgeOperationRequest | PlainMessage<AcknowledgeOperationRequest> | undefined): boolean {
+    return proto3.util.equals(AcknowledgeOperationRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.DeletePlanRequest
+ */
+export class DeletePlanRequest extends Message<DeletePlanRequest> {
+  /**
+   * @generated from field: string plan_id = 1;
+   */
+  planId = "";
+
+  /**
+   * apply the plan's retention policy one last time before it is removed.
+   *
+   * @generated from field: bool final_retention = 2;
+   */
+  finalRetention = false;
+
+  /**
+   * @generated from field: v1.HistoryDisposition history = 3;
+   */
+  history = HistoryDisposition.HISTORY_KEEP;
+
+  /**
+   * forget all of the plan's snapshots except pinned ones, requires confirm.
+   *
+   * @generated from field: bool forget_snapshots = 4;
+   */
+  forgetSnapshots = false;
+
+  /**
+   * must repeat the plan id to forget its snapshots.
+   *
+   * @generated from field: string confirm = 5;
+   */
+  confirm = "";
+
+  constructor(data?: PartialMessage<DeletePlanRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.DeletePlanRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "plan_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "final_retention", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "history", kind: "enum", T: proto3.getEnumType(HistoryDisposition) },
+    { no: 4, name: "forget_snapshots", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "confirm", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeletePlanRequest {
+    return new DeletePlanRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeletePlanRequest {
+    return new DeletePlanRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeletePlanRequest {
+    return new DeletePlanRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeletePlanRequest | PlainMessage<DeletePlanRequest> | undefined, b: DeletePlanRequest | PlainMessage<DeletePlanRequest> | undefined): boolean {
+    return proto3.util.equals(DeletePlanRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.DeleteRepoRequest
+ */
+export class DeleteRepoRequest extends Message<DeleteRepoRequest> {
+  /**
+   * @generated from field: string repo_id = 1;
+   */
+  repoId = "";
+
+  /**
+   * @generated from field: v1.HistoryDisposition history = 2;
+   */
+  history = HistoryDisposition.HISTORY_KEEP;
+
+  /**
+   * remove the repo's restic cache.
+   *
+   * @generated from field: bool remove_cache = 3;
+   */
+  removeCache = false;
+
+  constructor(data?: PartialMessage<DeleteRepoRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.DeleteRepoRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "repo_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "history", kind: "enum", T: proto3.getEnumType(HistoryDisposition) },
+    { no: 3, name: "remove_cache", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteRepoRequest {
+    return new DeleteRepoRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteRepoRequest {
+    return new DeleteRepoRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteRepoRequest {
+    return new DeleteRepoRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DeleteRepoRequest | PlainMessage<DeleteRepoRequest> | undefined, b: DeleteRepoRequest | PlainMessage<DeleteRepoRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteRepoRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.TeardownResult
+ */
+export class TeardownResult extends Message<TeardownResult> {
+  /**
+   * @generated from field: v1.Config config = 1;
+   */
+  config?: Config;
+
+  /**
+   * @generated from field: int64 operations_removed = 2;
+   */
+  operationsRemoved = protoInt64.zero;
+
+  /**
+   * path of the archived operations, if archived.
+   *
+   * @generated from field: string history_archive = 3;
+   */
+  historyArchive = "";
+
+  /**
+   * snapshots forgotten or marked for delayed deletion.
+   *
+   * @generated from field: int32 snapshots_forgotten = 4;
+   */
+  snapshotsForgotten = 0;
+
+  /**
+   * pinned snapshots that were kept.
+   *
+   * @generated from field: int32 snapshots_pinned = 5;
+   */
+  snapshotsPinned = 0;
+
+  /**
+   * @generated from field: int64 cache_bytes_removed = 6;
+   */
+  cacheBytesRemoved = protoInt64.zero;
+
+  constructor(data?: PartialMessage<TeardownResult>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.TeardownResult";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "config", kind: "message", T: Config },
+    { no: 2, name: "operations_removed", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "history_archive", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "snapshots_forgotten", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 5, name: "snapshots_pinned", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 6, name: "cache_bytes_removed", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TeardownResult {
+    return new TeardownResult().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TeardownResult {
+    return new TeardownResult().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TeardownResult {
+    return new TeardownResult().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TeardownResult | PlainMessage<TeardownResult> | undefined, b: TeardownResult | PlainMessage<TeardownResult> | undefined): boolean {
+    return proto3.util.equals(TeardownResult, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.InstantiatePlanTemplateRequest
+ */
+export class InstantiatePlanTemplateRequest extends Message<InstantiatePlanTemplateRequest> {
+  /**
+   * @generated from field: string template_id = 1;
+   */
+  templateId = "";
+
+  /**
+   * @generated from field: repeated v1.PlanTemplateInstance instances = 2;
+   */
+  instances: PlanTemplateInstance[] = [];
+
+  constructor(data?: PartialMessage<InstantiatePlanTemplateRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.InstantiatePlanTemplateRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "template_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "instances", kind: "message", T: PlanTemplateInstance, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): InstantiatePlanTemplateRequest {
+    return new InstantiatePlanTemplateRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): InstantiatePlanTemplateRequest {
+    return new InstantiatePlanTemplateRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): InstantiatePlanTemplateRequest {
+    return new InstantiatePlanTemplateRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: InstantiatePlanTemplateRequest | PlainMessage<InstantiatePlanTemplateRequest> | undefined, b: InstantiatePlanTemplateRequest | PlainMessage<InstantiatePlanTemplateRequest> | undefined): boolean {
+    return proto3.util.equals(InstantiatePlanTemplateRequest, a, b);
+  }
+}
+
+/**
+ * PlanTemplateInstance holds the variables of one plan created from a template. "hostname" defaults to the config's
+ * host, or the host's name if unset.
+ *
+ * @generated from message v1.PlanTemplateInstance
+ */
+export class PlanTemplateInstance extends Message<PlanTemplateInstance> {
+  /**
+   * @generated from field: map<string, string> vars = 1;
+   */
+  vars: { [key: string]: string } = {};
+
+  constructor(data?: PartialMessage<PlanTemplateInstance>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.PlanTemplateInstance";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "vars", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PlanTemplateInstance {
+    return new PlanTemplateInstance().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PlanTemplateInstance {
+    return new PlanTemplateInstance().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PlanTemplateInstance {
+    return new PlanTemplateInstance().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PlanTemplateInstance | PlainMessage<PlanTemplateInstance> | undefined, b: PlanTemplateInstance | PlainMessage<PlanTemplateInstance> | undefined): boolean {
+    return proto3.util.equals(PlanTemplateInstance, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.GetRunsRequest
+ */
+export class GetRunsRequest extends Message<GetRunsRequest> {
+  /**
+   * optional, one of plan_id or repo_id is required.
+   *
+   * @generated from field: string plan_id = 1;
+   */
+  planId = "";
+
+  /**
+   * optional
+   *
+   * @generated from field: string repo_id = 2;
+   */
+  repoId = "";
+
+  /**
+   * optional, only the most recent runs.
+   *
+   * @generated from field: int32 last_n = 3;
+   */
+  lastN = 0;
+
+  constructor(data?: PartialMessage<GetRunsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.GetRunsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "plan_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "repo_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "last_n", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetRunsRequest {
+    return new GetRunsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetRunsRequest {
+    return new GetRunsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetRunsRequest {
+    return new GetRunsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetRunsRequest | PlainMessage<GetRunsRequest> | undefined, b: GetRunsRequest | PlainMessage<GetRunsRequest> | undefined): boolean {
+    return proto3.util.equals(GetRunsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.UpdateResticRequest
+ */
+export class UpdateResticRequest extends Message<UpdateResticRequest> {
+  /**
+   * install the latest release if it is newer, otherwise only check.
+   *
+   * @generated from field: bool apply = 1;
+   */
+  apply = false;
+
+  constructor(data?: PartialMessage<UpdateResticRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.UpdateResticRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "apply", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateResticRequest {
+    return new UpdateResticRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateResticRequest {
+    return new UpdateResticRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateResticRequest {
+    return new UpdateResticRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateResticRequest | PlainMessage<UpdateResticRequest> | undefined, b: UpdateResticRequest | PlainMessage<UpdateResticRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateResticRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.ResticUpdateStatus
+ */
+export class ResticUpdateStatus extends Message<ResticUpdateStatus> {
+  /**
+   * @generated from field: string current_version = 1;
+   */
+  currentVersion = "";
+
+  /**
+   * @generated from field: string latest_version = 2;
+   */
+  latestVersion = "";
+
+  /**
+   * @generated from field: bool update_available = 3;
+   */
+  updateAvailable = false;
+
+  /**
+   * the latest release was installed and is used from now on.
+   *
+   * @generated from field: bool updated = 4;
+   */
+  updated = false;
+
+  constructor(data?: PartialMessage<ResticUpdateStatus>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.ResticUpdateStatus";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "current_version", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "latest_version", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "update_available", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "updated", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ResticUpdateStatus {
+    return new ResticUpdateStatus().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ResticUpdateStatus {
+    return new ResticUpdateStatus().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ResticUpdateStatus {
+    return new ResticUpdateStatus().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ResticUpdateStatus | PlainMessage<ResticUpdateStatus> | undefined, b: ResticUpdateStatus | PlainMessage<ResticUpdateStatus> | undefined): boolean {
+    return proto3.util.equals(ResticUpdateStatus, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.DurationEstimateList
+ */
+export class DurationEstimateList extends Message<DurationEstimateList> {
+  /**
+   * ordered by operation type.
+   *
+   * @generated from field: repeated v1.DurationEstimate estimates = 1;
+   */
+  estimates: DurationEstimate[] = [];
+
+  constructor(data?: PartialMessage<DurationEstimateList>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.DurationEstimateList";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "estimates", kind: "message", T: DurationEstimate, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DurationEstimateList {
+    return new DurationEstimateList().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DurationEstimateList {
+    return new DurationEstimateList().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DurationEstimateList {
+    return new DurationEstimateList().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: DurationEstimateList | PlainMessage<DurationEstimateList> | undefined, b: DurationEstimateList | PlainMessage<DurationEstimateList> | undefined): boolean {
+    return proto3.util.equals(DurationEstimateList, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.ResourceUsageSummaryList
+ */
+export class ResourceUsageSummaryList extends Message<ResourceUsageSummaryList> {
+  /**
+   * ordered by operation type.
+   *
+   * @generated from field: repeated v1.ResourceUsageSummary summaries = 1;
+   */
+  summaries: ResourceUsageSummary[] = [];
+
+  constructor(data?: PartialMessage<ResourceUsageSummaryList>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.ResourceUsageSummaryList";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "summaries", kind: "message", T: ResourceUsageSummary, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ResourceUsageSummaryList {
+    return new ResourceUsageSummaryList().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ResourceUsageSummaryList {
+    return new ResourceUsageSummaryList().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ResourceUsageSummaryList {
+    return new ResourceUsageSummaryList().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ResourceUsageSummaryList | PlainMessage<ResourceUsageSummaryList> | undefined, b: ResourceUsageSummaryList | PlainMessage<ResourceUsageSummaryList> | undefined): boolean {
+    return proto3.util.equals(ResourceUsageSummaryList, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.RunList
+ */
+export class RunList extends Message<RunList> {
+  /**
+   * @generated from field: repeated v1.Run runs = 1;
+   */
+  runs: Run[] = [];
+
+  constructor(data?: PartialMessage<RunList>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.RunList";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "runs", kind: "message", T: Run, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RunList {
+    return new RunList().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RunList {
+    return new RunList().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RunList {
+    return new RunList().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RunList | PlainMessage<RunList> | undefined, b: RunList | PlainMessage<RunList> | undefined): boolean {
+    return proto3.util.equals(RunList, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.ExclusionSuggestionList
+ */
+export class ExclusionSuggestionList extends Message<ExclusionSuggestionList> {
+  /**
+   * largest first.
+   *
+   * @generated from field: repeated v1.ExclusionSuggestion suggestions = 1;
+   */
+  suggestions: ExclusionSuggestion[] = [];
+
+  constructor(data?: PartialMessage<ExclusionSuggestionList>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.ExclusionSuggestionList";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "suggestions", kind: "message", T: ExclusionSuggestion, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExclusionSuggestionList {
+    return new ExclusionSuggestionList().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExclusionSuggestionList {
+    return new ExclusionSuggestionList().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExclusionSuggestionList {
+    return new ExclusionSuggestionList().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ExclusionSuggestionList | PlainMessage<ExclusionSuggestionList> | undefined, b: ExclusionSuggestionList | PlainMessage<ExclusionSuggestionList> | undefined): boolean {
+    return proto3.util.equals(ExclusionSuggestionList, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.ExclusionSuggestion
+ */
+export class ExclusionSuggestion extends Message<ExclusionSuggestion> {
+  /**
+   * @generated from field: string path = 1;
+   */
+  path = "";
+
+  /**
+   * data added to the repo by the path, summed over the backups.
+   *
+   * @generated from field: int64 bytes_added = 2;
+   */
+  bytesAdded = protoInt64.zero;
+
+  /**
+   * new or modified files beneath the path, summed over the backups.
+   *
+   * @generated from field: int64 files_added = 3;
+   */
+  filesAdded = protoInt64.zero;
+
+  /**
+   * number of the backups that added data beneath the path.
+   *
+   * @generated from field: int32 backups = 4;
+   */
+  backups = 0;
+
+  constructor(data?: PartialMessage<ExclusionSuggestion>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.ExclusionSuggestion";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "bytes_added", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "files_added", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "backups", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExclusionSuggestion {
+    return new ExclusionSuggestion().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExclusionSuggestion {
+    return new ExclusionSuggestion().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExclusionSuggestion {
+    return new ExclusionSuggestion().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ExclusionSuggestion | PlainMessage<ExclusionSuggestion> | undefined, b: ExclusionSuggestion | PlainMessage<ExclusionSuggestion> | undefined): boolean {
+    return proto3.util.equals(ExclusionSuggestion, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.RemoteStatusList
+ */
+export class RemoteStatusList extends Message<RemoteStatusList> {
+  /**
+   * this instance first with id "local", then the remote instances in config order.
+   *
+   * @generated from field: repeated v1.RemoteInstanceStatus instances = 1;
+   */
+  instances: RemoteInstanceStatus[] = [];
+
+  constructor(data?: PartialMessage<RemoteStatusList>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.RemoteStatusList";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "instances", kind: "message", T: RemoteInstanceStatus, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoteStatusList {
+    return new RemoteStatusList().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoteStatusList {
+    return new RemoteStatusList().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoteStatusList {
+    return new RemoteStatusList().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RemoteStatusList | PlainMessage<RemoteStatusList> | undefined, b: RemoteStatusList | PlainMessage<RemoteStatusList> | undefined): boolean {
+    return proto3.util.equals(RemoteStatusList, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.RemoteInstanceStatus
+ */
+export class RemoteInstanceStatus extends Message<RemoteInstanceStatus> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * empty for this instance.
+   *
+   * @generated from field: string url = 2;
+   */
+  url = "";
+
+  /**
+   * @generated from field: bool reachable = 3;
+   */
+  reachable = false;
+
+  /**
+   * why the instance could not be reached, empty if reachable.
+   *
+   * @generated from field: string error = 4;
+   */
+  error = "";
+
+  /**
+   * host name the instance tags its backups with.
+   *
+   * @generated from field: string host = 5;
+   */
+  host = "";
+
+  /**
+   * @generated from field: repeated v1.RemotePlanStatus plans = 6;
+   */
+  plans: RemotePlanStatus[] = [];
+
+  constructor(data?: PartialMessage<RemoteInstanceStatus>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.RemoteInstanceStatus";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "reachable", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "host", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "plans", kind: "message", T: RemotePlanStatus, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoteInstanceStatus {
+    return new RemoteInstanceStatus().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoteInstanceStatus {
+    return new RemoteInstanceStatus().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoteInstanceStatus {
+    return new RemoteInstanceStatus().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RemoteInstanceStatus | PlainMessage<RemoteInstanceStatus> | undefined, b: RemoteInstanceStatus | PlainMessage<RemoteInstanceStatus> | undefined): boolean {
+    return proto3.util.equals(RemoteInstanceStatus, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.RemotePlanStatus
+ */
+export class RemotePlanStatus extends Message<RemotePlanStatus> {
+  /**
+   * @generated from field: string plan_id = 1;
+   */
+  planId = "";
+
+  /**
+   * @generated from field: string repo_id = 2;
+   */
+  repoId = "";
+
+  /**
+   * most recent completed backup, unset if the plan has none.
+   *
+   * @generated from field: v1.Operation last_backup = 3;
+   */
+  lastBackup?: Operation;
+
+  /**
+   * most recent operation of any type, unset if the plan has none.
+   *
+   * @generated from field: v1.Operation last_operation = 4;
+   */
+  lastOperation?: Operation;
+
+  constructor(data?: PartialMessage<RemotePlanStatus>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.RemotePlanStatus";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "plan_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "repo_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "last_backup", kind: "message", T: Operation },
+    { no: 4, name: "last_operation", kind: "message", T: Operation },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemotePlanStatus {
+    return new RemotePlanStatus().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemotePlanStatus {
+    return new RemotePlanStatus().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemotePlanStatus {
+    return new RemotePlanStatus().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RemotePlanStatus | PlainMessage<RemotePlanStatus> | undefined, b: RemotePlanStatus | PlainMessage<RemotePlanStatus> | undefined): boolean {
+    return proto3.util.equals(RemotePlanStatus, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.GetRemoteOperationsRequest
+ */
+export class GetRemoteOperationsRequest extends Message<GetRemoteOperationsRequest> {
+  /**
+   * id of a remote instance in the config.
+   *
+   * @generated from field: string instance_id = 1;
+   */
+  instanceId = "";
+
+  /**
+   * @generated from field: v1.GetOperationsRequest request = 2;
+   */
+  request?: GetOperationsRequest;
+
+  constructor(data?: PartialMessage<GetRemoteOperationsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.GetRemoteOperationsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "instance_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "request", kind: "message", T: GetOperationsRequest },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetRemoteOperationsRequest {
+    return new GetRemoteOperationsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetRemoteOperationsRequest {
+    return new GetRemoteOperationsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetRemoteOperationsRequest {
+    return new GetRemoteOperationsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetRemoteOperationsRequest | PlainMessage<GetRemoteOperationsRequest> | undefined, b: GetRemoteOperationsRequest | PlainMessage<GetRemoteOperationsRequest> | undefined): boolean {
+    return proto3.util.equals(GetRemoteOperationsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.GetRecoveryBundleRequest
+ */
+export class GetRecoveryBundleRequest extends Message<GetRecoveryBundleRequest> {
+  /**
+   * @generated from field: string repo_id = 1;
+   */
+  repoId = "";
+
+  /**
+   * optional, ASCII armored OpenPGP public key the repo password is encrypted to.
+   *
+   * @generated from field: string public_key = 2;
+   */
+  publicKey = "";
+
+  constructor(data?: PartialMessage<GetRecoveryBundleRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.GetRecoveryBundleRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "repo_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "public_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetRecoveryBundleRequest {
+    return new GetRecoveryBundleRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetRecoveryBundleRequest {
+    return new GetRecoveryBundleRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetRecoveryBundleRequest {
+    return new GetRecoveryBundleRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetRecoveryBundleRequest | PlainMessage<GetRecoveryBundleRequest> | undefined, b: GetRecoveryBundleRequest | PlainMessage<GetRecoveryBundleRequest> | undefined): boolean {
+    return proto3.util.equals(GetRecoveryBundleRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.MigrateRepoRequest
+ */
+export class MigrateRepoRequest extends Message<MigrateRepoRequest> {
+  /**
+   * @generated from field: string repo_id = 1;
+   */
+  repoId = "";
+
+  /**
+   * name of the migration as listed by ListRepoMigrations.
+   *
+   * @generated from field: string migration = 2;
+   */
+  migration = "";
+
+  constructor(data?: PartialMessage<MigrateRepoRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.MigrateRepoRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "repo_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "migration", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): MigrateRepoRequest {
+    return new MigrateRepoRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): MigrateRepoRequest {
+    return new MigrateRepoRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): MigrateRepoRequest {
+    return new MigrateRepoRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: MigrateRepoRequest | PlainMessage<MigrateRepoRequest> | undefined, b: MigrateRepoRequest | PlainMessage<MigrateRepoRequest> | undefined): boolean {
+    return proto3.util.equals(MigrateRepoRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.GetRepoHealthRequest
+ */
+export class GetRepoHealthRequest extends Message<GetRepoHealthRequest> {
+  /**
+   * @generated from field: string repo_id = 1;
+   */
+  repoId = "";
+
+  /**
+   * run restic check (without reading pack data) as part of the health report.
+   *
+   * @generated from field: bool check = 2;
+   */
+  check = false;
+
+  /**
+   * run a prune dry run to estimate the unreferenced data in the repo.
+   *
+   * @generated from field: bool estimate_unused = 3;
+   */
+  estimateUnused = false;
+
+  /**
+   * with check, also read and verify all pack data (restic check --read-data).
+   *
+   * @generated from field: bool read_data = 4;
+   */
+  readData = false;
+
+  constructor(data?: PartialMessage<GetRepoHealthRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.GetRepoHealthRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "repo_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "check", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "estimate_unused", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "read_data", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetRepoHealthRequest {
+    return new GetRepoHealthRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetRepoHealthRequest {
+    return new GetRepoHealthRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetRepoHealthRequest {
+    return new GetRepoHealthRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetRepoHealthRequest | PlainMessage<GetRepoHealthRequest> | undefined, b: GetRepoHealthRequest | PlainMessage<GetRepoHealthRequest> | undefined): boolean {
+    return proto3.util.equals(GetRepoHealthRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.CheckOplogIntegrityRequest
+ */
+export class CheckOplogIntegrityRequest extends Message<CheckOplogIntegrityRequest> {
+  /**
+   * remove dangling index entries and quarantine corrupt records.
+   *
+   * @generated from field: bool repair = 1;
+   */
+  repair = false;
+
+  /**
+   * delete operations referencing repos or plans that no longer exist.
+   *
+   * @generated from field: bool remove_orphaned = 2;
+   */
+  removeOrphaned = false;
+
+  constructor(data?: PartialMessage<CheckOplogIntegrityRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.CheckOplogIntegrityRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "repair", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "remove_orphaned", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CheckOplogIntegrityRequest {
+    return new CheckOplogIntegrityRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CheckOplogIntegrityRequest {
+    return new CheckOplogIntegrityRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CheckOplogIntegrityRequest {
+    return new CheckOplogIntegrityRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CheckOplogIntegrityRequest | PlainMessage<CheckOplogIntegrityRequest> | undefined, b: CheckOplogIntegrityRequest | PlainMessage<CheckOplogIntegrityRequest> | undefined): boolean {
+    return proto3.util.equals(CheckOplogIntegrityRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.OplogIntegrityReport
+ */
+export class OplogIntegrityReport extends Message<OplogIntegrityReport> {
+  /**
+   * index entries pointing at missing or mismatched operations.
+   *
+   * @generated from field: int32 dangling_index_entries = 1;
+   */
+  danglingIndexEntries = 0;
+
+  /**
+   * records that could not be read or failed validation.
+   *
+   * @generated from field: repeated int64 corrupt_operation_ids = 2;
+   */
+  corruptOperationIds: bigint[] = [];
+
+  /**
+   * operations referencing a repo or plan that no longer exists.
+   *
+   * @generated from field: repeated int64 orphaned_operation_ids = 3;
+   */
+  orphanedOperationIds: bigint[] = [];
+
+  /**
+   * dangling index entries were removed and corrupt records quarantined.
+   *
+   * @generated from field: bool repaired = 4;
+   */
+  repaired = false;
+
+  /**
+   * orphaned operations were deleted.
+   *
+   * @generated from field: bool orphans_removed = 5;
+   */
+  orphansRemoved = false;
+
+  constructor(data?: PartialMessage<OplogIntegrityReport>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.OplogIntegrityReport";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "dangling_index_entries", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "corrupt_operation_ids", kind: "scalar", T: 3 /* ScalarType.INT64 */, repeated: true },
+    { no: 3, name: "orphaned_operation_ids", kind: "scalar", T: 3 /* ScalarType.INT64 */, repeated: true },
+    { no: 4, name: "repaired", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "orphans_removed", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): OplogIntegrityReport {
+    return new OplogIntegrityReport().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): OplogIntegrityReport {
+    return new OplogIntegrityReport().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): OplogIntegrityReport {
+    return new OplogIntegrityReport().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: OplogIntegrityReport | PlainMessage<OplogIntegrityReport> | undefined, b: OplogIntegrityReport | PlainMessage<OplogIntegrityReport> | undefined): boolean {
+    return proto3.util.equals(OplogIntegrityReport, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.GetAuditLogRequest
+ */
+export class GetAuditLogRequest extends Message<GetAuditLogRequest> {
+  /**
+   * optional, only entries at or after this time.
+   *
+   * @generated from field: int64 since_unix_ms = 1;
+   */
+  sinceUnixMs = protoInt64.zero;
+
+  /**
+   * optional, only entries before this time.
+   *
+   * @generated from field: int64 until_unix_ms = 2;
+   */
+  untilUnixMs = protoInt64.zero;
+
+  /**
+   * optional, only entries for this user.
+   *
+   * @generated from field: string user = 3;
+   */
+  user = "";
+
+  /**
+   * optional, only entries for this action.
+   *
+   * @generated from field: string action = 4;
+   */
+  action = "";
+
+  /**
+   * optional, only entries for this repo.
+   *
+   * @generated from field: string repo_id = 5;
+   */
+  repoId = "";
+
+  /**
+   * optional, only entries for this plan.
+   *
+   * @generated from field: string plan_id = 6;
+   */
+  planId = "";
+
+  /**
+   * optional, limit to the last n matching entries.
+   *
+   * @generated from field: int64 last_n = 7;
+   */
+  lastN = protoInt64.zero;
+
+  constructor(data?: PartialMessage<GetAuditLogRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.GetAuditLogRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "since_unix_ms", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "until_unix_ms", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "user", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "action", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "repo_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "plan_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "last_n", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetAuditLogRequest {
+    return new GetAuditLogRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetAuditLogRequest {
+    return new GetAuditLogRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetAuditLogRequest {
+    return new GetAuditLogRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetAuditLogRequest | PlainMessage<GetAuditLogRequest> | undefined, b: GetAuditLogRequest | PlainMessage<GetAuditLogRequest> | undefined): boolean {
+    return proto3.util.equals(GetAuditLogRequest, a, b);
+  }
+}
 
 /**
  * @generated from message v1.ClearHistoryRequest
  */
-export class ClearHistoryRequest extends Message<ClearHistoryRequest> {
+export class ClearHistoryRequest extends Message<ClearHistoryRequest> {
+  /**
+   * @generated from field: string repo_id = 1;
+   */
+  repoId = "";
+
+  /**
+   * @generated from field: string plan_id = 2;
+   */
+  planId = "";
+
+  /**
+   * @generated from field: bool only_failed = 3;
+   */
+  onlyFailed = false;
+
+  /**
+   * @generated from field: repeated int64 ops = 4;
+   */
+  ops: bigint[] = [];
+
+  constructor(data?: PartialMessage<ClearHistoryRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.ClearHistoryRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "repo_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "plan_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "only_failed", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "ops", kind: "scalar", T: 3 /* ScalarType.INT64 */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ClearHistoryRequest {
+    return new ClearHistoryRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ClearHistoryRequest {
+    return new ClearHistoryRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ClearHistoryRequest {
+    return new ClearHistoryRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ClearHistoryRequest | PlainMessage<ClearHistoryRequest> | undefined, b: ClearHistoryRequest | PlainMessage<ClearHistoryRequest> | undefined): boolean {
+    return proto3.util.equals(ClearHistoryRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.ForgetRequest
+ */
+export class ForgetRequest extends Message<ForgetRequest> {
+  /**
+   * @generated from field: string repo_id = 1;
+   */
+  repoId = "";
+
+  /**
+   * @generated from field: string plan_id = 2;
+   */
+  planId = "";
+
+  /**
+   * @generated from field: string snapshot_id = 3;
+   */
+  snapshotId = "";
+
+  constructor(data?: PartialMessage<ForgetRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.ForgetRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "repo_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "plan_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "snapshot_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ForgetRequest {
+    return new ForgetRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ForgetRequest {
+    return new ForgetRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ForgetRequest {
+    return new ForgetRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ForgetRequest | PlainMessage<ForgetRequest> | undefined, b: ForgetRequest | PlainMessage<ForgetRequest> | undefined): boolean {
+    return proto3.util.equals(ForgetRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.RepoProbe
+ */
+export class RepoProbe extends Message<RepoProbe> {
+  /**
+   * a restic repo exists at the URI.
+   *
+   * @generated from field: bool exists = 1;
+   */
+  exists = false;
+
+  /**
+   * the password opens the existing repo.
+   *
+   * @generated from field: bool password_accepted = 2;
+   */
+  passwordAccepted = false;
+
+  /**
+   * ID restic assigned the existing repo, only set if the password is accepted.
+   *
+   * @generated from field: string restic_repo_id = 3;
+   */
+  resticRepoId = "";
+
+  /**
+   * repository format version of the existing repo, only set if the password is accepted.
+   *
+   * @generated from field: int32 version = 4;
+   */
+  version = 0;
+
+  constructor(data?: PartialMessage<RepoProbe>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.RepoProbe";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "exists", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "password_accepted", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "restic_repo_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "version", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RepoProbe {
+    return new RepoProbe().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RepoProbe {
+    return new RepoProbe().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RepoProbe {
+    return new RepoProbe().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RepoProbe | PlainMessage<RepoProbe> | undefined, b: RepoProbe | PlainMessage<RepoProbe> | undefined): boolean {
+    return proto3.util.equals(RepoProbe, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.InitRepoRequest
+ */
+export class InitRepoRequest extends Message<InitRepoRequest> {
+  /**
+   * @generated from field: v1.Repo repo = 1;
+   */
+  repo?: Repo;
+
+  /**
+   * optional, restic repository format version 1 or 2, restic's default if 0.
+   *
+   * @generated from field: int32 repository_version = 2;
+   */
+  repositoryVersion = 0;
+
+  constructor(data?: PartialMessage<InitRepoRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.InitRepoRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "repo", kind: "message", T: Repo },
+    { no: 2, name: "repository_version", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): InitRepoRequest {
+    return new InitRepoRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): InitRepoRequest {
+    return new InitRepoRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): InitRepoRequest {
+    return new InitRepoRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: InitRepoRequest | PlainMessage<InitRepoRequest> | undefined, b: InitRepoRequest | PlainMessage<InitRepoRequest> | undefined): boolean {
+    return proto3.util.equals(InitRepoRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.AdhocBackupRequest
+ */
+export class AdhocBackupRequest extends Message<AdhocBackupRequest> {
+  /**
+   * @generated from field: string repo_id = 1;
+   */
+  repoId = "";
+
+  /**
+   * absolute paths to back up.
+   *
+   * @generated from field: repeated string paths = 2;
+   */
+  paths: string[] = [];
+
+  /**
+   * optional, tags added to the snapshot.
+   *
+   * @generated from field: repeated string tags = 3;
+   */
+  tags: string[] = [];
+
+  /**
+   * optional, as Plan.excludes.
+   *
+   * @generated from field: repeated string excludes = 4;
+   */
+  excludes: string[] = [];
+
+  constructor(data?: PartialMessage<AdhocBackupRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.AdhocBackupRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "repo_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "paths", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "tags", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 4, name: "excludes", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AdhocBackupRequest {
+    return new AdhocBackupRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AdhocBackupRequest {
+    return new AdhocBackupRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AdhocBackupRequest {
+    return new AdhocBackupRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AdhocBackupRequest | PlainMessage<AdhocBackupRequest> | undefined, b: AdhocBackupRequest | PlainMessage<AdhocBackupRequest> | undefined): boolean {
+    return proto3.util.equals(AdhocBackupRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.AnnotateSnapshotRequest
+ */
+export class AnnotateSnapshotRequest extends Message<AnnotateSnapshotRequest> {
+  /**
+   * @generated from field: string repo_id = 1;
+   */
+  repoId = "";
+
+  /**
+   * @generated from field: string snapshot_id = 2;
+   */
+  snapshotId = "";
+
+  /**
+   * @generated from field: string note = 3;
+   */
+  note = "";
+
+  constructor(data?: PartialMessage<AnnotateSnapshotRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.AnnotateSnapshotRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "repo_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "snapshot_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "note", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AnnotateSnapshotRequest {
+    return new AnnotateSnapshotRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AnnotateSnapshotRequest {
+    return new AnnotateSnapshotRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AnnotateSnapshotRequest {
+    return new AnnotateSnapshotRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AnnotateSnapshotRequest | PlainMessage<AnnotateSnapshotRequest> | undefined, b: AnnotateSnapshotRequest | PlainMessage<AnnotateSnapshotRequest> | undefined): boolean {
+    return proto3.util.equals(AnnotateSnapshotRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.RescueSnapshotRequest
+ */
+export class RescueSnapshotRequest extends Message<RescueSnapshotRequest> {
+  /**
+   * @generated from field: string repo_id = 1;
+   */
+  repoId = "";
+
+  /**
+   * @generated from field: string snapshot_id = 2;
+   */
+  snapshotId = "";
+
+  constructor(data?: PartialMessage<RescueSnapshotRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.RescueSnapshotRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "repo_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "snapshot_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RescueSnapshotRequest {
+    return new RescueSnapshotRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RescueSnapshotRequest {
+    return new RescueSnapshotRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RescueSnapshotRequest {
+    return new RescueSnapshotRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RescueSnapshotRequest | PlainMessage<RescueSnapshotRequest> | undefined, b: RescueSnapshotRequest | PlainMessage<RescueSnapshotRequest> | undefined): boolean {
+    return proto3.util.equals(RescueSnapshotRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.PinSnapshotRequest
+ */
+export class PinSnapshotRequest extends Message<PinSnapshotRequest> {
+  /**
+   * @generated from field: string repo_id = 1;
+   */
+  repoId = "";
+
+  /**
+   * @generated from field: string snapshot_id = 2;
+   */
+  snapshotId = "";
+
+  /**
+   * @generated from field: bool pinned = 3;
+   */
+  pinned = false;
+
+  constructor(data?: PartialMessage<PinSnapshotRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.PinSnapshotRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "repo_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "snapshot_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "pinned", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PinSnapshotRequest {
+    return new PinSnapshotRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PinSnapshotRequest {
+    return new PinSnapshotRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PinSnapshotRequest {
+    return new PinSnapshotRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: PinSnapshotRequest | PlainMessage<PinSnapshotRequest> | undefined, b: PinSnapshotRequest | PlainMessage<PinSnapshotRequest> | undefined): boolean {
+    return proto3.util.equals(PinSnapshotRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.ListSnapshotsRequest
+ */
+export class ListSnapshotsRequest extends Message<ListSnapshotsRequest> {
+  /**
+   * @generated from field: string repo_id = 1;
+   */
+  repoId = "";
+
+  /**
+   * @generated from field: string plan_id = 2;
+   */
+  planId = "";
+
+  constructor(data?: PartialMessage<ListSnapshotsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.ListSnapshotsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "repo_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "plan_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSnapshotsRequest {
+    return new ListSnapshotsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSnapshotsRequest {
+    return new ListSnapshotsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSnapshotsRequest {
+    return new ListSnapshotsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListSnapshotsRequest | PlainMessage<ListSnapshotsRequest> | undefined, b: ListSnapshotsRequest | PlainMessage<ListSnapshotsRequest> | undefined): boolean {
+    return proto3.util.equals(ListSnapshotsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.GetOperationsRequest
+ */
+export class GetOperationsRequest extends Message<GetOperationsRequest> {
+  /**
+   * @generated from field: string repo_id = 1;
+   */
+  repoId = "";
+
+  /**
+   * @generated from field: string plan_id = 2;
+   */
+  planId = "";
+
+  /**
+   * @generated from field: string snapshot_id = 4;
+   */
+  snapshotId = "";
+
+  /**
+   * @generated from field: repeated int64 ids = 5;
+   */
+  ids: bigint[] = [];
+
+  /**
+   * limit to the last n operations
+   *
+   * @generated from field: int64 last_n = 3;
+   */
+  lastN = protoInt64.zero;
+
+  constructor(data?: PartialMessage<GetOperationsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.GetOperationsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "repo_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "plan_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "snapshot_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "ids", kind: "scalar", T: 3 /* ScalarType.INT64 */, repeated: true },
+    { no: 3, name: "last_n", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetOperationsRequest {
+    return new GetOperationsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetOperationsRequest {
+    return new GetOperationsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetOperationsRequest {
+    return new GetOperationsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetOperationsRequest | PlainMessage<GetOperationsRequest> | undefined, b: GetOperationsRequest | PlainMessage<GetOperationsRequest> | undefined): boolean {
+    return proto3.util.equals(GetOperationsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.SubscribeOperationsRequest
+ */
+export class SubscribeOperationsRequest extends Message<SubscribeOperationsRequest> {
+  /**
+   * optional
+   *
+   * @generated from field: string repo_id = 1;
+   */
+  repoId = "";
+
+  /**
+   * optional
+   *
+   * @generated from field: string plan_id = 2;
+   */
+  planId = "";
+
+  /**
+   * optional
+   *
+   * @generated from field: string snapshot_id = 3;
+   */
+  snapshotId = "";
+
+  /**
+   * optional, match any of these statuses.
+   *
+   * @generated from field: repeated v1.OperationStatus statuses = 4;
+   */
+  statuses: OperationStatus[] = [];
+
+  /**
+   * optional, match any of these types: backup, index_snapshot, forget, prune, restore, stats, run_hook.
+   *
+   * @generated from field: repeated string types = 5;
+   */
+  types: string[] = [];
+
+  /**
+   * optional, match any of these event types.
+   *
+   * @generated from field: repeated v1.OperationEventType event_types = 6;
+   */
+  eventTypes: OperationEventType[] = [];
+
+  /**
+   * skip updates that do not change the operation's status e.g. progress updates.
+   *
+   * @generated from field: bool status_changes_only = 7;
+   */
+  statusChangesOnly = false;
+
+  constructor(data?: PartialMessage<SubscribeOperationsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.SubscribeOperationsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "repo_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "plan_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "snapshot_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "statuses", kind: "enum", T: proto3.getEnumType(OperationStatus), repeated: true },
+    { no: 5, name: "types", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 6, name: "event_types", kind: "enum", T: proto3.getEnumType(OperationEventType), repeated: true },
+    { no: 7, name: "status_changes_only", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SubscribeOperationsRequest {
+    return new SubscribeOperationsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SubscribeOperationsRequest {
+    return new SubscribeOperationsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SubscribeOperationsRequest {
+    return new SubscribeOperationsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SubscribeOperationsRequest | PlainMessage<SubscribeOperationsRequest> | undefined, b: SubscribeOperationsRequest | PlainMessage<SubscribeOperationsRequest> | undefined): boolean {
+    return proto3.util.equals(SubscribeOperationsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.QueryOperationsRequest
+ */
+export class QueryOperationsRequest extends Message<QueryOperationsRequest> {
+  /**
+   * optional
+   *
+   * @generated from field: string repo_id = 1;
+   */
+  repoId = "";
+
+  /**
+   * optional
+   *
+   * @generated from field: string plan_id = 2;
+   */
+  planId = "";
+
+  /**
+   * optional
+   *
+   * @generated from field: string snapshot_id = 3;
+   */
+  snapshotId = "";
+
+  /**
+   * optional, match any of these statuses.
+   *
+   * @generated from field: repeated v1.OperationStatus statuses = 4;
+   */
+  statuses: OperationStatus[] = [];
+
+  /**
+   * optional, match any of these types: backup, index_snapshot, forget, prune, restore, stats, run_hook.
+   *
+   * @generated from field: repeated string types = 5;
+   */
+  types: string[] = [];
+
+  /**
+   * optional, only operations started at or after this time.
+   *
+   * @generated from field: int64 since_unix_ms = 6;
+   */
+  sinceUnixMs = protoInt64.zero;
+
+  /**
+   * optional, only operations started before this time.
+   *
+   * @generated from field: int64 until_unix_ms = 7;
+   */
+  untilUnixMs = protoInt64.zero;
+
+  /**
+   * optional, case insensitive search of the operation's display message e.g. error text.
+   *
+   * @generated from field: string text = 8;
+   */
+  text = "";
+
+  /**
+   * return the newest operations first.
+   *
+   * @generated from field: bool descending = 9;
+   */
+  descending = false;
+
+  /**
+   * defaults to 100, at most 1000.
+   *
+   * @generated from field: int32 page_size = 10;
+   */
+  pageSize = 0;
+
+  /**
+   * next_page_token from the previous response, empty for the first page.
+   *
+   * @generated from field: string page_token = 11;
+   */
+  pageToken = "";
+
+  constructor(data?: PartialMessage<QueryOperationsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.QueryOperationsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "repo_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "plan_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "snapshot_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "statuses", kind: "enum", T: proto3.getEnumType(OperationStatus), repeated: true },
+    { no: 5, name: "types", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 6, name: "since_unix_ms", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 7, name: "until_unix_ms", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 8, name: "text", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "descending", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 10, name: "page_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 11, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryOperationsRequest {
+    return new QueryOperationsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryOperationsRequest {
+    return new QueryOperationsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryOperationsRequest {
+    return new QueryOperationsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: QueryOperationsRequest | PlainMessage<QueryOperationsRequest> | undefined, b: QueryOperationsRequest | PlainMessage<QueryOperationsRequest> | undefined): boolean {
+    return proto3.util.equals(QueryOperationsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.QueryOperationsResponse
+ */
+export class QueryOperationsResponse extends Message<QueryOperationsResponse> {
+  /**
+   * @generated from field: repeated v1.Operation operations = 1;
+   */
+  operations: Operation[] = [];
+
+  /**
+   * empty if there are no more results.
+   *
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
+  constructor(data?: PartialMessage<QueryOperationsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.QueryOperationsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "operations", kind: "message", T: Operation, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): QueryOperationsResponse {
+    return new QueryOperationsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): QueryOperationsResponse {
+    return new QueryOperationsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): QueryOperationsResponse {
+    return new QueryOperationsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: QueryOperationsResponse | PlainMessage<QueryOperationsResponse> | undefined, b: QueryOperationsResponse | PlainMessage<QueryOperationsResponse> | undefined): boolean {
+    return proto3.util.equals(QueryOperationsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.RestoreSnapshotRequest
+ */
+export class RestoreSnapshotRequest extends Message<RestoreSnapshotRequest> {
+  /**
+   * @generated from field: string plan_id = 1;
+   */
+  planId = "";
+
+  /**
+   * @generated from field: string repo_id = 5;
+   */
+  repoId = "";
+
+  /**
+   * @generated from field: string snapshot_id = 2;
+   */
+  snapshotId = "";
+
+  /**
+   * @generated from field: string path = 3;
+   */
+  path = "";
+
+  /**
+   * @generated from field: string target = 4;
+   */
+  target = "";
+
+  /**
+   * @generated from field: v1.RestoreOptions options = 6;
+   */
+  options?: RestoreOptions;
+
+  constructor(data?: PartialMessage<RestoreSnapshotRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.RestoreSnapshotRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "plan_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "repo_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "snapshot_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "target", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "options", kind: "message", T: RestoreOptions },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RestoreSnapshotRequest {
+    return new RestoreSnapshotRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RestoreSnapshotRequest {
+    return new RestoreSnapshotRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RestoreSnapshotRequest {
+    return new RestoreSnapshotRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RestoreSnapshotRequest | PlainMessage<RestoreSnapshotRequest> | undefined, b: RestoreSnapshotRequest | PlainMessage<RestoreSnapshotRequest> | undefined): boolean {
+    return proto3.util.equals(RestoreSnapshotRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.ListSnapshotFilesRequest
+ */
+export class ListSnapshotFilesRequest extends Message<ListSnapshotFilesRequest> {
   /**
    * @generated from field: string repo_id = 1;
    */
   repoId = "";
 
   /**
-   * @generated from field: string plan_id = 2;
+   * @generated from field: string snapshot_id = 2;
    */
-  planId = "";
+  snapshotId = "";
 
   /**
-   * @generated from field: bool only_failed = 3;
+   * @generated from field: string path = 3;
    */
-  onlyFailed = false;
+  path = "";
 
   /**
-   * @generated from field: repeated int64 ops = 4;
+   * set the size of directories to the cumulative size of the files beneath them, the sizes are computed once per snapshot.
+   *
+   * @generated from field: bool include_sizes = 4;
    */
-  ops: bigint[] = [];
+  includeSizes = false;
 
-  constructor(data?: PartialMessage<ClearHistoryRequest>) {
+  constructor(data?: PartialMessage<ListSnapshotFilesRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "v1.ClearHistoryRequest";
+  static readonly typeName = "v1.ListSnapshotFilesRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "repo_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "plan_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "only_failed", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
-    { no: 4, name: "ops", kind: "scalar", T: 3 /* ScalarType.INT64 */, repeated: true },
+    { no: 2, name: "snapshot_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "include_sizes", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ClearHistoryRequest {
-    return new ClearHistoryRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSnapshotFilesRequest {
+    return new ListSnapshotFilesRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ClearHistoryRequest {
-    return new ClearHistoryRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSnapshotFilesRequest {
+    return new ListSnapshotFilesRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ClearHistoryRequest {
-    return new ClearHistoryRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSnapshotFilesRequest {
+    return new ListSnapshotFilesRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ClearHistoryRequest | PlainMessage<ClearHistoryRequest> | undefined, b: ClearHistoryRequest | PlainMessage<ClearHistoryRequest> | undefined): boolean {
-    return proto3.util.equals(ClearHistoryRequest, a, b);
+  static equals(a: ListSnapshotFilesRequest | PlainMessage<ListSnapshotFilesRequest> | undefined, b: ListSnapshotFilesRequest | PlainMessage<ListSnapshotFilesRequest> | undefined): boolean {
+    return proto3.util.equals(ListSnapshotFilesRequest, a, b);
   }
 }
 
 /**
- * @generated from message v1.ForgetRequest
+ * @generated from message v1.GetLargestFilesRequest
  */
-export class ForgetRequest extends Message<ForgetRequest> {
+export class GetLargestFilesRequest extends Message<GetLargestFilesRequest> {
   /**
    * @generated from field: string repo_id = 1;
    */
   repoId = "";
 
   /**
-   * @generated from field: string plan_id = 2;
+   * @generated from field: string snapshot_id = 2;
    */
-  planId = "";
+  snapshotId = "";
 
   /**
-   * @generated from field: string snapshot_id = 3;
+   * optional, number of files and directories returned, defaults to 100 and at most 1000.
+   *
+   * @generated from field: int32 limit = 3;
    */
-  snapshotId = "";
+  limit = 0;
 
-  constructor(data?: PartialMessage<ForgetRequest>) {
+  constructor(data?: PartialMessage<GetLargestFilesRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "v1.ForgetRequest";
+  static readonly typeName = "v1.GetLargestFilesRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "repo_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "plan_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "snapshot_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "snapshot_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "limit", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ForgetRequest {
-    return new ForgetRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetLargestFilesRequest {
+    return new GetLargestFilesRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ForgetRequest {
-    return new ForgetRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetLargestFilesRequest {
+    return new GetLargestFilesRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ForgetRequest {
-    return new ForgetRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetLargestFilesRequest {
+    return new GetLargestFilesRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ForgetRequest | PlainMessage<ForgetRequest> | undefined, b: ForgetRequest | PlainMessage<ForgetRequest> | undefined): boolean {
-    return proto3.util.equals(ForgetRequest, a, b);
+  static equals(a: GetLargestFilesRequest | PlainMessage<GetLargestFilesRequest> | undefined, b: GetLargestFilesRequest | PlainMessage<GetLargestFilesRequest> | undefined): boolean {
+    return proto3.util.equals(GetLargestFilesRequest, a, b);
   }
 }
 
 /**
- * @generated from message v1.ListSnapshotsRequest
+ * @generated from message v1.ReclaimedSpace
  */
-export class ListSnapshotsRequest extends Message<ListSnapshotsRequest> {
+export class ReclaimedSpace extends Message<ReclaimedSpace> {
   /**
-   * @generated from field: string repo_id = 1;
+   * one per successful prune or forget, oldest first.
+   *
+   * @generated from field: repeated v1.ReclaimedSpacePoint points = 1;
    */
-  repoId = "";
+  points: ReclaimedSpacePoint[] = [];
 
   /**
-   * @generated from field: string plan_id = 2;
+   * @generated from field: int64 total_bytes_freed = 2;
    */
-  planId = "";
+  totalBytesFreed = protoInt64.zero;
 
-  constructor(data?: PartialMessage<ListSnapshotsRequest>) {
+  /**
+   * @generated from field: int64 total_snapshots_removed = 3;
+   */
+  totalSnapshotsRemoved = protoInt64.zero;
+
+  constructor(data?: PartialMessage<ReclaimedSpace>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "v1.ListSnapshotsRequest";
+  static readonly typeName = "v1.ReclaimedSpace";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "repo_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "plan_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "points", kind: "message", T: ReclaimedSpacePoint, repeated: true },
+    { no: 2, name: "total_bytes_freed", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "total_snapshots_removed", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSnapshotsRequest {
-    return new ListSnapshotsRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReclaimedSpace {
+    return new ReclaimedSpace().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSnapshotsRequest {
-    return new ListSnapshotsRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReclaimedSpace {
+    return new ReclaimedSpace().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSnapshotsRequest {
-    return new ListSnapshotsRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReclaimedSpace {
+    return new ReclaimedSpace().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListSnapshotsRequest | PlainMessage<ListSnapshotsRequest> | undefined, b: ListSnapshotsRequest | PlainMessage<ListSnapshotsRequest> | undefined): boolean {
-    return proto3.util.equals(ListSnapshotsRequest, a, b);
+  static equals(a: ReclaimedSpace | PlainMessage<ReclaimedSpace> | undefined, b: ReclaimedSpace | PlainMessage<ReclaimedSpace> | undefined): boolean {
+    return proto3.util.equals(ReclaimedSpace, a, b);
   }
 }
 
 /**
- * @generated from message v1.GetOperationsRequest
+ * @generated from message v1.ReclaimedSpacePoint
  */
-export class GetOperationsRequest extends Message<GetOperationsRequest> {
+export class ReclaimedSpacePoint extends Message<ReclaimedSpacePoint> {
   /**
-   * @generated from field: string repo_id = 1;
+   * end time of the operation.
+   *
+   * @generated from field: int64 unix_time_ms = 1;
    */
-  repoId = "";
+  unixTimeMs = protoInt64.zero;
 
   /**
-   * @generated from field: string plan_id = 2;
+   * @generated from field: int64 operation_id = 2;
    */
-  planId = "";
+  operationId = protoInt64.zero;
 
   /**
-   * @generated from field: string snapshot_id = 4;
+   * bytes freed by the prune, 0 for forgets.
+   *
+   * @generated from field: int64 bytes_freed = 3;
    */
-  snapshotId = "";
+  bytesFreed = protoInt64.zero;
 
   /**
-   * @generated from field: repeated int64 ids = 5;
+   * snapshots removed by the forget, 0 for prunes.
+   *
+   * @generated from field: int64 snapshots_removed = 4;
    */
-  ids: bigint[] = [];
+  snapshotsRemoved = protoInt64.zero;
 
   /**
-   * limit to the last n operations
+   * bytes freed by this and all earlier prunes.
    *
-   * @generated from field: int64 last_n = 3;
+   * @generated from field: int64 cumulative_bytes_freed = 5;
    */
-  lastN = protoInt64.zero;
+  cumulativeBytesFreed = protoInt64.zero;
 
-  constructor(data?: PartialMessage<GetOperationsRequest>) {
+  /**
+   * snapshots removed by this and all earlier forgets.
+   *
+   * @generated from field: int64 cumulative_snapshots_removed = 6;
+   */
+  cumulativeSnapshotsRemoved = protoInt64.zero;
+
+  constructor(data?: PartialMessage<ReclaimedSpacePoint>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "v1.GetOperationsRequest";
+  static readonly typeName = "v1.ReclaimedSpacePoint";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "repo_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "plan_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 4, name: "snapshot_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 5, name: "ids", kind: "scalar", T: 3 /* ScalarType.INT64 */, repeated: true },
-    { no: 3, name: "last_n", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 1, name: "unix_time_ms", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "operation_id", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "bytes_freed", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 4, name: "snapshots_removed", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 5, name: "cumulative_bytes_freed", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 6, name: "cumulative_snapshots_removed", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetOperationsRequest {
-    return new GetOperationsRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReclaimedSpacePoint {
+    return new ReclaimedSpacePoint().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetOperationsRequest {
-    return new GetOperationsRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReclaimedSpacePoint {
+    return new ReclaimedSpacePoint().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetOperationsRequest {
-    return new GetOperationsRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReclaimedSpacePoint {
+    return new ReclaimedSpacePoint().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetOperationsRequest | PlainMessage<GetOperationsRequest> | undefined, b: GetOperationsRequest | PlainMessage<GetOperationsRequest> | undefined): boolean {
-    return proto3.util.equals(GetOperationsRequest, a, b);
+  static equals(a: ReclaimedSpacePoint | PlainMessage<ReclaimedSpacePoint> | undefined, b: ReclaimedSpacePoint | PlainMessage<ReclaimedSpacePoint> | undefined): boolean {
+    return proto3.util.equals(ReclaimedSpacePoint, a, b);
   }
 }
 
 /**
- * @generated from message v1.RestoreSnapshotRequest
+ * @generated from message v1.GetFileHistoryRequest
  */
-export class RestoreSnapshotRequest extends Message<RestoreSnapshotRequest> {
-  /**
-   * @generated from field: string plan_id = 1;
-   */
-  planId = "";
-
+export class GetFileHistoryRequest extends Message<GetFileHistoryRequest> {
   /**
-   * @generated from field: string repo_id = 5;
+   * @generated from field: string repo_id = 1;
    */
   repoId = "";
 
   /**
-   * @generated from field: string snapshot_id = 2;
+   * optional, only the snapshots of the plan.
+   *
+   * @generated from field: string plan_id = 2;
    */
-  snapshotId = "";
+  planId = "";
 
   /**
+   * absolute path of the file or directory.
+   *
    * @generated from field: string path = 3;
    */
   path = "";
 
+  constructor(data?: PartialMessage<GetFileHistoryRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.GetFileHistoryRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "repo_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "plan_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetFileHistoryRequest {
+    return new GetFileHistoryRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetFileHistoryRequest {
+    return new GetFileHistoryRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetFileHistoryRequest {
+    return new GetFileHistoryRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetFileHistoryRequest | PlainMessage<GetFileHistoryRequest> | undefined, b: GetFileHistoryRequest | PlainMessage<GetFileHistoryRequest> | undefined): boolean {
+    return proto3.util.equals(GetFileHistoryRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.FileHistory
+ */
+export class FileHistory extends Message<FileHistory> {
   /**
-   * @generated from field: string target = 4;
+   * one per snapshot backing up the path, oldest first.
+   *
+   * @generated from field: repeated v1.FileVersion versions = 1;
    */
-  target = "";
+  versions: FileVersion[] = [];
 
-  constructor(data?: PartialMessage<RestoreSnapshotRequest>) {
+  constructor(data?: PartialMessage<FileHistory>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "v1.RestoreSnapshotRequest";
+  static readonly typeName = "v1.FileHistory";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "plan_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 5, name: "repo_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "snapshot_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 4, name: "target", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "versions", kind: "message", T: FileVersion, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RestoreSnapshotRequest {
-    return new RestoreSnapshotRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FileHistory {
+    return new FileHistory().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RestoreSnapshotRequest {
-    return new RestoreSnapshotRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FileHistory {
+    return new FileHistory().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RestoreSnapshotRequest {
-    return new RestoreSnapshotRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FileHistory {
+    return new FileHistory().fromJsonString(jsonString, options);
   }
 
-  static equals(a: RestoreSnapshotRequest | PlainMessage<RestoreSnapshotRequest> | undefined, b: RestoreSnapshotRequest | PlainMessage<RestoreSnapshotRequest> | undefined): boolean {
-    return proto3.util.equals(RestoreSnapshotRequest, a, b);
+  static equals(a: FileHistory | PlainMessage<FileHistory> | undefined, b: FileHistory | PlainMessage<FileHistory> | undefined): boolean {
+    return proto3.util.equals(FileHistory, a, b);
   }
 }
 
 /**
- * @generated from message v1.ListSnapshotFilesRequest
+ * FileVersion is the state of a path in one snapshot.
+ *
+ * @generated from message v1.FileVersion
  */
-export class ListSnapshotFilesRequest extends Message<ListSnapshotFilesRequest> {
+export class FileVersion extends Message<FileVersion> {
   /**
-   * @generated from field: string repo_id = 1;
+   * @generated from field: string snapshot_id = 1;
    */
-  repoId = "";
+  snapshotId = "";
 
   /**
-   * @generated from field: string snapshot_id = 2;
+   * @generated from field: int64 snapshot_unix_time_ms = 2;
    */
-  snapshotId = "";
+  snapshotUnixTimeMs = protoInt64.zero;
 
   /**
-   * @generated from field: string path = 3;
+   * whether the path is in the snapshot.
+   *
+   * @generated from field: bool present = 3;
    */
-  path = "";
+  present = false;
 
-  constructor(data?: PartialMessage<ListSnapshotFilesRequest>) {
+  /**
+   * the path's entry if present.
+   *
+   * @generated from field: v1.LsEntry entry = 4;
+   */
+  entry?: LsEntry;
+
+  /**
+   * present with a different size or mtime than in the previous snapshot it was present in, or present for the first time.
+   *
+   * @generated from field: bool changed = 5;
+   */
+  changed = false;
+
+  constructor(data?: PartialMessage<FileVersion>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "v1.ListSnapshotFilesRequest";
+  static readonly typeName = "v1.FileVersion";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "repo_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "snapshot_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "path", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "snapshot_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "snapshot_unix_time_ms", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "present", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "entry", kind: "message", T: LsEntry },
+    { no: 5, name: "changed", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSnapshotFilesRequest {
-    return new ListSnapshotFilesRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): FileVersion {
+    return new FileVersion().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSnapshotFilesRequest {
-    return new ListSnapshotFilesRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): FileVersion {
+    return new FileVersion().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSnapshotFilesRequest {
-    return new ListSnapshotFilesRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): FileVersion {
+    return new FileVersion().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListSnapshotFilesRequest | PlainMessage<ListSnapshotFilesRequest> | undefined, b: ListSnapshotFilesRequest | PlainMessage<ListSnapshotFilesRequest> | undefined): boolean {
-    return proto3.util.equals(ListSnapshotFilesRequest, a, b);
+  static equals(a: FileVersion | PlainMessage<FileVersion> | undefined, b: FileVersion | PlainMessage<FileVersion> | undefined): boolean {
+    return proto3.util.equals(FileVersion, a, b);
+  }
+}
+
+/**
+ * @generated from message v1.GetLargestFilesResponse
+ */
+export class GetLargestFilesResponse extends Message<GetLargestFilesResponse> {
+  /**
+   * largest files, largest first.
+   *
+   * @generated from field: repeated v1.LsEntry files = 1;
+   */
+  files: LsEntry[] = [];
+
+  /**
+   * largest directories by the cumulative size of the files beneath them, largest first.
+   *
+   * @generated from field: repeated v1.LsEntry dirs = 2;
+   */
+  dirs: LsEntry[] = [];
+
+  constructor(data?: PartialMessage<GetLargestFilesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "v1.GetLargestFilesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "files", kind: "message", T: LsEntry, repeated: true },
+    { no: 2, name: "dirs", kind: "message", T: LsEntry, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetLargestFilesResponse {
+    return new GetLargestFilesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetLargestFilesResponse {
+    return new GetLargestFilesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetLargestFilesResponse {
+    return new GetLargestFilesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetLargestFilesResponse | PlainMessage<GetLargestFilesResponse> | undefined, b: GetLargestFilesResponse | PlainMessage<GetLargestFilesResponse> | undefined): boolean {
+    return proto3.util.equals(GetLargestFilesResponse, a, b);
   }
 }
 
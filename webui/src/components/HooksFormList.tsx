@@ -1,6 +1,6 @@
 import React, { useState } from 'react';
 import { Hook, Hook_Command, Hook_Condition, Hook_Discord, Hook_Gotify, Hook_Webhook } from '../../gen/ts/v1/config_pb';
-import { Button, Card, Collapse, CollapseProps, Form, FormListFieldData, Input, Popover, Radio, Row, Select, Tooltip } from 'antd';
+import { Button, Card, Collapse, CollapseProps, Form, FormListFieldData, Input, InputNumber, Popover, Radio, Row, Select, Tooltip } from 'antd';
 import { MinusCircleOutlined, PlusOutlined } from '@ant-design/icons';
 import { Rule } from 'antd/es/form';
 
@@ -17,6 +17,7 @@ export interface HookFields {
   actionDiscord?: any;
   actionWebhook?: any;
   actionSlack?: any;
+  actionPlugin?: any;
 }
 
 export const hooksListTooltipText = <>
@@ -81,6 +82,14 @@ export const HooksFormList = () => {
                 ]}
               />
             </Form.Item>
+            <Form.Item name={[field.name, "timeoutSeconds"]}>
+              <InputNumber addonBefore={<div style={{ width: "8em" }}>Timeout (sec)</div>} min={0} placeholder="600" />
+            </Form.Item>
+            <Tooltip title={<>Only run the hook if the expression is true e.g. <code>error contains "timeout" &amp;&amp; plan == "media"</code> or <code>snapshot.data_added &gt; 50GB</code>.</>}>
+              <Form.Item name={[field.name, "conditionExpr"]}>
+                <Input addonBefore={<div style={{ width: "8em" }}>Only If</div>} placeholder="always" style={{ fontFamily: "monospace" }} />
+              </Form.Item>
+            </Tooltip>
             <Form.Item shouldUpdate={(prevValues, curValues) => {
               return prevValues.hooks[index] !== curValues.hooks[index];
             }}>
@@ -153,6 +162,15 @@ const hookTypes: {
         },
         conditions: [],
       }
+    },
+    {
+      name: "Plugin", template: {
+        actionPlugin: {
+          name: "",
+          settings: {},
+        },
+        conditions: [],
+      }
     }
   ];
 
@@ -178,6 +196,17 @@ const HookBuilder = ({ field }: { field: FormListFieldData }) => {
       <Form.Item name={[field.name, "actionCommand", "command"]} rules={[requiredField("command is required")]}>
         <Input.TextArea style={{ width: "100%", fontFamily: "monospace" }} />
       </Form.Item>
+      <Form.Item name={[field.name, "actionCommand", "workingDir"]}>
+        <Input addonBefore={<div style={{ width: "8em" }}>Working Dir</div>} placeholder="backrest's working directory" />
+      </Form.Item>
+      <Form.Item name={[field.name, "actionCommand", "user"]}>
+        <Input addonBefore={<div style={{ width: "8em" }}>Run As User</div>} placeholder="backrest's user" />
+      </Form.Item>
+      <Tooltip title="Environment variables passed to the script from backrest's environment, all are passed if empty.">
+        <Form.Item name={[field.name, "actionCommand", "envAllowlist"]}>
+          <Select mode="tags" placeholder="Environment variable allowlist" />
+        </Form.Item>
+      </Tooltip>
     </>
   } else if (hookData.actionGotify) {
     return <>
@@ -205,9 +234,46 @@ const HookBuilder = ({ field }: { field: FormListFieldData }) => {
         <Input.TextArea style={{ width: "100%", fontFamily: "monospace" }} />
       </Form.Item >
     </>
+  } else if (hookData.actionPlugin) {
+    return <>
+      <Tooltip title="Name of an executable in backrest's plugins directory (BACKREST_PLUGINS_DIR), without its extension.">
+        <Form.Item name={[field.name, "actionPlugin", "name"]} rules={[requiredField("plugin name is required")]}>
+          <Input addonBefore={<div style={{ width: "8em" }}>Plugin</div>} />
+        </Form.Item>
+      </Tooltip>
+      Settings (one key=value per line):
+      <Form.Item name={[field.name, "actionPlugin", "settings"]}>
+        <PluginSettingsInput />
+      </Form.Item>
+    </>
   } else {
     return <p>Unknown hook</p>
   }
 }
 
+// PluginSettingsInput edits a plugin's settings map as key=value lines, the text is kept while typing so lines
+// without a value yet aren't dropped.
+const PluginSettingsInput = ({ value, onChange }: { value?: { [key: string]: string }, onChange?: (settings: { [key: string]: string }) => void }) => {
+  const [text, setText] = useState(() => Object.entries(value || {}).map(([k, v]) => `${k}=${v}`).join("\n"));
+
+  const handleChange = (newText: string) => {
+    setText(newText);
+    const settings: { [key: string]: string } = {};
+    for (const line of newText.split("\n")) {
+      const idx = line.indexOf("=");
+      if (idx > 0) {
+        settings[line.substring(0, idx).trim()] = line.substring(idx + 1);
+      }
+    }
+    onChange?.(settings);
+  };
+
+  return <Input.TextArea
+    style={{ width: "100%", fontFamily: "monospace" }}
+    placeholder="routing_key=..."
+    value={text}
+    onChange={(e) => handleChange(e.target.value)}
+  />
+}
+
 const requiredField = (message: string, extra?: Rule) => ({ required: true, message: message });
\ No newline at end of file
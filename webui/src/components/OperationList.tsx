@@ -24,7 +24,11 @@ import {
   DownloadOutlined,
   RobotOutlined,
 } from "@ant-design/icons";
-import { BackupProgressEntry, ResticSnapshot } from "../../gen/ts/v1/restic_pb";
+import {
+  BackupProgressEntry,
+  ErrorClass,
+  ResticSnapshot,
+} from "../../gen/ts/v1/restic_pb";
 import {
   BackupInfo,
   BackupInfoCollector,
@@ -39,11 +43,12 @@ import {
 import { SnapshotBrowser } from "./SnapshotBrowser";
 import {
   formatBytes,
+  formatDuration,
   formatTime,
   normalizeSnapshotId,
 } from "../lib/formatting";
 import _ from "lodash";
-import { GetOperationsRequest, LogDataRequest } from "../../gen/ts/v1/service_pb";
+import { AcknowledgeOperationRequest, GetOperationsRequest, LogDataRequest } from "../../gen/ts/v1/service_pb";
 import { useAlertApi } from "./Alerts";
 import { MessageInstance } from "antd/es/message/interface";
 import { backrestService } from "../api";
@@ -206,6 +211,26 @@ export const OperationRow = ({
     </>
   }
 
+  if (operation.status === OperationStatus.STATUS_ERROR || operation.status === OperationStatus.STATUS_WARNING) {
+    const acknowledge = (unacknowledge: boolean) => {
+      const note = unacknowledge ? "" : window.prompt("Note for the acknowledgement (optional)");
+      if (note === null) {
+        return;
+      }
+      backrestService.acknowledgeOperation(new AcknowledgeOperationRequest({ operationId: operation.id, note, unacknowledge })).then(() => {
+        alertApi?.success(unacknowledge ? "Removed acknowledgement" : "Acknowledged failure");
+      }).catch((e) => {
+        alertApi?.error("Failed to acknowledge operation: " + e.message);
+      });
+    };
+    title = <>
+      {title}
+      <Button type="link" size="small" onClick={() => acknowledge(!!operation.acknowledgement)}>
+        {operation.acknowledgement ? "[Remove Acknowledgement]" : "[Acknowledge]"}
+      </Button>
+    </>
+  }
+
   let body: React.ReactNode | undefined;
 
   if (operation.op.case === "operationBackup") {
@@ -251,17 +276,26 @@ export const OperationRow = ({
   } else if (operation.op.case === "operationPrune") {
     const prune = operation.op.value;
     body = (
-      <Collapse
-        size="small"
-        destroyInactivePanel
-        items={[
-          {
-            key: 1,
-            label: "Prune Output",
-            children: <pre>{prune.output}</pre>,
-          },
-        ]}
-      />
+      <>
+        {prune.stats ? (
+          <p>
+            Freed {formatBytes(Number(prune.stats.bytesFreed))}, repacked{" "}
+            {formatBytes(Number(prune.stats.bytesRepacked))}, deleted{" "}
+            {prune.stats.packsDeleted.toString()} packs
+          </p>
+        ) : null}
+        <Collapse
+          size="small"
+          destroyInactivePanel
+          items={[
+            {
+              key: 1,
+              label: "Prune Output",
+              children: <pre>{prune.output}</pre>,
+            },
+          ]}
+        />
+      </>
     );
   } else if (operation.op.case === "operationRestore") {
     const restore = operation.op.value;
@@ -276,17 +310,67 @@ export const OperationRow = ({
   } else if (operation.op.case === "operationRunHook") {
     const hook = operation.op.value;
     body = <RunHookOperationStatus op={operation} />
+  } else if (operation.op.case === "operationBenchmark") {
+    const benchmark = operation.op.value;
+    body = benchmark.uploadMs ? (
+      <p>
+        Latency {benchmark.latencyMs.toString()}ms, uploaded{" "}
+        {formatBytes(Number(benchmark.sizeBytes))} at{" "}
+        {formatBytes(benchmark.uploadBytesPerSecond)}/s
+        {benchmark.downloadMs
+          ? `, downloaded at ${formatBytes(benchmark.downloadBytesPerSecond)}/s`
+          : ""}
+        {benchmark.cleaned ? "" : " (test data was not removed)"}
+      </p>
+    ) : null;
   }
 
   if (operation.displayMessage) {
     body = (
       <>
-        <pre>{details.state}: {operation.displayMessage}</pre>
+        <pre>
+          {details.state}
+          {operation.errorClass !== ErrorClass.UNKNOWN
+            ? ` (${ErrorClass[operation.errorClass].toLowerCase()})`
+            : ""}
+          : {operation.displayMessage}
+        </pre>
         {body}
       </>
     );
   }
 
+  const ack = operation.acknowledgement;
+  if (ack) {
+    body = (
+      <>
+        <Typography.Text type="secondary">
+          Acknowledged{ack.user ? ` by ${ack.user}` : ""} at {formatTime(Number(ack.unixTimeMs))}
+          {ack.note ? `: ${ack.note}` : ""}
+        </Typography.Text>
+        {body}
+      </>
+    );
+  }
+
+  const usage = operation.resourceUsage;
+  if (usage) {
+    body = (
+      <>
+        {body}
+        <Typography.Text type="secondary">
+          CPU {formatDuration(Number(usage.cpuUserMs + usage.cpuSystemMs))},
+          peak memory {formatBytes(Number(usage.maxRssBytes))}, read{" "}
+          {formatBytes(Number(usage.readBytes))}, written{" "}
+          {formatBytes(Number(usage.writeBytes))}
+          {usage.uploadBytesEstimate
+            ? `, uploaded ~${formatBytes(Number(usage.uploadBytesEstimate))} (estimated)`
+            : ""}
+        </Typography.Text>
+      </>
+    );
+  }
+
   return (
     <List.Item>
       <List.Item.Meta title={title} avatar={avatar} description={body} />
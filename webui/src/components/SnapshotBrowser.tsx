@@ -217,7 +217,7 @@ const FileNode = ({ entry }: { entry: LsEntry }) => {
   return (
     <Space onMouseEnter={showDropdown} onMouseLeave={() => setDropdown(null)}>
       {entry.name}
-      {entry.type === "file" ? (
+      {entry.type === "file" || (entry.type === "dir" && Number(entry.size) > 0) ? (
         <span className="backrest file-details">
           ({formatBytes(Number(entry.size))})
         </span>
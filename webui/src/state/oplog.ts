@@ -90,7 +90,8 @@ const getStatus = async (req: GetOperationsRequest) => {
     if (
       op.status !== OperationStatus.STATUS_SUCCESS &&
       op.status !== OperationStatus.STATUS_USER_CANCELLED &&
-      op.status !== OperationStatus.STATUS_SYSTEM_CANCELLED
+      op.status !== OperationStatus.STATUS_SYSTEM_CANCELLED &&
+      !op.acknowledgement
     ) {
       return op.status;
     }
@@ -107,6 +108,7 @@ export enum DisplayType {
   RESTORE,
   STATS,
   RUNHOOK,
+  BENCHMARK,
 }
 
 export interface BackupInfo {
@@ -342,6 +344,8 @@ export const getTypeForDisplay = (op: Operation) => {
       return DisplayType.STATS;
     case "operationRunHook":
       return DisplayType.RUNHOOK;
+    case "operationBenchmark":
+      return DisplayType.BENCHMARK;
     default:
       return DisplayType.UNKNOWN;
   }
@@ -363,6 +367,8 @@ export const displayTypeToString = (type: DisplayType) => {
       return "Stats";
     case DisplayType.RUNHOOK:
       return "Run Hook";
+    case DisplayType.BENCHMARK:
+      return "Benchmark";
     default:
       return "Unknown";
   }
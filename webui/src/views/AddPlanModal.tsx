@@ -26,6 +26,7 @@ import { HooksFormList, hooksListTooltipText } from "../components/HooksFormList
 import { ConfirmButton, SpinButton } from "../components/SpinButton";
 import { useConfig } from "../components/ConfigProvider";
 import { backrestService } from "../api";
+import { DeletePlanRequest, HistoryDisposition } from "../../gen/ts/v1/service_pb";
 
 export const AddPlanModal = ({
   template,
@@ -53,20 +54,19 @@ export const AddPlanModal = ({
         throw new Error("template not found");
       }
 
-      // Remove the plan from the config
-      const idx = config.plans.findIndex((r) => r.id === template.id);
-      if (idx === -1) {
-        throw new Error("failed to update config, plan to delete not found");
-      }
-
-      config.plans.splice(idx, 1);
-
-      // Update config and notify success.
-      setConfig(await backrestService.setConfig(config));
+      // Remove the plan from the config and archive its history.
+      const result = await backrestService.deletePlan(
+        new DeletePlanRequest({
+          planId: template.id,
+          history: HistoryDisposition.HISTORY_ARCHIVE,
+        })
+      );
+      setConfig(result.config!);
       showModal(null);
 
       alertsApi.success(
-        "Plan deleted from config, but not from restic repo. Snapshots will remain in storage and operations will be tracked until manually deleted. Reusing a deleted plan ID is not recommended if backups have already been performed.",
+        "Plan deleted from config, but not from restic repo. Snapshots will remain in storage. " +
+        `${result.operationsRemoved} operations were archived to ${result.historyArchive}. Reusing a deleted plan ID is not recommended if backups have already been performed.`,
         30
       );
     } catch (e: any) {
@@ -86,6 +86,9 @@ export const AddPlanModal = ({
       if (plan.retention && plan.retention.equals(new RetentionPolicy())) {
         delete plan.retention;
       }
+      if (!plan.webhookTrigger?.token) {
+        delete plan.webhookTrigger;
+      }
 
       // Merge the new plan (or update) into the config
       if (template) {
@@ -208,6 +211,25 @@ export const AddPlanModal = ({
             />
           </Form.Item>
 
+          {/* Plan.additionalRepos */}
+          <Form.Item<Plan>
+            name="additionalRepos"
+            label={
+              <Tooltip title="Further repositories each backup is also made to, e.g. an offsite copy. They are backed up one after another following the backup to the repository above, retention is applied in each.">
+                Additional Repositories
+              </Tooltip>
+            }
+            initialValue={template ? template.additionalRepos : []}
+          >
+            <Select
+              mode="multiple"
+              allowClear
+              options={repos.map((repo) => ({
+                value: repo.id,
+              }))}
+            />
+          </Form.Item>
+
           {/* Plan.paths */}
           <Form.Item label="Paths" required={true}>
             <Form.List
@@ -392,6 +414,29 @@ export const AddPlanModal = ({
             </Form.Item>
           </Tooltip>
 
+          {/* Plan.timezone */}
+          <Tooltip title="IANA time zone the schedule is evaluated in e.g. Europe/Berlin, defaults to the server's time zone">
+            <Form.Item<Plan>
+              name="timezone"
+              label="Time Zone"
+              initialValue={template ? template.timezone : ""}
+            >
+              <Input placeholder="server local time" />
+            </Form.Item>
+          </Tooltip>
+
+          {/* Plan.webhookTrigger */}
+          <Tooltip title="Token external systems trigger backups with by POSTing to /api/trigger/<plan id> with the token as a bearer token or ?token= query parameter, at least 16 characters. Leave empty to disable.">
+            <Form.Item<Plan>
+              name={["webhookTrigger", "token"]}
+              label="Webhook Trigger Token"
+              initialValue={template ? template.webhookTrigger?.token : ""}
+              rules={[{ min: 16, message: "Token must be at least 16 characters" }]}
+            >
+              <Input.Password placeholder="disabled" />
+            </Form.Item>
+          </Tooltip>
+
           {/* Plan.retention */}
           <RetentionPolicyView />
 
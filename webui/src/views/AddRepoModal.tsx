@@ -22,6 +22,7 @@ import { MinusCircleOutlined, PlusOutlined } from "@ant-design/icons";
 import { useAlertApi } from "../components/Alerts";
 import { namePattern, validateForm } from "../lib/formutil";
 import { backrestService } from "../api";
+import { DeleteRepoRequest, HistoryDisposition } from "../../gen/ts/v1/service_pb";
 import {
   HooksFormList,
   hooksListTooltipText,
@@ -65,22 +66,22 @@ export const AddRepoModal = ({
         }
       }
 
-      // Remove the plan from the config
-      const idx = config.repos.findIndex((r) => r.id === template.id);
-      if (idx === -1) {
-        throw new Error("failed to update config, plan to delete not found");
-      }
-
-      config.repos.splice(idx, 1);
-
-      // Update config and notify success.
-      setConfig(await backrestService.setConfig(config));
+      // Remove the repo from the config, archive its history and remove its cache.
+      const result = await backrestService.deleteRepo(
+        new DeleteRepoRequest({
+          repoId: template.id,
+          history: HistoryDisposition.HISTORY_ARCHIVE,
+          removeCache: true,
+        })
+      );
+      setConfig(result.config!);
       showModal(null);
       alertsApi.success(
         "Deleted repo " +
         template.id +
         " from config but files remain. To release storage delete the files manually. URI: " +
-        template.uri
+        template.uri +
+        (result.historyArchive ? ". Its history was archived to " + result.historyArchive : "")
       );
     } catch (e: any) {
       alertsApi.error("Operation failed: " + e.message, 15);
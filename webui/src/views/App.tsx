@@ -26,6 +26,8 @@ import { backrestService, setAuthToken } from "../api";
 import { MainContentArea, useSetContent } from "./MainContentArea";
 import { GettingStartedGuide } from "./GettingStartedGuide";
 import { useConfig } from "../components/ConfigProvider";
+import { ConfirmButton } from "../components/SpinButton";
+import { StringValue } from "@bufbuild/protobuf";
 
 const { Header, Sider } = Layout;
 
@@ -90,6 +92,24 @@ export const App: React.FC = () => {
 
   const items = getSidenavItems(config);
 
+  const handleEmergencyStop = async () => {
+    try {
+      setConfig(await backrestService.emergencyStop(new StringValue({ value: "triggered from the UI" })));
+      alertApi.warning("Emergency stop: all tasks cancelled and schedules paused.");
+    } catch (e: any) {
+      alertApi.error("Emergency stop failed: " + e.message);
+    }
+  };
+
+  const handleResumeSchedules = async () => {
+    try {
+      setConfig(await backrestService.resumeSchedules({}));
+      alertApi.success("Schedules resumed.");
+    } catch (e: any) {
+      alertApi.error("Failed to resume schedules: " + e.message);
+    }
+  };
+
   return (
     <Layout style={{ height: "auto", minHeight: "100vh" }}>
       <Header
@@ -121,6 +141,27 @@ export const App: React.FC = () => {
           <small style={{ color: "rgba(255,255,255,0.3)", fontSize: "0.6em" }}>
             {config && config.host ? "Host: " + config.host : undefined}
           </small>
+          {config && config.emergencyStop ? (
+            <ConfirmButton
+              danger
+              type="primary"
+              style={{ marginLeft: "10px" }}
+              confirmTitle="Confirm resume"
+              onClickAsync={handleResumeSchedules}
+            >
+              Stopped, Resume Schedules
+            </ConfirmButton>
+          ) : (
+            <ConfirmButton
+              danger
+              type="text"
+              style={{ marginLeft: "10px" }}
+              confirmTitle="Confirm emergency stop"
+              onClickAsync={handleEmergencyStop}
+            >
+              Emergency Stop
+            </ConfirmButton>
+          )}
           <Button
             type="text"
             style={{ marginLeft: "10px", color: "white" }}
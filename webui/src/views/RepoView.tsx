@@ -4,7 +4,7 @@ import { Col, Empty, Flex, Row, Spin, TabsProps, Tabs, Tooltip, Typography } fro
 import { OperationList } from "../components/OperationList";
 import { OperationTree } from "../components/OperationTree";
 import { MAX_OPERATION_HISTORY, STATS_OPERATION_HISTORY } from "../constants";
-import { GetOperationsRequest } from "../../gen/ts/v1/service_pb";
+import { BenchmarkRepoRequest, GetOperationsRequest } from "../../gen/ts/v1/service_pb";
 import { getOperations } from "../state/oplog";
 import { RepoStats } from "../../gen/ts/v1/restic_pb";
 import { formatBytes, formatTime } from "../lib/formatting";
@@ -44,6 +44,14 @@ export const RepoView = ({ repo }: React.PropsWithChildren<{ repo: Repo }>) => {
     await backrestService.indexSnapshots(new StringValue({ value: repo.id! }));
   }
 
+  const handleWarmupNow = async () => {
+    await backrestService.warmupRepo(new StringValue({ value: repo.id! }));
+  }
+
+  const handleBenchmarkNow = async () => {
+    await backrestService.benchmarkRepo(new BenchmarkRepoRequest({ repoId: repo.id! }));
+  }
+
   // Gracefully handle deletions by checking if the plan is still in the config.
   let repoInConfig = config?.repos?.find((r) => r.id === repo.id);
   if (!repoInConfig) {
@@ -118,6 +126,20 @@ export const RepoView = ({ repo }: React.PropsWithChildren<{ repo: Repo }>) => {
             Index Snapshots
           </SpinButton>
         </Tooltip>
+        {repo.coldStorage?.enabled && repo.coldStorage?.warmupCommand ? (
+          <Tooltip title="Runs the repo's warmup command, restores and prunes of the cold storage repo are possible once it completes.">
+            <SpinButton type="default" onClickAsync={handleWarmupNow}>
+              Warm Up
+            </SpinButton>
+          </Tooltip>
+        ) : null}
+        {!repo.appendOnly?.enabled ? (
+          <Tooltip title="Measures the repo's latency and upload and download throughput with 64 MiB of test data, the test data is removed afterwards.">
+            <SpinButton type="default" onClickAsync={handleBenchmarkNow}>
+              Benchmark
+            </SpinButton>
+          </Tooltip>
+        ) : null}
       </Flex>
       <Tabs
         defaultActiveKey={items[0].key}
@@ -75,6 +75,22 @@ export const SettingsModal = () => {
     showModal(null);
   };
 
+  const handleUpdateRestic = async (apply: boolean) => {
+    try {
+      const status = await backrestService.updateRestic({ apply });
+      if (status.updated) {
+        alertsApi.success(`Updated restic from ${status.currentVersion} to ${status.latestVersion}`, 5);
+        setConfig(await backrestService.getConfig({}));
+      } else if (status.updateAvailable) {
+        alertsApi.info(`Restic ${status.latestVersion} is available, backrest uses ${status.currentVersion}`, 10);
+      } else {
+        alertsApi.info(`Restic ${status.currentVersion} is the latest version`, 5);
+      }
+    } catch (e: any) {
+      alertsApi.error("Failed to update restic: " + e.message, 15);
+    }
+  };
+
   const users = config.auth?.users || [];
 
   return (
@@ -179,6 +195,18 @@ export const SettingsModal = () => {
             </Form.List>
           </Form.Item>
 
+          <Form.Item label="Restic">
+            <Typography.Text>{config.resticBinary?.version || "Provided by BACKREST_RESTIC_COMMAND"}</Typography.Text>
+            {config.resticBinary?.version ? (
+              <>
+                <Button type="link" onClick={() => handleUpdateRestic(false)}>Check for updates</Button>
+                <Tooltip title="Downloads, verifies and test runs the latest restic release before switching to it">
+                  <Button onClick={() => handleUpdateRestic(true)}>Update Restic</Button>
+                </Tooltip>
+              </>
+            ) : null}
+          </Form.Item>
+
           <Form.Item shouldUpdate label="Preview">
             {() => (
               <Collapse